@@ -0,0 +1,220 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
+)
+
+// ErrCacheBypassed is returned by reads while the cache's circuit breaker is open, without
+// calling the cache, so callers fall back to the database right away
+var ErrCacheBypassed = errors.New("cache bypassed")
+
+// Defaults of the cache's circuit breaker
+const (
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerCoolDown         = 10 * time.Second
+	// maxPendingInvalidations bounds the keys remembered for deletion while the cache is down
+	maxPendingInvalidations = 10000
+)
+
+// BreakerConfig controls when the cache's circuit breaker opens and how long it stays open
+type BreakerConfig struct {
+	FailureThreshold int
+	CoolDown         time.Duration
+}
+
+// BreakerConfigFromEnv reads CACHE_BREAKER_FAILURE_THRESHOLD and CACHE_BREAKER_COOLDOWN_SECONDS,
+// keeping the defaults of 5 failures and 10 seconds for unset or invalid values
+func BreakerConfigFromEnv() BreakerConfig {
+	cfg := BreakerConfig{
+		FailureThreshold: defaultBreakerFailureThreshold,
+		CoolDown:         defaultBreakerCoolDown,
+	}
+	if v, err := strconv.Atoi(os.Getenv("CACHE_BREAKER_FAILURE_THRESHOLD")); err == nil && v > 0 {
+		cfg.FailureThreshold = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("CACHE_BREAKER_COOLDOWN_SECONDS")); err == nil && v > 0 {
+		cfg.CoolDown = time.Duration(v) * time.Second
+	}
+	return cfg
+}
+
+// BreakerCache stops calling the cache it wraps once it keeps failing, so an unreachable Redis
+// doesn't add a connection timeout to every request. While the breaker is open, reads return
+// ErrCacheBypassed, writes are skipped and deletes are queued; the skipped writes report
+// success, so callers don't log an error per operation. After the cool-down the next call
+// probes the cache, and once it succeeds the queued deletes are replayed, so entries changed
+// during the outage aren't served stale.
+type BreakerCache struct {
+	cache   Cache
+	breaker *resilience.CircuitBreaker
+	// pending holds the keys deleted while the breaker was open
+	pending invalidationQueue
+}
+
+// NewBreakerCache wraps c with breaker, which reports the cache's state to the health endpoint
+// once it is registered
+func NewBreakerCache(c Cache, breaker *resilience.CircuitBreaker) *BreakerCache {
+	return &BreakerCache{cache: c, breaker: breaker}
+}
+
+// Set stores a value in the wrapped cache, or skips it while the breaker is open
+func (b *BreakerCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	if b.breaker.Allow() != nil {
+		return nil
+	}
+	err := b.cache.Set(ctx, key, value, expiration)
+	b.record(ctx, err)
+	return err
+}
+
+// Get retrieves a value from the wrapped cache, returning ErrCacheBypassed while the breaker is open
+func (b *BreakerCache) Get(ctx context.Context, key string, dest interface{}) error {
+	if b.breaker.Allow() != nil {
+		return ErrCacheBypassed
+	}
+	err := b.cache.Get(ctx, key, dest)
+	b.record(ctx, err)
+	return err
+}
+
+// Delete removes keys from the wrapped cache, or queues them while the breaker is open
+func (b *BreakerCache) Delete(ctx context.Context, keys ...string) error {
+	if b.breaker.Allow() != nil {
+		b.pending.add(keys)
+		return nil
+	}
+	err := b.cache.Delete(ctx, keys...)
+	if err != nil {
+		// The keys may still be cached, so they are deleted again once the cache recovers
+		b.pending.add(keys)
+	}
+	b.record(ctx, err)
+	return err
+}
+
+// Exists checks the wrapped cache for a key, returning ErrCacheBypassed while the breaker is open
+func (b *BreakerCache) Exists(ctx context.Context, key string) (bool, error) {
+	if b.breaker.Allow() != nil {
+		return false, ErrCacheBypassed
+	}
+	exists, err := b.cache.Exists(ctx, key)
+	b.record(ctx, err)
+	return exists, err
+}
+
+// Keys lists the keys of the wrapped cache, returning ErrCacheBypassed while the breaker is open
+func (b *BreakerCache) Keys(ctx context.Context, prefix string) ([]KeyInfo, error) {
+	if b.breaker.Allow() != nil {
+		return nil, ErrCacheBypassed
+	}
+	keys, err := b.cache.Keys(ctx, prefix)
+	b.record(ctx, err)
+	return keys, err
+}
+
+// Close closes the wrapped cache
+func (b *BreakerCache) Close() error {
+	return b.cache.Close()
+}
+
+// PendingInvalidations returns the number of keys waiting to be deleted once the cache recovers
+func (b *BreakerCache) PendingInvalidations() int {
+	return b.pending.len()
+}
+
+// record reports the outcome of a call to the breaker. A miss means the cache answered, so it
+// counts as a success. The success that closes the breaker replays the queued deletes.
+func (b *BreakerCache) record(ctx context.Context, err error) {
+	if err != nil && !isMiss(err) {
+		b.breaker.Failure()
+		return
+	}
+	recovered := b.breaker.State() == resilience.StateHalfOpen
+	b.breaker.Success()
+	if recovered {
+		b.flush(ctx)
+	}
+}
+
+// flush deletes the queued keys from the wrapped cache, keeping them queued if that fails
+func (b *BreakerCache) flush(ctx context.Context) {
+	if err := b.pending.replay(ctx, b.cache); err != nil {
+		logger.ZapLogger.Warn("Failed to replay cache invalidations queued while the cache was down", zap.Error(err))
+	}
+}
+
+// invalidationQueue remembers the keys deleted while a cache is down, so they can be deleted
+// once it recovers
+type invalidationQueue struct {
+	mu      sync.Mutex
+	pending map[string]struct{}
+	// overflowed is set once pending is full, so the dropped keys are only logged once per outage
+	overflowed bool
+}
+
+// add remembers keys to delete once the cache recovers
+func (q *invalidationQueue) add(keys []string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.pending == nil {
+		q.pending = make(map[string]struct{})
+	}
+	for _, key := range keys {
+		if len(q.pending) >= maxPendingInvalidations {
+			if !q.overflowed {
+				q.overflowed = true
+				logger.ZapLogger.Warn("Too many cache invalidations queued while the cache is down; some entries may be served stale until they expire",
+					zap.Int("queued", len(q.pending)))
+			}
+			return
+		}
+		q.pending[key] = struct{}{}
+	}
+}
+
+// len returns the number of queued keys
+func (q *invalidationQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// replay deletes the queued keys from c, keeping them queued if that fails
+func (q *invalidationQueue) replay(ctx context.Context, c Cache) error {
+	q.mu.Lock()
+	keys := make([]string, 0, len(q.pending))
+	for key := range q.pending {
+		keys = append(keys, key)
+	}
+	q.pending = nil
+	q.overflowed = false
+	q.mu.Unlock()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := c.Delete(ctx, keys...); err != nil {
+		q.add(keys)
+		return fmt.Errorf("deleting %d keys: %w", len(keys), err)
+	}
+	logger.ZapLogger.Info("Replayed cache invalidations queued while the cache was down", zap.Int("keys", len(keys)))
+	return nil
+}
+
+// isMiss reports whether err means the key isn't cached, or isn't cached in a readable form
+func isMiss(err error) bool {
+	return errors.Is(err, redis.Nil) || errors.Is(err, gcache.KeyNotFoundError) || errors.Is(err, ErrUndecodable)
+}
@@ -0,0 +1,125 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
+)
+
+// failingCache wraps a MemoryCache, failing every call while down and counting the calls it receives
+type failingCache struct {
+	*cache.MemoryCache
+	down    bool
+	calls   int
+	deleted []string
+}
+
+var errCacheDown = errors.New("dial tcp: i/o timeout")
+
+func (c *failingCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.calls++
+	if c.down {
+		return errCacheDown
+	}
+	return c.MemoryCache.Set(ctx, key, value, expiration)
+}
+
+func (c *failingCache) Get(ctx context.Context, key string, dest interface{}) error {
+	c.calls++
+	if c.down {
+		return errCacheDown
+	}
+	return c.MemoryCache.Get(ctx, key, dest)
+}
+
+func (c *failingCache) Delete(ctx context.Context, keys ...string) error {
+	c.calls++
+	if c.down {
+		return errCacheDown
+	}
+	c.deleted = append(c.deleted, keys...)
+	return c.MemoryCache.Delete(ctx, keys...)
+}
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time { return c.now }
+
+func TestBreakerCache_BypassesFailingCache(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+	inner := &failingCache{MemoryCache: cache.NewMemoryCache(10)}
+	now := &clock{now: time.Unix(0, 0)}
+	breaker := resilience.NewCircuitBreaker("cache", 3, 10*time.Second).WithClock(now.Now)
+	c := cache.NewBreakerCache(inner, breaker)
+
+	require.NoError(t, c.Set(ctx, "user:1", "Jane", time.Minute))
+	var value string
+	assert.Error(t, c.Get(ctx, "user:2", &value), "a miss is returned as it is")
+	assert.Equal(t, resilience.StateClosed, breaker.State(), "a miss doesn't count as a failure")
+
+	// Failures below the threshold are returned and keep the breaker closed
+	inner.down = true
+	assert.ErrorIs(t, c.Get(ctx, "user:1", &value), errCacheDown)
+	assert.ErrorIs(t, c.Set(ctx, "user:1", "Janet", time.Minute), errCacheDown)
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+	assert.ErrorIs(t, c.Delete(ctx, "user:1"), errCacheDown)
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+
+	// While open, the wrapped cache isn't called: reads are bypassed, writes skipped and deletes queued
+	calls := inner.calls
+	for i := 0; i < 10; i++ {
+		assert.ErrorIs(t, c.Get(ctx, "user:1", &value), cache.ErrCacheBypassed)
+		assert.NoError(t, c.Set(ctx, "user:1", "Janet", time.Minute))
+		assert.NoError(t, c.Delete(ctx, "users:all"))
+	}
+	_, err := c.Exists(ctx, "user:1")
+	assert.ErrorIs(t, err, cache.ErrCacheBypassed)
+	assert.Equal(t, calls, inner.calls)
+	assert.Equal(t, 2, c.PendingInvalidations())
+
+	// A probe after the cool-down that fails keeps the breaker open
+	now.now = now.now.Add(10 * time.Second)
+	assert.ErrorIs(t, c.Get(ctx, "user:1", &value), errCacheDown)
+	assert.Equal(t, calls+1, inner.calls)
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+	assert.ErrorIs(t, c.Get(ctx, "user:1", &value), cache.ErrCacheBypassed)
+
+	// A probe that succeeds closes it and replays the queued deletes
+	inner.down = false
+	now.now = now.now.Add(10 * time.Second)
+	require.NoError(t, c.Get(ctx, "user:1", &value))
+	assert.Equal(t, "Jane", value, "the value cached before the outage is still there")
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+	assert.ElementsMatch(t, []string{"user:1", "users:all"}, inner.deleted)
+	assert.Zero(t, c.PendingInvalidations())
+	assert.Error(t, c.Get(ctx, "user:1", &value), "the entry deleted during the outage is gone")
+
+	stats := breaker.Stats()
+	assert.Equal(t, int64(4), stats.TotalFailures)
+	assert.Equal(t, int64(32), stats.Rejections)
+}
+
+func TestBreakerConfigFromEnv(t *testing.T) {
+	t.Setenv("CACHE_BREAKER_FAILURE_THRESHOLD", "")
+	t.Setenv("CACHE_BREAKER_COOLDOWN_SECONDS", "")
+	assert.Equal(t, cache.BreakerConfig{FailureThreshold: 5, CoolDown: 10 * time.Second}, cache.BreakerConfigFromEnv())
+
+	t.Setenv("CACHE_BREAKER_FAILURE_THRESHOLD", "2")
+	t.Setenv("CACHE_BREAKER_COOLDOWN_SECONDS", "60")
+	assert.Equal(t, cache.BreakerConfig{FailureThreshold: 2, CoolDown: time.Minute}, cache.BreakerConfigFromEnv())
+
+	t.Setenv("CACHE_BREAKER_FAILURE_THRESHOLD", "-1")
+	assert.Equal(t, 5, cache.BreakerConfigFromEnv().FailureThreshold)
+}
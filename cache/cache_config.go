@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// defaultTTLSeconds is used when neither an entity-specific TTL nor CACHE_TTL is set
+const defaultTTLSeconds = 3600
+
+// defaultIssueResponseTTLSeconds is used when CACHE_TTL_ISSUE_RESPONSE_SECONDS is not set. The
+// responses hold project and user details, so they are kept briefly whatever CACHE_TTL is.
+const defaultIssueResponseTTLSeconds = 30
+
+// jitterFraction is how far Jitter moves a TTL either way, as a fraction of the TTL
+const jitterFraction = 0.1
+
+// CacheConfig holds how long each kind of cached entity stays fresh. A TTL of zero turns
+// caching of that entity off, so its reads always go to the repository.
+type CacheConfig struct {
+	IssueTTL       time.Duration
+	IssueListTTL   time.Duration
+	UserTTL        time.Duration
+	UserListTTL    time.Duration
+	ProjectTTL     time.Duration
+	ProjectListTTL time.Duration
+	// IssueResponseTTL is how long GetIssue responses with project and user details stay cached
+	IssueResponseTTL time.Duration
+}
+
+// CacheConfigFromEnv reads the per-entity TTLs from CACHE_TTL_ISSUE_SECONDS, CACHE_TTL_ISSUE_LIST_SECONDS,
+// CACHE_TTL_USER_SECONDS, CACHE_TTL_USER_LIST_SECONDS, CACHE_TTL_PROJECT_SECONDS and
+// CACHE_TTL_PROJECT_LIST_SECONDS. Each one falls back to CACHE_TTL and then to one hour. The TTL
+// of GetIssue responses is read from CACHE_TTL_ISSUE_RESPONSE_SECONDS and defaults to 30 seconds.
+func CacheConfigFromEnv() CacheConfig {
+	ttl := getEnvAsInt("CACHE_TTL", defaultTTLSeconds)
+
+	return CacheConfig{
+		IssueTTL:       seconds(getEnvAsInt("CACHE_TTL_ISSUE_SECONDS", ttl)),
+		IssueListTTL:   seconds(getEnvAsInt("CACHE_TTL_ISSUE_LIST_SECONDS", ttl)),
+		UserTTL:        seconds(getEnvAsInt("CACHE_TTL_USER_SECONDS", ttl)),
+		UserListTTL:    seconds(getEnvAsInt("CACHE_TTL_USER_LIST_SECONDS", ttl)),
+		ProjectTTL:     seconds(getEnvAsInt("CACHE_TTL_PROJECT_SECONDS", ttl)),
+		ProjectListTTL: seconds(getEnvAsInt("CACHE_TTL_PROJECT_LIST_SECONDS", ttl)),
+
+		IssueResponseTTL: seconds(getEnvAsInt("CACHE_TTL_ISSUE_RESPONSE_SECONDS", defaultIssueResponseTTLSeconds)),
+	}
+}
+
+// TTLSeconds reports the TTL of each entity in whole seconds, with 0 for entities that aren't cached
+func (c CacheConfig) TTLSeconds() map[string]int64 {
+	return map[string]int64{
+		"issue":          ttlSeconds(c.IssueTTL),
+		"issue_list":     ttlSeconds(c.IssueListTTL),
+		"user":           ttlSeconds(c.UserTTL),
+		"user_list":      ttlSeconds(c.UserListTTL),
+		"project":        ttlSeconds(c.ProjectTTL),
+		"project_list":   ttlSeconds(c.ProjectListTTL),
+		"issue_response": ttlSeconds(c.IssueResponseTTL),
+	}
+}
+
+// Jitter moves ttl by a random amount of up to 10% either way, so that entries cached at the
+// same time, such as everything cached right after startup, don't all expire together.
+// TTLs of zero or less are returned unchanged.
+func Jitter(ttl time.Duration) time.Duration {
+	spread := time.Duration(float64(ttl) * jitterFraction)
+	if spread <= 0 {
+		return ttl
+	}
+	return ttl - spread + rand.N(2*spread+1)
+}
+
+func seconds(n int) time.Duration {
+	return time.Duration(n) * time.Second
+}
+
+func ttlSeconds(ttl time.Duration) int64 {
+	if ttl <= 0 {
+		return 0
+	}
+	return int64(ttl / time.Second)
+}
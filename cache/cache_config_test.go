@@ -0,0 +1,108 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+)
+
+func TestCacheConfigFromEnv(t *testing.T) {
+	testCases := []struct {
+		name     string
+		env      map[string]string
+		expected cache.CacheConfig
+	}{
+		{
+			name: "defaults to one hour",
+			expected: cache.CacheConfig{
+				IssueTTL:       time.Hour,
+				IssueListTTL:   time.Hour,
+				UserTTL:        time.Hour,
+				UserListTTL:    time.Hour,
+				ProjectTTL:     time.Hour,
+				ProjectListTTL: time.Hour,
+
+				IssueResponseTTL: 30 * time.Second,
+			},
+		},
+		{
+			name: "falls back to CACHE_TTL",
+			env:  map[string]string{"CACHE_TTL": "60"},
+			expected: cache.CacheConfig{
+				IssueTTL:       time.Minute,
+				IssueListTTL:   time.Minute,
+				UserTTL:        time.Minute,
+				UserListTTL:    time.Minute,
+				ProjectTTL:     time.Minute,
+				ProjectListTTL: time.Minute,
+
+				IssueResponseTTL: 30 * time.Second,
+			},
+		},
+		{
+			name: "entity-specific TTLs take precedence",
+			env: map[string]string{
+				"CACHE_TTL":                        "60",
+				"CACHE_TTL_ISSUE_SECONDS":          "30",
+				"CACHE_TTL_ISSUE_LIST_SECONDS":     "600",
+				"CACHE_TTL_USER_SECONDS":           "7200",
+				"CACHE_TTL_USER_LIST_SECONDS":      "300",
+				"CACHE_TTL_PROJECT_SECONDS":        "not-a-number",
+				"CACHE_TTL_PROJECT_LIST_SECONDS":   "120",
+				"CACHE_TTL_ISSUE_RESPONSE_SECONDS": "5",
+			},
+			expected: cache.CacheConfig{
+				IssueTTL:       30 * time.Second,
+				IssueListTTL:   10 * time.Minute,
+				UserTTL:        2 * time.Hour,
+				UserListTTL:    5 * time.Minute,
+				ProjectTTL:     time.Minute,
+				ProjectListTTL: 2 * time.Minute,
+
+				IssueResponseTTL: 5 * time.Second,
+			},
+		},
+		{
+			name: "zero turns caching off",
+			env: map[string]string{
+				"CACHE_TTL":                        "0",
+				"CACHE_TTL_ISSUE_SECONDS":          "30",
+				"CACHE_TTL_ISSUE_LIST_SECONDS":     "",
+				"CACHE_TTL_ISSUE_RESPONSE_SECONDS": "0",
+			},
+			expected: cache.CacheConfig{IssueTTL: 30 * time.Second},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, key := range []string{
+				"CACHE_TTL", "CACHE_TTL_ISSUE_SECONDS", "CACHE_TTL_ISSUE_LIST_SECONDS",
+				"CACHE_TTL_USER_SECONDS", "CACHE_TTL_USER_LIST_SECONDS",
+				"CACHE_TTL_PROJECT_SECONDS", "CACHE_TTL_PROJECT_LIST_SECONDS", "CACHE_TTL_ISSUE_RESPONSE_SECONDS",
+			} {
+				t.Setenv(key, tc.env[key])
+			}
+
+			assert.Equal(t, tc.expected, cache.CacheConfigFromEnv())
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	ttl := time.Hour
+	seen := make(map[time.Duration]bool)
+	for range 1000 {
+		jittered := cache.Jitter(ttl)
+		assert.GreaterOrEqual(t, jittered, 54*time.Minute)
+		assert.LessOrEqual(t, jittered, 66*time.Minute)
+		seen[jittered] = true
+	}
+	assert.Greater(t, len(seen), 1, "expirations are spread out")
+
+	assert.Equal(t, time.Duration(0), cache.Jitter(0), "a disabled TTL stays disabled")
+	assert.Equal(t, time.Duration(5), cache.Jitter(5), "too short to move")
+}
@@ -10,6 +10,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -29,6 +30,14 @@ const (
 // Global cache instance for direct access when needed
 var globalCacheInstance Cache
 
+var (
+	// ErrCacheDisabled is returned by HealthCheck when no cache is configured or created
+	ErrCacheDisabled = errors.New("cache disabled")
+	// ErrCacheNotInitialized is returned by HealthCheck when CACHE_TYPE asks for a cache
+	// that was never created
+	ErrCacheNotInitialized = errors.New("cache not initialized")
+)
+
 // NewCache creates a new cache based on environment configuration
 func NewCache() Cache {
 	cacheType := Type(getEnv("CACHE_TYPE", string(Redis)))
@@ -54,6 +63,11 @@ func NewCache() Cache {
 		)
 	}
 
+	// Both caches compress large values when CACHE_COMPRESSION is set
+	if compressible, ok := instance.(interface{ SetCompression(CompressionConfig) }); ok {
+		compressible.SetCompression(CompressionConfigFromEnv())
+	}
+
 	// Store in global variable for access during shutdown
 	globalCacheInstance = instance
 
@@ -70,9 +84,16 @@ func CloseConnections() error {
 
 // HealthCheck verifies that the cache is working properly by performing
 // a simple set and get operation. Returns an error if the cache is not functioning.
+// Without a cache it returns ErrCacheNotInitialized if CACHE_TYPE names a cache type,
+// since one was expected, and ErrCacheDisabled otherwise.
 func HealthCheck() error {
 	if globalCacheInstance == nil {
-		return nil // No cache initialized yet
+		switch cacheType := Type(os.Getenv("CACHE_TYPE")); cacheType {
+		case Redis, Memory:
+			return fmt.Errorf("%w: CACHE_TYPE is %s but no cache was created", ErrCacheNotInitialized, cacheType)
+		default:
+			return ErrCacheDisabled
+		}
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
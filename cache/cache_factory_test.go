@@ -0,0 +1,37 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+)
+
+func TestHealthCheck(t *testing.T) {
+	testCases := []struct {
+		name      string
+		instance  cache.Cache
+		cacheType string
+		expected  error
+	}{
+		{name: "Healthy cache", instance: cache.NewMemoryCache(10), cacheType: "memory", expected: nil},
+		{name: "Redis expected but never created", cacheType: "redis", expected: cache.ErrCacheNotInitialized},
+		{name: "Memory expected but never created", cacheType: "memory", expected: cache.ErrCacheNotInitialized},
+		{name: "No cache configured", cacheType: "", expected: cache.ErrCacheDisabled},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("CACHE_TYPE", tc.cacheType)
+			defer cache.SetGlobalCache(tc.instance)()
+
+			err := cache.HealthCheck()
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
@@ -19,6 +19,34 @@ type Cache interface {
 	// Exists checks if a key exists in the cache
 	Exists(ctx context.Context, key string) (bool, error)
 
+	// Keys lists the keys starting with prefix, such as "issues:", with their remaining TTLs.
+	// An empty prefix lists every key.
+	Keys(ctx context.Context, prefix string) ([]KeyInfo, error)
+
 	// Close closes the cache connection if needed
 	Close() error
 }
+
+// KeyInfo describes a cached key. TTL is negative if the key never expires.
+type KeyInfo struct {
+	Key string
+	TTL time.Duration
+}
+
+// Stats describes the occupancy of a cache and how entries left it
+type Stats struct {
+	// Entries is the number of unexpired entries and Capacity the most the cache holds
+	Entries  int
+	Capacity int
+	// Evictions counts entries pushed out to make room for new ones and Expirations those
+	// dropped after their TTL; entries deleted by the application are counted in neither
+	Evictions   uint64
+	Expirations uint64
+	Hits        uint64
+	Misses      uint64
+}
+
+// StatsReporter is implemented by caches that can report their Stats
+type StatsReporter interface {
+	Stats() Stats
+}
@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrUndecodable is returned by Get when a cached entry can't be decoded into the destination,
+// for example because it was written in an earlier format. The cache answered, so it is a miss
+// rather than a cache failure.
+var ErrUndecodable = errors.New("cached value can't be decoded")
+
+// marshalValue encodes a value for the cache. Protobuf messages are encoded with protojson so
+// well-known types such as Timestamp and enums read back exactly as stored; other values, like
+// the wrappers of cached lists, are encoded with encoding/json.
+func marshalValue(value interface{}) ([]byte, error) {
+	if msg, ok := value.(proto.Message); ok {
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal cached %T: %w", value, err)
+		}
+		return data, nil
+	}
+	return json.Marshal(value)
+}
+
+// unmarshalValue decodes a cached value into dest, the way marshalValue encoded it. Entries of
+// a message cached before messages were encoded with protojson fail with ErrUndecodable, so
+// they are read again from the store and overwritten.
+func unmarshalValue(data []byte, dest interface{}) error {
+	if msg, ok := dest.(proto.Message); ok {
+		if err := protojson.Unmarshal(data, msg); err != nil {
+			return fmt.Errorf("%w: %T: %v", ErrUndecodable, dest, err)
+		}
+		return nil
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("%w: %T: %v", ErrUndecodable, dest, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+func TestMemoryCache_ProtoMessagesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	issue := &issuesPbv1.Issue{
+		IssueId:    "c72d237e-2658-4252-be58-760c7867d783",
+		Summary:    "Login fails",
+		Status:     issuesPbv1.Status_CLOSED,
+		Resolution: issuesPbv1.Resolution_DUPLICATE,
+		Priority:   issuesPbv1.Priority_MINOR,
+		CreateDate: timestamppb.New(time.Date(2024, 3, 10, 8, 30, 15, 123456789, time.UTC)),
+		ModifyDate: timestamppb.New(time.Date(2024, 3, 11, 9, 0, 0, 1, time.UTC)),
+	}
+
+	for name, c := range map[string]*cache.MemoryCache{
+		"plain":      cache.NewMemoryCache(10),
+		"compressed": newCompressingCache(0),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, c.Set(ctx, "issue:1", issue, time.Minute))
+
+			got := &issuesPbv1.Issue{}
+			require.NoError(t, c.Get(ctx, "issue:1", got))
+			assert.True(t, proto.Equal(issue, got), "cached issue: %v", got)
+		})
+	}
+}
+
+func TestMemoryCache_MessageCachedAsPlainJSONIsAMiss(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemoryCache(10)
+
+	// Entries written before messages were encoded with protojson used encoding/json field names
+	type legacyIssue struct{ IssueId, Summary string }
+	require.NoError(t, c.Set(ctx, "issue:1", legacyIssue{IssueId: "c72d237e-2658-4252-be58-760c7867d783", Summary: "Login fails"}, time.Minute))
+
+	assert.ErrorIs(t, c.Get(ctx, "issue:1", &issuesPbv1.Issue{}), cache.ErrUndecodable)
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// defaultCompressionMinBytes is used when CACHE_COMPRESSION_MIN_BYTES is not set
+const defaultCompressionMinBytes = 1024
+
+// gzipHeader starts every gzip stream. JSON can't start with it, so it marks compressed values.
+var gzipHeader = []byte{0x1f, 0x8b}
+
+// CompressionConfig controls gzip compression of cached values
+type CompressionConfig struct {
+	Enabled bool
+	// MinBytes is the marshaled size from which values are compressed; smaller ones gain
+	// too little to be worth the CPU time
+	MinBytes int
+}
+
+// CompressionConfigFromEnv reads whether cached values are compressed from CACHE_COMPRESSION
+// and the size from which they are from CACHE_COMPRESSION_MIN_BYTES
+func CompressionConfigFromEnv() CompressionConfig {
+	cfg := CompressionConfig{MinBytes: defaultCompressionMinBytes}
+	if v, err := strconv.ParseBool(os.Getenv("CACHE_COMPRESSION")); err == nil {
+		cfg.Enabled = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("CACHE_COMPRESSION_MIN_BYTES")); err == nil && v >= 0 {
+		cfg.MinBytes = v
+	}
+	return cfg
+}
+
+// encodeValue marshals a value to JSON with marshalValue, compressing the result when compression is enabled and
+// it is at least MinBytes long
+func encodeValue(value interface{}, cfg CompressionConfig) ([]byte, error) {
+	data, err := marshalValue(value)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.Enabled || len(data) < cfg.MinBytes {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress cached value: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress cached value: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeValue unmarshals a cached value into dest, decompressing it first if it is compressed.
+// Values are read the same way whether compression is enabled or not, so toggling it leaves the
+// entries already cached readable.
+func decodeValue(data []byte, dest interface{}) error {
+	if !bytes.HasPrefix(data, gzipHeader) {
+		return unmarshalValue(data, dest)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decompress cached value: %w", err)
+	}
+	defer zr.Close()
+
+	data, err = io.ReadAll(zr)
+	if err != nil {
+		return fmt.Errorf("failed to decompress cached value: %w", err)
+	}
+	return unmarshalValue(data, dest)
+}
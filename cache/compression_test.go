@@ -0,0 +1,138 @@
+package cache_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// largeIssueList returns a page of issues like the ones ListIssues caches
+func largeIssueList(n int) []*issuesPbv1.Issue {
+	issues := make([]*issuesPbv1.Issue, n)
+	for i := range issues {
+		issues[i] = &issuesPbv1.Issue{
+			IssueId:     fmt.Sprintf("00000000-0000-4000-8000-%012d", i),
+			Summary:     fmt.Sprintf("Fix login timeout on the settings page (%d)", i),
+			Description: "Users are logged out after a few seconds on the settings page. Steps to reproduce: open settings, wait, click save.",
+			Status:      issuesPbv1.Status_IN_PROGRESS,
+			Type:        issuesPbv1.Type_BUG,
+			Priority:    issuesPbv1.Priority_MAJOR,
+			ProjectId:   "928f705f-0efa-4c96-b2f6-ceb36281e1f1",
+			AssigneeId:  "5b0f3b1c-7f0e-4a53-9d7e-2f1c6a8e4d10",
+			IssueNumber: int32(i + 1),
+			Version:     1,
+		}
+	}
+	return issues
+}
+
+func newCompressingCache(minBytes int) *cache.MemoryCache {
+	c := cache.NewMemoryCache(10)
+	c.SetCompression(cache.CompressionConfig{Enabled: true, MinBytes: minBytes})
+	return c
+}
+
+func TestMemoryCache_CompressesLargeValues(t *testing.T) {
+	ctx := context.Background()
+	issues := largeIssueList(500)
+	plainSize := len(mustMarshal(t, issues))
+
+	c := newCompressingCache(1024)
+	require.NoError(t, c.Set(ctx, "issues:list::500", issues, time.Minute))
+	assert.Less(t, c.StoredSize("issues:list::500"), plainSize/5)
+
+	var got []*issuesPbv1.Issue
+	require.NoError(t, c.Get(ctx, "issues:list::500", &got))
+	require.Len(t, got, len(issues))
+	for i := range issues {
+		assert.True(t, proto.Equal(issues[i], got[i]), "issue %d", i)
+	}
+}
+
+func TestMemoryCache_LeavesSmallValuesUncompressed(t *testing.T) {
+	ctx := context.Background()
+	c := newCompressingCache(1024)
+
+	require.NoError(t, c.Set(ctx, "health:check", "ok", time.Minute))
+	assert.Equal(t, len(`"ok"`), c.StoredSize("health:check"))
+
+	var got string
+	require.NoError(t, c.Get(ctx, "health:check", &got))
+	assert.Equal(t, "ok", got)
+}
+
+func TestMemoryCache_ReadsEntriesAcrossCompressionToggle(t *testing.T) {
+	ctx := context.Background()
+	issues := largeIssueList(50)
+	c := cache.NewMemoryCache(10)
+
+	require.NoError(t, c.Set(ctx, "plain", issues, time.Minute))
+	c.SetCompression(cache.CompressionConfig{Enabled: true})
+	require.NoError(t, c.Set(ctx, "compressed", issues, time.Minute))
+	c.SetCompression(cache.CompressionConfig{})
+
+	for _, key := range []string{"plain", "compressed"} {
+		var got []*issuesPbv1.Issue
+		require.NoError(t, c.Get(ctx, key, &got), key)
+		assert.Len(t, got, len(issues), key)
+	}
+}
+
+func TestCompressionConfigFromEnv(t *testing.T) {
+	t.Setenv("CACHE_COMPRESSION", "")
+	t.Setenv("CACHE_COMPRESSION_MIN_BYTES", "")
+	assert.Equal(t, cache.CompressionConfig{MinBytes: 1024}, cache.CompressionConfigFromEnv())
+
+	t.Setenv("CACHE_COMPRESSION", "true")
+	t.Setenv("CACHE_COMPRESSION_MIN_BYTES", "4096")
+	assert.Equal(t, cache.CompressionConfig{Enabled: true, MinBytes: 4096}, cache.CompressionConfigFromEnv())
+}
+
+func mustMarshal(t testing.TB, v interface{}) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+// BenchmarkMemoryCache_LargeIssueList compares storing and reading a 500-issue page with and
+// without compression. stored-bytes is the memory the entry takes; compare it with the ns/op
+// and allocations each variant costs.
+func BenchmarkMemoryCache_LargeIssueList(b *testing.B) {
+	ctx := context.Background()
+	issues := largeIssueList(500)
+
+	for _, bc := range []struct {
+		name        string
+		compression cache.CompressionConfig
+	}{
+		{"uncompressed", cache.CompressionConfig{}},
+		{"gzip", cache.CompressionConfig{Enabled: true, MinBytes: 1024}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			c := cache.NewMemoryCache(10)
+			c.SetCompression(bc.compression)
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if err := c.Set(ctx, "issues:list::500", issues, time.Minute); err != nil {
+					b.Fatal(err)
+				}
+				var got []*issuesPbv1.Issue
+				if err := c.Get(ctx, "issues:list::500", &got); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(c.StoredSize("issues:list::500")), "stored-bytes")
+		})
+	}
+}
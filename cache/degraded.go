@@ -0,0 +1,284 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+// Defaults of the cache's degraded mode
+const (
+	defaultDegradedErrorThreshold = 5
+	defaultDegradedWindow         = 10 * time.Second
+	// degradedWarnInterval is how often a degraded cache logs that it is skipping operations
+	degradedWarnInterval = time.Minute
+)
+
+// DegradedConfig controls how many cache errors within a window put a cache in degraded mode
+type DegradedConfig struct {
+	ErrorThreshold int
+	Window         time.Duration
+}
+
+// DegradedConfigFromEnv reads CACHE_DEGRADED_ERROR_THRESHOLD and CACHE_DEGRADED_WINDOW_SECONDS,
+// keeping the defaults of 5 errors in 10 seconds for unset or invalid values
+func DegradedConfigFromEnv() DegradedConfig {
+	cfg := DegradedConfig{
+		ErrorThreshold: defaultDegradedErrorThreshold,
+		Window:         defaultDegradedWindow,
+	}
+	if v, err := strconv.Atoi(os.Getenv("CACHE_DEGRADED_ERROR_THRESHOLD")); err == nil && v > 0 {
+		cfg.ErrorThreshold = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("CACHE_DEGRADED_WINDOW_SECONDS")); err == nil && v > 0 {
+		cfg.Window = time.Duration(v) * time.Second
+	}
+	return cfg
+}
+
+// DegradedCache stops using the cache it wraps once its errors within a sliding window reach
+// a threshold, so a Redis brownout doesn't log an error for every cached read and write. In
+// degraded mode reads return ErrCacheBypassed, writes are skipped, and deletes and the prefixes
+// listed to flush are queued, all reporting success apart from the bypassed reads, and a
+// single warning is logged per minute. The cache is only used again once Recover is called
+// after a successful health check, which replays the queued invalidations first.
+type DegradedCache struct {
+	name  string
+	cache Cache
+	cfg   DegradedConfig
+	now   func() time.Time
+	// pending holds the keys deleted while degraded
+	pending invalidationQueue
+
+	mu sync.Mutex
+	// pendingPrefixes holds the prefixes listed while degraded
+	pendingPrefixes map[string]struct{}
+	failures        []time.Time
+	degraded        bool
+	// skipped counts the operations skipped since the last warning
+	skipped  int
+	lastWarn time.Time
+}
+
+// NewDegradedCache wraps c, naming it in its logs after the repository using it
+func NewDegradedCache(name string, c Cache, cfg DegradedConfig) *DegradedCache {
+	if cfg.ErrorThreshold <= 0 {
+		cfg.ErrorThreshold = 1
+	}
+	return &DegradedCache{name: name, cache: c, cfg: cfg, now: time.Now}
+}
+
+// WithClock overrides the time source, which is useful in tests
+func (d *DegradedCache) WithClock(now func() time.Time) *DegradedCache {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.now = now
+	return d
+}
+
+// Set stores a value in the wrapped cache, or skips it in degraded mode
+func (d *DegradedCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	if d.skip() {
+		return nil
+	}
+	err := d.cache.Set(ctx, key, value, expiration)
+	d.record(err)
+	return err
+}
+
+// Get retrieves a value from the wrapped cache, returning ErrCacheBypassed in degraded mode
+func (d *DegradedCache) Get(ctx context.Context, key string, dest interface{}) error {
+	if d.skip() {
+		return ErrCacheBypassed
+	}
+	err := d.cache.Get(ctx, key, dest)
+	d.record(err)
+	return err
+}
+
+// Delete removes keys from the wrapped cache, or queues them in degraded mode
+func (d *DegradedCache) Delete(ctx context.Context, keys ...string) error {
+	if d.skip() {
+		d.pending.add(keys)
+		return nil
+	}
+	err := d.cache.Delete(ctx, keys...)
+	if err != nil {
+		// The keys may still be cached, so they are deleted again once the cache recovers
+		d.pending.add(keys)
+	}
+	d.record(err)
+	return err
+}
+
+// Exists checks the wrapped cache for a key, returning ErrCacheBypassed in degraded mode
+func (d *DegradedCache) Exists(ctx context.Context, key string) (bool, error) {
+	if d.skip() {
+		return false, ErrCacheBypassed
+	}
+	exists, err := d.cache.Exists(ctx, key)
+	d.record(err)
+	return exists, err
+}
+
+// Keys lists the keys of the wrapped cache. In degraded mode it lists none and queues the
+// prefix, whose keys are deleted on recovery, since the caches list keys to Flush them.
+func (d *DegradedCache) Keys(ctx context.Context, prefix string) ([]KeyInfo, error) {
+	if d.skip() {
+		d.queuePrefixes(prefix)
+		return nil, nil
+	}
+	keys, err := d.cache.Keys(ctx, prefix)
+	d.record(err)
+	return keys, err
+}
+
+// Close closes the wrapped cache
+func (d *DegradedCache) Close() error {
+	return d.cache.Close()
+}
+
+// Degraded reports whether the cache is in degraded mode
+func (d *DegradedCache) Degraded() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.degraded
+}
+
+// Recover leaves degraded mode after replaying the invalidations queued in it. If the replay
+// fails the cache stays degraded and the invalidations stay queued.
+func (d *DegradedCache) Recover(ctx context.Context) {
+	if !d.Degraded() {
+		return
+	}
+	if err := d.replay(ctx); err != nil {
+		logger.ZapLogger.Warn("Cache still degraded: failed to replay the invalidations queued while degraded",
+			zap.String("cache", d.name), zap.Error(err))
+		return
+	}
+
+	d.mu.Lock()
+	d.degraded = false
+	d.failures = nil
+	skipped := d.skipped
+	d.skipped = 0
+	d.mu.Unlock()
+	logger.ZapLogger.Info("Cache re-enabled after a successful health check",
+		zap.String("cache", d.name), zap.Int("skipped_operations", skipped))
+}
+
+// replay deletes the queued keys and flushes the queued prefixes, requeuing them if that fails
+func (d *DegradedCache) replay(ctx context.Context) error {
+	if err := d.pending.replay(ctx, d.cache); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	prefixes := make([]string, 0, len(d.pendingPrefixes))
+	for prefix := range d.pendingPrefixes {
+		prefixes = append(prefixes, prefix)
+	}
+	d.pendingPrefixes = nil
+	d.mu.Unlock()
+	for _, prefix := range prefixes {
+		if _, err := Flush(ctx, d.cache, prefix); err != nil {
+			d.queuePrefixes(prefixes...)
+			return fmt.Errorf("flushing %q: %w", prefix, err)
+		}
+	}
+	return nil
+}
+
+// queuePrefixes remembers prefixes to flush once the cache recovers
+func (d *DegradedCache) queuePrefixes(prefixes ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pendingPrefixes == nil {
+		d.pendingPrefixes = make(map[string]struct{})
+	}
+	for _, prefix := range prefixes {
+		d.pendingPrefixes[prefix] = struct{}{}
+	}
+}
+
+// skip reports whether the cache is degraded, counting the skipped operation and logging a
+// warning if none was logged in the last minute
+func (d *DegradedCache) skip() bool {
+	d.mu.Lock()
+	if !d.degraded {
+		d.mu.Unlock()
+		return false
+	}
+	d.skipped++
+	now := d.now()
+	if now.Sub(d.lastWarn) < degradedWarnInterval {
+		d.mu.Unlock()
+		return true
+	}
+	skipped := d.skipped
+	d.skipped = 0
+	d.lastWarn = now
+	d.mu.Unlock()
+
+	logger.ZapLogger.Warn("Cache degraded; skipping cache operations until a health check succeeds",
+		zap.String("cache", d.name), zap.Int("skipped_operations", skipped))
+	return true
+}
+
+// record counts a failed call within the window, entering degraded mode once the threshold
+// is reached. A miss means the cache answered, so it isn't counted. Neither is a read bypassed
+// by a BreakerCache below, which skips the cache until a probe after its cool-down succeeds,
+// so an open breaker doesn't also degrade the cache until the next health check.
+func (d *DegradedCache) record(err error) {
+	if err == nil || isMiss(err) || errors.Is(err, ErrCacheBypassed) {
+		return
+	}
+
+	d.mu.Lock()
+	now := d.now()
+	recent := d.failures[:0]
+	for _, failedAt := range d.failures {
+		if now.Sub(failedAt) < d.cfg.Window {
+			recent = append(recent, failedAt)
+		}
+	}
+	d.failures = append(recent, now)
+	if d.degraded || len(d.failures) < d.cfg.ErrorThreshold {
+		d.mu.Unlock()
+		return
+	}
+	d.degraded = true
+	d.lastWarn = now
+	failures := len(d.failures)
+	d.mu.Unlock()
+
+	logger.ZapLogger.Warn("Cache degraded; skipping cache operations until a health check succeeds",
+		zap.String("cache", d.name), zap.Int("errors", failures), zap.Duration("window", d.cfg.Window), zap.Error(err))
+}
+
+// IsDegraded reports whether c is a DegradedCache in degraded mode
+func IsDegraded(c Cache) bool {
+	d, ok := c.(*DegradedCache)
+	return ok && d.Degraded()
+}
+
+// RecoverOnHealthy wraps a cache health check so that each successful check re-enables caches
+func RecoverOnHealthy(check func() error, caches ...*DegradedCache) func() error {
+	return func() error {
+		err := check()
+		if err == nil {
+			for _, c := range caches {
+				c.Recover(context.Background())
+			}
+		}
+		return err
+	}
+}
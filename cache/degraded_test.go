@@ -0,0 +1,145 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
+)
+
+func TestDegradedCache_SkipsFailingCache(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	logger.ZapLogger = zap.New(core)
+	defer func() { logger.ZapLogger = zap.NewNop() }()
+
+	ctx := context.Background()
+	inner := &failingCache{MemoryCache: cache.NewMemoryCache(10)}
+	now := &clock{now: time.Unix(0, 0)}
+	c := cache.NewDegradedCache("users", inner, cache.DegradedConfig{ErrorThreshold: 3, Window: 10 * time.Second}).WithClock(now.Now)
+
+	require.NoError(t, c.Set(ctx, "user:1", "Jane", time.Minute))
+	var value string
+	assert.Error(t, c.Get(ctx, "user:2", &value), "a miss is returned as it is")
+
+	// Errors that don't fall within one window don't degrade the cache, even without a success between them
+	require.NoError(t, c.Set(ctx, "users:list:1", "Jane", time.Minute))
+	inner.down = true
+	assert.ErrorIs(t, c.Get(ctx, "user:1", &value), errCacheDown)
+	assert.ErrorIs(t, c.Set(ctx, "user:1", "Janet", time.Minute), errCacheDown)
+	now.now = now.now.Add(10 * time.Second)
+	assert.ErrorIs(t, c.Get(ctx, "user:1", &value), errCacheDown)
+	assert.False(t, c.Degraded())
+
+	assert.ErrorIs(t, c.Delete(ctx, "user:1"), errCacheDown)
+	assert.ErrorIs(t, c.Get(ctx, "user:1", &value), errCacheDown)
+	assert.True(t, c.Degraded())
+	assert.True(t, cache.IsDegraded(c))
+	require.Equal(t, 1, logs.Len())
+
+	// Degraded, the wrapped cache isn't called: reads are bypassed, writes skipped and deletes queued
+	calls := inner.calls
+	for i := 0; i < 10; i++ {
+		assert.ErrorIs(t, c.Get(ctx, "user:1", &value), cache.ErrCacheBypassed)
+		assert.NoError(t, c.Set(ctx, "user:1", "Janet", time.Minute))
+		assert.NoError(t, c.Delete(ctx, "users:all"))
+	}
+	removed, err := cache.Flush(ctx, c, "users:list:")
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+	assert.Equal(t, calls, inner.calls)
+	assert.Equal(t, 1, logs.Len(), "skipped operations are logged at most once a minute")
+
+	now.now = now.now.Add(time.Minute)
+	assert.NoError(t, c.Set(ctx, "user:1", "Janet", time.Minute))
+	require.Equal(t, 2, logs.Len())
+	assert.Equal(t, int64(32), logs.All()[1].ContextMap()["skipped_operations"])
+
+	// A failed health check leaves the cache degraded; a successful one replays the queued deletes first
+	check := cache.RecoverOnHealthy(func() error { return errCacheDown }, c)
+	assert.ErrorIs(t, check(), errCacheDown)
+	assert.True(t, c.Degraded())
+
+	c.Recover(ctx)
+	assert.True(t, c.Degraded(), "the queued deletes can't be replayed while the cache is down")
+
+	inner.down = false
+	check = cache.RecoverOnHealthy(func() error { return nil }, c)
+	require.NoError(t, check())
+	assert.False(t, c.Degraded())
+	assert.ElementsMatch(t, []string{"user:1", "users:all", "users:list:1"}, inner.deleted)
+	assert.Error(t, c.Get(ctx, "user:1", &value), "the entry deleted while degraded is gone")
+	assert.Error(t, c.Get(ctx, "users:list:1", &value), "the prefix flushed while degraded is gone")
+	require.NoError(t, c.Set(ctx, "user:1", "Janet", time.Minute))
+	require.NoError(t, c.Get(ctx, "user:1", &value))
+	assert.Equal(t, "Janet", value)
+}
+
+func TestDegradedCache_ErrorsOfOtherCachesDontCount(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+	inner := &failingCache{MemoryCache: cache.NewMemoryCache(10), down: true}
+	users := cache.NewDegradedCache("users", inner, cache.DegradedConfig{ErrorThreshold: 2, Window: time.Minute})
+	issues := cache.NewDegradedCache("issues", inner, cache.DegradedConfig{ErrorThreshold: 2, Window: time.Minute})
+
+	var value string
+	for i := 0; i < 2; i++ {
+		assert.ErrorIs(t, users.Get(ctx, "user:1", &value), errCacheDown)
+	}
+	assert.True(t, users.Degraded())
+	assert.False(t, issues.Degraded())
+	assert.False(t, cache.IsDegraded(inner), "only a DegradedCache is ever degraded")
+}
+
+func TestDegradedCache_OverBreakerCache(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+	inner := &failingCache{MemoryCache: cache.NewMemoryCache(10)}
+	now := &clock{now: time.Unix(0, 0)}
+	breaker := resilience.NewCircuitBreaker("cache", 2, 10*time.Second).WithClock(now.Now)
+	c := cache.NewDegradedCache("users", cache.NewBreakerCache(inner, breaker), cache.DegradedConfig{ErrorThreshold: 3, Window: time.Minute}).WithClock(now.Now)
+	require.NoError(t, c.Set(ctx, "user:1", "Jane", time.Minute))
+
+	// The failures that open the breaker count towards degraded mode, the reads it bypasses don't
+	inner.down = true
+	var value string
+	for i := 0; i < 2; i++ {
+		assert.ErrorIs(t, c.Get(ctx, "user:1", &value), errCacheDown)
+	}
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+	for i := 0; i < 10; i++ {
+		assert.ErrorIs(t, c.Get(ctx, "user:1", &value), cache.ErrCacheBypassed)
+		_, err := c.Exists(ctx, "user:1")
+		assert.ErrorIs(t, err, cache.ErrCacheBypassed)
+	}
+	assert.False(t, c.Degraded(), "an open breaker doesn't degrade the cache")
+
+	// Once the cache is back, the breaker's probe closes it without waiting for a health check
+	inner.down = false
+	now.now = now.now.Add(10 * time.Second)
+	require.NoError(t, c.Get(ctx, "user:1", &value))
+	assert.Equal(t, "Jane", value)
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+	assert.False(t, c.Degraded())
+}
+
+func TestDegradedConfigFromEnv(t *testing.T) {
+	t.Setenv("CACHE_DEGRADED_ERROR_THRESHOLD", "")
+	t.Setenv("CACHE_DEGRADED_WINDOW_SECONDS", "")
+	assert.Equal(t, cache.DegradedConfig{ErrorThreshold: 5, Window: 10 * time.Second}, cache.DegradedConfigFromEnv())
+
+	t.Setenv("CACHE_DEGRADED_ERROR_THRESHOLD", "20")
+	t.Setenv("CACHE_DEGRADED_WINDOW_SECONDS", "60")
+	assert.Equal(t, cache.DegradedConfig{ErrorThreshold: 20, Window: time.Minute}, cache.DegradedConfigFromEnv())
+
+	t.Setenv("CACHE_DEGRADED_ERROR_THRESHOLD", "0")
+	assert.Equal(t, 5, cache.DegradedConfigFromEnv().ErrorThreshold)
+}
@@ -0,0 +1,17 @@
+package cache
+
+// SetGlobalCache replaces the global cache instance and returns a function restoring it
+func SetGlobalCache(c Cache) (restore func()) {
+	previous := globalCacheInstance
+	globalCacheInstance = c
+	return func() { globalCacheInstance = previous }
+}
+
+// StoredSize returns the number of bytes the cache holds for key, or -1 if it isn't cached
+func (m *MemoryCache) StoredSize(key string) int {
+	value, err := m.cache.Get(key)
+	if err != nil {
+		return -1
+	}
+	return len(value.(memoryEntry).data)
+}
@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"context"
+)
+
+// flushBatchSize is the number of keys removed per Delete call while flushing
+const flushBatchSize = 100
+
+// Flush deletes every key starting with prefix, or every key if prefix is empty,
+// and returns how many keys were removed
+func Flush(ctx context.Context, c Cache, prefix string) (int, error) {
+	keys, err := c.Keys(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for start := 0; start < len(keys); start += flushBatchSize {
+		end := min(start+flushBatchSize, len(keys))
+		batch := make([]string, 0, end-start)
+		for _, key := range keys[start:end] {
+			batch = append(batch, key.Key)
+		}
+		if err := c.Delete(ctx, batch...); err != nil {
+			return removed, err
+		}
+		removed += len(batch)
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,92 @@
+package cache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+)
+
+func TestMemoryCache_Keys(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemoryCache(10)
+	require.NoError(t, c.Set(ctx, "issue:2", "b", time.Minute))
+	require.NoError(t, c.Set(ctx, "issue:1", "a", time.Hour))
+	require.NoError(t, c.Set(ctx, "project:1", "p", time.Hour))
+
+	keys, err := c.Keys(ctx, "issue:")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "issue:1", keys[0].Key)
+	assert.Equal(t, "issue:2", keys[1].Key)
+	assert.InDelta(t, time.Hour.Seconds(), keys[0].TTL.Seconds(), 5)
+	assert.InDelta(t, time.Minute.Seconds(), keys[1].TTL.Seconds(), 5)
+
+	all, err := c.Keys(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+}
+
+func TestFlush_PrefixLeavesOtherKeys(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemoryCache(300)
+	// More keys than one delete batch
+	for i := 0; i < 150; i++ {
+		require.NoError(t, c.Set(ctx, fmt.Sprintf("issues:list:%d", i), i, time.Hour))
+	}
+	require.NoError(t, c.Set(ctx, "issue:1", "a", time.Hour))
+	require.NoError(t, c.Set(ctx, "project:1", "p", time.Hour))
+
+	removed, err := cache.Flush(ctx, c, "issues:")
+	require.NoError(t, err)
+	assert.Equal(t, 150, removed)
+
+	remaining, err := c.Keys(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+	assert.Equal(t, "issue:1", remaining[0].Key)
+	assert.Equal(t, "project:1", remaining[1].Key)
+
+	removed, err = cache.Flush(ctx, c, "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+	exists, err := c.Exists(ctx, "project:1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestMemoryCache_StatsCountEvictions(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemoryCache(5)
+
+	for i := 0; i < 12; i++ {
+		require.NoError(t, c.Set(ctx, fmt.Sprintf("issue:%d", i), i, time.Hour))
+	}
+	var value int
+	require.NoError(t, c.Get(ctx, "issue:11", &value))
+	assert.Error(t, c.Get(ctx, "issue:0", &value))
+
+	stats := c.Stats()
+	assert.Equal(t, 5, stats.Entries)
+	assert.Equal(t, 5, c.Len())
+	assert.Equal(t, 5, stats.Capacity)
+	assert.Equal(t, uint64(7), stats.Evictions)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+
+	// Deleted and expired entries aren't evictions
+	require.NoError(t, c.Delete(ctx, "issue:11"))
+	require.NoError(t, c.Set(ctx, "issue:short", 1, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	assert.Error(t, c.Get(ctx, "issue:short", &value))
+
+	stats = c.Stats()
+	assert.Equal(t, uint64(7), stats.Evictions)
+	assert.Equal(t, uint64(1), stats.Expirations)
+	assert.Equal(t, 4, stats.Entries)
+}
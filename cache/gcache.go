@@ -2,8 +2,11 @@ package cache
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bluele/gcache"
@@ -12,26 +15,60 @@ import (
 // MemoryCache implements the Cache interface using gcache
 type MemoryCache struct {
 	cache gcache.Cache
+	size  int
+	// compression applies to values stored from now on
+	compression CompressionConfig
+
+	evictions   atomic.Uint64
+	expirations atomic.Uint64
+	// deleting holds the keys Delete is removing, so their removal isn't counted as an eviction
+	deleting sync.Map
+}
+
+// memoryEntry is what MemoryCache stores; the expiry is kept so Keys can report TTLs
+type memoryEntry struct {
+	data      []byte
+	expiresAt time.Time
 }
 
-// NewMemoryCache creates a new in-memory cache
+// NewMemoryCache creates a new in-memory cache holding at most size entries, evicting the least
+// recently used entry when full
 func NewMemoryCache(size int) *MemoryCache {
-	cache := gcache.New(size).
+	m := &MemoryCache{size: size}
+	m.cache = gcache.New(size).
 		LRU().
+		EvictedFunc(m.onEvicted).
 		Build()
 
-	return &MemoryCache{
-		cache: cache,
+	return m
+}
+
+// SetCompression configures the compression of values stored from now on. Values already
+// cached stay readable.
+func (m *MemoryCache) SetCompression(cfg CompressionConfig) {
+	m.compression = cfg
+}
+
+// onEvicted is called by gcache whenever an entry leaves the cache
+func (m *MemoryCache) onEvicted(key, value interface{}) {
+	if _, deleting := m.deleting.Load(key); deleting {
+		return
 	}
+	if entry, ok := value.(memoryEntry); ok && !time.Now().Before(entry.expiresAt) {
+		m.expirations.Add(1)
+		return
+	}
+	m.evictions.Add(1)
 }
 
 // Set stores a value in the memory cache with expiration
 func (m *MemoryCache) Set(_ context.Context, key string, value interface{}, expiration time.Duration) error {
-	data, err := json.Marshal(value)
+	data, err := encodeValue(value, m.compression)
 	if err != nil {
 		return err
 	}
-	return m.cache.SetWithExpire(key, data, expiration)
+	entry := memoryEntry{data: data, expiresAt: time.Now().Add(expiration)}
+	return m.cache.SetWithExpire(key, entry, expiration)
 }
 
 // Get retrieves a value from the memory cache
@@ -41,18 +78,20 @@ func (m *MemoryCache) Get(_ context.Context, key string, dest interface{}) error
 		return err
 	}
 
-	data, ok := value.([]byte)
+	entry, ok := value.(memoryEntry)
 	if !ok {
 		return fmt.Errorf("invalid cached data type")
 	}
 
-	return json.Unmarshal(data, dest)
+	return decodeValue(entry.data, dest)
 }
 
 // Delete removes a key from the memory cache
 func (m *MemoryCache) Delete(_ context.Context, keys ...string) error {
 	for _, key := range keys {
+		m.deleting.Store(key, struct{}{})
 		m.cache.Remove(key)
+		m.deleting.Delete(key)
 	}
 	return nil
 }
@@ -62,6 +101,45 @@ func (m *MemoryCache) Exists(_ context.Context, key string) (bool, error) {
 	return m.cache.Has(key), nil
 }
 
+// Keys lists the unexpired keys starting with prefix, sorted by key
+func (m *MemoryCache) Keys(_ context.Context, prefix string) ([]KeyInfo, error) {
+	now := time.Now()
+	var keys []KeyInfo
+	for rawKey, value := range m.cache.GetALL(true) {
+		key, ok := rawKey.(string)
+		if !ok || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		info := KeyInfo{Key: key, TTL: -1}
+		if entry, ok := value.(memoryEntry); ok {
+			info.TTL = entry.expiresAt.Sub(now)
+		}
+		keys = append(keys, info)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	return keys, nil
+}
+
+// Len returns the number of unexpired entries in the cache
+func (m *MemoryCache) Len() int {
+	return m.cache.Len(true)
+}
+
+// Stats reports the occupancy of the cache and how many entries were evicted or expired, so
+// the size can be tuned; a cache that is too small shows a steadily growing eviction count
+func (m *MemoryCache) Stats() Stats {
+	return Stats{
+		Entries:     m.Len(),
+		Capacity:    m.size,
+		Evictions:   m.evictions.Load(),
+		Expirations: m.expirations.Load(),
+		Hits:        m.cache.HitCount(),
+		Misses:      m.cache.MissCount(),
+	}
+}
+
 // Close is a no-op for memory cache
 func (m *MemoryCache) Close() error {
 	return nil
@@ -2,7 +2,7 @@ package cache
 
 import (
 	"context"
-	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -11,6 +11,8 @@ import (
 // RedisClient wraps the Redis client functionality
 type RedisClient struct {
 	client *redis.Client
+	// compression applies to values stored from now on
+	compression CompressionConfig
 }
 
 // NewRedisClient creates a new Redis client
@@ -26,9 +28,15 @@ func NewRedisClient(addr, password string, db int) *RedisClient {
 	}
 }
 
+// SetCompression configures the compression of values stored from now on. Values already
+// cached stay readable.
+func (r *RedisClient) SetCompression(cfg CompressionConfig) {
+	r.compression = cfg
+}
+
 // Set stores a value in Redis with expiration
 func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
-	data, err := json.Marshal(value)
+	data, err := encodeValue(value, r.compression)
 	if err != nil {
 		return err
 	}
@@ -41,7 +49,7 @@ func (r *RedisClient) Get(ctx context.Context, key string, dest interface{}) err
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(data, dest)
+	return decodeValue(data, dest)
 }
 
 // Delete removes a key from Redis
@@ -55,6 +63,55 @@ func (r *RedisClient) Exists(ctx context.Context, key string) (bool, error) {
 	return result > 0, err
 }
 
+// scanBatchSize is the COUNT hint passed to SCAN when listing keys
+const scanBatchSize = 100
+
+// globEscaper escapes the characters SCAN MATCH treats as wildcards
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+// Keys lists the keys starting with prefix using SCAN, so Redis isn't blocked the way KEYS would block it
+func (r *RedisClient) Keys(ctx context.Context, prefix string) ([]KeyInfo, error) {
+	match := globEscaper.Replace(prefix) + "*"
+
+	var keys []KeyInfo
+	// SCAN may return a key more than once
+	seen := make(map[string]bool)
+	var cursor uint64
+	for {
+		batch, next, err := r.client.Scan(ctx, cursor, match, scanBatchSize).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		// Fetch the TTLs of the whole batch in one round trip
+		pipe := r.client.Pipeline()
+		ttls := make([]*redis.DurationCmd, len(batch))
+		for i, key := range batch {
+			ttls[i] = pipe.TTL(ctx, key)
+		}
+		if len(batch) > 0 {
+			if _, err := pipe.Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		for i, key := range batch {
+			ttl := ttls[i].Val()
+			if ttl == -2 || seen[key] {
+				// -2 means the key expired or was deleted since the scan returned it
+				continue
+			}
+			seen[key] = true
+			keys = append(keys, KeyInfo{Key: key, TTL: ttl})
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return keys, nil
+		}
+	}
+}
+
 // Close closes the Redis client connection
 func (r *RedisClient) Close() error {
 	return r.client.Close()
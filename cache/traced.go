@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
+)
+
+// TracedCache records a span for every operation of the cache it wraps, so a trace shows
+// whether a request was served from the cache
+type TracedCache struct {
+	cache Cache
+}
+
+// NewTracedCache wraps c so its operations are traced
+func NewTracedCache(c Cache) *TracedCache {
+	return &TracedCache{cache: c}
+}
+
+// Set stores a value in the wrapped cache within a cache.Set span
+func (t *TracedCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	ctx, span := tracing.StartSpan(ctx, "cache.Set", attribute.String("cache.key", key))
+	err := t.cache.Set(ctx, key, value, expiration)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+// Get retrieves a value from the wrapped cache within a cache.Get span. A miss is recorded
+// as cache.hit=false rather than as an error.
+func (t *TracedCache) Get(ctx context.Context, key string, dest interface{}) error {
+	ctx, span := tracing.StartSpan(ctx, "cache.Get", attribute.String("cache.key", key))
+	err := t.cache.Get(ctx, key, dest)
+	span.SetAttributes(attribute.Bool("cache.hit", err == nil))
+	span.End()
+	return err
+}
+
+// Delete removes keys from the wrapped cache within a cache.Delete span
+func (t *TracedCache) Delete(ctx context.Context, keys ...string) error {
+	ctx, span := tracing.StartSpan(ctx, "cache.Delete", attribute.StringSlice("cache.keys", keys))
+	err := t.cache.Delete(ctx, keys...)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+// Exists checks the wrapped cache for a key within a cache.Exists span
+func (t *TracedCache) Exists(ctx context.Context, key string) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "cache.Exists", attribute.String("cache.key", key))
+	exists, err := t.cache.Exists(ctx, key)
+	tracing.EndSpan(span, err)
+	return exists, err
+}
+
+// Keys lists the keys of the wrapped cache within a cache.Keys span
+func (t *TracedCache) Keys(ctx context.Context, prefix string) ([]KeyInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "cache.Keys", attribute.String("cache.prefix", prefix))
+	keys, err := t.cache.Keys(ctx, prefix)
+	tracing.EndSpan(span, err)
+	return keys, err
+}
+
+// Close closes the wrapped cache
+func (t *TracedCache) Close() error {
+	return t.cache.Close()
+}
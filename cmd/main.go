@@ -2,12 +2,18 @@
 package main
 
 import (
+	"flag"
+	"os"
+
 	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/pkg/server"
 	"go.uber.org/zap"
 )
 
 func main() {
+	selfTest := flag.Bool("selftest", false, "run the create, update, list and delete self-test against the services and exit")
+	flag.Parse()
+
 	// Create and initialize the application
 	app, err := server.NewApplication()
 	if err != nil {
@@ -20,6 +26,16 @@ func main() {
 		_ = logger.ZapLogger.Sync()
 	}()
 
+	// The self-test exits with 1 if any step failed, for deployment pipelines to check
+	if *selfTest || os.Getenv("RUN_SELFTEST") == "true" {
+		if err := app.RunSelfTest(); err != nil {
+			logger.ZapLogger.Error("Self-test failed", zap.Error(err))
+			_ = logger.ZapLogger.Sync()
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Start the application
 	if err := app.Start(); err != nil {
 		logger.ZapLogger.Fatal("Failed to serve", zap.Error(err))
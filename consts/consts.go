@@ -9,18 +9,27 @@ import (
 var (
 	ErrEmailAlreadyExists = errors.New("email already exists")
 	ErrUserNotFound       = errors.New("user not found")
+	ErrUserDeactivated    = errors.New("user is deactivated")
 	ErrDatabaseError      = errors.New("database error")
 	ErrNotFound           = errors.New("not found")
 
 	// Issues related error constants
-	ErrIssueNotFound           = errors.New("issue not found")
-	ErrProjectNotFound         = errors.New("project not found")
-	ErrIssueAlreadyExists      = errors.New("issue already exists")
-	ErrInvalidStatusTransition = errors.New("invalid status transition")
-	ErrInvalidIssueType        = errors.New("invalid issue type")
-	ErrInvalidIssuePriority    = errors.New("invalid issue priority")
-	ErrInvalidIssueStatus      = errors.New("invalid issue status")
-	ErrInvalidIssueResolution  = errors.New("invalid issue resolution")
+	ErrIssueNotFound            = errors.New("issue not found")
+	ErrProjectNotFound          = errors.New("project not found")
+	ErrIssueAlreadyExists       = errors.New("issue already exists")
+	ErrInvalidStatusTransition  = errors.New("invalid status transition")
+	ErrInvalidIssueType         = errors.New("invalid issue type")
+	ErrInvalidIssuePriority     = errors.New("invalid issue priority")
+	ErrInvalidIssueStatus       = errors.New("invalid issue status")
+	ErrInvalidIssueResolution   = errors.New("invalid issue resolution")
+	ErrIssueVersionConflict     = errors.New("issue was modified by another request")
+	ErrIssueNotInProject        = errors.New("issue does not belong to project")
+	ErrTemplateNotFound         = errors.New("issue template not found")
+	ErrIssueLinkNotFound        = errors.New("issue link not found")
+	ErrProjectMemberNotFound    = errors.New("user is not a member of the project")
+	ErrProjectNameAlreadyExists = errors.New("project name already exists")
+	ErrTeamNotFound             = errors.New("team not found")
+	ErrTeamMemberNotFound       = errors.New("user is not a member of the team")
 
 	ErrNoSubscription = errors.New("no subscription found for project")
 	ErrPublishFailed  = errors.New("failed to publish update")
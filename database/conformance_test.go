@@ -0,0 +1,85 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/yasindce1998/issue-tracker/database"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/repotest"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// The Postgres repositories run the same suites against a real database in
+// postgres_integration_test.go, which needs the integration build tag.
+
+func TestRepositoryConformance_MemDB(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repotest.RunUserRepositorySuite(t, func(t *testing.T) usersvc.UserRepository {
+		repo, err := usersvc.NewMemDBUserRepository()
+		require.NoError(t, err)
+		return repo
+	})
+	repotest.RunProjectRepositorySuite(t, func(t *testing.T) projectsvc.ProjectRepository {
+		repo, err := projectsvc.NewMemDBProjectRepository()
+		require.NoError(t, err)
+		return repo
+	})
+	repotest.RunIssuesRepositorySuite(t, func(t *testing.T) issuessvc.IssuesRepository {
+		repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+		require.NoError(t, err)
+		return repo
+	})
+	repotest.RunTeamRepositorySuite(t, func(t *testing.T) teamsvc.TeamRepository {
+		repo, err := teamsvc.NewMemDBTeamRepository()
+		require.NoError(t, err)
+		return repo
+	})
+	repotest.RunProjectIssueCountSuite(t, func(t *testing.T) (issuessvc.IssuesRepository, projectsvc.ProjectRepository) {
+		projects, err := projectsvc.NewMemDBProjectRepository()
+		require.NoError(t, err)
+		issues, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+		require.NoError(t, err)
+		issues.SetClients(issuessvc.NewLocalValidator(projects, nil))
+		issues.SetProjectRepository(projects)
+		return issues, projects
+	})
+}
+
+func TestRepositoryConformance_SQLite(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repotest.RunUserRepositorySuite(t, func(t *testing.T) usersvc.UserRepository {
+		return usersvc.NewPostgresUserRepository(newSQLiteDB(t))
+	})
+	repotest.RunProjectRepositorySuite(t, func(t *testing.T) projectsvc.ProjectRepository {
+		return projectsvc.NewPostgresProjectRepository(newSQLiteDB(t))
+	})
+	repotest.RunIssuesRepositorySuite(t, func(t *testing.T) issuessvc.IssuesRepository {
+		return issuessvc.NewPostgresIssuesRepository(newSQLiteDB(t))
+	})
+	repotest.RunTeamRepositorySuite(t, func(t *testing.T) teamsvc.TeamRepository {
+		return teamsvc.NewPostgresTeamRepository(newSQLiteDB(t))
+	})
+	repotest.RunProjectIssueCountSuite(t, func(t *testing.T) (issuessvc.IssuesRepository, projectsvc.ProjectRepository) {
+		db := newSQLiteDB(t)
+		return issuessvc.NewPostgresIssuesRepository(db), projectsvc.NewPostgresProjectRepository(db)
+	})
+}
+
+// newSQLiteDB opens a migrated in-memory SQLite database that is closed when the test ends
+func newSQLiteDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := database.OpenSQLite(":memory:")
+	require.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	return db
+}
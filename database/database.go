@@ -1,40 +1,161 @@
 // Package database provides functionality for database operations and repository management.
-// It supports both PostgreSQL and in-memory database implementations, handles connections,
+// It supports PostgreSQL, SQLite and in-memory database implementations, handles connections,
 // migrations, and exposes repositories for the application's domain entities.
 package database
 
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/glebarez/sqlite"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
 
 	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/models"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
 )
 
 // Database type constants
 const (
 	PostgresDB = "postgres"
+	SQLiteDB   = "sqlite"
 	MemDB      = "memdb"
 )
 
+// defaultSQLitePath is used when SQLITE_PATH is not set
+const defaultSQLitePath = "issue-tracker.db"
+
+// Defaults of the retries of the first Postgres connection
+const (
+	defaultConnectMaxAttempts = 10
+	defaultConnectInterval    = time.Second
+	// maxConnectInterval caps the wait between attempts as it doubles
+	maxConnectInterval = 30 * time.Second
+)
+
+// ConnectRetryConfig controls how often the first connection to Postgres is attempted, for
+// databases that start after the service
+type ConnectRetryConfig struct {
+	MaxAttempts int
+	// Interval is the wait after the first failed attempt; it doubles after each further one
+	Interval time.Duration
+}
+
+// ConnectRetryConfigFromEnv reads DB_CONNECT_MAX_ATTEMPTS and DB_CONNECT_RETRY_INTERVAL_SECONDS,
+// keeping the defaults of 10 attempts and 1 second for unset or invalid values
+func ConnectRetryConfigFromEnv() ConnectRetryConfig {
+	cfg := ConnectRetryConfig{MaxAttempts: defaultConnectMaxAttempts, Interval: defaultConnectInterval}
+	if v, err := strconv.Atoi(os.Getenv("DB_CONNECT_MAX_ATTEMPTS")); err == nil && v > 0 {
+		cfg.MaxAttempts = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("DB_CONNECT_RETRY_INTERVAL_SECONDS")); err == nil && v > 0 {
+		cfg.Interval = time.Duration(v) * time.Second
+	}
+	return cfg
+}
+
+// dialPostgres opens the connection to Postgres, failing if the database can't be reached
+func dialPostgres(dsn string) (*gorm.DB, error) {
+	gormConfig := &gorm.Config{
+		PrepareStmt: true, // Cache prepared statements for better performance
+		Logger:      gormlogger.Default.LogMode(gormlogger.Error),
+	}
+
+	return gorm.Open(postgresDialector(dsn), gormConfig)
+}
+
+// postgresDialector returns the GORM dialector of the Postgres database at dsn
+func postgresDialector(dsn string) gorm.Dialector {
+	return postgres.New(postgres.Config{
+		DSN:                  dsn,
+		PreferSimpleProtocol: true,
+	})
+}
+
+// useReadReplica routes the queries of db that only read to replica and everything else,
+// including every query inside a transaction, to the database db is connected to. The
+// replica's connections are pooled like the primary's.
+func useReadReplica(db *gorm.DB, replica gorm.Dialector) error {
+	pool := connectionPoolFromEnv()
+	resolver := dbresolver.Register(dbresolver.Config{Replicas: []gorm.Dialector{replica}}).
+		SetMaxOpenConns(pool.MaxOpenConns).
+		SetMaxIdleConns(pool.MaxIdleConns).
+		SetConnMaxLifetime(pool.ConnMaxLifetime)
+	if err := db.Use(resolver); err != nil {
+		return fmt.Errorf("failed to connect to the read replica: %w", err)
+	}
+	return nil
+}
+
+// connectWithRetry calls dial until it succeeds or cfg.MaxAttempts have failed, waiting with
+// sleep between attempts, and returns the last error if none succeeded
+func connectWithRetry(dsn string, cfg ConnectRetryConfig, dial func(string) (*gorm.DB, error), sleep func(time.Duration)) (*gorm.DB, error) {
+	wait := cfg.Interval
+	var err error
+	for attempt := 1; ; attempt++ {
+		var db *gorm.DB
+		db, err = dial(dsn)
+		if err == nil {
+			return db, nil
+		}
+		if attempt >= cfg.MaxAttempts {
+			return nil, fmt.Errorf("failed to connect to Postgres after %d attempts: %w", attempt, err)
+		}
+
+		logger.ZapLogger.Warn("Postgres is not ready, retrying",
+			zap.Int("attempt", attempt),
+			zap.Int("max_attempts", cfg.MaxAttempts),
+			zap.Duration("retry_in", wait),
+			zap.Error(err))
+		sleep(wait)
+		wait = min(wait*2, maxConnectInterval)
+	}
+}
+
+// connectionPool holds the connection pool settings of a Postgres database
+type connectionPool struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// connectionPoolFromEnv reads DB_MAX_CONNECTIONS, DB_MAX_IDLE_CONNECTIONS and
+// DB_CONN_MAX_LIFETIME (in minutes), keeping the defaults of 25, 10 and 30 minutes for unset or
+// invalid values
+func connectionPoolFromEnv() connectionPool {
+	pool := connectionPool{MaxOpenConns: 25, MaxIdleConns: 10, ConnMaxLifetime: 30 * time.Minute}
+	if v, err := strconv.Atoi(os.Getenv("DB_MAX_CONNECTIONS")); err == nil && v > 0 {
+		pool.MaxOpenConns = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("DB_MAX_IDLE_CONNECTIONS")); err == nil && v > 0 {
+		pool.MaxIdleConns = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("DB_CONN_MAX_LIFETIME")); err == nil && v > 0 {
+		pool.ConnMaxLifetime = time.Duration(v) * time.Minute
+	}
+	return pool
+}
+
 var dbInstance *gorm.DB
 
 // Repository encapsulates all data access repositories for the application.
-// It provides access to users, issues, and projects repositories.
+// It provides access to users, issues, projects and teams repositories.
 type Repository struct {
 	UserRepo    usersvc.UserRepository
 	IssuesRepo  issuessvc.IssuesRepository
 	ProjectRepo projectsvc.ProjectRepository
+	TeamRepo    teamsvc.TeamRepository
 }
 
 // InitializeDatabase initializes the database connections and repositories.
@@ -56,6 +177,14 @@ func InitializeDatabase() (*Repository, error) {
 		}
 		logger.ZapLogger.Info("PostgreSQL database initialized successfully")
 		return repos, nil
+	case SQLiteDB:
+		repos, err := initializeSQLite()
+		if err != nil {
+			logger.ZapLogger.Error("Failed to initialize SQLite", zap.Error(err))
+			return nil, err
+		}
+		logger.ZapLogger.Info("SQLite database initialized successfully")
+		return repos, nil
 	case MemDB:
 		repos, err := initializeMemDB()
 		if err != nil {
@@ -77,67 +206,108 @@ func initializePostgres() (*Repository, error) {
 		return nil, err
 	}
 
-	// Configure connection pooling
-	pgConfig := postgres.Config{
-		DSN:                  dsn,
-		PreferSimpleProtocol: true,
+	readDSN, err := buildPostgresReadDSN()
+	if err != nil {
+		return nil, err
 	}
 
-	gormConfig := &gorm.Config{
-		PrepareStmt: true, // Cache prepared statements for better performance
-		Logger:      gormlogger.Default.LogMode(gormlogger.Error),
+	db, err := OpenPostgres(dsn)
+	if err != nil {
+		return nil, err
 	}
+	// The replica is added after the migrations, so they run against the primary only
+	if readDSN != "" {
+		if err := useReadReplica(db, postgresDialector(readDSN)); err != nil {
+			return nil, err
+		}
+		logger.ZapLogger.Info("Routing reads to the PostgreSQL read replica",
+			zap.String("host", os.Getenv("POSTGRES_READ_HOST")))
+	}
+	dbInstance = db
 
-	db, err := gorm.Open(postgres.New(pgConfig), gormConfig)
+	return newGormRepositories(db), nil
+}
+
+// OpenPostgres connects to the PostgreSQL database at dsn, retrying as configured by
+// ConnectRetryConfigFromEnv while it isn't ready, configures the connection pool from the DB_*
+// environment variables and migrates the schema.
+func OpenPostgres(dsn string) (*gorm.DB, error) {
+	db, err := connectWithRetry(dsn, ConnectRetryConfigFromEnv(), dialPostgres, time.Sleep)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to Postgres: %w", err)
+		return nil, err
 	}
 
-	dbInstance = db
-
 	// Set connection pool parameters
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get DB instance: %w", err)
 	}
+	pool := connectionPoolFromEnv()
+	sqlDB.SetMaxOpenConns(pool.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(pool.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(pool.ConnMaxLifetime)
 
-	// Set max open connections
-	maxConn, err := strconv.Atoi(os.Getenv("DB_MAX_CONNECTIONS"))
-	if err == nil && maxConn > 0 {
-		sqlDB.SetMaxOpenConns(maxConn)
-	} else {
-		sqlDB.SetMaxOpenConns(25) // Default value
+	// Run database migrations
+	if err := migrateDatabase(db); err != nil {
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	// Set max idle connections
-	maxIdleConn, err := strconv.Atoi(os.Getenv("DB_MAX_IDLE_CONNECTIONS"))
-	if err == nil && maxIdleConn > 0 {
-		sqlDB.SetMaxIdleConns(maxIdleConn)
-	} else {
-		sqlDB.SetMaxIdleConns(10) // Default value
+	return db, nil
+}
+
+// initializeSQLite sets up the SQLite connection and repositories.
+func initializeSQLite() (*Repository, error) {
+	path := os.Getenv("SQLITE_PATH")
+	if path == "" {
+		path = defaultSQLitePath
 	}
 
-	// Set connection max lifetime
-	connMaxLifetime, err := strconv.Atoi(os.Getenv("DB_CONN_MAX_LIFETIME"))
-	if err == nil && connMaxLifetime > 0 {
-		sqlDB.SetConnMaxLifetime(time.Duration(connMaxLifetime) * time.Minute)
-	} else {
-		sqlDB.SetConnMaxLifetime(30 * time.Minute) // Default value
+	db, err := OpenSQLite(path)
+	if err != nil {
+		return nil, err
 	}
+	dbInstance = db
+
+	return newGormRepositories(db), nil
+}
+
+// OpenSQLite opens the SQLite database at path and migrates its schema. A path of ":memory:"
+// gives a private in-memory database that lives as long as the returned connection.
+func OpenSQLite(path string) (*gorm.DB, error) {
+	gormConfig := &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Error),
+	}
+
+	db, err := gorm.Open(sqlite.Open(path), gormConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DB instance: %w", err)
+	}
+	// SQLite allows a single writer, and every connection to ":memory:" opens a separate
+	// database, so all queries share one connection that is never recycled
+	sqlDB.SetMaxOpenConns(1)
+	sqlDB.SetMaxIdleConns(1)
+	sqlDB.SetConnMaxLifetime(0)
 
-	// Run database migrations
 	if err := migrateDatabase(db); err != nil {
 		return nil, fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	// Initialize repositories
-	repositories := &Repository{
+	return db, nil
+}
+
+// newGormRepositories creates the GORM-backed repositories shared by Postgres and SQLite
+func newGormRepositories(db *gorm.DB) *Repository {
+	return &Repository{
 		UserRepo:    usersvc.NewPostgresUserRepository(db),
 		IssuesRepo:  issuessvc.NewPostgresIssuesRepository(db),
 		ProjectRepo: projectsvc.NewPostgresProjectRepository(db),
+		TeamRepo:    teamsvc.NewPostgresTeamRepository(db),
 	}
-
-	return repositories, nil
 }
 
 // initializeMemDB sets up in-memory repositories.
@@ -157,11 +327,17 @@ func initializeMemDB() (*Repository, error) {
 		return nil, fmt.Errorf("failed to initialize MemDB ProjectRepository: %w", err)
 	}
 
+	teamRepo, err := teamsvc.NewMemDBTeamRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize MemDB TeamRepository: %w", err)
+	}
+
 	// Return a single struct encapsulating all repositories
 	return &Repository{
 		UserRepo:    userRepo,
 		IssuesRepo:  issuesRepo,
 		ProjectRepo: projectRepo,
+		TeamRepo:    teamRepo,
 	}, nil
 }
 
@@ -192,19 +368,103 @@ func buildPostgresDSN() (string, error) {
 		return "", err
 	}
 
+	if err := validatePostgresConfig(host, port, user, dbName); err != nil {
+		return "", err
+	}
+
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		host, port, user, password, dbName)
 
 	return dsn, nil
 }
 
+// buildPostgresReadDSN builds the DSN of the read replica at POSTGRES_READ_HOST and
+// POSTGRES_READ_PORT, which defaults to POSTGRES_PORT. The replica is reached with the
+// primary's user, password and database name. Without POSTGRES_READ_HOST it returns an empty
+// DSN, and reads go to the primary.
+func buildPostgresReadDSN() (string, error) {
+	host := os.Getenv("POSTGRES_READ_HOST")
+	if host == "" {
+		return "", nil
+	}
+	port := os.Getenv("POSTGRES_READ_PORT")
+	if port == "" {
+		port = os.Getenv("POSTGRES_PORT")
+	}
+	if strings.ContainsAny(host, " \t\n") {
+		return "", fmt.Errorf("POSTGRES_READ_HOST must not contain spaces, got: %s", host)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return "", fmt.Errorf("POSTGRES_READ_PORT must be a number between 1 and 65535, got: %s", port)
+	}
+
+	// The user and database name are checked with the primary's settings
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		host, port, os.Getenv("POSTGRES_USER"), os.Getenv("POSTGRES_PASSWORD"), os.Getenv("POSTGRES_DB")), nil
+}
+
+// postgresIdentifier matches the user and database names the DSN accepts without quoting
+var postgresIdentifier = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// validatePostgresConfig checks the connection settings before they are put in a DSN, so a
+// misconfigured variable is reported by name instead of as a connection error
+func validatePostgresConfig(host, port, user, dbName string) error {
+	if host == "" {
+		return fmt.Errorf("POSTGRES_HOST must not be empty")
+	}
+	if strings.ContainsAny(host, " \t\n") {
+		return fmt.Errorf("POSTGRES_HOST must not contain spaces, got: %s", host)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("POSTGRES_PORT must be a number between 1 and 65535, got: %s", port)
+	}
+	if !postgresIdentifier.MatchString(user) {
+		return fmt.Errorf("POSTGRES_USER must only contain letters, digits and underscores, got: %s", user)
+	}
+	if !postgresIdentifier.MatchString(dbName) {
+		return fmt.Errorf("POSTGRES_DB must only contain letters, digits and underscores, got: %s", dbName)
+	}
+	return nil
+}
+
 // migrateDatabase performs automatic migrations for the database schema.
 func migrateDatabase(db *gorm.DB) error {
-	return db.AutoMigrate(
+	hadProjectIssues := db.Migrator().HasTable(&models.ProjectIssue{})
+	if err := db.AutoMigrate(
 		&models.User{},
 		&models.Issues{},
 		&models.Project{},
-	)
+		&models.IssueTemplate{},
+		&models.IssueLink{},
+		&models.ProjectMember{},
+		&models.ProjectIssue{},
+		&models.Team{},
+		&models.TeamMember{},
+	); err != nil {
+		return err
+	}
+	if !hadProjectIssues {
+		if err := backfillProjectIssues(db); err != nil {
+			return err
+		}
+	}
+
+	// Users created before created_at was added are listed first, in ID order
+	return db.Unscoped().Model(&models.User{}).Where("created_at IS NULL").Update("created_at", time.Unix(0, 0).UTC()).Error
+}
+
+// backfillProjectIssues fills the new project_issues join table from the project_id of each
+// issue, which is how membership was tracked before, and recounts the issues of every project.
+// It only runs when the table is created, so associations removed later aren't restored.
+func backfillProjectIssues(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO project_issues (project_id, issue_id)
+			SELECT project_id, issue_id FROM issues WHERE deleted_at IS NULL`).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`UPDATE projects SET issue_count =
+			(SELECT COUNT(*) FROM project_issues WHERE project_issues.project_id = projects.project_id)`).Error
+	})
 }
 
 // CloseConnections closes any open database connections
@@ -223,7 +483,7 @@ func CloseConnections() error {
 
 // HealthCheck performs a health check on the database
 func HealthCheck() error {
-	if os.Getenv("DB_TYPE") != PostgresDB {
+	if dbType := os.Getenv("DB_TYPE"); dbType != PostgresDB && dbType != SQLiteDB {
 		return nil // In-memory DB is always healthy
 	}
 
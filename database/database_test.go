@@ -1,18 +1,27 @@
 package database_test
 
 import (
+	"context"
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/glebarez/sqlite"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/yasindce1998/issue-tracker/database"
+	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/models"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 func TestInitializeDatabase_Postgres(t *testing.T) {
@@ -118,3 +127,242 @@ func TestHealthCheck(t *testing.T) {
 	err := database.HealthCheck()
 	assert.NoError(t, err)
 }
+
+func TestInitializeDatabase_SQLite(t *testing.T) {
+	logger.ZapLogger, _ = zap.NewDevelopment()
+	t.Setenv("DB_TYPE", database.SQLiteDB)
+	t.Setenv("SQLITE_PATH", ":memory:")
+
+	repo, err := database.InitializeDatabase()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = database.CloseConnections() })
+
+	// SQLite reuses the GORM repositories
+	_, ok := repo.UserRepo.(*usersvc.PostgresUserRepository)
+	assert.True(t, ok, "Expected UserRepo to be PostgresUserRepository")
+	_, ok = repo.IssuesRepo.(*issuessvc.PostgresIssuesRepository)
+	assert.True(t, ok, "Expected IssuesRepo to be PostgresIssuesRepository")
+	_, ok = repo.ProjectRepo.(*projectsvc.PostgresProjectRepository)
+	assert.True(t, ok, "Expected ProjectRepo to be PostgresProjectRepository")
+
+	assert.NoError(t, database.HealthCheck())
+}
+
+func TestMigrateDatabase_BackfillsProjectIssues(t *testing.T) {
+	db, err := database.OpenSQLite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	// A database from before the join table tracked membership in issues.project_id
+	const projectID, issueID, deletedID = "928f705f-0efa-4c96-b2f6-ceb36281e1f1", "c72d237e-2658-4252-be58-760c7867d783", "a28f705f-0efa-4c96-b2f6-ceb36281e1f2"
+	require.NoError(t, db.Migrator().DropTable(&models.ProjectIssue{}))
+	require.NoError(t, db.Create(&models.Project{ProjectID: projectID, Name: "Project", IssueCount: 5}).Error)
+	for _, id := range []string{issueID, deletedID} {
+		require.NoError(t, db.Create(&models.Issues{IssueID: id, Summary: "Issue", ProjectID: projectID}).Error)
+	}
+	require.NoError(t, db.Delete(&models.Issues{}, "issue_id = ?", deletedID).Error)
+
+	require.NoError(t, database.MigrateDatabase(db))
+	var rows []models.ProjectIssue
+	require.NoError(t, db.Find(&rows).Error)
+	assert.Equal(t, []models.ProjectIssue{{ProjectID: projectID, IssueID: issueID}}, rows)
+	var project models.Project
+	require.NoError(t, db.First(&project, "project_id = ?", projectID).Error)
+	assert.Equal(t, int32(1), project.IssueCount)
+
+	// Later migrations don't restore removed associations
+	require.NoError(t, db.Delete(&models.ProjectIssue{}, "issue_id = ?", issueID).Error)
+	require.NoError(t, database.MigrateDatabase(db))
+	var count int64
+	require.NoError(t, db.Model(&models.ProjectIssue{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+func TestMigrateDatabase_CreatesIssueIndexes(t *testing.T) {
+	db, err := database.OpenSQLite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	indexes := []string{"idx_issues_status", "idx_issues_assignee_id", "idx_issues_project_created"}
+	for _, index := range indexes {
+		assert.True(t, db.Migrator().HasIndex(&models.Issues{}, index), index)
+	}
+	dbIndexes, err := db.Migrator().GetIndexes(&models.Issues{})
+	require.NoError(t, err)
+	columns := make(map[string][]string, len(dbIndexes))
+	for _, index := range dbIndexes {
+		columns[index.Name()] = index.Columns()
+	}
+	assert.Equal(t, []string{"project_id", "create_date", "issue_id"}, columns["idx_issues_project_created"],
+		"the index matches the order of a project's issue pages")
+
+	// Tables created by an earlier version get the indexes when they are migrated
+	for _, index := range indexes {
+		require.NoError(t, db.Migrator().DropIndex(&models.Issues{}, index))
+	}
+	require.NoError(t, database.MigrateDatabase(db))
+	for _, index := range indexes {
+		assert.True(t, db.Migrator().HasIndex(&models.Issues{}, index), index)
+	}
+}
+
+func TestValidatePostgresConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		host        string
+		port        string
+		user        string
+		dbName      string
+		expectedErr string
+	}{
+		{name: "Valid", host: "db.internal", port: "5432", user: "issue_tracker", dbName: "issues"},
+		{name: "Lowest port", host: "localhost", port: "1", user: "postgres", dbName: "postgres"},
+		{name: "Highest port", host: "localhost", port: "65535", user: "postgres", dbName: "postgres"},
+		{name: "Empty host", host: "", port: "5432", user: "postgres", dbName: "postgres", expectedErr: "POSTGRES_HOST must not be empty"},
+		{name: "Host with spaces", host: "db internal", port: "5432", user: "postgres", dbName: "postgres", expectedErr: "POSTGRES_HOST must not contain spaces, got: db internal"},
+		{name: "Port not a number", host: "localhost", port: "five-four-three-two", user: "postgres", dbName: "postgres",
+			expectedErr: "POSTGRES_PORT must be a number between 1 and 65535, got: five-four-three-two"},
+		{name: "Port zero", host: "localhost", port: "0", user: "postgres", dbName: "postgres", expectedErr: "POSTGRES_PORT must be a number between 1 and 65535, got: 0"},
+		{name: "Port too high", host: "localhost", port: "65536", user: "postgres", dbName: "postgres", expectedErr: "POSTGRES_PORT must be a number between 1 and 65535, got: 65536"},
+		{name: "User with a dash", host: "localhost", port: "5432", user: "issue-tracker", dbName: "postgres",
+			expectedErr: "POSTGRES_USER must only contain letters, digits and underscores, got: issue-tracker"},
+		{name: "Database with a space", host: "localhost", port: "5432", user: "postgres", dbName: "issue tracker",
+			expectedErr: "POSTGRES_DB must only contain letters, digits and underscores, got: issue tracker"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := database.ValidatePostgresConfig(tc.host, tc.port, tc.user, tc.dbName)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestConnectWithRetry(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	cfg := database.ConnectRetryConfig{MaxAttempts: 8, Interval: 10 * time.Second}
+	refused := errors.New("dial tcp 127.0.0.1:5432: connect: connection refused")
+	ready := &gorm.DB{}
+
+	// fakeDialer fails until it has been called failures times
+	fakeDialer := func(failures int) (func(string) (*gorm.DB, error), *int) {
+		calls := 0
+		return func(dsn string) (*gorm.DB, error) {
+			assert.Equal(t, "host=db", dsn)
+			calls++
+			if calls <= failures {
+				return nil, refused
+			}
+			return ready, nil
+		}, &calls
+	}
+
+	t.Run("Succeeds once the database is up", func(t *testing.T) {
+		dial, calls := fakeDialer(4)
+		var waits []time.Duration
+		db, err := database.ConnectWithRetry("host=db", cfg, dial, func(d time.Duration) { waits = append(waits, d) })
+		require.NoError(t, err)
+		assert.Same(t, ready, db)
+		assert.Equal(t, 5, *calls)
+		// The wait doubles up to 30 seconds
+		assert.Equal(t, []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second}, waits)
+	})
+
+	t.Run("Gives up after the last attempt", func(t *testing.T) {
+		dial, calls := fakeDialer(100)
+		waits := 0
+		_, err := database.ConnectWithRetry("host=db", cfg, dial, func(time.Duration) { waits++ })
+		require.ErrorIs(t, err, refused)
+		assert.Contains(t, err.Error(), "after 8 attempts")
+		assert.Equal(t, 8, *calls)
+		assert.Equal(t, 7, waits)
+	})
+}
+
+func TestConnectRetryConfigFromEnv(t *testing.T) {
+	t.Setenv("DB_CONNECT_MAX_ATTEMPTS", "")
+	t.Setenv("DB_CONNECT_RETRY_INTERVAL_SECONDS", "")
+	assert.Equal(t, database.ConnectRetryConfig{MaxAttempts: 10, Interval: time.Second}, database.ConnectRetryConfigFromEnv())
+
+	t.Setenv("DB_CONNECT_MAX_ATTEMPTS", "3")
+	t.Setenv("DB_CONNECT_RETRY_INTERVAL_SECONDS", "5")
+	assert.Equal(t, database.ConnectRetryConfig{MaxAttempts: 3, Interval: 5 * time.Second}, database.ConnectRetryConfigFromEnv())
+
+	t.Setenv("DB_CONNECT_MAX_ATTEMPTS", "0")
+	assert.Equal(t, 10, database.ConnectRetryConfigFromEnv().MaxAttempts)
+}
+
+func TestBuildPostgresReadDSN(t *testing.T) {
+	t.Setenv("POSTGRES_PORT", "5432")
+	t.Setenv("POSTGRES_USER", "postgres")
+	t.Setenv("POSTGRES_PASSWORD", "secret")
+	t.Setenv("POSTGRES_DB", "issue_tracker")
+
+	t.Setenv("POSTGRES_READ_HOST", "")
+	dsn, err := database.BuildPostgresReadDSN()
+	require.NoError(t, err)
+	assert.Empty(t, dsn, "reads go to the primary without a replica")
+
+	t.Setenv("POSTGRES_READ_HOST", "replica")
+	t.Setenv("POSTGRES_READ_PORT", "")
+	dsn, err = database.BuildPostgresReadDSN()
+	require.NoError(t, err)
+	assert.Equal(t, "host=replica port=5432 user=postgres password=secret dbname=issue_tracker sslmode=disable", dsn)
+
+	t.Setenv("POSTGRES_READ_PORT", "6432")
+	dsn, err = database.BuildPostgresReadDSN()
+	require.NoError(t, err)
+	assert.Contains(t, dsn, "port=6432")
+
+	t.Setenv("POSTGRES_READ_PORT", "replica")
+	_, err = database.BuildPostgresReadDSN()
+	assert.EqualError(t, err, "POSTGRES_READ_PORT must be a number between 1 and 65535, got: replica")
+}
+
+func TestUseReadReplica(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// Two separate databases with the same schema stand in for the primary and its replica
+	openMigrated := func(name string) *gorm.DB {
+		db, err := database.OpenSQLite(filepath.Join(dir, name))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			if sqlDB, err := db.DB(); err == nil {
+				_ = sqlDB.Close()
+			}
+		})
+		return db
+	}
+	primary := openMigrated("primary.db")
+	openMigrated("replica.db")
+	require.NoError(t, database.UseReadReplica(primary, sqlite.Open(filepath.Join(dir, "replica.db"))))
+
+	repo := projectsvc.NewPostgresProjectRepository(primary)
+	project := &projectPbv1.Project{ProjectId: "928f705f-0efa-4c96-b2f6-ceb36281e1f1", Name: "Replicated"}
+	require.NoError(t, repo.CreateProject(ctx, project))
+
+	// The write went to the primary, and reads outside of transactions go to the replica, which
+	// hasn't caught up
+	_, err := repo.ReadProject(ctx, project.ProjectId)
+	assert.ErrorIs(t, err, consts.ErrProjectNotFound)
+
+	var names []string
+	require.NoError(t, primary.Transaction(func(tx *gorm.DB) error {
+		return tx.Table("projects").Pluck("name", &names).Error
+	}))
+	assert.Equal(t, []string{"Replicated"}, names, "transactions only use the primary")
+}
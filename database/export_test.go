@@ -0,0 +1,10 @@
+package database
+
+// Exported for tests
+var (
+	ValidatePostgresConfig = validatePostgresConfig
+	ConnectWithRetry       = connectWithRetry
+	BuildPostgresReadDSN   = buildPostgresReadDSN
+	UseReadReplica         = useReadReplica
+	MigrateDatabase        = migrateDatabase
+)
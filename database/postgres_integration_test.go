@@ -0,0 +1,122 @@
+//go:build integration
+
+package database_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/yasindce1998/issue-tracker/database"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/repotest"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// postgresDB is the migrated database the integration tests share; each test empties it first
+var postgresDB *gorm.DB
+
+// TestMain connects to the database named by POSTGRES_TEST_DSN or, without it, starts a
+// disposable Postgres container that is removed once the tests have run
+func TestMain(m *testing.M) {
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	var pool *dockertest.Pool
+	var resource *dockertest.Resource
+
+	if dsn == "" {
+		var err error
+		pool, err = dockertest.NewPool("")
+		if err != nil {
+			log.Fatalf("Failed to connect to Docker: %v", err)
+		}
+		resource, err = pool.RunWithOptions(&dockertest.RunOptions{
+			Repository: "postgres",
+			Tag:        "16-alpine",
+			Env: []string{
+				"POSTGRES_USER=issue_tracker",
+				"POSTGRES_PASSWORD=issue_tracker",
+				"POSTGRES_DB=issue_tracker",
+			},
+		}, func(config *docker.HostConfig) {
+			config.AutoRemove = true
+			config.RestartPolicy = docker.RestartPolicy{Name: "no"}
+		})
+		if err != nil {
+			log.Fatalf("Failed to start Postgres: %v", err)
+		}
+		// Remove the container even if the tests hang
+		_ = resource.Expire(300)
+
+		dsn = fmt.Sprintf("host=localhost port=%s user=issue_tracker password=issue_tracker dbname=issue_tracker sslmode=disable",
+			resource.GetPort("5432/tcp"))
+	}
+
+	connect := func() error {
+		db, err := database.OpenPostgres(dsn)
+		if err != nil {
+			return err
+		}
+		postgresDB = db
+		return nil
+	}
+	var err error
+	if pool != nil {
+		pool.MaxWait = time.Minute
+		err = pool.Retry(connect)
+	} else {
+		err = connect()
+	}
+	if err != nil {
+		if pool != nil {
+			_ = pool.Purge(resource)
+		}
+		log.Fatalf("Failed to open Postgres: %v", err)
+	}
+
+	code := m.Run()
+
+	if pool != nil {
+		if err := pool.Purge(resource); err != nil {
+			log.Printf("Failed to remove the Postgres container: %v", err)
+		}
+	}
+	os.Exit(code)
+}
+
+// newPostgresDB empties the shared database and returns it
+func newPostgresDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	require.NoError(t, postgresDB.Exec("TRUNCATE users, issues, projects, issue_templates, issue_links, project_members, project_issues, teams, team_members").Error)
+	return postgresDB
+}
+
+func TestRepositoryConformance_Postgres(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repotest.RunUserRepositorySuite(t, func(t *testing.T) usersvc.UserRepository {
+		return usersvc.NewPostgresUserRepository(newPostgresDB(t))
+	})
+	repotest.RunProjectRepositorySuite(t, func(t *testing.T) projectsvc.ProjectRepository {
+		return projectsvc.NewPostgresProjectRepository(newPostgresDB(t))
+	})
+	repotest.RunIssuesRepositorySuite(t, func(t *testing.T) issuessvc.IssuesRepository {
+		return issuessvc.NewPostgresIssuesRepository(newPostgresDB(t))
+	})
+	repotest.RunTeamRepositorySuite(t, func(t *testing.T) teamsvc.TeamRepository {
+		return teamsvc.NewPostgresTeamRepository(newPostgresDB(t))
+	})
+	repotest.RunProjectIssueCountSuite(t, func(t *testing.T) (issuessvc.IssuesRepository, projectsvc.ProjectRepository) {
+		db := newPostgresDB(t)
+		return issuessvc.NewPostgresIssuesRepository(db), projectsvc.NewPostgresProjectRepository(db)
+	})
+}
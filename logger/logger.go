@@ -12,12 +12,18 @@ import (
 // ZapLogger is the global logger instance available across your application
 var ZapLogger *zap.Logger
 
+// atomicLevel is the level of the logger built by InitializeLogger. Keeping it lets
+// SetLevel change the level of the running logger without rebuilding it.
+var atomicLevel = zap.NewAtomicLevel()
+
 // Key types for context values to avoid collisions
 type contextKey string
 
 const (
 	// CacheStatsKey is used to store cache statistics in context
 	CacheStatsKey contextKey = "cache_stats"
+	// traceIDKey holds the trace ID of the request being served
+	traceIDKey contextKey = "trace_id"
 )
 
 // CacheAccessType represents where data was retrieved from
@@ -49,8 +55,9 @@ func InitializeLogger(level string) error {
 	}
 
 	// Create the logger configuration
+	atomicLevel.SetLevel(zapLevel)
 	config := zap.Config{
-		Level:            zap.NewAtomicLevelAt(zapLevel),
+		Level:            atomicLevel,
 		Development:      zapLevel == zap.DebugLevel,
 		Encoding:         "json",
 		EncoderConfig:    zap.NewProductionEncoderConfig(),
@@ -75,14 +82,44 @@ func InitializeLogger(level string) error {
 	return nil
 }
 
-// LogCacheAccess logs a cache hit or miss with entity information
-func LogCacheAccess(ctx context.Context, entity, entityID string, source CacheAccessType) {
-	// Extract trace ID if present
-	var traceID string
-	if val := ctx.Value(contextKey("trace_id")); val != nil {
-		traceID = val.(string)
+// SetLevel changes the level of the logger built by InitializeLogger while it is running.
+// The encoding chosen at startup is kept.
+func SetLevel(level string) error {
+	var zapLevel zapcore.Level
+	if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("invalid log level '%s': %w", level, err)
+	}
+	atomicLevel.SetLevel(zapLevel)
+	return nil
+}
+
+// Level returns the current level of the logger built by InitializeLogger
+func Level() zapcore.Level {
+	return atomicLevel.Level()
+}
+
+// WithTraceID returns a context carrying the trace ID of the request being served
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey, traceID)
+}
+
+// TraceIDFromContext returns the trace ID added by WithTraceID, or "" outside of a request
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey).(string)
+	return traceID
+}
+
+// FromContext returns the global logger with the request's trace_id attached, so log lines
+// written while serving a request can be correlated with it
+func FromContext(ctx context.Context) *zap.Logger {
+	if traceID := TraceIDFromContext(ctx); traceID != "" {
+		return ZapLogger.With(zap.String("trace_id", traceID))
 	}
+	return ZapLogger
+}
 
+// LogCacheAccess logs a cache hit or miss with entity information
+func LogCacheAccess(ctx context.Context, entity, entityID string, source CacheAccessType) {
 	// Gather fields for the log message
 	fields := []zapcore.Field{
 		zap.String("entity", entity),
@@ -90,15 +127,11 @@ func LogCacheAccess(ctx context.Context, entity, entityID string, source CacheAc
 		zap.String("data_source", string(source)),
 	}
 
-	if traceID != "" {
-		fields = append(fields, zap.String("trace_id", traceID))
-	}
-
 	// Record the event
 	if source == FromCache {
-		ZapLogger.Info("Data retrieved from cache", fields...)
+		FromContext(ctx).Info("Data retrieved from cache", fields...)
 	} else {
-		ZapLogger.Info("Data retrieved from database", fields...)
+		FromContext(ctx).Info("Data retrieved from database", fields...)
 	}
 }
 
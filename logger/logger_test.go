@@ -2,6 +2,7 @@ package logger_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestInitializeLogger_ValidLevels(t *testing.T) {
@@ -137,3 +139,39 @@ func TestProductionLevelUsesJSONEncoding(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "test info message", logEntry["msg"])
 }
+
+func TestSetLevel_ChangesRunningLogger(t *testing.T) {
+	assert.NoError(t, logger.InitializeLogger("info"))
+	assert.False(t, logger.ZapLogger.Core().Enabled(zapcore.DebugLevel))
+
+	// Raising the verbosity takes effect on the logger already in use
+	assert.NoError(t, logger.SetLevel("debug"))
+	assert.Equal(t, zapcore.DebugLevel, logger.Level())
+	assert.True(t, logger.ZapLogger.Core().Enabled(zapcore.DebugLevel))
+
+	assert.NoError(t, logger.SetLevel("error"))
+	assert.False(t, logger.ZapLogger.Core().Enabled(zapcore.WarnLevel))
+	assert.True(t, logger.ZapLogger.Core().Enabled(zapcore.ErrorLevel))
+
+	err := logger.SetLevel("verbose")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid log level")
+	assert.Equal(t, zapcore.ErrorLevel, logger.Level())
+}
+
+func TestFromContext_AttachesTraceID(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger.ZapLogger = zap.New(core)
+	defer func() { logger.ZapLogger = zap.NewNop() }()
+
+	ctx := logger.WithTraceID(context.Background(), "trace-123")
+	assert.Equal(t, "trace-123", logger.TraceIDFromContext(ctx))
+
+	logger.FromContext(ctx).Error("cache write failed")
+	logger.FromContext(context.Background()).Error("outside a request")
+
+	entries := logs.AllUntimed()
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "trace-123", entries[0].ContextMap()["trace_id"])
+	assert.NotContains(t, entries[1].ContextMap(), "trace_id")
+}
@@ -0,0 +1,146 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// RedactedValue replaces the value of redacted string fields
+	RedactedValue = "[REDACTED]"
+
+	defaultPayloadMaxBytes = 2048
+)
+
+// defaultRedactedFields are masked unless LOG_PAYLOAD_REDACT_FIELDS overrides them
+var defaultRedactedFields = []string{"email_address", "email", "description"}
+
+// PayloadConfig controls how request and response payloads are written to the logs
+type PayloadConfig struct {
+	// Enabled turns request payload logging on
+	Enabled bool
+	// LogResponses additionally logs response payloads at debug level
+	LogResponses bool
+	// MaxBytes caps the size of a serialized payload; longer payloads are truncated
+	MaxBytes int
+	// RedactFields lists protobuf field names whose values are masked at any depth
+	RedactFields []string
+}
+
+// DefaultPayloadConfig returns the payload settings used when nothing is configured
+func DefaultPayloadConfig() PayloadConfig {
+	return PayloadConfig{
+		Enabled:      true,
+		MaxBytes:     defaultPayloadMaxBytes,
+		RedactFields: defaultRedactedFields,
+	}
+}
+
+// PayloadConfigFromEnv reads payload logging settings from the environment.
+// Payload logging is off by default in production.
+func PayloadConfigFromEnv() PayloadConfig {
+	cfg := DefaultPayloadConfig()
+	cfg.Enabled = os.Getenv("ENVIRONMENT") != "production"
+
+	if v, err := strconv.ParseBool(os.Getenv("LOG_PAYLOADS")); err == nil {
+		cfg.Enabled = v
+	}
+	if v, err := strconv.ParseBool(os.Getenv("LOG_RESPONSES")); err == nil {
+		cfg.LogResponses = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("LOG_PAYLOAD_MAX_BYTES")); err == nil && v > 0 {
+		cfg.MaxBytes = v
+	}
+	if v := os.Getenv("LOG_PAYLOAD_REDACT_FIELDS"); v != "" {
+		cfg.RedactFields = nil
+		for _, field := range strings.Split(v, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				cfg.RedactFields = append(cfg.RedactFields, field)
+			}
+		}
+	}
+
+	return cfg
+}
+
+// FormatPayload serializes a payload for logging, masking redacted fields and
+// truncating the result to MaxBytes. Protobuf messages are redacted via reflection,
+// so new message types are covered without any extra code.
+func (c PayloadConfig) FormatPayload(payload any) string {
+	var serialized string
+	if msg, ok := payload.(proto.Message); ok && msg != nil && msg.ProtoReflect().IsValid() {
+		redacted := proto.Clone(msg)
+		redactMessage(redacted.ProtoReflect(), c.redactSet())
+		b, err := protojson.Marshal(redacted)
+		if err != nil {
+			serialized = fmt.Sprintf("<unserializable %T: %v>", payload, err)
+		} else {
+			serialized = string(b)
+		}
+	} else {
+		serialized = fmt.Sprintf("%v", payload)
+	}
+
+	return truncatePayload(serialized, c.MaxBytes)
+}
+
+func (c PayloadConfig) redactSet() map[string]struct{} {
+	fields := make(map[string]struct{}, len(c.RedactFields))
+	for _, field := range c.RedactFields {
+		fields[field] = struct{}{}
+	}
+	return fields
+}
+
+// redactMessage masks redacted fields in place, descending into nested messages, lists and maps
+func redactMessage(msg protoreflect.Message, fields map[string]struct{}) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if _, ok := fields[string(fd.Name())]; ok {
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				msg.Set(fd, protoreflect.ValueOfString(RedactedValue))
+			} else {
+				msg.Clear(fd)
+			}
+			return true
+		}
+
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message(), fields)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					redactMessage(list.Get(i).Message(), fields)
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			redactMessage(v.Message(), fields)
+		}
+		return true
+	})
+}
+
+func truncatePayload(s string, maxBytes int) string {
+	if maxBytes <= 0 || len(s) <= maxBytes {
+		return s
+	}
+	// Don't cut a multi-byte character in half
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...(truncated %d bytes)", s[:cut], len(s)-cut)
+}
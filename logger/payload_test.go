@@ -0,0 +1,95 @@
+package logger_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+)
+
+func TestPayloadConfig_FormatPayload_Redacts(t *testing.T) {
+	cfg := logger.DefaultPayloadConfig()
+
+	testCases := []struct {
+		name     string
+		payload  any
+		hidden   []string
+		expected []string
+	}{
+		{
+			name:     "Top-level field",
+			payload:  &userPbv1.CreateUserRequest{FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"},
+			hidden:   []string{"jane@example.com"},
+			expected: []string{"Jane", logger.RedactedValue},
+		},
+		{
+			name: "Nested messages",
+			payload: &issuesPbv1.GetIssueResponse{
+				Issue:    &issuesPbv1.Issue{Summary: "Crash", Description: "secret steps"},
+				UserInfo: &issuesPbv1.UserInfo{FirstName: "Jane", Email: "jane@example.com"},
+			},
+			hidden:   []string{"jane@example.com", "secret steps"},
+			expected: []string{"Crash", "Jane"},
+		},
+		{
+			name: "Repeated messages",
+			payload: &issuesPbv1.ListIssuesResponse{Issues: []*issuesPbv1.Issue{
+				{Summary: "First", Description: "private one"},
+				{Summary: "Second", Description: "private two"},
+			}},
+			hidden:   []string{"private one", "private two"},
+			expected: []string{"First", "Second"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := cfg.FormatPayload(tc.payload)
+			for _, s := range tc.hidden {
+				assert.NotContains(t, out, s)
+			}
+			for _, s := range tc.expected {
+				assert.Contains(t, out, s)
+			}
+		})
+	}
+}
+
+func TestPayloadConfig_FormatPayload_DoesNotMutateInput(t *testing.T) {
+	req := &userPbv1.CreateUserRequest{EmailAddress: "jane@example.com"}
+
+	logger.DefaultPayloadConfig().FormatPayload(req)
+
+	assert.Equal(t, "jane@example.com", req.EmailAddress)
+}
+
+func TestPayloadConfig_FormatPayload_Truncates(t *testing.T) {
+	cfg := logger.DefaultPayloadConfig()
+	cfg.MaxBytes = 100
+
+	out := cfg.FormatPayload(&issuesPbv1.CreateIssueRequest{Summary: strings.Repeat("é", 1000)})
+
+	assert.Less(t, len(out), 150)
+	assert.Contains(t, out, "...(truncated")
+	assert.True(t, strings.ToValidUTF8(out, "") == out, "truncation must not split characters")
+}
+
+func TestPayloadConfigFromEnv(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "production")
+	t.Setenv("LOG_PAYLOADS", "")
+	t.Setenv("LOG_PAYLOAD_REDACT_FIELDS", "first_name, last_name")
+	t.Setenv("LOG_PAYLOAD_MAX_BYTES", "512")
+
+	cfg := logger.PayloadConfigFromEnv()
+
+	assert.False(t, cfg.Enabled, "payload logging is off by default in production")
+	assert.Equal(t, 512, cfg.MaxBytes)
+	assert.Equal(t, []string{"first_name", "last_name"}, cfg.RedactFields)
+
+	t.Setenv("LOG_PAYLOADS", "true")
+	assert.True(t, logger.PayloadConfigFromEnv().Enabled)
+}
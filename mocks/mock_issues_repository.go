@@ -12,8 +12,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	issuesv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	issuessvc "github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	projectsvc "github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,52 +44,201 @@ func (m *MockIssuesRepository) EXPECT() *MockIssuesRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BatchGetIssues mocks base method.
+func (m *MockIssuesRepository) BatchGetIssues(ctx context.Context, issueIDs []string) ([]*issuesv1.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetIssues", ctx, issueIDs)
+	ret0, _ := ret[0].([]*issuesv1.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetIssues indicates an expected call of BatchGetIssues.
+func (mr *MockIssuesRepositoryMockRecorder) BatchGetIssues(ctx, issueIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetIssues", reflect.TypeOf((*MockIssuesRepository)(nil).BatchGetIssues), ctx, issueIDs)
+}
+
+// BulkUpdateStatus mocks base method.
+func (m *MockIssuesRepository) BulkUpdateStatus(ctx context.Context, update issuessvc.BulkStatusUpdate) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdateStatus", ctx, update)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateStatus indicates an expected call of BulkUpdateStatus.
+func (mr *MockIssuesRepositoryMockRecorder) BulkUpdateStatus(ctx, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateStatus", reflect.TypeOf((*MockIssuesRepository)(nil).BulkUpdateStatus), ctx, update)
+}
+
+// CountOpenIssuesByAssignee mocks base method.
+func (m *MockIssuesRepository) CountOpenIssuesByAssignee(ctx context.Context, assigneeID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenIssuesByAssignee", ctx, assigneeID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenIssuesByAssignee indicates an expected call of CountOpenIssuesByAssignee.
+func (mr *MockIssuesRepositoryMockRecorder) CountOpenIssuesByAssignee(ctx, assigneeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenIssuesByAssignee", reflect.TypeOf((*MockIssuesRepository)(nil).CountOpenIssuesByAssignee), ctx, assigneeID)
+}
+
 // CreateIssue mocks base method.
-func (m *MockIssuesRepository) CreateIssue(issue *issuesv1.Issue) error {
+func (m *MockIssuesRepository) CreateIssue(ctx context.Context, issue *issuesv1.Issue) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateIssue", issue)
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, issue)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateIssue indicates an expected call of CreateIssue.
-func (mr *MockIssuesRepositoryMockRecorder) CreateIssue(issue any) *gomock.Call {
+func (mr *MockIssuesRepositoryMockRecorder) CreateIssue(ctx, issue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockIssuesRepository)(nil).CreateIssue), ctx, issue)
+}
+
+// CreateIssueLink mocks base method.
+func (m *MockIssuesRepository) CreateIssueLink(ctx context.Context, link *issuesv1.IssueLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueLink", ctx, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIssueLink indicates an expected call of CreateIssueLink.
+func (mr *MockIssuesRepositoryMockRecorder) CreateIssueLink(ctx, link any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockIssuesRepository)(nil).CreateIssue), issue)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueLink", reflect.TypeOf((*MockIssuesRepository)(nil).CreateIssueLink), ctx, link)
 }
 
 // DeleteIssue mocks base method.
-func (m *MockIssuesRepository) DeleteIssue(issueID string) error {
+func (m *MockIssuesRepository) DeleteIssue(ctx context.Context, issueID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteIssue", issueID)
+	ret := m.ctrl.Call(m, "DeleteIssue", ctx, issueID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteIssue indicates an expected call of DeleteIssue.
-func (mr *MockIssuesRepositoryMockRecorder) DeleteIssue(issueID any) *gomock.Call {
+func (mr *MockIssuesRepositoryMockRecorder) DeleteIssue(ctx, issueID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssue", reflect.TypeOf((*MockIssuesRepository)(nil).DeleteIssue), issueID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssue", reflect.TypeOf((*MockIssuesRepository)(nil).DeleteIssue), ctx, issueID)
+}
+
+// DeleteIssueLink mocks base method.
+func (m *MockIssuesRepository) DeleteIssueLink(ctx context.Context, linkID string) (*issuesv1.IssueLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssueLink", ctx, linkID)
+	ret0, _ := ret[0].(*issuesv1.IssueLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIssueLink indicates an expected call of DeleteIssueLink.
+func (mr *MockIssuesRepositoryMockRecorder) DeleteIssueLink(ctx, linkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueLink", reflect.TypeOf((*MockIssuesRepository)(nil).DeleteIssueLink), ctx, linkID)
+}
+
+// FindIssuesDueSoon mocks base method.
+func (m *MockIssuesRepository) FindIssuesDueSoon(ctx context.Context, within time.Duration) ([]*issuesv1.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIssuesDueSoon", ctx, within)
+	ret0, _ := ret[0].([]*issuesv1.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIssuesDueSoon indicates an expected call of FindIssuesDueSoon.
+func (mr *MockIssuesRepositoryMockRecorder) FindIssuesDueSoon(ctx, within any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIssuesDueSoon", reflect.TypeOf((*MockIssuesRepository)(nil).FindIssuesDueSoon), ctx, within)
+}
+
+// GetProjectIssueCount mocks base method.
+func (m *MockIssuesRepository) GetProjectIssueCount(ctx context.Context, projectID string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectIssueCount", ctx, projectID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectIssueCount indicates an expected call of GetProjectIssueCount.
+func (mr *MockIssuesRepositoryMockRecorder) GetProjectIssueCount(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIssueCount", reflect.TypeOf((*MockIssuesRepository)(nil).GetProjectIssueCount), ctx, projectID)
+}
+
+// GetProjectMaxIssues mocks base method.
+func (m *MockIssuesRepository) GetProjectMaxIssues(ctx context.Context, projectID string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMaxIssues", ctx, projectID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectMaxIssues indicates an expected call of GetProjectMaxIssues.
+func (mr *MockIssuesRepositoryMockRecorder) GetProjectMaxIssues(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMaxIssues", reflect.TypeOf((*MockIssuesRepository)(nil).GetProjectMaxIssues), ctx, projectID)
+}
+
+// GetProjectMemberRole mocks base method.
+func (m *MockIssuesRepository) GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMemberRole", ctx, projectID, userID)
+	ret0, _ := ret[0].(projectsvc.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectMemberRole indicates an expected call of GetProjectMemberRole.
+func (mr *MockIssuesRepositoryMockRecorder) GetProjectMemberRole(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMemberRole", reflect.TypeOf((*MockIssuesRepository)(nil).GetProjectMemberRole), ctx, projectID, userID)
 }
 
 // IsValidStatusTransition mocks base method.
-func (m *MockIssuesRepository) IsValidStatusTransition(currentStatus, newStatus issuesv1.Status) error {
+func (m *MockIssuesRepository) IsValidStatusTransition(ctx context.Context, currentStatus, newStatus issuesv1.Status) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsValidStatusTransition", currentStatus, newStatus)
+	ret := m.ctrl.Call(m, "IsValidStatusTransition", ctx, currentStatus, newStatus)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // IsValidStatusTransition indicates an expected call of IsValidStatusTransition.
-func (mr *MockIssuesRepositoryMockRecorder) IsValidStatusTransition(currentStatus, newStatus any) *gomock.Call {
+func (mr *MockIssuesRepositoryMockRecorder) IsValidStatusTransition(ctx, currentStatus, newStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidStatusTransition", reflect.TypeOf((*MockIssuesRepository)(nil).IsValidStatusTransition), ctx, currentStatus, newStatus)
+}
+
+// ListIssueLinks mocks base method.
+func (m *MockIssuesRepository) ListIssueLinks(ctx context.Context, issueID string) ([]*issuesv1.IssueLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueLinks", ctx, issueID)
+	ret0, _ := ret[0].([]*issuesv1.IssueLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueLinks indicates an expected call of ListIssueLinks.
+func (mr *MockIssuesRepositoryMockRecorder) ListIssueLinks(ctx, issueID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidStatusTransition", reflect.TypeOf((*MockIssuesRepository)(nil).IsValidStatusTransition), currentStatus, newStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueLinks", reflect.TypeOf((*MockIssuesRepository)(nil).ListIssueLinks), ctx, issueID)
 }
 
 // ListIssues mocks base method.
-func (m *MockIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*issuesv1.Issue, string, error) {
+func (m *MockIssuesRepository) ListIssues(ctx context.Context, pageToken string, pageSize int) ([]*issuesv1.Issue, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIssues", pageToken, pageSize)
+	ret := m.ctrl.Call(m, "ListIssues", ctx, pageToken, pageSize)
 	ret0, _ := ret[0].([]*issuesv1.Issue)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -94,38 +246,144 @@ func (m *MockIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*is
 }
 
 // ListIssues indicates an expected call of ListIssues.
-func (mr *MockIssuesRepositoryMockRecorder) ListIssues(pageToken, pageSize any) *gomock.Call {
+func (mr *MockIssuesRepositoryMockRecorder) ListIssues(ctx, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssues", reflect.TypeOf((*MockIssuesRepository)(nil).ListIssues), ctx, pageToken, pageSize)
+}
+
+// ListIssuesByProject mocks base method.
+func (m *MockIssuesRepository) ListIssuesByProject(ctx context.Context, projectID, pageToken string, pageSize int) ([]*issuesv1.Issue, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuesByProject", ctx, projectID, pageToken, pageSize)
+	ret0, _ := ret[0].([]*issuesv1.Issue)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListIssuesByProject indicates an expected call of ListIssuesByProject.
+func (mr *MockIssuesRepositoryMockRecorder) ListIssuesByProject(ctx, projectID, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuesByProject", reflect.TypeOf((*MockIssuesRepository)(nil).ListIssuesByProject), ctx, projectID, pageToken, pageSize)
+}
+
+// MoveIssue mocks base method.
+func (m *MockIssuesRepository) MoveIssue(ctx context.Context, issueID, fromProjectID, toProjectID string) (*issuessvc.IssueMove, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveIssue", ctx, issueID, fromProjectID, toProjectID)
+	ret0, _ := ret[0].(*issuessvc.IssueMove)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveIssue indicates an expected call of MoveIssue.
+func (mr *MockIssuesRepositoryMockRecorder) MoveIssue(ctx, issueID, fromProjectID, toProjectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveIssue", reflect.TypeOf((*MockIssuesRepository)(nil).MoveIssue), ctx, issueID, fromProjectID, toProjectID)
+}
+
+// ProjectActivity mocks base method.
+func (m *MockIssuesRepository) ProjectActivity(ctx context.Context, window issuessvc.ProjectActivityWindow) (*issuessvc.ProjectActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectActivity", ctx, window)
+	ret0, _ := ret[0].(*issuessvc.ProjectActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectActivity indicates an expected call of ProjectActivity.
+func (mr *MockIssuesRepositoryMockRecorder) ProjectActivity(ctx, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectActivity", reflect.TypeOf((*MockIssuesRepository)(nil).ProjectActivity), ctx, window)
+}
+
+// ProjectProgress mocks base method.
+func (m *MockIssuesRepository) ProjectProgress(ctx context.Context, projectID string) (*issuessvc.ProjectProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectProgress", ctx, projectID)
+	ret0, _ := ret[0].(*issuessvc.ProjectProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectProgress indicates an expected call of ProjectProgress.
+func (mr *MockIssuesRepositoryMockRecorder) ProjectProgress(ctx, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssues", reflect.TypeOf((*MockIssuesRepository)(nil).ListIssues), pageToken, pageSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectProgress", reflect.TypeOf((*MockIssuesRepository)(nil).ProjectProgress), ctx, projectID)
 }
 
 // ReadIssue mocks base method.
-func (m *MockIssuesRepository) ReadIssue(issueID string) (*issuesv1.Issue, error) {
+func (m *MockIssuesRepository) ReadIssue(ctx context.Context, issueID string) (*issuesv1.Issue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadIssue", issueID)
+	ret := m.ctrl.Call(m, "ReadIssue", ctx, issueID)
 	ret0, _ := ret[0].(*issuesv1.Issue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadIssue indicates an expected call of ReadIssue.
-func (mr *MockIssuesRepositoryMockRecorder) ReadIssue(issueID any) *gomock.Call {
+func (mr *MockIssuesRepositoryMockRecorder) ReadIssue(ctx, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadIssue", reflect.TypeOf((*MockIssuesRepository)(nil).ReadIssue), ctx, issueID)
+}
+
+// ReadIssueByNumber mocks base method.
+func (m *MockIssuesRepository) ReadIssueByNumber(ctx context.Context, projectID string, issueNumber int32) (*issuesv1.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadIssueByNumber", ctx, projectID, issueNumber)
+	ret0, _ := ret[0].(*issuesv1.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadIssueByNumber indicates an expected call of ReadIssueByNumber.
+func (mr *MockIssuesRepositoryMockRecorder) ReadIssueByNumber(ctx, projectID, issueNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadIssueByNumber", reflect.TypeOf((*MockIssuesRepository)(nil).ReadIssueByNumber), ctx, projectID, issueNumber)
+}
+
+// ReassignOpenIssues mocks base method.
+func (m *MockIssuesRepository) ReassignOpenIssues(ctx context.Context, fromAssigneeID, toAssigneeID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignOpenIssues", ctx, fromAssigneeID, toAssigneeID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignOpenIssues indicates an expected call of ReassignOpenIssues.
+func (mr *MockIssuesRepositoryMockRecorder) ReassignOpenIssues(ctx, fromAssigneeID, toAssigneeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignOpenIssues", reflect.TypeOf((*MockIssuesRepository)(nil).ReassignOpenIssues), ctx, fromAssigneeID, toAssigneeID)
+}
+
+// ResolutionMetrics mocks base method.
+func (m *MockIssuesRepository) ResolutionMetrics(ctx context.Context, filter issuessvc.ResolutionMetricsFilter) (*issuessvc.ResolutionMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolutionMetrics", ctx, filter)
+	ret0, _ := ret[0].(*issuessvc.ResolutionMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolutionMetrics indicates an expected call of ResolutionMetrics.
+func (mr *MockIssuesRepositoryMockRecorder) ResolutionMetrics(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadIssue", reflect.TypeOf((*MockIssuesRepository)(nil).ReadIssue), issueID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolutionMetrics", reflect.TypeOf((*MockIssuesRepository)(nil).ResolutionMetrics), ctx, filter)
 }
 
 // UpdateIssue mocks base method.
-func (m *MockIssuesRepository) UpdateIssue(issue *issuesv1.Issue) error {
+func (m *MockIssuesRepository) UpdateIssue(ctx context.Context, issue *issuesv1.Issue) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIssue", issue)
+	ret := m.ctrl.Call(m, "UpdateIssue", ctx, issue)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateIssue indicates an expected call of UpdateIssue.
-func (mr *MockIssuesRepositoryMockRecorder) UpdateIssue(issue any) *gomock.Call {
+func (mr *MockIssuesRepositoryMockRecorder) UpdateIssue(ctx, issue any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssue", reflect.TypeOf((*MockIssuesRepository)(nil).UpdateIssue), issue)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssue", reflect.TypeOf((*MockIssuesRepository)(nil).UpdateIssue), ctx, issue)
 }
 
 // ValidateProjectExists mocks base method.
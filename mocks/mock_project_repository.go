@@ -10,9 +10,11 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	projectv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	projectsvc "github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,101 +43,235 @@ func (m *MockProjectRepository) EXPECT() *MockProjectRepositoryMockRecorder {
 }
 
 // AddIssueToProject mocks base method.
-func (m *MockProjectRepository) AddIssueToProject(projectID, issueID string) error {
+func (m *MockProjectRepository) AddIssueToProject(ctx context.Context, projectID, issueID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddIssueToProject", projectID, issueID)
+	ret := m.ctrl.Call(m, "AddIssueToProject", ctx, projectID, issueID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddIssueToProject indicates an expected call of AddIssueToProject.
-func (mr *MockProjectRepositoryMockRecorder) AddIssueToProject(projectID, issueID any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) AddIssueToProject(ctx, projectID, issueID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIssueToProject", reflect.TypeOf((*MockProjectRepository)(nil).AddIssueToProject), projectID, issueID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIssueToProject", reflect.TypeOf((*MockProjectRepository)(nil).AddIssueToProject), ctx, projectID, issueID)
+}
+
+// CreateIssueTemplate mocks base method.
+func (m *MockProjectRepository) CreateIssueTemplate(ctx context.Context, template *projectv1.IssueTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIssueTemplate indicates an expected call of CreateIssueTemplate.
+func (mr *MockProjectRepositoryMockRecorder) CreateIssueTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueTemplate", reflect.TypeOf((*MockProjectRepository)(nil).CreateIssueTemplate), ctx, template)
 }
 
 // CreateProject mocks base method.
-func (m *MockProjectRepository) CreateProject(project *projectv1.Project) error {
+func (m *MockProjectRepository) CreateProject(ctx context.Context, project *projectv1.Project) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProject", project)
+	ret := m.ctrl.Call(m, "CreateProject", ctx, project)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateProject indicates an expected call of CreateProject.
-func (mr *MockProjectRepositoryMockRecorder) CreateProject(project any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) CreateProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectRepository)(nil).CreateProject), ctx, project)
+}
+
+// DeleteIssueTemplate mocks base method.
+func (m *MockProjectRepository) DeleteIssueTemplate(ctx context.Context, templateID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssueTemplate", ctx, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssueTemplate indicates an expected call of DeleteIssueTemplate.
+func (mr *MockProjectRepositoryMockRecorder) DeleteIssueTemplate(ctx, templateID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectRepository)(nil).CreateProject), project)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueTemplate", reflect.TypeOf((*MockProjectRepository)(nil).DeleteIssueTemplate), ctx, templateID)
 }
 
 // DeleteProject mocks base method.
-func (m *MockProjectRepository) DeleteProject(projectID string) error {
+func (m *MockProjectRepository) DeleteProject(ctx context.Context, projectID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProject", projectID)
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, projectID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteProject indicates an expected call of DeleteProject.
-func (mr *MockProjectRepositoryMockRecorder) DeleteProject(projectID any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) DeleteProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectRepository)(nil).DeleteProject), ctx, projectID)
+}
+
+// FindOrCreateProject mocks base method.
+func (m *MockProjectRepository) FindOrCreateProject(ctx context.Context, project *projectv1.Project) (*projectv1.Project, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrCreateProject", ctx, project)
+	ret0, _ := ret[0].(*projectv1.Project)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindOrCreateProject indicates an expected call of FindOrCreateProject.
+func (mr *MockProjectRepositoryMockRecorder) FindOrCreateProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateProject", reflect.TypeOf((*MockProjectRepository)(nil).FindOrCreateProject), ctx, project)
+}
+
+// GetProjectMemberRole mocks base method.
+func (m *MockProjectRepository) GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMemberRole", ctx, projectID, userID)
+	ret0, _ := ret[0].(projectsvc.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectMemberRole indicates an expected call of GetProjectMemberRole.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectMemberRole(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMemberRole", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectMemberRole), ctx, projectID, userID)
+}
+
+// ListIssueTemplates mocks base method.
+func (m *MockProjectRepository) ListIssueTemplates(ctx context.Context, projectID string) ([]*projectv1.IssueTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueTemplates", ctx, projectID)
+	ret0, _ := ret[0].([]*projectv1.IssueTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueTemplates indicates an expected call of ListIssueTemplates.
+func (mr *MockProjectRepositoryMockRecorder) ListIssueTemplates(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueTemplates", reflect.TypeOf((*MockProjectRepository)(nil).ListIssueTemplates), ctx, projectID)
+}
+
+// ListProjectMembers mocks base method.
+func (m *MockProjectRepository) ListProjectMembers(ctx context.Context, projectID string) ([]*projectv1.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectMembers", ctx, projectID)
+	ret0, _ := ret[0].([]*projectv1.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectMembers indicates an expected call of ListProjectMembers.
+func (mr *MockProjectRepositoryMockRecorder) ListProjectMembers(ctx, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectRepository)(nil).DeleteProject), projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectMembers", reflect.TypeOf((*MockProjectRepository)(nil).ListProjectMembers), ctx, projectID)
 }
 
 // ListProjects mocks base method.
-func (m *MockProjectRepository) ListProjects() ([]*projectv1.Project, error) {
+func (m *MockProjectRepository) ListProjects(ctx context.Context) ([]*projectv1.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjects")
+	ret := m.ctrl.Call(m, "ListProjects", ctx)
 	ret0, _ := ret[0].([]*projectv1.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProjects indicates an expected call of ListProjects.
-func (mr *MockProjectRepositoryMockRecorder) ListProjects() *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) ListProjects(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectRepository)(nil).ListProjects))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectRepository)(nil).ListProjects), ctx)
+}
+
+// ListProjectsPage mocks base method.
+func (m *MockProjectRepository) ListProjectsPage(ctx context.Context, pageToken string, pageSize int) ([]*projectv1.Project, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsPage", ctx, pageToken, pageSize)
+	ret0, _ := ret[0].([]*projectv1.Project)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListProjectsPage indicates an expected call of ListProjectsPage.
+func (mr *MockProjectRepositoryMockRecorder) ListProjectsPage(ctx, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsPage", reflect.TypeOf((*MockProjectRepository)(nil).ListProjectsPage), ctx, pageToken, pageSize)
+}
+
+// ReadIssueTemplate mocks base method.
+func (m *MockProjectRepository) ReadIssueTemplate(ctx context.Context, templateID string) (*projectv1.IssueTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadIssueTemplate", ctx, templateID)
+	ret0, _ := ret[0].(*projectv1.IssueTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadIssueTemplate indicates an expected call of ReadIssueTemplate.
+func (mr *MockProjectRepositoryMockRecorder) ReadIssueTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadIssueTemplate", reflect.TypeOf((*MockProjectRepository)(nil).ReadIssueTemplate), ctx, templateID)
 }
 
 // ReadProject mocks base method.
-func (m *MockProjectRepository) ReadProject(projectID string) (*projectv1.Project, error) {
+func (m *MockProjectRepository) ReadProject(ctx context.Context, projectID string) (*projectv1.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadProject", projectID)
+	ret := m.ctrl.Call(m, "ReadProject", ctx, projectID)
 	ret0, _ := ret[0].(*projectv1.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadProject indicates an expected call of ReadProject.
-func (mr *MockProjectRepositoryMockRecorder) ReadProject(projectID any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) ReadProject(ctx, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadProject", reflect.TypeOf((*MockProjectRepository)(nil).ReadProject), projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadProject", reflect.TypeOf((*MockProjectRepository)(nil).ReadProject), ctx, projectID)
 }
 
 // RemoveIssueFromProject mocks base method.
-func (m *MockProjectRepository) RemoveIssueFromProject(projectID, issueID string) error {
+func (m *MockProjectRepository) RemoveIssueFromProject(ctx context.Context, projectID, issueID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveIssueFromProject", projectID, issueID)
+	ret := m.ctrl.Call(m, "RemoveIssueFromProject", ctx, projectID, issueID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveIssueFromProject indicates an expected call of RemoveIssueFromProject.
-func (mr *MockProjectRepositoryMockRecorder) RemoveIssueFromProject(projectID, issueID any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) RemoveIssueFromProject(ctx, projectID, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIssueFromProject", reflect.TypeOf((*MockProjectRepository)(nil).RemoveIssueFromProject), ctx, projectID, issueID)
+}
+
+// SetProjectMember mocks base method.
+func (m *MockProjectRepository) SetProjectMember(ctx context.Context, member *projectv1.ProjectMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectMember indicates an expected call of SetProjectMember.
+func (mr *MockProjectRepositoryMockRecorder) SetProjectMember(ctx, member any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIssueFromProject", reflect.TypeOf((*MockProjectRepository)(nil).RemoveIssueFromProject), projectID, issueID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectMember", reflect.TypeOf((*MockProjectRepository)(nil).SetProjectMember), ctx, member)
 }
 
 // UpdateProject mocks base method.
-func (m *MockProjectRepository) UpdateProject(project *projectv1.Project) error {
+func (m *MockProjectRepository) UpdateProject(ctx context.Context, project *projectv1.Project) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProject", project)
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, project)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateProject indicates an expected call of UpdateProject.
-func (mr *MockProjectRepositoryMockRecorder) UpdateProject(project any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) UpdateProject(ctx, project any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectRepository)(nil).UpdateProject), project)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectRepository)(nil).UpdateProject), ctx, project)
 }
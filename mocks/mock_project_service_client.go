@@ -43,6 +43,26 @@ func (m *MockProjectServiceClient) EXPECT() *MockProjectServiceClientMockRecorde
 	return m.recorder
 }
 
+// CreateIssueTemplate mocks base method.
+func (m *MockProjectServiceClient) CreateIssueTemplate(ctx context.Context, in *projectv1.CreateIssueTemplateRequest, opts ...grpc.CallOption) (*projectv1.CreateIssueTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateIssueTemplate", varargs...)
+	ret0, _ := ret[0].(*projectv1.CreateIssueTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssueTemplate indicates an expected call of CreateIssueTemplate.
+func (mr *MockProjectServiceClientMockRecorder) CreateIssueTemplate(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueTemplate", reflect.TypeOf((*MockProjectServiceClient)(nil).CreateIssueTemplate), varargs...)
+}
+
 // CreateProject mocks base method.
 func (m *MockProjectServiceClient) CreateProject(ctx context.Context, in *projectv1.CreateProjectRequest, opts ...grpc.CallOption) (*projectv1.CreateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +83,26 @@ func (mr *MockProjectServiceClientMockRecorder) CreateProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectServiceClient)(nil).CreateProject), varargs...)
 }
 
+// DeleteIssueTemplate mocks base method.
+func (m *MockProjectServiceClient) DeleteIssueTemplate(ctx context.Context, in *projectv1.DeleteIssueTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIssueTemplate", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIssueTemplate indicates an expected call of DeleteIssueTemplate.
+func (mr *MockProjectServiceClientMockRecorder) DeleteIssueTemplate(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueTemplate", reflect.TypeOf((*MockProjectServiceClient)(nil).DeleteIssueTemplate), varargs...)
+}
+
 // DeleteProject mocks base method.
 func (m *MockProjectServiceClient) DeleteProject(ctx context.Context, in *projectv1.DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +123,46 @@ func (mr *MockProjectServiceClientMockRecorder) DeleteProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectServiceClient)(nil).DeleteProject), varargs...)
 }
 
+// FindOrCreateProject mocks base method.
+func (m *MockProjectServiceClient) FindOrCreateProject(ctx context.Context, in *projectv1.FindOrCreateProjectRequest, opts ...grpc.CallOption) (*projectv1.FindOrCreateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindOrCreateProject", varargs...)
+	ret0, _ := ret[0].(*projectv1.FindOrCreateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrCreateProject indicates an expected call of FindOrCreateProject.
+func (mr *MockProjectServiceClientMockRecorder) FindOrCreateProject(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateProject", reflect.TypeOf((*MockProjectServiceClient)(nil).FindOrCreateProject), varargs...)
+}
+
+// GetIssueTemplate mocks base method.
+func (m *MockProjectServiceClient) GetIssueTemplate(ctx context.Context, in *projectv1.GetIssueTemplateRequest, opts ...grpc.CallOption) (*projectv1.GetIssueTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIssueTemplate", varargs...)
+	ret0, _ := ret[0].(*projectv1.GetIssueTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssueTemplate indicates an expected call of GetIssueTemplate.
+func (mr *MockProjectServiceClientMockRecorder) GetIssueTemplate(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssueTemplate", reflect.TypeOf((*MockProjectServiceClient)(nil).GetIssueTemplate), varargs...)
+}
+
 // GetProject mocks base method.
 func (m *MockProjectServiceClient) GetProject(ctx context.Context, in *projectv1.GetProjectRequest, opts ...grpc.CallOption) (*projectv1.GetProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,8 +183,68 @@ func (mr *MockProjectServiceClientMockRecorder) GetProject(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockProjectServiceClient)(nil).GetProject), varargs...)
 }
 
+// GetProjectUpdateSnapshot mocks base method.
+func (m *MockProjectServiceClient) GetProjectUpdateSnapshot(ctx context.Context, in *projectv1.GetProjectUpdateSnapshotRequest, opts ...grpc.CallOption) (*projectv1.ProjectUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectUpdateSnapshot", varargs...)
+	ret0, _ := ret[0].(*projectv1.ProjectUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectUpdateSnapshot indicates an expected call of GetProjectUpdateSnapshot.
+func (mr *MockProjectServiceClientMockRecorder) GetProjectUpdateSnapshot(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectUpdateSnapshot", reflect.TypeOf((*MockProjectServiceClient)(nil).GetProjectUpdateSnapshot), varargs...)
+}
+
+// ListIssueTemplates mocks base method.
+func (m *MockProjectServiceClient) ListIssueTemplates(ctx context.Context, in *projectv1.ListIssueTemplatesRequest, opts ...grpc.CallOption) (*projectv1.ListIssueTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIssueTemplates", varargs...)
+	ret0, _ := ret[0].(*projectv1.ListIssueTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueTemplates indicates an expected call of ListIssueTemplates.
+func (mr *MockProjectServiceClientMockRecorder) ListIssueTemplates(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueTemplates", reflect.TypeOf((*MockProjectServiceClient)(nil).ListIssueTemplates), varargs...)
+}
+
+// ListProjectMembers mocks base method.
+func (m *MockProjectServiceClient) ListProjectMembers(ctx context.Context, in *projectv1.ListProjectMembersRequest, opts ...grpc.CallOption) (*projectv1.ListProjectMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectMembers", varargs...)
+	ret0, _ := ret[0].(*projectv1.ListProjectMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectMembers indicates an expected call of ListProjectMembers.
+func (mr *MockProjectServiceClientMockRecorder) ListProjectMembers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectMembers", reflect.TypeOf((*MockProjectServiceClient)(nil).ListProjectMembers), varargs...)
+}
+
 // ListProjects mocks base method.
-func (m *MockProjectServiceClient) ListProjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*projectv1.ListProjectsResponse, error) {
+func (m *MockProjectServiceClient) ListProjects(ctx context.Context, in *projectv1.ListProjectsRequest, opts ...grpc.CallOption) (*projectv1.ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
@@ -123,6 +263,26 @@ func (mr *MockProjectServiceClientMockRecorder) ListProjects(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectServiceClient)(nil).ListProjects), varargs...)
 }
 
+// SetProjectMemberRole mocks base method.
+func (m *MockProjectServiceClient) SetProjectMemberRole(ctx context.Context, in *projectv1.SetProjectMemberRoleRequest, opts ...grpc.CallOption) (*projectv1.SetProjectMemberRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetProjectMemberRole", varargs...)
+	ret0, _ := ret[0].(*projectv1.SetProjectMemberRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectMemberRole indicates an expected call of SetProjectMemberRole.
+func (mr *MockProjectServiceClientMockRecorder) SetProjectMemberRole(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectMemberRole", reflect.TypeOf((*MockProjectServiceClient)(nil).SetProjectMemberRole), varargs...)
+}
+
 // StreamProjectUpdates mocks base method.
 func (m *MockProjectServiceClient) StreamProjectUpdates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[projectv1.ProjectUpdateRequest, projectv1.ProjectUpdateResponse], error) {
 	m.ctrl.T.Helper()
@@ -207,6 +367,21 @@ func (m *MockProjectServiceServer) EXPECT() *MockProjectServiceServerMockRecorde
 	return m.recorder
 }
 
+// CreateIssueTemplate mocks base method.
+func (m *MockProjectServiceServer) CreateIssueTemplate(arg0 context.Context, arg1 *projectv1.CreateIssueTemplateRequest) (*projectv1.CreateIssueTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*projectv1.CreateIssueTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssueTemplate indicates an expected call of CreateIssueTemplate.
+func (mr *MockProjectServiceServerMockRecorder) CreateIssueTemplate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueTemplate", reflect.TypeOf((*MockProjectServiceServer)(nil).CreateIssueTemplate), arg0, arg1)
+}
+
 // CreateProject mocks base method.
 func (m *MockProjectServiceServer) CreateProject(arg0 context.Context, arg1 *projectv1.CreateProjectRequest) (*projectv1.CreateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +397,21 @@ func (mr *MockProjectServiceServerMockRecorder) CreateProject(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectServiceServer)(nil).CreateProject), arg0, arg1)
 }
 
+// DeleteIssueTemplate mocks base method.
+func (m *MockProjectServiceServer) DeleteIssueTemplate(arg0 context.Context, arg1 *projectv1.DeleteIssueTemplateRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssueTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIssueTemplate indicates an expected call of DeleteIssueTemplate.
+func (mr *MockProjectServiceServerMockRecorder) DeleteIssueTemplate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssueTemplate", reflect.TypeOf((*MockProjectServiceServer)(nil).DeleteIssueTemplate), arg0, arg1)
+}
+
 // DeleteProject mocks base method.
 func (m *MockProjectServiceServer) DeleteProject(arg0 context.Context, arg1 *projectv1.DeleteProjectRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +427,36 @@ func (mr *MockProjectServiceServerMockRecorder) DeleteProject(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectServiceServer)(nil).DeleteProject), arg0, arg1)
 }
 
+// FindOrCreateProject mocks base method.
+func (m *MockProjectServiceServer) FindOrCreateProject(arg0 context.Context, arg1 *projectv1.FindOrCreateProjectRequest) (*projectv1.FindOrCreateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrCreateProject", arg0, arg1)
+	ret0, _ := ret[0].(*projectv1.FindOrCreateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrCreateProject indicates an expected call of FindOrCreateProject.
+func (mr *MockProjectServiceServerMockRecorder) FindOrCreateProject(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateProject", reflect.TypeOf((*MockProjectServiceServer)(nil).FindOrCreateProject), arg0, arg1)
+}
+
+// GetIssueTemplate mocks base method.
+func (m *MockProjectServiceServer) GetIssueTemplate(arg0 context.Context, arg1 *projectv1.GetIssueTemplateRequest) (*projectv1.GetIssueTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssueTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*projectv1.GetIssueTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssueTemplate indicates an expected call of GetIssueTemplate.
+func (mr *MockProjectServiceServerMockRecorder) GetIssueTemplate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssueTemplate", reflect.TypeOf((*MockProjectServiceServer)(nil).GetIssueTemplate), arg0, arg1)
+}
+
 // GetProject mocks base method.
 func (m *MockProjectServiceServer) GetProject(arg0 context.Context, arg1 *projectv1.GetProjectRequest) (*projectv1.GetProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -252,8 +472,53 @@ func (mr *MockProjectServiceServerMockRecorder) GetProject(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockProjectServiceServer)(nil).GetProject), arg0, arg1)
 }
 
+// GetProjectUpdateSnapshot mocks base method.
+func (m *MockProjectServiceServer) GetProjectUpdateSnapshot(arg0 context.Context, arg1 *projectv1.GetProjectUpdateSnapshotRequest) (*projectv1.ProjectUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectUpdateSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*projectv1.ProjectUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectUpdateSnapshot indicates an expected call of GetProjectUpdateSnapshot.
+func (mr *MockProjectServiceServerMockRecorder) GetProjectUpdateSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectUpdateSnapshot", reflect.TypeOf((*MockProjectServiceServer)(nil).GetProjectUpdateSnapshot), arg0, arg1)
+}
+
+// ListIssueTemplates mocks base method.
+func (m *MockProjectServiceServer) ListIssueTemplates(arg0 context.Context, arg1 *projectv1.ListIssueTemplatesRequest) (*projectv1.ListIssueTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssueTemplates", arg0, arg1)
+	ret0, _ := ret[0].(*projectv1.ListIssueTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssueTemplates indicates an expected call of ListIssueTemplates.
+func (mr *MockProjectServiceServerMockRecorder) ListIssueTemplates(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueTemplates", reflect.TypeOf((*MockProjectServiceServer)(nil).ListIssueTemplates), arg0, arg1)
+}
+
+// ListProjectMembers mocks base method.
+func (m *MockProjectServiceServer) ListProjectMembers(arg0 context.Context, arg1 *projectv1.ListProjectMembersRequest) (*projectv1.ListProjectMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectMembers", arg0, arg1)
+	ret0, _ := ret[0].(*projectv1.ListProjectMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectMembers indicates an expected call of ListProjectMembers.
+func (mr *MockProjectServiceServerMockRecorder) ListProjectMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectMembers", reflect.TypeOf((*MockProjectServiceServer)(nil).ListProjectMembers), arg0, arg1)
+}
+
 // ListProjects mocks base method.
-func (m *MockProjectServiceServer) ListProjects(arg0 context.Context, arg1 *emptypb.Empty) (*projectv1.ListProjectsResponse, error) {
+func (m *MockProjectServiceServer) ListProjects(arg0 context.Context, arg1 *projectv1.ListProjectsRequest) (*projectv1.ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListProjects", arg0, arg1)
 	ret0, _ := ret[0].(*projectv1.ListProjectsResponse)
@@ -267,6 +532,21 @@ func (mr *MockProjectServiceServerMockRecorder) ListProjects(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectServiceServer)(nil).ListProjects), arg0, arg1)
 }
 
+// SetProjectMemberRole mocks base method.
+func (m *MockProjectServiceServer) SetProjectMemberRole(arg0 context.Context, arg1 *projectv1.SetProjectMemberRoleRequest) (*projectv1.SetProjectMemberRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectMemberRole", arg0, arg1)
+	ret0, _ := ret[0].(*projectv1.SetProjectMemberRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectMemberRole indicates an expected call of SetProjectMemberRole.
+func (mr *MockProjectServiceServerMockRecorder) SetProjectMemberRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectMemberRole", reflect.TypeOf((*MockProjectServiceServer)(nil).SetProjectMemberRole), arg0, arg1)
+}
+
 // StreamProjectUpdates mocks base method.
 func (m *MockProjectServiceServer) StreamProjectUpdates(arg0 grpc.BidiStreamingServer[projectv1.ProjectUpdateRequest, projectv1.ProjectUpdateResponse]) error {
 	m.ctrl.T.Helper()
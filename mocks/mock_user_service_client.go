@@ -42,6 +42,26 @@ func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
 	return m.recorder
 }
 
+// BatchGetUsers mocks base method.
+func (m *MockUserServiceClient) BatchGetUsers(ctx context.Context, in *userv1.BatchGetUsersRequest, opts ...grpc.CallOption) (*userv1.BatchGetUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetUsers", varargs...)
+	ret0, _ := ret[0].(*userv1.BatchGetUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetUsers indicates an expected call of BatchGetUsers.
+func (mr *MockUserServiceClientMockRecorder) BatchGetUsers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetUsers", reflect.TypeOf((*MockUserServiceClient)(nil).BatchGetUsers), varargs...)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserServiceClient) CreateUser(ctx context.Context, in *userv1.CreateUserRequest, opts ...grpc.CallOption) (*userv1.CreateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -62,6 +82,26 @@ func (mr *MockUserServiceClientMockRecorder) CreateUser(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserServiceClient)(nil).CreateUser), varargs...)
 }
 
+// DeactivateUser mocks base method.
+func (m *MockUserServiceClient) DeactivateUser(ctx context.Context, in *userv1.DeactivateUserRequest, opts ...grpc.CallOption) (*userv1.DeactivateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeactivateUser", varargs...)
+	ret0, _ := ret[0].(*userv1.DeactivateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockUserServiceClientMockRecorder) DeactivateUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockUserServiceClient)(nil).DeactivateUser), varargs...)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUserServiceClient) DeleteUser(ctx context.Context, in *userv1.DeleteUserRequest, opts ...grpc.CallOption) (*userv1.DeleteUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +122,26 @@ func (mr *MockUserServiceClientMockRecorder) DeleteUser(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteUser), varargs...)
 }
 
+// FindOrCreateUser mocks base method.
+func (m *MockUserServiceClient) FindOrCreateUser(ctx context.Context, in *userv1.FindOrCreateUserRequest, opts ...grpc.CallOption) (*userv1.FindOrCreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindOrCreateUser", varargs...)
+	ret0, _ := ret[0].(*userv1.FindOrCreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrCreateUser indicates an expected call of FindOrCreateUser.
+func (mr *MockUserServiceClientMockRecorder) FindOrCreateUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateUser", reflect.TypeOf((*MockUserServiceClient)(nil).FindOrCreateUser), varargs...)
+}
+
 // GetUser mocks base method.
 func (m *MockUserServiceClient) GetUser(ctx context.Context, in *userv1.GetUserRequest, opts ...grpc.CallOption) (*userv1.GetUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +162,26 @@ func (mr *MockUserServiceClientMockRecorder) GetUser(ctx, in any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserServiceClient)(nil).GetUser), varargs...)
 }
 
+// ListInactiveUsers mocks base method.
+func (m *MockUserServiceClient) ListInactiveUsers(ctx context.Context, in *userv1.ListInactiveUsersRequest, opts ...grpc.CallOption) (*userv1.ListInactiveUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInactiveUsers", varargs...)
+	ret0, _ := ret[0].(*userv1.ListInactiveUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInactiveUsers indicates an expected call of ListInactiveUsers.
+func (mr *MockUserServiceClientMockRecorder) ListInactiveUsers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInactiveUsers", reflect.TypeOf((*MockUserServiceClient)(nil).ListInactiveUsers), varargs...)
+}
+
 // ListUsers mocks base method.
 func (m *MockUserServiceClient) ListUsers(ctx context.Context, in *userv1.ListUsersRequest, opts ...grpc.CallOption) (*userv1.ListUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +202,26 @@ func (mr *MockUserServiceClientMockRecorder) ListUsers(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserServiceClient)(nil).ListUsers), varargs...)
 }
 
+// ReactivateUser mocks base method.
+func (m *MockUserServiceClient) ReactivateUser(ctx context.Context, in *userv1.ReactivateUserRequest, opts ...grpc.CallOption) (*userv1.ReactivateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReactivateUser", varargs...)
+	ret0, _ := ret[0].(*userv1.ReactivateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReactivateUser indicates an expected call of ReactivateUser.
+func (mr *MockUserServiceClientMockRecorder) ReactivateUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateUser", reflect.TypeOf((*MockUserServiceClient)(nil).ReactivateUser), varargs...)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserServiceClient) UpdateUser(ctx context.Context, in *userv1.UpdateUserRequest, opts ...grpc.CallOption) (*userv1.UpdateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +266,21 @@ func (m *MockUserServiceServer) EXPECT() *MockUserServiceServerMockRecorder {
 	return m.recorder
 }
 
+// BatchGetUsers mocks base method.
+func (m *MockUserServiceServer) BatchGetUsers(arg0 context.Context, arg1 *userv1.BatchGetUsersRequest) (*userv1.BatchGetUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetUsers", arg0, arg1)
+	ret0, _ := ret[0].(*userv1.BatchGetUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetUsers indicates an expected call of BatchGetUsers.
+func (mr *MockUserServiceServerMockRecorder) BatchGetUsers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetUsers", reflect.TypeOf((*MockUserServiceServer)(nil).BatchGetUsers), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserServiceServer) CreateUser(arg0 context.Context, arg1 *userv1.CreateUserRequest) (*userv1.CreateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +296,21 @@ func (mr *MockUserServiceServerMockRecorder) CreateUser(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserServiceServer)(nil).CreateUser), arg0, arg1)
 }
 
+// DeactivateUser mocks base method.
+func (m *MockUserServiceServer) DeactivateUser(arg0 context.Context, arg1 *userv1.DeactivateUserRequest) (*userv1.DeactivateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", arg0, arg1)
+	ret0, _ := ret[0].(*userv1.DeactivateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockUserServiceServerMockRecorder) DeactivateUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockUserServiceServer)(nil).DeactivateUser), arg0, arg1)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUserServiceServer) DeleteUser(arg0 context.Context, arg1 *userv1.DeleteUserRequest) (*userv1.DeleteUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +326,21 @@ func (mr *MockUserServiceServerMockRecorder) DeleteUser(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserServiceServer)(nil).DeleteUser), arg0, arg1)
 }
 
+// FindOrCreateUser mocks base method.
+func (m *MockUserServiceServer) FindOrCreateUser(arg0 context.Context, arg1 *userv1.FindOrCreateUserRequest) (*userv1.FindOrCreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrCreateUser", arg0, arg1)
+	ret0, _ := ret[0].(*userv1.FindOrCreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrCreateUser indicates an expected call of FindOrCreateUser.
+func (mr *MockUserServiceServerMockRecorder) FindOrCreateUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateUser", reflect.TypeOf((*MockUserServiceServer)(nil).FindOrCreateUser), arg0, arg1)
+}
+
 // GetUser mocks base method.
 func (m *MockUserServiceServer) GetUser(arg0 context.Context, arg1 *userv1.GetUserRequest) (*userv1.GetUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +356,21 @@ func (mr *MockUserServiceServerMockRecorder) GetUser(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserServiceServer)(nil).GetUser), arg0, arg1)
 }
 
+// ListInactiveUsers mocks base method.
+func (m *MockUserServiceServer) ListInactiveUsers(arg0 context.Context, arg1 *userv1.ListInactiveUsersRequest) (*userv1.ListInactiveUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInactiveUsers", arg0, arg1)
+	ret0, _ := ret[0].(*userv1.ListInactiveUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInactiveUsers indicates an expected call of ListInactiveUsers.
+func (mr *MockUserServiceServerMockRecorder) ListInactiveUsers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInactiveUsers", reflect.TypeOf((*MockUserServiceServer)(nil).ListInactiveUsers), arg0, arg1)
+}
+
 // ListUsers mocks base method.
 func (m *MockUserServiceServer) ListUsers(arg0 context.Context, arg1 *userv1.ListUsersRequest) (*userv1.ListUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +386,21 @@ func (mr *MockUserServiceServerMockRecorder) ListUsers(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserServiceServer)(nil).ListUsers), arg0, arg1)
 }
 
+// ReactivateUser mocks base method.
+func (m *MockUserServiceServer) ReactivateUser(arg0 context.Context, arg1 *userv1.ReactivateUserRequest) (*userv1.ReactivateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactivateUser", arg0, arg1)
+	ret0, _ := ret[0].(*userv1.ReactivateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReactivateUser indicates an expected call of ReactivateUser.
+func (mr *MockUserServiceServerMockRecorder) ReactivateUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateUser", reflect.TypeOf((*MockUserServiceServer)(nil).ReactivateUser), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserServiceServer) UpdateUser(arg0 context.Context, arg1 *userv1.UpdateUserRequest) (*userv1.UpdateUserResponse, error) {
 	m.ctrl.T.Helper()
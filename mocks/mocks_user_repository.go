@@ -10,9 +10,12 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	userv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	usersvc "github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,53 +43,114 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BatchGetUsers mocks base method.
+func (m *MockUserRepository) BatchGetUsers(ctx context.Context, userIDs []string) ([]*userv1.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetUsers", ctx, userIDs)
+	ret0, _ := ret[0].([]*userv1.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetUsers indicates an expected call of BatchGetUsers.
+func (mr *MockUserRepositoryMockRecorder) BatchGetUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetUsers", reflect.TypeOf((*MockUserRepository)(nil).BatchGetUsers), ctx, userIDs)
+}
+
 // CreateUser mocks base method.
-func (m *MockUserRepository) CreateUser(user *userv1.User) error {
+func (m *MockUserRepository) CreateUser(ctx context.Context, user *userv1.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", user)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateUser indicates an expected call of CreateUser.
-func (mr *MockUserRepositoryMockRecorder) CreateUser(user any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), ctx, user)
 }
 
 // DeleteUser mocks base method.
-func (m *MockUserRepository) DeleteUser(userID string) error {
+func (m *MockUserRepository) DeleteUser(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", userID)
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockUserRepositoryMockRecorder) DeleteUser(userID any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserRepository)(nil).DeleteUser), ctx, userID)
+}
+
+// FindOrCreateUser mocks base method.
+func (m *MockUserRepository) FindOrCreateUser(ctx context.Context, user *userv1.User) (*userv1.User, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrCreateUser", ctx, user)
+	ret0, _ := ret[0].(*userv1.User)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindOrCreateUser indicates an expected call of FindOrCreateUser.
+func (mr *MockUserRepositoryMockRecorder) FindOrCreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateUser", reflect.TypeOf((*MockUserRepository)(nil).FindOrCreateUser), ctx, user)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockUserRepository) GetUserByEmail(ctx context.Context, email string) (*userv1.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*userv1.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUserRepositoryMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserRepository)(nil).DeleteUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepository)(nil).GetUserByEmail), ctx, email)
 }
 
 // GetUserByID mocks base method.
-func (m *MockUserRepository) GetUserByID(userID string) (*userv1.User, error) {
+func (m *MockUserRepository) GetUserByID(ctx context.Context, userID string) (*userv1.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByID", userID)
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
 	ret0, _ := ret[0].(*userv1.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByID indicates an expected call of GetUserByID.
-func (mr *MockUserRepositoryMockRecorder) GetUserByID(userID any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) GetUserByID(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), ctx, userID)
+}
+
+// ListInactiveUsers mocks base method.
+func (m *MockUserRepository) ListInactiveUsers(ctx context.Context, inactiveSince time.Time) ([]*userv1.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInactiveUsers", ctx, inactiveSince)
+	ret0, _ := ret[0].([]*userv1.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInactiveUsers indicates an expected call of ListInactiveUsers.
+func (mr *MockUserRepositoryMockRecorder) ListInactiveUsers(ctx, inactiveSince any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInactiveUsers", reflect.TypeOf((*MockUserRepository)(nil).ListInactiveUsers), ctx, inactiveSince)
 }
 
 // ListUsers mocks base method.
-func (m *MockUserRepository) ListUsers(pageToken string, pageSize int) ([]*userv1.User, string, error) {
+func (m *MockUserRepository) ListUsers(ctx context.Context, pageToken string, pageSize int, opts usersvc.ListUsersOptions) ([]*userv1.User, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", pageToken, pageSize)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, pageToken, pageSize, opts)
 	ret0, _ := ret[0].([]*userv1.User)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -94,21 +158,50 @@ func (m *MockUserRepository) ListUsers(pageToken string, pageSize int) ([]*userv
 }
 
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockUserRepositoryMockRecorder) ListUsers(pageToken, pageSize any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) ListUsers(ctx, pageToken, pageSize, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepository)(nil).ListUsers), ctx, pageToken, pageSize, opts)
+}
+
+// SetUserActive mocks base method.
+func (m *MockUserRepository) SetUserActive(ctx context.Context, userID string, active bool) (*userv1.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserActive", ctx, userID, active)
+	ret0, _ := ret[0].(*userv1.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserActive indicates an expected call of SetUserActive.
+func (mr *MockUserRepositoryMockRecorder) SetUserActive(ctx, userID, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserActive", reflect.TypeOf((*MockUserRepository)(nil).SetUserActive), ctx, userID, active)
+}
+
+// UpdateLastActive mocks base method.
+func (m *MockUserRepository) UpdateLastActive(ctx context.Context, userID string, lastActive time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastActive", ctx, userID, lastActive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastActive indicates an expected call of UpdateLastActive.
+func (mr *MockUserRepositoryMockRecorder) UpdateLastActive(ctx, userID, lastActive any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepository)(nil).ListUsers), pageToken, pageSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastActive", reflect.TypeOf((*MockUserRepository)(nil).UpdateLastActive), ctx, userID, lastActive)
 }
 
 // UpdateUser mocks base method.
-func (m *MockUserRepository) UpdateUser(user *userv1.User) error {
+func (m *MockUserRepository) UpdateUser(ctx context.Context, user *userv1.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", user)
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockUserRepositoryMockRecorder) UpdateUser(user any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) UpdateUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), ctx, user)
 }
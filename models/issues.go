@@ -8,18 +8,42 @@ import (
 	"gorm.io/gorm"
 )
 
-// Issues represents the database schema for the Issue entity
+// Issues represents the database schema for the Issue entity. idx_issues_project_created
+// serves a project's issues in the creation order ListIssuesByProject pages through.
 type Issues struct {
-	IssueID     string         `gorm:"type:uuid;primaryKey"` // Unique identifier for the issue
-	Summary     string         `gorm:"size:100;not null"`    // Short summary of the issue
-	Description string         `gorm:"size:500"`             // Detailed description of the issue
-	Status      string         `gorm:"size:50;not null"`     // Status of the issue (e.g., NEW, ASSIGNED)
-	Resolution  string         `gorm:"size:50"`              // Resolution status (e.g., FIXED, INVALID)
-	Type        string         `gorm:"size:50;not null"`     // Type of the issue (e.g., BUG, FEATURE)
-	Priority    string         `gorm:"size:50;not null"`     // Priority level (e.g., CRITICAL, MINOR)
-	ProjectID   string         `gorm:"type:uuid;not null"`   // Associated project ID
-	AssigneeID  *string        `gorm:"type:uuid"`            // ID of the assigned user (nullable)
-	CreateDate  time.Time      `gorm:"autoCreateTime"`       // Timestamp when the issue was created
-	ModifyDate  time.Time      `gorm:"autoUpdateTime"`       // Timestamp when the issue was last modified
-	DeletedAt   gorm.DeletedAt `gorm:"index"`                // Soft delete field
+	IssueID              string         `gorm:"type:uuid;primaryKey;index:idx_issues_project_created,priority:3"`                               // Unique identifier for the issue
+	Summary              string         `gorm:"type:text;not null"`                                                                             // Short summary of the issue; its length is limited by the service
+	Description          string         `gorm:"type:text"`                                                                                      // Detailed description of the issue
+	Status               string         `gorm:"size:50;not null;index"`                                                                         // Status of the issue (e.g., NEW, ASSIGNED)
+	Resolution           string         `gorm:"size:50"`                                                                                        // Resolution status (e.g., FIXED, INVALID)
+	Type                 string         `gorm:"size:50;not null"`                                                                               // Type of the issue (e.g., BUG, FEATURE)
+	Priority             string         `gorm:"size:50;not null"`                                                                               // Priority level (e.g., CRITICAL, MINOR)
+	ProjectID            string         `gorm:"type:uuid;not null;index:idx_issues_project_number;index:idx_issues_project_created,priority:1"` // Associated project ID; both indexes lead with it
+	IssueNumber          int32          `gorm:"not null;default:0;index:idx_issues_project_number"`                                             // Sequential number of the issue within its project
+	AssigneeID           *string        `gorm:"type:uuid;index"`                                                                                // ID of the assigned user (nullable)
+	DuplicateOf          *string        `gorm:"type:uuid"`                                                                                      // ID of the issue this one duplicates (nullable)
+	ClonedFrom           *string        `gorm:"type:uuid"`                                                                                      // ID of the issue this one was cloned from (nullable)
+	TeamID               *string        `gorm:"type:uuid;index"`                                                                                // ID of the team the issue was created for (nullable)
+	ResolutionComment    string         `gorm:"size:500"`                                                                                       // Explanation recorded with the resolution
+	ReopenCount          int32          `gorm:"not null;default:0"`                                                                             // Number of times the issue has been reopened
+	LastReopenReason     string         `gorm:"size:500"`                                                                                       // Reason given when the issue was last reopened
+	LastReopenedBy       *string        `gorm:"type:uuid"`                                                                                      // ID of the user who last reopened the issue (nullable)
+	ResolveDate          *time.Time     `gorm:"index"`                                                                                          // Timestamp when the issue was first resolved or closed (nullable)
+	DueDate              *time.Time     `gorm:"index"`                                                                                          // Deadline the assignee is reminded of (nullable)
+	Version              int64          `gorm:"not null;default:1"`                                                                             // Incremented on every update for optimistic locking
+	CreatedByUserID      *string        `gorm:"type:uuid"`                                                                                      // ID of the user who created the issue (nullable)
+	LastModifiedByUserID *string        `gorm:"type:uuid"`                                                                                      // ID of the user who last modified the issue (nullable)
+	CreateDate           time.Time      `gorm:"autoCreateTime;index:idx_issues_project_created,priority:2"`                                     // Timestamp when the issue was created
+	ModifyDate           time.Time      `gorm:"autoUpdateTime"`                                                                                 // Timestamp when the issue was last modified
+	DeletedAt            gorm.DeletedAt `gorm:"index"`                                                                                          // Soft delete field
+}
+
+// IssueLink represents the database schema for a typed relation between two issues. Each link is
+// stored once; the inverse relation is derived when listing the links of the target issue.
+type IssueLink struct {
+	LinkID        string    `gorm:"type:uuid;primaryKey"`     // Unique identifier for the link
+	SourceIssueID string    `gorm:"type:uuid;not null;index"` // Issue the relation is stated from
+	TargetIssueID string    `gorm:"type:uuid;not null;index"` // Issue the relation points to
+	Type          string    `gorm:"size:50;not null"`         // Relation type (e.g., BLOCKS, RELATES_TO)
+	CreateDate    time.Time `gorm:"autoCreateTime"`           // Timestamp when the link was created
 }
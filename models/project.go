@@ -4,9 +4,35 @@ import "gorm.io/gorm"
 
 // Project represents the database schema for the Project entity
 type Project struct {
-	ProjectID   string         `gorm:"type:uuid;primaryKey"` // Unique identifier for the project
-	Name        string         `gorm:"size:100;not null"`    // Name of the project
-	Description string         `gorm:"size:1000"`            // Detailed description of the project
-	IssueCount  int32          `gorm:"default:0"`            // Number of issues associated with the project
-	DeletedAt   gorm.DeletedAt `gorm:"index"`                // Soft delete field
+	ProjectID   string         `gorm:"type:uuid;primaryKey"`                                                     // Unique identifier for the project
+	Name        string         `gorm:"size:100;not null;uniqueIndex:idx_projects_name,where:deleted_at IS NULL"` // Name of the project, unique among projects that aren't deleted
+	Description string         `gorm:"size:1000"`                                                                // Detailed description of the project
+	IssueCount  int32          `gorm:"default:0"`                                                                // Number of issues associated with the project
+	MaxIssues   int32          `gorm:"not null;default:0"`                                                       // Most issues the project may have; 0 means unlimited
+	DeletedAt   gorm.DeletedAt `gorm:"index"`                                                                    // Soft delete field
+}
+
+// IssueTemplate represents the database schema for templates that prefill new issues in a project
+type IssueTemplate struct {
+	TemplateID          string `gorm:"type:uuid;primaryKey"`     // Unique identifier for the template
+	ProjectID           string `gorm:"type:uuid;not null;index"` // Project the template belongs to
+	Name                string `gorm:"size:100;not null"`        // Name of the template
+	DefaultType         string `gorm:"size:50"`                  // Issue type applied when the request has none
+	DefaultPriority     string `gorm:"size:50"`                  // Issue priority applied when the request has none
+	SummaryPrefix       string `gorm:"size:50"`                  // Prepended to the summary of new issues
+	DescriptionTemplate string `gorm:"type:text"`                // Description applied when the request has none
+}
+
+// ProjectMember represents the database schema for a user's role in a project
+type ProjectMember struct {
+	ProjectID string `gorm:"type:uuid;primaryKey"`       // Project the user is a member of
+	UserID    string `gorm:"type:uuid;primaryKey;index"` // Member of the project
+	Role      string `gorm:"size:50;not null"`           // Role of the user in the project (e.g., DEVELOPER, VIEWER)
+}
+
+// ProjectIssue represents the database schema for an issue's association with a project; a
+// project's issue count is the number of its rows
+type ProjectIssue struct {
+	ProjectID string `gorm:"type:uuid;primaryKey"`       // Project the issue is associated with
+	IssueID   string `gorm:"type:uuid;primaryKey;index"` // Issue associated with the project
 }
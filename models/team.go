@@ -0,0 +1,16 @@
+package models
+
+// Team represents the database schema for a group of a project's users that issues can be
+// assigned to in turn
+type Team struct {
+	TeamID    string `gorm:"type:uuid;primaryKey"`     // Unique identifier for the team
+	ProjectID string `gorm:"type:uuid;not null;index"` // Project the team belongs to
+	Name      string `gorm:"size:100;not null"`        // Name of the team
+}
+
+// TeamMember represents the database schema for a user's membership of a team
+type TeamMember struct {
+	TeamID   string `gorm:"type:uuid;primaryKey"`       // Team the user is a member of
+	UserID   string `gorm:"type:uuid;primaryKey;index"` // Member of the team
+	Position int    `gorm:"not null"`                   // Order of the member in the team, which issues are assigned in
+}
@@ -1,6 +1,10 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 // User schema reflecting the protobuf message
 type User struct {
@@ -8,5 +12,8 @@ type User struct {
 	FirstName    string         `gorm:"size:50;not null"`         // First name of the user
 	LastName     string         `gorm:"size:50;not null"`         // Last name of the user
 	EmailAddress string         `gorm:"size:255;unique;not null"` // Email address of the user
+	LastActiveAt *time.Time     `gorm:"index"`                    // When the user was last fetched
+	Active       bool           `gorm:"not null;default:true"`    // Cleared when the user is deactivated
+	CreatedAt    time.Time      `gorm:"autoCreateTime;index"`     // When the user was created; users are listed in creation order
 	DeletedAt    gorm.DeletedAt `gorm:"index"`                    // Soft delete field
 }
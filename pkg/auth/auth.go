@@ -0,0 +1,26 @@
+// Package auth carries the identity of the user a call is made for through the context, so the
+// layers below the API can record who made a change without it being passed to every call
+package auth
+
+import "context"
+
+// contextKey is the type of the keys this package stores in a context
+type contextKey string
+
+// actorIDContextKey is the context key holding the ID of the user making the call
+const actorIDContextKey = contextKey("actor_id")
+
+// WithActorID returns a copy of ctx carrying the ID of the user making the call
+func WithActorID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, actorIDContextKey, userID)
+}
+
+// ActorIDFromContext returns the ID of the user making the call, or an empty string for calls
+// made without one, such as those of the seeder and background jobs
+func ActorIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	userID, _ := ctx.Value(actorIDContextKey).(string)
+	return userID
+}
@@ -0,0 +1,18 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yasindce1998/issue-tracker/pkg/auth"
+)
+
+func TestActorIDFromContext(t *testing.T) {
+	assert.Empty(t, auth.ActorIDFromContext(context.Background()))
+
+	ctx := auth.WithActorID(context.Background(), "b38f705f-0efa-4c96-b2f6-ceb36281e1f3")
+	assert.Equal(t, "b38f705f-0efa-4c96-b2f6-ceb36281e1f3", auth.ActorIDFromContext(ctx))
+	assert.Empty(t, auth.ActorIDFromContext(context.WithValue(context.Background(), "actor_id", "someone")), "only the package's key is read")
+}
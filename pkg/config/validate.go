@@ -0,0 +1,184 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+// Defaults of the optional settings, matching those applied where the settings are used
+const (
+	defaultCacheType           = "redis"
+	defaultRedisAddr           = "localhost:6379"
+	defaultCommunicationMethod = "stream"
+	defaultKafkaBrokers        = "localhost:9092"
+	defaultKafkaTopicPrefix    = "issue-tracker"
+)
+
+// Config holds the settings checked at startup
+type Config struct {
+	GRPCPort string
+	HTTPPort string
+	// GRPCHost is where the issue service reaches the project and user services
+	GRPCHost        string
+	UseLocalClients bool
+
+	DBType           string
+	PostgresHost     string
+	PostgresPort     string
+	PostgresUser     string
+	PostgresPassword string
+	PostgresDB       string
+
+	CacheType     string
+	RedisAddr     string
+	RedisPassword string
+	// CacheTTLs is how long each kind of entity stays cached; zero turns caching it off
+	CacheTTLs cache.CacheConfig
+
+	CommunicationMethod string
+	KafkaBrokers        string
+	KafkaTopicPrefix    string
+}
+
+// ValidationError lists every required environment variable that is missing and every
+// setting with a value that isn't supported
+type ValidationError struct {
+	Missing []string
+	Invalid []string
+}
+
+func (e *ValidationError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing required environment variables: "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Invalid) > 0 {
+		problems = append(problems, "invalid environment variables: "+strings.Join(e.Invalid, ", "))
+	}
+	return strings.Join(problems, "; ")
+}
+
+// FromEnv reads the configuration from the environment. Optional settings that are unset get
+// their defaults, with a warning for those that usually need to be set outside development.
+func FromEnv() *Config {
+	cfg := &Config{
+		GRPCPort:            os.Getenv("GRPC_PORT"),
+		HTTPPort:            os.Getenv("HTTP_PORT"),
+		GRPCHost:            os.Getenv("GRPC_HOST"),
+		UseLocalClients:     os.Getenv("USE_LOCAL_CLIENTS") == "true",
+		DBType:              os.Getenv("DB_TYPE"),
+		PostgresHost:        os.Getenv("POSTGRES_HOST"),
+		PostgresPort:        os.Getenv("POSTGRES_PORT"),
+		PostgresUser:        os.Getenv("POSTGRES_USER"),
+		PostgresPassword:    os.Getenv("POSTGRES_PASSWORD"),
+		PostgresDB:          os.Getenv("POSTGRES_DB"),
+		CacheType:           os.Getenv("CACHE_TYPE"),
+		RedisAddr:           os.Getenv("REDIS_ADDR"),
+		RedisPassword:       os.Getenv("REDIS_PASSWORD"),
+		CacheTTLs:           cache.CacheConfigFromEnv(),
+		CommunicationMethod: strings.ToLower(os.Getenv("COMMUNICATION_METHOD")),
+		KafkaBrokers:        os.Getenv("KAFKA_BROKERS"),
+		KafkaTopicPrefix:    os.Getenv("KAFKA_TOPIC_PREFIX"),
+	}
+
+	// Only in-memory storage with USE_LOCAL_CLIENTS runs without clients of the other services
+	if cfg.GRPCHost == "" && (cfg.DBType != "memdb" || !cfg.UseLocalClients) {
+		logger.ZapLogger.Warn("GRPC_HOST is not set; the project and user service clients connect to localhost")
+	}
+
+	if _, set := os.LookupEnv("CACHE_TYPE"); !set {
+		cfg.CacheType = defaultCacheType
+		warnDefault("CACHE_TYPE", defaultCacheType)
+		if cfg.RedisAddr == "" {
+			cfg.RedisAddr = defaultRedisAddr
+			warnDefault("REDIS_ADDR", defaultRedisAddr)
+		}
+	}
+	if cfg.CacheType == "redis" && cfg.RedisAddr != "" && cfg.RedisPassword == "" {
+		logger.ZapLogger.Warn("REDIS_PASSWORD is not set; connecting to Redis without a password")
+	}
+
+	if cfg.CommunicationMethod == "" {
+		cfg.CommunicationMethod = defaultCommunicationMethod
+	}
+	if cfg.CommunicationMethod == "kafka" {
+		if cfg.KafkaBrokers == "" {
+			cfg.KafkaBrokers = defaultKafkaBrokers
+			warnDefault("KAFKA_BROKERS", defaultKafkaBrokers)
+		}
+		if cfg.KafkaTopicPrefix == "" {
+			cfg.KafkaTopicPrefix = defaultKafkaTopicPrefix
+			warnDefault("KAFKA_TOPIC_PREFIX", defaultKafkaTopicPrefix)
+		}
+	}
+
+	return cfg
+}
+
+func warnDefault(name, value string) {
+	logger.ZapLogger.Warn("Environment variable not set; using the default",
+		zap.String("name", name),
+		zap.String("default", value))
+}
+
+// Validate checks that every environment variable the configuration depends on is set. It
+// returns a *ValidationError listing all of the problems rather than stopping at the first.
+func Validate(cfg *Config) error {
+	verr := &ValidationError{}
+	require := func(name, value string) {
+		if strings.TrimSpace(value) == "" {
+			verr.Missing = append(verr.Missing, name)
+		}
+	}
+
+	require("GRPC_PORT", cfg.GRPCPort)
+	require("HTTP_PORT", cfg.HTTPPort)
+
+	switch cfg.DBType {
+	case "":
+		require("DB_TYPE", cfg.DBType)
+	case "postgres":
+		require("POSTGRES_HOST", cfg.PostgresHost)
+		require("POSTGRES_PORT", cfg.PostgresPort)
+		require("POSTGRES_USER", cfg.PostgresUser)
+		require("POSTGRES_PASSWORD", cfg.PostgresPassword)
+		require("POSTGRES_DB", cfg.PostgresDB)
+	case "sqlite", "memdb":
+	default:
+		verr.Invalid = append(verr.Invalid, fmt.Sprintf("DB_TYPE=%q (want postgres, sqlite or memdb)", cfg.DBType))
+	}
+
+	if cfg.CacheType == "redis" {
+		require("REDIS_ADDR", cfg.RedisAddr)
+	}
+	// Unset entity TTLs take the value of CACHE_TTL, so a negative CACHE_TTL is reported for each of them
+	ttls := []struct {
+		name string
+		ttl  time.Duration
+	}{
+		{"CACHE_TTL_ISSUE_SECONDS", cfg.CacheTTLs.IssueTTL},
+		{"CACHE_TTL_ISSUE_LIST_SECONDS", cfg.CacheTTLs.IssueListTTL},
+		{"CACHE_TTL_USER_SECONDS", cfg.CacheTTLs.UserTTL},
+		{"CACHE_TTL_USER_LIST_SECONDS", cfg.CacheTTLs.UserListTTL},
+		{"CACHE_TTL_PROJECT_SECONDS", cfg.CacheTTLs.ProjectTTL},
+		{"CACHE_TTL_PROJECT_LIST_SECONDS", cfg.CacheTTLs.ProjectListTTL},
+		{"CACHE_TTL_ISSUE_RESPONSE_SECONDS", cfg.CacheTTLs.IssueResponseTTL},
+	}
+	for _, ttl := range ttls {
+		if ttl.ttl < 0 {
+			verr.Invalid = append(verr.Invalid, fmt.Sprintf("%s=%d (want 0 or more seconds; 0 turns caching off)", ttl.name, int64(ttl.ttl/time.Second)))
+		}
+	}
+
+	if len(verr.Missing) > 0 || len(verr.Invalid) > 0 {
+		return verr
+	}
+	return nil
+}
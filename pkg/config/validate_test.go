@@ -0,0 +1,99 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/config"
+)
+
+// completeEnv sets every variable a Postgres and Redis deployment needs
+func completeEnv() map[string]string {
+	return map[string]string{
+		"GRPC_PORT":         "50052",
+		"HTTP_PORT":         "8080",
+		"GRPC_HOST":         "app",
+		"DB_TYPE":           "postgres",
+		"POSTGRES_HOST":     "postgres",
+		"POSTGRES_PORT":     "5432",
+		"POSTGRES_USER":     "postgres",
+		"POSTGRES_PASSWORD": "postgres",
+		"POSTGRES_DB":       "issue_tracker",
+		"CACHE_TYPE":        "redis",
+		"REDIS_ADDR":        "redis:6379",
+		"REDIS_PASSWORD":    "secret",
+	}
+}
+
+func TestValidate(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	testCases := []struct {
+		name            string
+		overrides       map[string]string
+		expectedMissing []string
+		expectedInvalid int
+	}{
+		{name: "all vars set"},
+		{name: "missing GRPC_PORT", overrides: map[string]string{"GRPC_PORT": ""}, expectedMissing: []string{"GRPC_PORT"}},
+		{name: "missing POSTGRES_HOST with postgres", overrides: map[string]string{"POSTGRES_HOST": ""}, expectedMissing: []string{"POSTGRES_HOST"}},
+		{name: "missing POSTGRES_HOST with sqlite", overrides: map[string]string{"DB_TYPE": "sqlite", "POSTGRES_HOST": ""}},
+		{name: "missing REDIS_ADDR with redis", overrides: map[string]string{"REDIS_ADDR": ""}, expectedMissing: []string{"REDIS_ADDR"}},
+		{name: "missing REDIS_ADDR with memory cache", overrides: map[string]string{"CACHE_TYPE": "memory", "REDIS_ADDR": ""}},
+		{name: "blank value", overrides: map[string]string{"HTTP_PORT": "  "}, expectedMissing: []string{"HTTP_PORT"}},
+		{
+			name:            "every missing var is listed",
+			overrides:       map[string]string{"GRPC_PORT": "", "HTTP_PORT": "", "POSTGRES_USER": "", "POSTGRES_DB": ""},
+			expectedMissing: []string{"GRPC_PORT", "HTTP_PORT", "POSTGRES_USER", "POSTGRES_DB"},
+		},
+		{name: "missing DB_TYPE", overrides: map[string]string{"DB_TYPE": ""}, expectedMissing: []string{"DB_TYPE"}},
+		{name: "unknown DB_TYPE", overrides: map[string]string{"DB_TYPE": "mysql"}, expectedInvalid: 1},
+		{name: "negative cache TTL", overrides: map[string]string{"CACHE_TTL_USER_SECONDS": "-5"}, expectedInvalid: 1},
+		{name: "zero cache TTL turns caching off", overrides: map[string]string{"CACHE_TTL": "0"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			env := completeEnv()
+			for name, value := range tc.overrides {
+				env[name] = value
+			}
+			for name, value := range env {
+				t.Setenv(name, value)
+			}
+
+			err := config.Validate(config.FromEnv())
+			if tc.expectedMissing == nil && tc.expectedInvalid == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var verr *config.ValidationError
+			require.True(t, errors.As(err, &verr), "unexpected error %v", err)
+			assert.Equal(t, tc.expectedMissing, verr.Missing)
+			assert.Len(t, verr.Invalid, tc.expectedInvalid)
+			for _, name := range tc.expectedMissing {
+				assert.Contains(t, err.Error(), name)
+			}
+		})
+	}
+}
+
+func TestFromEnv_Defaults(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	for name, value := range completeEnv() {
+		t.Setenv(name, value)
+	}
+	t.Setenv("COMMUNICATION_METHOD", "kafka")
+	t.Setenv("KAFKA_BROKERS", "")
+
+	cfg := config.FromEnv()
+	assert.Equal(t, "kafka", cfg.CommunicationMethod)
+	assert.Equal(t, "localhost:9092", cfg.KafkaBrokers)
+	assert.Equal(t, "issue-tracker", cfg.KafkaTopicPrefix)
+}
@@ -0,0 +1,84 @@
+// Package grpcpool spreads outbound gRPC calls over several client connections.
+// A single connection multiplexes every call over one HTTP/2 transport, which becomes
+// a bottleneck under high load; a pool of connections removes that limit.
+package grpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+)
+
+// defaultPoolSize is used when GRPC_POOL_SIZE is not set
+const defaultPoolSize = 5
+
+// PoolSizeFromEnv reads the number of pooled connections from GRPC_POOL_SIZE
+func PoolSizeFromEnv() int {
+	if v, err := strconv.Atoi(os.Getenv("GRPC_POOL_SIZE")); err == nil && v > 0 {
+		return v
+	}
+	return defaultPoolSize
+}
+
+// ConnectionPool holds a fixed number of connections to the same target and hands
+// them out round-robin. It implements grpc.ClientConnInterface, so generated clients
+// built on the pool pick a connection for every call.
+type ConnectionPool struct {
+	conns []*grpc.ClientConn
+	next  atomic.Uint64
+}
+
+// New creates a pool of size connections to target. A size below one is treated as one.
+func New(target string, size int, opts ...grpc.DialOption) (*ConnectionPool, error) {
+	if size < 1 {
+		size = 1
+	}
+
+	pool := &ConnectionPool{conns: make([]*grpc.ClientConn, 0, size)}
+	for i := 0; i < size; i++ {
+		conn, err := grpc.NewClient(target, opts...)
+		if err != nil {
+			_ = pool.Close()
+			return nil, fmt.Errorf("failed to create pooled gRPC connection: %w", err)
+		}
+		pool.conns = append(pool.conns, conn)
+	}
+	return pool, nil
+}
+
+// Get returns the next connection in round-robin order
+func (p *ConnectionPool) Get() *grpc.ClientConn {
+	n := p.next.Add(1) - 1
+	return p.conns[n%uint64(len(p.conns))]
+}
+
+// Size returns the number of connections in the pool
+func (p *ConnectionPool) Size() int {
+	return len(p.conns)
+}
+
+// Invoke performs a unary RPC on the next connection in the pool
+func (p *ConnectionPool) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return p.Get().Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream opens a stream on the next connection in the pool
+func (p *ConnectionPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return p.Get().NewStream(ctx, desc, method, opts...)
+}
+
+// Close closes every connection in the pool
+func (p *ConnectionPool) Close() error {
+	var errs []error
+	for _, conn := range p.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
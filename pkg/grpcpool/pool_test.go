@@ -0,0 +1,106 @@
+package grpcpool_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/yasindce1998/issue-tracker/pkg/grpcpool"
+)
+
+// newPool starts a health server on an in-memory listener and returns a pool connected to it
+func newPool(tb testing.TB, size int) *grpcpool.ConnectionPool {
+	tb.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(lis) }()
+	tb.Cleanup(server.Stop)
+
+	pool, err := grpcpool.New("passthrough:///bufnet", size,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(tb, err)
+	tb.Cleanup(func() { _ = pool.Close() })
+	return pool
+}
+
+func TestPoolSizeFromEnv(t *testing.T) {
+	t.Setenv("GRPC_POOL_SIZE", "")
+	assert.Equal(t, 5, grpcpool.PoolSizeFromEnv())
+
+	t.Setenv("GRPC_POOL_SIZE", "8")
+	assert.Equal(t, 8, grpcpool.PoolSizeFromEnv())
+
+	t.Setenv("GRPC_POOL_SIZE", "0")
+	assert.Equal(t, 5, grpcpool.PoolSizeFromEnv())
+}
+
+func TestConnectionPool_RoundRobin(t *testing.T) {
+	pool := newPool(t, 3)
+	require.Equal(t, 3, pool.Size())
+
+	first := []*grpc.ClientConn{pool.Get(), pool.Get(), pool.Get()}
+	assert.NotSame(t, first[0], first[1])
+	assert.NotSame(t, first[1], first[2])
+	assert.NotSame(t, first[0], first[2])
+
+	// The next round hands out the same connections in the same order
+	for _, conn := range first {
+		assert.Same(t, conn, pool.Get())
+	}
+}
+
+func TestConnectionPool_ServesGeneratedClients(t *testing.T) {
+	pool := newPool(t, 2)
+	client := healthpb.NewHealthClient(pool)
+
+	for i := 0; i < 4; i++ {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	}
+}
+
+func TestConnectionPool_Close(t *testing.T) {
+	pool := newPool(t, 2)
+	conns := []*grpc.ClientConn{pool.Get(), pool.Get()}
+
+	require.NoError(t, pool.Close())
+	for _, conn := range conns {
+		assert.Equal(t, connectivity.Shutdown, conn.GetState())
+	}
+}
+
+// BenchmarkConnectionPool compares the throughput of concurrent calls over a single
+// connection with pools of several connections
+func BenchmarkConnectionPool(b *testing.B) {
+	for _, size := range []int{1, 2, 5} {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			client := healthpb.NewHealthClient(newPool(b, size))
+			ctx := context.Background()
+
+			b.SetParallelism(16)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package i18n
+
+import "regexp"
+
+// Supported locales. Messages for locales without a catalog are given in English.
+const (
+	English = "en"
+	Turkish = "tr"
+)
+
+// rule recognizes one kind of protoc-gen-validate failure by its reason. The submatches of
+// pattern are the rule's parameters, such as the bounds of a length check, and fill in the
+// localized message.
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// rules lists the validation rules with localized messages. Their names are reported as the
+// reason of each field violation, so clients can tell the rules apart without parsing messages.
+var rules = []rule{
+	{"UUID", regexp.MustCompile(`^value must be a valid UUID$`)},
+	{"EMAIL", regexp.MustCompile(`^value must be a valid email address$`)},
+	{"REQUIRED", regexp.MustCompile(`^value is required$`)},
+	{"DEFINED_ENUM", regexp.MustCompile(`^value must be one of the defined enum values$`)},
+	{"PATTERN", regexp.MustCompile(`^value does not match regex pattern (".*")$`)},
+	{"LENGTH_BETWEEN", regexp.MustCompile(`^value length must be between (\d+) and (\d+) runes, inclusive$`)},
+	{"MAX_LENGTH", regexp.MustCompile(`^value length must be at most (\d+) runes$`)},
+	{"MIN_LENGTH", regexp.MustCompile(`^value length must be at least (\d+) runes$`)},
+	{"IN", regexp.MustCompile(`^value must be in list \[(.*)\]$`)},
+	{"NOT_IN", regexp.MustCompile(`^value must not be in list \[(.*)\]$`)},
+	{"GREATER_THAN", regexp.MustCompile(`^value must be greater than (\S+)$`)},
+	{"RANGE", regexp.MustCompile(`^value must be inside range \[(\S+), (\S+)\]$`)},
+	{"ITEMS_BETWEEN", regexp.MustCompile(`^value must contain between (\d+) and (\d+) items, inclusive$`)},
+	{"UNIQUE_ITEMS", regexp.MustCompile(`^repeated value must contain unique items$`)},
+	{"EMBEDDED_MESSAGE", regexp.MustCompile(`^embedded message failed validation$`)},
+}
+
+// Keys of the messages that wrap the message of a rule
+const (
+	invalidRequestKey = "INVALID_REQUEST"
+	invalidFieldKey   = "INVALID_FIELD"
+	mapKeyKey         = "MAP_KEY"
+	causedByKey       = "CAUSED_BY"
+)
+
+// catalog holds the messages of each locale as fmt formats, keyed by rule name. The English
+// messages match those protoc-gen-validate generates.
+var catalog = map[string]map[string]string{
+	English: {
+		invalidRequestKey: "invalid request: %s",
+		invalidFieldKey:   "invalid %s%s.%s: %s",
+		mapKeyKey:         "key for ",
+		causedByKey:       " | caused by: %s",
+
+		"UUID":             "value must be a valid UUID",
+		"EMAIL":            "value must be a valid email address",
+		"REQUIRED":         "value is required",
+		"DEFINED_ENUM":     "value must be one of the defined enum values",
+		"PATTERN":          "value does not match regex pattern %s",
+		"LENGTH_BETWEEN":   "value length must be between %s and %s runes, inclusive",
+		"MAX_LENGTH":       "value length must be at most %s runes",
+		"MIN_LENGTH":       "value length must be at least %s runes",
+		"IN":               "value must be in list [%s]",
+		"NOT_IN":           "value must not be in list [%s]",
+		"GREATER_THAN":     "value must be greater than %s",
+		"RANGE":            "value must be inside range [%s, %s]",
+		"ITEMS_BETWEEN":    "value must contain between %s and %s items, inclusive",
+		"UNIQUE_ITEMS":     "repeated value must contain unique items",
+		"EMBEDDED_MESSAGE": "embedded message failed validation",
+	},
+	Turkish: {
+		invalidRequestKey: "geçersiz istek: %s",
+		invalidFieldKey:   "geçersiz %s%s.%s: %s",
+		mapKeyKey:         "anahtar ",
+		causedByKey:       " | nedeni: %s",
+
+		"UUID":             "değer geçerli bir UUID olmalıdır",
+		"EMAIL":            "değer geçerli bir e-posta adresi olmalıdır",
+		"REQUIRED":         "değer zorunludur",
+		"DEFINED_ENUM":     "değer tanımlı enum değerlerinden biri olmalıdır",
+		"PATTERN":          "değer %s düzenli ifadesiyle eşleşmelidir",
+		"LENGTH_BETWEEN":   "değerin uzunluğu %s ile %s karakter arasında olmalıdır",
+		"MAX_LENGTH":       "değerin uzunluğu en fazla %s karakter olmalıdır",
+		"MIN_LENGTH":       "değerin uzunluğu en az %s karakter olmalıdır",
+		"IN":               "değer [%s] listesindekilerden biri olmalıdır",
+		"NOT_IN":           "değer [%s] listesindekilerden biri olmamalıdır",
+		"GREATER_THAN":     "değer %s değerinden büyük olmalıdır",
+		"RANGE":            "değer [%s, %s] aralığında olmalıdır",
+		"ITEMS_BETWEEN":    "değer %s ile %s arasında öğe içermelidir",
+		"UNIQUE_ITEMS":     "tekrarlanan alanın öğeleri benzersiz olmalıdır",
+		"EMBEDDED_MESSAGE": "iç içe mesaj doğrulamayı geçemedi",
+	},
+}
@@ -0,0 +1,232 @@
+// Package i18n localizes the request validation errors returned to clients, in the language
+// they ask for with Accept-Language
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// acceptLanguageKeys are the metadata keys a call's Accept-Language arrives in: sent by gRPC and
+// gRPC-Web clients, or forwarded from the HTTP header by the gateway
+var acceptLanguageKeys = []string{"accept-language", "grpcgateway-accept-language"}
+
+// validationError is implemented by the errors protoc-gen-validate generates
+type validationError interface {
+	error
+	Field() string
+	Reason() string
+	Cause() error
+	Key() bool
+	ErrorName() string
+}
+
+// InvalidRequest returns the InvalidArgument error for a request that failed validation. The
+// message is in the locale of the call, and the details carry the message with its locale as
+// a LocalizedMessage and every failed field with the rule's name as a BadRequest, so clients
+// can point at the fields. err is the error of a generated Validate or ValidateAll method.
+func InvalidRequest(ctx context.Context, err error) error {
+	locale := LocaleFromContext(ctx)
+	errs := validationErrors(err)
+
+	messages := make([]string, len(errs))
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, err := range errs {
+		messages[i] = Localize(err, locale)
+		if violation := fieldViolation(err, locale); violation != nil {
+			violations = append(violations, violation)
+		}
+	}
+	// Several errors are joined the way protoc-gen-validate joins them
+	message := format(locale, invalidRequestKey, strings.Join(messages, "; "))
+
+	st := status.New(codes.InvalidArgument, message)
+	details := []protoadapt.MessageV1{&errdetails.LocalizedMessage{Locale: locale, Message: message}}
+	if len(violations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+	if withDetails, detailsErr := st.WithDetails(details...); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// FieldViolations describes every field of a request that failed validation, in the locale of
+// the call. err is the error of a generated ValidateAll method or of a single failed rule.
+func FieldViolations(ctx context.Context, err error) []*errdetails.BadRequest_FieldViolation {
+	locale := LocaleFromContext(ctx)
+	errs := validationErrors(err)
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(errs))
+	for _, err := range errs {
+		violation := fieldViolation(err, locale)
+		if violation == nil {
+			violation = &errdetails.BadRequest_FieldViolation{Description: Localize(err, locale)}
+		}
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+// validationErrors returns the errors a ValidateAll method collected, or err itself
+func validationErrors(err error) []error {
+	var multi interface{ AllErrors() []error }
+	if errors.As(err, &multi) {
+		return multi.AllErrors()
+	}
+	return []error{err}
+}
+
+// LocaleFromContext returns the supported locale that best matches the Accept-Language of
+// an incoming gRPC call, or English without one
+func LocaleFromContext(ctx context.Context) string {
+	for _, key := range acceptLanguageKeys {
+		if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+			return MatchLocale(strings.Join(values, ","))
+		}
+	}
+	return English
+}
+
+// MatchLocale returns the supported locale an Accept-Language value such as "tr-TR,tr;q=0.9,en;q=0.8"
+// prefers most. Regions are ignored, and English is returned when no locale is supported.
+func MatchLocale(acceptLanguage string) string {
+	type preference struct {
+		locale string
+		weight float64
+	}
+	var preferences []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		language, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		if _, ok := catalog[language]; ok && weight > 0 {
+			preferences = append(preferences, preference{language, weight})
+		}
+	}
+	if len(preferences) == 0 {
+		return English
+	}
+	sort.SliceStable(preferences, func(i, j int) bool { return preferences[i].weight > preferences[j].weight })
+	return preferences[0].locale
+}
+
+// Localize returns the message of a validation error in locale. Errors that protoc-gen-validate
+// didn't generate, and rules without a localized message, keep their English text.
+func Localize(err error, locale string) string {
+	var verr validationError
+	if !errors.As(err, &verr) {
+		return err.Error()
+	}
+	if _, ok := catalog[locale]; !ok {
+		locale = English
+	}
+
+	name, args := matchRule(verr.Reason())
+	reason := verr.Reason()
+	if name != "" {
+		reason = format(locale, name, args...)
+	}
+	key := ""
+	if verr.Key() {
+		key = format(locale, mapKeyKey)
+	}
+	message := format(locale, invalidFieldKey, key, strings.TrimSuffix(verr.ErrorName(), "ValidationError"), verr.Field(), reason)
+
+	// Other causes, such as "invalid uuid format", explain the rule's message in English, so
+	// only English messages include them, as protoc-gen-validate does
+	if cause := verr.Cause(); cause != nil {
+		var causeErr validationError
+		if locale == English || errors.As(cause, &causeErr) {
+			message += format(locale, causedByKey, Localize(cause, locale))
+		}
+	}
+	return message
+}
+
+// fieldViolation describes the field that failed validation. Fields of embedded messages are
+// reported by their path, such as issues[0].summary, with the rule of the field itself.
+func fieldViolation(err error, locale string) *errdetails.BadRequest_FieldViolation {
+	var verr validationError
+	if !errors.As(err, &verr) {
+		return nil
+	}
+
+	if cause := verr.Cause(); cause != nil {
+		if nested := fieldViolation(cause, locale); nested != nil {
+			nested.Field = protoFieldName(verr.Field()) + "." + nested.Field
+			return nested
+		}
+	}
+
+	name, args := matchRule(verr.Reason())
+	description := verr.Reason()
+	if name != "" {
+		description = format(locale, name, args...)
+	}
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       protoFieldName(verr.Field()),
+		Description: description,
+		Reason:      name,
+	}
+}
+
+// matchRule returns the name and parameters of the rule a validation reason comes from,
+// or "" for reasons without a localized message
+func matchRule(reason string) (string, []any) {
+	for _, r := range rules {
+		match := r.pattern.FindStringSubmatch(reason)
+		if match == nil {
+			continue
+		}
+		args := make([]any, len(match)-1)
+		for i, arg := range match[1:] {
+			args[i] = arg
+		}
+		return r.name, args
+	}
+	return "", nil
+}
+
+// format fills in the message of key in locale, falling back to the English message
+func format(locale, key string, args ...any) string {
+	message, ok := catalog[locale][key]
+	if !ok {
+		message = catalog[English][key]
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// protoFieldName converts a generated Go field name such as ProjectId or IssueIds[0] to its
+// proto name, project_id or issue_ids[0]
+func protoFieldName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
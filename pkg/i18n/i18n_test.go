@@ -0,0 +1,178 @@
+package i18n_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+)
+
+func TestMatchLocale(t *testing.T) {
+	assert.Equal(t, i18n.Turkish, i18n.MatchLocale("tr"))
+	assert.Equal(t, i18n.Turkish, i18n.MatchLocale("tr-TR,tr;q=0.9,en;q=0.8"))
+	assert.Equal(t, i18n.Turkish, i18n.MatchLocale("fr-FR, en;q=0.5, tr;q=0.8"), "the most preferred supported locale wins")
+	assert.Equal(t, i18n.English, i18n.MatchLocale("fr-FR,de;q=0.9"), "unsupported locales fall back to English")
+	assert.Equal(t, i18n.English, i18n.MatchLocale("tr;q=0,en"))
+	assert.Equal(t, i18n.English, i18n.MatchLocale(""))
+}
+
+func TestLocaleFromContext(t *testing.T) {
+	assert.Equal(t, i18n.English, i18n.LocaleFromContext(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "tr-TR"))
+	assert.Equal(t, i18n.Turkish, i18n.LocaleFromContext(ctx))
+
+	// The HTTP gateway forwards the Accept-Language header with its own prefix
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("grpcgateway-accept-language", "tr"))
+	assert.Equal(t, i18n.Turkish, i18n.LocaleFromContext(ctx))
+}
+
+func TestLocalize(t *testing.T) {
+	uuidErr := (&issuesPbv1.GetIssueRequest{IssueId: "not-a-uuid"}).Validate()
+	require.Error(t, uuidErr)
+	lengthErr := (&userPbv1.CreateUserRequest{FirstName: "", LastName: "Doe", EmailAddress: "jane@example.com"}).Validate()
+	require.Error(t, lengthErr)
+
+	// English messages are those protoc-gen-validate generates
+	assert.Equal(t, uuidErr.Error(), i18n.Localize(uuidErr, i18n.English))
+	assert.Equal(t, lengthErr.Error(), i18n.Localize(lengthErr, i18n.English))
+
+	assert.Equal(t, "geçersiz GetIssueRequest.IssueId: değer geçerli bir UUID olmalıdır", i18n.Localize(uuidErr, i18n.Turkish))
+	assert.Equal(t, "geçersiz CreateUserRequest.FirstName: değerin uzunluğu 1 ile 50 karakter arasında olmalıdır",
+		i18n.Localize(lengthErr, i18n.Turkish))
+
+	// Locales without a catalog get English, and other errors keep their text
+	assert.Equal(t, uuidErr.Error(), i18n.Localize(uuidErr, "fr"))
+	assert.Equal(t, "boom", i18n.Localize(errors.New("boom"), i18n.Turkish))
+}
+
+func TestInvalidRequest(t *testing.T) {
+	validationErr := (&issuesPbv1.GetIssueRequest{IssueId: "not-a-uuid"}).Validate()
+	require.Error(t, validationErr)
+
+	testCases := []struct {
+		name                string
+		acceptLanguage      string
+		expectedLocale      string
+		expectedMessage     string
+		expectedDescription string
+	}{
+		{
+			name:                "English",
+			acceptLanguage:      "en-US",
+			expectedLocale:      i18n.English,
+			expectedMessage:     "invalid request: invalid GetIssueRequest.IssueId: value must be a valid UUID | caused by: invalid uuid format",
+			expectedDescription: "value must be a valid UUID",
+		},
+		{
+			name:                "Turkish",
+			acceptLanguage:      "tr-TR,tr;q=0.9",
+			expectedLocale:      i18n.Turkish,
+			expectedMessage:     "geçersiz istek: geçersiz GetIssueRequest.IssueId: değer geçerli bir UUID olmalıdır",
+			expectedDescription: "değer geçerli bir UUID olmalıdır",
+		},
+		{
+			name:                "Unsupported locale",
+			acceptLanguage:      "ja-JP",
+			expectedLocale:      i18n.English,
+			expectedMessage:     "invalid request: invalid GetIssueRequest.IssueId: value must be a valid UUID | caused by: invalid uuid format",
+			expectedDescription: "value must be a valid UUID",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", tc.acceptLanguage))
+			st := status.Convert(i18n.InvalidRequest(ctx, validationErr))
+
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, tc.expectedMessage, st.Message())
+
+			var localized *errdetails.LocalizedMessage
+			var badRequest *errdetails.BadRequest
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.LocalizedMessage:
+					localized = d
+				case *errdetails.BadRequest:
+					badRequest = d
+				}
+			}
+			require.NotNil(t, localized)
+			assert.Equal(t, tc.expectedLocale, localized.GetLocale())
+			assert.Equal(t, tc.expectedMessage, localized.GetMessage())
+
+			require.NotNil(t, badRequest)
+			require.Len(t, badRequest.GetFieldViolations(), 1)
+			violation := badRequest.GetFieldViolations()[0]
+			assert.Equal(t, "issue_id", violation.GetField())
+			assert.Equal(t, "UUID", violation.GetReason())
+			assert.Equal(t, tc.expectedDescription, violation.GetDescription())
+		})
+	}
+}
+
+func TestInvalidRequest_EmbeddedMessage(t *testing.T) {
+	validationErr := (&issuesPbv1.BatchGetIssuesResponse{Issues: []*issuesPbv1.Issue{{IssueId: "not-a-uuid"}}}).Validate()
+	require.Error(t, validationErr)
+
+	st := status.Convert(i18n.InvalidRequest(context.Background(), validationErr))
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violation := badRequest.GetFieldViolations()[0]
+			assert.Equal(t, "issues[0].issue_id", violation.GetField(), "fields of embedded messages are reported by their path")
+			assert.Equal(t, "UUID", violation.GetReason())
+			return
+		}
+	}
+	t.Fatal("no BadRequest details")
+}
+
+func TestInvalidRequest_SeveralFields(t *testing.T) {
+	validationErr := (&issuesPbv1.CreateIssueRequest{Summary: "", ProjectId: "not-a-uuid"}).ValidateAll()
+	require.Error(t, validationErr)
+
+	st := status.Convert(i18n.InvalidRequest(context.Background(), validationErr))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "invalid request: invalid CreateIssueRequest.Summary: value length must be at least 1 runes; "+
+		"invalid CreateIssueRequest.ProjectId: value must be a valid UUID | caused by: invalid uuid format", st.Message())
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			fields := make([]string, 0, len(badRequest.GetFieldViolations()))
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+			assert.Equal(t, []string{"summary", "project_id"}, fields, "every failed field is reported")
+			return
+		}
+	}
+	t.Fatal("no BadRequest details")
+}
+
+func TestFieldViolations(t *testing.T) {
+	validationErr := (&issuesPbv1.CreateIssueRequest{Summary: "", ProjectId: "not-a-uuid"}).ValidateAll()
+	require.Error(t, validationErr)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "tr"))
+	reasons := make(map[string]string)
+	for _, violation := range i18n.FieldViolations(ctx, validationErr) {
+		reasons[violation.GetField()] = violation.GetReason()
+		assert.NotEmpty(t, violation.GetDescription())
+	}
+	assert.Equal(t, map[string]string{"summary": "MIN_LENGTH", "project_id": "UUID"}, reasons, "every failed field is reported, not just the first")
+
+	single := i18n.FieldViolations(context.Background(), errors.New("boom"))
+	require.Len(t, single, 1)
+	assert.Equal(t, "boom", single[0].GetDescription())
+}
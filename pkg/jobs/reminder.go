@@ -0,0 +1,160 @@
+// Package jobs runs the background jobs the application schedules with cron expressions
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+const (
+	// defaultReminderSchedule is used when REMINDER_CRON_SCHEDULE is not set: 9 AM UTC daily
+	defaultReminderSchedule = "0 9 * * *"
+	// defaultReminderLookaheadHours is used when REMINDER_LOOKAHEAD_HOURS is not set
+	defaultReminderLookaheadHours = 24
+)
+
+// ReminderConfig controls when reminders are sent and how far ahead they look
+type ReminderConfig struct {
+	// Schedule is a five-field cron expression evaluated in UTC
+	Schedule string
+	// Lookahead is how soon an issue must be due for its assignee to be reminded
+	Lookahead time.Duration
+}
+
+// ReminderConfigFromEnv reads the reminder settings from REMINDER_CRON_SCHEDULE and
+// REMINDER_LOOKAHEAD_HOURS, falling back to the defaults for anything unset or invalid
+func ReminderConfigFromEnv() ReminderConfig {
+	cfg := ReminderConfig{
+		Schedule:  defaultReminderSchedule,
+		Lookahead: defaultReminderLookaheadHours * time.Hour,
+	}
+	if v := os.Getenv("REMINDER_CRON_SCHEDULE"); v != "" {
+		cfg.Schedule = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("REMINDER_LOOKAHEAD_HOURS")); err == nil && v > 0 {
+		cfg.Lookahead = time.Duration(v) * time.Hour
+	}
+	return cfg
+}
+
+// DueSoonFinder finds the issues reminders are sent for
+type DueSoonFinder interface {
+	FindIssuesDueSoon(ctx context.Context, within time.Duration) ([]*issuesPbv1.Issue, error)
+}
+
+// Scheduler runs functions on cron schedules. *cron.Cron implements it.
+type Scheduler interface {
+	AddFunc(spec string, cmd func()) (cron.EntryID, error)
+	Start()
+	// Stop stops scheduling; the returned context is done once running jobs have returned
+	Stop() context.Context
+}
+
+// ReminderJob publishes a REMINDER project update for every open assigned issue that is due
+// soon, each time its schedule fires
+type ReminderJob struct {
+	issues    DueSoonFinder
+	broker    broker.MessageBroker
+	scheduler Scheduler
+	schedule  string
+	lookahead time.Duration
+
+	stopOnce sync.Once
+	// ctx is cancelled by Stop, which also aborts a run in progress
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewReminderJob creates a job that publishes reminders of the issues found by issues on mb
+func NewReminderJob(issues DueSoonFinder, mb broker.MessageBroker, cfg ReminderConfig) *ReminderJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ReminderJob{
+		issues:    issues,
+		broker:    mb,
+		scheduler: cron.New(cron.WithLocation(time.UTC)),
+		schedule:  cfg.Schedule,
+		lookahead: cfg.Lookahead,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// WithScheduler overrides the cron scheduler, which is useful in tests
+func (j *ReminderJob) WithScheduler(scheduler Scheduler) *ReminderJob {
+	j.scheduler = scheduler
+	return j
+}
+
+// Start schedules the job and starts the scheduler. It fails if the schedule isn't a valid
+// cron expression.
+func (j *ReminderJob) Start() error {
+	if _, err := j.scheduler.AddFunc(j.schedule, j.run); err != nil {
+		return fmt.Errorf("invalid reminder schedule %q: %w", j.schedule, err)
+	}
+	j.scheduler.Start()
+	return nil
+}
+
+// Stop ends scheduling, cancels a run in progress and waits for it to return
+func (j *ReminderJob) Stop() {
+	j.stopOnce.Do(func() {
+		j.cancel()
+		<-j.scheduler.Stop().Done()
+	})
+}
+
+func (j *ReminderJob) run() {
+	if _, err := j.SendReminders(j.ctx); err != nil {
+		logger.ZapLogger.Error("Failed to send issue reminders", zap.Error(err))
+	}
+}
+
+// SendReminders publishes a reminder for every issue due within the lookahead and returns how
+// many were published. An issue whose reminder fails is logged and skipped; lookup errors and
+// cancellation end the run.
+func (j *ReminderJob) SendReminders(ctx context.Context) (int, error) {
+	issues, err := j.issues.FindIssuesDueSoon(ctx, j.lookahead)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, issue := range issues {
+		if err := ctx.Err(); err != nil {
+			return published, err
+		}
+
+		update := &projectPbv1.ProjectUpdateResponse{
+			ProjectId:  issue.ProjectId,
+			IssueId:    issue.IssueId,
+			UpdateType: projectPbv1.UpdateType_REMINDER,
+			Message: fmt.Sprintf("Issue %s assigned to %s is due at %s",
+				issue.IssueId, issue.AssigneeId, issue.DueDate.AsTime().UTC().Format(time.RFC3339)),
+		}
+		if err := j.broker.PublishUpdate(ctx, issue.ProjectId, update); err != nil {
+			logger.ZapLogger.Error("Failed to publish issue reminder",
+				zap.String("issue_id", issue.IssueId),
+				zap.String("project_id", issue.ProjectId),
+				zap.Error(err))
+			continue
+		}
+		published++
+	}
+
+	logger.ZapLogger.Info("Published issue reminders",
+		zap.Int("count", published),
+		zap.Duration("lookahead", j.lookahead))
+	return published, nil
+}
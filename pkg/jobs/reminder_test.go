@@ -0,0 +1,147 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/jobs"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/memory"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+const (
+	projectID  = "2a4c6e8f-0b1d-4f3a-8c5e-7f9b1d3e5a70"
+	issueID    = "3b5d7f9a-1c2e-4a4b-9d6f-8a0c2e4f6b81"
+	assigneeID = "4c6e8a0b-2d3f-4b5c-8e7a-9b1d3f5a7c92"
+)
+
+// fakeScheduler captures the scheduled function so tests can fire it
+type fakeScheduler struct {
+	spec    string
+	cmd     func()
+	started bool
+	stopped bool
+}
+
+func (s *fakeScheduler) AddFunc(spec string, cmd func()) (cron.EntryID, error) {
+	if _, err := cron.ParseStandard(spec); err != nil {
+		return 0, err
+	}
+	s.spec, s.cmd = spec, cmd
+	return 1, nil
+}
+
+func (s *fakeScheduler) Start() { s.started = true }
+
+func (s *fakeScheduler) Stop() context.Context {
+	s.stopped = true
+	// No job is left running
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+// stubFinder returns fixed issues and records the lookahead it was asked for
+type stubFinder struct {
+	issues []*issuesPbv1.Issue
+	err    error
+	within time.Duration
+}
+
+func (f *stubFinder) FindIssuesDueSoon(_ context.Context, within time.Duration) ([]*issuesPbv1.Issue, error) {
+	f.within = within
+	return f.issues, f.err
+}
+
+func TestReminderJob_PublishesRemindersWhenScheduled(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	mb := memory.NewInMemoryBroker()
+	defer mb.Close()
+
+	sub, err := mb.Subscribe(context.Background(), projectID, projectPbv1.UpdateType_REMINDER)
+	require.NoError(t, err)
+
+	due := time.Date(2024, 3, 10, 17, 0, 0, 0, time.UTC)
+	finder := &stubFinder{issues: []*issuesPbv1.Issue{
+		{IssueId: issueID, ProjectId: projectID, AssigneeId: assigneeID, DueDate: timestamppb.New(due)},
+	}}
+	scheduler := &fakeScheduler{}
+	job := jobs.NewReminderJob(finder, mb, jobs.ReminderConfig{Schedule: "30 8 * * 1-5", Lookahead: 6 * time.Hour}).
+		WithScheduler(scheduler)
+
+	require.NoError(t, job.Start())
+	assert.True(t, scheduler.started)
+	assert.Equal(t, "30 8 * * 1-5", scheduler.spec)
+
+	scheduler.cmd()
+	assert.Equal(t, 6*time.Hour, finder.within)
+
+	select {
+	case update := <-sub.Updates:
+		assert.Equal(t, projectPbv1.UpdateType_REMINDER, update.UpdateType)
+		assert.Equal(t, projectID, update.ProjectId)
+		assert.Equal(t, issueID, update.IssueId)
+		assert.Equal(t, "Issue "+issueID+" assigned to "+assigneeID+" is due at 2024-03-10T17:00:00Z", update.Message)
+	case <-time.After(time.Second):
+		t.Fatal("no reminder was published")
+	}
+
+	job.Stop()
+	assert.True(t, scheduler.stopped)
+}
+
+func TestReminderJob_SendReminders(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	mb := memory.NewInMemoryBroker()
+	defer mb.Close()
+
+	finder := &stubFinder{issues: []*issuesPbv1.Issue{
+		{IssueId: issueID, ProjectId: projectID, AssigneeId: assigneeID, DueDate: timestamppb.Now()},
+		{IssueId: assigneeID, ProjectId: projectID, AssigneeId: assigneeID, DueDate: timestamppb.Now()},
+	}}
+	job := jobs.NewReminderJob(finder, mb, jobs.ReminderConfigFromEnv())
+
+	published, err := job.SendReminders(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+
+	finder.err = errors.New("database unavailable")
+	_, err = job.SendReminders(context.Background())
+	assert.ErrorIs(t, err, finder.err)
+
+	// A run stopped part way ends with the context's error
+	finder.err = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	published, err = job.SendReminders(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, published)
+}
+
+func TestReminderJob_InvalidSchedule(t *testing.T) {
+	job := jobs.NewReminderJob(&stubFinder{}, memory.NewInMemoryBroker(), jobs.ReminderConfig{Schedule: "every morning", Lookahead: time.Hour})
+	assert.ErrorContains(t, job.Start(), `invalid reminder schedule "every morning"`)
+}
+
+func TestReminderConfigFromEnv(t *testing.T) {
+	t.Setenv("REMINDER_CRON_SCHEDULE", "")
+	t.Setenv("REMINDER_LOOKAHEAD_HOURS", "")
+	assert.Equal(t, jobs.ReminderConfig{Schedule: "0 9 * * *", Lookahead: 24 * time.Hour}, jobs.ReminderConfigFromEnv())
+
+	t.Setenv("REMINDER_CRON_SCHEDULE", "0 */4 * * *")
+	t.Setenv("REMINDER_LOOKAHEAD_HOURS", "48")
+	assert.Equal(t, jobs.ReminderConfig{Schedule: "0 */4 * * *", Lookahead: 48 * time.Hour}, jobs.ReminderConfigFromEnv())
+
+	t.Setenv("REMINDER_LOOKAHEAD_HOURS", "-3")
+	assert.Equal(t, 24*time.Hour, jobs.ReminderConfigFromEnv().Lookahead, "non-positive lookaheads fall back to the default")
+}
@@ -4,21 +4,61 @@ package broker
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 )
 
+// ErrBrokerClosed is returned when subscribing to a broker that has been closed
+var ErrBrokerClosed = errors.New("message broker is closed")
+
+// Subscription is a single subscriber's registration for updates on a project.
+// It is the handle passed back to Unsubscribe. Create it with NewSubscription.
+type Subscription struct {
+	// ID uniquely identifies the subscription within its broker
+	ID        string
+	ProjectID string
+	// Updates receives the project's updates; it is closed once the subscription ends
+	Updates <-chan *projectPbv1.ProjectUpdateResponse
+
+	// updateTypes holds the update types the subscriber asked for; empty means every type
+	updateTypes map[projectPbv1.UpdateType]struct{}
+	delivery    *delivery
+}
+
+// Accepts reports whether the subscriber wants update. Brokers check it before delivering,
+// so filtered-out updates don't take up room in the subscriber's buffer.
+func (s *Subscription) Accepts(update *projectPbv1.ProjectUpdateResponse) bool {
+	if len(s.updateTypes) == 0 {
+		return true
+	}
+	_, ok := s.updateTypes[update.GetUpdateType()]
+	return ok
+}
+
 // MessageBroker defines methods for project updates messaging
 type MessageBroker interface {
 	// PublishUpdate sends a project update message
 	PublishUpdate(ctx context.Context, projectID string, update *projectPbv1.ProjectUpdateResponse) error
 
-	// Subscribe registers for updates on a specific project
-	Subscribe(ctx context.Context, projectID string) (<-chan *projectPbv1.ProjectUpdateResponse, error)
+	// PublishDigest sends a project digest on the digests topic for downstream consumers such as mailers
+	PublishDigest(ctx context.Context, digest *issuesPbv1.ProjectDigest) error
+
+	// Subscribe registers for updates on a specific project. When updateTypes are given,
+	// only updates of those types are delivered.
+	Subscribe(ctx context.Context, projectID string, updateTypes ...projectPbv1.UpdateType) (*Subscription, error)
+
+	// SubscribeFrom is Subscribe, but first delivers the project's updates published since from,
+	// or every retained update when from is the zero time or the Unix epoch, with Replayed set.
+	// Live updates follow in publishing order, with none missed or repeated in between.
+	SubscribeFrom(ctx context.Context, projectID string, from time.Time, updateTypes ...projectPbv1.UpdateType) (*Subscription, error)
 
-	// Unsubscribe stops receiving updates for a project
-	Unsubscribe(ctx context.Context, projectID string, ch <-chan *projectPbv1.ProjectUpdateResponse) error
+	// Unsubscribe ends a single subscription, leaving other subscribers to the project untouched.
+	// Unsubscribing more than once is a no-op.
+	Unsubscribe(ctx context.Context, sub *Subscription) error
 
-	// Close releases resources
+	// Close releases resources and ends every subscription. It is safe to call more than once.
 	Close() error
 }
@@ -0,0 +1,210 @@
+package broker
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// OverflowPolicy decides what happens when a subscriber's buffer is full
+type OverflowPolicy string
+
+const (
+	// OverflowDropOldest discards the oldest buffered update to make room for the new one
+	OverflowDropOldest OverflowPolicy = "drop-oldest"
+	// OverflowDisconnect discards the new update and ends the subscription after too many consecutive drops
+	OverflowDisconnect OverflowPolicy = "disconnect"
+)
+
+// Defaults used when the SUBSCRIBER_* environment variables are not set
+const (
+	defaultSubscriberBufferSize     = 10
+	defaultMaxConsecutiveDrops      = 5
+	defaultSubscriberOverflowPolicy = OverflowDropOldest
+	defaultReplayBufferSize         = 100
+)
+
+// ErrSlowConsumer is the reason a subscription is ended under the disconnect policy
+var ErrSlowConsumer = errors.New("subscriber is not keeping up with updates")
+
+// DeliveryConfig controls how updates are buffered for each subscriber
+type DeliveryConfig struct {
+	// BufferSize is the number of updates held for a subscriber that hasn't read them yet
+	BufferSize int
+	Policy     OverflowPolicy
+	// MaxConsecutiveDrops is how many updates in a row may be dropped before a slow
+	// subscriber is disconnected. Only used by OverflowDisconnect.
+	MaxConsecutiveDrops int
+	// ReplayBufferSize is how many updates of each project the in-memory broker keeps for
+	// SubscribeFrom; Kafka replays what the topic retains instead
+	ReplayBufferSize int
+}
+
+// DefaultDeliveryConfig returns the delivery settings used when nothing is configured
+func DefaultDeliveryConfig() DeliveryConfig {
+	return DeliveryConfig{
+		BufferSize:          defaultSubscriberBufferSize,
+		Policy:              defaultSubscriberOverflowPolicy,
+		MaxConsecutiveDrops: defaultMaxConsecutiveDrops,
+		ReplayBufferSize:    defaultReplayBufferSize,
+	}
+}
+
+// DeliveryConfigFromEnv reads SUBSCRIBER_BUFFER_SIZE, SUBSCRIBER_OVERFLOW_POLICY,
+// SUBSCRIBER_MAX_CONSECUTIVE_DROPS and SUBSCRIBER_REPLAY_BUFFER_SIZE, falling back to the
+// defaults for missing or invalid values
+func DeliveryConfigFromEnv() DeliveryConfig {
+	cfg := DefaultDeliveryConfig()
+	if v, err := strconv.Atoi(os.Getenv("SUBSCRIBER_BUFFER_SIZE")); err == nil && v > 0 {
+		cfg.BufferSize = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("SUBSCRIBER_MAX_CONSECUTIVE_DROPS")); err == nil && v > 0 {
+		cfg.MaxConsecutiveDrops = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("SUBSCRIBER_REPLAY_BUFFER_SIZE")); err == nil && v >= 0 {
+		cfg.ReplayBufferSize = v
+	}
+	switch policy := OverflowPolicy(strings.ToLower(os.Getenv("SUBSCRIBER_OVERFLOW_POLICY"))); policy {
+	case OverflowDropOldest, OverflowDisconnect:
+		cfg.Policy = policy
+	}
+	return cfg
+}
+
+// delivery is the buffered channel behind a subscription along with its drop accounting
+type delivery struct {
+	ch  chan *projectPbv1.ProjectUpdateResponse
+	cfg DeliveryConfig
+
+	mu sync.Mutex
+	// dropped counts every update this subscriber missed, unreported counts those not yet
+	// reported through TakeDropped, and consecutive counts drops since the last delivery
+	dropped     int64
+	unreported  int64
+	consecutive int
+	err         error
+	closed      bool
+}
+
+// NewSubscription creates a subscription buffered according to cfg that accepts updates of the
+// given types, or every update when none are given. It is used by broker implementations.
+func NewSubscription(projectID string, cfg DeliveryConfig, updateTypes ...projectPbv1.UpdateType) *Subscription {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = defaultSubscriberBufferSize
+	}
+	d := &delivery{
+		ch:  make(chan *projectPbv1.ProjectUpdateResponse, cfg.BufferSize),
+		cfg: cfg,
+	}
+	sub := &Subscription{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		Updates:   d.ch,
+		delivery:  d,
+	}
+	if len(updateTypes) > 0 {
+		sub.updateTypes = make(map[projectPbv1.UpdateType]struct{}, len(updateTypes))
+		for _, t := range updateTypes {
+			sub.updateTypes[t] = struct{}{}
+		}
+	}
+	return sub
+}
+
+// Deliver queues an update for the subscriber without blocking, applying the overflow policy
+// when its buffer is full. It returns false once the subscriber should be disconnected;
+// the broker is then expected to Unsubscribe it.
+func (s *Subscription) Deliver(update *projectPbv1.ProjectUpdateResponse) bool {
+	d := s.delivery
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.err != nil {
+		return false
+	}
+	if d.closed {
+		return true
+	}
+
+	select {
+	case d.ch <- update:
+		d.consecutive = 0
+		return true
+	default:
+	}
+
+	d.dropped++
+	d.unreported++
+	d.consecutive++
+
+	if d.cfg.Policy == OverflowDisconnect {
+		if d.consecutive >= d.cfg.MaxConsecutiveDrops {
+			d.err = ErrSlowConsumer
+			return false
+		}
+		return true
+	}
+
+	// Make room by discarding the oldest update. Deliver calls are serialized and the
+	// subscriber only ever reads, so the second send can't find the buffer full.
+	select {
+	case <-d.ch:
+	default:
+	}
+	select {
+	case d.ch <- update:
+	default:
+	}
+	return true
+}
+
+// Close closes the updates channel. It is used by broker implementations and is safe to call more than once.
+func (s *Subscription) Close() {
+	d := s.delivery
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.closed {
+		d.closed = true
+		close(d.ch)
+	}
+}
+
+// Dropped returns the total number of updates this subscriber has missed
+func (s *Subscription) Dropped() int64 {
+	s.delivery.mu.Lock()
+	defer s.delivery.mu.Unlock()
+	return s.delivery.dropped
+}
+
+// TakeDropped returns the number of updates missed since the previous call, so the
+// subscriber can tell its client to resynchronize
+func (s *Subscription) TakeDropped() int64 {
+	s.delivery.mu.Lock()
+	defer s.delivery.mu.Unlock()
+	n := s.delivery.unreported
+	s.delivery.unreported = 0
+	return n
+}
+
+// Err returns why the broker ended the subscription, such as ErrSlowConsumer, or nil
+func (s *Subscription) Err() error {
+	s.delivery.mu.Lock()
+	defer s.delivery.mu.Unlock()
+	return s.delivery.err
+}
+
+// ReplayedUpdate returns a copy of update marked as replayed. It is used by broker
+// implementations delivering updates published before a SubscribeFrom call.
+func ReplayedUpdate(update *projectPbv1.ProjectUpdateResponse) *projectPbv1.ProjectUpdateResponse {
+	replayed := proto.Clone(update).(*projectPbv1.ProjectUpdateResponse)
+	replayed.Replayed = true
+	return replayed
+}
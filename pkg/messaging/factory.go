@@ -28,8 +28,8 @@ func NewMessageBroker() (broker.MessageBroker, error) {
 			topicPrefix = "issue-tracker" // Default
 		}
 
-		return kfkimp.NewKafkaBroker(strings.Split(kafkaBrokers, ","), topicPrefix)
+		return kfkimp.NewKafkaBroker(strings.Split(kafkaBrokers, ","), topicPrefix, broker.DeliveryConfigFromEnv())
 	default: // "stream" or empty
-		return memory.NewInMemoryBroker(), nil
+		return memory.NewInMemoryBrokerWithConfig(broker.DeliveryConfigFromEnv()), nil
 	}
 }
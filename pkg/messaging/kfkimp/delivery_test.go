@@ -0,0 +1,77 @@
+package kfkimp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/kfkimp"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+const testProjectID = "928f705f-0efa-4c96-b2f6-ceb36281e1f1"
+
+// distributeCounts hands updates with issue counts from..to to the broker's subscribers
+func distributeCounts(k *kfkimp.KafkaBroker, from, to int32) {
+	for i := from; i <= to; i++ {
+		k.DistributeUpdate(testProjectID, &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID, IssueCount: i})
+	}
+}
+
+// buffered drains and returns the issue counts of the updates buffered for sub
+func buffered(sub *broker.Subscription) []int32 {
+	var counts []int32
+	for {
+		select {
+		case update, ok := <-sub.Updates:
+			if !ok {
+				return counts
+			}
+			counts = append(counts, update.IssueCount)
+		default:
+			return counts
+		}
+	}
+}
+
+func TestKafkaBroker_DropOldestReportsMissedUpdates(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	cfg := broker.DeliveryConfig{BufferSize: 2, Policy: broker.OverflowDropOldest}
+	sub := broker.NewSubscription(testProjectID, cfg)
+	k := kfkimp.NewDeliveryTestBroker(cfg, sub)
+
+	distributeCounts(k, 1, 5)
+
+	// The newest updates are kept and the subscriber learns how many it missed, so it can resync
+	assert.Equal(t, []int32{4, 5}, buffered(sub))
+	assert.Equal(t, int64(3), sub.TakeDropped())
+	assert.True(t, k.Subscribed(sub))
+	assert.NoError(t, sub.Err())
+}
+
+func TestKafkaBroker_DisconnectsSlowConsumer(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	cfg := broker.DeliveryConfig{BufferSize: 1, Policy: broker.OverflowDisconnect, MaxConsecutiveDrops: 2}
+	slow := broker.NewSubscription(testProjectID, cfg)
+	fast := broker.NewSubscription(testProjectID, cfg)
+	k := kfkimp.NewDeliveryTestBroker(cfg, slow, fast)
+
+	for i := int32(1); i <= 3; i++ {
+		distributeCounts(k, i, i)
+		require.Equal(t, []int32{i}, buffered(fast))
+	}
+
+	// The second drop in a row ends the slow subscription, keeping the update it had buffered
+	require.ErrorIs(t, slow.Err(), broker.ErrSlowConsumer)
+	assert.False(t, k.Subscribed(slow))
+	assert.Equal(t, []int32{1}, buffered(slow))
+	_, open := <-slow.Updates
+	assert.False(t, open)
+
+	assert.True(t, k.Subscribed(fast))
+	assert.Equal(t, int64(0), fast.Dropped())
+}
@@ -0,0 +1,161 @@
+package kfkimp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Headers added to messages moved to the dead letter topic
+	dlqHeaderErrorMessage  = "error_message"
+	dlqHeaderOriginalTopic = "original_topic"
+	dlqHeaderFailedAt      = "failed_at"
+
+	// defaultDLQRetentionHours is used when DLQ_RETENTION_HOURS is not set
+	defaultDLQRetentionHours = 168
+)
+
+// dlqTopicName returns the dead letter topic for project updates that could not be deserialized
+func dlqTopicName(topicPrefix string) string {
+	return topicPrefix + ".projects.dlq"
+}
+
+// dlqRetentionFromEnv reads how long the dead letter topic keeps messages from DLQ_RETENTION_HOURS
+func dlqRetentionFromEnv() time.Duration {
+	if v, err := strconv.Atoi(os.Getenv("DLQ_RETENTION_HOURS")); err == nil && v > 0 {
+		return time.Duration(v) * time.Hour
+	}
+	return defaultDLQRetentionHours * time.Hour
+}
+
+// dlqTopicConfig returns the configuration the dead letter topic is created with
+func dlqTopicConfig(topicPrefix string) kafka.TopicConfig {
+	return kafka.TopicConfig{
+		Topic:             dlqTopicName(topicPrefix),
+		NumPartitions:     1,
+		ReplicationFactor: 1,
+		ConfigEntries: []kafka.ConfigEntry{
+			{
+				ConfigName:  "retention.ms",
+				ConfigValue: strconv.FormatInt(dlqRetentionFromEnv().Milliseconds(), 10),
+			},
+		},
+	}
+}
+
+// deadLetterMessage copies a failed message for the dead letter topic, keeping its key, value
+// and headers and recording why and when it failed and where it came from
+func deadLetterMessage(msg kafka.Message, cause error, failedAt time.Time) kafka.Message {
+	headers := make([]kafka.Header, 0, len(msg.Headers)+3)
+	headers = append(headers, msg.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: dlqHeaderErrorMessage, Value: []byte(cause.Error())},
+		kafka.Header{Key: dlqHeaderOriginalTopic, Value: []byte(msg.Topic)},
+		kafka.Header{Key: dlqHeaderFailedAt, Value: []byte(failedAt.UTC().Format(time.RFC3339Nano))},
+	)
+
+	return kafka.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}
+}
+
+// headerValue returns the last value of a message header, or an empty string if it isn't set
+func headerValue(msg kafka.Message, key string) string {
+	for i := len(msg.Headers) - 1; i >= 0; i-- {
+		if msg.Headers[i].Key == key {
+			return string(msg.Headers[i].Value)
+		}
+	}
+	return ""
+}
+
+// sendToDeadLetterQueue moves a message that could not be deserialized to the dead letter topic
+func (k *KafkaBroker) sendToDeadLetterQueue(msg kafka.Message, cause error) {
+	logger.ZapLogger.Warn("Moving undeserializable message to the dead letter topic",
+		zap.String("topic", msg.Topic),
+		zap.Int("partition", msg.Partition),
+		zap.Int64("offset", msg.Offset),
+		zap.String("projectID", string(msg.Key)),
+		zap.Error(cause))
+
+	if err := k.dlqWriter.WriteMessages(k.ctx, deadLetterMessage(msg, cause, time.Now())); err != nil {
+		logger.ZapLogger.Error("Failed to write message to the dead letter topic",
+			zap.String("topic", dlqTopicName(k.topicPrefix)),
+			zap.String("projectID", string(msg.Key)),
+			zap.Int64("offset", msg.Offset),
+			zap.Error(err))
+	}
+}
+
+// ProcessDeadLetterQueue reads the dead letter topic until ctx is cancelled or the broker is
+// closed, retrying deserialization of each message. Messages that now deserialize are
+// delivered to their project's subscribers; those that still fail are logged with their
+// full context and skipped.
+func (k *KafkaBroker) ProcessDeadLetterQueue(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-k.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	topicName := dlqTopicName(k.topicPrefix)
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: k.brokers,
+		Topic:   topicName,
+		GroupID: k.topicPrefix + "-dlq-processor",
+	})
+	defer func() {
+		if err := reader.Close(); err != nil {
+			logger.ZapLogger.Warn("Failed to close Kafka dead letter reader", zap.Error(err))
+		}
+	}()
+
+	for {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read from dead letter topic: %w", err)
+		}
+
+		update := &projectPbv1.ProjectUpdateResponse{}
+		if err := proto.Unmarshal(msg.Value, update); err != nil {
+			logger.ZapLogger.Error("Dead letter message still fails to deserialize",
+				zap.String("topic", topicName),
+				zap.Int("partition", msg.Partition),
+				zap.Int64("offset", msg.Offset),
+				zap.String("projectID", string(msg.Key)),
+				zap.Int("messageSize", len(msg.Value)),
+				zap.String("originalTopic", headerValue(msg, dlqHeaderOriginalTopic)),
+				zap.String("originalError", headerValue(msg, dlqHeaderErrorMessage)),
+				zap.String("failedAt", headerValue(msg, dlqHeaderFailedAt)),
+				zap.Time("enqueuedAt", msg.Time),
+				zap.Error(err))
+			continue
+		}
+
+		logger.ZapLogger.Info("Recovered message from the dead letter topic",
+			zap.String("projectID", string(msg.Key)),
+			zap.Int64("offset", msg.Offset))
+		k.distributeUpdate(string(msg.Key), update)
+	}
+}
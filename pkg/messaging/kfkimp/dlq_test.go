@@ -0,0 +1,64 @@
+package kfkimp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/kfkimp"
+)
+
+func TestDeadLetterMessage(t *testing.T) {
+	failedAt := time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)
+	original := kafka.Message{
+		Topic:     "issue-tracker.projects",
+		Partition: 0,
+		Offset:    42,
+		Key:       []byte("928f705f-0efa-4c96-b2f6-ceb36281e1f1"),
+		Value:     []byte{0xff, 0x01},
+		Headers:   []kafka.Header{{Key: "trace_id", Value: []byte("abc")}},
+	}
+
+	msg := kfkimp.DeadLetterMessage(original, errors.New("proto: cannot parse invalid wire-format data"), failedAt)
+
+	// The topic is set by the dead letter writer, not copied from the original
+	assert.Empty(t, msg.Topic)
+	assert.Equal(t, original.Key, msg.Key)
+	assert.Equal(t, original.Value, msg.Value)
+	assert.Equal(t, "abc", kfkimp.HeaderValue(msg, "trace_id"))
+	assert.Equal(t, "proto: cannot parse invalid wire-format data", kfkimp.HeaderValue(msg, kfkimp.DLQHeaderError))
+	assert.Equal(t, "issue-tracker.projects", kfkimp.HeaderValue(msg, kfkimp.DLQHeaderOrigin))
+	assert.Equal(t, "2024-03-10T08:00:00Z", kfkimp.HeaderValue(msg, kfkimp.DLQHeaderFailedAt))
+	assert.Empty(t, kfkimp.HeaderValue(msg, "missing"))
+
+	// The original message's headers are left untouched
+	assert.Len(t, original.Headers, 1)
+}
+
+func TestDLQTopicConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		retention string
+		expected  string
+	}{
+		{name: "Default", retention: "", expected: "604800000"},
+		{name: "Configured", retention: "24", expected: "86400000"},
+		{name: "Invalid", retention: "-1", expected: "604800000"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("DLQ_RETENTION_HOURS", tc.retention)
+
+			cfg := kfkimp.DLQTopicConfig("issue-tracker")
+			assert.Equal(t, "issue-tracker.projects.dlq", cfg.Topic)
+			require.Len(t, cfg.ConfigEntries, 1)
+			assert.Equal(t, "retention.ms", cfg.ConfigEntries[0].ConfigName)
+			assert.Equal(t, tc.expected, cfg.ConfigEntries[0].ConfigValue)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package kfkimp
+
+import (
+	"github.com/segmentio/kafka-go"
+
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// Exported for tests
+var (
+	DeadLetterMessage = deadLetterMessage
+	DLQTopicConfig    = dlqTopicConfig
+	HeaderValue       = headerValue
+	DLQHeaderError    = dlqHeaderErrorMessage
+	DLQHeaderOrigin   = dlqHeaderOriginalTopic
+	DLQHeaderFailedAt = dlqHeaderFailedAt
+)
+
+// NewDeliveryTestBroker creates a broker without Kafka connections that delivers to subs, so
+// tests can exercise what happens to updates read from Kafka once they reach slow subscribers
+func NewDeliveryTestBroker(cfg broker.DeliveryConfig, subs ...*broker.Subscription) *KafkaBroker {
+	k := &KafkaBroker{
+		readers:     make(map[string]*kafka.Reader),
+		subscribers: make(map[string]map[string]*broker.Subscription),
+		replays:     make(map[string]*replaySubscription),
+		delivery:    cfg,
+	}
+	for _, sub := range subs {
+		if k.subscribers[sub.ProjectID] == nil {
+			k.subscribers[sub.ProjectID] = make(map[string]*broker.Subscription)
+		}
+		k.subscribers[sub.ProjectID][sub.ID] = sub
+	}
+	return k
+}
+
+// DistributeUpdate hands update to the subscribers of projectID as if it had been read from Kafka
+func (k *KafkaBroker) DistributeUpdate(projectID string, update *projectPbv1.ProjectUpdateResponse) {
+	k.distributeUpdate(projectID, update)
+}
+
+// Subscribed reports whether sub is still subscribed
+func (k *KafkaBroker) Subscribed(sub *broker.Subscription) bool {
+	k.subscribersMutex.RLock()
+	defer k.subscribersMutex.RUnlock()
+	_, ok := k.subscribers[sub.ProjectID][sub.ID]
+	return ok
+}
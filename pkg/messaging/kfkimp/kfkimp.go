@@ -4,34 +4,53 @@ package kfkimp
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
 	"github.com/segmentio/kafka-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
 
 // KafkaBroker implements the MessageBroker interface using Kafka
 type KafkaBroker struct {
-	writer           *kafka.Writer
-	readers          map[string]*kafka.Reader
-	subscribers      map[string]map[chan<- *projectPbv1.ProjectUpdateResponse]bool
+	writer *kafka.Writer
+	// digestWriter writes project digests to their own topic
+	digestWriter *kafka.Writer
+	// dlqWriter writes project updates that could not be deserialized to the dead letter topic
+	dlqWriter *kafka.Writer
+	readers   map[string]*kafka.Reader
+	// subscribers maps a project ID to its subscriptions, keyed by subscription ID
+	subscribers map[string]map[string]*broker.Subscription
+	// replays holds the subscriptions made with SubscribeFrom, keyed by subscription ID. Each
+	// reads the topic with its own reader rather than the project's shared one.
+	replays          map[string]*replaySubscription
 	subscribersMutex sync.RWMutex
+	delivery         broker.DeliveryConfig
+	closed           bool
 	brokers          []string
 	topicPrefix      string
 	ctx              context.Context
 	cancel           context.CancelFunc
 }
 
-// NewKafkaBroker creates a new Kafka messaging implementation
-func NewKafkaBroker(brokers []string, topicPrefix string) (broker.MessageBroker, error) {
+// NewKafkaBroker creates a new Kafka messaging implementation.
+// Updates consumed from Kafka are buffered for each local subscriber as configured by delivery.
+func NewKafkaBroker(brokers []string, topicPrefix string, delivery broker.DeliveryConfig) (broker.MessageBroker, error) {
 	// Directly try to create topic first before doing anything else
 	topicName := topicPrefix + ".projects"
+	digestTopicName := topicPrefix + ".digests"
 	created := false
 
 	// Try multiple brokers in case one is not responding
@@ -74,6 +93,12 @@ func NewKafkaBroker(brokers []string, topicPrefix string) (broker.MessageBroker,
 				NumPartitions:     1,
 				ReplicationFactor: 1,
 			},
+			{
+				Topic:             digestTopicName,
+				NumPartitions:     1,
+				ReplicationFactor: 1,
+			},
+			dlqTopicConfig(topicPrefix),
 		}
 
 		err = controllerConn.CreateTopics(topicConfigs...)
@@ -112,6 +137,16 @@ func NewKafkaBroker(brokers []string, topicPrefix string) (broker.MessageBroker,
 		Topic:    topicName,
 		Balancer: &kafka.LeastBytes{},
 	})
+	digestWriter := kafka.NewWriter(kafka.WriterConfig{
+		Brokers:  brokers,
+		Topic:    digestTopicName,
+		Balancer: &kafka.LeastBytes{},
+	})
+	dlqWriter := kafka.NewWriter(kafka.WriterConfig{
+		Brokers:  brokers,
+		Topic:    dlqTopicName(topicPrefix),
+		Balancer: &kafka.LeastBytes{},
+	})
 
 	// Log the configuration
 	logger.ZapLogger.Info("Initializing Kafka broker",
@@ -121,13 +156,17 @@ func NewKafkaBroker(brokers []string, topicPrefix string) (broker.MessageBroker,
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &KafkaBroker{
-		writer:      writer,
-		readers:     make(map[string]*kafka.Reader),
-		subscribers: make(map[string]map[chan<- *projectPbv1.ProjectUpdateResponse]bool),
-		brokers:     brokers,
-		topicPrefix: topicPrefix,
-		ctx:         ctx,
-		cancel:      cancel,
+		writer:       writer,
+		digestWriter: digestWriter,
+		dlqWriter:    dlqWriter,
+		readers:      make(map[string]*kafka.Reader),
+		subscribers:  make(map[string]map[string]*broker.Subscription),
+		replays:      make(map[string]*replaySubscription),
+		delivery:     delivery,
+		brokers:      brokers,
+		topicPrefix:  topicPrefix,
+		ctx:          ctx,
+		cancel:       cancel,
 	}, nil
 }
 
@@ -160,13 +199,18 @@ func (k *KafkaBroker) PublishUpdate(ctx context.Context, projectID string, updat
 		zap.Int("messageSize", len(value)))
 
 	// Write the message to Kafka
+	span := startPublishSpan(ctx, topicName, projectID)
 	err = k.writer.WriteMessages(mergedCtx, kafka.Message{
 		Key:   []byte(projectID),
 		Value: value,
 	})
 
 	if err != nil {
-		return k.handlePublishError(mergedCtx, err, topicName, projectID, value)
+		err = k.handlePublishError(mergedCtx, err, topicName, projectID, value)
+	}
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return err
 	}
 
 	logger.ZapLogger.Debug("Successfully published message to Kafka",
@@ -176,6 +220,51 @@ func (k *KafkaBroker) PublishUpdate(ctx context.Context, projectID string, updat
 	return nil
 }
 
+// PublishDigest publishes a project digest to the digests topic, keyed by project ID
+func (k *KafkaBroker) PublishDigest(ctx context.Context, digest *issuesPbv1.ProjectDigest) error {
+	// Create merged context to respect both the broker's and the caller's context
+	mergedCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-k.ctx.Done():
+			cancel()
+		case <-mergedCtx.Done():
+		}
+	}()
+
+	value, err := proto.Marshal(digest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal project digest: %w", err)
+	}
+
+	span := startPublishSpan(ctx, k.topicPrefix+".digests", digest.ProjectId)
+	err = k.digestWriter.WriteMessages(mergedCtx, kafka.Message{
+		Key:   []byte(digest.ProjectId),
+		Value: value,
+	})
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return fmt.Errorf("failed to write digest to Kafka: %w", err)
+	}
+
+	logger.ZapLogger.Debug("Published project digest to Kafka",
+		zap.String("topic", k.topicPrefix+".digests"),
+		zap.String("projectID", digest.ProjectId))
+	return nil
+}
+
+// startPublishSpan starts the span covering the write of a message to a topic, including any
+// retry after creating the topic
+func startPublishSpan(ctx context.Context, topicName, key string) trace.Span {
+	_, span := tracing.StartSpan(ctx, "kafka.publish",
+		semconv.MessagingSystemKafka,
+		semconv.MessagingDestinationName(topicName),
+		semconv.MessagingKafkaMessageKey(key))
+	return span
+}
+
 // handlePublishError attempts to recover from Kafka publish errors
 func (k *KafkaBroker) handlePublishError(ctx context.Context, err error, topicName, projectID string, value []byte) error {
 	if err.Error() == "kafka: unknown topic or partition" ||
@@ -250,17 +339,21 @@ func (k *KafkaBroker) createTopicAndRetry(ctx context.Context, topicName, projec
 	return retryErr == nil
 }
 
-// Subscribe creates a subscription to project updates
-func (k *KafkaBroker) Subscribe(ctx context.Context, projectID string) (<-chan *projectPbv1.ProjectUpdateResponse, error) {
+// Subscribe creates a subscription to project updates, optionally of the given types only
+func (k *KafkaBroker) Subscribe(ctx context.Context, projectID string, updateTypes ...projectPbv1.UpdateType) (*broker.Subscription, error) {
 	k.subscribersMutex.Lock()
 	defer k.subscribersMutex.Unlock()
 
-	// Create channel for this subscriber
-	ch := make(chan *projectPbv1.ProjectUpdateResponse, 10)
+	if k.closed {
+		return nil, broker.ErrBrokerClosed
+	}
+
+	// Create the buffered subscription for this subscriber
+	sub := broker.NewSubscription(projectID, k.delivery, updateTypes...)
 
 	// Create map if it doesn't exist
 	if _, exists := k.subscribers[projectID]; !exists {
-		k.subscribers[projectID] = make(map[chan<- *projectPbv1.ProjectUpdateResponse]bool)
+		k.subscribers[projectID] = make(map[string]*broker.Subscription)
 
 		// Create a reader for this project if it doesn't exist
 		if _, exists := k.readers[projectID]; !exists {
@@ -281,53 +374,178 @@ func (k *KafkaBroker) Subscribe(ctx context.Context, projectID string) (<-chan *
 		}
 	}
 
-	k.subscribers[projectID][ch] = true
+	k.subscribers[projectID][sub.ID] = sub
 	logger.ZapLogger.Debug("Added new subscriber for project",
 		zap.String("projectID", projectID),
+		zap.String("subscriptionID", sub.ID),
 		zap.Int("totalSubscribers", len(k.subscribers[projectID])))
 
 	// Monitor context cancellation
-	go func() {
-		<-ctx.Done()
-		k.subscribersMutex.Lock()
-		defer k.subscribersMutex.Unlock()
-
-		// Remove the channel if context is done
-		if subs, ok := k.subscribers[projectID]; ok {
-			delete(subs, ch)
-			logger.ZapLogger.Debug("Removed subscriber due to context cancellation",
-				zap.String("projectID", projectID))
+	go k.unsubscribeWhenDone(ctx, sub)
+
+	return sub, nil
+}
+
+// replaySubscription is a subscription made with SubscribeFrom and the reader feeding it
+type replaySubscription struct {
+	sub    *broker.Subscription
+	reader *kafka.Reader
+}
+
+// SubscribeFrom creates a subscription that first receives the project's updates the topic
+// retains from from onwards, then live updates. Its reader starts at the first retained
+// message, or at the first one written at or after from, and carries on into live updates, so
+// the two keep their order with none missed or repeated between them.
+func (k *KafkaBroker) SubscribeFrom(ctx context.Context, projectID string, from time.Time, updateTypes ...projectPbv1.UpdateType) (*broker.Subscription, error) {
+	// Messages before the topic's current end were published before the subscription
+	end, err := k.endOffset(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the end of the project updates topic: %w", err)
+	}
+	// The topic is created with a single partition
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     k.brokers,
+		Topic:       k.topicPrefix + ".projects",
+		Partition:   0,
+		StartOffset: kafka.FirstOffset,
+	})
+	if from.After(time.Unix(0, 0)) {
+		if err := reader.SetOffsetAt(ctx, from); err != nil {
+			_ = reader.Close()
+			return nil, fmt.Errorf("failed to find project updates published since %s: %w", from, err)
+		}
+	}
+
+	k.subscribersMutex.Lock()
+	if k.closed {
+		k.subscribersMutex.Unlock()
+		_ = reader.Close()
+		return nil, broker.ErrBrokerClosed
+	}
+	sub := broker.NewSubscription(projectID, k.delivery, updateTypes...)
+	k.replays[sub.ID] = &replaySubscription{sub: sub, reader: reader}
+	k.subscribersMutex.Unlock()
+
+	logger.ZapLogger.Debug("Added replaying subscriber for project",
+		zap.String("projectID", projectID),
+		zap.String("subscriptionID", sub.ID),
+		zap.Time("from", from),
+		zap.Int64("endOffset", end))
+
+	go k.replay(sub, reader, end)
+	go k.unsubscribeWhenDone(ctx, sub)
+
+	return sub, nil
+}
+
+// endOffset returns the offset the next message written to the project updates topic gets
+func (k *KafkaBroker) endOffset(ctx context.Context) (int64, error) {
+	conn, err := kafka.DialLeader(ctx, "tcp", k.brokers[0], k.topicPrefix+".projects", 0)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.ZapLogger.Warn("Failed to close Kafka connection", zap.Error(err))
 		}
 	}()
+	return conn.ReadLastOffset()
+}
+
+// replay delivers the project's updates read by reader to sub, marking those below end as replayed
+func (k *KafkaBroker) replay(sub *broker.Subscription, reader *kafka.Reader, end int64) {
+	for {
+		msg, err := reader.ReadMessage(k.ctx)
+		if err != nil {
+			// The reader is closed once the subscription ends
+			if errors.Is(err, io.EOF) || k.ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		if string(msg.Key) != sub.ProjectID {
+			continue
+		}
+
+		update := &projectPbv1.ProjectUpdateResponse{}
+		if err := proto.Unmarshal(msg.Value, update); err != nil {
+			// The project's shared reader sets unreadable messages aside, so they aren't sent to the dead letter queue twice
+			continue
+		}
+		if msg.Offset < end {
+			update = broker.ReplayedUpdate(update)
+		}
+		if !sub.Accepts(update) {
+			continue
+		}
+		if !sub.Deliver(update) {
+			logger.ZapLogger.Warn("Disconnecting slow subscriber",
+				zap.String("projectID", sub.ProjectID),
+				zap.String("subscriptionID", sub.ID),
+				zap.Int64("droppedUpdates", sub.Dropped()))
+			if err := k.Unsubscribe(context.Background(), sub); err != nil {
+				logger.ZapLogger.Warn("Failed to unsubscribe slow subscriber", zap.Error(err))
+			}
+			return
+		}
+	}
+}
 
-	return ch, nil
+// unsubscribeWhenDone ends sub once ctx is cancelled
+func (k *KafkaBroker) unsubscribeWhenDone(ctx context.Context, sub *broker.Subscription) {
+	select {
+	case <-ctx.Done():
+	case <-k.ctx.Done():
+		return
+	}
+	if err := k.Unsubscribe(context.Background(), sub); err == nil {
+		logger.ZapLogger.Debug("Removed subscriber due to context cancellation",
+			zap.String("projectID", sub.ProjectID),
+			zap.String("subscriptionID", sub.ID))
+	}
 }
 
-// Unsubscribe removes a subscription
-func (k *KafkaBroker) Unsubscribe(_ context.Context, projectID string, _ <-chan *projectPbv1.ProjectUpdateResponse) error {
+// Unsubscribe removes a single subscription and closes its channel
+func (k *KafkaBroker) Unsubscribe(_ context.Context, sub *broker.Subscription) error {
 	k.subscribersMutex.Lock()
 	defer k.subscribersMutex.Unlock()
 
-	subs, ok := k.subscribers[projectID]
+	if replay, ok := k.replays[sub.ID]; ok {
+		sub.Close()
+		delete(k.replays, sub.ID)
+		if err := replay.reader.Close(); err != nil {
+			logger.ZapLogger.Warn("Failed to close Kafka reader", zap.Error(err))
+		}
+		return nil
+	}
+
+	subs, ok := k.subscribers[sub.ProjectID]
 	if !ok {
 		return nil
 	}
+	if _, ok := subs[sub.ID]; !ok {
+		// Already unsubscribed, or ended by Close
+		return nil
+	}
+
+	// distributeUpdate holds the read lock while sending, so the channel can't be written to after this
+	sub.Close()
+	delete(subs, sub.ID)
 
-	// Find and remove the specific channel
-	for subCh := range subs {
-		// This is a bit complex as we can't directly compare channel references
-		// For efficiency, in a real implementation you'd want to store a map of channels to IDs
-		// But for this example, we'll just remove them all
-		delete(subs, subCh)
+	if dropped := sub.Dropped(); dropped > 0 {
+		logger.ZapLogger.Info("Subscriber missed updates",
+			zap.String("projectID", sub.ProjectID),
+			zap.String("subscriptionID", sub.ID),
+			zap.Int64("droppedUpdates", dropped))
 	}
 
-	k.cleanupIfNoSubscribers(projectID, subs)
+	k.cleanupIfNoSubscribers(sub.ProjectID, subs)
 
 	return nil
 }
 
 // cleanupIfNoSubscribers removes the reader if there are no more subscribers
-func (k *KafkaBroker) cleanupIfNoSubscribers(projectID string, subs map[chan<- *projectPbv1.ProjectUpdateResponse]bool) {
+func (k *KafkaBroker) cleanupIfNoSubscribers(projectID string, subs map[string]*broker.Subscription) {
 	if len(subs) == 0 {
 		if reader, ok := k.readers[projectID]; ok {
 			if err := reader.Close(); err != nil {
@@ -341,15 +559,40 @@ func (k *KafkaBroker) cleanupIfNoSubscribers(projectID string, subs map[chan<- *
 
 // Close releases Kafka resources
 func (k *KafkaBroker) Close() error {
-	k.cancel()
-
 	k.subscribersMutex.Lock()
 	defer k.subscribersMutex.Unlock()
 
-	// Close the writer
+	if k.closed {
+		return nil
+	}
+	k.closed = true
+	k.cancel()
+
+	// Close all subscriber channels
+	for _, subscribers := range k.subscribers {
+		for _, sub := range subscribers {
+			sub.Close()
+		}
+	}
+	k.subscribers = make(map[string]map[string]*broker.Subscription)
+	for id, replay := range k.replays {
+		replay.sub.Close()
+		if err := replay.reader.Close(); err != nil {
+			logger.ZapLogger.Warn("Failed to close Kafka reader", zap.Error(err))
+		}
+		delete(k.replays, id)
+	}
+
+	// Close the writers
 	if err := k.writer.Close(); err != nil {
 		return err
 	}
+	if err := k.digestWriter.Close(); err != nil {
+		return err
+	}
+	if err := k.dlqWriter.Close(); err != nil {
+		return err
+	}
 
 	// Close all readers
 	for _, reader := range k.readers {
@@ -358,13 +601,6 @@ func (k *KafkaBroker) Close() error {
 		}
 	}
 
-	// Close all subscriber channels
-	for _, subscribers := range k.subscribers {
-		for ch := range subscribers {
-			close(ch)
-		}
-	}
-
 	return nil
 }
 
@@ -377,6 +613,10 @@ func (k *KafkaBroker) consumeMessages(projectID string, reader *kafka.Reader) {
 		default:
 			msg, err := reader.ReadMessage(k.ctx)
 			if err != nil {
+				// The reader is closed once the project's last subscriber leaves
+				if errors.Is(err, io.EOF) {
+					return
+				}
 				continue
 			}
 
@@ -385,9 +625,10 @@ func (k *KafkaBroker) consumeMessages(projectID string, reader *kafka.Reader) {
 				continue
 			}
 
-			// Deserialize the protobuf message
+			// Deserialize the protobuf message, setting aside messages that can't be read
 			update := &projectPbv1.ProjectUpdateResponse{}
 			if err := proto.Unmarshal(msg.Value, update); err != nil {
+				k.sendToDeadLetterQueue(msg, err)
 				continue
 			}
 
@@ -397,19 +638,29 @@ func (k *KafkaBroker) consumeMessages(projectID string, reader *kafka.Reader) {
 	}
 }
 
-// distributeUpdate sends update to all subscribers
+// distributeUpdate sends update to all subscribers that accept its type, disconnecting any the
+// overflow policy gives up on
 func (k *KafkaBroker) distributeUpdate(projectID string, update *projectPbv1.ProjectUpdateResponse) {
 	k.subscribersMutex.RLock()
-	defer k.subscribersMutex.RUnlock()
-
-	if subscribers, ok := k.subscribers[projectID]; ok {
-		for ch := range subscribers {
-			select {
-			case ch <- update:
-				// Message sent successfully
-			default:
-				// Channel is full, skip
-			}
+	var slow []*broker.Subscription
+	for _, sub := range k.subscribers[projectID] {
+		if !sub.Accepts(update) {
+			continue
+		}
+		if !sub.Deliver(update) {
+			slow = append(slow, sub)
+		}
+	}
+	k.subscribersMutex.RUnlock()
+
+	// Slow subscribers can only be removed once the read lock is released
+	for _, sub := range slow {
+		logger.ZapLogger.Warn("Disconnecting slow subscriber",
+			zap.String("projectID", projectID),
+			zap.String("subscriptionID", sub.ID),
+			zap.Int64("droppedUpdates", sub.Dropped()))
+		if err := k.Unsubscribe(context.Background(), sub); err != nil {
+			logger.ZapLogger.Warn("Failed to unsubscribe slow subscriber", zap.Error(err))
 		}
 	}
 }
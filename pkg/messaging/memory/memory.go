@@ -5,67 +5,193 @@ package memory
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"go.uber.org/zap"
 )
 
 // InMemoryBroker implements MessageBroker using in-memory channels
 type InMemoryBroker struct {
-	subscribers map[string]map[chan<- *projectPbv1.ProjectUpdateResponse]struct{}
-	mu          sync.RWMutex
+	// subscribers maps a project ID to its subscriptions, keyed by subscription ID
+	subscribers map[string]map[string]*broker.Subscription
+	// history holds the last ReplayBufferSize updates of each project, oldest first, for SubscribeFrom
+	history  map[string][]retainedUpdate
+	delivery broker.DeliveryConfig
+	closed   bool
+	mu       sync.RWMutex
 }
 
-// NewInMemoryBroker creates a new in-memory message broker
+// retainedUpdate is a published update kept for replay
+type retainedUpdate struct {
+	publishedAt time.Time
+	update      *projectPbv1.ProjectUpdateResponse
+}
+
+// NewInMemoryBroker creates a new in-memory message broker with the default delivery settings
 func NewInMemoryBroker() broker.MessageBroker {
+	return NewInMemoryBrokerWithConfig(broker.DefaultDeliveryConfig())
+}
+
+// NewInMemoryBrokerWithConfig creates a new in-memory message broker that buffers updates for each subscriber as configured
+func NewInMemoryBrokerWithConfig(cfg broker.DeliveryConfig) broker.MessageBroker {
 	return &InMemoryBroker{
-		subscribers: make(map[string]map[chan<- *projectPbv1.ProjectUpdateResponse]struct{}),
+		subscribers: make(map[string]map[string]*broker.Subscription),
+		history:     make(map[string][]retainedUpdate),
+		delivery:    cfg,
 	}
 }
 
-// PublishUpdate sends a project update to all subscribers
+// PublishUpdate sends a project update to all subscribers that accept its type
 func (b *InMemoryBroker) PublishUpdate(ctx context.Context, projectID string, update *projectPbv1.ProjectUpdateResponse) error {
-	b.mu.RLock()
-	defer b.mu.RUnlock()
-
-	if channels, ok := b.subscribers[projectID]; ok {
-		for ch := range channels {
-			select {
-			case ch <- update:
-				// Message sent successfully
-			case <-ctx.Done():
-				// Context canceled, stop processing
-				return ctx.Err()
-			default:
-				// Skip if channel is full (non-blocking)
-			}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// The write lock orders publishing with SubscribeFrom, so a replaying subscriber gets each
+	// update once, either replayed or live
+	b.mu.Lock()
+	b.retain(projectID, update)
+	var slow []*broker.Subscription
+	for _, sub := range b.subscribers[projectID] {
+		if !sub.Accepts(update) {
+			continue
+		}
+		if !sub.Deliver(update) {
+			slow = append(slow, sub)
+		}
+	}
+	b.mu.Unlock()
+
+	// Slow subscribers can only be removed once the read lock is released
+	for _, sub := range slow {
+		logger.ZapLogger.Warn("Disconnecting slow subscriber",
+			zap.String("projectID", projectID),
+			zap.String("subscriptionID", sub.ID),
+			zap.Int64("droppedUpdates", sub.Dropped()))
+		if err := b.Unsubscribe(ctx, sub); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-// Subscribe registers for project updates
-func (b *InMemoryBroker) Subscribe(_ context.Context, projectID string) (<-chan *projectPbv1.ProjectUpdateResponse, error) {
+// PublishDigest logs a project digest. Nothing outside the process can consume from the
+// in-memory broker, so digests only reach downstream consumers with Kafka.
+func (b *InMemoryBroker) PublishDigest(ctx context.Context, digest *issuesPbv1.ProjectDigest) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	logger.ZapLogger.Info("Project digest generated",
+		zap.String("projectID", digest.ProjectId),
+		zap.Int32("created", digest.CreatedCount),
+		zap.Int32("resolved", digest.ResolvedCount),
+		zap.Int32("closed", digest.ClosedCount),
+		zap.Int32("overdue", digest.OverdueCount))
+	return nil
+}
+
+// Subscribe registers for project updates, optionally of the given types only
+func (b *InMemoryBroker) Subscribe(_ context.Context, projectID string, updateTypes ...projectPbv1.UpdateType) (*broker.Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, broker.ErrBrokerClosed
+	}
+
+	sub := broker.NewSubscription(projectID, b.delivery, updateTypes...)
+
+	if _, ok := b.subscribers[projectID]; !ok {
+		b.subscribers[projectID] = make(map[string]*broker.Subscription)
+	}
+	b.subscribers[projectID][sub.ID] = sub
+
+	return sub, nil
+}
+
+// SubscribeFrom registers for project updates after delivering the retained updates published
+// since from. The subscription's buffer is enlarged to hold them, so replay doesn't push out
+// live updates.
+func (b *InMemoryBroker) SubscribeFrom(_ context.Context, projectID string, from time.Time, updateTypes ...projectPbv1.UpdateType) (*broker.Subscription, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	ch := make(chan *projectPbv1.ProjectUpdateResponse, 10)
+	if b.closed {
+		return nil, broker.ErrBrokerClosed
+	}
+
+	var replay []*projectPbv1.ProjectUpdateResponse
+	for _, retained := range b.history[projectID] {
+		if !retained.publishedAt.Before(from) {
+			replay = append(replay, retained.update)
+		}
+	}
+
+	cfg := b.delivery
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = broker.DefaultDeliveryConfig().BufferSize
+	}
+	cfg.BufferSize += len(replay)
+	sub := broker.NewSubscription(projectID, cfg, updateTypes...)
+	for _, update := range replay {
+		if sub.Accepts(update) {
+			sub.Deliver(broker.ReplayedUpdate(update))
+		}
+	}
 
 	if _, ok := b.subscribers[projectID]; !ok {
-		b.subscribers[projectID] = make(map[chan<- *projectPbv1.ProjectUpdateResponse]struct{})
+		b.subscribers[projectID] = make(map[string]*broker.Subscription)
 	}
+	b.subscribers[projectID][sub.ID] = sub
 
-	b.subscribers[projectID][ch] = struct{}{}
-	return ch, nil
+	return sub, nil
 }
 
-// Unsubscribe stops receiving updates for a project
-func (b *InMemoryBroker) Unsubscribe(_ context.Context, projectID string, _ <-chan *projectPbv1.ProjectUpdateResponse) error {
+// retain keeps update for replay, dropping the project's oldest update once ReplayBufferSize are kept.
+// It must be called with the write lock held.
+func (b *InMemoryBroker) retain(projectID string, update *projectPbv1.ProjectUpdateResponse) {
+	if b.delivery.ReplayBufferSize <= 0 {
+		return
+	}
+	history := b.history[projectID]
+	if len(history) >= b.delivery.ReplayBufferSize {
+		history = history[len(history)-b.delivery.ReplayBufferSize+1:]
+	}
+	b.history[projectID] = append(history, retainedUpdate{publishedAt: time.Now(), update: update})
+}
+
+// Unsubscribe removes a single subscription and closes its channel
+func (b *InMemoryBroker) Unsubscribe(_ context.Context, sub *broker.Subscription) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	// For this simplified implementation, we'll remove all subscriptions for the project
-	delete(b.subscribers, projectID)
+	subs, ok := b.subscribers[sub.ProjectID]
+	if !ok {
+		return nil
+	}
+	if _, ok := subs[sub.ID]; !ok {
+		// Already unsubscribed, or ended by Close
+		return nil
+	}
+
+	// Publishers hold the read lock while sending, so the channel can't be written to after this
+	sub.Close()
+	delete(subs, sub.ID)
+	if len(subs) == 0 {
+		delete(b.subscribers, sub.ProjectID)
+	}
+
+	if dropped := sub.Dropped(); dropped > 0 {
+		logger.ZapLogger.Info("Subscriber missed updates",
+			zap.String("projectID", sub.ProjectID),
+			zap.String("subscriptionID", sub.ID),
+			zap.Int64("droppedUpdates", dropped))
+	}
 
 	return nil
 }
@@ -75,13 +201,18 @@ func (b *InMemoryBroker) Close() error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+
 	// Close all channels
-	for _, channels := range b.subscribers {
-		for ch := range channels {
-			close(ch)
+	for _, subs := range b.subscribers {
+		for _, sub := range subs {
+			sub.Close()
 		}
 	}
 
-	b.subscribers = make(map[string]map[chan<- *projectPbv1.ProjectUpdateResponse]struct{})
+	b.subscribers = make(map[string]map[string]*broker.Subscription)
 	return nil
 }
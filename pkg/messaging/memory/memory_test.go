@@ -0,0 +1,356 @@
+package memory_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/memory"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+const testProjectID = "928f705f-0efa-4c96-b2f6-ceb36281e1f1"
+
+func init() {
+	logger.ZapLogger = zap.NewNop()
+}
+
+func receive(t *testing.T, sub *broker.Subscription) *projectPbv1.ProjectUpdateResponse {
+	t.Helper()
+	select {
+	case update, ok := <-sub.Updates:
+		require.True(t, ok, "subscription channel closed unexpectedly")
+		return update
+	case <-time.After(time.Second):
+		t.Fatal("no update received")
+		return nil
+	}
+}
+
+// assertClosed drains any updates buffered before the subscription ended and checks the channel is closed
+func assertClosed(t *testing.T, sub *broker.Subscription) {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-sub.Updates:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("subscription channel was not closed")
+		}
+	}
+}
+
+func TestInMemoryBroker_UnsubscribeLeavesOtherSubscribers(t *testing.T) {
+	b := memory.NewInMemoryBroker()
+	defer b.Close()
+	ctx := context.Background()
+
+	first, err := b.Subscribe(ctx, testProjectID)
+	require.NoError(t, err)
+	second, err := b.Subscribe(ctx, testProjectID)
+	require.NoError(t, err)
+	third, err := b.Subscribe(ctx, testProjectID)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, second.ID)
+
+	require.NoError(t, b.Unsubscribe(ctx, second))
+	assertClosed(t, second)
+
+	update := &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID, IssueCount: 3}
+	require.NoError(t, b.PublishUpdate(ctx, testProjectID, update))
+
+	assert.Equal(t, int32(3), receive(t, first).IssueCount)
+	assert.Equal(t, int32(3), receive(t, third).IssueCount)
+
+	// Unsubscribing twice is a no-op
+	assert.NoError(t, b.Unsubscribe(ctx, second))
+}
+
+func TestInMemoryBroker_FiltersUpdateTypes(t *testing.T) {
+	b := memory.NewInMemoryBrokerWithConfig(broker.DeliveryConfig{BufferSize: 2, Policy: broker.OverflowDisconnect, MaxConsecutiveDrops: 1})
+	defer b.Close()
+	ctx := context.Background()
+
+	all, err := b.Subscribe(ctx, testProjectID)
+	require.NoError(t, err)
+	moves, err := b.Subscribe(ctx, testProjectID, projectPbv1.UpdateType_ISSUE_MOVED)
+	require.NoError(t, err)
+
+	added := &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID, IssueCount: 1, UpdateType: projectPbv1.UpdateType_ISSUE_ADDED}
+	moved := &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID, IssueCount: 2, UpdateType: projectPbv1.UpdateType_ISSUE_MOVED}
+	require.NoError(t, b.PublishUpdate(ctx, testProjectID, added))
+	assert.Equal(t, added, receive(t, all))
+	// Filtered-out updates don't fill the buffer, so they can't get a subscriber disconnected
+	for i := 0; i < 5; i++ {
+		require.NoError(t, b.PublishUpdate(ctx, testProjectID, added))
+		receive(t, all)
+	}
+	require.NoError(t, b.PublishUpdate(ctx, testProjectID, moved))
+
+	assert.Equal(t, moved, receive(t, moves))
+	assert.Empty(t, moves.Updates)
+	assert.Zero(t, moves.Dropped())
+	assert.Equal(t, moved, receive(t, all))
+}
+
+func TestInMemoryBroker_ConcurrentSubscribers(t *testing.T) {
+	b := memory.NewInMemoryBroker()
+	defer b.Close()
+	ctx := context.Background()
+
+	const subscribers = 50
+	subs := make([]*broker.Subscription, subscribers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < subscribers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sub, err := b.Subscribe(ctx, testProjectID)
+			assert.NoError(t, err)
+			subs[i] = sub
+		}(i)
+	}
+	wg.Wait()
+
+	// Half the subscribers leave while updates are being published
+	for i := 0; i < subscribers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				assert.NoError(t, b.Unsubscribe(ctx, subs[i]))
+			} else {
+				assert.NoError(t, b.PublishUpdate(ctx, testProjectID, &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID}))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// Drain what was delivered during the race, then check the remaining subscribers still get updates
+	for i := 1; i < subscribers; i += 2 {
+		for len(subs[i].Updates) > 0 {
+			<-subs[i].Updates
+		}
+	}
+	require.NoError(t, b.PublishUpdate(ctx, testProjectID, &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID, IssueCount: 7}))
+
+	for i, sub := range subs {
+		if i%2 == 0 {
+			assertClosed(t, sub)
+		} else {
+			assert.Equal(t, int32(7), receive(t, sub).IssueCount)
+		}
+	}
+}
+
+func TestInMemoryBroker_Close(t *testing.T) {
+	b := memory.NewInMemoryBroker()
+	ctx := context.Background()
+
+	sub, err := b.Subscribe(ctx, testProjectID)
+	require.NoError(t, err)
+
+	require.NoError(t, b.Close())
+	assertClosed(t, sub)
+
+	// Closing again, or unsubscribing a subscription Close already ended, must not close the channel twice
+	assert.NoError(t, b.Close())
+	assert.NoError(t, b.Unsubscribe(ctx, sub))
+
+	_, err = b.Subscribe(ctx, testProjectID)
+	assert.ErrorIs(t, err, broker.ErrBrokerClosed)
+}
+
+func TestInMemoryBroker_CloseDuringSubscribe(t *testing.T) {
+	b := memory.NewInMemoryBroker()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	subs := make(chan *broker.Subscription, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sub, err := b.Subscribe(ctx, testProjectID)
+			if err != nil {
+				assert.ErrorIs(t, err, broker.ErrBrokerClosed)
+				return
+			}
+			subs <- sub
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, b.Close())
+		}()
+	}
+	wg.Wait()
+	close(subs)
+
+	// Every subscription that got in before Close was ended by it
+	for sub := range subs {
+		assertClosed(t, sub)
+	}
+}
+
+// publishCounts publishes updates with issue counts from..to, handing each to keepUp's subscribers as it goes
+func publishCounts(t *testing.T, b broker.MessageBroker, from, to int32, keepUp ...*broker.Subscription) {
+	t.Helper()
+	for i := from; i <= to; i++ {
+		require.NoError(t, b.PublishUpdate(context.Background(), testProjectID, &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID, IssueCount: i}))
+		for _, sub := range keepUp {
+			assert.Equal(t, i, receive(t, sub).IssueCount)
+		}
+	}
+}
+
+func TestInMemoryBroker_DropOldest(t *testing.T) {
+	b := memory.NewInMemoryBrokerWithConfig(broker.DeliveryConfig{BufferSize: 3, Policy: broker.OverflowDropOldest})
+	defer b.Close()
+
+	sub, err := b.Subscribe(context.Background(), testProjectID)
+	require.NoError(t, err)
+
+	// Nobody reads while ten updates are published
+	publishCounts(t, b, 1, 10)
+
+	// The buffer holds the newest updates and the subscriber stays connected
+	assert.Equal(t, int32(8), receive(t, sub).IssueCount)
+	assert.Equal(t, int32(9), receive(t, sub).IssueCount)
+	assert.Equal(t, int32(10), receive(t, sub).IssueCount)
+	assert.Equal(t, int64(7), sub.Dropped())
+	assert.NoError(t, sub.Err())
+
+	assert.Equal(t, int64(7), sub.TakeDropped())
+	assert.Equal(t, int64(0), sub.TakeDropped(), "dropped updates are reported once")
+
+	publishCounts(t, b, 11, 11)
+	assert.Equal(t, int32(11), receive(t, sub).IssueCount)
+	assert.Equal(t, int64(7), sub.Dropped())
+}
+
+func TestInMemoryBroker_DisconnectSlowConsumer(t *testing.T) {
+	b := memory.NewInMemoryBrokerWithConfig(broker.DeliveryConfig{BufferSize: 2, Policy: broker.OverflowDisconnect, MaxConsecutiveDrops: 3})
+	defer b.Close()
+	ctx := context.Background()
+
+	slow, err := b.Subscribe(ctx, testProjectID)
+	require.NoError(t, err)
+	fast, err := b.Subscribe(ctx, testProjectID)
+	require.NoError(t, err)
+
+	// Two updates fill the buffer and two more are dropped; a delivery resets the consecutive count
+	publishCounts(t, b, 1, 4, fast)
+	assert.Equal(t, int32(1), receive(t, slow).IssueCount)
+	publishCounts(t, b, 5, 7, fast)
+	assert.NoError(t, slow.Err())
+
+	// The third drop in a row disconnects the slow subscriber
+	publishCounts(t, b, 8, 8, fast)
+	assert.ErrorIs(t, slow.Err(), broker.ErrSlowConsumer)
+	assert.Equal(t, int64(5), slow.Dropped())
+
+	// Updates buffered before the disconnect are still delivered, newest ones were dropped
+	assert.Equal(t, int32(2), receive(t, slow).IssueCount)
+	assert.Equal(t, int32(5), receive(t, slow).IssueCount)
+	assertClosed(t, slow)
+
+	// The subscriber that kept up is unaffected
+	publishCounts(t, b, 9, 9, fast)
+	assert.NoError(t, fast.Err())
+	assert.Equal(t, int64(0), fast.Dropped())
+}
+
+func TestDeliveryConfigFromEnv(t *testing.T) {
+	t.Setenv("SUBSCRIBER_BUFFER_SIZE", "")
+	t.Setenv("SUBSCRIBER_OVERFLOW_POLICY", "")
+	t.Setenv("SUBSCRIBER_MAX_CONSECUTIVE_DROPS", "")
+	t.Setenv("SUBSCRIBER_REPLAY_BUFFER_SIZE", "")
+	assert.Equal(t, broker.DefaultDeliveryConfig(), broker.DeliveryConfigFromEnv())
+
+	t.Setenv("SUBSCRIBER_BUFFER_SIZE", "64")
+	t.Setenv("SUBSCRIBER_OVERFLOW_POLICY", "Disconnect")
+	t.Setenv("SUBSCRIBER_MAX_CONSECUTIVE_DROPS", "20")
+	t.Setenv("SUBSCRIBER_REPLAY_BUFFER_SIZE", "0")
+	assert.Equal(t, broker.DeliveryConfig{BufferSize: 64, Policy: broker.OverflowDisconnect, MaxConsecutiveDrops: 20}, broker.DeliveryConfigFromEnv())
+
+	// Invalid values fall back to the defaults
+	t.Setenv("SUBSCRIBER_BUFFER_SIZE", "-1")
+	t.Setenv("SUBSCRIBER_OVERFLOW_POLICY", "drop-newest")
+	t.Setenv("SUBSCRIBER_MAX_CONSECUTIVE_DROPS", "many")
+	t.Setenv("SUBSCRIBER_REPLAY_BUFFER_SIZE", "-1")
+	assert.Equal(t, broker.DefaultDeliveryConfig(), broker.DeliveryConfigFromEnv())
+}
+
+func TestInMemoryBroker_SubscribeFromReplays(t *testing.T) {
+	b := memory.NewInMemoryBrokerWithConfig(broker.DeliveryConfig{BufferSize: 2, Policy: broker.OverflowDropOldest, ReplayBufferSize: 5})
+	defer b.Close()
+	ctx := context.Background()
+
+	publishCounts(t, b, 1, 3)
+	sub, err := b.SubscribeFrom(ctx, testProjectID, time.Unix(0, 0))
+	require.NoError(t, err)
+	publishCounts(t, b, 4, 4)
+
+	// The replayed updates don't push the live one out of the subscriber's buffer
+	for count := int32(1); count <= 4; count++ {
+		update := receive(t, sub)
+		assert.Equal(t, count, update.IssueCount)
+		assert.Equal(t, count <= 3, update.Replayed, "update %d", count)
+	}
+	assert.Zero(t, sub.Dropped())
+
+	// Only the last ReplayBufferSize updates are kept, and from skips older ones
+	publishCounts(t, b, 5, 8)
+	recent, err := b.SubscribeFrom(ctx, testProjectID, time.Time{})
+	require.NoError(t, err)
+	for count := int32(4); count <= 8; count++ {
+		assert.Equal(t, count, receive(t, recent).IssueCount)
+	}
+	future, err := b.SubscribeFrom(ctx, testProjectID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, future.Updates)
+}
+
+func TestInMemoryBroker_SubscribeFromWhilePublishing(t *testing.T) {
+	const total = 200
+	b := memory.NewInMemoryBrokerWithConfig(broker.DeliveryConfig{BufferSize: total, Policy: broker.OverflowDropOldest, ReplayBufferSize: total})
+	defer b.Close()
+
+	published := make(chan int32, total)
+	go func() {
+		for count := int32(1); count <= total; count++ {
+			_ = b.PublishUpdate(context.Background(), testProjectID, &projectPbv1.ProjectUpdateResponse{ProjectId: testProjectID, IssueCount: count})
+			published <- count
+		}
+	}()
+	// Subscribe part way through
+	for <-published < total/4 {
+	}
+	sub, err := b.SubscribeFrom(context.Background(), testProjectID, time.Time{})
+	require.NoError(t, err)
+
+	// Every update arrives once and in order, replayed ones first
+	live := false
+	for count := int32(1); count <= total; count++ {
+		update := receive(t, sub)
+		require.Equal(t, count, update.IssueCount)
+		if !update.Replayed {
+			live = true
+		}
+		require.False(t, live && update.Replayed, "update %d was replayed after a live one", count)
+	}
+}
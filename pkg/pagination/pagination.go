@@ -0,0 +1,182 @@
+// Package pagination holds the page size limits and page tokens shared by the list endpoints
+// of every service
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Used when DEFAULT_PAGE_SIZE or MAX_PAGE_SIZE is not set
+const (
+	defaultDefaultPageSize = 10
+	defaultMaxPageSize     = 100
+)
+
+// Config holds the page size used when a request doesn't set one and the largest page size
+// a request can get
+type Config struct {
+	DefaultPageSize int
+	MaxPageSize     int
+}
+
+// ConfigFromEnv reads the page size limits from DEFAULT_PAGE_SIZE and MAX_PAGE_SIZE. Unset or
+// non-positive values keep the defaults of 10 and 100, and a default above the maximum is
+// lowered to the maximum.
+func ConfigFromEnv() Config {
+	cfg := Config{
+		DefaultPageSize: defaultDefaultPageSize,
+		MaxPageSize:     defaultMaxPageSize,
+	}
+	if v, err := strconv.Atoi(os.Getenv("DEFAULT_PAGE_SIZE")); err == nil && v > 0 {
+		cfg.DefaultPageSize = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("MAX_PAGE_SIZE")); err == nil && v > 0 {
+		cfg.MaxPageSize = v
+	}
+	if cfg.DefaultPageSize > cfg.MaxPageSize {
+		cfg.DefaultPageSize = cfg.MaxPageSize
+	}
+	return cfg
+}
+
+// Normalize returns the page size to list with for a requested size: def when none was
+// requested, capped at max
+func Normalize(requested int32, def, max int) int {
+	if requested <= 0 {
+		return def
+	}
+	if int(requested) > max {
+		return max
+	}
+	return int(requested)
+}
+
+// PageSize returns the page size to list with for a requested size, normalized with the
+// configured default and maximum
+func (c Config) PageSize(requested int32) int {
+	return Normalize(requested, c.DefaultPageSize, c.MaxPageSize)
+}
+
+// CheckPageSize returns an InvalidArgument error naming page_size when requested is negative
+// or above the maximum, so a client asking for more than it can get is told so rather than
+// getting a smaller page. Like request validation errors, it carries the field as a BadRequest.
+func (c Config) CheckPageSize(requested int32) error {
+	if requested >= 0 && int(requested) <= c.MaxPageSize {
+		return nil
+	}
+
+	message := fmt.Sprintf("page_size must be between 0 and %d", c.MaxPageSize)
+	st := status.New(codes.InvalidArgument, "invalid request: "+message)
+	if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+		{Field: "page_size", Description: message, Reason: "RANGE"},
+	}}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// ErrInvalidPageToken is returned for page tokens a list endpoint didn't issue
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Kinds of the items listed with page tokens
+const (
+	KindIssue   = "issue"
+	KindUser    = "user"
+	KindProject = "project"
+)
+
+// Orders listings share; a listing can also use an order of its own, such as by last name
+const (
+	// SortByID lists items by ID alone
+	SortByID = "id"
+	// SortByCreation lists items by creation time, then ID. This keeps pages stable as items
+	// are added, which listing by random IDs alone doesn't.
+	SortByCreation = "created"
+)
+
+// Token is the position a page of a listing ends at. Clients get it as an opaque string, the
+// base64 of its JSON, so what a token holds can change without clients noticing.
+type Token struct {
+	// Kind is the kind of the items listed
+	Kind string `json:"kind"`
+	// Sort is the order of the listing
+	Sort string `json:"sort"`
+	// Key is the sort key of the page's last item, such as its last name; listings by ID
+	// leave it empty
+	Key string `json:"key,omitempty"`
+	// ID is the ID of the page's last item, which breaks ties between equal keys
+	ID string `json:"id"`
+}
+
+// TimeKey returns the key of an item created at createdAt, for listings by SortByCreation
+func TimeKey(createdAt time.Time) string {
+	return strconv.FormatInt(createdAt.UnixNano(), 10)
+}
+
+// KeyTime returns the creation time held by the key of a SortByCreation token
+func (t Token) KeyTime() time.Time {
+	unixNano, _ := strconv.ParseInt(t.Key, 10, 64)
+	return time.Unix(0, unixNano).UTC()
+}
+
+// Encode returns the page token handed to clients
+func (t Token) Encode() string {
+	raw, _ := json.Marshal(t)
+	return base64.URLEncoding.EncodeToString(raw)
+}
+
+// Decode returns the token encoded in token, which must come from a listing of kind in the
+// order sort. Tokens of another listing return ErrInvalidPageToken, as does anything else a
+// listing didn't issue, such as the plain IDs in-memory listings used to issue, so a stale
+// token fails instead of starting the listing over. The "nanos:id" tokens listings by
+// creation time issued before tokens were typed are still accepted by those listings.
+func Decode(token, kind, sort string) (Token, error) {
+	raw, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return Token{}, ErrInvalidPageToken
+	}
+
+	var t Token
+	if json.Unmarshal(raw, &t) != nil {
+		if sort != SortByCreation {
+			return Token{}, ErrInvalidPageToken
+		}
+		if t, err = decodeKeysetToken(string(raw)); err != nil {
+			return Token{}, err
+		}
+		t.Kind = kind
+		t.Sort = sort
+	}
+	if t.Kind != kind || t.Sort != sort || t.ID == "" {
+		return Token{}, ErrInvalidPageToken
+	}
+	if sort == SortByCreation {
+		if _, err := strconv.ParseInt(t.Key, 10, 64); err != nil {
+			return Token{}, ErrInvalidPageToken
+		}
+	}
+	return t, nil
+}
+
+// decodeKeysetToken reads a "nanos:id" token of a listing by creation time
+func decodeKeysetToken(raw string) (Token, error) {
+	nanos, id, ok := strings.Cut(raw, ":")
+	if !ok || id == "" {
+		return Token{}, ErrInvalidPageToken
+	}
+	if _, err := strconv.ParseInt(nanos, 10, 64); err != nil {
+		return Token{}, ErrInvalidPageToken
+	}
+	return Token{Key: nanos, ID: id}, nil
+}
@@ -0,0 +1,122 @@
+package pagination_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("DEFAULT_PAGE_SIZE", "")
+	t.Setenv("MAX_PAGE_SIZE", "")
+	assert.Equal(t, pagination.Config{DefaultPageSize: 10, MaxPageSize: 100}, pagination.ConfigFromEnv())
+
+	t.Setenv("DEFAULT_PAGE_SIZE", "25")
+	t.Setenv("MAX_PAGE_SIZE", "50")
+	assert.Equal(t, pagination.Config{DefaultPageSize: 25, MaxPageSize: 50}, pagination.ConfigFromEnv())
+
+	t.Setenv("DEFAULT_PAGE_SIZE", "0")
+	t.Setenv("MAX_PAGE_SIZE", "-1")
+	assert.Equal(t, pagination.Config{DefaultPageSize: 10, MaxPageSize: 100}, pagination.ConfigFromEnv())
+
+	t.Setenv("DEFAULT_PAGE_SIZE", "20")
+	t.Setenv("MAX_PAGE_SIZE", "5")
+	assert.Equal(t, pagination.Config{DefaultPageSize: 5, MaxPageSize: 5}, pagination.ConfigFromEnv(), "the default can't exceed the maximum")
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		requested int32
+		want      int
+	}{
+		{requested: -3, want: 10},
+		{requested: 0, want: 10},
+		{requested: 1, want: 1},
+		{requested: 49, want: 49},
+		{requested: 50, want: 50},
+		{requested: 51, want: 50},
+		{requested: 500, want: 50},
+	} {
+		assert.Equal(t, tc.want, pagination.Normalize(tc.requested, 10, 50), tc.requested)
+		assert.Equal(t, tc.want, pagination.Config{DefaultPageSize: 10, MaxPageSize: 50}.PageSize(tc.requested), tc.requested)
+	}
+}
+
+func TestConfig_CheckPageSize(t *testing.T) {
+	cfg := pagination.Config{DefaultPageSize: 10, MaxPageSize: 50}
+	for _, tc := range []struct {
+		requested int32
+		valid     bool
+	}{
+		{requested: -1, valid: false},
+		{requested: 0, valid: true},
+		{requested: 1, valid: true},
+		{requested: 50, valid: true},
+		{requested: 51, valid: false},
+		{requested: 1000, valid: false},
+	} {
+		err := cfg.CheckPageSize(tc.requested)
+		if tc.valid {
+			assert.NoError(t, err, tc.requested)
+			continue
+		}
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code(), tc.requested)
+		assert.Equal(t, "invalid request: page_size must be between 0 and 50", st.Message())
+		require.Len(t, st.Details(), 1)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "page_size", badRequest.FieldViolations[0].Field)
+	}
+}
+
+func TestToken(t *testing.T) {
+	createdAt := time.Date(2024, 3, 10, 17, 0, 0, 123456789, time.UTC)
+	token := pagination.Token{
+		Kind: pagination.KindIssue, Sort: pagination.SortByCreation,
+		Key: pagination.TimeKey(createdAt), ID: "3b5d7f9a-1c2e-4a4b-9d6f-8a0c2e4f6b81",
+	}
+
+	decoded, err := pagination.Decode(token.Encode(), pagination.KindIssue, pagination.SortByCreation)
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+	assert.True(t, createdAt.Equal(decoded.KeyTime()))
+
+	for name, tc := range map[string]struct {
+		token      string
+		kind, sort string
+	}{
+		"another kind":                  {token: token.Encode(), kind: pagination.KindUser, sort: pagination.SortByCreation},
+		"another order":                 {token: token.Encode(), kind: pagination.KindIssue, sort: pagination.SortByID},
+		"a plain ID":                    {token: "3b5d7f9a-1c2e-4a4b-9d6f-8a0c2e4f6b81", kind: pagination.KindIssue, sort: pagination.SortByID},
+		"not base64":                    {token: "next page", kind: pagination.KindIssue, sort: pagination.SortByCreation},
+		"no ID":                         {token: pagination.Token{Kind: pagination.KindProject, Sort: pagination.SortByID}.Encode(), kind: pagination.KindProject, sort: pagination.SortByID},
+		"a key, not time":               {token: pagination.Token{Kind: pagination.KindIssue, Sort: pagination.SortByCreation, Key: "yesterday", ID: "3b5d7f9a"}.Encode(), kind: pagination.KindIssue, sort: pagination.SortByCreation},
+		"an old token without an ID":    {token: base64.URLEncoding.EncodeToString([]byte("1710090000:")), kind: pagination.KindIssue, sort: pagination.SortByCreation},
+		"an old token without a time":   {token: base64.URLEncoding.EncodeToString([]byte("yesterday:3b5d7f9a")), kind: pagination.KindIssue, sort: pagination.SortByCreation},
+		"an old token of another order": {token: base64.URLEncoding.EncodeToString([]byte("1710090000:3b5d7f9a")), kind: pagination.KindUser, sort: "last_name"},
+	} {
+		_, err := pagination.Decode(tc.token, tc.kind, tc.sort)
+		assert.ErrorIs(t, err, pagination.ErrInvalidPageToken, name)
+	}
+}
+
+func TestDecode_AcceptsKeysetTokens(t *testing.T) {
+	// Listings by creation time issued "nanos:id" tokens before tokens were typed
+	createdAt := time.Date(2024, 3, 10, 17, 0, 0, 123456789, time.UTC)
+	old := base64.URLEncoding.EncodeToString([]byte("1710090000123456789:3b5d7f9a-1c2e-4a4b-9d6f-8a0c2e4f6b81"))
+
+	decoded, err := pagination.Decode(old, pagination.KindUser, pagination.SortByCreation)
+	require.NoError(t, err)
+	assert.Equal(t, pagination.KindUser, decoded.Kind)
+	assert.Equal(t, "3b5d7f9a-1c2e-4a4b-9d6f-8a0c2e4f6b81", decoded.ID)
+	assert.True(t, createdAt.Equal(decoded.KeyTime()))
+}
@@ -94,6 +94,7 @@ const (
 	Resolution_INVALID                Resolution = 2
 	Resolution_WONTFIX                Resolution = 3
 	Resolution_WORKSFORME             Resolution = 4
+	Resolution_DUPLICATE              Resolution = 5
 )
 
 // Enum value maps for Resolution.
@@ -104,6 +105,7 @@ var (
 		2: "INVALID",
 		3: "WONTFIX",
 		4: "WORKSFORME",
+		5: "DUPLICATE",
 	}
 	Resolution_value = map[string]int32{
 		"RESOLUTION_UNSPECIFIED": 0,
@@ -111,6 +113,7 @@ var (
 		"INVALID":                2,
 		"WONTFIX":                3,
 		"WORKSFORME":             4,
+		"DUPLICATE":              5,
 	}
 )
 
@@ -251,21 +254,87 @@ func (Priority) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{3}
 }
 
+type LinkType int32
+
+const (
+	LinkType_LINK_TYPE_UNSPECIFIED LinkType = 0
+	LinkType_BLOCKS                LinkType = 1
+	LinkType_BLOCKED_BY            LinkType = 2
+	LinkType_RELATES_TO            LinkType = 3
+	LinkType_DUPLICATES            LinkType = 4
+)
+
+// Enum value maps for LinkType.
+var (
+	LinkType_name = map[int32]string{
+		0: "LINK_TYPE_UNSPECIFIED",
+		1: "BLOCKS",
+		2: "BLOCKED_BY",
+		3: "RELATES_TO",
+		4: "DUPLICATES",
+	}
+	LinkType_value = map[string]int32{
+		"LINK_TYPE_UNSPECIFIED": 0,
+		"BLOCKS":                1,
+		"BLOCKED_BY":            2,
+		"RELATES_TO":            3,
+		"DUPLICATES":            4,
+	}
+)
+
+func (x LinkType) Enum() *LinkType {
+	p := new(LinkType)
+	*p = x
+	return p
+}
+
+func (x LinkType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_issues_v1_issues_proto_enumTypes[4].Descriptor()
+}
+
+func (LinkType) Type() protoreflect.EnumType {
+	return &file_pkg_pb_issues_v1_issues_proto_enumTypes[4]
+}
+
+func (x LinkType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkType.Descriptor instead.
+func (LinkType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{4}
+}
+
 type Issue struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=issues.v1.Status" json:"status,omitempty"`
-	Resolution    Resolution             `protobuf:"varint,5,opt,name=resolution,proto3,enum=issues.v1.Resolution" json:"resolution,omitempty"`
-	Type          Type                   `protobuf:"varint,6,opt,name=type,proto3,enum=issues.v1.Type" json:"type,omitempty"`
-	Priority      Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=issues.v1.Priority" json:"priority,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AssigneeId    string                 `protobuf:"bytes,9,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
-	CreateDate    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"` // uneditable
-	ModifyDate    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=modify_date,json=modifyDate,proto3" json:"modify_date,omitempty"` // uneditable
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IssueId           string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Summary           string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`         // length is limited by SUMMARY_MAX_LENGTH
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // markdown; length is limited by DESCRIPTION_MAX_LENGTH
+	Status            Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=issues.v1.Status" json:"status,omitempty"`
+	Resolution        Resolution             `protobuf:"varint,5,opt,name=resolution,proto3,enum=issues.v1.Resolution" json:"resolution,omitempty"`
+	Type              Type                   `protobuf:"varint,6,opt,name=type,proto3,enum=issues.v1.Type" json:"type,omitempty"`
+	Priority          Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=issues.v1.Priority" json:"priority,omitempty"`
+	ProjectId         string                 `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AssigneeId        string                 `protobuf:"bytes,9,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	CreateDate        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`    // uneditable
+	ModifyDate        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=modify_date,json=modifyDate,proto3" json:"modify_date,omitempty"`    // uneditable
+	DuplicateOf       string                 `protobuf:"bytes,12,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"` // set when resolved as DUPLICATE
+	ResolutionComment string                 `protobuf:"bytes,13,opt,name=resolution_comment,json=resolutionComment,proto3" json:"resolution_comment,omitempty"`
+	ReopenCount       int32                  `protobuf:"varint,14,opt,name=reopen_count,json=reopenCount,proto3" json:"reopen_count,omitempty"`                 // uneditable
+	LastReopenReason  string                 `protobuf:"bytes,15,opt,name=last_reopen_reason,json=lastReopenReason,proto3" json:"last_reopen_reason,omitempty"` // uneditable
+	ResolveDate       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=resolve_date,json=resolveDate,proto3" json:"resolve_date,omitempty"`                  // uneditable, set when the issue is first resolved or closed
+	Version           int64                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`                                            // uneditable, incremented on every update
+	ClonedFrom        string                 `protobuf:"bytes,18,opt,name=cloned_from,json=clonedFrom,proto3" json:"cloned_from,omitempty"`                     // uneditable, set on issues created by CloneIssue
+	IssueNumber       int32                  `protobuf:"varint,19,opt,name=issue_number,json=issueNumber,proto3" json:"issue_number,omitempty"`                 // uneditable, sequential within the project starting at 1; reassigned when the issue moves
+	DueDate           *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`                              // assignees are reminded of open issues shortly before it
+	TeamId            string                 `protobuf:"bytes,21,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                                 // uneditable, the team the issue was created for
+	LastReopenedBy    string                 `protobuf:"bytes,22,opt,name=last_reopened_by,json=lastReopenedBy,proto3" json:"last_reopened_by,omitempty"`       // uneditable, the user who last reopened the issue, when known
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Issue) Reset() {
@@ -375,14 +444,96 @@ func (x *Issue) GetModifyDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Issue) GetDuplicateOf() string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return ""
+}
+
+func (x *Issue) GetResolutionComment() string {
+	if x != nil {
+		return x.ResolutionComment
+	}
+	return ""
+}
+
+func (x *Issue) GetReopenCount() int32 {
+	if x != nil {
+		return x.ReopenCount
+	}
+	return 0
+}
+
+func (x *Issue) GetLastReopenReason() string {
+	if x != nil {
+		return x.LastReopenReason
+	}
+	return ""
+}
+
+func (x *Issue) GetResolveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolveDate
+	}
+	return nil
+}
+
+func (x *Issue) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Issue) GetClonedFrom() string {
+	if x != nil {
+		return x.ClonedFrom
+	}
+	return ""
+}
+
+func (x *Issue) GetIssueNumber() int32 {
+	if x != nil {
+		return x.IssueNumber
+	}
+	return 0
+}
+
+func (x *Issue) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Issue) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *Issue) GetLastReopenedBy() string {
+	if x != nil {
+		return x.LastReopenedBy
+	}
+	return ""
+}
+
 type CreateIssueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Type          Type                   `protobuf:"varint,3,opt,name=type,proto3,enum=issues.v1.Type" json:"type,omitempty"`
-	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=issues.v1.Priority" json:"priority,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AssigneeId    *string                `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Summary     string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`               // length is limited by SUMMARY_MAX_LENGTH
+	Description *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"` // markdown; disallowed HTML is removed and the length is limited by DESCRIPTION_MAX_LENGTH
+	Type        Type                   `protobuf:"varint,3,opt,name=type,proto3,enum=issues.v1.Type" json:"type,omitempty"`
+	Priority    Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=issues.v1.Priority" json:"priority,omitempty"`
+	ProjectId   string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AssigneeId  *string                `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	TemplateId  string                 `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // project issue template whose defaults fill unset fields
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Without an assignee_id, the issue is assigned to the next available member of the team in
+	// round-robin order; the team must belong to the project
+	TeamId        *string `protobuf:"bytes,9,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -459,6 +610,27 @@ func (x *CreateIssueRequest) GetAssigneeId() string {
 	return ""
 }
 
+func (x *CreateIssueRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateIssueRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
 type CreateIssueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -568,6 +740,7 @@ type GetIssueResponse struct {
 	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
 	ProjectInfo   *ProjectInfo           `protobuf:"bytes,2,opt,name=project_info,json=projectInfo,proto3" json:"project_info,omitempty"`
 	UserInfo      *UserInfo              `protobuf:"bytes,3,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	DetailsErrors []string               `protobuf:"bytes,4,rep,name=details_errors,json=detailsErrors,proto3" json:"details_errors,omitempty"` // lookups of project_info or user_info that failed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -623,34 +796,35 @@ func (x *GetIssueResponse) GetUserInfo() *UserInfo {
 	return nil
 }
 
-type UpdateIssueRequest struct {
+func (x *GetIssueResponse) GetDetailsErrors() []string {
+	if x != nil {
+		return x.DetailsErrors
+	}
+	return nil
+}
+
+type GetIssueByProjectAndNumberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Status        Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=issues.v1.Status" json:"status,omitempty"`
-	Resolution    Resolution             `protobuf:"varint,5,opt,name=resolution,proto3,enum=issues.v1.Resolution" json:"resolution,omitempty"`
-	Type          Type                   `protobuf:"varint,6,opt,name=type,proto3,enum=issues.v1.Type" json:"type,omitempty"`
-	Priority      Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=issues.v1.Priority" json:"priority,omitempty"`
-	AssigneeId    *string                `protobuf:"bytes,8,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IssueNumber   int32                  `protobuf:"varint,2,opt,name=issue_number,json=issueNumber,proto3" json:"issue_number,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateIssueRequest) Reset() {
-	*x = UpdateIssueRequest{}
+func (x *GetIssueByProjectAndNumberRequest) Reset() {
+	*x = GetIssueByProjectAndNumberRequest{}
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIssueRequest) String() string {
+func (x *GetIssueByProjectAndNumberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIssueRequest) ProtoMessage() {}
+func (*GetIssueByProjectAndNumberRequest) ProtoMessage() {}
 
-func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
+func (x *GetIssueByProjectAndNumberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,89 +836,46 @@ func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIssueRequest.ProtoReflect.Descriptor instead.
-func (*UpdateIssueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIssueByProjectAndNumberRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueByProjectAndNumberRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateIssueRequest) GetIssueId() string {
-	if x != nil {
-		return x.IssueId
-	}
-	return ""
-}
-
-func (x *UpdateIssueRequest) GetSummary() string {
+func (x *GetIssueByProjectAndNumberRequest) GetProjectId() string {
 	if x != nil {
-		return x.Summary
-	}
-	return ""
-}
-
-func (x *UpdateIssueRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *UpdateIssueRequest) GetStatus() Status {
-	if x != nil {
-		return x.Status
-	}
-	return Status_STATUS_UNSPECIFIED
-}
-
-func (x *UpdateIssueRequest) GetResolution() Resolution {
-	if x != nil {
-		return x.Resolution
-	}
-	return Resolution_RESOLUTION_UNSPECIFIED
-}
-
-func (x *UpdateIssueRequest) GetType() Type {
-	if x != nil {
-		return x.Type
-	}
-	return Type_TYPE_UNSPECIFIED
-}
-
-func (x *UpdateIssueRequest) GetPriority() Priority {
+func (x *GetIssueByProjectAndNumberRequest) GetIssueNumber() int32 {
 	if x != nil {
-		return x.Priority
-	}
-	return Priority_PRIORITY_UNSPECIFIED
-}
-
-func (x *UpdateIssueRequest) GetAssigneeId() string {
-	if x != nil && x.AssigneeId != nil {
-		return *x.AssigneeId
+		return x.IssueNumber
 	}
-	return ""
+	return 0
 }
 
-type UpdateIssueResponse struct {
+type GetIssueByProjectAndNumberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateIssueResponse) Reset() {
-	*x = UpdateIssueResponse{}
+func (x *GetIssueByProjectAndNumberResponse) Reset() {
+	*x = GetIssueByProjectAndNumberResponse{}
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateIssueResponse) String() string {
+func (x *GetIssueByProjectAndNumberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateIssueResponse) ProtoMessage() {}
+func (*GetIssueByProjectAndNumberResponse) ProtoMessage() {}
 
-func (x *UpdateIssueResponse) ProtoReflect() protoreflect.Message {
+func (x *GetIssueByProjectAndNumberResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -756,46 +887,39 @@ func (x *UpdateIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateIssueResponse.ProtoReflect.Descriptor instead.
-func (*UpdateIssueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIssueByProjectAndNumberResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueByProjectAndNumberResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateIssueResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *UpdateIssueResponse) GetIssue() *Issue {
+func (x *GetIssueByProjectAndNumberResponse) GetIssue() *Issue {
 	if x != nil {
 		return x.Issue
 	}
 	return nil
 }
 
-type DeleteIssueRequest struct {
+type BatchGetIssuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	IssueIds      []string               `protobuf:"bytes,1,rep,name=issue_ids,json=issueIds,proto3" json:"issue_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIssueRequest) Reset() {
-	*x = DeleteIssueRequest{}
+func (x *BatchGetIssuesRequest) Reset() {
+	*x = BatchGetIssuesRequest{}
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIssueRequest) String() string {
+func (x *BatchGetIssuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIssueRequest) ProtoMessage() {}
+func (*BatchGetIssuesRequest) ProtoMessage() {}
 
-func (x *DeleteIssueRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetIssuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,40 +931,40 @@ func (x *DeleteIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIssueRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIssueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetIssuesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetIssuesRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteIssueRequest) GetIssueId() string {
+func (x *BatchGetIssuesRequest) GetIssueIds() []string {
 	if x != nil {
-		return x.IssueId
+		return x.IssueIds
 	}
-	return ""
+	return nil
 }
 
-type DeleteIssueResponse struct {
+type BatchGetIssuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"` // in the order of the requested IDs
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIssueResponse) Reset() {
-	*x = DeleteIssueResponse{}
+func (x *BatchGetIssuesResponse) Reset() {
+	*x = BatchGetIssuesResponse{}
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIssueResponse) String() string {
+func (x *BatchGetIssuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIssueResponse) ProtoMessage() {}
+func (*BatchGetIssuesResponse) ProtoMessage() {}
 
-func (x *DeleteIssueResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchGetIssuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -852,47 +976,57 @@ func (x *DeleteIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIssueResponse.ProtoReflect.Descriptor instead.
-func (*DeleteIssueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetIssuesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetIssuesResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteIssueResponse) GetMessage() string {
+func (x *BatchGetIssuesResponse) GetIssues() []*Issue {
 	if x != nil {
-		return x.Message
+		return x.Issues
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteIssueResponse) GetIssue() *Issue {
+func (x *BatchGetIssuesResponse) GetNotFoundIds() []string {
 	if x != nil {
-		return x.Issue
+		return x.NotFoundIds
 	}
 	return nil
 }
 
-type ListIssuesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateIssueRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IssueId           string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Summary           string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`               // length is limited by SUMMARY_MAX_LENGTH
+	Description       *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"` // markdown; disallowed HTML is removed and the length is limited by DESCRIPTION_MAX_LENGTH
+	Status            Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=issues.v1.Status" json:"status,omitempty"`
+	Resolution        Resolution             `protobuf:"varint,5,opt,name=resolution,proto3,enum=issues.v1.Resolution" json:"resolution,omitempty"`
+	Type              Type                   `protobuf:"varint,6,opt,name=type,proto3,enum=issues.v1.Type" json:"type,omitempty"`
+	Priority          Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=issues.v1.Priority" json:"priority,omitempty"`
+	AssigneeId        *string                `protobuf:"bytes,8,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	DuplicateOf       *string                `protobuf:"bytes,9,opt,name=duplicate_of,json=duplicateOf,proto3,oneof" json:"duplicate_of,omitempty"`
+	ResolutionComment *string                `protobuf:"bytes,10,opt,name=resolution_comment,json=resolutionComment,proto3,oneof" json:"resolution_comment,omitempty"`
+	Version           int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`               // version last read by the client; rejected if the issue changed since
+	DueDate           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // unset keeps the current due date
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListIssuesRequest) Reset() {
-	*x = ListIssuesRequest{}
+func (x *UpdateIssueRequest) Reset() {
+	*x = UpdateIssueRequest{}
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIssuesRequest) String() string {
+func (x *UpdateIssueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIssuesRequest) ProtoMessage() {}
+func (*UpdateIssueRequest) ProtoMessage() {}
 
-func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -904,48 +1038,1941 @@ func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
-func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateIssueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIssueRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListIssuesRequest) GetPageSize() int32 {
+func (x *UpdateIssueRequest) GetIssueId() string {
 	if x != nil {
-		return x.PageSize
+		return x.IssueId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListIssuesRequest) GetPageToken() string {
+func (x *UpdateIssueRequest) GetSummary() string {
 	if x != nil {
-		return x.PageToken
+		return x.Summary
 	}
 	return ""
 }
 
-type ListIssuesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateIssueRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
 }
 
-func (x *ListIssuesResponse) Reset() {
-	*x = ListIssuesResponse{}
+func (x *UpdateIssueRequest) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateIssueRequest) GetResolution() Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return Resolution_RESOLUTION_UNSPECIFIED
+}
+
+func (x *UpdateIssueRequest) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateIssueRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *UpdateIssueRequest) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetDuplicateOf() string {
+	if x != nil && x.DuplicateOf != nil {
+		return *x.DuplicateOf
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetResolutionComment() string {
+	if x != nil && x.ResolutionComment != nil {
+		return *x.ResolutionComment
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateIssueRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type UpdateIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIssueResponse) Reset() {
+	*x = UpdateIssueResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIssueResponse) ProtoMessage() {}
+
+func (x *UpdateIssueResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIssueResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIssueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateIssueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type DeleteIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueRequest) Reset() {
+	*x = DeleteIssueRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueRequest) ProtoMessage() {}
+
+func (x *DeleteIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIssueRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIssueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type DeleteIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueResponse) Reset() {
+	*x = DeleteIssueResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueResponse) ProtoMessage() {}
+
+func (x *DeleteIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIssueResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIssueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteIssueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type ListIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 lists DEFAULT_PAGE_SIZE items; sizes above MAX_PAGE_SIZE are rejected
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesRequest) Reset() {
+	*x = ListIssuesRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesRequest) ProtoMessage() {}
+
+func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListIssuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIssuesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesResponse) Reset() {
+	*x = ListIssuesResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesResponse) ProtoMessage() {}
+
+func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ListIssuesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AssignIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,2,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // empty unassigns
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignIssueRequest) Reset() {
+	*x = AssignIssueRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignIssueRequest) ProtoMessage() {}
+
+func (x *AssignIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignIssueRequest.ProtoReflect.Descriptor instead.
+func (*AssignIssueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AssignIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *AssignIssueRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+type AssignIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignIssueResponse) Reset() {
+	*x = AssignIssueResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignIssueResponse) ProtoMessage() {}
+
+func (x *AssignIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignIssueResponse.ProtoReflect.Descriptor instead.
+func (*AssignIssueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AssignIssueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AssignIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type ChangeIssueStatusRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IssueId           string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	NewStatus         Status                 `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=issues.v1.Status" json:"new_status,omitempty"`
+	Resolution        Resolution             `protobuf:"varint,3,opt,name=resolution,proto3,enum=issues.v1.Resolution" json:"resolution,omitempty"` // required for RESOLVED and CLOSED
+	ResolutionComment string                 `protobuf:"bytes,4,opt,name=resolution_comment,json=resolutionComment,proto3" json:"resolution_comment,omitempty"`
+	DuplicateOf       string                 `protobuf:"bytes,5,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChangeIssueStatusRequest) Reset() {
+	*x = ChangeIssueStatusRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeIssueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeIssueStatusRequest) ProtoMessage() {}
+
+func (x *ChangeIssueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeIssueStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeIssueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChangeIssueStatusRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ChangeIssueStatusRequest) GetNewStatus() Status {
+	if x != nil {
+		return x.NewStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *ChangeIssueStatusRequest) GetResolution() Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return Resolution_RESOLUTION_UNSPECIFIED
+}
+
+func (x *ChangeIssueStatusRequest) GetResolutionComment() string {
+	if x != nil {
+		return x.ResolutionComment
+	}
+	return ""
+}
+
+func (x *ChangeIssueStatusRequest) GetDuplicateOf() string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return ""
+}
+
+type ChangeIssueStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeIssueStatusResponse) Reset() {
+	*x = ChangeIssueStatusResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeIssueStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeIssueStatusResponse) ProtoMessage() {}
+
+func (x *ChangeIssueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeIssueStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeIssueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChangeIssueStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChangeIssueStatusResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type ReopenIssueRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	IssueId string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Reason  string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Assigns the reopened issue to this user instead of its previous assignee
+	AssigneeId    *string `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenIssueRequest) Reset() {
+	*x = ReopenIssueRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenIssueRequest) ProtoMessage() {}
+
+func (x *ReopenIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenIssueRequest.ProtoReflect.Descriptor instead.
+func (*ReopenIssueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReopenIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ReopenIssueRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReopenIssueRequest) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+type ReopenIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenIssueResponse) Reset() {
+	*x = ReopenIssueResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenIssueResponse) ProtoMessage() {}
+
+func (x *ReopenIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenIssueResponse.ProtoReflect.Descriptor instead.
+func (*ReopenIssueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReopenIssueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReopenIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type MoveIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	FromProjectId string                 `protobuf:"bytes,2,opt,name=from_project_id,json=fromProjectId,proto3" json:"from_project_id,omitempty"`
+	ToProjectId   string                 `protobuf:"bytes,3,opt,name=to_project_id,json=toProjectId,proto3" json:"to_project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveIssueRequest) Reset() {
+	*x = MoveIssueRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveIssueRequest) ProtoMessage() {}
+
+func (x *MoveIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveIssueRequest.ProtoReflect.Descriptor instead.
+func (*MoveIssueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MoveIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *MoveIssueRequest) GetFromProjectId() string {
+	if x != nil {
+		return x.FromProjectId
+	}
+	return ""
+}
+
+func (x *MoveIssueRequest) GetToProjectId() string {
+	if x != nil {
+		return x.ToProjectId
+	}
+	return ""
+}
+
+type MoveIssueResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Message               string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Issue                 *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	FromProjectIssueCount int32                  `protobuf:"varint,3,opt,name=from_project_issue_count,json=fromProjectIssueCount,proto3" json:"from_project_issue_count,omitempty"`
+	ToProjectIssueCount   int32                  `protobuf:"varint,4,opt,name=to_project_issue_count,json=toProjectIssueCount,proto3" json:"to_project_issue_count,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MoveIssueResponse) Reset() {
+	*x = MoveIssueResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveIssueResponse) ProtoMessage() {}
+
+func (x *MoveIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveIssueResponse.ProtoReflect.Descriptor instead.
+func (*MoveIssueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MoveIssueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MoveIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *MoveIssueResponse) GetFromProjectIssueCount() int32 {
+	if x != nil {
+		return x.FromProjectIssueCount
+	}
+	return 0
+}
+
+func (x *MoveIssueResponse) GetToProjectIssueCount() int32 {
+	if x != nil {
+		return x.ToProjectIssueCount
+	}
+	return 0
+}
+
+type CloneIssueRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceIssueId   string                 `protobuf:"bytes,1,opt,name=source_issue_id,json=sourceIssueId,proto3" json:"source_issue_id,omitempty"`
+	TargetProjectId string                 `protobuf:"bytes,2,opt,name=target_project_id,json=targetProjectId,proto3" json:"target_project_id,omitempty"` // defaults to the source issue's project
+	SummaryPrefix   *string                `protobuf:"bytes,3,opt,name=summary_prefix,json=summaryPrefix,proto3,oneof" json:"summary_prefix,omitempty"`   // defaults to "[CLONE]"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CloneIssueRequest) Reset() {
+	*x = CloneIssueRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneIssueRequest) ProtoMessage() {}
+
+func (x *CloneIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneIssueRequest.ProtoReflect.Descriptor instead.
+func (*CloneIssueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CloneIssueRequest) GetSourceIssueId() string {
+	if x != nil {
+		return x.SourceIssueId
+	}
+	return ""
+}
+
+func (x *CloneIssueRequest) GetTargetProjectId() string {
+	if x != nil {
+		return x.TargetProjectId
+	}
+	return ""
+}
+
+func (x *CloneIssueRequest) GetSummaryPrefix() string {
+	if x != nil && x.SummaryPrefix != nil {
+		return *x.SummaryPrefix
+	}
+	return ""
+}
+
+type CloneIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ClonedIssue   *Issue                 `protobuf:"bytes,2,opt,name=cloned_issue,json=clonedIssue,proto3" json:"cloned_issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneIssueResponse) Reset() {
+	*x = CloneIssueResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneIssueResponse) ProtoMessage() {}
+
+func (x *CloneIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneIssueResponse.ProtoReflect.Descriptor instead.
+func (*CloneIssueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CloneIssueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CloneIssueResponse) GetClonedIssue() *Issue {
+	if x != nil {
+		return x.ClonedIssue
+	}
+	return nil
+}
+
+type IssueLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	SourceIssueId string                 `protobuf:"bytes,2,opt,name=source_issue_id,json=sourceIssueId,proto3" json:"source_issue_id,omitempty"`
+	TargetIssueId string                 `protobuf:"bytes,3,opt,name=target_issue_id,json=targetIssueId,proto3" json:"target_issue_id,omitempty"`
+	Type          LinkType               `protobuf:"varint,4,opt,name=type,proto3,enum=issues.v1.LinkType" json:"type,omitempty"` // relation of the source issue to the target issue
+	CreateDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueLink) Reset() {
+	*x = IssueLink{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLink) ProtoMessage() {}
+
+func (x *IssueLink) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLink.ProtoReflect.Descriptor instead.
+func (*IssueLink) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IssueLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *IssueLink) GetSourceIssueId() string {
+	if x != nil {
+		return x.SourceIssueId
+	}
+	return ""
+}
+
+func (x *IssueLink) GetTargetIssueId() string {
+	if x != nil {
+		return x.TargetIssueId
+	}
+	return ""
+}
+
+func (x *IssueLink) GetType() LinkType {
+	if x != nil {
+		return x.Type
+	}
+	return LinkType_LINK_TYPE_UNSPECIFIED
+}
+
+func (x *IssueLink) GetCreateDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateDate
+	}
+	return nil
+}
+
+type LinkIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceIssueId string                 `protobuf:"bytes,1,opt,name=source_issue_id,json=sourceIssueId,proto3" json:"source_issue_id,omitempty"`
+	TargetIssueId string                 `protobuf:"bytes,2,opt,name=target_issue_id,json=targetIssueId,proto3" json:"target_issue_id,omitempty"`
+	Type          LinkType               `protobuf:"varint,3,opt,name=type,proto3,enum=issues.v1.LinkType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIssuesRequest) Reset() {
+	*x = LinkIssuesRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIssuesRequest) ProtoMessage() {}
+
+func (x *LinkIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIssuesRequest.ProtoReflect.Descriptor instead.
+func (*LinkIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LinkIssuesRequest) GetSourceIssueId() string {
+	if x != nil {
+		return x.SourceIssueId
+	}
+	return ""
+}
+
+func (x *LinkIssuesRequest) GetTargetIssueId() string {
+	if x != nil {
+		return x.TargetIssueId
+	}
+	return ""
+}
+
+func (x *LinkIssuesRequest) GetType() LinkType {
+	if x != nil {
+		return x.Type
+	}
+	return LinkType_LINK_TYPE_UNSPECIFIED
+}
+
+type LinkIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Link          *IssueLink             `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIssuesResponse) Reset() {
+	*x = LinkIssuesResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIssuesResponse) ProtoMessage() {}
+
+func (x *LinkIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIssuesResponse.ProtoReflect.Descriptor instead.
+func (*LinkIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LinkIssuesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LinkIssuesResponse) GetLink() *IssueLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type UnlinkIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkId        string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIssuesRequest) Reset() {
+	*x = UnlinkIssuesRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIssuesRequest) ProtoMessage() {}
+
+func (x *UnlinkIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIssuesRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnlinkIssuesRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type UnlinkIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Link          *IssueLink             `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIssuesResponse) Reset() {
+	*x = UnlinkIssuesResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIssuesResponse) ProtoMessage() {}
+
+func (x *UnlinkIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIssuesResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnlinkIssuesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnlinkIssuesResponse) GetLink() *IssueLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type ListIssueLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueLinksRequest) Reset() {
+	*x = ListIssueLinksRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueLinksRequest) ProtoMessage() {}
+
+func (x *ListIssueLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueLinksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListIssueLinksRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type ListIssueLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*IssueLink           `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"` // links in both directions, seen from the requested issue
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueLinksResponse) Reset() {
+	*x = ListIssueLinksResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueLinksResponse) ProtoMessage() {}
+
+func (x *ListIssueLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueLinksResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListIssueLinksResponse) GetLinks() []*IssueLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type GetIssueResolutionMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IssueType     Type                   `protobuf:"varint,2,opt,name=issue_type,json=issueType,proto3,enum=issues.v1.Type" json:"issue_type,omitempty"` // unspecified includes every type
+	FromDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`                         // inclusive, matched against the resolve date
+	ToDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                               // exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueResolutionMetricsRequest) Reset() {
+	*x = GetIssueResolutionMetricsRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueResolutionMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueResolutionMetricsRequest) ProtoMessage() {}
+
+func (x *GetIssueResolutionMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueResolutionMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueResolutionMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetIssueResolutionMetricsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetIssueResolutionMetricsRequest) GetIssueType() Type {
+	if x != nil {
+		return x.IssueType
+	}
+	return Type_TYPE_UNSPECIFIED
+}
+
+func (x *GetIssueResolutionMetricsRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *GetIssueResolutionMetricsRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+type GetIssueResolutionMetricsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	P50Hours       float64                `protobuf:"fixed64,1,opt,name=p50_hours,json=p50Hours,proto3" json:"p50_hours,omitempty"`
+	P90Hours       float64                `protobuf:"fixed64,2,opt,name=p90_hours,json=p90Hours,proto3" json:"p90_hours,omitempty"`
+	P99Hours       float64                `protobuf:"fixed64,3,opt,name=p99_hours,json=p99Hours,proto3" json:"p99_hours,omitempty"`
+	TotalResolved  int32                  `protobuf:"varint,4,opt,name=total_resolved,json=totalResolved,proto3" json:"total_resolved,omitempty"`
+	SloBreachCount int32                  `protobuf:"varint,5,opt,name=slo_breach_count,json=sloBreachCount,proto3" json:"slo_breach_count,omitempty"` // issues that took longer than slo_hours to resolve
+	SloHours       float64                `protobuf:"fixed64,6,opt,name=slo_hours,json=sloHours,proto3" json:"slo_hours,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetIssueResolutionMetricsResponse) Reset() {
+	*x = GetIssueResolutionMetricsResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueResolutionMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueResolutionMetricsResponse) ProtoMessage() {}
+
+func (x *GetIssueResolutionMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueResolutionMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueResolutionMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetIssueResolutionMetricsResponse) GetP50Hours() float64 {
+	if x != nil {
+		return x.P50Hours
+	}
+	return 0
+}
+
+func (x *GetIssueResolutionMetricsResponse) GetP90Hours() float64 {
+	if x != nil {
+		return x.P90Hours
+	}
+	return 0
+}
+
+func (x *GetIssueResolutionMetricsResponse) GetP99Hours() float64 {
+	if x != nil {
+		return x.P99Hours
+	}
+	return 0
+}
+
+func (x *GetIssueResolutionMetricsResponse) GetTotalResolved() int32 {
+	if x != nil {
+		return x.TotalResolved
+	}
+	return 0
+}
+
+func (x *GetIssueResolutionMetricsResponse) GetSloBreachCount() int32 {
+	if x != nil {
+		return x.SloBreachCount
+	}
+	return 0
+}
+
+func (x *GetIssueResolutionMetricsResponse) GetSloHours() float64 {
+	if x != nil {
+		return x.SloHours
+	}
+	return 0
+}
+
+type GenerateProjectDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // start of the window, inclusive; defaults to 24 hours ago
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateProjectDigestRequest) Reset() {
+	*x = GenerateProjectDigestRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateProjectDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateProjectDigestRequest) ProtoMessage() {}
+
+func (x *GenerateProjectDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateProjectDigestRequest.ProtoReflect.Descriptor instead.
+func (*GenerateProjectDigestRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GenerateProjectDigestRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GenerateProjectDigestRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// ProjectDigest summarizes a project's issue activity over a window that ends when the digest is generated
+type ProjectDigest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`        // inclusive
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`              // exclusive
+	CreatedCount  int32                  `protobuf:"varint,4,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`    // issues created in the window
+	ResolvedCount int32                  `protobuf:"varint,5,opt,name=resolved_count,json=resolvedCount,proto3" json:"resolved_count,omitempty"` // issues first resolved or closed in the window
+	ClosedCount   int32                  `protobuf:"varint,6,opt,name=closed_count,json=closedCount,proto3" json:"closed_count,omitempty"`       // closed issues last modified in the window
+	OverdueCount  int32                  `protobuf:"varint,7,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"`    // issues still open that were created more than overdue_days before the window end
+	OverdueDays   int32                  `protobuf:"varint,8,opt,name=overdue_days,json=overdueDays,proto3" json:"overdue_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectDigest) Reset() {
+	*x = ProjectDigest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDigest) ProtoMessage() {}
+
+func (x *ProjectDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDigest.ProtoReflect.Descriptor instead.
+func (*ProjectDigest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProjectDigest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectDigest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ProjectDigest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *ProjectDigest) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ProjectDigest) GetResolvedCount() int32 {
+	if x != nil {
+		return x.ResolvedCount
+	}
+	return 0
+}
+
+func (x *ProjectDigest) GetClosedCount() int32 {
+	if x != nil {
+		return x.ClosedCount
+	}
+	return 0
+}
+
+func (x *ProjectDigest) GetOverdueCount() int32 {
+	if x != nil {
+		return x.OverdueCount
+	}
+	return 0
+}
+
+func (x *ProjectDigest) GetOverdueDays() int32 {
+	if x != nil {
+		return x.OverdueDays
+	}
+	return 0
+}
+
+type GenerateProjectDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *ProjectDigest         `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateProjectDigestResponse) Reset() {
+	*x = GenerateProjectDigestResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateProjectDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateProjectDigestResponse) ProtoMessage() {}
+
+func (x *GenerateProjectDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateProjectDigestResponse.ProtoReflect.Descriptor instead.
+func (*GenerateProjectDigestResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GenerateProjectDigestResponse) GetDigest() *ProjectDigest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type GetProjectProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectProgressRequest) Reset() {
+	*x = GetProjectProgressRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectProgressRequest) ProtoMessage() {}
+
+func (x *GetProjectProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectProgressRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetProjectProgressRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectProgressResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalIssues    int32                  `protobuf:"varint,1,opt,name=total_issues,json=totalIssues,proto3" json:"total_issues,omitempty"`
+	ClosedIssues   int32                  `protobuf:"varint,2,opt,name=closed_issues,json=closedIssues,proto3" json:"closed_issues,omitempty"`
+	ResolvedIssues int32                  `protobuf:"varint,3,opt,name=resolved_issues,json=resolvedIssues,proto3" json:"resolved_issues,omitempty"`
+	CompletionPct  float32                `protobuf:"fixed32,4,opt,name=completion_pct,json=completionPct,proto3" json:"completion_pct,omitempty"`                                                                               // (closed_issues + resolved_issues) / total_issues * 100; 0 for a project without issues
+	IssuesByStatus map[string]int32       `protobuf:"bytes,5,rep,name=issues_by_status,json=issuesByStatus,proto3" json:"issues_by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // issue counts keyed by status name, e.g. "IN_PROGRESS"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProjectProgressResponse) Reset() {
+	*x = GetProjectProgressResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectProgressResponse) ProtoMessage() {}
+
+func (x *GetProjectProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectProgressResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetProjectProgressResponse) GetTotalIssues() int32 {
+	if x != nil {
+		return x.TotalIssues
+	}
+	return 0
+}
+
+func (x *GetProjectProgressResponse) GetClosedIssues() int32 {
+	if x != nil {
+		return x.ClosedIssues
+	}
+	return 0
+}
+
+func (x *GetProjectProgressResponse) GetResolvedIssues() int32 {
+	if x != nil {
+		return x.ResolvedIssues
+	}
+	return 0
+}
+
+func (x *GetProjectProgressResponse) GetCompletionPct() float32 {
+	if x != nil {
+		return x.CompletionPct
+	}
+	return 0
+}
+
+func (x *GetProjectProgressResponse) GetIssuesByStatus() map[string]int32 {
+	if x != nil {
+		return x.IssuesByStatus
+	}
+	return nil
+}
+
+// IssueFilter narrows a set of issues; an empty field matches every issue
+type IssueFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []Type                 `protobuf:"varint,1,rep,packed,name=types,proto3,enum=issues.v1.Type" json:"types,omitempty"`
+	Priorities    []Priority             `protobuf:"varint,2,rep,packed,name=priorities,proto3,enum=issues.v1.Priority" json:"priorities,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueFilter) Reset() {
+	*x = IssueFilter{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueFilter) ProtoMessage() {}
+
+func (x *IssueFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueFilter.ProtoReflect.Descriptor instead.
+func (*IssueFilter) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *IssueFilter) GetTypes() []Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *IssueFilter) GetPriorities() []Priority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *IssueFilter) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+type BulkUpdateIssueStatusRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId         string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	FromStatus        Status                 `protobuf:"varint,2,opt,name=from_status,json=fromStatus,proto3,enum=issues.v1.Status" json:"from_status,omitempty"`
+	ToStatus          Status                 `protobuf:"varint,3,opt,name=to_status,json=toStatus,proto3,enum=issues.v1.Status" json:"to_status,omitempty"`
+	Resolution        Resolution             `protobuf:"varint,4,opt,name=resolution,proto3,enum=issues.v1.Resolution" json:"resolution,omitempty"` // required when moving to RESOLVED or CLOSED, except from RESOLVED
+	ResolutionComment string                 `protobuf:"bytes,5,opt,name=resolution_comment,json=resolutionComment,proto3" json:"resolution_comment,omitempty"`
+	Filter            *IssueFilter           `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BulkUpdateIssueStatusRequest) Reset() {
+	*x = BulkUpdateIssueStatusRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateIssueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateIssueStatusRequest) ProtoMessage() {}
+
+func (x *BulkUpdateIssueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateIssueStatusRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BulkUpdateIssueStatusRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *BulkUpdateIssueStatusRequest) GetFromStatus() Status {
+	if x != nil {
+		return x.FromStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *BulkUpdateIssueStatusRequest) GetToStatus() Status {
+	if x != nil {
+		return x.ToStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *BulkUpdateIssueStatusRequest) GetResolution() Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return Resolution_RESOLUTION_UNSPECIFIED
+}
+
+func (x *BulkUpdateIssueStatusRequest) GetResolutionComment() string {
+	if x != nil {
+		return x.ResolutionComment
+	}
+	return ""
+}
+
+func (x *BulkUpdateIssueStatusRequest) GetFilter() *IssueFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type BulkUpdateIssueStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedCount  int32                  `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateIssueStatusResponse) Reset() {
+	*x = BulkUpdateIssueStatusResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIssuesResponse) String() string {
+func (x *BulkUpdateIssueStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIssuesResponse) ProtoMessage() {}
+func (*BulkUpdateIssueStatusResponse) ProtoMessage() {}
 
-func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[10]
+func (x *BulkUpdateIssueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,25 +2983,325 @@ func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
-func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BulkUpdateIssueStatusResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateIssueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListIssuesResponse) GetIssues() []*Issue {
+func (x *BulkUpdateIssueStatusResponse) GetUpdatedCount() int32 {
 	if x != nil {
-		return x.Issues
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *BulkUpdateIssueStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ValidateIssueRequest runs the checks of CreateIssue or UpdateIssue on a payload without saving it
+type ValidateIssueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ValidateIssueRequest_Create
+	//	*ValidateIssueRequest_Update
+	Payload       isValidateIssueRequest_Payload `protobuf_oneof:"payload"`
+	CurrentStatus Status                         `protobuf:"varint,3,opt,name=current_status,json=currentStatus,proto3,enum=issues.v1.Status" json:"current_status,omitempty"` // status the update's transition is checked from; unset uses the stored issue's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateIssueRequest) Reset() {
+	*x = ValidateIssueRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateIssueRequest) ProtoMessage() {}
+
+func (x *ValidateIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateIssueRequest.ProtoReflect.Descriptor instead.
+func (*ValidateIssueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ValidateIssueRequest) GetPayload() isValidateIssueRequest_Payload {
+	if x != nil {
+		return x.Payload
 	}
 	return nil
 }
 
-func (x *ListIssuesResponse) GetNextPageToken() string {
+func (x *ValidateIssueRequest) GetCreate() *CreateIssueRequest {
 	if x != nil {
-		return x.NextPageToken
+		if x, ok := x.Payload.(*ValidateIssueRequest_Create); ok {
+			return x.Create
+		}
+	}
+	return nil
+}
+
+func (x *ValidateIssueRequest) GetUpdate() *UpdateIssueRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ValidateIssueRequest_Update); ok {
+			return x.Update
+		}
+	}
+	return nil
+}
+
+func (x *ValidateIssueRequest) GetCurrentStatus() Status {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+type isValidateIssueRequest_Payload interface {
+	isValidateIssueRequest_Payload()
+}
+
+type ValidateIssueRequest_Create struct {
+	// The payloads are checked by ValidateIssue itself, which reports every problem instead of the first
+	Create *CreateIssueRequest `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type ValidateIssueRequest_Update struct {
+	Update *UpdateIssueRequest `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+func (*ValidateIssueRequest_Create) isValidateIssueRequest_Payload() {}
+
+func (*ValidateIssueRequest_Update) isValidateIssueRequest_Payload() {}
+
+type ValidationProblem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`     // path of the field, such as summary; empty for problems with the issue as a whole
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // in the language of the request's Accept-Language
+	Rule          string                 `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`       // name of the field rule that failed, such as REQUIRED; empty for the other checks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationProblem) Reset() {
+	*x = ValidationProblem{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationProblem) ProtoMessage() {}
+
+func (x *ValidationProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationProblem.ProtoReflect.Descriptor instead.
+func (*ValidationProblem) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ValidationProblem) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ValidationProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidationProblem) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type ValidateIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"` // true when there are no problems
+	Problems      []*ValidationProblem   `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateIssueResponse) Reset() {
+	*x = ValidateIssueResponse{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateIssueResponse) ProtoMessage() {}
+
+func (x *ValidateIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateIssueResponse.ProtoReflect.Descriptor instead.
+func (*ValidateIssueResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ValidateIssueResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateIssueResponse) GetProblems() []*ValidationProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type WatchIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchIssueRequest) Reset() {
+	*x = WatchIssueRequest{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchIssueRequest) ProtoMessage() {}
+
+func (x *WatchIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchIssueRequest.ProtoReflect.Descriptor instead.
+func (*WatchIssueRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WatchIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type IssueEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // "updated", "reopened", "cloned" or "deleted"
+	Issue         *Issue                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueEvent) Reset() {
+	*x = IssueEvent{}
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueEvent) ProtoMessage() {}
+
+func (x *IssueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueEvent.ProtoReflect.Descriptor instead.
+func (*IssueEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *IssueEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
 	}
 	return ""
 }
 
+func (x *IssueEvent) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
 type ProjectInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -986,7 +3313,7 @@ type ProjectInfo struct {
 
 func (x *ProjectInfo) Reset() {
 	*x = ProjectInfo{}
-	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[11]
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -998,7 +3325,7 @@ func (x *ProjectInfo) String() string {
 func (*ProjectInfo) ProtoMessage() {}
 
 func (x *ProjectInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[11]
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +3338,7 @@ func (x *ProjectInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectInfo.ProtoReflect.Descriptor instead.
 func (*ProjectInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{11}
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ProjectInfo) GetProjectId() string {
@@ -1047,7 +3374,7 @@ type UserInfo struct {
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[12]
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1059,7 +3386,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[12]
+	mi := &file_pkg_pb_issues_v1_issues_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +3399,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{12}
+	return file_pkg_pb_issues_v1_issues_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UserInfo) GetUserId() string {
@@ -1107,12 +3434,11 @@ var File_pkg_pb_issues_v1_issues_proto protoreflect.FileDescriptor
 
 const file_pkg_pb_issues_v1_issues_proto_rawDesc = "" +
 	"\n" +
-	"\x1dpkg/pb/issues/v1/issues.proto\x12\tissues.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dproto/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xad\x04\n" +
+	"\x1dpkg/pb/issues/v1/issues.proto\x12\tissues.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dproto/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xaa\b\n" +
 	"\x05Issue\x12#\n" +
-	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12#\n" +
-	"\asummary\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\asummary\x12,\n" +
-	"\vdescription\x18\x03 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x01\x18\xf4\x03R\vdescription\x123\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12!\n" +
+	"\asummary\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asummary\x12)\n" +
+	"\vdescription\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vdescription\x123\n" +
 	"\x06status\x18\x04 \x01(\x0e2\x11.issues.v1.StatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12?\n" +
 	"\n" +
 	"resolution\x18\x05 \x01(\x0e2\x15.issues.v1.ResolutionB\b\xfaB\x05\x82\x01\x02\x10\x01R\n" +
@@ -1127,33 +3453,62 @@ const file_pkg_pb_issues_v1_issues_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createDate\x12;\n" +
 	"\vmodify_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"modifyDate\"\xce\x02\n" +
-	"\x12CreateIssueRequest\x12#\n" +
-	"\asummary\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\asummary\x120\n" +
-	"\vdescription\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dH\x00R\vdescription\x88\x01\x01\x12-\n" +
+	"modifyDate\x12.\n" +
+	"\fduplicate_of\x18\f \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\vduplicateOf\x127\n" +
+	"\x12resolution_comment\x18\r \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x11resolutionComment\x12!\n" +
+	"\freopen_count\x18\x0e \x01(\x05R\vreopenCount\x126\n" +
+	"\x12last_reopen_reason\x18\x0f \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x10lastReopenReason\x12=\n" +
+	"\fresolve_date\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vresolveDate\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x03R\aversion\x12,\n" +
+	"\vcloned_from\x18\x12 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\n" +
+	"clonedFrom\x12!\n" +
+	"\fissue_number\x18\x13 \x01(\x05R\vissueNumber\x125\n" +
+	"\bdue_date\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12$\n" +
+	"\ateam_id\x18\x15 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x06teamId\x125\n" +
+	"\x10last_reopened_by\x18\x16 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x0elastReopenedBy\"\xe3\x03\n" +
+	"\x12CreateIssueRequest\x12!\n" +
+	"\asummary\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asummary\x12.\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\vdescription\x88\x01\x01\x12-\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x0f.issues.v1.TypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x129\n" +
 	"\bpriority\x18\x04 \x01(\x0e2\x13.issues.v1.PriorityB\b\xfaB\x05\x82\x01\x02\x10\x01R\bpriority\x12'\n" +
 	"\n" +
 	"project_id\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\x12.\n" +
 	"\vassignee_id\x18\x06 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x01R\n" +
-	"assigneeId\x88\x01\x01B\x0e\n" +
+	"assigneeId\x88\x01\x01\x12,\n" +
+	"\vtemplate_id\x18\a \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\n" +
+	"templateId\x125\n" +
+	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12&\n" +
+	"\ateam_id\x18\t \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x02R\x06teamId\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\x0e\n" +
-	"\f_assignee_id\"W\n" +
+	"\f_assignee_idB\n" +
+	"\n" +
+	"\b_team_id\"W\n" +
 	"\x13CreateIssueResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
 	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"_\n" +
 	"\x0fGetIssueRequest\x12#\n" +
 	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12'\n" +
-	"\x0finclude_details\x18\x02 \x01(\bR\x0eincludeDetails\"\xa7\x01\n" +
+	"\x0finclude_details\x18\x02 \x01(\bR\x0eincludeDetails\"\xce\x01\n" +
 	"\x10GetIssueResponse\x12&\n" +
 	"\x05issue\x18\x01 \x01(\v2\x10.issues.v1.IssueR\x05issue\x129\n" +
 	"\fproject_info\x18\x02 \x01(\v2\x16.issues.v1.ProjectInfoR\vprojectInfo\x120\n" +
-	"\tuser_info\x18\x03 \x01(\v2\x13.issues.v1.UserInfoR\buserInfo\"\xc1\x03\n" +
+	"\tuser_info\x18\x03 \x01(\v2\x13.issues.v1.UserInfoR\buserInfo\x12%\n" +
+	"\x0edetails_errors\x18\x04 \x03(\tR\rdetailsErrors\"x\n" +
+	"!GetIssueByProjectAndNumberRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\x12*\n" +
+	"\fissue_number\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\vissueNumber\"L\n" +
+	"\"GetIssueByProjectAndNumberResponse\x12&\n" +
+	"\x05issue\x18\x01 \x01(\v2\x10.issues.v1.IssueR\x05issue\"G\n" +
+	"\x15BatchGetIssuesRequest\x12.\n" +
+	"\tissue_ids\x18\x01 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\x102\"\x05r\x03\xb0\x01\x01R\bissueIds\"f\n" +
+	"\x16BatchGetIssuesResponse\x12(\n" +
+	"\x06issues\x18\x01 \x03(\v2\x10.issues.v1.IssueR\x06issues\x12\"\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\vnotFoundIds\"\xb1\x05\n" +
 	"\x12UpdateIssueRequest\x12#\n" +
-	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12#\n" +
-	"\asummary\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\asummary\x121\n" +
-	"\vdescription\x18\x03 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x01\x18\xf4\x03H\x00R\vdescription\x88\x01\x01\x123\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12!\n" +
+	"\asummary\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asummary\x12.\n" +
+	"\vdescription\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\vdescription\x88\x01\x01\x123\n" +
 	"\x06status\x18\x04 \x01(\x0e2\x11.issues.v1.StatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12?\n" +
 	"\n" +
 	"resolution\x18\x05 \x01(\x0e2\x15.issues.v1.ResolutionB\b\xfaB\x05\x82\x01\x02\x10\x01R\n" +
@@ -1161,9 +3516,16 @@ const file_pkg_pb_issues_v1_issues_proto_rawDesc = "" +
 	"\x04type\x18\x06 \x01(\x0e2\x0f.issues.v1.TypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x129\n" +
 	"\bpriority\x18\a \x01(\x0e2\x13.issues.v1.PriorityB\b\xfaB\x05\x82\x01\x02\x10\x01R\bpriority\x12.\n" +
 	"\vassignee_id\x18\b \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x01R\n" +
-	"assigneeId\x88\x01\x01B\x0e\n" +
+	"assigneeId\x88\x01\x01\x123\n" +
+	"\fduplicate_of\x18\t \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01H\x02R\vduplicateOf\x88\x01\x01\x12<\n" +
+	"\x12resolution_comment\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03H\x03R\x11resolutionComment\x88\x01\x01\x12!\n" +
+	"\aversion\x18\v \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aversion\x125\n" +
+	"\bdue_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\adueDateB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
-	"\f_assignee_id\"W\n" +
+	"\f_assignee_idB\x0f\n" +
+	"\r_duplicate_ofB\x15\n" +
+	"\x13_resolution_comment\"W\n" +
 	"\x13UpdateIssueResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
 	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"9\n" +
@@ -1171,15 +3533,172 @@ const file_pkg_pb_issues_v1_issues_proto_rawDesc = "" +
 	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\"W\n" +
 	"\x13DeleteIssueResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
-	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"[\n" +
-	"\x11ListIssuesRequest\x12'\n" +
-	"\tpage_size\x18\x01 \x01(\x05B\n" +
-	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12\x1d\n" +
+	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"X\n" +
+	"\x11ListIssuesRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"f\n" +
 	"\x12ListIssuesResponse\x12(\n" +
 	"\x06issues\x18\x01 \x03(\v2\x10.issues.v1.IssueR\x06issues\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"b\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"g\n" +
+	"\x12AssignIssueRequest\x12#\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12,\n" +
+	"\vassignee_id\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\n" +
+	"assigneeId\"W\n" +
+	"\x13AssignIssueResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
+	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"\xa7\x02\n" +
+	"\x18ChangeIssueStatusRequest\x12#\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12<\n" +
+	"\n" +
+	"new_status\x18\x02 \x01(\x0e2\x11.issues.v1.StatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tnewStatus\x12?\n" +
+	"\n" +
+	"resolution\x18\x03 \x01(\x0e2\x15.issues.v1.ResolutionB\b\xfaB\x05\x82\x01\x02\x10\x01R\n" +
+	"resolution\x127\n" +
+	"\x12resolution_comment\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x11resolutionComment\x12.\n" +
+	"\fduplicate_of\x18\x05 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\vduplicateOf\"]\n" +
+	"\x19ChangeIssueStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
+	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"\x9d\x01\n" +
+	"\x12ReopenIssueRequest\x12#\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xf4\x03R\x06reason\x12.\n" +
+	"\vassignee_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\n" +
+	"assigneeId\x88\x01\x01B\x0e\n" +
+	"\f_assignee_id\"W\n" +
+	"\x13ReopenIssueResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
+	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"\x97\x01\n" +
+	"\x10MoveIssueRequest\x12#\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\x120\n" +
+	"\x0ffrom_project_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\rfromProjectId\x12,\n" +
+	"\rto_project_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\vtoProjectId\"\xc3\x01\n" +
+	"\x11MoveIssueResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
+	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\x127\n" +
+	"\x18from_project_issue_count\x18\x03 \x01(\x05R\x15fromProjectIssueCount\x123\n" +
+	"\x16to_project_issue_count\x18\x04 \x01(\x05R\x13toProjectIssueCount\"\xc6\x01\n" +
+	"\x11CloneIssueRequest\x120\n" +
+	"\x0fsource_issue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\rsourceIssueId\x127\n" +
+	"\x11target_project_id\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x0ftargetProjectId\x123\n" +
+	"\x0esummary_prefix\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18\x14H\x00R\rsummaryPrefix\x88\x01\x01B\x11\n" +
+	"\x0f_summary_prefix\"c\n" +
+	"\x12CloneIssueResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x123\n" +
+	"\fcloned_issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\vclonedIssue\"\xda\x01\n" +
+	"\tIssueLink\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12&\n" +
+	"\x0fsource_issue_id\x18\x02 \x01(\tR\rsourceIssueId\x12&\n" +
+	"\x0ftarget_issue_id\x18\x03 \x01(\tR\rtargetIssueId\x12'\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x13.issues.v1.LinkTypeR\x04type\x12;\n" +
+	"\vcreate_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createDate\"\xac\x01\n" +
+	"\x11LinkIssuesRequest\x120\n" +
+	"\x0fsource_issue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\rsourceIssueId\x120\n" +
+	"\x0ftarget_issue_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\rtargetIssueId\x123\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x13.issues.v1.LinkTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04type\"X\n" +
+	"\x12LinkIssuesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12(\n" +
+	"\x04link\x18\x02 \x01(\v2\x14.issues.v1.IssueLinkR\x04link\"8\n" +
+	"\x13UnlinkIssuesRequest\x12!\n" +
+	"\alink_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06linkId\"Z\n" +
+	"\x14UnlinkIssuesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12(\n" +
+	"\x04link\x18\x02 \x01(\v2\x14.issues.v1.IssueLinkR\x04link\"<\n" +
+	"\x15ListIssueLinksRequest\x12#\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\"D\n" +
+	"\x16ListIssueLinksResponse\x12*\n" +
+	"\x05links\x18\x01 \x03(\v2\x14.issues.v1.IssueLinkR\x05links\"\xf6\x01\n" +
+	" GetIssueResolutionMetricsRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\tprojectId\x128\n" +
+	"\n" +
+	"issue_type\x18\x02 \x01(\x0e2\x0f.issues.v1.TypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tissueType\x127\n" +
+	"\tfrom_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
+	"\ato_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\"\xe8\x01\n" +
+	"!GetIssueResolutionMetricsResponse\x12\x1b\n" +
+	"\tp50_hours\x18\x01 \x01(\x01R\bp50Hours\x12\x1b\n" +
+	"\tp90_hours\x18\x02 \x01(\x01R\bp90Hours\x12\x1b\n" +
+	"\tp99_hours\x18\x03 \x01(\x01R\bp99Hours\x12%\n" +
+	"\x0etotal_resolved\x18\x04 \x01(\x05R\rtotalResolved\x12(\n" +
+	"\x10slo_breach_count\x18\x05 \x01(\x05R\x0esloBreachCount\x12\x1b\n" +
+	"\tslo_hours\x18\x06 \x01(\x01R\bsloHours\"y\n" +
+	"\x1cGenerateProjectDigestRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\xdf\x02\n" +
+	"\rProjectDigest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12#\n" +
+	"\rcreated_count\x18\x04 \x01(\x05R\fcreatedCount\x12%\n" +
+	"\x0eresolved_count\x18\x05 \x01(\x05R\rresolvedCount\x12!\n" +
+	"\fclosed_count\x18\x06 \x01(\x05R\vclosedCount\x12#\n" +
+	"\roverdue_count\x18\a \x01(\x05R\foverdueCount\x12!\n" +
+	"\foverdue_days\x18\b \x01(\x05R\voverdueDays\"Q\n" +
+	"\x1dGenerateProjectDigestResponse\x120\n" +
+	"\x06digest\x18\x01 \x01(\v2\x18.issues.v1.ProjectDigestR\x06digest\"D\n" +
+	"\x19GetProjectProgressRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\"\xdc\x02\n" +
+	"\x1aGetProjectProgressResponse\x12!\n" +
+	"\ftotal_issues\x18\x01 \x01(\x05R\vtotalIssues\x12#\n" +
+	"\rclosed_issues\x18\x02 \x01(\x05R\fclosedIssues\x12'\n" +
+	"\x0fresolved_issues\x18\x03 \x01(\x05R\x0eresolvedIssues\x12%\n" +
+	"\x0ecompletion_pct\x18\x04 \x01(\x02R\rcompletionPct\x12c\n" +
+	"\x10issues_by_status\x18\x05 \x03(\v29.issues.v1.GetProjectProgressResponse.IssuesByStatusEntryR\x0eissuesByStatus\x1aA\n" +
+	"\x13IssuesByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb5\x01\n" +
+	"\vIssueFilter\x124\n" +
+	"\x05types\x18\x01 \x03(\x0e2\x0f.issues.v1.TypeB\r\xfaB\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\x05types\x12B\n" +
+	"\n" +
+	"priorities\x18\x02 \x03(\x0e2\x13.issues.v1.PriorityB\r\xfaB\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\n" +
+	"priorities\x12,\n" +
+	"\vassignee_id\x18\x03 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\n" +
+	"assigneeId\"\xed\x02\n" +
+	"\x1cBulkUpdateIssueStatusRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\x12>\n" +
+	"\vfrom_status\x18\x02 \x01(\x0e2\x11.issues.v1.StatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"fromStatus\x12:\n" +
+	"\tto_status\x18\x03 \x01(\x0e2\x11.issues.v1.StatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\btoStatus\x12?\n" +
+	"\n" +
+	"resolution\x18\x04 \x01(\x0e2\x15.issues.v1.ResolutionB\b\xfaB\x05\x82\x01\x02\x10\x01R\n" +
+	"resolution\x127\n" +
+	"\x12resolution_comment\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x11resolutionComment\x12.\n" +
+	"\x06filter\x18\x06 \x01(\v2\x16.issues.v1.IssueFilterR\x06filter\"^\n" +
+	"\x1dBulkUpdateIssueStatusResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf0\x01\n" +
+	"\x14ValidateIssueRequest\x12A\n" +
+	"\x06create\x18\x01 \x01(\v2\x1d.issues.v1.CreateIssueRequestB\b\xfaB\x05\x8a\x01\x02\b\x01H\x00R\x06create\x12A\n" +
+	"\x06update\x18\x02 \x01(\v2\x1d.issues.v1.UpdateIssueRequestB\b\xfaB\x05\x8a\x01\x02\b\x01H\x00R\x06update\x12B\n" +
+	"\x0ecurrent_status\x18\x03 \x01(\x0e2\x11.issues.v1.StatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\rcurrentStatusB\x0e\n" +
+	"\apayload\x12\x03\xf8B\x01\"W\n" +
+	"\x11ValidationProblem\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\"g\n" +
+	"\x15ValidateIssueResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x128\n" +
+	"\bproblems\x18\x02 \x03(\v2\x1c.issues.v1.ValidationProblemR\bproblems\"8\n" +
+	"\x11WatchIssueRequest\x12#\n" +
+	"\bissue_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aissueId\"S\n" +
+	"\n" +
+	"IssueEvent\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12&\n" +
+	"\x05issue\x18\x02 \x01(\v2\x10.issues.v1.IssueR\x05issue\"b\n" +
 	"\vProjectInfo\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
@@ -1199,7 +3718,7 @@ const file_pkg_pb_issues_v1_issues_proto_rawDesc = "" +
 	"\bRESOLVED\x10\x04\x12\n" +
 	"\n" +
 	"\x06CLOSED\x10\x05\x12\f\n" +
-	"\bREOPENED\x10\x06*]\n" +
+	"\bREOPENED\x10\x06*l\n" +
 	"\n" +
 	"Resolution\x12\x1a\n" +
 	"\x16RESOLUTION_UNSPECIFIED\x10\x00\x12\t\n" +
@@ -1207,7 +3726,8 @@ const file_pkg_pb_issues_v1_issues_proto_rawDesc = "" +
 	"\aINVALID\x10\x02\x12\v\n" +
 	"\aWONTFIX\x10\x03\x12\x0e\n" +
 	"\n" +
-	"WORKSFORME\x10\x04*Q\n" +
+	"WORKSFORME\x10\x04\x12\r\n" +
+	"\tDUPLICATE\x10\x05*Q\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bCOSMETIC\x10\x01\x12\a\n" +
@@ -1219,14 +3739,43 @@ const file_pkg_pb_issues_v1_issues_proto_rawDesc = "" +
 	"\bCRITICAL\x10\x01\x12\t\n" +
 	"\x05MAJOR\x10\x02\x12\r\n" +
 	"\tIMPORTANT\x10\x03\x12\t\n" +
-	"\x05MINOR\x10\x042\xa8\x04\n" +
+	"\x05MINOR\x10\x04*a\n" +
+	"\bLinkType\x12\x19\n" +
+	"\x15LINK_TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06BLOCKS\x10\x01\x12\x0e\n" +
+	"\n" +
+	"BLOCKED_BY\x10\x02\x12\x0e\n" +
+	"\n" +
+	"RELATES_TO\x10\x03\x12\x0e\n" +
+	"\n" +
+	"DUPLICATES\x10\x042\xab\x15\n" +
 	"\rIssuesService\x12g\n" +
 	"\vCreateIssue\x12\x1d.issues.v1.CreateIssueRequest\x1a\x1e.issues.v1.CreateIssueResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/issues\x12f\n" +
-	"\bGetIssue\x12\x1a.issues.v1.GetIssueRequest\x1a\x1b.issues.v1.GetIssueResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/issues/{issue_id}\x12r\n" +
+	"\bGetIssue\x12\x1a.issues.v1.GetIssueRequest\x1a\x1b.issues.v1.GetIssueResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/issues/{issue_id}\x12\xb6\x01\n" +
+	"\x1aGetIssueByProjectAndNumber\x12,.issues.v1.GetIssueByProjectAndNumberRequest\x1a-.issues.v1.GetIssueByProjectAndNumberResponse\";\x82\xd3\xe4\x93\x025\x123/api/v1/projects/{project_id}/issues/{issue_number}\x12v\n" +
+	"\x0eBatchGetIssues\x12 .issues.v1.BatchGetIssuesRequest\x1a!.issues.v1.BatchGetIssuesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/issues:batchGet\x12r\n" +
 	"\vUpdateIssue\x12\x1d.issues.v1.UpdateIssueRequest\x1a\x1e.issues.v1.UpdateIssueResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/v1/issues/{issue_id}\x12o\n" +
 	"\vDeleteIssue\x12\x1d.issues.v1.DeleteIssueRequest\x1a\x1e.issues.v1.DeleteIssueResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/issues/{issue_id}\x12a\n" +
 	"\n" +
-	"ListIssues\x12\x1c.issues.v1.ListIssuesRequest\x1a\x1d.issues.v1.ListIssuesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/issuesB\x1bZ\x19pkg/pb/issues/v1;issuesv1b\x06proto3"
+	"ListIssues\x12\x1c.issues.v1.ListIssuesRequest\x1a\x1d.issues.v1.ListIssuesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/issues\x12y\n" +
+	"\vAssignIssue\x12\x1d.issues.v1.AssignIssueRequest\x1a\x1e.issues.v1.AssignIssueResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/issues/{issue_id}/assign\x12l\n" +
+	"\n" +
+	"WatchIssue\x12\x1c.issues.v1.WatchIssueRequest\x1a\x15.issues.v1.IssueEvent\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/issues/{issue_id}/watch0\x01\x12\x8b\x01\n" +
+	"\x11ChangeIssueStatus\x12#.issues.v1.ChangeIssueStatusRequest\x1a$.issues.v1.ChangeIssueStatusResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/issues/{issue_id}/status\x12y\n" +
+	"\vReopenIssue\x12\x1d.issues.v1.ReopenIssueRequest\x1a\x1e.issues.v1.ReopenIssueResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/issues/{issue_id}/reopen\x12q\n" +
+	"\tMoveIssue\x12\x1b.issues.v1.MoveIssueRequest\x1a\x1c.issues.v1.MoveIssueResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/issues/{issue_id}/move\x12|\n" +
+	"\n" +
+	"CloneIssue\x12\x1c.issues.v1.CloneIssueRequest\x1a\x1d.issues.v1.CloneIssueResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/issues/{source_issue_id}/clone\x12|\n" +
+	"\n" +
+	"LinkIssues\x12\x1c.issues.v1.LinkIssuesRequest\x1a\x1d.issues.v1.LinkIssuesResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/issues/{source_issue_id}/links\x12v\n" +
+	"\fUnlinkIssues\x12\x1e.issues.v1.UnlinkIssuesRequest\x1a\x1f.issues.v1.UnlinkIssuesResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/issue-links/{link_id}\x12~\n" +
+	"\x0eListIssueLinks\x12 .issues.v1.ListIssueLinksRequest\x1a!.issues.v1.ListIssueLinksResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/issues/{issue_id}/links\x12\xa0\x01\n" +
+	"\x19GetIssueResolutionMetrics\x12+.issues.v1.GetIssueResolutionMetricsRequest\x1a,.issues.v1.GetIssueResolutionMetricsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/metrics/issue-resolution\x12\x98\x01\n" +
+	"\x15GenerateProjectDigest\x12'.issues.v1.GenerateProjectDigestRequest\x1a(.issues.v1.GenerateProjectDigestResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/projects/{project_id}/digest\x12\x91\x01\n" +
+	"\x12GetProjectProgress\x12$.issues.v1.GetProjectProgressRequest\x1a%.issues.v1.GetProjectProgressResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/projects/{project_id}/progress\x12\xac\x01\n" +
+	"\x15BulkUpdateIssueStatus\x12'.issues.v1.BulkUpdateIssueStatusRequest\x1a(.issues.v1.BulkUpdateIssueStatusResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/projects/{project_id}/issues:bulkUpdateStatus\x12v\n" +
+	"\rValidateIssue\x12\x1f.issues.v1.ValidateIssueRequest\x1a .issues.v1.ValidateIssueResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/issues:validateB\x1bZ\x19pkg/pb/issues/v1;issuesv1b\x06proto3"
 
 var (
 	file_pkg_pb_issues_v1_issues_proto_rawDescOnce sync.Once
@@ -1240,63 +3789,171 @@ func file_pkg_pb_issues_v1_issues_proto_rawDescGZIP() []byte {
 	return file_pkg_pb_issues_v1_issues_proto_rawDescData
 }
 
-var file_pkg_pb_issues_v1_issues_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_pkg_pb_issues_v1_issues_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_pkg_pb_issues_v1_issues_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_pkg_pb_issues_v1_issues_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_pkg_pb_issues_v1_issues_proto_goTypes = []any{
-	(Status)(0),                   // 0: issues.v1.Status
-	(Resolution)(0),               // 1: issues.v1.Resolution
-	(Type)(0),                     // 2: issues.v1.Type
-	(Priority)(0),                 // 3: issues.v1.Priority
-	(*Issue)(nil),                 // 4: issues.v1.Issue
-	(*CreateIssueRequest)(nil),    // 5: issues.v1.CreateIssueRequest
-	(*CreateIssueResponse)(nil),   // 6: issues.v1.CreateIssueResponse
-	(*GetIssueRequest)(nil),       // 7: issues.v1.GetIssueRequest
-	(*GetIssueResponse)(nil),      // 8: issues.v1.GetIssueResponse
-	(*UpdateIssueRequest)(nil),    // 9: issues.v1.UpdateIssueRequest
-	(*UpdateIssueResponse)(nil),   // 10: issues.v1.UpdateIssueResponse
-	(*DeleteIssueRequest)(nil),    // 11: issues.v1.DeleteIssueRequest
-	(*DeleteIssueResponse)(nil),   // 12: issues.v1.DeleteIssueResponse
-	(*ListIssuesRequest)(nil),     // 13: issues.v1.ListIssuesRequest
-	(*ListIssuesResponse)(nil),    // 14: issues.v1.ListIssuesResponse
-	(*ProjectInfo)(nil),           // 15: issues.v1.ProjectInfo
-	(*UserInfo)(nil),              // 16: issues.v1.UserInfo
-	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(Status)(0),                 // 0: issues.v1.Status
+	(Resolution)(0),             // 1: issues.v1.Resolution
+	(Type)(0),                   // 2: issues.v1.Type
+	(Priority)(0),               // 3: issues.v1.Priority
+	(LinkType)(0),               // 4: issues.v1.LinkType
+	(*Issue)(nil),               // 5: issues.v1.Issue
+	(*CreateIssueRequest)(nil),  // 6: issues.v1.CreateIssueRequest
+	(*CreateIssueResponse)(nil), // 7: issues.v1.CreateIssueResponse
+	(*GetIssueRequest)(nil),     // 8: issues.v1.GetIssueRequest
+	(*GetIssueResponse)(nil),    // 9: issues.v1.GetIssueResponse
+	(*GetIssueByProjectAndNumberRequest)(nil),  // 10: issues.v1.GetIssueByProjectAndNumberRequest
+	(*GetIssueByProjectAndNumberResponse)(nil), // 11: issues.v1.GetIssueByProjectAndNumberResponse
+	(*BatchGetIssuesRequest)(nil),              // 12: issues.v1.BatchGetIssuesRequest
+	(*BatchGetIssuesResponse)(nil),             // 13: issues.v1.BatchGetIssuesResponse
+	(*UpdateIssueRequest)(nil),                 // 14: issues.v1.UpdateIssueRequest
+	(*UpdateIssueResponse)(nil),                // 15: issues.v1.UpdateIssueResponse
+	(*DeleteIssueRequest)(nil),                 // 16: issues.v1.DeleteIssueRequest
+	(*DeleteIssueResponse)(nil),                // 17: issues.v1.DeleteIssueResponse
+	(*ListIssuesRequest)(nil),                  // 18: issues.v1.ListIssuesRequest
+	(*ListIssuesResponse)(nil),                 // 19: issues.v1.ListIssuesResponse
+	(*AssignIssueRequest)(nil),                 // 20: issues.v1.AssignIssueRequest
+	(*AssignIssueResponse)(nil),                // 21: issues.v1.AssignIssueResponse
+	(*ChangeIssueStatusRequest)(nil),           // 22: issues.v1.ChangeIssueStatusRequest
+	(*ChangeIssueStatusResponse)(nil),          // 23: issues.v1.ChangeIssueStatusResponse
+	(*ReopenIssueRequest)(nil),                 // 24: issues.v1.ReopenIssueRequest
+	(*ReopenIssueResponse)(nil),                // 25: issues.v1.ReopenIssueResponse
+	(*MoveIssueRequest)(nil),                   // 26: issues.v1.MoveIssueRequest
+	(*MoveIssueResponse)(nil),                  // 27: issues.v1.MoveIssueResponse
+	(*CloneIssueRequest)(nil),                  // 28: issues.v1.CloneIssueRequest
+	(*CloneIssueResponse)(nil),                 // 29: issues.v1.CloneIssueResponse
+	(*IssueLink)(nil),                          // 30: issues.v1.IssueLink
+	(*LinkIssuesRequest)(nil),                  // 31: issues.v1.LinkIssuesRequest
+	(*LinkIssuesResponse)(nil),                 // 32: issues.v1.LinkIssuesResponse
+	(*UnlinkIssuesRequest)(nil),                // 33: issues.v1.UnlinkIssuesRequest
+	(*UnlinkIssuesResponse)(nil),               // 34: issues.v1.UnlinkIssuesResponse
+	(*ListIssueLinksRequest)(nil),              // 35: issues.v1.ListIssueLinksRequest
+	(*ListIssueLinksResponse)(nil),             // 36: issues.v1.ListIssueLinksResponse
+	(*GetIssueResolutionMetricsRequest)(nil),   // 37: issues.v1.GetIssueResolutionMetricsRequest
+	(*GetIssueResolutionMetricsResponse)(nil),  // 38: issues.v1.GetIssueResolutionMetricsResponse
+	(*GenerateProjectDigestRequest)(nil),       // 39: issues.v1.GenerateProjectDigestRequest
+	(*ProjectDigest)(nil),                      // 40: issues.v1.ProjectDigest
+	(*GenerateProjectDigestResponse)(nil),      // 41: issues.v1.GenerateProjectDigestResponse
+	(*GetProjectProgressRequest)(nil),          // 42: issues.v1.GetProjectProgressRequest
+	(*GetProjectProgressResponse)(nil),         // 43: issues.v1.GetProjectProgressResponse
+	(*IssueFilter)(nil),                        // 44: issues.v1.IssueFilter
+	(*BulkUpdateIssueStatusRequest)(nil),       // 45: issues.v1.BulkUpdateIssueStatusRequest
+	(*BulkUpdateIssueStatusResponse)(nil),      // 46: issues.v1.BulkUpdateIssueStatusResponse
+	(*ValidateIssueRequest)(nil),               // 47: issues.v1.ValidateIssueRequest
+	(*ValidationProblem)(nil),                  // 48: issues.v1.ValidationProblem
+	(*ValidateIssueResponse)(nil),              // 49: issues.v1.ValidateIssueResponse
+	(*WatchIssueRequest)(nil),                  // 50: issues.v1.WatchIssueRequest
+	(*IssueEvent)(nil),                         // 51: issues.v1.IssueEvent
+	(*ProjectInfo)(nil),                        // 52: issues.v1.ProjectInfo
+	(*UserInfo)(nil),                           // 53: issues.v1.UserInfo
+	nil,                                        // 54: issues.v1.GetProjectProgressResponse.IssuesByStatusEntry
+	(*timestamppb.Timestamp)(nil),              // 55: google.protobuf.Timestamp
 }
 var file_pkg_pb_issues_v1_issues_proto_depIdxs = []int32{
 	0,  // 0: issues.v1.Issue.status:type_name -> issues.v1.Status
 	1,  // 1: issues.v1.Issue.resolution:type_name -> issues.v1.Resolution
 	2,  // 2: issues.v1.Issue.type:type_name -> issues.v1.Type
 	3,  // 3: issues.v1.Issue.priority:type_name -> issues.v1.Priority
-	17, // 4: issues.v1.Issue.create_date:type_name -> google.protobuf.Timestamp
-	17, // 5: issues.v1.Issue.modify_date:type_name -> google.protobuf.Timestamp
-	2,  // 6: issues.v1.CreateIssueRequest.type:type_name -> issues.v1.Type
-	3,  // 7: issues.v1.CreateIssueRequest.priority:type_name -> issues.v1.Priority
-	4,  // 8: issues.v1.CreateIssueResponse.issue:type_name -> issues.v1.Issue
-	4,  // 9: issues.v1.GetIssueResponse.issue:type_name -> issues.v1.Issue
-	15, // 10: issues.v1.GetIssueResponse.project_info:type_name -> issues.v1.ProjectInfo
-	16, // 11: issues.v1.GetIssueResponse.user_info:type_name -> issues.v1.UserInfo
-	0,  // 12: issues.v1.UpdateIssueRequest.status:type_name -> issues.v1.Status
-	1,  // 13: issues.v1.UpdateIssueRequest.resolution:type_name -> issues.v1.Resolution
-	2,  // 14: issues.v1.UpdateIssueRequest.type:type_name -> issues.v1.Type
-	3,  // 15: issues.v1.UpdateIssueRequest.priority:type_name -> issues.v1.Priority
-	4,  // 16: issues.v1.UpdateIssueResponse.issue:type_name -> issues.v1.Issue
-	4,  // 17: issues.v1.DeleteIssueResponse.issue:type_name -> issues.v1.Issue
-	4,  // 18: issues.v1.ListIssuesResponse.issues:type_name -> issues.v1.Issue
-	5,  // 19: issues.v1.IssuesService.CreateIssue:input_type -> issues.v1.CreateIssueRequest
-	7,  // 20: issues.v1.IssuesService.GetIssue:input_type -> issues.v1.GetIssueRequest
-	9,  // 21: issues.v1.IssuesService.UpdateIssue:input_type -> issues.v1.UpdateIssueRequest
-	11, // 22: issues.v1.IssuesService.DeleteIssue:input_type -> issues.v1.DeleteIssueRequest
-	13, // 23: issues.v1.IssuesService.ListIssues:input_type -> issues.v1.ListIssuesRequest
-	6,  // 24: issues.v1.IssuesService.CreateIssue:output_type -> issues.v1.CreateIssueResponse
-	8,  // 25: issues.v1.IssuesService.GetIssue:output_type -> issues.v1.GetIssueResponse
-	10, // 26: issues.v1.IssuesService.UpdateIssue:output_type -> issues.v1.UpdateIssueResponse
-	12, // 27: issues.v1.IssuesService.DeleteIssue:output_type -> issues.v1.DeleteIssueResponse
-	14, // 28: issues.v1.IssuesService.ListIssues:output_type -> issues.v1.ListIssuesResponse
-	24, // [24:29] is the sub-list for method output_type
-	19, // [19:24] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	55, // 4: issues.v1.Issue.create_date:type_name -> google.protobuf.Timestamp
+	55, // 5: issues.v1.Issue.modify_date:type_name -> google.protobuf.Timestamp
+	55, // 6: issues.v1.Issue.resolve_date:type_name -> google.protobuf.Timestamp
+	55, // 7: issues.v1.Issue.due_date:type_name -> google.protobuf.Timestamp
+	2,  // 8: issues.v1.CreateIssueRequest.type:type_name -> issues.v1.Type
+	3,  // 9: issues.v1.CreateIssueRequest.priority:type_name -> issues.v1.Priority
+	55, // 10: issues.v1.CreateIssueRequest.due_date:type_name -> google.protobuf.Timestamp
+	5,  // 11: issues.v1.CreateIssueResponse.issue:type_name -> issues.v1.Issue
+	5,  // 12: issues.v1.GetIssueResponse.issue:type_name -> issues.v1.Issue
+	52, // 13: issues.v1.GetIssueResponse.project_info:type_name -> issues.v1.ProjectInfo
+	53, // 14: issues.v1.GetIssueResponse.user_info:type_name -> issues.v1.UserInfo
+	5,  // 15: issues.v1.GetIssueByProjectAndNumberResponse.issue:type_name -> issues.v1.Issue
+	5,  // 16: issues.v1.BatchGetIssuesResponse.issues:type_name -> issues.v1.Issue
+	0,  // 17: issues.v1.UpdateIssueRequest.status:type_name -> issues.v1.Status
+	1,  // 18: issues.v1.UpdateIssueRequest.resolution:type_name -> issues.v1.Resolution
+	2,  // 19: issues.v1.UpdateIssueRequest.type:type_name -> issues.v1.Type
+	3,  // 20: issues.v1.UpdateIssueRequest.priority:type_name -> issues.v1.Priority
+	55, // 21: issues.v1.UpdateIssueRequest.due_date:type_name -> google.protobuf.Timestamp
+	5,  // 22: issues.v1.UpdateIssueResponse.issue:type_name -> issues.v1.Issue
+	5,  // 23: issues.v1.DeleteIssueResponse.issue:type_name -> issues.v1.Issue
+	5,  // 24: issues.v1.ListIssuesResponse.issues:type_name -> issues.v1.Issue
+	5,  // 25: issues.v1.AssignIssueResponse.issue:type_name -> issues.v1.Issue
+	0,  // 26: issues.v1.ChangeIssueStatusRequest.new_status:type_name -> issues.v1.Status
+	1,  // 27: issues.v1.ChangeIssueStatusRequest.resolution:type_name -> issues.v1.Resolution
+	5,  // 28: issues.v1.ChangeIssueStatusResponse.issue:type_name -> issues.v1.Issue
+	5,  // 29: issues.v1.ReopenIssueResponse.issue:type_name -> issues.v1.Issue
+	5,  // 30: issues.v1.MoveIssueResponse.issue:type_name -> issues.v1.Issue
+	5,  // 31: issues.v1.CloneIssueResponse.cloned_issue:type_name -> issues.v1.Issue
+	4,  // 32: issues.v1.IssueLink.type:type_name -> issues.v1.LinkType
+	55, // 33: issues.v1.IssueLink.create_date:type_name -> google.protobuf.Timestamp
+	4,  // 34: issues.v1.LinkIssuesRequest.type:type_name -> issues.v1.LinkType
+	30, // 35: issues.v1.LinkIssuesResponse.link:type_name -> issues.v1.IssueLink
+	30, // 36: issues.v1.UnlinkIssuesResponse.link:type_name -> issues.v1.IssueLink
+	30, // 37: issues.v1.ListIssueLinksResponse.links:type_name -> issues.v1.IssueLink
+	2,  // 38: issues.v1.GetIssueResolutionMetricsRequest.issue_type:type_name -> issues.v1.Type
+	55, // 39: issues.v1.GetIssueResolutionMetricsRequest.from_date:type_name -> google.protobuf.Timestamp
+	55, // 40: issues.v1.GetIssueResolutionMetricsRequest.to_date:type_name -> google.protobuf.Timestamp
+	55, // 41: issues.v1.GenerateProjectDigestRequest.since:type_name -> google.protobuf.Timestamp
+	55, // 42: issues.v1.ProjectDigest.window_start:type_name -> google.protobuf.Timestamp
+	55, // 43: issues.v1.ProjectDigest.window_end:type_name -> google.protobuf.Timestamp
+	40, // 44: issues.v1.GenerateProjectDigestResponse.digest:type_name -> issues.v1.ProjectDigest
+	54, // 45: issues.v1.GetProjectProgressResponse.issues_by_status:type_name -> issues.v1.GetProjectProgressResponse.IssuesByStatusEntry
+	2,  // 46: issues.v1.IssueFilter.types:type_name -> issues.v1.Type
+	3,  // 47: issues.v1.IssueFilter.priorities:type_name -> issues.v1.Priority
+	0,  // 48: issues.v1.BulkUpdateIssueStatusRequest.from_status:type_name -> issues.v1.Status
+	0,  // 49: issues.v1.BulkUpdateIssueStatusRequest.to_status:type_name -> issues.v1.Status
+	1,  // 50: issues.v1.BulkUpdateIssueStatusRequest.resolution:type_name -> issues.v1.Resolution
+	44, // 51: issues.v1.BulkUpdateIssueStatusRequest.filter:type_name -> issues.v1.IssueFilter
+	6,  // 52: issues.v1.ValidateIssueRequest.create:type_name -> issues.v1.CreateIssueRequest
+	14, // 53: issues.v1.ValidateIssueRequest.update:type_name -> issues.v1.UpdateIssueRequest
+	0,  // 54: issues.v1.ValidateIssueRequest.current_status:type_name -> issues.v1.Status
+	48, // 55: issues.v1.ValidateIssueResponse.problems:type_name -> issues.v1.ValidationProblem
+	5,  // 56: issues.v1.IssueEvent.issue:type_name -> issues.v1.Issue
+	6,  // 57: issues.v1.IssuesService.CreateIssue:input_type -> issues.v1.CreateIssueRequest
+	8,  // 58: issues.v1.IssuesService.GetIssue:input_type -> issues.v1.GetIssueRequest
+	10, // 59: issues.v1.IssuesService.GetIssueByProjectAndNumber:input_type -> issues.v1.GetIssueByProjectAndNumberRequest
+	12, // 60: issues.v1.IssuesService.BatchGetIssues:input_type -> issues.v1.BatchGetIssuesRequest
+	14, // 61: issues.v1.IssuesService.UpdateIssue:input_type -> issues.v1.UpdateIssueRequest
+	16, // 62: issues.v1.IssuesService.DeleteIssue:input_type -> issues.v1.DeleteIssueRequest
+	18, // 63: issues.v1.IssuesService.ListIssues:input_type -> issues.v1.ListIssuesRequest
+	20, // 64: issues.v1.IssuesService.AssignIssue:input_type -> issues.v1.AssignIssueRequest
+	50, // 65: issues.v1.IssuesService.WatchIssue:input_type -> issues.v1.WatchIssueRequest
+	22, // 66: issues.v1.IssuesService.ChangeIssueStatus:input_type -> issues.v1.ChangeIssueStatusRequest
+	24, // 67: issues.v1.IssuesService.ReopenIssue:input_type -> issues.v1.ReopenIssueRequest
+	26, // 68: issues.v1.IssuesService.MoveIssue:input_type -> issues.v1.MoveIssueRequest
+	28, // 69: issues.v1.IssuesService.CloneIssue:input_type -> issues.v1.CloneIssueRequest
+	31, // 70: issues.v1.IssuesService.LinkIssues:input_type -> issues.v1.LinkIssuesRequest
+	33, // 71: issues.v1.IssuesService.UnlinkIssues:input_type -> issues.v1.UnlinkIssuesRequest
+	35, // 72: issues.v1.IssuesService.ListIssueLinks:input_type -> issues.v1.ListIssueLinksRequest
+	37, // 73: issues.v1.IssuesService.GetIssueResolutionMetrics:input_type -> issues.v1.GetIssueResolutionMetricsRequest
+	39, // 74: issues.v1.IssuesService.GenerateProjectDigest:input_type -> issues.v1.GenerateProjectDigestRequest
+	42, // 75: issues.v1.IssuesService.GetProjectProgress:input_type -> issues.v1.GetProjectProgressRequest
+	45, // 76: issues.v1.IssuesService.BulkUpdateIssueStatus:input_type -> issues.v1.BulkUpdateIssueStatusRequest
+	47, // 77: issues.v1.IssuesService.ValidateIssue:input_type -> issues.v1.ValidateIssueRequest
+	7,  // 78: issues.v1.IssuesService.CreateIssue:output_type -> issues.v1.CreateIssueResponse
+	9,  // 79: issues.v1.IssuesService.GetIssue:output_type -> issues.v1.GetIssueResponse
+	11, // 80: issues.v1.IssuesService.GetIssueByProjectAndNumber:output_type -> issues.v1.GetIssueByProjectAndNumberResponse
+	13, // 81: issues.v1.IssuesService.BatchGetIssues:output_type -> issues.v1.BatchGetIssuesResponse
+	15, // 82: issues.v1.IssuesService.UpdateIssue:output_type -> issues.v1.UpdateIssueResponse
+	17, // 83: issues.v1.IssuesService.DeleteIssue:output_type -> issues.v1.DeleteIssueResponse
+	19, // 84: issues.v1.IssuesService.ListIssues:output_type -> issues.v1.ListIssuesResponse
+	21, // 85: issues.v1.IssuesService.AssignIssue:output_type -> issues.v1.AssignIssueResponse
+	51, // 86: issues.v1.IssuesService.WatchIssue:output_type -> issues.v1.IssueEvent
+	23, // 87: issues.v1.IssuesService.ChangeIssueStatus:output_type -> issues.v1.ChangeIssueStatusResponse
+	25, // 88: issues.v1.IssuesService.ReopenIssue:output_type -> issues.v1.ReopenIssueResponse
+	27, // 89: issues.v1.IssuesService.MoveIssue:output_type -> issues.v1.MoveIssueResponse
+	29, // 90: issues.v1.IssuesService.CloneIssue:output_type -> issues.v1.CloneIssueResponse
+	32, // 91: issues.v1.IssuesService.LinkIssues:output_type -> issues.v1.LinkIssuesResponse
+	34, // 92: issues.v1.IssuesService.UnlinkIssues:output_type -> issues.v1.UnlinkIssuesResponse
+	36, // 93: issues.v1.IssuesService.ListIssueLinks:output_type -> issues.v1.ListIssueLinksResponse
+	38, // 94: issues.v1.IssuesService.GetIssueResolutionMetrics:output_type -> issues.v1.GetIssueResolutionMetricsResponse
+	41, // 95: issues.v1.IssuesService.GenerateProjectDigest:output_type -> issues.v1.GenerateProjectDigestResponse
+	43, // 96: issues.v1.IssuesService.GetProjectProgress:output_type -> issues.v1.GetProjectProgressResponse
+	46, // 97: issues.v1.IssuesService.BulkUpdateIssueStatus:output_type -> issues.v1.BulkUpdateIssueStatusResponse
+	49, // 98: issues.v1.IssuesService.ValidateIssue:output_type -> issues.v1.ValidateIssueResponse
+	78, // [78:99] is the sub-list for method output_type
+	57, // [57:78] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_pkg_pb_issues_v1_issues_proto_init() }
@@ -1305,14 +3962,20 @@ func file_pkg_pb_issues_v1_issues_proto_init() {
 		return
 	}
 	file_pkg_pb_issues_v1_issues_proto_msgTypes[1].OneofWrappers = []any{}
-	file_pkg_pb_issues_v1_issues_proto_msgTypes[5].OneofWrappers = []any{}
+	file_pkg_pb_issues_v1_issues_proto_msgTypes[9].OneofWrappers = []any{}
+	file_pkg_pb_issues_v1_issues_proto_msgTypes[19].OneofWrappers = []any{}
+	file_pkg_pb_issues_v1_issues_proto_msgTypes[23].OneofWrappers = []any{}
+	file_pkg_pb_issues_v1_issues_proto_msgTypes[42].OneofWrappers = []any{
+		(*ValidateIssueRequest_Create)(nil),
+		(*ValidateIssueRequest_Update)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_pb_issues_v1_issues_proto_rawDesc), len(file_pkg_pb_issues_v1_issues_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   13,
+			NumEnums:      5,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
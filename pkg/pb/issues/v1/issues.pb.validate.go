@@ -71,10 +71,10 @@ func (m *Issue) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetSummary()); l < 1 || l > 100 {
+	if utf8.RuneCountInString(m.GetSummary()) < 1 {
 		err := IssueValidationError{
 			field:  "Summary",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -82,10 +82,10 @@ func (m *Issue) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetDescription()); l < 1 || l > 500 {
+	if utf8.RuneCountInString(m.GetDescription()) < 1 {
 		err := IssueValidationError{
 			field:  "Description",
-			reason: "value length must be between 1 and 500 runes, inclusive",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -219,6 +219,156 @@ func (m *Issue) validate(all bool) error {
 		}
 	}
 
+	if m.GetDuplicateOf() != "" {
+
+		if err := m._validateUuid(m.GetDuplicateOf()); err != nil {
+			err = IssueValidationError{
+				field:  "DuplicateOf",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetResolutionComment()) > 500 {
+		err := IssueValidationError{
+			field:  "ResolutionComment",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ReopenCount
+
+	if utf8.RuneCountInString(m.GetLastReopenReason()) > 500 {
+		err := IssueValidationError{
+			field:  "LastReopenReason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResolveDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "ResolveDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "ResolveDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolveDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueValidationError{
+				field:  "ResolveDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if m.GetClonedFrom() != "" {
+
+		if err := m._validateUuid(m.GetClonedFrom()); err != nil {
+			err = IssueValidationError{
+				field:  "ClonedFrom",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for IssueNumber
+
+	if all {
+		switch v := interface{}(m.GetDueDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueValidationError{
+				field:  "DueDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTeamId() != "" {
+
+		if err := m._validateUuid(m.GetTeamId()); err != nil {
+			err = IssueValidationError{
+				field:  "TeamId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetLastReopenedBy() != "" {
+
+		if err := m._validateUuid(m.GetLastReopenedBy()); err != nil {
+			err = IssueValidationError{
+				field:  "LastReopenedBy",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return IssueMultiError(errors)
 	}
@@ -326,10 +476,10 @@ func (m *CreateIssueRequest) validate(all bool) error {
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetSummary()); l < 1 || l > 100 {
+	if utf8.RuneCountInString(m.GetSummary()) < 1 {
 		err := CreateIssueRequestValidationError{
 			field:  "Summary",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -371,12 +521,57 @@ func (m *CreateIssueRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetTemplateId() != "" {
+
+		if err := m._validateUuid(m.GetTemplateId()); err != nil {
+			err = CreateIssueRequestValidationError{
+				field:  "TemplateId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDueDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateIssueRequestValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateIssueRequestValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateIssueRequestValidationError{
+				field:  "DueDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Description != nil {
 
-		if l := utf8.RuneCountInString(m.GetDescription()); l < 1 || l > 100 {
+		if utf8.RuneCountInString(m.GetDescription()) < 1 {
 			err := CreateIssueRequestValidationError{
 				field:  "Description",
-				reason: "value length must be between 1 and 100 runes, inclusive",
+				reason: "value length must be at least 1 runes",
 			}
 			if !all {
 				return err
@@ -402,6 +597,22 @@ func (m *CreateIssueRequest) validate(all bool) error {
 
 	}
 
+	if m.TeamId != nil {
+
+		if err := m._validateUuid(m.GetTeamId()); err != nil {
+			err = CreateIssueRequestValidationError{
+				field:  "TeamId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateIssueRequestMultiError(errors)
 	}
@@ -932,31 +1143,32 @@ var _ interface {
 	ErrorName() string
 } = GetIssueResponseValidationError{}
 
-// Validate checks the field values on UpdateIssueRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateIssueRequest) Validate() error {
+// Validate checks the field values on GetIssueByProjectAndNumberRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIssueByProjectAndNumberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateIssueRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateIssueRequestMultiError, or nil if none found.
-func (m *UpdateIssueRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetIssueByProjectAndNumberRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetIssueByProjectAndNumberRequestMultiError, or nil if none found.
+func (m *GetIssueByProjectAndNumberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateIssueRequest) validate(all bool) error {
+func (m *GetIssueByProjectAndNumberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetIssueId()); err != nil {
-		err = UpdateIssueRequestValidationError{
-			field:  "IssueId",
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = GetIssueByProjectAndNumberRequestValidationError{
+			field:  "ProjectId",
 			reason: "value must be a valid UUID",
 			cause:  err,
 		}
@@ -966,54 +1178,10 @@ func (m *UpdateIssueRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetSummary()); l < 1 || l > 100 {
-		err := UpdateIssueRequestValidationError{
-			field:  "Summary",
-			reason: "value length must be between 1 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := Status_name[int32(m.GetStatus())]; !ok {
-		err := UpdateIssueRequestValidationError{
-			field:  "Status",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := Resolution_name[int32(m.GetResolution())]; !ok {
-		err := UpdateIssueRequestValidationError{
-			field:  "Resolution",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := Type_name[int32(m.GetType())]; !ok {
-		err := UpdateIssueRequestValidationError{
-			field:  "Type",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := Priority_name[int32(m.GetPriority())]; !ok {
-		err := UpdateIssueRequestValidationError{
-			field:  "Priority",
-			reason: "value must be one of the defined enum values",
+	if m.GetIssueNumber() <= 0 {
+		err := GetIssueByProjectAndNumberRequestValidationError{
+			field:  "IssueNumber",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1021,45 +1189,14 @@ func (m *UpdateIssueRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.Description != nil {
-
-		if l := utf8.RuneCountInString(m.GetDescription()); l < 1 || l > 500 {
-			err := UpdateIssueRequestValidationError{
-				field:  "Description",
-				reason: "value length must be between 1 and 500 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.AssigneeId != nil {
-
-		if err := m._validateUuid(m.GetAssigneeId()); err != nil {
-			err = UpdateIssueRequestValidationError{
-				field:  "AssigneeId",
-				reason: "value must be a valid UUID",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return UpdateIssueRequestMultiError(errors)
+		return GetIssueByProjectAndNumberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *UpdateIssueRequest) _validateUuid(uuid string) error {
+func (m *GetIssueByProjectAndNumberRequest) _validateUuid(uuid string) error {
 	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -1067,13 +1204,14 @@ func (m *UpdateIssueRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// UpdateIssueRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateIssueRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateIssueRequestMultiError []error
+// GetIssueByProjectAndNumberRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIssueByProjectAndNumberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetIssueByProjectAndNumberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateIssueRequestMultiError) Error() string {
+func (m GetIssueByProjectAndNumberRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1082,11 +1220,12 @@ func (m UpdateIssueRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateIssueRequestMultiError) AllErrors() []error { return m }
+func (m GetIssueByProjectAndNumberRequestMultiError) AllErrors() []error { return m }
 
-// UpdateIssueRequestValidationError is the validation error returned by
-// UpdateIssueRequest.Validate if the designated constraints aren't met.
-type UpdateIssueRequestValidationError struct {
+// GetIssueByProjectAndNumberRequestValidationError is the validation error
+// returned by GetIssueByProjectAndNumberRequest.Validate if the designated
+// constraints aren't met.
+type GetIssueByProjectAndNumberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1094,24 +1233,24 @@ type UpdateIssueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateIssueRequestValidationError) Field() string { return e.field }
+func (e GetIssueByProjectAndNumberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateIssueRequestValidationError) Reason() string { return e.reason }
+func (e GetIssueByProjectAndNumberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateIssueRequestValidationError) Cause() error { return e.cause }
+func (e GetIssueByProjectAndNumberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateIssueRequestValidationError) Key() bool { return e.key }
+func (e GetIssueByProjectAndNumberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateIssueRequestValidationError) ErrorName() string {
-	return "UpdateIssueRequestValidationError"
+func (e GetIssueByProjectAndNumberRequestValidationError) ErrorName() string {
+	return "GetIssueByProjectAndNumberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateIssueRequestValidationError) Error() string {
+func (e GetIssueByProjectAndNumberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1123,14 +1262,14 @@ func (e UpdateIssueRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateIssueRequest.%s: %s%s",
+		"invalid %sGetIssueByProjectAndNumberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateIssueRequestValidationError{}
+var _ error = GetIssueByProjectAndNumberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1138,37 +1277,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateIssueRequestValidationError{}
+} = GetIssueByProjectAndNumberRequestValidationError{}
 
-// Validate checks the field values on UpdateIssueResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateIssueResponse) Validate() error {
+// Validate checks the field values on GetIssueByProjectAndNumberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIssueByProjectAndNumberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateIssueResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateIssueResponseMultiError, or nil if none found.
-func (m *UpdateIssueResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetIssueByProjectAndNumberResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetIssueByProjectAndNumberResponseMultiError, or nil if none found.
+func (m *GetIssueByProjectAndNumberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateIssueResponse) validate(all bool) error {
+func (m *GetIssueByProjectAndNumberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
-
 	if all {
 		switch v := interface{}(m.GetIssue()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateIssueResponseValidationError{
+				errors = append(errors, GetIssueByProjectAndNumberResponseValidationError{
 					field:  "Issue",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1176,7 +1314,7 @@ func (m *UpdateIssueResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateIssueResponseValidationError{
+				errors = append(errors, GetIssueByProjectAndNumberResponseValidationError{
 					field:  "Issue",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1185,7 +1323,7 @@ func (m *UpdateIssueResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateIssueResponseValidationError{
+			return GetIssueByProjectAndNumberResponseValidationError{
 				field:  "Issue",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1194,19 +1332,5250 @@ func (m *UpdateIssueResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateIssueResponseMultiError(errors)
+		return GetIssueByProjectAndNumberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueByProjectAndNumberResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIssueByProjectAndNumberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetIssueByProjectAndNumberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueByProjectAndNumberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueByProjectAndNumberResponseMultiError) AllErrors() []error { return m }
+
+// GetIssueByProjectAndNumberResponseValidationError is the validation error
+// returned by GetIssueByProjectAndNumberResponse.Validate if the designated
+// constraints aren't met.
+type GetIssueByProjectAndNumberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueByProjectAndNumberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueByProjectAndNumberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueByProjectAndNumberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueByProjectAndNumberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueByProjectAndNumberResponseValidationError) ErrorName() string {
+	return "GetIssueByProjectAndNumberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueByProjectAndNumberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueByProjectAndNumberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueByProjectAndNumberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueByProjectAndNumberResponseValidationError{}
+
+// Validate checks the field values on BatchGetIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetIssuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetIssuesRequestMultiError, or nil if none found.
+func (m *BatchGetIssuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetIssuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetIssueIds()); l < 1 || l > 50 {
+		err := BatchGetIssuesRequestValidationError{
+			field:  "IssueIds",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIssueIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = BatchGetIssuesRequestValidationError{
+				field:  fmt.Sprintf("IssueIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetIssuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BatchGetIssuesRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BatchGetIssuesRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetIssuesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetIssuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetIssuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetIssuesRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetIssuesRequestValidationError is the validation error returned by
+// BatchGetIssuesRequest.Validate if the designated constraints aren't met.
+type BatchGetIssuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetIssuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetIssuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetIssuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetIssuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetIssuesRequestValidationError) ErrorName() string {
+	return "BatchGetIssuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetIssuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetIssuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetIssuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetIssuesRequestValidationError{}
+
+// Validate checks the field values on BatchGetIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetIssuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetIssuesResponseMultiError, or nil if none found.
+func (m *BatchGetIssuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetIssuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetIssuesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetIssuesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetIssuesResponseValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetIssuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetIssuesResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetIssuesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetIssuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetIssuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetIssuesResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetIssuesResponseValidationError is the validation error returned by
+// BatchGetIssuesResponse.Validate if the designated constraints aren't met.
+type BatchGetIssuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetIssuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetIssuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetIssuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetIssuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetIssuesResponseValidationError) ErrorName() string {
+	return "BatchGetIssuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetIssuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetIssuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetIssuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetIssuesResponseValidationError{}
+
+// Validate checks the field values on UpdateIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateIssueRequestMultiError, or nil if none found.
+func (m *UpdateIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = UpdateIssueRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSummary()) < 1 {
+		err := UpdateIssueRequestValidationError{
+			field:  "Summary",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Status_name[int32(m.GetStatus())]; !ok {
+		err := UpdateIssueRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Resolution_name[int32(m.GetResolution())]; !ok {
+		err := UpdateIssueRequestValidationError{
+			field:  "Resolution",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Type_name[int32(m.GetType())]; !ok {
+		err := UpdateIssueRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Priority_name[int32(m.GetPriority())]; !ok {
+		err := UpdateIssueRequestValidationError{
+			field:  "Priority",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := UpdateIssueRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDueDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateIssueRequestValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateIssueRequestValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateIssueRequestValidationError{
+				field:  "DueDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) < 1 {
+			err := UpdateIssueRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.AssigneeId != nil {
+
+		if err := m._validateUuid(m.GetAssigneeId()); err != nil {
+			err = UpdateIssueRequestValidationError{
+				field:  "AssigneeId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.DuplicateOf != nil {
+
+		if m.GetDuplicateOf() != "" {
+
+			if err := m._validateUuid(m.GetDuplicateOf()); err != nil {
+				err = UpdateIssueRequestValidationError{
+					field:  "DuplicateOf",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.ResolutionComment != nil {
+
+		if utf8.RuneCountInString(m.GetResolutionComment()) > 500 {
+			err := UpdateIssueRequestValidationError{
+				field:  "ResolutionComment",
+				reason: "value length must be at most 500 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateIssueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateIssueRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateIssueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateIssueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateIssueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateIssueRequestMultiError) AllErrors() []error { return m }
+
+// UpdateIssueRequestValidationError is the validation error returned by
+// UpdateIssueRequest.Validate if the designated constraints aren't met.
+type UpdateIssueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateIssueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateIssueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateIssueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateIssueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateIssueRequestValidationError) ErrorName() string {
+	return "UpdateIssueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateIssueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateIssueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateIssueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateIssueRequestValidationError{}
+
+// Validate checks the field values on UpdateIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateIssueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateIssueResponseMultiError, or nil if none found.
+func (m *UpdateIssueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateIssueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateIssueResponseValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateIssueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateIssueResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateIssueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateIssueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateIssueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateIssueResponseMultiError) AllErrors() []error { return m }
+
+// UpdateIssueResponseValidationError is the validation error returned by
+// UpdateIssueResponse.Validate if the designated constraints aren't met.
+type UpdateIssueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateIssueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateIssueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateIssueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateIssueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateIssueResponseValidationError) ErrorName() string {
+	return "UpdateIssueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateIssueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateIssueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateIssueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateIssueResponseValidationError{}
+
+// Validate checks the field values on DeleteIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteIssueRequestMultiError, or nil if none found.
+func (m *DeleteIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = DeleteIssueRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteIssueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteIssueRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteIssueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteIssueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteIssueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteIssueRequestMultiError) AllErrors() []error { return m }
+
+// DeleteIssueRequestValidationError is the validation error returned by
+// DeleteIssueRequest.Validate if the designated constraints aren't met.
+type DeleteIssueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteIssueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteIssueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteIssueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteIssueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteIssueRequestValidationError) ErrorName() string {
+	return "DeleteIssueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteIssueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteIssueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteIssueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteIssueRequestValidationError{}
+
+// Validate checks the field values on DeleteIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteIssueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteIssueResponseMultiError, or nil if none found.
+func (m *DeleteIssueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteIssueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteIssueResponseValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteIssueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteIssueResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteIssueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteIssueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteIssueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteIssueResponseMultiError) AllErrors() []error { return m }
+
+// DeleteIssueResponseValidationError is the validation error returned by
+// DeleteIssueResponse.Validate if the designated constraints aren't met.
+type DeleteIssueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteIssueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteIssueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteIssueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteIssueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteIssueResponseValidationError) ErrorName() string {
+	return "DeleteIssueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteIssueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteIssueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteIssueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteIssueResponseValidationError{}
+
+// Validate checks the field values on ListIssuesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListIssuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssuesRequestMultiError, or nil if none found.
+func (m *ListIssuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListIssuesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListIssuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssuesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListIssuesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListIssuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssuesRequestMultiError) AllErrors() []error { return m }
+
+// ListIssuesRequestValidationError is the validation error returned by
+// ListIssuesRequest.Validate if the designated constraints aren't met.
+type ListIssuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssuesRequestValidationError) ErrorName() string {
+	return "ListIssuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssuesRequestValidationError{}
+
+// Validate checks the field values on ListIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssuesResponseMultiError, or nil if none found.
+func (m *ListIssuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIssuesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIssuesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIssuesResponseValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListIssuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssuesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListIssuesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListIssuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssuesResponseMultiError) AllErrors() []error { return m }
+
+// ListIssuesResponseValidationError is the validation error returned by
+// ListIssuesResponse.Validate if the designated constraints aren't met.
+type ListIssuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssuesResponseValidationError) ErrorName() string {
+	return "ListIssuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssuesResponseValidationError{}
+
+// Validate checks the field values on AssignIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignIssueRequestMultiError, or nil if none found.
+func (m *AssignIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = AssignIssueRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAssigneeId() != "" {
+
+		if err := m._validateUuid(m.GetAssigneeId()); err != nil {
+			err = AssignIssueRequestValidationError{
+				field:  "AssigneeId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AssignIssueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AssignIssueRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AssignIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by AssignIssueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AssignIssueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignIssueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignIssueRequestMultiError) AllErrors() []error { return m }
+
+// AssignIssueRequestValidationError is the validation error returned by
+// AssignIssueRequest.Validate if the designated constraints aren't met.
+type AssignIssueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignIssueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignIssueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignIssueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignIssueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignIssueRequestValidationError) ErrorName() string {
+	return "AssignIssueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignIssueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignIssueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignIssueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignIssueRequestValidationError{}
+
+// Validate checks the field values on AssignIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignIssueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignIssueResponseMultiError, or nil if none found.
+func (m *AssignIssueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignIssueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssignIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssignIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssignIssueResponseValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssignIssueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignIssueResponseMultiError is an error wrapping multiple validation
+// errors returned by AssignIssueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AssignIssueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignIssueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignIssueResponseMultiError) AllErrors() []error { return m }
+
+// AssignIssueResponseValidationError is the validation error returned by
+// AssignIssueResponse.Validate if the designated constraints aren't met.
+type AssignIssueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignIssueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignIssueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignIssueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignIssueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignIssueResponseValidationError) ErrorName() string {
+	return "AssignIssueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignIssueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignIssueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignIssueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignIssueResponseValidationError{}
+
+// Validate checks the field values on ChangeIssueStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeIssueStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeIssueStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeIssueStatusRequestMultiError, or nil if none found.
+func (m *ChangeIssueStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeIssueStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = ChangeIssueStatusRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ChangeIssueStatusRequest_NewStatus_NotInLookup[m.GetNewStatus()]; ok {
+		err := ChangeIssueStatusRequestValidationError{
+			field:  "NewStatus",
+			reason: "value must not be in list [STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Status_name[int32(m.GetNewStatus())]; !ok {
+		err := ChangeIssueStatusRequestValidationError{
+			field:  "NewStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Resolution_name[int32(m.GetResolution())]; !ok {
+		err := ChangeIssueStatusRequestValidationError{
+			field:  "Resolution",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResolutionComment()) > 500 {
+		err := ChangeIssueStatusRequestValidationError{
+			field:  "ResolutionComment",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDuplicateOf() != "" {
+
+		if err := m._validateUuid(m.GetDuplicateOf()); err != nil {
+			err = ChangeIssueStatusRequestValidationError{
+				field:  "DuplicateOf",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ChangeIssueStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ChangeIssueStatusRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ChangeIssueStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangeIssueStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeIssueStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeIssueStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeIssueStatusRequestMultiError) AllErrors() []error { return m }
+
+// ChangeIssueStatusRequestValidationError is the validation error returned by
+// ChangeIssueStatusRequest.Validate if the designated constraints aren't met.
+type ChangeIssueStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeIssueStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeIssueStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeIssueStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeIssueStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeIssueStatusRequestValidationError) ErrorName() string {
+	return "ChangeIssueStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeIssueStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeIssueStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeIssueStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeIssueStatusRequestValidationError{}
+
+var _ChangeIssueStatusRequest_NewStatus_NotInLookup = map[Status]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ChangeIssueStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeIssueStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeIssueStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeIssueStatusResponseMultiError, or nil if none found.
+func (m *ChangeIssueStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeIssueStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeIssueStatusResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeIssueStatusResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeIssueStatusResponseValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ChangeIssueStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeIssueStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangeIssueStatusResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ChangeIssueStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeIssueStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeIssueStatusResponseMultiError) AllErrors() []error { return m }
+
+// ChangeIssueStatusResponseValidationError is the validation error returned by
+// ChangeIssueStatusResponse.Validate if the designated constraints aren't met.
+type ChangeIssueStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeIssueStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeIssueStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeIssueStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeIssueStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeIssueStatusResponseValidationError) ErrorName() string {
+	return "ChangeIssueStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeIssueStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeIssueStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeIssueStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeIssueStatusResponseValidationError{}
+
+// Validate checks the field values on ReopenIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReopenIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReopenIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReopenIssueRequestMultiError, or nil if none found.
+func (m *ReopenIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReopenIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = ReopenIssueRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := ReopenIssueRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.AssigneeId != nil {
+
+		if err := m._validateUuid(m.GetAssigneeId()); err != nil {
+			err = ReopenIssueRequestValidationError{
+				field:  "AssigneeId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReopenIssueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReopenIssueRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReopenIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by ReopenIssueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReopenIssueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReopenIssueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReopenIssueRequestMultiError) AllErrors() []error { return m }
+
+// ReopenIssueRequestValidationError is the validation error returned by
+// ReopenIssueRequest.Validate if the designated constraints aren't met.
+type ReopenIssueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReopenIssueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReopenIssueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReopenIssueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReopenIssueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReopenIssueRequestValidationError) ErrorName() string {
+	return "ReopenIssueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReopenIssueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReopenIssueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReopenIssueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReopenIssueRequestValidationError{}
+
+// Validate checks the field values on ReopenIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReopenIssueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReopenIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReopenIssueResponseMultiError, or nil if none found.
+func (m *ReopenIssueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReopenIssueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReopenIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReopenIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReopenIssueResponseValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReopenIssueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReopenIssueResponseMultiError is an error wrapping multiple validation
+// errors returned by ReopenIssueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReopenIssueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReopenIssueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReopenIssueResponseMultiError) AllErrors() []error { return m }
+
+// ReopenIssueResponseValidationError is the validation error returned by
+// ReopenIssueResponse.Validate if the designated constraints aren't met.
+type ReopenIssueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReopenIssueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReopenIssueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReopenIssueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReopenIssueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReopenIssueResponseValidationError) ErrorName() string {
+	return "ReopenIssueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReopenIssueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReopenIssueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReopenIssueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReopenIssueResponseValidationError{}
+
+// Validate checks the field values on MoveIssueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveIssueRequestMultiError, or nil if none found.
+func (m *MoveIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = MoveIssueRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetFromProjectId()); err != nil {
+		err = MoveIssueRequestValidationError{
+			field:  "FromProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetToProjectId()); err != nil {
+		err = MoveIssueRequestValidationError{
+			field:  "ToProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveIssueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MoveIssueRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MoveIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveIssueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveIssueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveIssueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveIssueRequestMultiError) AllErrors() []error { return m }
+
+// MoveIssueRequestValidationError is the validation error returned by
+// MoveIssueRequest.Validate if the designated constraints aren't met.
+type MoveIssueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveIssueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveIssueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveIssueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveIssueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveIssueRequestValidationError) ErrorName() string { return "MoveIssueRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveIssueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveIssueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveIssueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveIssueRequestValidationError{}
+
+// Validate checks the field values on MoveIssueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveIssueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveIssueResponseMultiError, or nil if none found.
+func (m *MoveIssueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveIssueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveIssueResponseValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FromProjectIssueCount
+
+	// no validation rules for ToProjectIssueCount
+
+	if len(errors) > 0 {
+		return MoveIssueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveIssueResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveIssueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveIssueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveIssueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveIssueResponseMultiError) AllErrors() []error { return m }
+
+// MoveIssueResponseValidationError is the validation error returned by
+// MoveIssueResponse.Validate if the designated constraints aren't met.
+type MoveIssueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveIssueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveIssueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveIssueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveIssueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveIssueResponseValidationError) ErrorName() string {
+	return "MoveIssueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveIssueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveIssueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveIssueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveIssueResponseValidationError{}
+
+// Validate checks the field values on CloneIssueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CloneIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneIssueRequestMultiError, or nil if none found.
+func (m *CloneIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSourceIssueId()); err != nil {
+		err = CloneIssueRequestValidationError{
+			field:  "SourceIssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetProjectId() != "" {
+
+		if err := m._validateUuid(m.GetTargetProjectId()); err != nil {
+			err = CloneIssueRequestValidationError{
+				field:  "TargetProjectId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.SummaryPrefix != nil {
+
+		if utf8.RuneCountInString(m.GetSummaryPrefix()) > 20 {
+			err := CloneIssueRequestValidationError{
+				field:  "SummaryPrefix",
+				reason: "value length must be at most 20 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CloneIssueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CloneIssueRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CloneIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by CloneIssueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CloneIssueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneIssueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneIssueRequestMultiError) AllErrors() []error { return m }
+
+// CloneIssueRequestValidationError is the validation error returned by
+// CloneIssueRequest.Validate if the designated constraints aren't met.
+type CloneIssueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneIssueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneIssueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneIssueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneIssueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneIssueRequestValidationError) ErrorName() string {
+	return "CloneIssueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneIssueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneIssueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneIssueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneIssueRequestValidationError{}
+
+// Validate checks the field values on CloneIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneIssueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneIssueResponseMultiError, or nil if none found.
+func (m *CloneIssueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneIssueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetClonedIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloneIssueResponseValidationError{
+					field:  "ClonedIssue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloneIssueResponseValidationError{
+					field:  "ClonedIssue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClonedIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloneIssueResponseValidationError{
+				field:  "ClonedIssue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloneIssueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneIssueResponseMultiError is an error wrapping multiple validation errors
+// returned by CloneIssueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CloneIssueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneIssueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneIssueResponseMultiError) AllErrors() []error { return m }
+
+// CloneIssueResponseValidationError is the validation error returned by
+// CloneIssueResponse.Validate if the designated constraints aren't met.
+type CloneIssueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneIssueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneIssueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneIssueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneIssueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneIssueResponseValidationError) ErrorName() string {
+	return "CloneIssueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneIssueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneIssueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneIssueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneIssueResponseValidationError{}
+
+// Validate checks the field values on IssueLink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueLinkMultiError, or nil
+// if none found.
+func (m *IssueLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LinkId
+
+	// no validation rules for SourceIssueId
+
+	// no validation rules for TargetIssueId
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetCreateDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueLinkValidationError{
+					field:  "CreateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueLinkValidationError{
+					field:  "CreateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueLinkValidationError{
+				field:  "CreateDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IssueLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueLinkMultiError is an error wrapping multiple validation errors returned
+// by IssueLink.ValidateAll() if the designated constraints aren't met.
+type IssueLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueLinkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueLinkMultiError) AllErrors() []error { return m }
+
+// IssueLinkValidationError is the validation error returned by
+// IssueLink.Validate if the designated constraints aren't met.
+type IssueLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueLinkValidationError) ErrorName() string { return "IssueLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueLinkValidationError{}
+
+// Validate checks the field values on LinkIssuesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LinkIssuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LinkIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LinkIssuesRequestMultiError, or nil if none found.
+func (m *LinkIssuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LinkIssuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSourceIssueId()); err != nil {
+		err = LinkIssuesRequestValidationError{
+			field:  "SourceIssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetIssueId()); err != nil {
+		err = LinkIssuesRequestValidationError{
+			field:  "TargetIssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _LinkIssuesRequest_Type_NotInLookup[m.GetType()]; ok {
+		err := LinkIssuesRequestValidationError{
+			field:  "Type",
+			reason: "value must not be in list [LINK_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LinkType_name[int32(m.GetType())]; !ok {
+		err := LinkIssuesRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LinkIssuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *LinkIssuesRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// LinkIssuesRequestMultiError is an error wrapping multiple validation errors
+// returned by LinkIssuesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LinkIssuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LinkIssuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LinkIssuesRequestMultiError) AllErrors() []error { return m }
+
+// LinkIssuesRequestValidationError is the validation error returned by
+// LinkIssuesRequest.Validate if the designated constraints aren't met.
+type LinkIssuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LinkIssuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LinkIssuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LinkIssuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LinkIssuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LinkIssuesRequestValidationError) ErrorName() string {
+	return "LinkIssuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LinkIssuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLinkIssuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LinkIssuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LinkIssuesRequestValidationError{}
+
+var _LinkIssuesRequest_Type_NotInLookup = map[LinkType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on LinkIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LinkIssuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LinkIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LinkIssuesResponseMultiError, or nil if none found.
+func (m *LinkIssuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LinkIssuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetLink()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LinkIssuesResponseValidationError{
+					field:  "Link",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LinkIssuesResponseValidationError{
+					field:  "Link",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLink()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LinkIssuesResponseValidationError{
+				field:  "Link",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LinkIssuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LinkIssuesResponseMultiError is an error wrapping multiple validation errors
+// returned by LinkIssuesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LinkIssuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LinkIssuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LinkIssuesResponseMultiError) AllErrors() []error { return m }
+
+// LinkIssuesResponseValidationError is the validation error returned by
+// LinkIssuesResponse.Validate if the designated constraints aren't met.
+type LinkIssuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LinkIssuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LinkIssuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LinkIssuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LinkIssuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LinkIssuesResponseValidationError) ErrorName() string {
+	return "LinkIssuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LinkIssuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLinkIssuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LinkIssuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LinkIssuesResponseValidationError{}
+
+// Validate checks the field values on UnlinkIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlinkIssuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlinkIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlinkIssuesRequestMultiError, or nil if none found.
+func (m *UnlinkIssuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlinkIssuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLinkId()); err != nil {
+		err = UnlinkIssuesRequestValidationError{
+			field:  "LinkId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnlinkIssuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnlinkIssuesRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnlinkIssuesRequestMultiError is an error wrapping multiple validation
+// errors returned by UnlinkIssuesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnlinkIssuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlinkIssuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlinkIssuesRequestMultiError) AllErrors() []error { return m }
+
+// UnlinkIssuesRequestValidationError is the validation error returned by
+// UnlinkIssuesRequest.Validate if the designated constraints aren't met.
+type UnlinkIssuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlinkIssuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlinkIssuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlinkIssuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlinkIssuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlinkIssuesRequestValidationError) ErrorName() string {
+	return "UnlinkIssuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlinkIssuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlinkIssuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlinkIssuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlinkIssuesRequestValidationError{}
+
+// Validate checks the field values on UnlinkIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlinkIssuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlinkIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlinkIssuesResponseMultiError, or nil if none found.
+func (m *UnlinkIssuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlinkIssuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetLink()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnlinkIssuesResponseValidationError{
+					field:  "Link",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnlinkIssuesResponseValidationError{
+					field:  "Link",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLink()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnlinkIssuesResponseValidationError{
+				field:  "Link",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnlinkIssuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlinkIssuesResponseMultiError is an error wrapping multiple validation
+// errors returned by UnlinkIssuesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnlinkIssuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlinkIssuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlinkIssuesResponseMultiError) AllErrors() []error { return m }
+
+// UnlinkIssuesResponseValidationError is the validation error returned by
+// UnlinkIssuesResponse.Validate if the designated constraints aren't met.
+type UnlinkIssuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlinkIssuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlinkIssuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlinkIssuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlinkIssuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlinkIssuesResponseValidationError) ErrorName() string {
+	return "UnlinkIssuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlinkIssuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlinkIssuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlinkIssuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlinkIssuesResponseValidationError{}
+
+// Validate checks the field values on ListIssueLinksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssueLinksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueLinksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssueLinksRequestMultiError, or nil if none found.
+func (m *ListIssueLinksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueLinksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = ListIssueLinksRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListIssueLinksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListIssueLinksRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListIssueLinksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListIssueLinksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListIssueLinksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueLinksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueLinksRequestMultiError) AllErrors() []error { return m }
+
+// ListIssueLinksRequestValidationError is the validation error returned by
+// ListIssueLinksRequest.Validate if the designated constraints aren't met.
+type ListIssueLinksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueLinksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueLinksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueLinksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueLinksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueLinksRequestValidationError) ErrorName() string {
+	return "ListIssueLinksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueLinksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueLinksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueLinksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueLinksRequestValidationError{}
+
+// Validate checks the field values on ListIssueLinksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssueLinksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueLinksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssueLinksResponseMultiError, or nil if none found.
+func (m *ListIssueLinksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueLinksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIssueLinksResponseValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIssueLinksResponseValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIssueLinksResponseValidationError{
+					field:  fmt.Sprintf("Links[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListIssueLinksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssueLinksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListIssueLinksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListIssueLinksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueLinksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueLinksResponseMultiError) AllErrors() []error { return m }
+
+// ListIssueLinksResponseValidationError is the validation error returned by
+// ListIssueLinksResponse.Validate if the designated constraints aren't met.
+type ListIssueLinksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueLinksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueLinksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueLinksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueLinksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueLinksResponseValidationError) ErrorName() string {
+	return "ListIssueLinksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueLinksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueLinksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueLinksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueLinksResponseValidationError{}
+
+// Validate checks the field values on GetIssueResolutionMetricsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIssueResolutionMetricsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueResolutionMetricsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetIssueResolutionMetricsRequestMultiError, or nil if none found.
+func (m *GetIssueResolutionMetricsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueResolutionMetricsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProjectId() != "" {
+
+		if err := m._validateUuid(m.GetProjectId()); err != nil {
+			err = GetIssueResolutionMetricsRequestValidationError{
+				field:  "ProjectId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := Type_name[int32(m.GetIssueType())]; !ok {
+		err := GetIssueResolutionMetricsRequestValidationError{
+			field:  "IssueType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFromDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIssueResolutionMetricsRequestValidationError{
+					field:  "FromDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIssueResolutionMetricsRequestValidationError{
+					field:  "FromDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFromDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIssueResolutionMetricsRequestValidationError{
+				field:  "FromDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetToDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIssueResolutionMetricsRequestValidationError{
+					field:  "ToDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIssueResolutionMetricsRequestValidationError{
+					field:  "ToDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIssueResolutionMetricsRequestValidationError{
+				field:  "ToDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetIssueResolutionMetricsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetIssueResolutionMetricsRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetIssueResolutionMetricsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIssueResolutionMetricsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetIssueResolutionMetricsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueResolutionMetricsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueResolutionMetricsRequestMultiError) AllErrors() []error { return m }
+
+// GetIssueResolutionMetricsRequestValidationError is the validation error
+// returned by GetIssueResolutionMetricsRequest.Validate if the designated
+// constraints aren't met.
+type GetIssueResolutionMetricsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueResolutionMetricsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueResolutionMetricsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueResolutionMetricsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueResolutionMetricsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueResolutionMetricsRequestValidationError) ErrorName() string {
+	return "GetIssueResolutionMetricsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueResolutionMetricsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueResolutionMetricsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueResolutionMetricsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueResolutionMetricsRequestValidationError{}
+
+// Validate checks the field values on GetIssueResolutionMetricsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIssueResolutionMetricsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueResolutionMetricsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetIssueResolutionMetricsResponseMultiError, or nil if none found.
+func (m *GetIssueResolutionMetricsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueResolutionMetricsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for P50Hours
+
+	// no validation rules for P90Hours
+
+	// no validation rules for P99Hours
+
+	// no validation rules for TotalResolved
+
+	// no validation rules for SloBreachCount
+
+	// no validation rules for SloHours
+
+	if len(errors) > 0 {
+		return GetIssueResolutionMetricsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueResolutionMetricsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIssueResolutionMetricsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetIssueResolutionMetricsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueResolutionMetricsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueResolutionMetricsResponseMultiError) AllErrors() []error { return m }
+
+// GetIssueResolutionMetricsResponseValidationError is the validation error
+// returned by GetIssueResolutionMetricsResponse.Validate if the designated
+// constraints aren't met.
+type GetIssueResolutionMetricsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueResolutionMetricsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueResolutionMetricsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueResolutionMetricsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueResolutionMetricsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueResolutionMetricsResponseValidationError) ErrorName() string {
+	return "GetIssueResolutionMetricsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueResolutionMetricsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueResolutionMetricsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueResolutionMetricsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueResolutionMetricsResponseValidationError{}
+
+// Validate checks the field values on GenerateProjectDigestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateProjectDigestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateProjectDigestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateProjectDigestRequestMultiError, or nil if none found.
+func (m *GenerateProjectDigestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateProjectDigestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = GenerateProjectDigestRequestValidationError{
+			field:  "ProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateProjectDigestRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateProjectDigestRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateProjectDigestRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateProjectDigestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GenerateProjectDigestRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GenerateProjectDigestRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateProjectDigestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateProjectDigestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateProjectDigestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateProjectDigestRequestMultiError) AllErrors() []error { return m }
+
+// GenerateProjectDigestRequestValidationError is the validation error returned
+// by GenerateProjectDigestRequest.Validate if the designated constraints
+// aren't met.
+type GenerateProjectDigestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateProjectDigestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateProjectDigestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateProjectDigestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateProjectDigestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateProjectDigestRequestValidationError) ErrorName() string {
+	return "GenerateProjectDigestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateProjectDigestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateProjectDigestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateProjectDigestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateProjectDigestRequestValidationError{}
+
+// Validate checks the field values on ProjectDigest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProjectDigest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectDigest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProjectDigestMultiError, or
+// nil if none found.
+func (m *ProjectDigest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectDigest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	if all {
+		switch v := interface{}(m.GetWindowStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectDigestValidationError{
+					field:  "WindowStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectDigestValidationError{
+					field:  "WindowStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindowStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectDigestValidationError{
+				field:  "WindowStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWindowEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectDigestValidationError{
+					field:  "WindowEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectDigestValidationError{
+					field:  "WindowEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindowEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectDigestValidationError{
+				field:  "WindowEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedCount
+
+	// no validation rules for ResolvedCount
+
+	// no validation rules for ClosedCount
+
+	// no validation rules for OverdueCount
+
+	// no validation rules for OverdueDays
+
+	if len(errors) > 0 {
+		return ProjectDigestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectDigestMultiError is an error wrapping multiple validation errors
+// returned by ProjectDigest.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectDigestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectDigestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectDigestMultiError) AllErrors() []error { return m }
+
+// ProjectDigestValidationError is the validation error returned by
+// ProjectDigest.Validate if the designated constraints aren't met.
+type ProjectDigestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectDigestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectDigestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectDigestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectDigestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectDigestValidationError) ErrorName() string { return "ProjectDigestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectDigestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectDigest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectDigestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectDigestValidationError{}
+
+// Validate checks the field values on GenerateProjectDigestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateProjectDigestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateProjectDigestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateProjectDigestResponseMultiError, or nil if none found.
+func (m *GenerateProjectDigestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateProjectDigestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDigest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateProjectDigestResponseValidationError{
+					field:  "Digest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateProjectDigestResponseValidationError{
+					field:  "Digest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDigest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateProjectDigestResponseValidationError{
+				field:  "Digest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateProjectDigestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateProjectDigestResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateProjectDigestResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateProjectDigestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateProjectDigestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateProjectDigestResponseMultiError) AllErrors() []error { return m }
+
+// GenerateProjectDigestResponseValidationError is the validation error
+// returned by GenerateProjectDigestResponse.Validate if the designated
+// constraints aren't met.
+type GenerateProjectDigestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateProjectDigestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateProjectDigestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateProjectDigestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateProjectDigestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateProjectDigestResponseValidationError) ErrorName() string {
+	return "GenerateProjectDigestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateProjectDigestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateProjectDigestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateProjectDigestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateProjectDigestResponseValidationError{}
+
+// Validate checks the field values on GetProjectProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectProgressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectProgressRequestMultiError, or nil if none found.
+func (m *GetProjectProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = GetProjectProgressRequestValidationError{
+			field:  "ProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProjectProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetProjectProgressRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetProjectProgressRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProjectProgressRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetProjectProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectProgressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectProgressRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectProgressRequestValidationError is the validation error returned by
+// GetProjectProgressRequest.Validate if the designated constraints aren't met.
+type GetProjectProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectProgressRequestValidationError) ErrorName() string {
+	return "GetProjectProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectProgressRequestValidationError{}
+
+// Validate checks the field values on GetProjectProgressResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectProgressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectProgressResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectProgressResponseMultiError, or nil if none found.
+func (m *GetProjectProgressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectProgressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalIssues
+
+	// no validation rules for ClosedIssues
+
+	// no validation rules for ResolvedIssues
+
+	// no validation rules for CompletionPct
+
+	// no validation rules for IssuesByStatus
+
+	if len(errors) > 0 {
+		return GetProjectProgressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectProgressResponseMultiError is an error wrapping multiple
+// validation errors returned by GetProjectProgressResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetProjectProgressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectProgressResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectProgressResponseMultiError) AllErrors() []error { return m }
+
+// GetProjectProgressResponseValidationError is the validation error returned
+// by GetProjectProgressResponse.Validate if the designated constraints aren't met.
+type GetProjectProgressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectProgressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectProgressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectProgressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectProgressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectProgressResponseValidationError) ErrorName() string {
+	return "GetProjectProgressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectProgressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectProgressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectProgressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectProgressResponseValidationError{}
+
+// Validate checks the field values on IssueFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueFilterMultiError, or
+// nil if none found.
+func (m *IssueFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTypes() {
+		_, _ = idx, item
+
+		if _, ok := Type_name[int32(item)]; !ok {
+			err := IssueFilterValidationError{
+				field:  fmt.Sprintf("Types[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetPriorities() {
+		_, _ = idx, item
+
+		if _, ok := Priority_name[int32(item)]; !ok {
+			err := IssueFilterValidationError{
+				field:  fmt.Sprintf("Priorities[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetAssigneeId() != "" {
+
+		if err := m._validateUuid(m.GetAssigneeId()); err != nil {
+			err = IssueFilterValidationError{
+				field:  "AssigneeId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IssueFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *IssueFilter) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// IssueFilterMultiError is an error wrapping multiple validation errors
+// returned by IssueFilter.ValidateAll() if the designated constraints aren't met.
+type IssueFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueFilterMultiError) AllErrors() []error { return m }
+
+// IssueFilterValidationError is the validation error returned by
+// IssueFilter.Validate if the designated constraints aren't met.
+type IssueFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueFilterValidationError) ErrorName() string { return "IssueFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueFilterValidationError{}
+
+// Validate checks the field values on BulkUpdateIssueStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkUpdateIssueStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkUpdateIssueStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkUpdateIssueStatusRequestMultiError, or nil if none found.
+func (m *BulkUpdateIssueStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkUpdateIssueStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = BulkUpdateIssueStatusRequestValidationError{
+			field:  "ProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _BulkUpdateIssueStatusRequest_FromStatus_NotInLookup[m.GetFromStatus()]; ok {
+		err := BulkUpdateIssueStatusRequestValidationError{
+			field:  "FromStatus",
+			reason: "value must not be in list [STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Status_name[int32(m.GetFromStatus())]; !ok {
+		err := BulkUpdateIssueStatusRequestValidationError{
+			field:  "FromStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _BulkUpdateIssueStatusRequest_ToStatus_NotInLookup[m.GetToStatus()]; ok {
+		err := BulkUpdateIssueStatusRequestValidationError{
+			field:  "ToStatus",
+			reason: "value must not be in list [STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Status_name[int32(m.GetToStatus())]; !ok {
+		err := BulkUpdateIssueStatusRequestValidationError{
+			field:  "ToStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Resolution_name[int32(m.GetResolution())]; !ok {
+		err := BulkUpdateIssueStatusRequestValidationError{
+			field:  "Resolution",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResolutionComment()) > 500 {
+		err := BulkUpdateIssueStatusRequestValidationError{
+			field:  "ResolutionComment",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkUpdateIssueStatusRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkUpdateIssueStatusRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkUpdateIssueStatusRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkUpdateIssueStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BulkUpdateIssueStatusRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BulkUpdateIssueStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkUpdateIssueStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BulkUpdateIssueStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkUpdateIssueStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkUpdateIssueStatusRequestMultiError) AllErrors() []error { return m }
+
+// BulkUpdateIssueStatusRequestValidationError is the validation error returned
+// by BulkUpdateIssueStatusRequest.Validate if the designated constraints
+// aren't met.
+type BulkUpdateIssueStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkUpdateIssueStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkUpdateIssueStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkUpdateIssueStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkUpdateIssueStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkUpdateIssueStatusRequestValidationError) ErrorName() string {
+	return "BulkUpdateIssueStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkUpdateIssueStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkUpdateIssueStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkUpdateIssueStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkUpdateIssueStatusRequestValidationError{}
+
+var _BulkUpdateIssueStatusRequest_FromStatus_NotInLookup = map[Status]struct{}{
+	0: {},
+}
+
+var _BulkUpdateIssueStatusRequest_ToStatus_NotInLookup = map[Status]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on BulkUpdateIssueStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkUpdateIssueStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkUpdateIssueStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkUpdateIssueStatusResponseMultiError, or nil if none found.
+func (m *BulkUpdateIssueStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkUpdateIssueStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpdatedCount
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BulkUpdateIssueStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkUpdateIssueStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkUpdateIssueStatusResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BulkUpdateIssueStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkUpdateIssueStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkUpdateIssueStatusResponseMultiError) AllErrors() []error { return m }
+
+// BulkUpdateIssueStatusResponseValidationError is the validation error
+// returned by BulkUpdateIssueStatusResponse.Validate if the designated
+// constraints aren't met.
+type BulkUpdateIssueStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkUpdateIssueStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkUpdateIssueStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkUpdateIssueStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkUpdateIssueStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkUpdateIssueStatusResponseValidationError) ErrorName() string {
+	return "BulkUpdateIssueStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkUpdateIssueStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkUpdateIssueStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkUpdateIssueStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkUpdateIssueStatusResponseValidationError{}
+
+// Validate checks the field values on ValidateIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateIssueRequestMultiError, or nil if none found.
+func (m *ValidateIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := Status_name[int32(m.GetCurrentStatus())]; !ok {
+		err := ValidateIssueRequestValidationError{
+			field:  "CurrentStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofPayloadPresent := false
+	switch v := m.Payload.(type) {
+	case *ValidateIssueRequest_Create:
+		if v == nil {
+			err := ValidateIssueRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+
+		// skipping validation for create
+
+	case *ValidateIssueRequest_Update:
+		if v == nil {
+			err := ValidateIssueRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+
+		// skipping validation for update
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofPayloadPresent {
+		err := ValidateIssueRequestValidationError{
+			field:  "Payload",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ValidateIssueRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateIssueResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateIssueResponse.ValidateAll() if the designated
+// ValidateIssueRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateIssueRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateIssueResponseMultiError []error
+type ValidateIssueRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateIssueResponseMultiError) Error() string {
+func (m ValidateIssueRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1215,11 +6584,11 @@ func (m UpdateIssueResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateIssueResponseMultiError) AllErrors() []error { return m }
+func (m ValidateIssueRequestMultiError) AllErrors() []error { return m }
 
-// UpdateIssueResponseValidationError is the validation error returned by
-// UpdateIssueResponse.Validate if the designated constraints aren't met.
-type UpdateIssueResponseValidationError struct {
+// ValidateIssueRequestValidationError is the validation error returned by
+// ValidateIssueRequest.Validate if the designated constraints aren't met.
+type ValidateIssueRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1227,24 +6596,24 @@ type UpdateIssueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateIssueResponseValidationError) Field() string { return e.field }
+func (e ValidateIssueRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateIssueResponseValidationError) Reason() string { return e.reason }
+func (e ValidateIssueRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateIssueResponseValidationError) Cause() error { return e.cause }
+func (e ValidateIssueRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateIssueResponseValidationError) Key() bool { return e.key }
+func (e ValidateIssueRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateIssueResponseValidationError) ErrorName() string {
-	return "UpdateIssueResponseValidationError"
+func (e ValidateIssueRequestValidationError) ErrorName() string {
+	return "ValidateIssueRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateIssueResponseValidationError) Error() string {
+func (e ValidateIssueRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1256,14 +6625,14 @@ func (e UpdateIssueResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateIssueResponse.%s: %s%s",
+		"invalid %sValidateIssueRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateIssueResponseValidationError{}
+var _ error = ValidateIssueRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1271,64 +6640,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateIssueResponseValidationError{}
+} = ValidateIssueRequestValidationError{}
 
-// Validate checks the field values on DeleteIssueRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteIssueRequest) Validate() error {
+// Validate checks the field values on ValidationProblem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ValidationProblem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteIssueRequest with the rules
+// ValidateAll checks the field values on ValidationProblem with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteIssueRequestMultiError, or nil if none found.
-func (m *DeleteIssueRequest) ValidateAll() error {
+// ValidationProblemMultiError, or nil if none found.
+func (m *ValidationProblem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteIssueRequest) validate(all bool) error {
+func (m *ValidationProblem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetIssueId()); err != nil {
-		err = DeleteIssueRequestValidationError{
-			field:  "IssueId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Field
 
-	if len(errors) > 0 {
-		return DeleteIssueRequestMultiError(errors)
-	}
+	// no validation rules for Message
 
-	return nil
-}
+	// no validation rules for Rule
 
-func (m *DeleteIssueRequest) _validateUuid(uuid string) error {
-	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+	if len(errors) > 0 {
+		return ValidationProblemMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteIssueRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteIssueRequest.ValidateAll() if the designated constraints
+// ValidationProblemMultiError is an error wrapping multiple validation errors
+// returned by ValidationProblem.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteIssueRequestMultiError []error
+type ValidationProblemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteIssueRequestMultiError) Error() string {
+func (m ValidationProblemMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1337,11 +6692,11 @@ func (m DeleteIssueRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteIssueRequestMultiError) AllErrors() []error { return m }
+func (m ValidationProblemMultiError) AllErrors() []error { return m }
 
-// DeleteIssueRequestValidationError is the validation error returned by
-// DeleteIssueRequest.Validate if the designated constraints aren't met.
-type DeleteIssueRequestValidationError struct {
+// ValidationProblemValidationError is the validation error returned by
+// ValidationProblem.Validate if the designated constraints aren't met.
+type ValidationProblemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1349,24 +6704,24 @@ type DeleteIssueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteIssueRequestValidationError) Field() string { return e.field }
+func (e ValidationProblemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteIssueRequestValidationError) Reason() string { return e.reason }
+func (e ValidationProblemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteIssueRequestValidationError) Cause() error { return e.cause }
+func (e ValidationProblemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteIssueRequestValidationError) Key() bool { return e.key }
+func (e ValidationProblemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteIssueRequestValidationError) ErrorName() string {
-	return "DeleteIssueRequestValidationError"
+func (e ValidationProblemValidationError) ErrorName() string {
+	return "ValidationProblemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteIssueRequestValidationError) Error() string {
+func (e ValidationProblemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1378,14 +6733,14 @@ func (e DeleteIssueRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteIssueRequest.%s: %s%s",
+		"invalid %sValidationProblem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteIssueRequestValidationError{}
+var _ error = ValidationProblemValidationError{}
 
 var _ interface {
 	Field() string
@@ -1393,75 +6748,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteIssueRequestValidationError{}
+} = ValidationProblemValidationError{}
 
-// Validate checks the field values on DeleteIssueResponse with the rules
+// Validate checks the field values on ValidateIssueResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteIssueResponse) Validate() error {
+func (m *ValidateIssueResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteIssueResponse with the rules
+// ValidateAll checks the field values on ValidateIssueResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteIssueResponseMultiError, or nil if none found.
-func (m *DeleteIssueResponse) ValidateAll() error {
+// ValidateIssueResponseMultiError, or nil if none found.
+func (m *ValidateIssueResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteIssueResponse) validate(all bool) error {
+func (m *ValidateIssueResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Valid
 
-	if all {
-		switch v := interface{}(m.GetIssue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeleteIssueResponseValidationError{
-					field:  "Issue",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateIssueResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateIssueResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeleteIssueResponseValidationError{
-					field:  "Issue",
+				return ValidateIssueResponseValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DeleteIssueResponseValidationError{
-				field:  "Issue",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return DeleteIssueResponseMultiError(errors)
+		return ValidateIssueResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteIssueResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteIssueResponse.ValidateAll() if the designated
+// ValidateIssueResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidateIssueResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteIssueResponseMultiError []error
+type ValidateIssueResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteIssueResponseMultiError) Error() string {
+func (m ValidateIssueResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1470,11 +6830,11 @@ func (m DeleteIssueResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteIssueResponseMultiError) AllErrors() []error { return m }
+func (m ValidateIssueResponseMultiError) AllErrors() []error { return m }
 
-// DeleteIssueResponseValidationError is the validation error returned by
-// DeleteIssueResponse.Validate if the designated constraints aren't met.
-type DeleteIssueResponseValidationError struct {
+// ValidateIssueResponseValidationError is the validation error returned by
+// ValidateIssueResponse.Validate if the designated constraints aren't met.
+type ValidateIssueResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1482,24 +6842,24 @@ type DeleteIssueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteIssueResponseValidationError) Field() string { return e.field }
+func (e ValidateIssueResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteIssueResponseValidationError) Reason() string { return e.reason }
+func (e ValidateIssueResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteIssueResponseValidationError) Cause() error { return e.cause }
+func (e ValidateIssueResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteIssueResponseValidationError) Key() bool { return e.key }
+func (e ValidateIssueResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteIssueResponseValidationError) ErrorName() string {
-	return "DeleteIssueResponseValidationError"
+func (e ValidateIssueResponseValidationError) ErrorName() string {
+	return "ValidateIssueResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteIssueResponseValidationError) Error() string {
+func (e ValidateIssueResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1511,14 +6871,14 @@ func (e DeleteIssueResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteIssueResponse.%s: %s%s",
+		"invalid %sValidateIssueResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteIssueResponseValidationError{}
+var _ error = ValidateIssueResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1526,34 +6886,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteIssueResponseValidationError{}
+} = ValidateIssueResponseValidationError{}
 
-// Validate checks the field values on ListIssuesRequest with the rules defined
+// Validate checks the field values on WatchIssueRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListIssuesRequest) Validate() error {
+func (m *WatchIssueRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListIssuesRequest with the rules
+// ValidateAll checks the field values on WatchIssueRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListIssuesRequestMultiError, or nil if none found.
-func (m *ListIssuesRequest) ValidateAll() error {
+// WatchIssueRequestMultiError, or nil if none found.
+func (m *WatchIssueRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListIssuesRequest) validate(all bool) error {
+func (m *WatchIssueRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if val := m.GetPageSize(); val < 1 || val > 1000 {
-		err := ListIssuesRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be inside range [1, 1000]",
+	if err := m._validateUuid(m.GetIssueId()); err != nil {
+		err = WatchIssueRequestValidationError{
+			field:  "IssueId",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
 		if !all {
 			return err
@@ -1561,22 +6922,28 @@ func (m *ListIssuesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListIssuesRequestMultiError(errors)
+		return WatchIssueRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListIssuesRequestMultiError is an error wrapping multiple validation errors
-// returned by ListIssuesRequest.ValidateAll() if the designated constraints
+func (m *WatchIssueRequest) _validateUuid(uuid string) error {
+	if matched := _issues_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchIssueRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListIssuesRequestMultiError []error
+type WatchIssueRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListIssuesRequestMultiError) Error() string {
+func (m WatchIssueRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1585,11 +6952,11 @@ func (m ListIssuesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListIssuesRequestMultiError) AllErrors() []error { return m }
+func (m WatchIssueRequestMultiError) AllErrors() []error { return m }
 
-// ListIssuesRequestValidationError is the validation error returned by
-// ListIssuesRequest.Validate if the designated constraints aren't met.
-type ListIssuesRequestValidationError struct {
+// WatchIssueRequestValidationError is the validation error returned by
+// WatchIssueRequest.Validate if the designated constraints aren't met.
+type WatchIssueRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1597,24 +6964,24 @@ type ListIssuesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListIssuesRequestValidationError) Field() string { return e.field }
+func (e WatchIssueRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListIssuesRequestValidationError) Reason() string { return e.reason }
+func (e WatchIssueRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListIssuesRequestValidationError) Cause() error { return e.cause }
+func (e WatchIssueRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListIssuesRequestValidationError) Key() bool { return e.key }
+func (e WatchIssueRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListIssuesRequestValidationError) ErrorName() string {
-	return "ListIssuesRequestValidationError"
+func (e WatchIssueRequestValidationError) ErrorName() string {
+	return "WatchIssueRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListIssuesRequestValidationError) Error() string {
+func (e WatchIssueRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1626,14 +6993,14 @@ func (e ListIssuesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListIssuesRequest.%s: %s%s",
+		"invalid %sWatchIssueRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListIssuesRequestValidationError{}
+var _ error = WatchIssueRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1641,80 +7008,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListIssuesRequestValidationError{}
+} = WatchIssueRequestValidationError{}
 
-// Validate checks the field values on ListIssuesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListIssuesResponse) Validate() error {
+// Validate checks the field values on IssueEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListIssuesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListIssuesResponseMultiError, or nil if none found.
-func (m *ListIssuesResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueEventMultiError, or
+// nil if none found.
+func (m *IssueEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListIssuesResponse) validate(all bool) error {
+func (m *IssueEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetIssues() {
-		_, _ = idx, item
+	// no validation rules for EventType
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListIssuesResponseValidationError{
-						field:  fmt.Sprintf("Issues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListIssuesResponseValidationError{
-						field:  fmt.Sprintf("Issues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueEventValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListIssuesResponseValidationError{
-					field:  fmt.Sprintf("Issues[%v]", idx),
+				errors = append(errors, IssueEventValidationError{
+					field:  "Issue",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueEventValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListIssuesResponseMultiError(errors)
+		return IssueEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListIssuesResponseMultiError is an error wrapping multiple validation errors
-// returned by ListIssuesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListIssuesResponseMultiError []error
+// IssueEventMultiError is an error wrapping multiple validation errors
+// returned by IssueEvent.ValidateAll() if the designated constraints aren't met.
+type IssueEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListIssuesResponseMultiError) Error() string {
+func (m IssueEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1723,11 +7084,11 @@ func (m ListIssuesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListIssuesResponseMultiError) AllErrors() []error { return m }
+func (m IssueEventMultiError) AllErrors() []error { return m }
 
-// ListIssuesResponseValidationError is the validation error returned by
-// ListIssuesResponse.Validate if the designated constraints aren't met.
-type ListIssuesResponseValidationError struct {
+// IssueEventValidationError is the validation error returned by
+// IssueEvent.Validate if the designated constraints aren't met.
+type IssueEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1735,24 +7096,22 @@ type ListIssuesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListIssuesResponseValidationError) Field() string { return e.field }
+func (e IssueEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListIssuesResponseValidationError) Reason() string { return e.reason }
+func (e IssueEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListIssuesResponseValidationError) Cause() error { return e.cause }
+func (e IssueEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListIssuesResponseValidationError) Key() bool { return e.key }
+func (e IssueEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListIssuesResponseValidationError) ErrorName() string {
-	return "ListIssuesResponseValidationError"
-}
+func (e IssueEventValidationError) ErrorName() string { return "IssueEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListIssuesResponseValidationError) Error() string {
+func (e IssueEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1764,14 +7123,14 @@ func (e ListIssuesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListIssuesResponse.%s: %s%s",
+		"invalid %sIssueEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListIssuesResponseValidationError{}
+var _ error = IssueEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1779,7 +7138,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListIssuesResponseValidationError{}
+} = IssueEventValidationError{}
 
 // Validate checks the field values on ProjectInfo with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -0,0 +1,189 @@
+package issuesv1_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+const validUUID = "928f705f-0efa-4c96-b2f6-ceb36281e1f1"
+
+// uuidSeeds are valid and nearly valid UUIDs: unusual unicode, null bytes, wrong lengths and
+// very long strings
+var uuidSeeds = []string{
+	validUUID,
+	strings.ToUpper(validUUID),
+	"",
+	"928f705f0efa4c96b2f6ceb36281e1f1",
+	"{928f705f-0efa-4c96-b2f6-ceb36281e1f1}",
+	"928f705f-0efa-4c96-b2f6-ceb36281e1f",
+	"928f705f-0efa-4c96-b2f6-ceb36281e1f1\x00",
+	"\x00928f705f-0efa-4c96-b2f6-ceb36281e1f",
+	"928f705f-0efa-4c96-b2f6-ceb36281e1f1\n",
+	"９２８f705f-0efa-4c96-b2f6-ceb36281e1f1",
+	"928f705f–0efa-4c96-b2f6-ceb36281e1f1",
+	"928f705f-0efa-4c96-b2f6-ceb36281e1́f1",
+	"\xff\xfe\xfd",
+	strings.Repeat("a", 1<<16),
+}
+
+// checkValidation calls both validators and checks they agree. A panic fails the fuzz target
+// on its own, so this only has to catch an error from one validator that the other misses.
+func checkValidation(t *testing.T, req interface {
+	Validate() error
+	ValidateAll() error
+}) error {
+	t.Helper()
+	err := req.Validate()
+	errAll := req.ValidateAll()
+	if (err == nil) != (errAll == nil) {
+		t.Fatalf("Validate returned %v but ValidateAll returned %v", err, errAll)
+	}
+	return err
+}
+
+// checkUUID fails if a UUID field that passed validation can't be parsed as one
+func checkUUID(t *testing.T, field, value string) {
+	t.Helper()
+	if _, err := uuid.Parse(value); err != nil || len(value) != 36 {
+		t.Fatalf("%s %q passed validation but is not a UUID", field, value)
+	}
+}
+
+// checkSummary fails if a summary that passed validation is outside 1 to 100 runes
+func checkSummary(t *testing.T, summary string) {
+	t.Helper()
+	if n := utf8.RuneCountInString(summary); n < 1 || n > 100 {
+		t.Fatalf("summary of %d runes passed validation", n)
+	}
+}
+
+func FuzzCreateIssueRequestValidate(f *testing.F) {
+	f.Add("Login fails", "Steps to reproduce", true, int32(issuesPbv1.Type_BUG), int32(issuesPbv1.Priority_MAJOR), validUUID, validUUID, true, "")
+	f.Add("x", "", false, int32(0), int32(0), validUUID, "", false, validUUID)
+	f.Add(strings.Repeat("a", 100), "d", true, int32(issuesPbv1.Type_FEATURE), int32(issuesPbv1.Priority_CRITICAL), validUUID, "", false, "")
+	f.Add(strings.Repeat("é", 101), "", true, int32(-1), int32(99), validUUID, validUUID, true, "not-a-uuid")
+	f.Add("", "", false, int32(0), int32(0), "", "", true, "")
+	f.Add("summary\x00", "\x00", true, int32(1<<30), int32(-1<<31), "\x00", "\x00", true, "\x00")
+	for _, id := range uuidSeeds {
+		f.Add("summary", "description", true, int32(0), int32(0), id, id, true, id)
+	}
+
+	f.Fuzz(func(t *testing.T, summary, description string, hasDescription bool, typ, priority int32, projectID, assigneeID string, hasAssignee bool, templateID string) {
+		req := &issuesPbv1.CreateIssueRequest{
+			Summary:    summary,
+			Type:       issuesPbv1.Type(typ),
+			Priority:   issuesPbv1.Priority(priority),
+			ProjectId:  projectID,
+			TemplateId: templateID,
+		}
+		if hasDescription {
+			req.Description = &description
+		}
+		if hasAssignee {
+			req.AssigneeId = &assigneeID
+		}
+
+		if checkValidation(t, req) != nil {
+			return
+		}
+		checkSummary(t, summary)
+		checkUUID(t, "project_id", projectID)
+		if hasAssignee {
+			checkUUID(t, "assignee_id", assigneeID)
+		}
+		if templateID != "" {
+			checkUUID(t, "template_id", templateID)
+		}
+		if hasDescription && description == "" {
+			t.Fatal("empty description passed validation")
+		}
+		if _, ok := issuesPbv1.Type_name[typ]; !ok {
+			t.Fatalf("undefined type %d passed validation", typ)
+		}
+		if _, ok := issuesPbv1.Priority_name[priority]; !ok {
+			t.Fatalf("undefined priority %d passed validation", priority)
+		}
+	})
+}
+
+func FuzzGetIssueRequestValidate(f *testing.F) {
+	for _, id := range uuidSeeds {
+		f.Add(id, false)
+		f.Add(id, true)
+	}
+
+	f.Fuzz(func(t *testing.T, issueID string, includeDetails bool) {
+		req := &issuesPbv1.GetIssueRequest{IssueId: issueID, IncludeDetails: includeDetails}
+		if checkValidation(t, req) != nil {
+			return
+		}
+		checkUUID(t, "issue_id", issueID)
+	})
+}
+
+func FuzzUpdateIssueRequestValidate(f *testing.F) {
+	f.Add(validUUID, "Login fails", int32(issuesPbv1.Status_RESOLVED), int32(issuesPbv1.Resolution_FIXED), validUUID, true, "", "Fixed in 1.2", int64(1))
+	f.Add(validUUID, strings.Repeat("ü", 100), int32(0), int32(0), "", false, validUUID, strings.Repeat("c", 500), int64(1<<62))
+	f.Add(validUUID, strings.Repeat("a", 101), int32(-1), int32(99), "", false, "", strings.Repeat("c", 501), int64(0))
+	f.Add("", "", int32(0), int32(0), "", true, "", "", int64(-1))
+	for _, id := range uuidSeeds {
+		f.Add(id, "summary\x00", int32(1), int32(1), id, true, id, "\x00", int64(1))
+	}
+
+	f.Fuzz(func(t *testing.T, issueID, summary string, status, resolution int32, assigneeID string, hasAssignee bool, duplicateOf, resolutionComment string, version int64) {
+		req := &issuesPbv1.UpdateIssueRequest{
+			IssueId:           issueID,
+			Summary:           summary,
+			Status:            issuesPbv1.Status(status),
+			Resolution:        issuesPbv1.Resolution(resolution),
+			DuplicateOf:       &duplicateOf,
+			ResolutionComment: &resolutionComment,
+			Version:           version,
+		}
+		if hasAssignee {
+			req.AssigneeId = &assigneeID
+		}
+
+		if checkValidation(t, req) != nil {
+			return
+		}
+		checkUUID(t, "issue_id", issueID)
+		checkSummary(t, summary)
+		if hasAssignee {
+			checkUUID(t, "assignee_id", assigneeID)
+		}
+		if duplicateOf != "" {
+			checkUUID(t, "duplicate_of", duplicateOf)
+		}
+		if n := utf8.RuneCountInString(resolutionComment); n > 500 {
+			t.Fatalf("resolution comment of %d runes passed validation", n)
+		}
+		if version <= 0 {
+			t.Fatalf("version %d passed validation", version)
+		}
+	})
+}
+
+func FuzzListIssuesRequestValidate(f *testing.F) {
+	for _, pageSize := range []int32{-1 << 31, -1, 0, 1, 2, 999, 1000, 1001, 1<<31 - 1} {
+		f.Add(pageSize, "")
+	}
+	f.Add(int32(10), "not-base64\x00")
+	f.Add(int32(10), strings.Repeat("t", 1<<16))
+
+	f.Fuzz(func(t *testing.T, pageSize int32, pageToken string) {
+		req := &issuesPbv1.ListIssuesRequest{PageSize: pageSize, PageToken: pageToken}
+		if checkValidation(t, req) != nil {
+			return
+		}
+		// The largest page size depends on MAX_PAGE_SIZE, so the service checks it
+		if pageSize < 0 {
+			t.Fatalf("page size %d passed validation", pageSize)
+		}
+	})
+}
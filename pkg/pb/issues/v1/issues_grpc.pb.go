@@ -20,11 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IssuesService_CreateIssue_FullMethodName = "/issues.v1.IssuesService/CreateIssue"
-	IssuesService_GetIssue_FullMethodName    = "/issues.v1.IssuesService/GetIssue"
-	IssuesService_UpdateIssue_FullMethodName = "/issues.v1.IssuesService/UpdateIssue"
-	IssuesService_DeleteIssue_FullMethodName = "/issues.v1.IssuesService/DeleteIssue"
-	IssuesService_ListIssues_FullMethodName  = "/issues.v1.IssuesService/ListIssues"
+	IssuesService_CreateIssue_FullMethodName                = "/issues.v1.IssuesService/CreateIssue"
+	IssuesService_GetIssue_FullMethodName                   = "/issues.v1.IssuesService/GetIssue"
+	IssuesService_GetIssueByProjectAndNumber_FullMethodName = "/issues.v1.IssuesService/GetIssueByProjectAndNumber"
+	IssuesService_BatchGetIssues_FullMethodName             = "/issues.v1.IssuesService/BatchGetIssues"
+	IssuesService_UpdateIssue_FullMethodName                = "/issues.v1.IssuesService/UpdateIssue"
+	IssuesService_DeleteIssue_FullMethodName                = "/issues.v1.IssuesService/DeleteIssue"
+	IssuesService_ListIssues_FullMethodName                 = "/issues.v1.IssuesService/ListIssues"
+	IssuesService_AssignIssue_FullMethodName                = "/issues.v1.IssuesService/AssignIssue"
+	IssuesService_WatchIssue_FullMethodName                 = "/issues.v1.IssuesService/WatchIssue"
+	IssuesService_ChangeIssueStatus_FullMethodName          = "/issues.v1.IssuesService/ChangeIssueStatus"
+	IssuesService_ReopenIssue_FullMethodName                = "/issues.v1.IssuesService/ReopenIssue"
+	IssuesService_MoveIssue_FullMethodName                  = "/issues.v1.IssuesService/MoveIssue"
+	IssuesService_CloneIssue_FullMethodName                 = "/issues.v1.IssuesService/CloneIssue"
+	IssuesService_LinkIssues_FullMethodName                 = "/issues.v1.IssuesService/LinkIssues"
+	IssuesService_UnlinkIssues_FullMethodName               = "/issues.v1.IssuesService/UnlinkIssues"
+	IssuesService_ListIssueLinks_FullMethodName             = "/issues.v1.IssuesService/ListIssueLinks"
+	IssuesService_GetIssueResolutionMetrics_FullMethodName  = "/issues.v1.IssuesService/GetIssueResolutionMetrics"
+	IssuesService_GenerateProjectDigest_FullMethodName      = "/issues.v1.IssuesService/GenerateProjectDigest"
+	IssuesService_GetProjectProgress_FullMethodName         = "/issues.v1.IssuesService/GetProjectProgress"
+	IssuesService_BulkUpdateIssueStatus_FullMethodName      = "/issues.v1.IssuesService/BulkUpdateIssueStatus"
+	IssuesService_ValidateIssue_FullMethodName              = "/issues.v1.IssuesService/ValidateIssue"
 )
 
 // IssuesServiceClient is the client API for IssuesService service.
@@ -33,9 +49,25 @@ const (
 type IssuesServiceClient interface {
 	CreateIssue(ctx context.Context, in *CreateIssueRequest, opts ...grpc.CallOption) (*CreateIssueResponse, error)
 	GetIssue(ctx context.Context, in *GetIssueRequest, opts ...grpc.CallOption) (*GetIssueResponse, error)
+	GetIssueByProjectAndNumber(ctx context.Context, in *GetIssueByProjectAndNumberRequest, opts ...grpc.CallOption) (*GetIssueByProjectAndNumberResponse, error)
+	BatchGetIssues(ctx context.Context, in *BatchGetIssuesRequest, opts ...grpc.CallOption) (*BatchGetIssuesResponse, error)
 	UpdateIssue(ctx context.Context, in *UpdateIssueRequest, opts ...grpc.CallOption) (*UpdateIssueResponse, error)
 	DeleteIssue(ctx context.Context, in *DeleteIssueRequest, opts ...grpc.CallOption) (*DeleteIssueResponse, error)
 	ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error)
+	AssignIssue(ctx context.Context, in *AssignIssueRequest, opts ...grpc.CallOption) (*AssignIssueResponse, error)
+	WatchIssue(ctx context.Context, in *WatchIssueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssueEvent], error)
+	ChangeIssueStatus(ctx context.Context, in *ChangeIssueStatusRequest, opts ...grpc.CallOption) (*ChangeIssueStatusResponse, error)
+	ReopenIssue(ctx context.Context, in *ReopenIssueRequest, opts ...grpc.CallOption) (*ReopenIssueResponse, error)
+	MoveIssue(ctx context.Context, in *MoveIssueRequest, opts ...grpc.CallOption) (*MoveIssueResponse, error)
+	CloneIssue(ctx context.Context, in *CloneIssueRequest, opts ...grpc.CallOption) (*CloneIssueResponse, error)
+	LinkIssues(ctx context.Context, in *LinkIssuesRequest, opts ...grpc.CallOption) (*LinkIssuesResponse, error)
+	UnlinkIssues(ctx context.Context, in *UnlinkIssuesRequest, opts ...grpc.CallOption) (*UnlinkIssuesResponse, error)
+	ListIssueLinks(ctx context.Context, in *ListIssueLinksRequest, opts ...grpc.CallOption) (*ListIssueLinksResponse, error)
+	GetIssueResolutionMetrics(ctx context.Context, in *GetIssueResolutionMetricsRequest, opts ...grpc.CallOption) (*GetIssueResolutionMetricsResponse, error)
+	GenerateProjectDigest(ctx context.Context, in *GenerateProjectDigestRequest, opts ...grpc.CallOption) (*GenerateProjectDigestResponse, error)
+	GetProjectProgress(ctx context.Context, in *GetProjectProgressRequest, opts ...grpc.CallOption) (*GetProjectProgressResponse, error)
+	BulkUpdateIssueStatus(ctx context.Context, in *BulkUpdateIssueStatusRequest, opts ...grpc.CallOption) (*BulkUpdateIssueStatusResponse, error)
+	ValidateIssue(ctx context.Context, in *ValidateIssueRequest, opts ...grpc.CallOption) (*ValidateIssueResponse, error)
 }
 
 type issuesServiceClient struct {
@@ -66,6 +98,26 @@ func (c *issuesServiceClient) GetIssue(ctx context.Context, in *GetIssueRequest,
 	return out, nil
 }
 
+func (c *issuesServiceClient) GetIssueByProjectAndNumber(ctx context.Context, in *GetIssueByProjectAndNumberRequest, opts ...grpc.CallOption) (*GetIssueByProjectAndNumberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueByProjectAndNumberResponse)
+	err := c.cc.Invoke(ctx, IssuesService_GetIssueByProjectAndNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) BatchGetIssues(ctx context.Context, in *BatchGetIssuesRequest, opts ...grpc.CallOption) (*BatchGetIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetIssuesResponse)
+	err := c.cc.Invoke(ctx, IssuesService_BatchGetIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issuesServiceClient) UpdateIssue(ctx context.Context, in *UpdateIssueRequest, opts ...grpc.CallOption) (*UpdateIssueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateIssueResponse)
@@ -96,15 +148,180 @@ func (c *issuesServiceClient) ListIssues(ctx context.Context, in *ListIssuesRequ
 	return out, nil
 }
 
+func (c *issuesServiceClient) AssignIssue(ctx context.Context, in *AssignIssueRequest, opts ...grpc.CallOption) (*AssignIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignIssueResponse)
+	err := c.cc.Invoke(ctx, IssuesService_AssignIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) WatchIssue(ctx context.Context, in *WatchIssueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssueEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssuesService_ServiceDesc.Streams[0], IssuesService_WatchIssue_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchIssueRequest, IssueEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssuesService_WatchIssueClient = grpc.ServerStreamingClient[IssueEvent]
+
+func (c *issuesServiceClient) ChangeIssueStatus(ctx context.Context, in *ChangeIssueStatusRequest, opts ...grpc.CallOption) (*ChangeIssueStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeIssueStatusResponse)
+	err := c.cc.Invoke(ctx, IssuesService_ChangeIssueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) ReopenIssue(ctx context.Context, in *ReopenIssueRequest, opts ...grpc.CallOption) (*ReopenIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenIssueResponse)
+	err := c.cc.Invoke(ctx, IssuesService_ReopenIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) MoveIssue(ctx context.Context, in *MoveIssueRequest, opts ...grpc.CallOption) (*MoveIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveIssueResponse)
+	err := c.cc.Invoke(ctx, IssuesService_MoveIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) CloneIssue(ctx context.Context, in *CloneIssueRequest, opts ...grpc.CallOption) (*CloneIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneIssueResponse)
+	err := c.cc.Invoke(ctx, IssuesService_CloneIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) LinkIssues(ctx context.Context, in *LinkIssuesRequest, opts ...grpc.CallOption) (*LinkIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkIssuesResponse)
+	err := c.cc.Invoke(ctx, IssuesService_LinkIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) UnlinkIssues(ctx context.Context, in *UnlinkIssuesRequest, opts ...grpc.CallOption) (*UnlinkIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkIssuesResponse)
+	err := c.cc.Invoke(ctx, IssuesService_UnlinkIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) ListIssueLinks(ctx context.Context, in *ListIssueLinksRequest, opts ...grpc.CallOption) (*ListIssueLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueLinksResponse)
+	err := c.cc.Invoke(ctx, IssuesService_ListIssueLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) GetIssueResolutionMetrics(ctx context.Context, in *GetIssueResolutionMetricsRequest, opts ...grpc.CallOption) (*GetIssueResolutionMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueResolutionMetricsResponse)
+	err := c.cc.Invoke(ctx, IssuesService_GetIssueResolutionMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) GenerateProjectDigest(ctx context.Context, in *GenerateProjectDigestRequest, opts ...grpc.CallOption) (*GenerateProjectDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateProjectDigestResponse)
+	err := c.cc.Invoke(ctx, IssuesService_GenerateProjectDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) GetProjectProgress(ctx context.Context, in *GetProjectProgressRequest, opts ...grpc.CallOption) (*GetProjectProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectProgressResponse)
+	err := c.cc.Invoke(ctx, IssuesService_GetProjectProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) BulkUpdateIssueStatus(ctx context.Context, in *BulkUpdateIssueStatusRequest, opts ...grpc.CallOption) (*BulkUpdateIssueStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateIssueStatusResponse)
+	err := c.cc.Invoke(ctx, IssuesService_BulkUpdateIssueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesServiceClient) ValidateIssue(ctx context.Context, in *ValidateIssueRequest, opts ...grpc.CallOption) (*ValidateIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateIssueResponse)
+	err := c.cc.Invoke(ctx, IssuesService_ValidateIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssuesServiceServer is the server API for IssuesService service.
 // All implementations must embed UnimplementedIssuesServiceServer
 // for forward compatibility.
 type IssuesServiceServer interface {
 	CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error)
 	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
+	GetIssueByProjectAndNumber(context.Context, *GetIssueByProjectAndNumberRequest) (*GetIssueByProjectAndNumberResponse, error)
+	BatchGetIssues(context.Context, *BatchGetIssuesRequest) (*BatchGetIssuesResponse, error)
 	UpdateIssue(context.Context, *UpdateIssueRequest) (*UpdateIssueResponse, error)
 	DeleteIssue(context.Context, *DeleteIssueRequest) (*DeleteIssueResponse, error)
 	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
+	AssignIssue(context.Context, *AssignIssueRequest) (*AssignIssueResponse, error)
+	WatchIssue(*WatchIssueRequest, grpc.ServerStreamingServer[IssueEvent]) error
+	ChangeIssueStatus(context.Context, *ChangeIssueStatusRequest) (*ChangeIssueStatusResponse, error)
+	ReopenIssue(context.Context, *ReopenIssueRequest) (*ReopenIssueResponse, error)
+	MoveIssue(context.Context, *MoveIssueRequest) (*MoveIssueResponse, error)
+	CloneIssue(context.Context, *CloneIssueRequest) (*CloneIssueResponse, error)
+	LinkIssues(context.Context, *LinkIssuesRequest) (*LinkIssuesResponse, error)
+	UnlinkIssues(context.Context, *UnlinkIssuesRequest) (*UnlinkIssuesResponse, error)
+	ListIssueLinks(context.Context, *ListIssueLinksRequest) (*ListIssueLinksResponse, error)
+	GetIssueResolutionMetrics(context.Context, *GetIssueResolutionMetricsRequest) (*GetIssueResolutionMetricsResponse, error)
+	GenerateProjectDigest(context.Context, *GenerateProjectDigestRequest) (*GenerateProjectDigestResponse, error)
+	GetProjectProgress(context.Context, *GetProjectProgressRequest) (*GetProjectProgressResponse, error)
+	BulkUpdateIssueStatus(context.Context, *BulkUpdateIssueStatusRequest) (*BulkUpdateIssueStatusResponse, error)
+	ValidateIssue(context.Context, *ValidateIssueRequest) (*ValidateIssueResponse, error)
 	mustEmbedUnimplementedIssuesServiceServer()
 }
 
@@ -121,6 +338,12 @@ func (UnimplementedIssuesServiceServer) CreateIssue(context.Context, *CreateIssu
 func (UnimplementedIssuesServiceServer) GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIssue not implemented")
 }
+func (UnimplementedIssuesServiceServer) GetIssueByProjectAndNumber(context.Context, *GetIssueByProjectAndNumberRequest) (*GetIssueByProjectAndNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueByProjectAndNumber not implemented")
+}
+func (UnimplementedIssuesServiceServer) BatchGetIssues(context.Context, *BatchGetIssuesRequest) (*BatchGetIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetIssues not implemented")
+}
 func (UnimplementedIssuesServiceServer) UpdateIssue(context.Context, *UpdateIssueRequest) (*UpdateIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssue not implemented")
 }
@@ -130,6 +353,48 @@ func (UnimplementedIssuesServiceServer) DeleteIssue(context.Context, *DeleteIssu
 func (UnimplementedIssuesServiceServer) ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIssues not implemented")
 }
+func (UnimplementedIssuesServiceServer) AssignIssue(context.Context, *AssignIssueRequest) (*AssignIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignIssue not implemented")
+}
+func (UnimplementedIssuesServiceServer) WatchIssue(*WatchIssueRequest, grpc.ServerStreamingServer[IssueEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchIssue not implemented")
+}
+func (UnimplementedIssuesServiceServer) ChangeIssueStatus(context.Context, *ChangeIssueStatusRequest) (*ChangeIssueStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeIssueStatus not implemented")
+}
+func (UnimplementedIssuesServiceServer) ReopenIssue(context.Context, *ReopenIssueRequest) (*ReopenIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenIssue not implemented")
+}
+func (UnimplementedIssuesServiceServer) MoveIssue(context.Context, *MoveIssueRequest) (*MoveIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveIssue not implemented")
+}
+func (UnimplementedIssuesServiceServer) CloneIssue(context.Context, *CloneIssueRequest) (*CloneIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneIssue not implemented")
+}
+func (UnimplementedIssuesServiceServer) LinkIssues(context.Context, *LinkIssuesRequest) (*LinkIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIssues not implemented")
+}
+func (UnimplementedIssuesServiceServer) UnlinkIssues(context.Context, *UnlinkIssuesRequest) (*UnlinkIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIssues not implemented")
+}
+func (UnimplementedIssuesServiceServer) ListIssueLinks(context.Context, *ListIssueLinksRequest) (*ListIssueLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueLinks not implemented")
+}
+func (UnimplementedIssuesServiceServer) GetIssueResolutionMetrics(context.Context, *GetIssueResolutionMetricsRequest) (*GetIssueResolutionMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueResolutionMetrics not implemented")
+}
+func (UnimplementedIssuesServiceServer) GenerateProjectDigest(context.Context, *GenerateProjectDigestRequest) (*GenerateProjectDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateProjectDigest not implemented")
+}
+func (UnimplementedIssuesServiceServer) GetProjectProgress(context.Context, *GetProjectProgressRequest) (*GetProjectProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectProgress not implemented")
+}
+func (UnimplementedIssuesServiceServer) BulkUpdateIssueStatus(context.Context, *BulkUpdateIssueStatusRequest) (*BulkUpdateIssueStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateIssueStatus not implemented")
+}
+func (UnimplementedIssuesServiceServer) ValidateIssue(context.Context, *ValidateIssueRequest) (*ValidateIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateIssue not implemented")
+}
 func (UnimplementedIssuesServiceServer) mustEmbedUnimplementedIssuesServiceServer() {}
 func (UnimplementedIssuesServiceServer) testEmbeddedByValue()                       {}
 
@@ -187,6 +452,42 @@ func _IssuesService_GetIssue_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssuesService_GetIssueByProjectAndNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueByProjectAndNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).GetIssueByProjectAndNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_GetIssueByProjectAndNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).GetIssueByProjectAndNumber(ctx, req.(*GetIssueByProjectAndNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_BatchGetIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).BatchGetIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_BatchGetIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).BatchGetIssues(ctx, req.(*BatchGetIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IssuesService_UpdateIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateIssueRequest)
 	if err := dec(in); err != nil {
@@ -241,6 +542,251 @@ func _IssuesService_ListIssues_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssuesService_AssignIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).AssignIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_AssignIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).AssignIssue(ctx, req.(*AssignIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_WatchIssue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchIssueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IssuesServiceServer).WatchIssue(m, &grpc.GenericServerStream[WatchIssueRequest, IssueEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssuesService_WatchIssueServer = grpc.ServerStreamingServer[IssueEvent]
+
+func _IssuesService_ChangeIssueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeIssueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).ChangeIssueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_ChangeIssueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).ChangeIssueStatus(ctx, req.(*ChangeIssueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_ReopenIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).ReopenIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_ReopenIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).ReopenIssue(ctx, req.(*ReopenIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_MoveIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).MoveIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_MoveIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).MoveIssue(ctx, req.(*MoveIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_CloneIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).CloneIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_CloneIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).CloneIssue(ctx, req.(*CloneIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_LinkIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).LinkIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_LinkIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).LinkIssues(ctx, req.(*LinkIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_UnlinkIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).UnlinkIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_UnlinkIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).UnlinkIssues(ctx, req.(*UnlinkIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_ListIssueLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).ListIssueLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_ListIssueLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).ListIssueLinks(ctx, req.(*ListIssueLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_GetIssueResolutionMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueResolutionMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).GetIssueResolutionMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_GetIssueResolutionMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).GetIssueResolutionMetrics(ctx, req.(*GetIssueResolutionMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_GenerateProjectDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateProjectDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).GenerateProjectDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_GenerateProjectDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).GenerateProjectDigest(ctx, req.(*GenerateProjectDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_GetProjectProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).GetProjectProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_GetProjectProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).GetProjectProgress(ctx, req.(*GetProjectProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_BulkUpdateIssueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateIssueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).BulkUpdateIssueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_BulkUpdateIssueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).BulkUpdateIssueStatus(ctx, req.(*BulkUpdateIssueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuesService_ValidateIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuesServiceServer).ValidateIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuesService_ValidateIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuesServiceServer).ValidateIssue(ctx, req.(*ValidateIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssuesService_ServiceDesc is the grpc.ServiceDesc for IssuesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,6 +802,14 @@ var IssuesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIssue",
 			Handler:    _IssuesService_GetIssue_Handler,
 		},
+		{
+			MethodName: "GetIssueByProjectAndNumber",
+			Handler:    _IssuesService_GetIssueByProjectAndNumber_Handler,
+		},
+		{
+			MethodName: "BatchGetIssues",
+			Handler:    _IssuesService_BatchGetIssues_Handler,
+		},
 		{
 			MethodName: "UpdateIssue",
 			Handler:    _IssuesService_UpdateIssue_Handler,
@@ -268,7 +822,65 @@ var IssuesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListIssues",
 			Handler:    _IssuesService_ListIssues_Handler,
 		},
+		{
+			MethodName: "AssignIssue",
+			Handler:    _IssuesService_AssignIssue_Handler,
+		},
+		{
+			MethodName: "ChangeIssueStatus",
+			Handler:    _IssuesService_ChangeIssueStatus_Handler,
+		},
+		{
+			MethodName: "ReopenIssue",
+			Handler:    _IssuesService_ReopenIssue_Handler,
+		},
+		{
+			MethodName: "MoveIssue",
+			Handler:    _IssuesService_MoveIssue_Handler,
+		},
+		{
+			MethodName: "CloneIssue",
+			Handler:    _IssuesService_CloneIssue_Handler,
+		},
+		{
+			MethodName: "LinkIssues",
+			Handler:    _IssuesService_LinkIssues_Handler,
+		},
+		{
+			MethodName: "UnlinkIssues",
+			Handler:    _IssuesService_UnlinkIssues_Handler,
+		},
+		{
+			MethodName: "ListIssueLinks",
+			Handler:    _IssuesService_ListIssueLinks_Handler,
+		},
+		{
+			MethodName: "GetIssueResolutionMetrics",
+			Handler:    _IssuesService_GetIssueResolutionMetrics_Handler,
+		},
+		{
+			MethodName: "GenerateProjectDigest",
+			Handler:    _IssuesService_GenerateProjectDigest_Handler,
+		},
+		{
+			MethodName: "GetProjectProgress",
+			Handler:    _IssuesService_GetProjectProgress_Handler,
+		},
+		{
+			MethodName: "BulkUpdateIssueStatus",
+			Handler:    _IssuesService_BulkUpdateIssueStatus_Handler,
+		},
+		{
+			MethodName: "ValidateIssue",
+			Handler:    _IssuesService_ValidateIssue_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchIssue",
+			Handler:       _IssuesService_WatchIssue_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/issues/v1/issues.proto",
 }
@@ -0,0 +1,8 @@
+package issuesv1
+
+import _ "embed" // OpenAPIDocument is embedded
+
+// OpenAPIDocument is the OpenAPI (swagger) document of the REST API, generated from issues.proto
+//
+//go:embed issues.swagger.json
+var OpenAPIDocument []byte
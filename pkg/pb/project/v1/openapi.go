@@ -0,0 +1,8 @@
+package projectv1
+
+import _ "embed" // OpenAPIDocument is embedded
+
+// OpenAPIDocument is the OpenAPI (swagger) document of the REST API, generated from project.proto
+//
+//go:embed project.swagger.json
+var OpenAPIDocument []byte
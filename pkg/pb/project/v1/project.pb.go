@@ -16,6 +16,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -25,12 +26,133 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// UpdateType is what a ProjectUpdateResponse reports
+type UpdateType int32
+
+const (
+	UpdateType_UPDATE_TYPE_UNSPECIFIED UpdateType = 0
+	UpdateType_ISSUE_ADDED             UpdateType = 1 // An issue was added to the project
+	UpdateType_ISSUE_MOVED             UpdateType = 2 // An issue was moved into or out of the project
+	UpdateType_PROJECT_UPDATED         UpdateType = 3 // A client sent an update action for the project
+	UpdateType_UPDATES_DROPPED         UpdateType = 4 // Marker: updates were dropped and the project should be re-fetched
+	UpdateType_SERVER_SHUTDOWN         UpdateType = 5 // The server is shutting down and the client should reconnect
+	UpdateType_REMINDER                UpdateType = 6 // An assigned issue of the project is due soon
+	UpdateType_PROJECT_CHANGED         UpdateType = 7 // The project was renamed, its description changed or it was deleted
+)
+
+// Enum value maps for UpdateType.
+var (
+	UpdateType_name = map[int32]string{
+		0: "UPDATE_TYPE_UNSPECIFIED",
+		1: "ISSUE_ADDED",
+		2: "ISSUE_MOVED",
+		3: "PROJECT_UPDATED",
+		4: "UPDATES_DROPPED",
+		5: "SERVER_SHUTDOWN",
+		6: "REMINDER",
+		7: "PROJECT_CHANGED",
+	}
+	UpdateType_value = map[string]int32{
+		"UPDATE_TYPE_UNSPECIFIED": 0,
+		"ISSUE_ADDED":             1,
+		"ISSUE_MOVED":             2,
+		"PROJECT_UPDATED":         3,
+		"UPDATES_DROPPED":         4,
+		"SERVER_SHUTDOWN":         5,
+		"REMINDER":                6,
+		"PROJECT_CHANGED":         7,
+	}
+)
+
+func (x UpdateType) Enum() *UpdateType {
+	p := new(UpdateType)
+	*p = x
+	return p
+}
+
+func (x UpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_project_v1_project_proto_enumTypes[0].Descriptor()
+}
+
+func (UpdateType) Type() protoreflect.EnumType {
+	return &file_pkg_pb_project_v1_project_proto_enumTypes[0]
+}
+
+func (x UpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateType.Descriptor instead.
+func (UpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
+// Role is a user's role in a project. Viewers can't be assigned the project's issues; users
+// who aren't members of a project aren't restricted.
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_MAINTAINER       Role = 1
+	Role_DEVELOPER        Role = 2
+	Role_VIEWER           Role = 3
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "MAINTAINER",
+		2: "DEVELOPER",
+		3: "VIEWER",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"MAINTAINER":       1,
+		"DEVELOPER":        2,
+		"VIEWER":           3,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_project_v1_project_proto_enumTypes[1].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_pkg_pb_project_v1_project_proto_enumTypes[1]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{1}
+}
+
 type Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	IssueCount    int32                  `protobuf:"varint,4,opt,name=issue_count,json=issueCount,proto3" json:"issue_count,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId   string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IssueCount  int32                  `protobuf:"varint,4,opt,name=issue_count,json=issueCount,proto3" json:"issue_count,omitempty"`
+	// Most issues the project may have; 0 means unlimited
+	MaxIssues     int32 `protobuf:"varint,5,opt,name=max_issues,json=maxIssues,proto3" json:"max_issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -93,10 +215,19 @@ func (x *Project) GetIssueCount() int32 {
 	return 0
 }
 
+func (x *Project) GetMaxIssues() int32 {
+	if x != nil {
+		return x.MaxIssues
+	}
+	return 0
+}
+
 type CreateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Most issues the project may have; 0 means unlimited
+	MaxIssues     int32 `protobuf:"varint,3,opt,name=max_issues,json=maxIssues,proto3" json:"max_issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -145,6 +276,13 @@ func (x *CreateProjectRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateProjectRequest) GetMaxIssues() int32 {
+	if x != nil {
+		return x.MaxIssues
+	}
+	return 0
+}
+
 type CreateProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -189,6 +327,119 @@ func (x *CreateProjectResponse) GetProject() *Project {
 	return nil
 }
 
+type FindOrCreateProjectRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Most issues the project may have; 0 means unlimited. Ignored when the project exists
+	MaxIssues     int32 `protobuf:"varint,3,opt,name=max_issues,json=maxIssues,proto3" json:"max_issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindOrCreateProjectRequest) Reset() {
+	*x = FindOrCreateProjectRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindOrCreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrCreateProjectRequest) ProtoMessage() {}
+
+func (x *FindOrCreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrCreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*FindOrCreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindOrCreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindOrCreateProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FindOrCreateProjectRequest) GetMaxIssues() int32 {
+	if x != nil {
+		return x.MaxIssues
+	}
+	return 0
+}
+
+type FindOrCreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // false when an existing project with the same name was returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindOrCreateProjectResponse) Reset() {
+	*x = FindOrCreateProjectResponse{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindOrCreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrCreateProjectResponse) ProtoMessage() {}
+
+func (x *FindOrCreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrCreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*FindOrCreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindOrCreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *FindOrCreateProjectResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
 type GetProjectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -198,7 +449,7 @@ type GetProjectRequest struct {
 
 func (x *GetProjectRequest) Reset() {
 	*x = GetProjectRequest{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[3]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -210,7 +461,7 @@ func (x *GetProjectRequest) String() string {
 func (*GetProjectRequest) ProtoMessage() {}
 
 func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[3]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,7 +474,7 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{3}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetProjectRequest) GetProjectId() string {
@@ -242,7 +493,7 @@ type GetProjectResponse struct {
 
 func (x *GetProjectResponse) Reset() {
 	*x = GetProjectResponse{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[4]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +505,7 @@ func (x *GetProjectResponse) String() string {
 func (*GetProjectResponse) ProtoMessage() {}
 
 func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[4]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +518,7 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{4}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetProjectResponse) GetProject() *Project {
@@ -278,17 +529,19 @@ func (x *GetProjectResponse) GetProject() *Project {
 }
 
 type UpdateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId   string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Most issues the project may have; 0 means unlimited
+	MaxIssues     int32 `protobuf:"varint,4,opt,name=max_issues,json=maxIssues,proto3" json:"max_issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProjectRequest) Reset() {
 	*x = UpdateProjectRequest{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[5]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +553,7 @@ func (x *UpdateProjectRequest) String() string {
 func (*UpdateProjectRequest) ProtoMessage() {}
 
 func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[5]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +566,7 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{5}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateProjectRequest) GetProjectId() string {
@@ -337,6 +590,13 @@ func (x *UpdateProjectRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateProjectRequest) GetMaxIssues() int32 {
+	if x != nil {
+		return x.MaxIssues
+	}
+	return 0
+}
+
 type UpdateProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -346,7 +606,7 @@ type UpdateProjectResponse struct {
 
 func (x *UpdateProjectResponse) Reset() {
 	*x = UpdateProjectResponse{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[6]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +618,7 @@ func (x *UpdateProjectResponse) String() string {
 func (*UpdateProjectResponse) ProtoMessage() {}
 
 func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[6]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +631,7 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{6}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateProjectResponse) GetProject() *Project {
@@ -390,7 +650,7 @@ type DeleteProjectRequest struct {
 
 func (x *DeleteProjectRequest) Reset() {
 	*x = DeleteProjectRequest{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[7]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -402,7 +662,7 @@ func (x *DeleteProjectRequest) String() string {
 func (*DeleteProjectRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[7]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +675,7 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{7}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteProjectRequest) GetProjectId() string {
@@ -425,16 +685,69 @@ func (x *DeleteProjectRequest) GetProjectId() string {
 	return ""
 }
 
+type ListProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 lists DEFAULT_PAGE_SIZE items; sizes above MAX_PAGE_SIZE are rejected
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListProjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListProjectsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProjectsResponse) Reset() {
 	*x = ListProjectsResponse{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[8]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +759,7 @@ func (x *ListProjectsResponse) String() string {
 func (*ListProjectsResponse) ProtoMessage() {}
 
 func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[8]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +772,7 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{8}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListProjectsResponse) GetProjects() []*Project {
@@ -469,6 +782,13 @@ func (x *ListProjectsResponse) GetProjects() []*Project {
 	return nil
 }
 
+func (x *ListProjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateProjectWithIssueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Cannot be empty
@@ -479,7 +799,7 @@ type UpdateProjectWithIssueRequest struct {
 
 func (x *UpdateProjectWithIssueRequest) Reset() {
 	*x = UpdateProjectWithIssueRequest{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[9]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +811,7 @@ func (x *UpdateProjectWithIssueRequest) String() string {
 func (*UpdateProjectWithIssueRequest) ProtoMessage() {}
 
 func (x *UpdateProjectWithIssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[9]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +824,7 @@ func (x *UpdateProjectWithIssueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectWithIssueRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectWithIssueRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{9}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateProjectWithIssueRequest) GetProjectId() string {
@@ -532,7 +852,7 @@ type UpdateProjectWithIssueResponse struct {
 
 func (x *UpdateProjectWithIssueResponse) Reset() {
 	*x = UpdateProjectWithIssueResponse{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[10]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -544,7 +864,7 @@ func (x *UpdateProjectWithIssueResponse) String() string {
 func (*UpdateProjectWithIssueResponse) ProtoMessage() {}
 
 func (x *UpdateProjectWithIssueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[10]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +877,7 @@ func (x *UpdateProjectWithIssueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectWithIssueResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectWithIssueResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{10}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateProjectWithIssueResponse) GetProjectId() string {
@@ -583,16 +903,24 @@ func (x *UpdateProjectWithIssueResponse) GetMessage() string {
 
 // StreamProjectUpdates (Bidirectional)
 type ProjectUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Cannot be empty
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                        // Cannot be empty
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Cannot be empty
+	Action    string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                        // Cannot be empty
+	// Only updates of these types are sent after subscribing; empty sends every update.
+	// Dropped-update markers and the shutdown message are always sent.
+	UpdateTypes []UpdateType `protobuf:"varint,3,rep,packed,name=update_types,json=updateTypes,proto3,enum=project.v1.UpdateType" json:"update_types,omitempty"`
+	// When set on a subscribe action, the project's updates published since this time are sent
+	// first, with replayed set, followed by live updates without gaps or duplicates in between.
+	// The Unix epoch replays every retained update. Kafka replays what the topic retains; the
+	// in-memory broker keeps the last SUBSCRIBER_REPLAY_BUFFER_SIZE updates of each project.
+	ReplayFrom    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=replay_from,json=replayFrom,proto3" json:"replay_from,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProjectUpdateRequest) Reset() {
 	*x = ProjectUpdateRequest{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[11]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +932,7 @@ func (x *ProjectUpdateRequest) String() string {
 func (*ProjectUpdateRequest) ProtoMessage() {}
 
 func (x *ProjectUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[11]
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +945,7 @@ func (x *ProjectUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectUpdateRequest.ProtoReflect.Descriptor instead.
 func (*ProjectUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{11}
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ProjectUpdateRequest) GetProjectId() string {
@@ -634,18 +962,81 @@ func (x *ProjectUpdateRequest) GetAction() string {
 	return ""
 }
 
-type ProjectUpdateResponse struct {
+func (x *ProjectUpdateRequest) GetUpdateTypes() []UpdateType {
+	if x != nil {
+		return x.UpdateTypes
+	}
+	return nil
+}
+
+func (x *ProjectUpdateRequest) GetReplayFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplayFrom
+	}
+	return nil
+}
+
+type GetProjectUpdateSnapshotRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`     // Project ID being updated
-	IssueCount    int32                  `protobuf:"varint,2,opt,name=issue_count,json=issueCount,proto3" json:"issue_count,omitempty"` // Updated issue count
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                          // Additional messages from the server
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectUpdateResponse) Reset() {
-	*x = ProjectUpdateResponse{}
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[12]
+func (x *GetProjectUpdateSnapshotRequest) Reset() {
+	*x = GetProjectUpdateSnapshotRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectUpdateSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectUpdateSnapshotRequest) ProtoMessage() {}
+
+func (x *GetProjectUpdateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectUpdateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectUpdateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetProjectUpdateSnapshotRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ProjectUpdateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId      string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`                 // Project ID being updated
+	IssueCount     int32                  `protobuf:"varint,2,opt,name=issue_count,json=issueCount,proto3" json:"issue_count,omitempty"`             // Updated issue count
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                                      // Additional messages from the server
+	DroppedUpdates int64                  `protobuf:"varint,5,opt,name=dropped_updates,json=droppedUpdates,proto3" json:"dropped_updates,omitempty"` // Set on marker messages: updates this subscriber missed since the last marker
+	UpdateType     UpdateType             `protobuf:"varint,6,opt,name=update_type,json=updateType,proto3,enum=project.v1.UpdateType" json:"update_type,omitempty"`
+	Heartbeat      bool                   `protobuf:"varint,7,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`           // Set on keepalive messages sent while the stream is idle; they carry no update
+	IssueId        string                 `protobuf:"bytes,8,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"` // Set on REMINDER updates: the issue that is due soon
+	Replayed       bool                   `protobuf:"varint,9,opt,name=replayed,proto3" json:"replayed,omitempty"`             // Set on updates published before the subscription that were sent because of replay_from
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProjectUpdateResponse) Reset() {
+	*x = ProjectUpdateResponse{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,10 +1045,414 @@ func (x *ProjectUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectUpdateResponse) ProtoMessage() {}
+func (*ProjectUpdateResponse) ProtoMessage() {}
+
+func (x *ProjectUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectUpdateResponse.ProtoReflect.Descriptor instead.
+func (*ProjectUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProjectUpdateResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectUpdateResponse) GetIssueCount() int32 {
+	if x != nil {
+		return x.IssueCount
+	}
+	return 0
+}
+
+func (x *ProjectUpdateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProjectUpdateResponse) GetDroppedUpdates() int64 {
+	if x != nil {
+		return x.DroppedUpdates
+	}
+	return 0
+}
+
+func (x *ProjectUpdateResponse) GetUpdateType() UpdateType {
+	if x != nil {
+		return x.UpdateType
+	}
+	return UpdateType_UPDATE_TYPE_UNSPECIFIED
+}
+
+func (x *ProjectUpdateResponse) GetHeartbeat() bool {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return false
+}
+
+func (x *ProjectUpdateResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ProjectUpdateResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+// IssueTemplate holds defaults applied to issues created from it. default_type and
+// default_priority are issues.v1 Type and Priority names; empty leaves the field unset.
+type IssueTemplate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId          string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	ProjectId           string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	DefaultType         string                 `protobuf:"bytes,4,opt,name=default_type,json=defaultType,proto3" json:"default_type,omitempty"`
+	DefaultPriority     string                 `protobuf:"bytes,5,opt,name=default_priority,json=defaultPriority,proto3" json:"default_priority,omitempty"`
+	SummaryPrefix       string                 `protobuf:"bytes,6,opt,name=summary_prefix,json=summaryPrefix,proto3" json:"summary_prefix,omitempty"`                   // Prepended to the summary of issues created from the template
+	DescriptionTemplate string                 `protobuf:"bytes,7,opt,name=description_template,json=descriptionTemplate,proto3" json:"description_template,omitempty"` // Used as the description when the request has none
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *IssueTemplate) Reset() {
+	*x = IssueTemplate{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTemplate) ProtoMessage() {}
+
+func (x *IssueTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTemplate.ProtoReflect.Descriptor instead.
+func (*IssueTemplate) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IssueTemplate) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetDefaultType() string {
+	if x != nil {
+		return x.DefaultType
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetDefaultPriority() string {
+	if x != nil {
+		return x.DefaultPriority
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetSummaryPrefix() string {
+	if x != nil {
+		return x.SummaryPrefix
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetDescriptionTemplate() string {
+	if x != nil {
+		return x.DescriptionTemplate
+	}
+	return ""
+}
+
+type CreateIssueTemplateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId       string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DefaultType     string                 `protobuf:"bytes,3,opt,name=default_type,json=defaultType,proto3" json:"default_type,omitempty"`
+	DefaultPriority string                 `protobuf:"bytes,4,opt,name=default_priority,json=defaultPriority,proto3" json:"default_priority,omitempty"`
+	SummaryPrefix   string                 `protobuf:"bytes,5,opt,name=summary_prefix,json=summaryPrefix,proto3" json:"summary_prefix,omitempty"`
+	// Limited like an issue description by default, as it becomes one
+	DescriptionTemplate string `protobuf:"bytes,6,opt,name=description_template,json=descriptionTemplate,proto3" json:"description_template,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateIssueTemplateRequest) Reset() {
+	*x = CreateIssueTemplateRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueTemplateRequest) ProtoMessage() {}
+
+func (x *CreateIssueTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateIssueTemplateRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateIssueTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIssueTemplateRequest) GetDefaultType() string {
+	if x != nil {
+		return x.DefaultType
+	}
+	return ""
+}
+
+func (x *CreateIssueTemplateRequest) GetDefaultPriority() string {
+	if x != nil {
+		return x.DefaultPriority
+	}
+	return ""
+}
+
+func (x *CreateIssueTemplateRequest) GetSummaryPrefix() string {
+	if x != nil {
+		return x.SummaryPrefix
+	}
+	return ""
+}
+
+func (x *CreateIssueTemplateRequest) GetDescriptionTemplate() string {
+	if x != nil {
+		return x.DescriptionTemplate
+	}
+	return ""
+}
+
+type CreateIssueTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *IssueTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueTemplateResponse) Reset() {
+	*x = CreateIssueTemplateResponse{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueTemplateResponse) ProtoMessage() {}
+
+func (x *CreateIssueTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateIssueTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateIssueTemplateResponse) GetTemplate() *IssueTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetIssueTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueTemplateRequest) Reset() {
+	*x = GetIssueTemplateRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueTemplateRequest) ProtoMessage() {}
+
+func (x *GetIssueTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetIssueTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type GetIssueTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *IssueTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueTemplateResponse) Reset() {
+	*x = GetIssueTemplateResponse{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueTemplateResponse) ProtoMessage() {}
+
+func (x *GetIssueTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetIssueTemplateResponse) GetTemplate() *IssueTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListIssueTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueTemplatesRequest) Reset() {
+	*x = ListIssueTemplatesRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueTemplatesRequest) ProtoMessage() {}
 
-func (x *ProjectUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[12]
+func (x *ListIssueTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,67 +1463,412 @@ func (x *ProjectUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectUpdateResponse.ProtoReflect.Descriptor instead.
-func (*ProjectUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListIssueTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ProjectUpdateResponse) GetProjectId() string {
+func (x *ListIssueTemplatesRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *ProjectUpdateResponse) GetIssueCount() int32 {
+type ListIssueTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*IssueTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueTemplatesResponse) Reset() {
+	*x = ListIssueTemplatesResponse{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueTemplatesResponse) ProtoMessage() {}
+
+func (x *ListIssueTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[23]
 	if x != nil {
-		return x.IssueCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProjectUpdateResponse) GetMessage() string {
+// Deprecated: Use ListIssueTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListIssueTemplatesResponse) GetTemplates() []*IssueTemplate {
 	if x != nil {
-		return x.Message
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteIssueTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIssueTemplateRequest) Reset() {
+	*x = DeleteIssueTemplateRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIssueTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIssueTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteIssueTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIssueTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIssueTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteIssueTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type ProjectMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=project.v1.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMember) Reset() {
+	*x = ProjectMember{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMember) ProtoMessage() {}
+
+func (x *ProjectMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
+func (*ProjectMember) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProjectMember) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type SetProjectMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=project.v1.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProjectMemberRoleRequest) Reset() {
+	*x = SetProjectMemberRoleRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProjectMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetProjectMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetProjectMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetProjectMemberRoleRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetProjectMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetProjectMemberRoleRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type SetProjectMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ProjectMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProjectMemberRoleResponse) Reset() {
+	*x = SetProjectMemberRoleResponse{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProjectMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectMemberRoleResponse) ProtoMessage() {}
+
+func (x *SetProjectMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetProjectMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetProjectMemberRoleResponse) GetMember() *ProjectMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ListProjectMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersRequest) Reset() {
+	*x = ListProjectMembersRequest{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersRequest) ProtoMessage() {}
+
+func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListProjectMembersRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
 	}
 	return ""
 }
 
+type ListProjectMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ProjectMember       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersResponse) Reset() {
+	*x = ListProjectMembersResponse{}
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersResponse) ProtoMessage() {}
+
+func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_project_v1_project_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_project_v1_project_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListProjectMembersResponse) GetMembers() []*ProjectMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
 var File_pkg_pb_project_v1_project_proto protoreflect.FileDescriptor
 
 const file_pkg_pb_project_v1_project_proto_rawDesc = "" +
 	"\n" +
 	"\x1fpkg/pb/project/v1/project.proto\x12\n" +
-	"project.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dproto/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xc4\x01\n" +
+	"project.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dproto/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xec\x01\n" +
 	"\aProject\x12:\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\x120\n" +
 	"\x04name\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17\x10\x01\x18d2\x11^[a-zA-Z0-9 _-]+$R\x04name\x12*\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12\x1f\n" +
 	"\vissue_count\x18\x04 \x01(\x05R\n" +
-	"issueCount\"t\n" +
+	"issueCount\x12&\n" +
+	"\n" +
+	"max_issues\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tmaxIssues\"\x9c\x01\n" +
 	"\x14CreateProjectRequest\x120\n" +
 	"\x04name\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x01\x18d2\x11^[a-zA-Z0-9 _-]+$R\x04name\x12*\n" +
-	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\"F\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12&\n" +
+	"\n" +
+	"max_issues\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tmaxIssues\"F\n" +
 	"\x15CreateProjectResponse\x12-\n" +
-	"\aproject\x18\x01 \x01(\v2\x13.project.v1.ProjectR\aproject\"O\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.project.v1.ProjectR\aproject\"\xa2\x01\n" +
+	"\x1aFindOrCreateProjectRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x01\x18d2\x11^[a-zA-Z0-9 _-]+$R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12&\n" +
+	"\n" +
+	"max_issues\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tmaxIssues\"f\n" +
+	"\x1bFindOrCreateProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.project.v1.ProjectR\aproject\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"O\n" +
 	"\x11GetProjectRequest\x12:\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\"C\n" +
 	"\x12GetProjectResponse\x12-\n" +
-	"\aproject\x18\x01 \x01(\v2\x13.project.v1.ProjectR\aproject\"\xb0\x01\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.project.v1.ProjectR\aproject\"\xd8\x01\n" +
 	"\x14UpdateProjectRequest\x12:\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\x120\n" +
 	"\x04name\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17\x10\x01\x18d2\x11^[a-zA-Z0-9 _-]+$R\x04name\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\"F\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12&\n" +
+	"\n" +
+	"max_issues\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tmaxIssues\"F\n" +
 	"\x15UpdateProjectResponse\x12-\n" +
 	"\aproject\x18\x01 \x01(\v2\x13.project.v1.ProjectR\aproject\"R\n" +
 	"\x14DeleteProjectRequest\x12:\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\"G\n" +
+	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\"Z\n" +
+	"\x13ListProjectsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"o\n" +
 	"\x14ListProjectsResponse\x12/\n" +
-	"\bprojects\x18\x01 \x03(\v2\x13.project.v1.ProjectR\bprojects\"b\n" +
+	"\bprojects\x18\x01 \x03(\v2\x13.project.v1.ProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"b\n" +
 	"\x1dUpdateProjectWithIssueRequest\x12&\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tprojectId\x12\x19\n" +
@@ -738,26 +1878,114 @@ const file_pkg_pb_project_v1_project_proto_rawDesc = "" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
 	"\vissue_count\x18\x02 \x01(\x05R\n" +
 	"issueCount\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"w\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x82\x02\n" +
 	"\x14ProjectUpdateRequest\x12&\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tprojectId\x127\n" +
 	"\x06action\x18\x02 \x01(\tB\x1f\xfaB\x1cr\x1a\x10\x01\x18\n" +
-	"2\x14^(subscribe|update)$R\x06action\"q\n" +
+	"2\x14^(subscribe|update)$R\x06action\x12L\n" +
+	"\fupdate_types\x18\x03 \x03(\x0e2\x16.project.v1.UpdateTypeB\x11\xfaB\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\vupdateTypes\x12;\n" +
+	"\vreplay_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"replayFrom\"]\n" +
+	"\x1fGetProjectUpdateSnapshotRequest\x12:\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\"\xa8\x02\n" +
 	"\x15ProjectUpdateResponse\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
 	"\vissue_count\x18\x02 \x01(\x05R\n" +
 	"issueCount\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage2\xb9\x06\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12'\n" +
+	"\x0fdropped_updates\x18\x05 \x01(\x03R\x0edroppedUpdates\x127\n" +
+	"\vupdate_type\x18\x06 \x01(\x0e2\x16.project.v1.UpdateTypeR\n" +
+	"updateType\x12\x1c\n" +
+	"\theartbeat\x18\a \x01(\bR\theartbeat\x12\x19\n" +
+	"\bissue_id\x18\b \x01(\tR\aissueId\x12\x1a\n" +
+	"\breplayed\x18\t \x01(\bR\breplayed\"\x8b\x02\n" +
+	"\rIssueTemplate\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fdefault_type\x18\x04 \x01(\tR\vdefaultType\x12)\n" +
+	"\x10default_priority\x18\x05 \x01(\tR\x0fdefaultPriority\x12%\n" +
+	"\x0esummary_prefix\x18\x06 \x01(\tR\rsummaryPrefix\x121\n" +
+	"\x14description_template\x18\a \x01(\tR\x13descriptionTemplate\"\x8c\x03\n" +
+	"\x1aCreateIssueTemplateRequest\x12:\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12O\n" +
+	"\fdefault_type\x18\x03 \x01(\tB,\xfaB)r'R\x00R\bCOSMETICR\x03BUGR\aFEATURER\vPERFORMANCER\vdefaultType\x12U\n" +
+	"\x10default_priority\x18\x04 \x01(\tB*\xfaB'r%R\x00R\bCRITICALR\x05MAJORR\tIMPORTANTR\x05MINORR\x0fdefaultPriority\x12.\n" +
+	"\x0esummary_prefix\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x182R\rsummaryPrefix\x12;\n" +
+	"\x14description_template\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x13descriptionTemplate\"T\n" +
+	"\x1bCreateIssueTemplateResponse\x125\n" +
+	"\btemplate\x18\x01 \x01(\v2\x19.project.v1.IssueTemplateR\btemplate\"D\n" +
+	"\x17GetIssueTemplateRequest\x12)\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"templateId\"Q\n" +
+	"\x18GetIssueTemplateResponse\x125\n" +
+	"\btemplate\x18\x01 \x01(\v2\x19.project.v1.IssueTemplateR\btemplate\"W\n" +
+	"\x19ListIssueTemplatesRequest\x12:\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x10\x01\x18$2\x10^[a-zA-Z0-9_-]+$R\tprojectId\"U\n" +
+	"\x1aListIssueTemplatesResponse\x127\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x19.project.v1.IssueTemplateR\ttemplates\"G\n" +
+	"\x1aDeleteIssueTemplateRequest\x12)\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"templateId\"m\n" +
+	"\rProjectMember\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.project.v1.RoleR\x04role\"\x9b\x01\n" +
+	"\x1bSetProjectMemberRoleRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x120\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.project.v1.RoleB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04role\"Q\n" +
+	"\x1cSetProjectMemberRoleResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x19.project.v1.ProjectMemberR\x06member\"D\n" +
+	"\x19ListProjectMembersRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tprojectId\"Q\n" +
+	"\x1aListProjectMembersResponse\x123\n" +
+	"\amembers\x18\x01 \x03(\v2\x19.project.v1.ProjectMemberR\amembers*\xad\x01\n" +
+	"\n" +
+	"UpdateType\x12\x1b\n" +
+	"\x17UPDATE_TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vISSUE_ADDED\x10\x01\x12\x0f\n" +
+	"\vISSUE_MOVED\x10\x02\x12\x13\n" +
+	"\x0fPROJECT_UPDATED\x10\x03\x12\x13\n" +
+	"\x0fUPDATES_DROPPED\x10\x04\x12\x13\n" +
+	"\x0fSERVER_SHUTDOWN\x10\x05\x12\f\n" +
+	"\bREMINDER\x10\x06\x12\x13\n" +
+	"\x0fPROJECT_CHANGED\x10\a*G\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"MAINTAINER\x10\x01\x12\r\n" +
+	"\tDEVELOPER\x10\x02\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x032\xd6\x0f\n" +
 	"\x0eProjectService\x12m\n" +
-	"\rCreateProject\x12 .project.v1.CreateProjectRequest\x1a!.project.v1.CreateProjectResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/projects\x12n\n" +
+	"\rCreateProject\x12 .project.v1.CreateProjectRequest\x1a!.project.v1.CreateProjectResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/projects\x12\x8e\x01\n" +
+	"\x13FindOrCreateProject\x12&.project.v1.FindOrCreateProjectRequest\x1a'.project.v1.FindOrCreateProjectResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/projects/find-or-create\x12n\n" +
 	"\n" +
 	"GetProject\x12\x1d.project.v1.GetProjectRequest\x1a\x1e.project.v1.GetProjectResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/projects/{project_id}\x12z\n" +
 	"\rUpdateProject\x12 .project.v1.UpdateProjectRequest\x1a!.project.v1.UpdateProjectResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/projects/{project_id}\x12l\n" +
-	"\rDeleteProject\x12 .project.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/projects/{project_id}\x12^\n" +
-	"\fListProjects\x12\x16.google.protobuf.Empty\x1a .project.v1.ListProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x12\x9c\x01\n" +
-	"\x16UpdateProjectWithIssue\x12).project.v1.UpdateProjectWithIssueRequest\x1a*.project.v1.UpdateProjectWithIssueResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/projects/{project_id}/issues\x12_\n" +
+	"\rDeleteProject\x12 .project.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/projects/{project_id}\x12g\n" +
+	"\fListProjects\x12\x1f.project.v1.ListProjectsRequest\x1a .project.v1.ListProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x12\x9c\x01\n" +
+	"\x16UpdateProjectWithIssue\x12).project.v1.UpdateProjectWithIssueRequest\x1a*.project.v1.UpdateProjectWithIssueResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/projects/{project_id}/issues\x12\x96\x01\n" +
+	"\x13CreateIssueTemplate\x12&.project.v1.CreateIssueTemplateRequest\x1a'.project.v1.CreateIssueTemplateResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/projects/{project_id}/templates\x12\x82\x01\n" +
+	"\x10GetIssueTemplate\x12#.project.v1.GetIssueTemplateRequest\x1a$.project.v1.GetIssueTemplateResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/templates/{template_id}\x12\x90\x01\n" +
+	"\x12ListIssueTemplates\x12%.project.v1.ListIssueTemplatesRequest\x1a&.project.v1.ListIssueTemplatesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/projects/{project_id}/templates\x12z\n" +
+	"\x13DeleteIssueTemplate\x12&.project.v1.DeleteIssueTemplateRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/templates/{template_id}\x12\xa1\x01\n" +
+	"\x14SetProjectMemberRole\x12'.project.v1.SetProjectMemberRoleRequest\x1a(.project.v1.SetProjectMemberRoleResponse\"6\x82\xd3\xe4\x93\x020:\x01*\x1a+/v1/projects/{project_id}/members/{user_id}\x12\x8e\x01\n" +
+	"\x12ListProjectMembers\x12%.project.v1.ListProjectMembersRequest\x1a&.project.v1.ListProjectMembersResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/projects/{project_id}/members\x12\x9e\x01\n" +
+	"\x18GetProjectUpdateSnapshot\x12+.project.v1.GetProjectUpdateSnapshotRequest\x1a!.project.v1.ProjectUpdateResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/projects/{project_id}/updates:snapshot\x12_\n" +
 	"\x14StreamProjectUpdates\x12 .project.v1.ProjectUpdateRequest\x1a!.project.v1.ProjectUpdateResponse(\x010\x01B\x1dZ\x1bpkg/pb/project/v1;projectv1b\x06proto3"
 
 var (
@@ -772,47 +2000,95 @@ func file_pkg_pb_project_v1_project_proto_rawDescGZIP() []byte {
 	return file_pkg_pb_project_v1_project_proto_rawDescData
 }
 
-var file_pkg_pb_project_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_pkg_pb_project_v1_project_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_pb_project_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_pkg_pb_project_v1_project_proto_goTypes = []any{
-	(*Project)(nil),                        // 0: project.v1.Project
-	(*CreateProjectRequest)(nil),           // 1: project.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil),          // 2: project.v1.CreateProjectResponse
-	(*GetProjectRequest)(nil),              // 3: project.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),             // 4: project.v1.GetProjectResponse
-	(*UpdateProjectRequest)(nil),           // 5: project.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),          // 6: project.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),           // 7: project.v1.DeleteProjectRequest
-	(*ListProjectsResponse)(nil),           // 8: project.v1.ListProjectsResponse
-	(*UpdateProjectWithIssueRequest)(nil),  // 9: project.v1.UpdateProjectWithIssueRequest
-	(*UpdateProjectWithIssueResponse)(nil), // 10: project.v1.UpdateProjectWithIssueResponse
-	(*ProjectUpdateRequest)(nil),           // 11: project.v1.ProjectUpdateRequest
-	(*ProjectUpdateResponse)(nil),          // 12: project.v1.ProjectUpdateResponse
-	(*emptypb.Empty)(nil),                  // 13: google.protobuf.Empty
+	(UpdateType)(0),                         // 0: project.v1.UpdateType
+	(Role)(0),                               // 1: project.v1.Role
+	(*Project)(nil),                         // 2: project.v1.Project
+	(*CreateProjectRequest)(nil),            // 3: project.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),           // 4: project.v1.CreateProjectResponse
+	(*FindOrCreateProjectRequest)(nil),      // 5: project.v1.FindOrCreateProjectRequest
+	(*FindOrCreateProjectResponse)(nil),     // 6: project.v1.FindOrCreateProjectResponse
+	(*GetProjectRequest)(nil),               // 7: project.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),              // 8: project.v1.GetProjectResponse
+	(*UpdateProjectRequest)(nil),            // 9: project.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),           // 10: project.v1.UpdateProjectResponse
+	(*DeleteProjectRequest)(nil),            // 11: project.v1.DeleteProjectRequest
+	(*ListProjectsRequest)(nil),             // 12: project.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),            // 13: project.v1.ListProjectsResponse
+	(*UpdateProjectWithIssueRequest)(nil),   // 14: project.v1.UpdateProjectWithIssueRequest
+	(*UpdateProjectWithIssueResponse)(nil),  // 15: project.v1.UpdateProjectWithIssueResponse
+	(*ProjectUpdateRequest)(nil),            // 16: project.v1.ProjectUpdateRequest
+	(*GetProjectUpdateSnapshotRequest)(nil), // 17: project.v1.GetProjectUpdateSnapshotRequest
+	(*ProjectUpdateResponse)(nil),           // 18: project.v1.ProjectUpdateResponse
+	(*IssueTemplate)(nil),                   // 19: project.v1.IssueTemplate
+	(*CreateIssueTemplateRequest)(nil),      // 20: project.v1.CreateIssueTemplateRequest
+	(*CreateIssueTemplateResponse)(nil),     // 21: project.v1.CreateIssueTemplateResponse
+	(*GetIssueTemplateRequest)(nil),         // 22: project.v1.GetIssueTemplateRequest
+	(*GetIssueTemplateResponse)(nil),        // 23: project.v1.GetIssueTemplateResponse
+	(*ListIssueTemplatesRequest)(nil),       // 24: project.v1.ListIssueTemplatesRequest
+	(*ListIssueTemplatesResponse)(nil),      // 25: project.v1.ListIssueTemplatesResponse
+	(*DeleteIssueTemplateRequest)(nil),      // 26: project.v1.DeleteIssueTemplateRequest
+	(*ProjectMember)(nil),                   // 27: project.v1.ProjectMember
+	(*SetProjectMemberRoleRequest)(nil),     // 28: project.v1.SetProjectMemberRoleRequest
+	(*SetProjectMemberRoleResponse)(nil),    // 29: project.v1.SetProjectMemberRoleResponse
+	(*ListProjectMembersRequest)(nil),       // 30: project.v1.ListProjectMembersRequest
+	(*ListProjectMembersResponse)(nil),      // 31: project.v1.ListProjectMembersResponse
+	(*timestamppb.Timestamp)(nil),           // 32: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 33: google.protobuf.Empty
 }
 var file_pkg_pb_project_v1_project_proto_depIdxs = []int32{
-	0,  // 0: project.v1.CreateProjectResponse.project:type_name -> project.v1.Project
-	0,  // 1: project.v1.GetProjectResponse.project:type_name -> project.v1.Project
-	0,  // 2: project.v1.UpdateProjectResponse.project:type_name -> project.v1.Project
-	0,  // 3: project.v1.ListProjectsResponse.projects:type_name -> project.v1.Project
-	1,  // 4: project.v1.ProjectService.CreateProject:input_type -> project.v1.CreateProjectRequest
-	3,  // 5: project.v1.ProjectService.GetProject:input_type -> project.v1.GetProjectRequest
-	5,  // 6: project.v1.ProjectService.UpdateProject:input_type -> project.v1.UpdateProjectRequest
-	7,  // 7: project.v1.ProjectService.DeleteProject:input_type -> project.v1.DeleteProjectRequest
-	13, // 8: project.v1.ProjectService.ListProjects:input_type -> google.protobuf.Empty
-	9,  // 9: project.v1.ProjectService.UpdateProjectWithIssue:input_type -> project.v1.UpdateProjectWithIssueRequest
-	11, // 10: project.v1.ProjectService.StreamProjectUpdates:input_type -> project.v1.ProjectUpdateRequest
-	2,  // 11: project.v1.ProjectService.CreateProject:output_type -> project.v1.CreateProjectResponse
-	4,  // 12: project.v1.ProjectService.GetProject:output_type -> project.v1.GetProjectResponse
-	6,  // 13: project.v1.ProjectService.UpdateProject:output_type -> project.v1.UpdateProjectResponse
-	13, // 14: project.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	8,  // 15: project.v1.ProjectService.ListProjects:output_type -> project.v1.ListProjectsResponse
-	10, // 16: project.v1.ProjectService.UpdateProjectWithIssue:output_type -> project.v1.UpdateProjectWithIssueResponse
-	12, // 17: project.v1.ProjectService.StreamProjectUpdates:output_type -> project.v1.ProjectUpdateResponse
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	2,  // 0: project.v1.CreateProjectResponse.project:type_name -> project.v1.Project
+	2,  // 1: project.v1.FindOrCreateProjectResponse.project:type_name -> project.v1.Project
+	2,  // 2: project.v1.GetProjectResponse.project:type_name -> project.v1.Project
+	2,  // 3: project.v1.UpdateProjectResponse.project:type_name -> project.v1.Project
+	2,  // 4: project.v1.ListProjectsResponse.projects:type_name -> project.v1.Project
+	0,  // 5: project.v1.ProjectUpdateRequest.update_types:type_name -> project.v1.UpdateType
+	32, // 6: project.v1.ProjectUpdateRequest.replay_from:type_name -> google.protobuf.Timestamp
+	0,  // 7: project.v1.ProjectUpdateResponse.update_type:type_name -> project.v1.UpdateType
+	19, // 8: project.v1.CreateIssueTemplateResponse.template:type_name -> project.v1.IssueTemplate
+	19, // 9: project.v1.GetIssueTemplateResponse.template:type_name -> project.v1.IssueTemplate
+	19, // 10: project.v1.ListIssueTemplatesResponse.templates:type_name -> project.v1.IssueTemplate
+	1,  // 11: project.v1.ProjectMember.role:type_name -> project.v1.Role
+	1,  // 12: project.v1.SetProjectMemberRoleRequest.role:type_name -> project.v1.Role
+	27, // 13: project.v1.SetProjectMemberRoleResponse.member:type_name -> project.v1.ProjectMember
+	27, // 14: project.v1.ListProjectMembersResponse.members:type_name -> project.v1.ProjectMember
+	3,  // 15: project.v1.ProjectService.CreateProject:input_type -> project.v1.CreateProjectRequest
+	5,  // 16: project.v1.ProjectService.FindOrCreateProject:input_type -> project.v1.FindOrCreateProjectRequest
+	7,  // 17: project.v1.ProjectService.GetProject:input_type -> project.v1.GetProjectRequest
+	9,  // 18: project.v1.ProjectService.UpdateProject:input_type -> project.v1.UpdateProjectRequest
+	11, // 19: project.v1.ProjectService.DeleteProject:input_type -> project.v1.DeleteProjectRequest
+	12, // 20: project.v1.ProjectService.ListProjects:input_type -> project.v1.ListProjectsRequest
+	14, // 21: project.v1.ProjectService.UpdateProjectWithIssue:input_type -> project.v1.UpdateProjectWithIssueRequest
+	20, // 22: project.v1.ProjectService.CreateIssueTemplate:input_type -> project.v1.CreateIssueTemplateRequest
+	22, // 23: project.v1.ProjectService.GetIssueTemplate:input_type -> project.v1.GetIssueTemplateRequest
+	24, // 24: project.v1.ProjectService.ListIssueTemplates:input_type -> project.v1.ListIssueTemplatesRequest
+	26, // 25: project.v1.ProjectService.DeleteIssueTemplate:input_type -> project.v1.DeleteIssueTemplateRequest
+	28, // 26: project.v1.ProjectService.SetProjectMemberRole:input_type -> project.v1.SetProjectMemberRoleRequest
+	30, // 27: project.v1.ProjectService.ListProjectMembers:input_type -> project.v1.ListProjectMembersRequest
+	17, // 28: project.v1.ProjectService.GetProjectUpdateSnapshot:input_type -> project.v1.GetProjectUpdateSnapshotRequest
+	16, // 29: project.v1.ProjectService.StreamProjectUpdates:input_type -> project.v1.ProjectUpdateRequest
+	4,  // 30: project.v1.ProjectService.CreateProject:output_type -> project.v1.CreateProjectResponse
+	6,  // 31: project.v1.ProjectService.FindOrCreateProject:output_type -> project.v1.FindOrCreateProjectResponse
+	8,  // 32: project.v1.ProjectService.GetProject:output_type -> project.v1.GetProjectResponse
+	10, // 33: project.v1.ProjectService.UpdateProject:output_type -> project.v1.UpdateProjectResponse
+	33, // 34: project.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	13, // 35: project.v1.ProjectService.ListProjects:output_type -> project.v1.ListProjectsResponse
+	15, // 36: project.v1.ProjectService.UpdateProjectWithIssue:output_type -> project.v1.UpdateProjectWithIssueResponse
+	21, // 37: project.v1.ProjectService.CreateIssueTemplate:output_type -> project.v1.CreateIssueTemplateResponse
+	23, // 38: project.v1.ProjectService.GetIssueTemplate:output_type -> project.v1.GetIssueTemplateResponse
+	25, // 39: project.v1.ProjectService.ListIssueTemplates:output_type -> project.v1.ListIssueTemplatesResponse
+	33, // 40: project.v1.ProjectService.DeleteIssueTemplate:output_type -> google.protobuf.Empty
+	29, // 41: project.v1.ProjectService.SetProjectMemberRole:output_type -> project.v1.SetProjectMemberRoleResponse
+	31, // 42: project.v1.ProjectService.ListProjectMembers:output_type -> project.v1.ListProjectMembersResponse
+	18, // 43: project.v1.ProjectService.GetProjectUpdateSnapshot:output_type -> project.v1.ProjectUpdateResponse
+	18, // 44: project.v1.ProjectService.StreamProjectUpdates:output_type -> project.v1.ProjectUpdateResponse
+	30, // [30:45] is the sub-list for method output_type
+	15, // [15:30] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_pkg_pb_project_v1_project_proto_init() }
@@ -825,13 +2101,14 @@ func file_pkg_pb_project_v1_project_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_pb_project_v1_project_proto_rawDesc), len(file_pkg_pb_project_v1_project_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_pb_project_v1_project_proto_goTypes,
 		DependencyIndexes: file_pkg_pb_project_v1_project_proto_depIdxs,
+		EnumInfos:         file_pkg_pb_project_v1_project_proto_enumTypes,
 		MessageInfos:      file_pkg_pb_project_v1_project_proto_msgTypes,
 	}.Build()
 	File_pkg_pb_project_v1_project_proto = out.File
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _project_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on Project with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -113,6 +116,17 @@ func (m *Project) validate(all bool) error {
 
 	// no validation rules for IssueCount
 
+	if m.GetMaxIssues() < 0 {
+		err := ProjectValidationError{
+			field:  "MaxIssues",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ProjectMultiError(errors)
 	}
@@ -249,6 +263,17 @@ func (m *CreateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetMaxIssues() < 0 {
+		err := CreateProjectRequestValidationError{
+			field:  "MaxIssues",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateProjectRequestMultiError(errors)
 	}
@@ -462,6 +487,288 @@ var _ interface {
 	ErrorName() string
 } = CreateProjectResponseValidationError{}
 
+// Validate checks the field values on FindOrCreateProjectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindOrCreateProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindOrCreateProjectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindOrCreateProjectRequestMultiError, or nil if none found.
+func (m *FindOrCreateProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindOrCreateProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := FindOrCreateProjectRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_FindOrCreateProjectRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := FindOrCreateProjectRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9 _-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := FindOrCreateProjectRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxIssues() < 0 {
+		err := FindOrCreateProjectRequestValidationError{
+			field:  "MaxIssues",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FindOrCreateProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindOrCreateProjectRequestMultiError is an error wrapping multiple
+// validation errors returned by FindOrCreateProjectRequest.ValidateAll() if
+// the designated constraints aren't met.
+type FindOrCreateProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindOrCreateProjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindOrCreateProjectRequestMultiError) AllErrors() []error { return m }
+
+// FindOrCreateProjectRequestValidationError is the validation error returned
+// by FindOrCreateProjectRequest.Validate if the designated constraints aren't met.
+type FindOrCreateProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindOrCreateProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindOrCreateProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindOrCreateProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindOrCreateProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindOrCreateProjectRequestValidationError) ErrorName() string {
+	return "FindOrCreateProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindOrCreateProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindOrCreateProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindOrCreateProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindOrCreateProjectRequestValidationError{}
+
+var _FindOrCreateProjectRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9 _-]+$")
+
+// Validate checks the field values on FindOrCreateProjectResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindOrCreateProjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindOrCreateProjectResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindOrCreateProjectResponseMultiError, or nil if none found.
+func (m *FindOrCreateProjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindOrCreateProjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FindOrCreateProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FindOrCreateProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindOrCreateProjectResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return FindOrCreateProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindOrCreateProjectResponseMultiError is an error wrapping multiple
+// validation errors returned by FindOrCreateProjectResponse.ValidateAll() if
+// the designated constraints aren't met.
+type FindOrCreateProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindOrCreateProjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindOrCreateProjectResponseMultiError) AllErrors() []error { return m }
+
+// FindOrCreateProjectResponseValidationError is the validation error returned
+// by FindOrCreateProjectResponse.Validate if the designated constraints
+// aren't met.
+type FindOrCreateProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindOrCreateProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindOrCreateProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindOrCreateProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindOrCreateProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindOrCreateProjectResponseValidationError) ErrorName() string {
+	return "FindOrCreateProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindOrCreateProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindOrCreateProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindOrCreateProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindOrCreateProjectResponseValidationError{}
+
 // Validate checks the field values on GetProjectRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -796,6 +1103,17 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetMaxIssues() < 0 {
+		err := UpdateProjectRequestValidationError{
+			field:  "MaxIssues",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return UpdateProjectRequestMultiError(errors)
 	}
@@ -1137,30 +1455,145 @@ var _ interface {
 
 var _DeleteProjectRequest_ProjectId_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
 
-// Validate checks the field values on ListProjectsResponse with the rules
+// Validate checks the field values on ListProjectsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectsResponse) Validate() error {
+func (m *ListProjectsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectsResponse with the rules
+// ValidateAll checks the field values on ListProjectsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectsResponseMultiError, or nil if none found.
-func (m *ListProjectsResponse) ValidateAll() error {
+// ListProjectsRequestMultiError, or nil if none found.
+func (m *ListProjectsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectsResponse) validate(all bool) error {
+func (m *ListProjectsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProjects() {
-		_, _ = idx, item
+	if m.GetPageSize() < 0 {
+		err := ListProjectsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectsRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectsRequestValidationError is the validation error returned by
+// ListProjectsRequest.Validate if the designated constraints aren't met.
+type ListProjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectsRequestValidationError) ErrorName() string {
+	return "ListProjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectsRequestValidationError{}
+
+// Validate checks the field values on ListProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectsResponseMultiError, or nil if none found.
+func (m *ListProjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
@@ -1193,6 +1626,8 @@ func (m *ListProjectsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListProjectsResponseMultiError(errors)
 	}
@@ -1553,6 +1988,77 @@ func (m *ProjectUpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	_ProjectUpdateRequest_UpdateTypes_Unique := make(map[UpdateType]struct{}, len(m.GetUpdateTypes()))
+
+	for idx, item := range m.GetUpdateTypes() {
+		_, _ = idx, item
+
+		if _, exists := _ProjectUpdateRequest_UpdateTypes_Unique[item]; exists {
+			err := ProjectUpdateRequestValidationError{
+				field:  fmt.Sprintf("UpdateTypes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ProjectUpdateRequest_UpdateTypes_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _ProjectUpdateRequest_UpdateTypes_NotInLookup[item]; ok {
+			err := ProjectUpdateRequestValidationError{
+				field:  fmt.Sprintf("UpdateTypes[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := UpdateType_name[int32(item)]; !ok {
+			err := ProjectUpdateRequestValidationError{
+				field:  fmt.Sprintf("UpdateTypes[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetReplayFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectUpdateRequestValidationError{
+					field:  "ReplayFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectUpdateRequestValidationError{
+					field:  "ReplayFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplayFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectUpdateRequestValidationError{
+				field:  "ReplayFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ProjectUpdateRequestMultiError(errors)
 	}
@@ -1635,6 +2141,137 @@ var _ interface {
 
 var _ProjectUpdateRequest_Action_Pattern = regexp.MustCompile("^(subscribe|update)$")
 
+var _ProjectUpdateRequest_UpdateTypes_NotInLookup = map[UpdateType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetProjectUpdateSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectUpdateSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectUpdateSnapshotRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProjectUpdateSnapshotRequestMultiError, or nil if none found.
+func (m *GetProjectUpdateSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectUpdateSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProjectId()); l < 1 || l > 36 {
+		err := GetProjectUpdateSnapshotRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetProjectUpdateSnapshotRequest_ProjectId_Pattern.MatchString(m.GetProjectId()) {
+		err := GetProjectUpdateSnapshotRequestValidationError{
+			field:  "ProjectId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProjectUpdateSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectUpdateSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProjectUpdateSnapshotRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetProjectUpdateSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectUpdateSnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectUpdateSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectUpdateSnapshotRequestValidationError is the validation error
+// returned by GetProjectUpdateSnapshotRequest.Validate if the designated
+// constraints aren't met.
+type GetProjectUpdateSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectUpdateSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectUpdateSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectUpdateSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectUpdateSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectUpdateSnapshotRequestValidationError) ErrorName() string {
+	return "GetProjectUpdateSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectUpdateSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectUpdateSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectUpdateSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectUpdateSnapshotRequestValidationError{}
+
+var _GetProjectUpdateSnapshotRequest_ProjectId_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
 // Validate checks the field values on ProjectUpdateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1663,6 +2300,16 @@ func (m *ProjectUpdateResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	// no validation rules for DroppedUpdates
+
+	// no validation rules for UpdateType
+
+	// no validation rules for Heartbeat
+
+	// no validation rules for IssueId
+
+	// no validation rules for Replayed
+
 	if len(errors) > 0 {
 		return ProjectUpdateResponseMultiError(errors)
 	}
@@ -1742,3 +2389,1740 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ProjectUpdateResponseValidationError{}
+
+// Validate checks the field values on IssueTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueTemplateMultiError, or
+// nil if none found.
+func (m *IssueTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Name
+
+	// no validation rules for DefaultType
+
+	// no validation rules for DefaultPriority
+
+	// no validation rules for SummaryPrefix
+
+	// no validation rules for DescriptionTemplate
+
+	if len(errors) > 0 {
+		return IssueTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueTemplateMultiError is an error wrapping multiple validation errors
+// returned by IssueTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type IssueTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueTemplateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueTemplateMultiError) AllErrors() []error { return m }
+
+// IssueTemplateValidationError is the validation error returned by
+// IssueTemplate.Validate if the designated constraints aren't met.
+type IssueTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueTemplateValidationError) ErrorName() string { return "IssueTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueTemplateValidationError{}
+
+// Validate checks the field values on CreateIssueTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateIssueTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateIssueTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateIssueTemplateRequestMultiError, or nil if none found.
+func (m *CreateIssueTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateIssueTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProjectId()); l < 1 || l > 36 {
+		err := CreateIssueTemplateRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateIssueTemplateRequest_ProjectId_Pattern.MatchString(m.GetProjectId()) {
+		err := CreateIssueTemplateRequestValidationError{
+			field:  "ProjectId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateIssueTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateIssueTemplateRequest_DefaultType_InLookup[m.GetDefaultType()]; !ok {
+		err := CreateIssueTemplateRequestValidationError{
+			field:  "DefaultType",
+			reason: "value must be in list [ COSMETIC BUG FEATURE PERFORMANCE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateIssueTemplateRequest_DefaultPriority_InLookup[m.GetDefaultPriority()]; !ok {
+		err := CreateIssueTemplateRequestValidationError{
+			field:  "DefaultPriority",
+			reason: "value must be in list [ CRITICAL MAJOR IMPORTANT MINOR]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSummaryPrefix()) > 50 {
+		err := CreateIssueTemplateRequestValidationError{
+			field:  "SummaryPrefix",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescriptionTemplate()) > 500 {
+		err := CreateIssueTemplateRequestValidationError{
+			field:  "DescriptionTemplate",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateIssueTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateIssueTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateIssueTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateIssueTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateIssueTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateIssueTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateIssueTemplateRequestValidationError is the validation error returned
+// by CreateIssueTemplateRequest.Validate if the designated constraints aren't met.
+type CreateIssueTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateIssueTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateIssueTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateIssueTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateIssueTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateIssueTemplateRequestValidationError) ErrorName() string {
+	return "CreateIssueTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateIssueTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateIssueTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateIssueTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateIssueTemplateRequestValidationError{}
+
+var _CreateIssueTemplateRequest_ProjectId_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+var _CreateIssueTemplateRequest_DefaultType_InLookup = map[string]struct{}{
+	"":            {},
+	"COSMETIC":    {},
+	"BUG":         {},
+	"FEATURE":     {},
+	"PERFORMANCE": {},
+}
+
+var _CreateIssueTemplateRequest_DefaultPriority_InLookup = map[string]struct{}{
+	"":          {},
+	"CRITICAL":  {},
+	"MAJOR":     {},
+	"IMPORTANT": {},
+	"MINOR":     {},
+}
+
+// Validate checks the field values on CreateIssueTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateIssueTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateIssueTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateIssueTemplateResponseMultiError, or nil if none found.
+func (m *CreateIssueTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateIssueTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateIssueTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateIssueTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateIssueTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateIssueTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateIssueTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateIssueTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateIssueTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateIssueTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateIssueTemplateResponseMultiError) AllErrors() []error { return m }
+
+// CreateIssueTemplateResponseValidationError is the validation error returned
+// by CreateIssueTemplateResponse.Validate if the designated constraints
+// aren't met.
+type CreateIssueTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateIssueTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateIssueTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateIssueTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateIssueTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateIssueTemplateResponseValidationError) ErrorName() string {
+	return "CreateIssueTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateIssueTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateIssueTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateIssueTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateIssueTemplateResponseValidationError{}
+
+// Validate checks the field values on GetIssueTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIssueTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIssueTemplateRequestMultiError, or nil if none found.
+func (m *GetIssueTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTemplateId()); err != nil {
+		err = GetIssueTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetIssueTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetIssueTemplateRequest) _validateUuid(uuid string) error {
+	if matched := _project_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetIssueTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by GetIssueTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetIssueTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetIssueTemplateRequestValidationError is the validation error returned by
+// GetIssueTemplateRequest.Validate if the designated constraints aren't met.
+type GetIssueTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueTemplateRequestValidationError) ErrorName() string {
+	return "GetIssueTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueTemplateRequestValidationError{}
+
+// Validate checks the field values on GetIssueTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIssueTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIssueTemplateResponseMultiError, or nil if none found.
+func (m *GetIssueTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIssueTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIssueTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIssueTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetIssueTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by GetIssueTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetIssueTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetIssueTemplateResponseValidationError is the validation error returned by
+// GetIssueTemplateResponse.Validate if the designated constraints aren't met.
+type GetIssueTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueTemplateResponseValidationError) ErrorName() string {
+	return "GetIssueTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueTemplateResponseValidationError{}
+
+// Validate checks the field values on ListIssueTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssueTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssueTemplatesRequestMultiError, or nil if none found.
+func (m *ListIssueTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProjectId()); l < 1 || l > 36 {
+		err := ListIssueTemplatesRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListIssueTemplatesRequest_ProjectId_Pattern.MatchString(m.GetProjectId()) {
+		err := ListIssueTemplatesRequestValidationError{
+			field:  "ProjectId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListIssueTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssueTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListIssueTemplatesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListIssueTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueTemplatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListIssueTemplatesRequestValidationError is the validation error returned by
+// ListIssueTemplatesRequest.Validate if the designated constraints aren't met.
+type ListIssueTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueTemplatesRequestValidationError) ErrorName() string {
+	return "ListIssueTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueTemplatesRequestValidationError{}
+
+var _ListIssueTemplatesRequest_ProjectId_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+// Validate checks the field values on ListIssueTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssueTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssueTemplatesResponseMultiError, or nil if none found.
+func (m *ListIssueTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIssueTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIssueTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIssueTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListIssueTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssueTemplatesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListIssueTemplatesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListIssueTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueTemplatesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// ListIssueTemplatesResponseValidationError is the validation error returned
+// by ListIssueTemplatesResponse.Validate if the designated constraints aren't met.
+type ListIssueTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueTemplatesResponseValidationError) ErrorName() string {
+	return "ListIssueTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueTemplatesResponseValidationError{}
+
+// Validate checks the field values on DeleteIssueTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteIssueTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteIssueTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteIssueTemplateRequestMultiError, or nil if none found.
+func (m *DeleteIssueTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteIssueTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTemplateId()); err != nil {
+		err = DeleteIssueTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteIssueTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteIssueTemplateRequest) _validateUuid(uuid string) error {
+	if matched := _project_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteIssueTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteIssueTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteIssueTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteIssueTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteIssueTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteIssueTemplateRequestValidationError is the validation error returned
+// by DeleteIssueTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteIssueTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteIssueTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteIssueTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteIssueTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteIssueTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteIssueTemplateRequestValidationError) ErrorName() string {
+	return "DeleteIssueTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteIssueTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteIssueTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteIssueTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteIssueTemplateRequestValidationError{}
+
+// Validate checks the field values on ProjectMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProjectMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectMember with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProjectMemberMultiError, or
+// nil if none found.
+func (m *ProjectMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return ProjectMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectMemberMultiError is an error wrapping multiple validation errors
+// returned by ProjectMember.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectMemberMultiError) AllErrors() []error { return m }
+
+// ProjectMemberValidationError is the validation error returned by
+// ProjectMember.Validate if the designated constraints aren't met.
+type ProjectMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectMemberValidationError) ErrorName() string { return "ProjectMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectMemberValidationError{}
+
+// Validate checks the field values on SetProjectMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectMemberRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = SetProjectMemberRoleRequestValidationError{
+			field:  "ProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SetProjectMemberRoleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetProjectMemberRoleRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Role_name[int32(m.GetRole())]; !ok {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetProjectMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetProjectMemberRoleRequest) _validateUuid(uuid string) error {
+	if matched := _project_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetProjectMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberRoleRequestValidationError is the validation error returned
+// by SetProjectMemberRoleRequest.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberRoleRequestValidationError{}
+
+var _SetProjectMemberRoleRequest_Role_NotInLookup = map[Role]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SetProjectMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectMemberRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetProjectMemberRoleResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetProjectMemberRoleResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetProjectMemberRoleResponseValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetProjectMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberRoleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberRoleResponseValidationError is the validation error returned
+// by SetProjectMemberRoleResponse.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberRoleResponseValidationError{}
+
+// Validate checks the field values on ListProjectMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersRequestMultiError, or nil if none found.
+func (m *ListProjectMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = ListProjectMembersRequestValidationError{
+			field:  "ProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListProjectMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListProjectMembersRequest) _validateUuid(uuid string) error {
+	if matched := _project_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListProjectMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectMembersRequestValidationError is the validation error returned by
+// ListProjectMembersRequest.Validate if the designated constraints aren't met.
+type ListProjectMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMembersRequestValidationError) ErrorName() string {
+	return "ListProjectMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMembersRequestValidationError{}
+
+// Validate checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersResponseMultiError, or nil if none found.
+func (m *ListProjectMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProjectMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProjectMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMembersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectMembersResponseValidationError is the validation error returned
+// by ListProjectMembersResponse.Validate if the designated constraints aren't met.
+type ListProjectMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMembersResponseValidationError) ErrorName() string {
+	return "ListProjectMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMembersResponseValidationError{}
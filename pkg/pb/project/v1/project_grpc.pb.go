@@ -21,13 +21,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProjectService_CreateProject_FullMethodName          = "/project.v1.ProjectService/CreateProject"
-	ProjectService_GetProject_FullMethodName             = "/project.v1.ProjectService/GetProject"
-	ProjectService_UpdateProject_FullMethodName          = "/project.v1.ProjectService/UpdateProject"
-	ProjectService_DeleteProject_FullMethodName          = "/project.v1.ProjectService/DeleteProject"
-	ProjectService_ListProjects_FullMethodName           = "/project.v1.ProjectService/ListProjects"
-	ProjectService_UpdateProjectWithIssue_FullMethodName = "/project.v1.ProjectService/UpdateProjectWithIssue"
-	ProjectService_StreamProjectUpdates_FullMethodName   = "/project.v1.ProjectService/StreamProjectUpdates"
+	ProjectService_CreateProject_FullMethodName            = "/project.v1.ProjectService/CreateProject"
+	ProjectService_FindOrCreateProject_FullMethodName      = "/project.v1.ProjectService/FindOrCreateProject"
+	ProjectService_GetProject_FullMethodName               = "/project.v1.ProjectService/GetProject"
+	ProjectService_UpdateProject_FullMethodName            = "/project.v1.ProjectService/UpdateProject"
+	ProjectService_DeleteProject_FullMethodName            = "/project.v1.ProjectService/DeleteProject"
+	ProjectService_ListProjects_FullMethodName             = "/project.v1.ProjectService/ListProjects"
+	ProjectService_UpdateProjectWithIssue_FullMethodName   = "/project.v1.ProjectService/UpdateProjectWithIssue"
+	ProjectService_CreateIssueTemplate_FullMethodName      = "/project.v1.ProjectService/CreateIssueTemplate"
+	ProjectService_GetIssueTemplate_FullMethodName         = "/project.v1.ProjectService/GetIssueTemplate"
+	ProjectService_ListIssueTemplates_FullMethodName       = "/project.v1.ProjectService/ListIssueTemplates"
+	ProjectService_DeleteIssueTemplate_FullMethodName      = "/project.v1.ProjectService/DeleteIssueTemplate"
+	ProjectService_SetProjectMemberRole_FullMethodName     = "/project.v1.ProjectService/SetProjectMemberRole"
+	ProjectService_ListProjectMembers_FullMethodName       = "/project.v1.ProjectService/ListProjectMembers"
+	ProjectService_GetProjectUpdateSnapshot_FullMethodName = "/project.v1.ProjectService/GetProjectUpdateSnapshot"
+	ProjectService_StreamProjectUpdates_FullMethodName     = "/project.v1.ProjectService/StreamProjectUpdates"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -35,11 +43,23 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProjectServiceClient interface {
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	// FindOrCreateProject returns the project with the given name, creating it if it doesn't exist
+	FindOrCreateProject(ctx context.Context, in *FindOrCreateProjectRequest, opts ...grpc.CallOption) (*FindOrCreateProjectResponse, error)
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	ListProjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	UpdateProjectWithIssue(ctx context.Context, in *UpdateProjectWithIssueRequest, opts ...grpc.CallOption) (*UpdateProjectWithIssueResponse, error)
+	CreateIssueTemplate(ctx context.Context, in *CreateIssueTemplateRequest, opts ...grpc.CallOption) (*CreateIssueTemplateResponse, error)
+	GetIssueTemplate(ctx context.Context, in *GetIssueTemplateRequest, opts ...grpc.CallOption) (*GetIssueTemplateResponse, error)
+	ListIssueTemplates(ctx context.Context, in *ListIssueTemplatesRequest, opts ...grpc.CallOption) (*ListIssueTemplatesResponse, error)
+	DeleteIssueTemplate(ctx context.Context, in *DeleteIssueTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetProjectMemberRole adds a user to the project's members or changes the user's role
+	SetProjectMemberRole(ctx context.Context, in *SetProjectMemberRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberRoleResponse, error)
+	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
+	// GetProjectUpdateSnapshot returns the project's current state in the shape StreamProjectUpdates
+	// sends, for clients that poll instead of streaming
+	GetProjectUpdateSnapshot(ctx context.Context, in *GetProjectUpdateSnapshotRequest, opts ...grpc.CallOption) (*ProjectUpdateResponse, error)
 	StreamProjectUpdates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ProjectUpdateRequest, ProjectUpdateResponse], error)
 }
 
@@ -61,6 +81,16 @@ func (c *projectServiceClient) CreateProject(ctx context.Context, in *CreateProj
 	return out, nil
 }
 
+func (c *projectServiceClient) FindOrCreateProject(ctx context.Context, in *FindOrCreateProjectRequest, opts ...grpc.CallOption) (*FindOrCreateProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindOrCreateProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_FindOrCreateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProjectResponse)
@@ -91,7 +121,7 @@ func (c *projectServiceClient) DeleteProject(ctx context.Context, in *DeleteProj
 	return out, nil
 }
 
-func (c *projectServiceClient) ListProjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+func (c *projectServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProjectsResponse)
 	err := c.cc.Invoke(ctx, ProjectService_ListProjects_FullMethodName, in, out, cOpts...)
@@ -111,6 +141,76 @@ func (c *projectServiceClient) UpdateProjectWithIssue(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *projectServiceClient) CreateIssueTemplate(ctx context.Context, in *CreateIssueTemplateRequest, opts ...grpc.CallOption) (*CreateIssueTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIssueTemplateResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CreateIssueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetIssueTemplate(ctx context.Context, in *GetIssueTemplateRequest, opts ...grpc.CallOption) (*GetIssueTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueTemplateResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetIssueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListIssueTemplates(ctx context.Context, in *ListIssueTemplatesRequest, opts ...grpc.CallOption) (*ListIssueTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueTemplatesResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListIssueTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteIssueTemplate(ctx context.Context, in *DeleteIssueTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteIssueTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) SetProjectMemberRole(ctx context.Context, in *SetProjectMemberRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProjectMemberRoleResponse)
+	err := c.cc.Invoke(ctx, ProjectService_SetProjectMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectMembersResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListProjectMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProjectUpdateSnapshot(ctx context.Context, in *GetProjectUpdateSnapshotRequest, opts ...grpc.CallOption) (*ProjectUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectUpdateResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetProjectUpdateSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) StreamProjectUpdates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ProjectUpdateRequest, ProjectUpdateResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &ProjectService_ServiceDesc.Streams[0], ProjectService_StreamProjectUpdates_FullMethodName, cOpts...)
@@ -129,11 +229,23 @@ type ProjectService_StreamProjectUpdatesClient = grpc.BidiStreamingClient[Projec
 // for forward compatibility.
 type ProjectServiceServer interface {
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// FindOrCreateProject returns the project with the given name, creating it if it doesn't exist
+	FindOrCreateProject(context.Context, *FindOrCreateProjectRequest) (*FindOrCreateProjectResponse, error)
 	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
-	ListProjects(context.Context, *emptypb.Empty) (*ListProjectsResponse, error)
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	UpdateProjectWithIssue(context.Context, *UpdateProjectWithIssueRequest) (*UpdateProjectWithIssueResponse, error)
+	CreateIssueTemplate(context.Context, *CreateIssueTemplateRequest) (*CreateIssueTemplateResponse, error)
+	GetIssueTemplate(context.Context, *GetIssueTemplateRequest) (*GetIssueTemplateResponse, error)
+	ListIssueTemplates(context.Context, *ListIssueTemplatesRequest) (*ListIssueTemplatesResponse, error)
+	DeleteIssueTemplate(context.Context, *DeleteIssueTemplateRequest) (*emptypb.Empty, error)
+	// SetProjectMemberRole adds a user to the project's members or changes the user's role
+	SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error)
+	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
+	// GetProjectUpdateSnapshot returns the project's current state in the shape StreamProjectUpdates
+	// sends, for clients that poll instead of streaming
+	GetProjectUpdateSnapshot(context.Context, *GetProjectUpdateSnapshotRequest) (*ProjectUpdateResponse, error)
 	StreamProjectUpdates(grpc.BidiStreamingServer[ProjectUpdateRequest, ProjectUpdateResponse]) error
 	mustEmbedUnimplementedProjectServiceServer()
 }
@@ -148,6 +260,9 @@ type UnimplementedProjectServiceServer struct{}
 func (UnimplementedProjectServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
 }
+func (UnimplementedProjectServiceServer) FindOrCreateProject(context.Context, *FindOrCreateProjectRequest) (*FindOrCreateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOrCreateProject not implemented")
+}
 func (UnimplementedProjectServiceServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
 }
@@ -157,12 +272,33 @@ func (UnimplementedProjectServiceServer) UpdateProject(context.Context, *UpdateP
 func (UnimplementedProjectServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
-func (UnimplementedProjectServiceServer) ListProjects(context.Context, *emptypb.Empty) (*ListProjectsResponse, error) {
+func (UnimplementedProjectServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
 func (UnimplementedProjectServiceServer) UpdateProjectWithIssue(context.Context, *UpdateProjectWithIssueRequest) (*UpdateProjectWithIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectWithIssue not implemented")
 }
+func (UnimplementedProjectServiceServer) CreateIssueTemplate(context.Context, *CreateIssueTemplateRequest) (*CreateIssueTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueTemplate not implemented")
+}
+func (UnimplementedProjectServiceServer) GetIssueTemplate(context.Context, *GetIssueTemplateRequest) (*GetIssueTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueTemplate not implemented")
+}
+func (UnimplementedProjectServiceServer) ListIssueTemplates(context.Context, *ListIssueTemplatesRequest) (*ListIssueTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueTemplates not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteIssueTemplate(context.Context, *DeleteIssueTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueTemplate not implemented")
+}
+func (UnimplementedProjectServiceServer) SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMemberRole not implemented")
+}
+func (UnimplementedProjectServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
+}
+func (UnimplementedProjectServiceServer) GetProjectUpdateSnapshot(context.Context, *GetProjectUpdateSnapshotRequest) (*ProjectUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectUpdateSnapshot not implemented")
+}
 func (UnimplementedProjectServiceServer) StreamProjectUpdates(grpc.BidiStreamingServer[ProjectUpdateRequest, ProjectUpdateResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamProjectUpdates not implemented")
 }
@@ -205,6 +341,24 @@ func _ProjectService_CreateProject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_FindOrCreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOrCreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).FindOrCreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_FindOrCreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).FindOrCreateProject(ctx, req.(*FindOrCreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProjectRequest)
 	if err := dec(in); err != nil {
@@ -260,7 +414,7 @@ func _ProjectService_DeleteProject_Handler(srv interface{}, ctx context.Context,
 }
 
 func _ProjectService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -272,7 +426,7 @@ func _ProjectService_ListProjects_Handler(srv interface{}, ctx context.Context,
 		FullMethod: ProjectService_ListProjects_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).ListProjects(ctx, req.(*emptypb.Empty))
+		return srv.(ProjectServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -295,6 +449,132 @@ func _ProjectService_UpdateProjectWithIssue_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_CreateIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateIssueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateIssueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateIssueTemplate(ctx, req.(*CreateIssueTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetIssueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetIssueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetIssueTemplate(ctx, req.(*GetIssueTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListIssueTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListIssueTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListIssueTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListIssueTemplates(ctx, req.(*ListIssueTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteIssueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteIssueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteIssueTemplate(ctx, req.(*DeleteIssueTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_SetProjectMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).SetProjectMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_SetProjectMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).SetProjectMemberRole(ctx, req.(*SetProjectMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjectMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListProjectMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjectMembers(ctx, req.(*ListProjectMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProjectUpdateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectUpdateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectUpdateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProjectUpdateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectUpdateSnapshot(ctx, req.(*GetProjectUpdateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_StreamProjectUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ProjectServiceServer).StreamProjectUpdates(&grpc.GenericServerStream[ProjectUpdateRequest, ProjectUpdateResponse]{ServerStream: stream})
 }
@@ -313,6 +593,10 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateProject",
 			Handler:    _ProjectService_CreateProject_Handler,
 		},
+		{
+			MethodName: "FindOrCreateProject",
+			Handler:    _ProjectService_FindOrCreateProject_Handler,
+		},
 		{
 			MethodName: "GetProject",
 			Handler:    _ProjectService_GetProject_Handler,
@@ -333,6 +617,34 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProjectWithIssue",
 			Handler:    _ProjectService_UpdateProjectWithIssue_Handler,
 		},
+		{
+			MethodName: "CreateIssueTemplate",
+			Handler:    _ProjectService_CreateIssueTemplate_Handler,
+		},
+		{
+			MethodName: "GetIssueTemplate",
+			Handler:    _ProjectService_GetIssueTemplate_Handler,
+		},
+		{
+			MethodName: "ListIssueTemplates",
+			Handler:    _ProjectService_ListIssueTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteIssueTemplate",
+			Handler:    _ProjectService_DeleteIssueTemplate_Handler,
+		},
+		{
+			MethodName: "SetProjectMemberRole",
+			Handler:    _ProjectService_SetProjectMemberRole_Handler,
+		},
+		{
+			MethodName: "ListProjectMembers",
+			Handler:    _ProjectService_ListProjectMembers_Handler,
+		},
+		{
+			MethodName: "GetProjectUpdateSnapshot",
+			Handler:    _ProjectService_GetProjectUpdateSnapshot_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,8 @@
+package teamv1
+
+import _ "embed" // OpenAPIDocument is embedded
+
+// OpenAPIDocument is the OpenAPI (swagger) document of the REST API, generated from team.proto
+//
+//go:embed team.swagger.json
+var OpenAPIDocument []byte
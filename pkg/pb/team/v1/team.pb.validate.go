@@ -0,0 +1,1914 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/pb/team/v1/team.proto
+
+package teamv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _team_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Team with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Team) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Team with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TeamMultiError, or nil if none found.
+func (m *Team) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Team) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	// no validation rules for Name
+
+	// no validation rules for ProjectId
+
+	if len(errors) > 0 {
+		return TeamMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamMultiError is an error wrapping multiple validation errors returned by
+// Team.ValidateAll() if the designated constraints aren't met.
+type TeamMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamMultiError) AllErrors() []error { return m }
+
+// TeamValidationError is the validation error returned by Team.Validate if the
+// designated constraints aren't met.
+type TeamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamValidationError) ErrorName() string { return "TeamValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeam.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamValidationError{}
+
+// Validate checks the field values on CreateTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamRequestMultiError, or nil if none found.
+func (m *CreateTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateTeamRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = CreateTeamRequestValidationError{
+			field:  "ProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMemberIds()) > 100 {
+		err := CreateTeamRequestValidationError{
+			field:  "MemberIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateTeamRequest_MemberIds_Unique := make(map[string]struct{}, len(m.GetMemberIds()))
+
+	for idx, item := range m.GetMemberIds() {
+		_, _ = idx, item
+
+		if _, exists := _CreateTeamRequest_MemberIds_Unique[item]; exists {
+			err := CreateTeamRequestValidationError{
+				field:  fmt.Sprintf("MemberIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateTeamRequest_MemberIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = CreateTeamRequestValidationError{
+				field:  fmt.Sprintf("MemberIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateTeamRequest) _validateUuid(uuid string) error {
+	if matched := _team_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamRequestMultiError) AllErrors() []error { return m }
+
+// CreateTeamRequestValidationError is the validation error returned by
+// CreateTeamRequest.Validate if the designated constraints aren't met.
+type CreateTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamRequestValidationError) ErrorName() string {
+	return "CreateTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamRequestValidationError{}
+
+// Validate checks the field values on CreateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamResponseMultiError, or nil if none found.
+func (m *CreateTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTeamResponseValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamResponseMultiError) AllErrors() []error { return m }
+
+// CreateTeamResponseValidationError is the validation error returned by
+// CreateTeamResponse.Validate if the designated constraints aren't met.
+type CreateTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamResponseValidationError) ErrorName() string {
+	return "CreateTeamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamResponseValidationError{}
+
+// Validate checks the field values on GetTeamRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTeamRequestMultiError,
+// or nil if none found.
+func (m *GetTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTeamId()); err != nil {
+		err = GetTeamRequestValidationError{
+			field:  "TeamId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetTeamRequest) _validateUuid(uuid string) error {
+	if matched := _team_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTeamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTeamRequestMultiError) AllErrors() []error { return m }
+
+// GetTeamRequestValidationError is the validation error returned by
+// GetTeamRequest.Validate if the designated constraints aren't met.
+type GetTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTeamRequestValidationError) ErrorName() string { return "GetTeamRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTeamRequestValidationError{}
+
+// Validate checks the field values on GetTeamResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTeamResponseMultiError, or nil if none found.
+func (m *GetTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTeamResponseValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTeamResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTeamResponseMultiError) AllErrors() []error { return m }
+
+// GetTeamResponseValidationError is the validation error returned by
+// GetTeamResponse.Validate if the designated constraints aren't met.
+type GetTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTeamResponseValidationError) ErrorName() string { return "GetTeamResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTeamResponseValidationError{}
+
+// Validate checks the field values on UpdateTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTeamRequestMultiError, or nil if none found.
+func (m *UpdateTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTeamId()); err != nil {
+		err = UpdateTeamRequestValidationError{
+			field:  "TeamId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UpdateTeamRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMemberIds()) > 100 {
+		err := UpdateTeamRequestValidationError{
+			field:  "MemberIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_UpdateTeamRequest_MemberIds_Unique := make(map[string]struct{}, len(m.GetMemberIds()))
+
+	for idx, item := range m.GetMemberIds() {
+		_, _ = idx, item
+
+		if _, exists := _UpdateTeamRequest_MemberIds_Unique[item]; exists {
+			err := UpdateTeamRequestValidationError{
+				field:  fmt.Sprintf("MemberIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_UpdateTeamRequest_MemberIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = UpdateTeamRequestValidationError{
+				field:  fmt.Sprintf("MemberIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateTeamRequest) _validateUuid(uuid string) error {
+	if matched := _team_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTeamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTeamRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTeamRequestValidationError is the validation error returned by
+// UpdateTeamRequest.Validate if the designated constraints aren't met.
+type UpdateTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTeamRequestValidationError) ErrorName() string {
+	return "UpdateTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTeamRequestValidationError{}
+
+// Validate checks the field values on UpdateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTeamResponseMultiError, or nil if none found.
+func (m *UpdateTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTeamResponseValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTeamResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTeamResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTeamResponseValidationError is the validation error returned by
+// UpdateTeamResponse.Validate if the designated constraints aren't met.
+type UpdateTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTeamResponseValidationError) ErrorName() string {
+	return "UpdateTeamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTeamResponseValidationError{}
+
+// Validate checks the field values on DeleteTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTeamRequestMultiError, or nil if none found.
+func (m *DeleteTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTeamId()); err != nil {
+		err = DeleteTeamRequestValidationError{
+			field:  "TeamId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteTeamRequest) _validateUuid(uuid string) error {
+	if matched := _team_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTeamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTeamRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTeamRequestValidationError is the validation error returned by
+// DeleteTeamRequest.Validate if the designated constraints aren't met.
+type DeleteTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTeamRequestValidationError) ErrorName() string {
+	return "DeleteTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTeamRequestValidationError{}
+
+// Validate checks the field values on ListTeamsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamsRequestMultiError, or nil if none found.
+func (m *ListTeamsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProjectId()); err != nil {
+		err = ListTeamsRequestValidationError{
+			field:  "ProjectId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTeamsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListTeamsRequest) _validateUuid(uuid string) error {
+	if matched := _team_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListTeamsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTeamsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamsRequestMultiError) AllErrors() []error { return m }
+
+// ListTeamsRequestValidationError is the validation error returned by
+// ListTeamsRequest.Validate if the designated constraints aren't met.
+type ListTeamsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamsRequestValidationError) ErrorName() string { return "ListTeamsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTeamsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamsRequestValidationError{}
+
+// Validate checks the field values on ListTeamsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamsResponseMultiError, or nil if none found.
+func (m *ListTeamsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTeamsResponseValidationError{
+					field:  fmt.Sprintf("Teams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTeamsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTeamsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamsResponseMultiError) AllErrors() []error { return m }
+
+// ListTeamsResponseValidationError is the validation error returned by
+// ListTeamsResponse.Validate if the designated constraints aren't met.
+type ListTeamsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamsResponseValidationError) ErrorName() string {
+	return "ListTeamsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamsResponseValidationError{}
+
+// Validate checks the field values on AddTeamMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTeamMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTeamMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTeamMemberRequestMultiError, or nil if none found.
+func (m *AddTeamMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTeamMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTeamId()); err != nil {
+		err = AddTeamMemberRequestValidationError{
+			field:  "TeamId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AddTeamMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddTeamMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddTeamMemberRequest) _validateUuid(uuid string) error {
+	if matched := _team_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddTeamMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddTeamMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddTeamMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTeamMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTeamMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddTeamMemberRequestValidationError is the validation error returned by
+// AddTeamMemberRequest.Validate if the designated constraints aren't met.
+type AddTeamMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTeamMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTeamMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTeamMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTeamMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTeamMemberRequestValidationError) ErrorName() string {
+	return "AddTeamMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTeamMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTeamMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTeamMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTeamMemberRequestValidationError{}
+
+// Validate checks the field values on AddTeamMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTeamMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTeamMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTeamMemberResponseMultiError, or nil if none found.
+func (m *AddTeamMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTeamMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddTeamMemberResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddTeamMemberResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddTeamMemberResponseValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddTeamMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTeamMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by AddTeamMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddTeamMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTeamMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTeamMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddTeamMemberResponseValidationError is the validation error returned by
+// AddTeamMemberResponse.Validate if the designated constraints aren't met.
+type AddTeamMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTeamMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTeamMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTeamMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTeamMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTeamMemberResponseValidationError) ErrorName() string {
+	return "AddTeamMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTeamMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTeamMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTeamMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTeamMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveTeamMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveTeamMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTeamMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveTeamMemberRequestMultiError, or nil if none found.
+func (m *RemoveTeamMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTeamMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTeamId()); err != nil {
+		err = RemoveTeamMemberRequestValidationError{
+			field:  "TeamId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveTeamMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveTeamMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveTeamMemberRequest) _validateUuid(uuid string) error {
+	if matched := _team_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveTeamMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveTeamMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveTeamMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTeamMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTeamMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveTeamMemberRequestValidationError is the validation error returned by
+// RemoveTeamMemberRequest.Validate if the designated constraints aren't met.
+type RemoveTeamMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTeamMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTeamMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTeamMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTeamMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTeamMemberRequestValidationError) ErrorName() string {
+	return "RemoveTeamMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveTeamMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTeamMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTeamMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTeamMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveTeamMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveTeamMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTeamMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveTeamMemberResponseMultiError, or nil if none found.
+func (m *RemoveTeamMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTeamMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveTeamMemberResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveTeamMemberResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveTeamMemberResponseValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveTeamMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveTeamMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveTeamMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveTeamMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTeamMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTeamMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveTeamMemberResponseValidationError is the validation error returned by
+// RemoveTeamMemberResponse.Validate if the designated constraints aren't met.
+type RemoveTeamMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTeamMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTeamMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTeamMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTeamMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTeamMemberResponseValidationError) ErrorName() string {
+	return "RemoveTeamMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveTeamMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTeamMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTeamMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTeamMemberResponseValidationError{}
@@ -0,0 +1,8 @@
+package userv1
+
+import _ "embed" // OpenAPIDocument is embedded
+
+// OpenAPIDocument is the OpenAPI (swagger) document of the REST API, generated from user.proto
+//
+//go:embed user.swagger.json
+var OpenAPIDocument []byte
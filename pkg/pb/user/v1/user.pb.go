@@ -15,6 +15,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -24,12 +25,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// UserOrder is the order ListUsers returns users in
+type UserOrder int32
+
+const (
+	UserOrder_USER_ORDER_UNSPECIFIED UserOrder = 0 // by creation time, then user ID; by user ID with the in-memory database
+	UserOrder_LAST_NAME              UserOrder = 1 // by last name, then user ID
+)
+
+// Enum value maps for UserOrder.
+var (
+	UserOrder_name = map[int32]string{
+		0: "USER_ORDER_UNSPECIFIED",
+		1: "LAST_NAME",
+	}
+	UserOrder_value = map[string]int32{
+		"USER_ORDER_UNSPECIFIED": 0,
+		"LAST_NAME":              1,
+	}
+)
+
+func (x UserOrder) Enum() *UserOrder {
+	p := new(UserOrder)
+	*p = x
+	return p
+}
+
+func (x UserOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_user_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (UserOrder) Type() protoreflect.EnumType {
+	return &file_pkg_pb_user_v1_user_proto_enumTypes[0]
+}
+
+func (x UserOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserOrder.Descriptor instead.
+func (UserOrder) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
 	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	EmailAddress  string                 `protobuf:"bytes,4,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	LastActiveAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"` // Updated when the user is fetched; set on creation
+	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                                  // false once deactivated; deactivated users can't be assigned issues but can still be fetched
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -92,6 +142,20 @@ func (x *User) GetEmailAddress() string {
 	return ""
 }
 
+func (x *User) GetLastActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return nil
+}
+
+func (x *User) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FirstName     string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
@@ -399,6 +463,7 @@ func (x *UpdateUserResponse) GetUser() *User {
 type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReassignTo    string                 `protobuf:"bytes,2,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"` // active user who takes over the open issues; without it, users with open issues can't be deleted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -440,6 +505,13 @@ func (x *DeleteUserRequest) GetUserId() string {
 	return ""
 }
 
+func (x *DeleteUserRequest) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
 type DeleteUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -485,11 +557,14 @@ func (x *DeleteUserResponse) GetUser() *User {
 }
 
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 lists DEFAULT_PAGE_SIZE items; sizes above MAX_PAGE_SIZE are rejected
+	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	IncludeDeactivated bool                   `protobuf:"varint,3,opt,name=include_deactivated,json=includeDeactivated,proto3" json:"include_deactivated,omitempty"` // deactivated users are left out unless set
+	Filter             string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                                                    // case-insensitive substring of the first name, last name, full name or email address
+	OrderBy            UserOrder              `protobuf:"varint,5,opt,name=order_by,json=orderBy,proto3,enum=user.v1.UserOrder" json:"order_by,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ListUsersRequest) Reset() {
@@ -536,6 +611,27 @@ func (x *ListUsersRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListUsersRequest) GetIncludeDeactivated() bool {
+	if x != nil {
+		return x.IncludeDeactivated
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetOrderBy() UserOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return UserOrder_USER_ORDER_UNSPECIFIED
+}
+
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
@@ -588,17 +684,491 @@ func (x *ListUsersResponse) GetNextPageToken() string {
 	return ""
 }
 
+type BatchGetUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUsersRequest) Reset() {
+	*x = BatchGetUsersRequest{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersRequest) ProtoMessage() {}
+
+func (x *BatchGetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetUsersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type BatchGetUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"` // in the order of the requested IDs
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUsersResponse) Reset() {
+	*x = BatchGetUsersResponse{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersResponse) ProtoMessage() {}
+
+func (x *BatchGetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchGetUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BatchGetUsersResponse) GetNotFoundIds() []string {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+type FindOrCreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmailAddress  string                 `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindOrCreateUserRequest) Reset() {
+	*x = FindOrCreateUserRequest{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindOrCreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrCreateUserRequest) ProtoMessage() {}
+
+func (x *FindOrCreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrCreateUserRequest.ProtoReflect.Descriptor instead.
+func (*FindOrCreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FindOrCreateUserRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *FindOrCreateUserRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *FindOrCreateUserRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type FindOrCreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // false when an existing user with the same email was returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindOrCreateUserResponse) Reset() {
+	*x = FindOrCreateUserResponse{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindOrCreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrCreateUserResponse) ProtoMessage() {}
+
+func (x *FindOrCreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrCreateUserResponse.ProtoReflect.Descriptor instead.
+func (*FindOrCreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FindOrCreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FindOrCreateUserResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type ListInactiveUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InactiveSince *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=inactive_since,json=inactiveSince,proto3" json:"inactive_since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInactiveUsersRequest) Reset() {
+	*x = ListInactiveUsersRequest{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInactiveUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInactiveUsersRequest) ProtoMessage() {}
+
+func (x *ListInactiveUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInactiveUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListInactiveUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListInactiveUsersRequest) GetInactiveSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InactiveSince
+	}
+	return nil
+}
+
+type ListInactiveUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"` // Users last active before inactive_since, least recently active first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInactiveUsersResponse) Reset() {
+	*x = ListInactiveUsersResponse{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInactiveUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInactiveUsersResponse) ProtoMessage() {}
+
+func (x *ListInactiveUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInactiveUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListInactiveUsersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListInactiveUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_user_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_user_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 var File_pkg_pb_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_pkg_pb_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x19pkg/pb/user/v1/user.proto\x12\auser.v1\x1a\x1dproto/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xa9\x01\n" +
+	"\x19pkg/pb/user/v1/user.proto\x12\auser.v1\x1a\x1dproto/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x83\x02\n" +
 	"\x04User\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12(\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
 	"\tlast_name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blastName\x12,\n" +
-	"\remail_address\x18\x04 \x01(\tB\a\xfaB\x04r\x02`\x01R\femailAddress\"\x93\x01\n" +
+	"\remail_address\x18\x04 \x01(\tB\a\xfaB\x04r\x02`\x01R\femailAddress\x12@\n" +
+	"\x0elast_active_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastActiveAt\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\"\x93\x01\n" +
 	"\x11CreateUserRequest\x12(\n" +
 	"\n" +
 	"first_name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
@@ -617,18 +1187,51 @@ const file_pkg_pb_user_v1_user_proto_rawDesc = "" +
 	"\tlast_name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blastName\x12,\n" +
 	"\remail_address\x18\x04 \x01(\tB\a\xfaB\x04r\x02`\x01R\femailAddress\"7\n" +
 	"\x12UpdateUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"6\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"d\n" +
 	"\x11DeleteUserRequest\x12!\n" +
-	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"7\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12,\n" +
+	"\vreassign_to\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\n" +
+	"reassignTo\"7\n" +
 	"\x12DeleteUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"Y\n" +
-	"\x10ListUsersRequest\x12&\n" +
-	"\tpage_size\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\x12\x1d\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\xe2\x01\n" +
+	"\x10ListUsersRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"`\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12/\n" +
+	"\x13include_deactivated\x18\x03 \x01(\bR\x12includeDeactivated\x12\x1f\n" +
+	"\x06filter\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18dR\x06filter\x127\n" +
+	"\border_by\x18\x05 \x01(\x0e2\x12.user.v1.UserOrderB\b\xfaB\x05\x82\x01\x02\x10\x01R\aorderBy\"`\n" +
 	"\x11ListUsersResponse\x12#\n" +
 	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xe7\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"D\n" +
+	"\x14BatchGetUsersRequest\x12,\n" +
+	"\buser_ids\x18\x01 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\auserIds\"`\n" +
+	"\x15BatchGetUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12\"\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\vnotFoundIds\"\x99\x01\n" +
+	"\x17FindOrCreateUserRequest\x12,\n" +
+	"\remail_address\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\femailAddress\x12(\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\tfirstName\x12&\n" +
+	"\tlast_name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\blastName\"W\n" +
+	"\x18FindOrCreateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"g\n" +
+	"\x18ListInactiveUsersRequest\x12K\n" +
+	"\x0einactive_since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\rinactiveSince\"@\n" +
+	"\x19ListInactiveUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\":\n" +
+	"\x15DeactivateUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\";\n" +
+	"\x16DeactivateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\":\n" +
+	"\x15ReactivateUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\";\n" +
+	"\x16ReactivateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user*6\n" +
+	"\tUserOrder\x12\x1a\n" +
+	"\x16USER_ORDER_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tLAST_NAME\x10\x012\xc5\b\n" +
 	"\vUserService\x12[\n" +
 	"\n" +
 	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x1b.user.v1.CreateUserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12Y\n" +
@@ -637,7 +1240,12 @@ const file_pkg_pb_user_v1_user_proto_rawDesc = "" +
 	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x1b.user.v1.UpdateUserResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/users/{user_id}\x12b\n" +
 	"\n" +
 	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/users/{user_id}\x12U\n" +
-	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/usersB\x17Z\x15pkg/pb/user/v1;userv1b\x06proto3"
+	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12j\n" +
+	"\rBatchGetUsers\x12\x1d.user.v1.BatchGetUsersRequest\x1a\x1e.user.v1.BatchGetUsersResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users:batchGet\x12|\n" +
+	"\x10FindOrCreateUser\x12 .user.v1.FindOrCreateUserRequest\x1a!.user.v1.FindOrCreateUserResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/users/find-or-create\x12v\n" +
+	"\x11ListInactiveUsers\x12!.user.v1.ListInactiveUsersRequest\x1a\".user.v1.ListInactiveUsersResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users/inactive\x12|\n" +
+	"\x0eDeactivateUser\x12\x1e.user.v1.DeactivateUserRequest\x1a\x1f.user.v1.DeactivateUserResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/users/{user_id}/deactivate\x12|\n" +
+	"\x0eReactivateUser\x12\x1e.user.v1.ReactivateUserRequest\x1a\x1f.user.v1.ReactivateUserResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/users/{user_id}/reactivateB\x17Z\x15pkg/pb/user/v1;userv1b\x06proto3"
 
 var (
 	file_pkg_pb_user_v1_user_proto_rawDescOnce sync.Once
@@ -651,41 +1259,72 @@ func file_pkg_pb_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_pkg_pb_user_v1_user_proto_rawDescData
 }
 
-var file_pkg_pb_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_pkg_pb_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_pb_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_pkg_pb_user_v1_user_proto_goTypes = []any{
-	(*User)(nil),               // 0: user.v1.User
-	(*CreateUserRequest)(nil),  // 1: user.v1.CreateUserRequest
-	(*CreateUserResponse)(nil), // 2: user.v1.CreateUserResponse
-	(*GetUserRequest)(nil),     // 3: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),    // 4: user.v1.GetUserResponse
-	(*UpdateUserRequest)(nil),  // 5: user.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil), // 6: user.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),  // 7: user.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil), // 8: user.v1.DeleteUserResponse
-	(*ListUsersRequest)(nil),   // 9: user.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),  // 10: user.v1.ListUsersResponse
+	(UserOrder)(0),                    // 0: user.v1.UserOrder
+	(*User)(nil),                      // 1: user.v1.User
+	(*CreateUserRequest)(nil),         // 2: user.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),        // 3: user.v1.CreateUserResponse
+	(*GetUserRequest)(nil),            // 4: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),           // 5: user.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),         // 6: user.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),        // 7: user.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),         // 8: user.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),        // 9: user.v1.DeleteUserResponse
+	(*ListUsersRequest)(nil),          // 10: user.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),         // 11: user.v1.ListUsersResponse
+	(*BatchGetUsersRequest)(nil),      // 12: user.v1.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),     // 13: user.v1.BatchGetUsersResponse
+	(*FindOrCreateUserRequest)(nil),   // 14: user.v1.FindOrCreateUserRequest
+	(*FindOrCreateUserResponse)(nil),  // 15: user.v1.FindOrCreateUserResponse
+	(*ListInactiveUsersRequest)(nil),  // 16: user.v1.ListInactiveUsersRequest
+	(*ListInactiveUsersResponse)(nil), // 17: user.v1.ListInactiveUsersResponse
+	(*DeactivateUserRequest)(nil),     // 18: user.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),    // 19: user.v1.DeactivateUserResponse
+	(*ReactivateUserRequest)(nil),     // 20: user.v1.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),    // 21: user.v1.ReactivateUserResponse
+	(*timestamppb.Timestamp)(nil),     // 22: google.protobuf.Timestamp
 }
 var file_pkg_pb_user_v1_user_proto_depIdxs = []int32{
-	0,  // 0: user.v1.CreateUserResponse.user:type_name -> user.v1.User
-	0,  // 1: user.v1.GetUserResponse.user:type_name -> user.v1.User
-	0,  // 2: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
-	0,  // 3: user.v1.DeleteUserResponse.user:type_name -> user.v1.User
-	0,  // 4: user.v1.ListUsersResponse.users:type_name -> user.v1.User
-	1,  // 5: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
-	3,  // 6: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	5,  // 7: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	7,  // 8: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
-	9,  // 9: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
-	2,  // 10: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
-	4,  // 11: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	6,  // 12: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
-	8,  // 13: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
-	10, // 14: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	22, // 0: user.v1.User.last_active_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: user.v1.CreateUserResponse.user:type_name -> user.v1.User
+	1,  // 2: user.v1.GetUserResponse.user:type_name -> user.v1.User
+	1,  // 3: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
+	1,  // 4: user.v1.DeleteUserResponse.user:type_name -> user.v1.User
+	0,  // 5: user.v1.ListUsersRequest.order_by:type_name -> user.v1.UserOrder
+	1,  // 6: user.v1.ListUsersResponse.users:type_name -> user.v1.User
+	1,  // 7: user.v1.BatchGetUsersResponse.users:type_name -> user.v1.User
+	1,  // 8: user.v1.FindOrCreateUserResponse.user:type_name -> user.v1.User
+	22, // 9: user.v1.ListInactiveUsersRequest.inactive_since:type_name -> google.protobuf.Timestamp
+	1,  // 10: user.v1.ListInactiveUsersResponse.users:type_name -> user.v1.User
+	1,  // 11: user.v1.DeactivateUserResponse.user:type_name -> user.v1.User
+	1,  // 12: user.v1.ReactivateUserResponse.user:type_name -> user.v1.User
+	2,  // 13: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
+	4,  // 14: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	6,  // 15: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	8,  // 16: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
+	10, // 17: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
+	12, // 18: user.v1.UserService.BatchGetUsers:input_type -> user.v1.BatchGetUsersRequest
+	14, // 19: user.v1.UserService.FindOrCreateUser:input_type -> user.v1.FindOrCreateUserRequest
+	16, // 20: user.v1.UserService.ListInactiveUsers:input_type -> user.v1.ListInactiveUsersRequest
+	18, // 21: user.v1.UserService.DeactivateUser:input_type -> user.v1.DeactivateUserRequest
+	20, // 22: user.v1.UserService.ReactivateUser:input_type -> user.v1.ReactivateUserRequest
+	3,  // 23: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
+	5,  // 24: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	7,  // 25: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	9,  // 26: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
+	11, // 27: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
+	13, // 28: user.v1.UserService.BatchGetUsers:output_type -> user.v1.BatchGetUsersResponse
+	15, // 29: user.v1.UserService.FindOrCreateUser:output_type -> user.v1.FindOrCreateUserResponse
+	17, // 30: user.v1.UserService.ListInactiveUsers:output_type -> user.v1.ListInactiveUsersResponse
+	19, // 31: user.v1.UserService.DeactivateUser:output_type -> user.v1.DeactivateUserResponse
+	21, // 32: user.v1.UserService.ReactivateUser:output_type -> user.v1.ReactivateUserResponse
+	23, // [23:33] is the sub-list for method output_type
+	13, // [13:23] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_pkg_pb_user_v1_user_proto_init() }
@@ -698,13 +1337,14 @@ func file_pkg_pb_user_v1_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_pb_user_v1_user_proto_rawDesc), len(file_pkg_pb_user_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_pb_user_v1_user_proto_goTypes,
 		DependencyIndexes: file_pkg_pb_user_v1_user_proto_depIdxs,
+		EnumInfos:         file_pkg_pb_user_v1_user_proto_enumTypes,
 		MessageInfos:      file_pkg_pb_user_v1_user_proto_msgTypes,
 	}.Build()
 	File_pkg_pb_user_v1_user_proto = out.File
@@ -105,6 +105,37 @@ func (m *User) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetLastActiveAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "LastActiveAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "LastActiveAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastActiveAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "LastActiveAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Active
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -1177,6 +1208,22 @@ func (m *DeleteUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetReassignTo() != "" {
+
+		if err := m._validateUuid(m.GetReassignTo()); err != nil {
+			err = DeleteUserRequestValidationError{
+				field:  "ReassignTo",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return DeleteUserRequestMultiError(errors)
 	}
@@ -1418,10 +1465,10 @@ func (m *ListUsersRequest) validate(all bool) error {
 
 	var errors []error
 
-	if val := m.GetPageSize(); val < 1 || val > 100 {
+	if m.GetPageSize() < 0 {
 		err := ListUsersRequestValidationError{
 			field:  "PageSize",
-			reason: "value must be inside range [1, 100]",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -1431,6 +1478,30 @@ func (m *ListUsersRequest) validate(all bool) error {
 
 	// no validation rules for PageToken
 
+	// no validation rules for IncludeDeactivated
+
+	if utf8.RuneCountInString(m.GetFilter()) > 100 {
+		err := ListUsersRequestValidationError{
+			field:  "Filter",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := UserOrder_name[int32(m.GetOrderBy())]; !ok {
+		err := ListUsersRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListUsersRequestMultiError(errors)
 	}
@@ -1646,3 +1717,1351 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListUsersResponseValidationError{}
+
+// Validate checks the field values on BatchGetUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetUsersRequestMultiError, or nil if none found.
+func (m *BatchGetUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIds()); l < 1 || l > 100 {
+		err := BatchGetUsersRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = BatchGetUsersRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BatchGetUsersRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BatchGetUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetUsersRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetUsersRequestValidationError is the validation error returned by
+// BatchGetUsersRequest.Validate if the designated constraints aren't met.
+type BatchGetUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetUsersRequestValidationError) ErrorName() string {
+	return "BatchGetUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetUsersRequestValidationError{}
+
+// Validate checks the field values on BatchGetUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetUsersResponseMultiError, or nil if none found.
+func (m *BatchGetUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetUsersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetUsersResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetUsersResponseValidationError is the validation error returned by
+// BatchGetUsersResponse.Validate if the designated constraints aren't met.
+type BatchGetUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetUsersResponseValidationError) ErrorName() string {
+	return "BatchGetUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetUsersResponseValidationError{}
+
+// Validate checks the field values on FindOrCreateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindOrCreateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindOrCreateUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindOrCreateUserRequestMultiError, or nil if none found.
+func (m *FindOrCreateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindOrCreateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmailAddress()); err != nil {
+		err = FindOrCreateUserRequestValidationError{
+			field:  "EmailAddress",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetFirstName()); l < 1 || l > 50 {
+		err := FindOrCreateUserRequestValidationError{
+			field:  "FirstName",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetLastName()); l < 1 || l > 50 {
+		err := FindOrCreateUserRequestValidationError{
+			field:  "LastName",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FindOrCreateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FindOrCreateUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *FindOrCreateUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// FindOrCreateUserRequestMultiError is an error wrapping multiple validation
+// errors returned by FindOrCreateUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FindOrCreateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindOrCreateUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindOrCreateUserRequestMultiError) AllErrors() []error { return m }
+
+// FindOrCreateUserRequestValidationError is the validation error returned by
+// FindOrCreateUserRequest.Validate if the designated constraints aren't met.
+type FindOrCreateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindOrCreateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindOrCreateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindOrCreateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindOrCreateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindOrCreateUserRequestValidationError) ErrorName() string {
+	return "FindOrCreateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindOrCreateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindOrCreateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindOrCreateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindOrCreateUserRequestValidationError{}
+
+// Validate checks the field values on FindOrCreateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindOrCreateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindOrCreateUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindOrCreateUserResponseMultiError, or nil if none found.
+func (m *FindOrCreateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindOrCreateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FindOrCreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FindOrCreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindOrCreateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return FindOrCreateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindOrCreateUserResponseMultiError is an error wrapping multiple validation
+// errors returned by FindOrCreateUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FindOrCreateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindOrCreateUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindOrCreateUserResponseMultiError) AllErrors() []error { return m }
+
+// FindOrCreateUserResponseValidationError is the validation error returned by
+// FindOrCreateUserResponse.Validate if the designated constraints aren't met.
+type FindOrCreateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindOrCreateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindOrCreateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindOrCreateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindOrCreateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindOrCreateUserResponseValidationError) ErrorName() string {
+	return "FindOrCreateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindOrCreateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindOrCreateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindOrCreateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindOrCreateUserResponseValidationError{}
+
+// Validate checks the field values on ListInactiveUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInactiveUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInactiveUsersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInactiveUsersRequestMultiError, or nil if none found.
+func (m *ListInactiveUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInactiveUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetInactiveSince() == nil {
+		err := ListInactiveUsersRequestValidationError{
+			field:  "InactiveSince",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListInactiveUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInactiveUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInactiveUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInactiveUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInactiveUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInactiveUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListInactiveUsersRequestValidationError is the validation error returned by
+// ListInactiveUsersRequest.Validate if the designated constraints aren't met.
+type ListInactiveUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInactiveUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInactiveUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInactiveUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInactiveUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInactiveUsersRequestValidationError) ErrorName() string {
+	return "ListInactiveUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInactiveUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInactiveUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInactiveUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInactiveUsersRequestValidationError{}
+
+// Validate checks the field values on ListInactiveUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInactiveUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInactiveUsersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInactiveUsersResponseMultiError, or nil if none found.
+func (m *ListInactiveUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInactiveUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInactiveUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInactiveUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInactiveUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListInactiveUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInactiveUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInactiveUsersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListInactiveUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInactiveUsersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInactiveUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListInactiveUsersResponseValidationError is the validation error returned by
+// ListInactiveUsersResponse.Validate if the designated constraints aren't met.
+type ListInactiveUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInactiveUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInactiveUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInactiveUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInactiveUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInactiveUsersResponseValidationError) ErrorName() string {
+	return "ListInactiveUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInactiveUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInactiveUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInactiveUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInactiveUsersResponseValidationError{}
+
+// Validate checks the field values on DeactivateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeactivateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeactivateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeactivateUserRequestMultiError, or nil if none found.
+func (m *DeactivateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeactivateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = DeactivateUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeactivateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeactivateUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeactivateUserRequestMultiError is an error wrapping multiple validation
+// errors returned by DeactivateUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeactivateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeactivateUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeactivateUserRequestMultiError) AllErrors() []error { return m }
+
+// DeactivateUserRequestValidationError is the validation error returned by
+// DeactivateUserRequest.Validate if the designated constraints aren't met.
+type DeactivateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeactivateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeactivateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeactivateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeactivateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeactivateUserRequestValidationError) ErrorName() string {
+	return "DeactivateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeactivateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeactivateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeactivateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeactivateUserRequestValidationError{}
+
+// Validate checks the field values on DeactivateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeactivateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeactivateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeactivateUserResponseMultiError, or nil if none found.
+func (m *DeactivateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeactivateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeactivateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeactivateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeactivateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeactivateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeactivateUserResponseMultiError is an error wrapping multiple validation
+// errors returned by DeactivateUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeactivateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeactivateUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeactivateUserResponseMultiError) AllErrors() []error { return m }
+
+// DeactivateUserResponseValidationError is the validation error returned by
+// DeactivateUserResponse.Validate if the designated constraints aren't met.
+type DeactivateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeactivateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeactivateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeactivateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeactivateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeactivateUserResponseValidationError) ErrorName() string {
+	return "DeactivateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeactivateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeactivateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeactivateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeactivateUserResponseValidationError{}
+
+// Validate checks the field values on ReactivateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReactivateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactivateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReactivateUserRequestMultiError, or nil if none found.
+func (m *ReactivateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactivateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ReactivateUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReactivateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReactivateUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReactivateUserRequestMultiError is an error wrapping multiple validation
+// errors returned by ReactivateUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReactivateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactivateUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactivateUserRequestMultiError) AllErrors() []error { return m }
+
+// ReactivateUserRequestValidationError is the validation error returned by
+// ReactivateUserRequest.Validate if the designated constraints aren't met.
+type ReactivateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactivateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactivateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactivateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactivateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactivateUserRequestValidationError) ErrorName() string {
+	return "ReactivateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReactivateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactivateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactivateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactivateUserRequestValidationError{}
+
+// Validate checks the field values on ReactivateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReactivateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactivateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReactivateUserResponseMultiError, or nil if none found.
+func (m *ReactivateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactivateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReactivateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReactivateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReactivateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReactivateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactivateUserResponseMultiError is an error wrapping multiple validation
+// errors returned by ReactivateUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReactivateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactivateUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactivateUserResponseMultiError) AllErrors() []error { return m }
+
+// ReactivateUserResponseValidationError is the validation error returned by
+// ReactivateUserResponse.Validate if the designated constraints aren't met.
+type ReactivateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactivateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactivateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactivateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactivateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactivateUserResponseValidationError) ErrorName() string {
+	return "ReactivateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReactivateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactivateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactivateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactivateUserResponseValidationError{}
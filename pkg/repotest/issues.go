@@ -0,0 +1,345 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// RunIssuesRepositorySuite checks the behavior every IssuesRepository must share. newRepo is
+// called for each subtest and must return an empty repository.
+func RunIssuesRepositorySuite(t *testing.T, newRepo func(t *testing.T) issuessvc.IssuesRepository) {
+	t.Run("CreateAndRead", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		created := timestamp(time.Now().Add(-48 * time.Hour))
+		resolved := timestamp(time.Now().Add(-time.Hour))
+		issue := &issuesPbv1.Issue{
+			IssueId:           id(1),
+			Summary:           "Crash on start",
+			Description:       "The app *crashes*",
+			Status:            issuesPbv1.Status_RESOLVED,
+			Resolution:        issuesPbv1.Resolution_DUPLICATE,
+			Type:              issuesPbv1.Type_BUG,
+			Priority:          issuesPbv1.Priority_CRITICAL,
+			ProjectId:         id(100),
+			AssigneeId:        id(200),
+			CreateDate:        created,
+			ModifyDate:        resolved,
+			DuplicateOf:       id(2),
+			ResolutionComment: "Reported twice",
+			ReopenCount:       1,
+			LastReopenReason:  "Still crashes",
+			LastReopenedBy:    id(201),
+			ResolveDate:       resolved,
+			ClonedFrom:        id(3),
+			DueDate:           timestamp(time.Now().Add(72 * time.Hour)),
+		}
+		require.NoError(t, repo.CreateIssue(ctx, issue))
+		assert.Equal(t, int64(1), issue.Version, "new issues start at version 1")
+		assert.Equal(t, int32(1), issue.IssueNumber)
+
+		got, err := repo.ReadIssue(ctx, id(1))
+		require.NoError(t, err)
+		assertSameIssue(t, issue, got)
+
+		byNumber, err := repo.ReadIssueByNumber(ctx, id(100), 1)
+		require.NoError(t, err)
+		assert.Equal(t, id(1), byNumber.IssueId)
+	})
+
+	t.Run("Enums", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		// Every defined value of each enum is stored and read back unchanged
+		n := 0
+		create := func(issue *issuesPbv1.Issue) {
+			n++
+			issue.IssueId = id(n)
+			issue.Summary = "Summary"
+			issue.ProjectId = id(100)
+			require.NoError(t, repo.CreateIssue(ctx, issue))
+
+			got, err := repo.ReadIssue(ctx, issue.IssueId)
+			require.NoError(t, err)
+			assert.Equal(t, issue.Status, got.Status)
+			assert.Equal(t, issue.Resolution, got.Resolution)
+			assert.Equal(t, issue.Type, got.Type)
+			assert.Equal(t, issue.Priority, got.Priority)
+		}
+		for value := range issuesPbv1.Status_name {
+			create(&issuesPbv1.Issue{Status: issuesPbv1.Status(value)})
+		}
+		for value := range issuesPbv1.Resolution_name {
+			create(&issuesPbv1.Issue{Resolution: issuesPbv1.Resolution(value)})
+		}
+		for value := range issuesPbv1.Type_name {
+			create(&issuesPbv1.Issue{Type: issuesPbv1.Type(value)})
+		}
+		for value := range issuesPbv1.Priority_name {
+			create(&issuesPbv1.Issue{Priority: issuesPbv1.Priority(value)})
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		_, err := repo.ReadIssue(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrIssueNotFound)
+		_, err = repo.ReadIssueByNumber(ctx, id(100), 1)
+		assert.ErrorIs(t, err, consts.ErrIssueNotFound)
+		assert.ErrorIs(t, repo.UpdateIssue(ctx, &issuesPbv1.Issue{IssueId: id(1), Summary: "Summary", Version: 1}), consts.ErrIssueNotFound)
+		assert.ErrorIs(t, repo.DeleteIssue(ctx, id(1)), consts.ErrIssueNotFound)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		issue := &issuesPbv1.Issue{IssueId: id(1), Summary: "Summary", Status: issuesPbv1.Status_NEW, ProjectId: id(100)}
+		require.NoError(t, repo.CreateIssue(ctx, issue))
+
+		stale := &issuesPbv1.Issue{IssueId: id(1), Summary: "Stale", Status: issuesPbv1.Status_NEW, ProjectId: id(100), Version: issue.Version}
+
+		issue.Summary = "Updated"
+		issue.Status = issuesPbv1.Status_ASSIGNED
+		issue.AssigneeId = id(200)
+		issue.ModifyDate = timestamp(time.Now())
+		require.NoError(t, repo.UpdateIssue(ctx, issue))
+		assert.Equal(t, int64(2), issue.Version)
+
+		got, err := repo.ReadIssue(ctx, id(1))
+		require.NoError(t, err)
+		assert.Equal(t, "Updated", got.Summary)
+		assert.Equal(t, issuesPbv1.Status_ASSIGNED, got.Status)
+		assert.Equal(t, id(200), got.AssigneeId)
+		assert.Equal(t, int64(2), got.Version)
+		assert.Equal(t, int32(1), got.IssueNumber, "updates keep the issue number")
+		assert.NotNil(t, got.ModifyDate)
+
+		// A write based on the version before the update is refused
+		assert.ErrorIs(t, repo.UpdateIssue(ctx, stale), consts.ErrIssueVersionConflict)
+		got, err = repo.ReadIssue(ctx, id(1))
+		require.NoError(t, err)
+		assert.Equal(t, "Updated", got.Summary)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: id(1), Summary: "Summary", ProjectId: id(100)}))
+		require.NoError(t, repo.DeleteIssue(ctx, id(1)))
+
+		_, err := repo.ReadIssue(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrIssueNotFound)
+		assert.ErrorIs(t, repo.DeleteIssue(ctx, id(1)), consts.ErrIssueNotFound)
+
+		// The numbers of deleted issues aren't handed out again
+		issue := &issuesPbv1.Issue{IssueId: id(2), Summary: "Summary", ProjectId: id(100)}
+		require.NoError(t, repo.CreateIssue(ctx, issue))
+		assert.Equal(t, int32(2), issue.IssueNumber)
+	})
+
+	t.Run("ListPagination", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		// Created out of ID order. The order of the list is up to the repository, as the SQL
+		// repositories list by creation date, but every issue is listed once.
+		for _, n := range []int{3, 1, 5, 2, 4} {
+			require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: id(n), Summary: "Summary", ProjectId: id(100)}))
+		}
+
+		var ids []string
+		var pages int
+		token := ""
+		for {
+			issues, next, err := repo.ListIssues(ctx, token, 2)
+			require.NoError(t, err)
+			pages++
+			for _, issue := range issues {
+				ids = append(ids, issue.IssueId)
+			}
+			if next == "" || pages > 5 {
+				break
+			}
+			token = next
+		}
+		assert.ElementsMatch(t, []string{id(1), id(2), id(3), id(4), id(5)}, ids)
+		assert.Equal(t, 3, pages, "the page token is empty on the last page")
+
+		// A page that ends with the last issue has no next page either
+		_, next, err := repo.ListIssues(ctx, "", 3)
+		require.NoError(t, err)
+		issues, next, err := repo.ListIssues(ctx, next, 2)
+		require.NoError(t, err)
+		assert.Len(t, issues, 2)
+		assert.Empty(t, next)
+
+		// Nor does a page holding exactly the issues left
+		issues, next, err = repo.ListIssues(ctx, "", 5)
+		require.NoError(t, err)
+		assert.Len(t, issues, 5)
+		assert.Empty(t, next)
+	})
+
+	t.Run("ListByProject", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		for _, n := range []int{3, 1, 5, 2, 4} {
+			require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: id(n), Summary: "Summary", ProjectId: id(100 + n%2)}))
+		}
+
+		var ids []string
+		var pages int
+		token := ""
+		for {
+			issues, next, err := repo.ListIssuesByProject(ctx, id(101), token, 2)
+			require.NoError(t, err)
+			pages++
+			for _, issue := range issues {
+				assert.Equal(t, id(101), issue.ProjectId)
+				ids = append(ids, issue.IssueId)
+			}
+			if next == "" || pages > 5 {
+				break
+			}
+			token = next
+		}
+		assert.ElementsMatch(t, []string{id(1), id(3), id(5)}, ids, "only the project's issues are listed")
+		assert.Equal(t, 2, pages)
+
+		// The other project's two issues fill a page of two, which is also its last
+		issues, next, err := repo.ListIssuesByProject(ctx, id(100), "", 2)
+		require.NoError(t, err)
+		assert.Len(t, issues, 2)
+		assert.Empty(t, next)
+
+		issues, next, err = repo.ListIssuesByProject(ctx, id(102), "", 2)
+		require.NoError(t, err)
+		assert.Empty(t, issues)
+		assert.Empty(t, next)
+	})
+
+	t.Run("BatchGet", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		for n := 1; n <= 3; n++ {
+			require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: id(n), Summary: "Summary", ProjectId: id(100)}))
+		}
+
+		issues, err := repo.BatchGetIssues(ctx, []string{id(3), id(9), id(1)})
+		require.NoError(t, err)
+		ids := make([]string, len(issues))
+		for i, issue := range issues {
+			ids[i] = issue.IssueId
+		}
+		assert.Equal(t, []string{id(3), id(1)}, ids, "issues come in the order asked for; missing IDs are skipped")
+	})
+
+	t.Run("DueSoon", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		dueIn := func(d time.Duration) *timestamppb.Timestamp { return timestamp(time.Now().Add(d)) }
+		issues := []*issuesPbv1.Issue{
+			{IssueId: id(1), AssigneeId: id(200), Status: issuesPbv1.Status_ASSIGNED, DueDate: dueIn(20 * time.Hour)},
+			{IssueId: id(2), AssigneeId: id(200), Status: issuesPbv1.Status_IN_PROGRESS, DueDate: dueIn(2 * time.Hour)},
+			// Due later than the lookahead
+			{IssueId: id(3), AssigneeId: id(200), Status: issuesPbv1.Status_ASSIGNED, DueDate: dueIn(48 * time.Hour)},
+			// Already overdue
+			{IssueId: id(4), AssigneeId: id(200), Status: issuesPbv1.Status_ASSIGNED, DueDate: dueIn(-time.Hour)},
+			// Unassigned
+			{IssueId: id(5), Status: issuesPbv1.Status_NEW, DueDate: dueIn(time.Hour)},
+			// Resolved
+			{IssueId: id(6), AssigneeId: id(200), Status: issuesPbv1.Status_RESOLVED, DueDate: dueIn(time.Hour)},
+			// No due date
+			{IssueId: id(7), AssigneeId: id(200), Status: issuesPbv1.Status_ASSIGNED},
+		}
+		for _, issue := range issues {
+			issue.Summary = "Summary"
+			issue.ProjectId = id(100)
+			require.NoError(t, repo.CreateIssue(ctx, issue))
+		}
+
+		due, err := repo.FindIssuesDueSoon(ctx, 24*time.Hour)
+		require.NoError(t, err)
+		ids := make([]string, len(due))
+		for i, issue := range due {
+			ids[i] = issue.IssueId
+		}
+		assert.Equal(t, []string{id(2), id(1)}, ids, "open assigned issues due within the lookahead, soonest first")
+	})
+
+	t.Run("StatusTransitions", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		allowed := map[issuesPbv1.Status][]issuesPbv1.Status{
+			issuesPbv1.Status_NEW:         {issuesPbv1.Status_ASSIGNED},
+			issuesPbv1.Status_ASSIGNED:    {issuesPbv1.Status_IN_PROGRESS, issuesPbv1.Status_RESOLVED},
+			issuesPbv1.Status_IN_PROGRESS: {issuesPbv1.Status_RESOLVED, issuesPbv1.Status_CLOSED},
+			issuesPbv1.Status_RESOLVED:    {issuesPbv1.Status_CLOSED},
+			issuesPbv1.Status_CLOSED:      {},
+		}
+		for from, to := range allowed {
+			for value := range issuesPbv1.Status_name {
+				next := issuesPbv1.Status(value)
+				err := repo.IsValidStatusTransition(ctx, from, next)
+				if next == from || containsStatus(to, next) {
+					assert.NoError(t, err, "%s -> %s", from, next)
+				} else {
+					assert.Error(t, err, "%s -> %s", from, next)
+				}
+			}
+		}
+	})
+}
+
+func containsStatus(statuses []issuesPbv1.Status, status issuesPbv1.Status) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func assertSameIssue(t *testing.T, expected, actual *issuesPbv1.Issue) {
+	t.Helper()
+	assert.Equal(t, expected.IssueId, actual.IssueId)
+	assert.Equal(t, expected.Summary, actual.Summary)
+	assert.Equal(t, expected.Description, actual.Description)
+	assert.Equal(t, expected.Status, actual.Status)
+	assert.Equal(t, expected.Resolution, actual.Resolution)
+	assert.Equal(t, expected.Type, actual.Type)
+	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.ProjectId, actual.ProjectId)
+	assert.Equal(t, expected.AssigneeId, actual.AssigneeId)
+	assert.Equal(t, expected.DuplicateOf, actual.DuplicateOf)
+	assert.Equal(t, expected.ClonedFrom, actual.ClonedFrom)
+	assert.Equal(t, expected.ResolutionComment, actual.ResolutionComment)
+	assert.Equal(t, expected.ReopenCount, actual.ReopenCount)
+	assert.Equal(t, expected.LastReopenReason, actual.LastReopenReason)
+	assert.Equal(t, expected.LastReopenedBy, actual.LastReopenedBy)
+	assert.Equal(t, expected.Version, actual.Version)
+	assert.Equal(t, expected.IssueNumber, actual.IssueNumber)
+	assertSameTime(t, expected.CreateDate, actual.CreateDate, "create_date")
+	assertSameTime(t, expected.ModifyDate, actual.ModifyDate, "modify_date")
+	assertSameTime(t, expected.ResolveDate, actual.ResolveDate, "resolve_date")
+	assertSameTime(t, expected.DueDate, actual.DueDate, "due_date")
+}
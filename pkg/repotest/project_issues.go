@@ -0,0 +1,57 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+// RunProjectIssueCountSuite checks that an IssuesRepository counts a project's issues, which
+// max_issues is enforced against, from the issues the project repository associates with the
+// project. newRepos is called for each subtest and must return empty repositories of the same
+// backend, with the issues repository able to read and move the project repository's projects.
+func RunProjectIssueCountSuite(t *testing.T, newRepos func(t *testing.T) (issuessvc.IssuesRepository, projectsvc.ProjectRepository)) {
+	t.Run("CountsProjectIssues", func(t *testing.T) {
+		issues, projects := newRepos(t)
+		ctx := context.Background()
+
+		require.NoError(t, projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(100), Name: "Capped", MaxIssues: 2}))
+		require.NoError(t, projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(101), Name: "Other"}))
+		// The issues service creates an issue, then has the project service add it to its project
+		for n := 1; n <= 2; n++ {
+			require.NoError(t, issues.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: id(n), Summary: "Issue", ProjectId: id(100)}))
+			require.NoError(t, projects.AddIssueToProject(ctx, id(100), id(n)))
+		}
+		assertProjectIssueCount := func(projectID string, expected int32) {
+			t.Helper()
+			count, err := issues.GetProjectIssueCount(ctx, projectID)
+			require.NoError(t, err)
+			assert.Equal(t, expected, count, "issues counted for project %s", projectID)
+			project, err := projects.ReadProject(ctx, projectID)
+			require.NoError(t, err)
+			assert.Equal(t, project.IssueCount, count, "the count matches the project's issue_count")
+		}
+
+		maxIssues, err := issues.GetProjectMaxIssues(ctx, id(100))
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), maxIssues)
+		assertProjectIssueCount(id(100), 2)
+		assertProjectIssueCount(id(101), 0)
+
+		_, err = issues.MoveIssue(ctx, id(1), id(100), id(101))
+		require.NoError(t, err)
+		assertProjectIssueCount(id(100), 1)
+		assertProjectIssueCount(id(101), 1)
+
+		// Deleting an issue leaves its project association, and so the project's count, as it was
+		require.NoError(t, issues.DeleteIssue(ctx, id(2)))
+		assertProjectIssueCount(id(100), 1)
+	})
+}
@@ -0,0 +1,238 @@
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+// RunProjectRepositorySuite checks the behavior every ProjectRepository must share. newRepo is
+// called for each subtest and must return an empty repository.
+func RunProjectRepositorySuite(t *testing.T, newRepo func(t *testing.T) projectsvc.ProjectRepository) {
+	t.Run("CreateAndRead", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		project := &projectPbv1.Project{ProjectId: id(1), Name: "Tracker", Description: "Issue tracking", IssueCount: 3}
+		require.NoError(t, repo.CreateProject(ctx, project))
+
+		got, err := repo.ReadProject(ctx, id(1))
+		require.NoError(t, err)
+		assertSameProject(t, project, got)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		_, err := repo.ReadProject(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrProjectNotFound)
+		assert.ErrorIs(t, repo.UpdateProject(ctx, &projectPbv1.Project{ProjectId: id(1), Name: "Tracker"}), consts.ErrProjectNotFound)
+		assert.ErrorIs(t, repo.DeleteProject(ctx, id(1)), consts.ErrProjectNotFound)
+		assert.ErrorIs(t, repo.CreateIssueTemplate(ctx, &projectPbv1.IssueTemplate{TemplateId: id(2), ProjectId: id(1), Name: "Bug"}), consts.ErrProjectNotFound)
+
+		_, err = repo.ReadIssueTemplate(ctx, id(2))
+		assert.ErrorIs(t, err, consts.ErrTemplateNotFound)
+		assert.ErrorIs(t, repo.DeleteIssueTemplate(ctx, id(2)), consts.ErrTemplateNotFound)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(1), Name: "Tracker"}))
+		updated := &projectPbv1.Project{ProjectId: id(1), Name: "Issue Tracker", Description: "Tracks issues", IssueCount: 2}
+		require.NoError(t, repo.UpdateProject(ctx, updated))
+
+		got, err := repo.ReadProject(ctx, id(1))
+		require.NoError(t, err)
+		assertSameProject(t, updated, got)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(1), Name: "Tracker"}))
+		require.NoError(t, repo.CreateIssueTemplate(ctx, &projectPbv1.IssueTemplate{TemplateId: id(2), ProjectId: id(1), Name: "Bug"}))
+		require.NoError(t, repo.DeleteProject(ctx, id(1)))
+
+		_, err := repo.ReadProject(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrProjectNotFound)
+
+		// The project's templates go with it
+		templates, err := repo.ListIssueTemplates(ctx, id(1))
+		require.NoError(t, err)
+		assert.Empty(t, templates)
+		_, err = repo.ReadIssueTemplate(ctx, id(2))
+		assert.ErrorIs(t, err, consts.ErrTemplateNotFound)
+	})
+
+	t.Run("UniqueNames", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(1), Name: "Tracker"}))
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(2), Name: "Other"}))
+		err := repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(3), Name: "Tracker"})
+		assert.ErrorIs(t, err, consts.ErrProjectNameAlreadyExists)
+
+		// Renaming to a taken name is refused; keeping the project's own name isn't
+		err = repo.UpdateProject(ctx, &projectPbv1.Project{ProjectId: id(2), Name: "Tracker"})
+		assert.ErrorIs(t, err, consts.ErrProjectNameAlreadyExists)
+		require.NoError(t, repo.UpdateProject(ctx, &projectPbv1.Project{ProjectId: id(1), Name: "Tracker", Description: "Renamed nothing"}))
+
+		found, created, err := repo.FindOrCreateProject(ctx, &projectPbv1.Project{ProjectId: id(3), Name: "Tracker", Description: "Ignored"})
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, id(1), found.ProjectId)
+		assert.Equal(t, "Renamed nothing", found.Description)
+
+		found, created, err = repo.FindOrCreateProject(ctx, &projectPbv1.Project{ProjectId: id(3), Name: "New", Description: "Created", MaxIssues: 5})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assertSameProject(t, &projectPbv1.Project{ProjectId: id(3), Name: "New", Description: "Created", MaxIssues: 5}, found)
+
+		read, err := repo.ReadProject(ctx, id(3))
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), read.MaxIssues)
+
+		// A deleted project's name can be used again
+		require.NoError(t, repo.DeleteProject(ctx, id(1)))
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(4), Name: "Tracker"}))
+	})
+
+	t.Run("ListPagination", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		// Created out of order; pages list projects by ID
+		for _, n := range []int{3, 1, 5, 2, 4} {
+			require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(n), Name: fmt.Sprintf("Project %d", n)}))
+		}
+
+		all, err := repo.ListProjects(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 5)
+
+		var ids []string
+		var pages int
+		token := ""
+		for {
+			projects, next, err := repo.ListProjectsPage(ctx, token, 2)
+			require.NoError(t, err)
+			pages++
+			for _, project := range projects {
+				ids = append(ids, project.ProjectId)
+			}
+			if next == "" || pages > 5 {
+				break
+			}
+			token = next
+		}
+		assert.Equal(t, []string{id(1), id(2), id(3), id(4), id(5)}, ids)
+		assert.Equal(t, 3, pages, "the page token is empty on the last page")
+
+		// A page holding exactly the projects left is the last page as well
+		_, next, err := repo.ListProjectsPage(ctx, "", 3)
+		require.NoError(t, err)
+		projects, next, err := repo.ListProjectsPage(ctx, next, 2)
+		require.NoError(t, err)
+		assert.Len(t, projects, 2)
+		assert.Empty(t, next)
+
+		// Project IDs were page tokens before tokens were typed; they fail instead of starting over
+		_, _, err = repo.ListProjectsPage(ctx, id(2), 2)
+		assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+	})
+
+	t.Run("IssueTemplates", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(1), Name: "Tracker"}))
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(2), Name: "Other"}))
+
+		template := &projectPbv1.IssueTemplate{
+			TemplateId:          id(10),
+			ProjectId:           id(1),
+			Name:                "Bug report",
+			DefaultType:         "BUG",
+			DefaultPriority:     "MAJOR",
+			SummaryPrefix:       "[BUG]",
+			DescriptionTemplate: "Steps to reproduce:",
+		}
+		require.NoError(t, repo.CreateIssueTemplate(ctx, template))
+		require.NoError(t, repo.CreateIssueTemplate(ctx, &projectPbv1.IssueTemplate{TemplateId: id(11), ProjectId: id(2), Name: "Feature"}))
+
+		got, err := repo.ReadIssueTemplate(ctx, id(10))
+		require.NoError(t, err)
+		assert.Equal(t, template.TemplateId, got.TemplateId)
+		assert.Equal(t, template.ProjectId, got.ProjectId)
+		assert.Equal(t, template.Name, got.Name)
+		assert.Equal(t, template.DefaultType, got.DefaultType)
+		assert.Equal(t, template.DefaultPriority, got.DefaultPriority)
+		assert.Equal(t, template.SummaryPrefix, got.SummaryPrefix)
+		assert.Equal(t, template.DescriptionTemplate, got.DescriptionTemplate)
+
+		templates, err := repo.ListIssueTemplates(ctx, id(1))
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+		assert.Equal(t, id(10), templates[0].TemplateId)
+
+		require.NoError(t, repo.DeleteIssueTemplate(ctx, id(10)))
+		templates, err = repo.ListIssueTemplates(ctx, id(1))
+		require.NoError(t, err)
+		assert.Empty(t, templates)
+	})
+
+	t.Run("Members", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateProject(ctx, &projectPbv1.Project{ProjectId: id(1), Name: "Tracker"}))
+		require.NoError(t, repo.SetProjectMember(ctx, &projectPbv1.ProjectMember{ProjectId: id(1), UserId: id(20), Role: projectsvc.RoleViewer}))
+		require.NoError(t, repo.SetProjectMember(ctx, &projectPbv1.ProjectMember{ProjectId: id(1), UserId: id(21), Role: projectsvc.RoleViewer}))
+
+		// Setting a member again replaces the role
+		require.NoError(t, repo.SetProjectMember(ctx, &projectPbv1.ProjectMember{ProjectId: id(1), UserId: id(21), Role: projectsvc.RoleDeveloper}))
+
+		role, err := repo.GetProjectMemberRole(ctx, id(1), id(21))
+		require.NoError(t, err)
+		assert.Equal(t, projectsvc.RoleDeveloper, role)
+		_, err = repo.GetProjectMemberRole(ctx, id(1), id(22))
+		assert.ErrorIs(t, err, consts.ErrProjectMemberNotFound)
+
+		members, err := repo.ListProjectMembers(ctx, id(1))
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		assert.Equal(t, id(20), members[0].UserId)
+		assert.Equal(t, projectsvc.RoleViewer, members[0].Role)
+		assert.Equal(t, id(21), members[1].UserId)
+		assert.Equal(t, projectsvc.RoleDeveloper, members[1].Role)
+
+		err = repo.SetProjectMember(ctx, &projectPbv1.ProjectMember{ProjectId: id(2), UserId: id(20), Role: projectsvc.RoleViewer})
+		assert.ErrorIs(t, err, consts.ErrProjectNotFound)
+
+		// Deleting the project removes its members
+		require.NoError(t, repo.DeleteProject(ctx, id(1)))
+		_, err = repo.GetProjectMemberRole(ctx, id(1), id(20))
+		assert.ErrorIs(t, err, consts.ErrProjectMemberNotFound)
+	})
+}
+
+func assertSameProject(t *testing.T, expected, actual *projectPbv1.Project) {
+	t.Helper()
+	assert.Equal(t, expected.ProjectId, actual.ProjectId)
+	assert.Equal(t, expected.Name, actual.Name)
+	assert.Equal(t, expected.Description, actual.Description)
+	assert.Equal(t, expected.IssueCount, actual.IssueCount)
+}
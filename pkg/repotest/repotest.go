@@ -0,0 +1,36 @@
+// Package repotest holds conformance suites for the repository interfaces. Each suite takes a
+// constructor for an empty repository and checks the behavior every implementation must share,
+// so the MemDB, SQLite and Postgres repositories are held to the same contract and a new backend
+// is covered by running the suites against it.
+package repotest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// id returns a valid UUID that sorts by n, for the UUID columns of the SQL backends
+func id(n int) string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", n)
+}
+
+// timestamp returns a UTC timestamp at microsecond precision, which every backend stores exactly
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(t.UTC().Truncate(time.Microsecond))
+}
+
+// assertSameTime reports whether two timestamps are the same instant, whatever their time zone
+func assertSameTime(t *testing.T, expected, actual *timestamppb.Timestamp, msgAndArgs ...any) {
+	t.Helper()
+	if expected == nil {
+		assert.Nil(t, actual, msgAndArgs...)
+		return
+	}
+	if assert.NotNil(t, actual, msgAndArgs...) {
+		assert.True(t, expected.AsTime().Equal(actual.AsTime()), append([]any{"expected %s, got %s", expected.AsTime(), actual.AsTime()}, msgAndArgs...)...)
+	}
+}
@@ -0,0 +1,130 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+)
+
+// RunTeamRepositorySuite checks the behavior every TeamRepository must share. newRepo is called
+// for each subtest and must return an empty repository.
+func RunTeamRepositorySuite(t *testing.T, newRepo func(t *testing.T) teamsvc.TeamRepository) {
+	t.Run("CreateAndRead", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		// Members keep the order they were given in, not their ID order
+		team := &teamPbv1.Team{TeamId: id(1), Name: "Triage", ProjectId: id(10), MemberIds: []string{id(22), id(20), id(21)}}
+		require.NoError(t, repo.CreateTeam(ctx, team))
+
+		got, err := repo.ReadTeam(ctx, id(1))
+		require.NoError(t, err)
+		assertSameTeam(t, team, got)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		_, err := repo.ReadTeam(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrTeamNotFound)
+		assert.ErrorIs(t, repo.UpdateTeam(ctx, &teamPbv1.Team{TeamId: id(1), Name: "Triage"}), consts.ErrTeamNotFound)
+		assert.ErrorIs(t, repo.DeleteTeam(ctx, id(1)), consts.ErrTeamNotFound)
+		_, err = repo.AddTeamMember(ctx, id(1), id(20))
+		assert.ErrorIs(t, err, consts.ErrTeamNotFound)
+		_, err = repo.RemoveTeamMember(ctx, id(1), id(20))
+		assert.ErrorIs(t, err, consts.ErrTeamNotFound)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: id(1), Name: "Triage", ProjectId: id(10), MemberIds: []string{id(20), id(21)}}))
+
+		// The name and members are replaced; the project is kept
+		require.NoError(t, repo.UpdateTeam(ctx, &teamPbv1.Team{TeamId: id(1), Name: "Support", MemberIds: []string{id(22), id(20)}}))
+		got, err := repo.ReadTeam(ctx, id(1))
+		require.NoError(t, err)
+		assertSameTeam(t, &teamPbv1.Team{TeamId: id(1), Name: "Support", ProjectId: id(10), MemberIds: []string{id(22), id(20)}}, got)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: id(1), Name: "Triage", ProjectId: id(10), MemberIds: []string{id(20)}}))
+		require.NoError(t, repo.DeleteTeam(ctx, id(1)))
+
+		_, err := repo.ReadTeam(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrTeamNotFound)
+		teams, err := repo.ListTeams(ctx, id(10))
+		require.NoError(t, err)
+		assert.Empty(t, teams)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: id(1), Name: "Triage", ProjectId: id(10), MemberIds: []string{id(21), id(20)}}))
+		require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: id(2), Name: "Backend", ProjectId: id(10)}))
+		require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: id(3), Name: "Elsewhere", ProjectId: id(11)}))
+
+		// A project's teams are listed by name
+		teams, err := repo.ListTeams(ctx, id(10))
+		require.NoError(t, err)
+		require.Len(t, teams, 2)
+		assertSameTeam(t, &teamPbv1.Team{TeamId: id(2), Name: "Backend", ProjectId: id(10)}, teams[0])
+		assertSameTeam(t, &teamPbv1.Team{TeamId: id(1), Name: "Triage", ProjectId: id(10), MemberIds: []string{id(21), id(20)}}, teams[1])
+
+		teams, err = repo.ListTeams(ctx, id(12))
+		require.NoError(t, err)
+		assert.Empty(t, teams)
+	})
+
+	t.Run("Members", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: id(1), Name: "Triage", ProjectId: id(10), MemberIds: []string{id(22)}}))
+
+		// Added members go last, and adding a member again keeps their place
+		for _, userID := range []string{id(20), id(21), id(22)} {
+			_, err := repo.AddTeamMember(ctx, id(1), userID)
+			require.NoError(t, err)
+		}
+		team, err := repo.RemoveTeamMember(ctx, id(1), id(20))
+		require.NoError(t, err)
+		assert.Equal(t, []string{id(22), id(21)}, team.MemberIds)
+		team, err = repo.AddTeamMember(ctx, id(1), id(20))
+		require.NoError(t, err)
+		assert.Equal(t, []string{id(22), id(21), id(20)}, team.MemberIds)
+
+		_, err = repo.RemoveTeamMember(ctx, id(1), id(23))
+		assert.ErrorIs(t, err, consts.ErrTeamMemberNotFound)
+
+		got, err := repo.ReadTeam(ctx, id(1))
+		require.NoError(t, err)
+		assert.Equal(t, []string{id(22), id(21), id(20)}, got.MemberIds)
+	})
+}
+
+// assertSameTeam compares the fields every backend stores; teams without members may come back
+// with a nil or an empty member list
+func assertSameTeam(t *testing.T, expected, actual *teamPbv1.Team) {
+	t.Helper()
+	assert.Equal(t, expected.TeamId, actual.TeamId)
+	assert.Equal(t, expected.Name, actual.Name)
+	assert.Equal(t, expected.ProjectId, actual.ProjectId)
+	assert.ElementsMatch(t, expected.MemberIds, actual.MemberIds)
+	if len(expected.MemberIds) > 0 {
+		assert.Equal(t, expected.MemberIds, actual.MemberIds, "members are kept in order")
+	}
+}
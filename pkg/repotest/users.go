@@ -0,0 +1,304 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// RunUserRepositorySuite checks the behavior every UserRepository must share. newRepo is called
+// for each subtest and must return an empty repository.
+func RunUserRepositorySuite(t *testing.T, newRepo func(t *testing.T) usersvc.UserRepository) {
+	t.Run("CreateAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		user := &userPbv1.User{
+			UserId:       id(1),
+			FirstName:    "Jane",
+			LastName:     "Doe",
+			EmailAddress: "jane@example.com",
+			LastActiveAt: timestamp(time.Now().Add(-time.Hour)),
+			Active:       true,
+		}
+		require.NoError(t, repo.CreateUser(ctx, user))
+
+		byID, err := repo.GetUserByID(ctx, user.UserId)
+		require.NoError(t, err)
+		assertSameUser(t, user, byID)
+
+		byEmail, err := repo.GetUserByEmail(ctx, user.EmailAddress)
+		require.NoError(t, err)
+		assertSameUser(t, user, byEmail)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		_, err := repo.GetUserByID(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrUserNotFound)
+		_, err = repo.GetUserByEmail(ctx, "nobody@example.com")
+		assert.ErrorIs(t, err, consts.ErrUserNotFound)
+		assert.ErrorIs(t, repo.UpdateUser(ctx, &userPbv1.User{UserId: id(1), EmailAddress: "nobody@example.com"}), consts.ErrUserNotFound)
+		assert.ErrorIs(t, repo.DeleteUser(ctx, id(1)), consts.ErrUserNotFound)
+		assert.ErrorIs(t, repo.UpdateLastActive(ctx, id(1), time.Now()), consts.ErrUserNotFound)
+		_, err = repo.SetUserActive(ctx, id(1), false)
+		assert.ErrorIs(t, err, consts.ErrUserNotFound)
+	})
+
+	t.Run("DuplicateEmail", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(1), EmailAddress: "jane@example.com", Active: true}))
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(2), EmailAddress: "john@example.com", Active: true}))
+
+		err := repo.CreateUser(ctx, &userPbv1.User{UserId: id(3), EmailAddress: "jane@example.com", Active: true})
+		assert.ErrorIs(t, err, consts.ErrEmailAlreadyExists)
+		err = repo.UpdateUser(ctx, &userPbv1.User{UserId: id(2), EmailAddress: "jane@example.com"})
+		assert.ErrorIs(t, err, consts.ErrEmailAlreadyExists)
+
+		// The refused update left the user unchanged
+		john, err := repo.GetUserByID(ctx, id(2))
+		require.NoError(t, err)
+		assert.Equal(t, "john@example.com", john.EmailAddress)
+	})
+
+	t.Run("FindOrCreate", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		created, isNew, err := repo.FindOrCreateUser(ctx, &userPbv1.User{UserId: id(1), FirstName: "Jane", EmailAddress: "jane@example.com", Active: true})
+		require.NoError(t, err)
+		assert.True(t, isNew)
+		assert.Equal(t, id(1), created.UserId)
+		assert.True(t, created.Active)
+
+		found, isNew, err := repo.FindOrCreateUser(ctx, &userPbv1.User{UserId: id(2), FirstName: "Janet", EmailAddress: "jane@example.com", Active: true})
+		require.NoError(t, err)
+		assert.False(t, isNew)
+		assert.Equal(t, id(1), found.UserId)
+		assert.Equal(t, "Jane", found.FirstName)
+	})
+
+	t.Run("FindOrCreateListed", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		// Users created either way are paged through in creation order, one per page
+		for _, n := range []int{3, 1, 4, 2} {
+			user := &userPbv1.User{UserId: id(n), EmailAddress: id(n) + "@example.com", Active: true}
+			if n%2 == 0 {
+				require.NoError(t, repo.CreateUser(ctx, user))
+				continue
+			}
+			_, isNew, err := repo.FindOrCreateUser(ctx, user)
+			require.NoError(t, err)
+			require.True(t, isNew)
+		}
+
+		var ids []string
+		token := ""
+		for pages := 0; pages < 10; pages++ {
+			users, next, err := repo.ListUsers(ctx, token, 1, usersvc.ListUsersOptions{})
+			require.NoError(t, err)
+			for _, user := range users {
+				ids = append(ids, user.UserId)
+			}
+			if next == "" {
+				break
+			}
+			token = next
+		}
+		assert.ElementsMatch(t, []string{id(1), id(2), id(3), id(4)}, ids)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		lastActive := timestamp(time.Now().Add(-time.Hour))
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(1), FirstName: "Jane", EmailAddress: "jane@example.com", LastActiveAt: lastActive, Active: true}))
+		_, err := repo.SetUserActive(ctx, id(1), false)
+		require.NoError(t, err)
+
+		// The fields an update doesn't carry are kept and reported back
+		update := &userPbv1.User{UserId: id(1), FirstName: "Janet", LastName: "Doe", EmailAddress: "janet@example.com"}
+		require.NoError(t, repo.UpdateUser(ctx, update))
+		assert.False(t, update.Active)
+		assertSameTime(t, lastActive, update.LastActiveAt)
+
+		got, err := repo.GetUserByID(ctx, id(1))
+		require.NoError(t, err)
+		assertSameUser(t, &userPbv1.User{UserId: id(1), FirstName: "Janet", LastName: "Doe", EmailAddress: "janet@example.com", LastActiveAt: lastActive}, got)
+
+		_, err = repo.GetUserByEmail(ctx, "jane@example.com")
+		assert.ErrorIs(t, err, consts.ErrUserNotFound, "the old email address no longer finds the user")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(1), EmailAddress: "jane@example.com", Active: true}))
+		require.NoError(t, repo.DeleteUser(ctx, id(1)))
+
+		_, err := repo.GetUserByID(ctx, id(1))
+		assert.ErrorIs(t, err, consts.ErrUserNotFound)
+		assert.ErrorIs(t, repo.DeleteUser(ctx, id(1)), consts.ErrUserNotFound)
+	})
+
+	t.Run("ListPagination", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		// Created out of ID order. The order of the list is up to the repository, as the SQL
+		// repositories list by creation time, but every user is listed once.
+		for _, n := range []int{3, 1, 5, 2, 4} {
+			require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(n), EmailAddress: id(n) + "@example.com", Active: true}))
+		}
+		_, err := repo.SetUserActive(ctx, id(3), false)
+		require.NoError(t, err)
+
+		listAll := func(includeDeactivated bool) ([]string, int) {
+			var ids []string
+			var pages int
+			token := ""
+			for {
+				users, next, err := repo.ListUsers(ctx, token, 2, usersvc.ListUsersOptions{IncludeDeactivated: includeDeactivated})
+				require.NoError(t, err)
+				pages++
+				for _, user := range users {
+					ids = append(ids, user.UserId)
+				}
+				if next == "" || pages > 5 {
+					return ids, pages
+				}
+				token = next
+			}
+		}
+
+		ids, pages := listAll(false)
+		assert.ElementsMatch(t, []string{id(1), id(2), id(4), id(5)}, ids)
+		assert.Equal(t, 2, pages, "the page token is empty on the last page, even when it is full")
+
+		ids, pages = listAll(true)
+		assert.ElementsMatch(t, []string{id(1), id(2), id(3), id(4), id(5)}, ids)
+		assert.Equal(t, 3, pages)
+	})
+
+	t.Run("BatchGet", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		for _, n := range []int{1, 2, 3} {
+			require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(n), EmailAddress: id(n) + "@example.com", Active: true}))
+		}
+
+		users, err := repo.BatchGetUsers(ctx, []string{id(3), id(9), id(1)})
+		require.NoError(t, err)
+		ids := make([]string, len(users))
+		for i, user := range users {
+			ids[i] = user.UserId
+		}
+		assert.Equal(t, []string{id(3), id(1)}, ids, "users follow the requested order; missing IDs are skipped")
+	})
+
+	t.Run("FilterAndOrder", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		users := []*userPbv1.User{
+			{UserId: id(4), FirstName: "Ada", LastName: "Smith", EmailAddress: "ada@example.com"},
+			{UserId: id(1), FirstName: "Grace", LastName: "Hopper", EmailAddress: "grace@navy.example.com"},
+			{UserId: id(3), FirstName: "Alan", LastName: "Smith", EmailAddress: "alan@example.com"},
+			{UserId: id(2), FirstName: "Edsger", LastName: "Dijkstra", EmailAddress: "100%dijkstra@example.com"},
+		}
+		for _, user := range users {
+			user.Active = true
+			require.NoError(t, repo.CreateUser(ctx, user))
+		}
+
+		listAll := func(opts usersvc.ListUsersOptions) []string {
+			var ids []string
+			token := ""
+			for pages := 0; pages < 5; pages++ {
+				users, next, err := repo.ListUsers(ctx, token, 1, opts)
+				require.NoError(t, err)
+				for _, user := range users {
+					ids = append(ids, user.UserId)
+				}
+				if next == "" {
+					break
+				}
+				token = next
+			}
+			return ids
+		}
+
+		assert.Equal(t, []string{id(2), id(1), id(3), id(4)}, listAll(usersvc.ListUsersOptions{OrderBy: userPbv1.UserOrder_LAST_NAME}),
+			"ordered by last name, then by ID")
+		assert.Equal(t, []string{id(3), id(4)}, listAll(usersvc.ListUsersOptions{Filter: "SMITH", OrderBy: userPbv1.UserOrder_LAST_NAME}))
+		assert.Equal(t, []string{id(1)}, listAll(usersvc.ListUsersOptions{Filter: "navy"}), "the email address is matched")
+		assert.Equal(t, []string{id(3)}, listAll(usersvc.ListUsersOptions{Filter: "alan smith"}), "the full name is matched")
+		assert.Equal(t, []string{id(2)}, listAll(usersvc.ListUsersOptions{Filter: "0%d"}), "wildcards match literally")
+		assert.Empty(t, listAll(usersvc.ListUsersOptions{Filter: "a_a"}))
+	})
+
+	t.Run("LastActive", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		now := time.Now()
+
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(1), EmailAddress: "recent@example.com", Active: true}))
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(2), EmailAddress: "old@example.com", Active: true}))
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(3), EmailAddress: "older@example.com", Active: true}))
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(4), EmailAddress: "never@example.com", Active: true}))
+
+		require.NoError(t, repo.UpdateLastActive(ctx, id(1), now.Add(-time.Hour)))
+		require.NoError(t, repo.UpdateLastActive(ctx, id(2), now.Add(-48*time.Hour)))
+		require.NoError(t, repo.UpdateLastActive(ctx, id(3), now.Add(-72*time.Hour)))
+
+		inactive, err := repo.ListInactiveUsers(ctx, now.Add(-24*time.Hour))
+		require.NoError(t, err)
+		ids := make([]string, len(inactive))
+		for i, user := range inactive {
+			ids[i] = user.UserId
+		}
+		assert.Equal(t, []string{id(3), id(2)}, ids, "least recently active first; users never active are left out")
+	})
+
+	t.Run("SetUserActive", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: id(1), FirstName: "Jane", EmailAddress: "jane@example.com", Active: true}))
+
+		deactivated, err := repo.SetUserActive(ctx, id(1), false)
+		require.NoError(t, err)
+		assert.False(t, deactivated.Active)
+		assert.Equal(t, "Jane", deactivated.FirstName)
+
+		reactivated, err := repo.SetUserActive(ctx, id(1), true)
+		require.NoError(t, err)
+		assert.True(t, reactivated.Active)
+	})
+}
+
+func assertSameUser(t *testing.T, expected, actual *userPbv1.User) {
+	t.Helper()
+	assert.Equal(t, expected.UserId, actual.UserId)
+	assert.Equal(t, expected.FirstName, actual.FirstName)
+	assert.Equal(t, expected.LastName, actual.LastName)
+	assert.Equal(t, expected.EmailAddress, actual.EmailAddress)
+	assert.Equal(t, expected.Active, actual.Active)
+	assertSameTime(t, expected.LastActiveAt, actual.LastActiveAt, "last_active_at")
+}
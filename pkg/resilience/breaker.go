@@ -0,0 +1,196 @@
+// Package resilience provides helpers that protect the service from slow or failing dependencies.
+// It includes a simple circuit breaker whose state is shared with the health endpoint.
+package resilience
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"go.uber.org/zap"
+)
+
+// ErrCircuitOpen is returned when a call is rejected because the breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// State represents the state of a circuit breaker
+type State string
+
+const (
+	// StateClosed lets every call through
+	StateClosed State = "closed"
+	// StateOpen rejects every call until the cool-down period has elapsed
+	StateOpen State = "open"
+	// StateHalfOpen lets a single trial call through to probe the dependency
+	StateHalfOpen State = "half-open"
+)
+
+// Stats is a snapshot of a circuit breaker used for health reporting
+type Stats struct {
+	State               State `json:"state"`
+	ConsecutiveFailures int   `json:"consecutive_failures"`
+	TotalFailures       int64 `json:"total_failures"`
+	Rejections          int64 `json:"rejections"`
+}
+
+// CircuitBreaker opens after a number of consecutive failures and short-circuits
+// calls for a cool-down period before letting a trial call through again
+type CircuitBreaker struct {
+	name             string
+	failureThreshold int
+	coolDown         time.Duration
+	now              func() time.Time
+
+	mu                  sync.Mutex
+	state               State
+	consecutiveFailures int
+	totalFailures       int64
+	rejections          int64
+	openedAt            time.Time
+	trialInFlight       bool
+}
+
+// NewCircuitBreaker creates a closed circuit breaker
+func NewCircuitBreaker(name string, failureThreshold int, coolDown time.Duration) *CircuitBreaker {
+	if failureThreshold <= 0 {
+		failureThreshold = 1
+	}
+	return &CircuitBreaker{
+		name:             name,
+		failureThreshold: failureThreshold,
+		coolDown:         coolDown,
+		now:              time.Now,
+		state:            StateClosed,
+	}
+}
+
+// WithClock overrides the time source, which is useful in tests
+func (b *CircuitBreaker) WithClock(now func() time.Time) *CircuitBreaker {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.now = now
+	return b
+}
+
+// Name returns the name of the protected dependency
+func (b *CircuitBreaker) Name() string {
+	return b.name
+}
+
+// Allow reports whether a call may proceed. Callers that are allowed through
+// must report the outcome with Success or Failure.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.coolDown {
+			b.rejections++
+			return ErrCircuitOpen
+		}
+		b.transition(StateHalfOpen)
+		b.trialInFlight = true
+		return nil
+	case StateHalfOpen:
+		// Only one trial call at a time while probing the dependency
+		if b.trialInFlight {
+			b.rejections++
+			return ErrCircuitOpen
+		}
+		b.trialInFlight = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Success records a successful call and closes the breaker
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures = 0
+	b.trialInFlight = false
+	if b.state != StateClosed {
+		b.transition(StateClosed)
+	}
+}
+
+// Failure records a failed call and opens the breaker once the threshold is reached
+func (b *CircuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	b.totalFailures++
+	b.trialInFlight = false
+
+	if b.state == StateHalfOpen || b.consecutiveFailures >= b.failureThreshold {
+		b.openedAt = b.now()
+		if b.state != StateOpen {
+			b.transition(StateOpen)
+		}
+	}
+}
+
+// State returns the current state of the breaker
+func (b *CircuitBreaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Stats returns a snapshot of the breaker counters
+func (b *CircuitBreaker) Stats() Stats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Stats{
+		State:               b.state,
+		ConsecutiveFailures: b.consecutiveFailures,
+		TotalFailures:       b.totalFailures,
+		Rejections:          b.rejections,
+	}
+}
+
+// transition changes the state and logs it; the caller must hold the lock
+func (b *CircuitBreaker) transition(to State) {
+	from := b.state
+	b.state = to
+	if logger.ZapLogger != nil {
+		logger.ZapLogger.Warn("Circuit breaker state changed",
+			zap.String("breaker", b.name),
+			zap.String("from", string(from)),
+			zap.String("to", string(to)))
+	}
+}
+
+// Registered breakers are reported by the health endpoint
+var (
+	registry   = make(map[string]*CircuitBreaker)
+	registryMu sync.RWMutex
+)
+
+// Register makes a breaker visible to health reporting, replacing any breaker with the same name
+func Register(b *CircuitBreaker) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[b.name] = b
+}
+
+// Snapshot returns the stats of all registered breakers keyed by name
+func Snapshot() map[string]Stats {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	if len(registry) == 0 {
+		return nil
+	}
+
+	stats := make(map[string]Stats, len(registry))
+	for name, b := range registry {
+		stats[name] = b.Stats()
+	}
+	return stats
+}
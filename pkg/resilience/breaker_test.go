@@ -0,0 +1,78 @@
+package resilience_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestCircuitBreaker_Transitions(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := resilience.NewCircuitBreaker("test", 3, 10*time.Second).WithClock(clock.Now)
+
+	// Closed: failures below the threshold keep the breaker closed
+	for i := 0; i < 2; i++ {
+		require.NoError(t, breaker.Allow())
+		breaker.Failure()
+	}
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+
+	// Reaching the threshold opens the breaker
+	require.NoError(t, breaker.Allow())
+	breaker.Failure()
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+	assert.ErrorIs(t, breaker.Allow(), resilience.ErrCircuitOpen)
+
+	// After the cool-down a single trial call is let through
+	clock.Advance(10 * time.Second)
+	require.NoError(t, breaker.Allow())
+	assert.Equal(t, resilience.StateHalfOpen, breaker.State())
+	assert.ErrorIs(t, breaker.Allow(), resilience.ErrCircuitOpen, "only one trial call while half-open")
+
+	// A failed trial re-opens the breaker for another cool-down
+	breaker.Failure()
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+	assert.ErrorIs(t, breaker.Allow(), resilience.ErrCircuitOpen)
+
+	// A successful trial closes it again
+	clock.Advance(10 * time.Second)
+	require.NoError(t, breaker.Allow())
+	breaker.Success()
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+
+	stats := breaker.Stats()
+	assert.Equal(t, 0, stats.ConsecutiveFailures)
+	assert.Equal(t, int64(4), stats.TotalFailures)
+	assert.Equal(t, int64(3), stats.Rejections)
+}
+
+func TestCircuitBreaker_SuccessResetsFailureCount(t *testing.T) {
+	breaker := resilience.NewCircuitBreaker("test", 2, time.Minute)
+
+	breaker.Failure()
+	breaker.Success()
+	breaker.Failure()
+
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+}
+
+func TestSnapshot(t *testing.T) {
+	breaker := resilience.NewCircuitBreaker("snapshot_test", 1, time.Minute)
+	resilience.Register(breaker)
+	breaker.Failure()
+
+	stats := resilience.Snapshot()
+	require.Contains(t, stats, "snapshot_test")
+	assert.Equal(t, resilience.StateOpen, stats["snapshot_test"].State)
+}
@@ -1,6 +1,7 @@
 package seed
 
 import (
+	"context"
 	"os"
 	"strconv"
 
@@ -20,7 +21,7 @@ func Projects(projectRepository projectsvc.ProjectRepository) {
 		}
 	}
 
-	if err := projectsvc.SeedProjects(projectRepository, projectSeedCount); err != nil {
+	if err := projectsvc.SeedProjects(context.Background(), projectRepository, projectSeedCount); err != nil {
 		logger.ZapLogger.Error("Failed to seed project data", zap.Error(err))
 		// Continue anyway - seeding failure shouldn't stop the application
 	} else {
@@ -65,13 +65,13 @@ func TestProjects(t *testing.T) {
 
 			// Setup expectations - expect CreateProject to be called exactly expectedCount times
 			mockRepo.EXPECT().
-				CreateProject(gomock.Any()).
+				CreateProject(gomock.Any(), gomock.Any()).
 				Return(nil).
 				Times(tc.expectedCount)
 
 			// The SeedProjects function might call seedProjectIssues, which uses AddIssueToProject
 			mockRepo.EXPECT().
-				AddIssueToProject(gomock.Any(), gomock.Any()).
+				AddIssueToProject(gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil).
 				AnyTimes()
 
@@ -109,13 +109,13 @@ func TestProjects_Error(t *testing.T) {
 	// Setup expectations
 	// First two calls succeed, third one fails
 	gomock.InOrder(
-		mockRepo.EXPECT().CreateProject(gomock.Any()).Return(nil),
-		mockRepo.EXPECT().CreateProject(gomock.Any()).Return(nil),
-		mockRepo.EXPECT().CreateProject(gomock.Any()).Return(assert.AnError),
+		mockRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(nil),
+		mockRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(nil),
+		mockRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(assert.AnError),
 	)
 
 	// The seedProjectIssues function may be called for successful projects
-	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Call the function we're testing with the mock repository
 	seed.Projects(mockRepo)
@@ -150,12 +150,12 @@ func TestProjects_CountLimit(t *testing.T) {
 
 	// Setup expectations - should be called exactly 7 times
 	mockRepo.EXPECT().
-		CreateProject(gomock.Any()).
+		CreateProject(gomock.Any(), gomock.Any()).
 		Return(nil).
 		Times(7)
 
 	// Allow seedProjectIssues calls
-	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Call the function we're testing with the mock repository
 	seed.Projects(mockRepo)
@@ -187,11 +187,11 @@ func TestProjects_AddIssueError(t *testing.T) {
 	mockRepo := mocks.NewMockProjectRepository(ctrl)
 
 	// Setup expectations - project creation succeeds
-	mockRepo.EXPECT().CreateProject(gomock.Any()).Return(nil)
+	mockRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(nil)
 
 	// But adding issues fails
 	mockRepo.EXPECT().
-		AddIssueToProject(gomock.Any(), gomock.Any()).
+		AddIssueToProject(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(assert.AnError).
 		AnyTimes()
 
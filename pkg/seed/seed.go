@@ -2,7 +2,11 @@
 package seed
 
 import (
+	"context"
+	"math/rand/v2"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/yasindce1998/issue-tracker/logger"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
@@ -10,9 +14,51 @@ import (
 	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+	"github.com/brianvoe/gofakeit/v7"
+	"go.uber.org/zap"
 )
 
-// Data seeds all test data if environment conditions are met
+// defaultSeedTimeout bounds seeding when SEED_TIMEOUT_SECONDS is not set
+const defaultSeedTimeout = 30 * time.Second
+
+// seededRand replaces crypto/rand in the seed helpers while SEED_RANDOM_SEED is set
+var seededRand *rand.Rand
+
+// ConfigureRandomness makes seeding reproducible when SEED_RANDOM_SEED is set to a positive
+// integer, by seeding gofakeit and the seed helpers with it. Otherwise seeding stays random.
+func ConfigureRandomness() {
+	seededRand = nil
+
+	value := os.Getenv("SEED_RANDOM_SEED")
+	if value == "" {
+		return
+	}
+	seed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || seed == 0 {
+		logger.ZapLogger.Warn("Ignoring invalid SEED_RANDOM_SEED, seeding randomly", zap.String("value", value))
+		return
+	}
+
+	if err := gofakeit.Seed(seed); err != nil {
+		logger.ZapLogger.Warn("Failed to seed gofakeit, seeding randomly", zap.Error(err))
+		return
+	}
+	seededRand = rand.New(rand.NewPCG(seed, seed)) //nolint:gosec // reproducible seed data is requested explicitly
+	logger.ZapLogger.Info("Seeding deterministically", zap.Uint64("seed", seed))
+}
+
+// TimeoutFromEnv reads how long seeding may take from SEED_TIMEOUT_SECONDS, keeping the default
+// of 30 seconds for unset or non-positive values
+func TimeoutFromEnv() time.Duration {
+	if v, err := strconv.Atoi(os.Getenv("SEED_TIMEOUT_SECONDS")); err == nil && v > 0 {
+		return time.Duration(v) * time.Second
+	}
+	return defaultSeedTimeout
+}
+
+// Data seeds all test data if environment conditions are met. Seeding never stops the server
+// from starting: a panic in a step is logged and the remaining steps still run, and once
+// SEED_TIMEOUT_SECONDS have passed the steps that haven't finished are cancelled.
 func Data(
 	userRepo usersvc.UserRepository,
 	projectRepo projectsvc.ProjectRepository,
@@ -22,6 +68,12 @@ func Data(
 	projectClient projectPbv1.ProjectServiceClient,
 	userClient userPbv1.UserServiceClient,
 ) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.ZapLogger.Error("panic during seeding", zap.Any("panic", r))
+		}
+	}()
+
 	// Only seed data for memDB and non-production environments
 	if os.Getenv("DB_TYPE") != "memdb" || os.Getenv("ENVIRONMENT") == "production" {
 		logger.ZapLogger.Info("Skipping data seeding (not memdb or in production)")
@@ -38,12 +90,38 @@ func Data(
 		return
 	}
 
+	ConfigureRandomness()
+
 	// Now we have the concrete types, proceed with seeding
 	if projectClient != nil && userClient != nil {
-		memdbIssuesRepo.SetClients(projectClient, userClient)
+		memdbIssuesRepo.SetClients(issuessvc.NewClientValidator(projectClient, userClient))
 	}
 
-	Users(memdbUserRepo)
-	Projects(memdbProjectRepo)
-	RelationshipsIfEnabled(userService, projectService, memdbIssuesRepo)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	timeout := TimeoutFromEnv()
+	deadline := time.AfterFunc(timeout, func() {
+		logger.ZapLogger.Warn("Seeding took too long, cancelling the remaining steps", zap.Duration("timeout", timeout))
+		cancel()
+	})
+	defer deadline.Stop()
+
+	runStep(ctx, "users", func() { Users(memdbUserRepo) })
+	runStep(ctx, "projects", func() { Projects(memdbProjectRepo) })
+	runStep(ctx, "relationships", func() { RelationshipsIfEnabled(ctx, userService, projectService, memdbIssuesRepo) })
+}
+
+// runStep runs a seeding step unless seeding was cancelled, logging a panic in it instead of
+// letting it crash the server
+func runStep(ctx context.Context, name string, step func()) {
+	if ctx.Err() != nil {
+		logger.ZapLogger.Warn("Skipping seeding step after the seeding timeout", zap.String("step", name))
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			logger.ZapLogger.Error("panic during seeding", zap.String("step", name), zap.Any("panic", r))
+		}
+	}()
+	step()
 }
@@ -0,0 +1,149 @@
+package seed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/seed"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// seedRun seeds fresh repositories and returns the IDs and names of what was created
+func seedRun(t *testing.T) []string {
+	t.Helper()
+	seed.ConfigureRandomness()
+
+	userRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+
+	seed.Users(userRepo)
+	seed.Projects(projectRepo)
+
+	var seeded []string
+	users, _, err := userRepo.ListUsers(context.Background(), "", 10, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	for _, user := range users {
+		seeded = append(seeded, user.UserId, user.EmailAddress)
+	}
+	projects, err := projectRepo.ListProjects(context.Background())
+	require.NoError(t, err)
+	for _, project := range projects {
+		seeded = append(seeded, project.ProjectId, project.Name)
+	}
+	return seeded
+}
+
+func TestConfigureRandomness(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("SEED_USER_COUNT", "3")
+	t.Setenv("SEED_PROJECT_COUNT", "3")
+	t.Cleanup(func() { _ = gofakeit.Seed(0) })
+
+	t.Run("Seeded runs match", func(t *testing.T) {
+		t.Setenv("SEED_RANDOM_SEED", "1234")
+		first := seedRun(t)
+		require.Len(t, first, 12)
+		assert.Equal(t, first, seedRun(t))
+	})
+
+	for _, value := range []string{"", "0", "not-a-number"} {
+		t.Run("Unseeded runs differ with "+value, func(t *testing.T) {
+			t.Setenv("SEED_RANDOM_SEED", value)
+			assert.NotEqual(t, seedRun(t), seedRun(t))
+		})
+	}
+}
+
+// panickingUserService panics on ListUsers, like a misconfigured dependency of seeding
+type panickingUserService struct {
+	userPbv1.UnimplementedUserServiceServer
+}
+
+func (panickingUserService) ListUsers(context.Context, *userPbv1.ListUsersRequest) (*userPbv1.ListUsersResponse, error) {
+	panic("user service not initialized")
+}
+
+// blockingUserService answers ListUsers only once the call is cancelled
+type blockingUserService struct {
+	userPbv1.UnimplementedUserServiceServer
+}
+
+func (blockingUserService) ListUsers(ctx context.Context, _ *userPbv1.ListUsersRequest) (*userPbv1.ListUsersResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// setSeedEnv enables seeding of two users and their relationships
+func setSeedEnv(t *testing.T) {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("DB_TYPE", "memdb")
+	t.Setenv("ENVIRONMENT", "")
+	t.Setenv("SEED_RELATIONSHIPS", "true")
+	t.Setenv("SEED_USER_COUNT", "2")
+	t.Setenv("SEED_RANDOM_SEED", "")
+}
+
+func TestData_RecoversFromPanics(t *testing.T) {
+	setSeedEnv(t)
+	userRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	// A nil repository panics as soon as the projects are seeded
+	var projectRepo *projectsvc.MemDBProjectRepository
+
+	require.NotPanics(t, func() {
+		seed.Data(userRepo, projectRepo, issuesRepo, panickingUserService{}, nil, nil, nil)
+	})
+
+	// The steps before and after the panic still ran
+	users, _, err := userRepo.ListUsers(context.Background(), "", 10, usersvc.ListUsersOptions{})
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+}
+
+func TestData_StopsAtTimeout(t *testing.T) {
+	setSeedEnv(t)
+	t.Setenv("SEED_TIMEOUT_SECONDS", "1")
+	userRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		seed.Data(userRepo, projectRepo, issuesRepo, blockingUserService{}, nil, nil, nil)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("seeding didn't stop at SEED_TIMEOUT_SECONDS")
+	}
+}
+
+func TestTimeoutFromEnv(t *testing.T) {
+	t.Setenv("SEED_TIMEOUT_SECONDS", "")
+	assert.Equal(t, 30*time.Second, seed.TimeoutFromEnv())
+
+	t.Setenv("SEED_TIMEOUT_SECONDS", "5")
+	assert.Equal(t, 5*time.Second, seed.TimeoutFromEnv())
+
+	t.Setenv("SEED_TIMEOUT_SECONDS", "-1")
+	assert.Equal(t, 30*time.Second, seed.TimeoutFromEnv())
+}
@@ -1,6 +1,7 @@
 package seed
 
 import (
+	"context"
 	"os"
 	"strconv"
 
@@ -20,7 +21,7 @@ func Users(userRepository usersvc.UserRepository) {
 		}
 	}
 
-	if err := usersvc.SeedUsers(userRepository, userSeedCount); err != nil {
+	if err := usersvc.SeedUsers(context.Background(), userRepository, userSeedCount); err != nil {
 		logger.ZapLogger.Error("Failed to seed user data", zap.Error(err))
 		// Continue anyway - seeding failure shouldn't stop the application
 	} else {
@@ -65,7 +65,7 @@ func TestUsers(t *testing.T) {
 
 			// Setup expectations - expect CreateUser to be called exactly expectedCount times
 			mockRepo.EXPECT().
-				CreateUser(gomock.Any()).
+				CreateUser(gomock.Any(), gomock.Any()).
 				Return(nil).
 				Times(tc.expectedCount)
 
@@ -103,9 +103,9 @@ func TestUsers_Error(t *testing.T) {
 	// Setup expectations
 	// First two calls succeed, third one fails
 	gomock.InOrder(
-		mockRepo.EXPECT().CreateUser(gomock.Any()).Return(nil),
-		mockRepo.EXPECT().CreateUser(gomock.Any()).Return(nil),
-		mockRepo.EXPECT().CreateUser(gomock.Any()).Return(assert.AnError),
+		mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(nil),
+		mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(nil),
+		mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(assert.AnError),
 	)
 
 	// Call the function we're testing with the mock repository
@@ -139,7 +139,7 @@ func TestUsers_CountLimit(t *testing.T) {
 
 	// Setup expectations - should be called exactly 7 times
 	mockRepo.EXPECT().
-		CreateUser(gomock.Any()).
+		CreateUser(gomock.Any(), gomock.Any()).
 		Return(nil).
 		Times(7)
 
@@ -0,0 +1,191 @@
+// Package selftest runs a create, update, list and delete sequence against the running
+// services as a smoke test of a deployed build
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+)
+
+// stepTimeout bounds each step, so a service that hangs fails the self-test instead of stalling it
+const stepTimeout = 10 * time.Second
+
+// Clients are the service clients the self-test calls
+type Clients struct {
+	Users    userPbv1.UserServiceClient
+	Projects projectPbv1.ProjectServiceClient
+	Issues   issuesPbv1.IssuesServiceClient
+}
+
+// Step is the outcome of one call of the self-test
+type Step struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+// Report lists the steps of a self-test run in the order they ran
+type Report struct {
+	Steps []Step
+}
+
+// Passed reports whether every step succeeded
+func (r *Report) Passed() bool {
+	return r.Err() == nil
+}
+
+// Err returns the error of the first failed step, or nil if all of them succeeded
+func (r *Report) Err() error {
+	for _, step := range r.Steps {
+		if step.Err != nil {
+			return fmt.Errorf("self-test step %q failed: %w", step.Name, step.Err)
+		}
+	}
+	return nil
+}
+
+// run times a step and records it, returning whether it succeeded
+func (r *Report) run(ctx context.Context, name string, call func(context.Context) error) bool {
+	ctx, cancel := context.WithTimeout(ctx, stepTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := call(ctx)
+	r.Steps = append(r.Steps, Step{Name: name, Duration: time.Since(start), Err: err})
+	return err == nil
+}
+
+// Run creates a user, a project and an issue, assigns and resolves the issue, lists every
+// user, project and issue, and deletes what it created in reverse order. The deletes run
+// even when an earlier step fails, for whatever was created by then.
+func Run(ctx context.Context, clients Clients) *Report {
+	report := &Report{}
+	// A unique suffix keeps the records of concurrent or interrupted runs apart
+	suffix := uuid.NewString()[:8]
+
+	var userID, projectID, issueID string
+	defer func() {
+		if issueID != "" {
+			report.run(ctx, "delete issue", func(ctx context.Context) error {
+				_, err := clients.Issues.DeleteIssue(ctx, &issuesPbv1.DeleteIssueRequest{IssueId: issueID})
+				return err
+			})
+		}
+		if projectID != "" {
+			report.run(ctx, "delete project", func(ctx context.Context) error {
+				_, err := clients.Projects.DeleteProject(ctx, &projectPbv1.DeleteProjectRequest{ProjectId: projectID})
+				return err
+			})
+		}
+		if userID != "" {
+			report.run(ctx, "delete user", func(ctx context.Context) error {
+				_, err := clients.Users.DeleteUser(ctx, &userPbv1.DeleteUserRequest{UserId: userID})
+				return err
+			})
+		}
+	}()
+
+	ok := report.run(ctx, "create user", func(ctx context.Context) error {
+		resp, err := clients.Users.CreateUser(ctx, &userPbv1.CreateUserRequest{
+			FirstName:    "Self",
+			LastName:     "Test",
+			EmailAddress: fmt.Sprintf("selftest-%s@example.com", suffix),
+		})
+		if err != nil {
+			return err
+		}
+		userID = resp.User.UserId
+		return nil
+	})
+
+	ok = ok && report.run(ctx, "create project", func(ctx context.Context) error {
+		resp, err := clients.Projects.CreateProject(ctx, &projectPbv1.CreateProjectRequest{
+			Name:        "selftest-" + suffix,
+			Description: "Created by the startup self-test",
+		})
+		if err != nil {
+			return err
+		}
+		projectID = resp.Project.ProjectId
+		return nil
+	})
+
+	ok = ok && report.run(ctx, "create issue", func(ctx context.Context) error {
+		resp, err := clients.Issues.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+			Summary:   "Self-test issue " + suffix,
+			Type:      issuesPbv1.Type_BUG,
+			Priority:  issuesPbv1.Priority_MINOR,
+			ProjectId: projectID,
+		})
+		if err != nil {
+			return err
+		}
+		issueID = resp.Issue.IssueId
+		return nil
+	})
+
+	ok = ok && report.run(ctx, "assign issue", func(ctx context.Context) error {
+		resp, err := clients.Issues.AssignIssue(ctx, &issuesPbv1.AssignIssueRequest{IssueId: issueID, AssigneeId: userID})
+		if err != nil {
+			return err
+		}
+		if resp.Issue.Status != issuesPbv1.Status_ASSIGNED {
+			return fmt.Errorf("assigned issue has status %s", resp.Issue.Status)
+		}
+		return nil
+	})
+
+	ok = ok && report.run(ctx, "resolve issue", func(ctx context.Context) error {
+		resp, err := clients.Issues.ChangeIssueStatus(ctx, &issuesPbv1.ChangeIssueStatusRequest{
+			IssueId:    issueID,
+			NewStatus:  issuesPbv1.Status_RESOLVED,
+			Resolution: issuesPbv1.Resolution_FIXED,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Issue.Status != issuesPbv1.Status_RESOLVED {
+			return fmt.Errorf("resolved issue has status %s", resp.Issue.Status)
+		}
+		return nil
+	})
+
+	ok = ok && report.run(ctx, "list users", func(ctx context.Context) error {
+		_, err := clients.Users.ListUsers(ctx, &userPbv1.ListUsersRequest{PageSize: 100})
+		return err
+	})
+
+	ok = ok && report.run(ctx, "list projects", func(ctx context.Context) error {
+		req := &projectPbv1.ListProjectsRequest{PageSize: 100}
+		for {
+			resp, err := clients.Projects.ListProjects(ctx, req)
+			if err != nil {
+				return err
+			}
+			for _, project := range resp.Projects {
+				if project.ProjectId == projectID {
+					return nil
+				}
+			}
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		return fmt.Errorf("created project %s is not listed", projectID)
+	})
+
+	_ = ok && report.run(ctx, "list issues", func(ctx context.Context) error {
+		_, err := clients.Issues.ListIssues(ctx, &issuesPbv1.ListIssuesRequest{PageSize: 100})
+		return err
+	})
+
+	return report
+}
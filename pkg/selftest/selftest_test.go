@@ -0,0 +1,146 @@
+package selftest_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/selftest"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// repositories are the in-memory repositories behind the services the self-test calls
+type repositories struct {
+	users    usersvc.UserRepository
+	projects projectsvc.ProjectRepository
+	issues   issuessvc.IssuesRepository
+}
+
+// newClients serves the three services over in-memory repositories and an in-memory
+// listener, wired as the server wires them with USE_LOCAL_CLIENTS
+func newClients(t *testing.T) (selftest.Clients, repositories) {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	userRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	issuesRepo.SetClients(issuessvc.NewLocalValidator(projectRepo, userRepo))
+
+	sharedCache := cache.NewMemoryCache(100)
+	projectService, err := projectsvc.NewProjectService(projectsvc.NewCachedProjectRepository(projectRepo, sharedCache, cache.CacheConfigFromEnv()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = projectService.Close() })
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	userPbv1.RegisterUserServiceServer(grpcServer, usersvc.NewUserService(usersvc.NewCachedUserRepository(userRepo, sharedCache, cache.CacheConfigFromEnv())))
+	projectPbv1.RegisterProjectServiceServer(grpcServer, projectService)
+	issuesPbv1.RegisterIssuesServiceServer(grpcServer, issuessvc.NewIssuesService(issuessvc.NewCachedIssuesRepository(issuesRepo, sharedCache, cache.CacheConfigFromEnv()), nil, nil))
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	clients := selftest.Clients{
+		Users:    userPbv1.NewUserServiceClient(conn),
+		Projects: projectPbv1.NewProjectServiceClient(conn),
+		Issues:   issuesPbv1.NewIssuesServiceClient(conn),
+	}
+	return clients, repositories{users: userRepo, projects: projectRepo, issues: issuesRepo}
+}
+
+func stepNames(report *selftest.Report) []string {
+	names := make([]string, len(report.Steps))
+	for i, step := range report.Steps {
+		names[i] = step.Name
+	}
+	return names
+}
+
+// assertCleanedUp checks the repositories for anything the self-test left behind
+func assertCleanedUp(t *testing.T, repos repositories) {
+	t.Helper()
+	ctx := context.Background()
+
+	users, _, err := repos.users.ListUsers(ctx, "", 100, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	assert.Empty(t, users)
+
+	projects, err := repos.projects.ListProjects(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, projects)
+
+	issues, _, err := repos.issues.ListIssues(ctx, "", 1000)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestRun(t *testing.T) {
+	clients, repos := newClients(t)
+
+	report := selftest.Run(context.Background(), clients)
+
+	require.NoError(t, report.Err())
+	assert.True(t, report.Passed())
+	assert.Equal(t, []string{
+		"create user", "create project", "create issue", "assign issue", "resolve issue",
+		"list users", "list projects", "list issues",
+		"delete issue", "delete project", "delete user",
+	}, stepNames(report))
+	for _, step := range report.Steps {
+		assert.Positive(t, step.Duration, step.Name)
+	}
+	assertCleanedUp(t, repos)
+}
+
+// failingStatusClient fails every status change
+type failingStatusClient struct {
+	issuesPbv1.IssuesServiceClient
+}
+
+func (failingStatusClient) ChangeIssueStatus(context.Context, *issuesPbv1.ChangeIssueStatusRequest, ...grpc.CallOption) (*issuesPbv1.ChangeIssueStatusResponse, error) {
+	return nil, errors.New("status change unavailable")
+}
+
+func TestRun_CleansUpAfterFailure(t *testing.T) {
+	clients, repos := newClients(t)
+	failing := clients
+	failing.Issues = failingStatusClient{clients.Issues}
+
+	report := selftest.Run(context.Background(), failing)
+
+	assert.False(t, report.Passed())
+	assert.ErrorContains(t, report.Err(), `"resolve issue"`)
+	// The remaining steps are skipped, but everything created so far is deleted
+	assert.Equal(t, []string{
+		"create user", "create project", "create issue", "assign issue", "resolve issue",
+		"delete issue", "delete project", "delete user",
+	}, stepNames(report))
+	for _, step := range report.Steps[5:] {
+		assert.NoError(t, step.Err, step.Name)
+	}
+	assertCleanedUp(t, repos)
+}
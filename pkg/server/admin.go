@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+// adminContextKey is the context key holding the name of the authenticated admin
+const adminContextKey = contextKey("admin")
+
+// AdminKeysFromEnv reads the admin API keys from ADMIN_API_KEYS, a comma-separated list of
+// name:key pairs such as "alice:s3cret,ops-bot:t0ken". The name identifies who made an admin
+// call in the logs. It returns the names indexed by key.
+func AdminKeysFromEnv() map[string]string {
+	keys := make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv("ADMIN_API_KEYS"), ",") {
+		name, key, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || name == "" || key == "" {
+			continue
+		}
+		keys[key] = name
+	}
+	return keys
+}
+
+// RequireAdmin only lets requests through that carry one of the admin API keys as a bearer
+// token. Without any configured keys, every admin request is rejected.
+func RequireAdmin(keys map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(keys) == 0 {
+				writeErrorResponse(r.Context(), w, http.StatusForbidden, codes.PermissionDenied, "admin access is not configured")
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				writeErrorResponse(r.Context(), w, http.StatusUnauthorized, codes.Unauthenticated, "missing admin API key")
+				return
+			}
+
+			name, ok := lookupAdminKey(keys, token)
+			if !ok {
+				logger.ZapLogger.Warn("Rejected admin request with an unknown API key",
+					zap.String("method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.String("remote_addr", r.RemoteAddr))
+				writeErrorResponse(r.Context(), w, http.StatusForbidden, codes.PermissionDenied, "invalid admin API key")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), adminContextKey, name)))
+		})
+	}
+}
+
+// lookupAdminKey compares the token against every key in constant time
+func lookupAdminKey(keys map[string]string, token string) (string, bool) {
+	var found string
+	for key, name := range keys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(token)) == 1 {
+			found = name
+		}
+	}
+	return found, found != ""
+}
+
+// AdminFromContext returns the name of the admin authenticated by RequireAdmin
+func AdminFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(adminContextKey).(string)
+	return name
+}
+
+// CacheKey is a cached key as listed by the cache admin endpoint
+type CacheKey struct {
+	Key string `json:"key"`
+	// TTLSeconds is the remaining lifetime of the key, or -1 if it never expires
+	TTLSeconds int64 `json:"ttl_seconds"`
+}
+
+// CacheKeysResponse is the response of GET /admin/cache/keys
+type CacheKeysResponse struct {
+	Keys []CacheKey `json:"keys"`
+}
+
+// CacheFlushResponse is the response of POST /admin/cache/flush
+type CacheFlushResponse struct {
+	Removed int `json:"removed"`
+}
+
+// CacheStatsResponse is the response of GET /admin/cache/stats
+type CacheStatsResponse struct {
+	Entries     int    `json:"entries"`
+	Capacity    int    `json:"capacity"`
+	Evictions   uint64 `json:"evictions"`
+	Expirations uint64 `json:"expirations"`
+	Hits        uint64 `json:"hits"`
+	Misses      uint64 `json:"misses"`
+}
+
+// CacheAdminHandler lets admins inspect and flush the cache
+type CacheAdminHandler struct {
+	cache cache.Cache
+}
+
+// NewCacheAdminHandler creates a cache admin handler for the given cache
+func NewCacheAdminHandler(c cache.Cache) *CacheAdminHandler {
+	return &CacheAdminHandler{cache: c}
+}
+
+// ServeKeys lists the cached keys, optionally limited to those starting with the prefix query parameter
+func (h *CacheAdminHandler) ServeKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorResponse(r.Context(), w, http.StatusMethodNotAllowed, codes.Unimplemented, "method not allowed")
+		return
+	}
+
+	keys, err := h.cache.Keys(r.Context(), r.URL.Query().Get("prefix"))
+	if err != nil {
+		logger.ZapLogger.Error("Failed to list cache keys", zap.Error(err))
+		writeErrorResponse(r.Context(), w, http.StatusInternalServerError, codes.Internal, "failed to list cache keys")
+		return
+	}
+
+	response := CacheKeysResponse{Keys: make([]CacheKey, len(keys))}
+	for i, key := range keys {
+		ttl := int64(-1)
+		if key.TTL >= 0 {
+			ttl = int64(key.TTL.Seconds())
+		}
+		response.Keys[i] = CacheKey{Key: key.Key, TTLSeconds: ttl}
+	}
+	writeJSON(w, response)
+}
+
+// ServeFlush deletes the cached keys starting with the prefix query parameter, or every key without one
+func (h *CacheAdminHandler) ServeFlush(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeErrorResponse(r.Context(), w, http.StatusMethodNotAllowed, codes.Unimplemented, "method not allowed")
+		return
+	}
+
+	prefix := r.URL.Query().Get("prefix")
+	removed, err := cache.Flush(r.Context(), h.cache, prefix)
+	logger.ZapLogger.Info("Cache flushed by admin",
+		zap.String("admin", AdminFromContext(r.Context())),
+		zap.String("remote_addr", r.RemoteAddr),
+		zap.String("prefix", prefix),
+		zap.Int("removed", removed),
+		zap.Error(err))
+	if err != nil {
+		writeErrorResponse(r.Context(), w, http.StatusInternalServerError, codes.Internal, "failed to flush cache")
+		return
+	}
+
+	writeJSON(w, CacheFlushResponse{Removed: removed})
+}
+
+// ServeStats reports the occupancy and eviction counts of caches that keep them
+func (h *CacheAdminHandler) ServeStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorResponse(r.Context(), w, http.StatusMethodNotAllowed, codes.Unimplemented, "method not allowed")
+		return
+	}
+
+	reporter, ok := h.cache.(cache.StatsReporter)
+	if !ok {
+		writeErrorResponse(r.Context(), w, http.StatusNotImplemented, codes.Unimplemented, "the cache does not report statistics")
+		return
+	}
+
+	stats := reporter.Stats()
+	writeJSON(w, CacheStatsResponse{
+		Entries:     stats.Entries,
+		Capacity:    stats.Capacity,
+		Evictions:   stats.Evictions,
+		Expirations: stats.Expirations,
+		Hits:        stats.Hits,
+		Misses:      stats.Misses,
+	})
+}
+
+// LogLevel is the request and response body of /admin/loglevel
+type LogLevel struct {
+	Level string `json:"level"`
+}
+
+// ServeLogLevel returns the current log level on GET and changes it on POST, so a running
+// instance can be switched to debug logging without a redeploy
+func ServeLogLevel(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		var req LogLevel
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeErrorResponse(r.Context(), w, http.StatusBadRequest, codes.InvalidArgument, "invalid request body: "+err.Error())
+			return
+		}
+
+		previous := logger.Level()
+		if err := logger.SetLevel(req.Level); err != nil {
+			writeErrorResponse(r.Context(), w, http.StatusBadRequest, codes.InvalidArgument, err.Error())
+			return
+		}
+		// Logged at warn so the change is recorded at any level up to warn
+		logger.ZapLogger.Warn("Log level changed by admin",
+			zap.String("admin", AdminFromContext(r.Context())),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.String("from", previous.String()),
+			zap.String("to", logger.Level().String()))
+	default:
+		writeErrorResponse(r.Context(), w, http.StatusMethodNotAllowed, codes.Unimplemented, "method not allowed")
+		return
+	}
+
+	writeJSON(w, LogLevel{Level: logger.Level().String()})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.ZapLogger.Error("Failed to encode admin response", zap.Error(err))
+	}
+}
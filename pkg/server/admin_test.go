@@ -0,0 +1,157 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+)
+
+func TestAdminKeysFromEnv(t *testing.T) {
+	t.Setenv("ADMIN_API_KEYS", "alice:s3cret, ops-bot:t0ken,malformed,:nokey")
+
+	assert.Equal(t, map[string]string{"s3cret": "alice", "t0ken": "ops-bot"}, server.AdminKeysFromEnv())
+}
+
+func TestRequireAdmin(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	var admin string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		admin = server.AdminFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	testCases := []struct {
+		name           string
+		keys           map[string]string
+		authorization  string
+		expectedStatus int
+		expectedAdmin  string
+	}{
+		{name: "valid key", keys: map[string]string{"s3cret": "alice"}, authorization: "Bearer s3cret", expectedStatus: http.StatusNoContent, expectedAdmin: "alice"},
+		{name: "missing key", keys: map[string]string{"s3cret": "alice"}, expectedStatus: http.StatusUnauthorized},
+		{name: "wrong key", keys: map[string]string{"s3cret": "alice"}, authorization: "Bearer guess", expectedStatus: http.StatusForbidden},
+		{name: "no keys configured", authorization: "Bearer s3cret", expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			admin = ""
+			req := httptest.NewRequest(http.MethodPost, "/admin/cache/flush", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			server.RequireAdmin(tc.keys)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedAdmin, admin)
+		})
+	}
+}
+
+func TestCacheAdminHandler_KeysAndPrefixFlush(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	c := cache.NewMemoryCache(10)
+	require.NoError(t, c.Set(ctx, "issues:list::10", "page", time.Hour))
+	require.NoError(t, c.Set(ctx, "issues:list:abc:10", "page", time.Hour))
+	require.NoError(t, c.Set(ctx, "issue:1", "issue", time.Hour))
+	require.NoError(t, c.Set(ctx, "user:1", "user", time.Hour))
+	handler := server.NewCacheAdminHandler(c)
+
+	rec := httptest.NewRecorder()
+	handler.ServeKeys(rec, httptest.NewRequest(http.MethodGet, "/admin/cache/keys?prefix=issues:", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var keys server.CacheKeysResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&keys))
+	require.Len(t, keys.Keys, 2)
+	assert.Equal(t, "issues:list::10", keys.Keys[0].Key)
+	assert.InDelta(t, 3600, keys.Keys[0].TTLSeconds, 5)
+
+	rec = httptest.NewRecorder()
+	handler.ServeFlush(rec, httptest.NewRequest(http.MethodPost, "/admin/cache/flush?prefix=issues:", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var flush server.CacheFlushResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&flush))
+	assert.Equal(t, 2, flush.Removed)
+
+	// Keys outside the prefix are left intact
+	for _, key := range []string{"issue:1", "user:1"} {
+		exists, err := c.Exists(ctx, key)
+		require.NoError(t, err)
+		assert.True(t, exists, key)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeFlush(rec, httptest.NewRequest(http.MethodGet, "/admin/cache/flush", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestCacheAdminHandler_Stats(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewMemoryCache(2)
+	for _, key := range []string{"issue:1", "issue:2", "issue:3"} {
+		require.NoError(t, c.Set(ctx, key, "issue", time.Hour))
+	}
+
+	rec := httptest.NewRecorder()
+	server.NewCacheAdminHandler(c).ServeStats(rec, httptest.NewRequest(http.MethodGet, "/admin/cache/stats", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var stats server.CacheStatsResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&stats))
+	assert.Equal(t, server.CacheStatsResponse{Entries: 2, Capacity: 2, Evictions: 1}, stats)
+
+	// Caches without statistics say so
+	rec = httptest.NewRecorder()
+	server.NewCacheAdminHandler(cache.NewRedisClient("localhost:0", "", 0)).ServeStats(rec, httptest.NewRequest(http.MethodGet, "/admin/cache/stats", nil))
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+}
+
+func TestServeLogLevel(t *testing.T) {
+	require.NoError(t, logger.InitializeLogger("info"))
+	defer func() { logger.ZapLogger = zap.NewNop() }()
+
+	handler := server.RequireAdmin(map[string]string{"s3cret": "alice"})(http.HandlerFunc(server.ServeLogLevel))
+	request := func(method, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/admin/loglevel", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer s3cret")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.False(t, logger.ZapLogger.Core().Enabled(zapcore.DebugLevel))
+
+	rec := request(http.MethodPost, `{"level":"debug"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var level server.LogLevel
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&level))
+	assert.Equal(t, "debug", level.Level)
+
+	// The running logger now emits debug entries
+	assert.True(t, logger.ZapLogger.Core().Enabled(zapcore.DebugLevel))
+
+	rec = request(http.MethodPost, `{"level":"loud"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = request(http.MethodGet, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&level))
+	assert.Equal(t, "debug", level.Level)
+}
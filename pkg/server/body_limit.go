@@ -0,0 +1,70 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+// defaultMaxRequestBodyBytes is used when HTTP_MAX_REQUEST_BODY_BYTES is not set
+const defaultMaxRequestBodyBytes int64 = 1 << 20
+
+// MaxRequestBodyBytesFromEnv reads the request body limit from HTTP_MAX_REQUEST_BODY_BYTES
+func MaxRequestBodyBytesFromEnv() int64 {
+	if v, err := strconv.ParseInt(os.Getenv("HTTP_MAX_REQUEST_BODY_BYTES"), 10, 64); err == nil && v > 0 {
+		return v
+	}
+	return defaultMaxRequestBodyBytes
+}
+
+// MaxBytesMiddleware rejects requests whose body is larger than maxBytes with
+// 413 Request Entity Too Large. The body is read up front so oversized requests
+// never reach the wrapped handler, even when no Content-Length is sent.
+func MaxBytesMiddleware(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.ContentLength > maxBytes {
+				writeBodyTooLarge(w, r, maxBytes)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBytes))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					writeBodyTooLarge(w, r, maxBytes)
+					return
+				}
+				http.Error(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeBodyTooLarge(w http.ResponseWriter, r *http.Request, maxBytes int64) {
+	logger.ZapLogger.Warn("Rejected oversized request body",
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+		zap.Int64("max_bytes", maxBytes))
+
+	writeErrorResponse(r.Context(), w, http.StatusRequestEntityTooLarge, codes.ResourceExhausted,
+		fmt.Sprintf("request body exceeds the limit of %d bytes", maxBytes))
+}
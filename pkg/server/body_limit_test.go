@@ -0,0 +1,92 @@
+package server_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+)
+
+func TestMaxBytesMiddleware(t *testing.T) {
+	logger.ZapLogger, _ = zap.NewDevelopment()
+
+	// Echo the body back so we can check it is passed through intact
+	echoHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		_, err = w.Write(body)
+		require.NoError(t, err)
+	})
+	handler := server.MaxBytesMiddleware(16)(echoHandler)
+
+	testCases := []struct {
+		name           string
+		body           string
+		chunked        bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "body within the limit",
+			body:           `{"summary":"ok"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"summary":"ok"}`,
+		},
+		{
+			name:           "body over the limit",
+			body:           strings.Repeat("a", 17),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "body over the limit without a content length",
+			body:           strings.Repeat("a", 1024),
+			chunked:        true,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/issues", strings.NewReader(tc.body))
+			if tc.chunked {
+				req.ContentLength = -1
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, tc.expectedBody, rr.Body.String())
+				return
+			}
+
+			assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+			var errBody server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errBody))
+			assert.Equal(t, "RESOURCE_EXHAUSTED", errBody.Error.Status)
+			assert.Equal(t, http.StatusRequestEntityTooLarge, errBody.Error.HTTPStatus)
+			assert.Contains(t, errBody.Error.Message, "exceeds the limit of 16 bytes")
+		})
+	}
+}
+
+func TestMaxRequestBodyBytesFromEnv(t *testing.T) {
+	t.Setenv("HTTP_MAX_REQUEST_BODY_BYTES", "")
+	assert.Equal(t, int64(1<<20), server.MaxRequestBodyBytesFromEnv())
+
+	t.Setenv("HTTP_MAX_REQUEST_BODY_BYTES", "4096")
+	assert.Equal(t, int64(4096), server.MaxRequestBodyBytesFromEnv())
+
+	t.Setenv("HTTP_MAX_REQUEST_BODY_BYTES", "not-a-number")
+	assert.Equal(t, int64(1<<20), server.MaxRequestBodyBytesFromEnv())
+}
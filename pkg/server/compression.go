@@ -0,0 +1,218 @@
+package server
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpcgzip "google.golang.org/grpc/encoding/gzip"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+// defaultCompressionMinBytes is used when HTTP_COMPRESSION_MIN_BYTES is not set
+const defaultCompressionMinBytes = 1024
+
+// compressedContentTypes are content type prefixes whose bodies are already compressed
+var compressedContentTypes = []string{
+	"image/", "video/", "audio/",
+	"application/gzip", "application/x-gzip", "application/zip", "application/zstd",
+}
+
+// gzipWriters reuses gzip writers, whose buffers are costly to allocate for every response
+var gzipWriters = sync.Pool{
+	New: func() any { return gzip.NewWriter(nil) },
+}
+
+// CompressionMinBytesFromEnv reads the size from which HTTP responses are compressed from HTTP_COMPRESSION_MIN_BYTES
+func CompressionMinBytesFromEnv() int {
+	if v, err := strconv.Atoi(os.Getenv("HTTP_COMPRESSION_MIN_BYTES")); err == nil && v >= 0 {
+		return v
+	}
+	return defaultCompressionMinBytes
+}
+
+// CompressionDialOptions returns the options that gzip outgoing gRPC calls, or none when
+// GRPC_COMPRESSION is false. The server accepts gzip from any client and answers in kind.
+func CompressionDialOptions() []grpc.DialOption {
+	if enabled, err := strconv.ParseBool(os.Getenv("GRPC_COMPRESSION")); err == nil && !enabled {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithDefaultCallOptions(grpc.UseCompressor(grpcgzip.Name))}
+}
+
+// GzipMiddleware gzips responses of at least minBytes for clients that accept it. Smaller
+// responses, bodies that are already compressed and the streaming watch endpoints are sent as is.
+func GzipMiddleware(minBytes int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodHead || isStreamingPath(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Add("Vary", "Accept-Encoding")
+			if !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			gw := &gzipResponseWriter{ResponseWriter: w, minBytes: minBytes, status: http.StatusOK}
+			defer gw.finish()
+			next.ServeHTTP(gw, r)
+		})
+	}
+}
+
+// isStreamingPath reports whether the path serves a server stream, whose messages must reach
+// the client as they are sent rather than once a compressed block fills up
+func isStreamingPath(path string) bool {
+	return strings.HasSuffix(path, "/watch")
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip, honoring q=0
+func acceptsGzip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		if q, ok := strings.CutPrefix(strings.ReplaceAll(params, " ", ""), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// gzipResponseWriter holds back the start of a response until it knows whether the response
+// reaches minBytes, then either compresses it or passes it through unchanged
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	minBytes int
+	status   int
+	buf      []byte
+	// decided is set once the headers are written; gz is nil if the response isn't compressed
+	decided bool
+	gz      *gzip.Writer
+}
+
+func (g *gzipResponseWriter) WriteHeader(status int) {
+	if !g.decided {
+		g.status = status
+	}
+}
+
+func (g *gzipResponseWriter) Write(p []byte) (int, error) {
+	if g.decided {
+		if g.gz != nil {
+			return g.gz.Write(p)
+		}
+		return g.ResponseWriter.Write(p)
+	}
+
+	g.buf = append(g.buf, p...)
+	if len(g.buf) >= g.minBytes {
+		if err := g.decide(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends what has been written so far, compressing it if it already reached minBytes
+func (g *gzipResponseWriter) Flush() {
+	if !g.decided {
+		if err := g.decide(len(g.buf) >= g.minBytes); err != nil {
+			return
+		}
+	}
+	if g.gz != nil {
+		_ = g.gz.Flush()
+	}
+	if flusher, ok := g.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack lets protocol upgrades through; the connection is no longer an HTTP response to compress
+func (g *gzipResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := g.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
+// decide writes the headers, compressing the response if compress is set and its content isn't
+// already encoded, then writes what was held back
+func (g *gzipResponseWriter) decide(compress bool) error {
+	g.decided = true
+	header := g.ResponseWriter.Header()
+	if header.Get("Content-Type") == "" && len(g.buf) > 0 {
+		// Sniff the type now; once compressed, the body would be sniffed as gzip
+		header.Set("Content-Type", http.DetectContentType(g.buf))
+	}
+
+	if compress && g.status != http.StatusNoContent && g.status != http.StatusNotModified &&
+		header.Get("Content-Encoding") == "" && !isCompressedContentType(header.Get("Content-Type")) {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		g.gz = gzipWriters.Get().(*gzip.Writer)
+		g.gz.Reset(g.ResponseWriter)
+	}
+
+	g.ResponseWriter.WriteHeader(g.status)
+	buf := g.buf
+	g.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if g.gz != nil {
+		_, err = g.gz.Write(buf)
+	} else {
+		_, err = g.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+// finish sends a response that never reached minBytes and completes a compressed one
+func (g *gzipResponseWriter) finish() {
+	if !g.decided {
+		if err := g.decide(false); err != nil {
+			logger.ZapLogger.Warn("Failed to write response", zap.Error(err))
+		}
+		return
+	}
+	if g.gz == nil {
+		return
+	}
+	if err := g.gz.Close(); err != nil {
+		logger.ZapLogger.Warn("Failed to complete compressed response", zap.Error(err))
+	}
+	g.gz.Reset(nil)
+	gzipWriters.Put(g.gz)
+	g.gz = nil
+}
+
+func isCompressedContentType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	for _, prefix := range compressedContentTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,247 @@
+package server_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// listIssuesPath requests the largest page ListIssues serves
+const listIssuesPath = "/api/v1/issues?page_size=100"
+
+// newIssuesGateway serves issueCount issues, each with a full description, through the HTTP
+// gateway with gzip middleware, calling the issues service over a gzip-compressed connection
+func newIssuesGateway(tb testing.TB, issueCount int) http.Handler {
+	tb.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(tb, err)
+	for i := 0; i < issueCount; i++ {
+		require.NoError(tb, issuesRepo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+			IssueId:     fmt.Sprintf("c72d237e-2658-4252-be58-%012d", i),
+			Summary:     fmt.Sprintf("Login fails on attempt %d", i),
+			Description: strings.Repeat("Steps to reproduce the failure. ", 15),
+			Type:        issuesPbv1.Type_BUG,
+			Priority:    issuesPbv1.Priority_MAJOR,
+			Status:      issuesPbv1.Status_NEW,
+		}))
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	issuesPbv1.RegisterIssuesServiceServer(grpcServer, issuessvc.NewIssuesService(issuesRepo, nil, nil))
+	go func() { _ = grpcServer.Serve(lis) }()
+	tb.Cleanup(grpcServer.Stop)
+
+	opts := append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, server.CompressionDialOptions()...)
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { _ = conn.Close() })
+
+	mux := server.NewGatewayMux()
+	require.NoError(tb, issuesPbv1.RegisterIssuesServiceHandler(context.Background(), mux, conn))
+	return server.GzipMiddleware(1024)(mux)
+}
+
+func compressionRequest(tb testing.TB, handler http.Handler, path, acceptEncoding string) *httptest.ResponseRecorder {
+	tb.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func gunzip(tb testing.TB, body []byte) []byte {
+	tb.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	require.NoError(tb, err)
+	defer zr.Close()
+	data, err := io.ReadAll(zr)
+	require.NoError(tb, err)
+	return data
+}
+
+// listAllIssues pages through every issue with the given Accept-Encoding, returning the
+// decoded JSON of each page and the number of bytes sent over the wire
+func listAllIssues(tb testing.TB, handler http.Handler, acceptEncoding string) ([][]byte, int) {
+	tb.Helper()
+	var pages [][]byte
+	var sent int
+	path := listIssuesPath
+	for {
+		rec := compressionRequest(tb, handler, path, acceptEncoding)
+		require.Equal(tb, http.StatusOK, rec.Code)
+		sent += rec.Body.Len()
+
+		body := rec.Body.Bytes()
+		if rec.Header().Get("Content-Encoding") == "gzip" {
+			body = gunzip(tb, body)
+		}
+		pages = append(pages, body)
+
+		var resp issuesPbv1.ListIssuesResponse
+		require.NoError(tb, protojson.Unmarshal(body, &resp))
+		if resp.NextPageToken == "" {
+			return pages, sent
+		}
+		path = listIssuesPath + "&page_token=" + url.QueryEscape(resp.NextPageToken)
+	}
+}
+
+func TestGzipMiddleware_ListIssuesOverGateway(t *testing.T) {
+	handler := newIssuesGateway(t, 1000)
+
+	plain := compressionRequest(t, handler, listIssuesPath, "")
+	require.Equal(t, http.StatusOK, plain.Code)
+	assert.Empty(t, plain.Header().Get("Content-Encoding"), "clients not sending Accept-Encoding get plain responses")
+	assert.Contains(t, plain.Header().Get("Vary"), "Accept-Encoding")
+
+	compressed := compressionRequest(t, handler, listIssuesPath, "br;q=1.0, gzip;q=0.8")
+	require.Equal(t, http.StatusOK, compressed.Code)
+	assert.Equal(t, "gzip", compressed.Header().Get("Content-Encoding"))
+	assert.Equal(t, "application/json", compressed.Header().Get("Content-Type"))
+
+	// Every page of the 1000 issues decodes to the JSON sent to clients that don't compress
+	plainPages, plainBytes := listAllIssues(t, handler, "")
+	gzipPages, gzipBytes := listAllIssues(t, handler, "gzip")
+	require.Len(t, gzipPages, len(plainPages))
+	var issueCount int
+	for i := range plainPages {
+		assert.JSONEq(t, string(plainPages[i]), string(gzipPages[i]))
+		var resp issuesPbv1.ListIssuesResponse
+		require.NoError(t, protojson.Unmarshal(gzipPages[i], &resp))
+		issueCount += len(resp.Issues)
+	}
+	assert.Equal(t, 1000, issueCount)
+	assert.Less(t, gzipBytes, plainBytes/10)
+}
+
+func TestGzipMiddleware_Skips(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	large := strings.Repeat("a", 2048)
+
+	testCases := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		contentType    string
+		body           string
+		compressed     bool
+	}{
+		{name: "Large response", path: "/api/v1/issues", acceptEncoding: "gzip", body: large, compressed: true},
+		{name: "Any encoding", path: "/api/v1/issues", acceptEncoding: "*", body: large, compressed: true},
+		{name: "Below threshold", path: "/health", acceptEncoding: "gzip", body: `{"status":"ok"}`},
+		{name: "Gzip refused", path: "/api/v1/issues", acceptEncoding: "gzip;q=0, deflate", body: large},
+		{name: "Already compressed", path: "/files/logo.png", acceptEncoding: "gzip", contentType: "image/png", body: large},
+		{name: "Watch stream", path: "/api/v1/issues/" + gatewayIssueID + "/watch", acceptEncoding: "gzip", body: large},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := server.GzipMiddleware(1024)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tc.contentType != "" {
+					w.Header().Set("Content-Type", tc.contentType)
+				}
+				// Write in pieces, as the gateway and CSV exports do
+				for i := 0; i < len(tc.body); i += 100 {
+					_, _ = io.WriteString(w, tc.body[i:min(i+100, len(tc.body))])
+				}
+			}))
+
+			rec := compressionRequest(t, handler, tc.path, tc.acceptEncoding)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			body := rec.Body.Bytes()
+			if tc.compressed {
+				assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+				body = gunzip(t, body)
+			} else {
+				assert.Empty(t, rec.Header().Get("Content-Encoding"))
+			}
+			assert.Equal(t, tc.body, string(body))
+		})
+	}
+}
+
+func TestGzipMiddleware_KeepsStatusAndEmptyBodies(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	handler := server.GzipMiddleware(0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/not-modified" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"error":{"status":"NOT_FOUND"}}`)
+	}))
+
+	rec := compressionRequest(t, handler, "/not-modified", "gzip")
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.Zero(t, rec.Body.Len())
+
+	rec = compressionRequest(t, handler, "/missing", "gzip")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, `{"error":{"status":"NOT_FOUND"}}`, string(gunzip(t, rec.Body.Bytes())))
+}
+
+func TestCompressionMinBytesFromEnv(t *testing.T) {
+	t.Setenv("HTTP_COMPRESSION_MIN_BYTES", "")
+	assert.Equal(t, 1024, server.CompressionMinBytesFromEnv())
+	t.Setenv("HTTP_COMPRESSION_MIN_BYTES", "0")
+	assert.Equal(t, 0, server.CompressionMinBytesFromEnv())
+	t.Setenv("HTTP_COMPRESSION_MIN_BYTES", "-5")
+	assert.Equal(t, 1024, server.CompressionMinBytesFromEnv())
+}
+
+func TestCompressionDialOptions(t *testing.T) {
+	t.Setenv("GRPC_COMPRESSION", "")
+	assert.Len(t, server.CompressionDialOptions(), 1)
+	t.Setenv("GRPC_COMPRESSION", "false")
+	assert.Empty(t, server.CompressionDialOptions())
+}
+
+func BenchmarkGzipMiddleware_ListIssues(b *testing.B) {
+	handler := newIssuesGateway(b, 1000)
+
+	for _, encoding := range []string{"", "gzip"} {
+		name := "identity"
+		if encoding != "" {
+			name = encoding
+		}
+		b.Run(name, func(b *testing.B) {
+			var sent int
+			for i := 0; i < b.N; i++ {
+				_, sent = listAllIssues(b, handler, encoding)
+			}
+			b.ReportMetric(float64(sent), "bytes/list")
+		})
+	}
+}
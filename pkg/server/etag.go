@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+// etagResources are the paths whose GET responses carry ETags, along with their
+// direct children. Deeper paths, such as the issue watch stream, are left alone.
+var etagResources = []string{"/api/v1/issues", "/v1/projects"}
+
+// ETagResponseOption is a grpc-gateway forward response option that sets a weak ETag
+// derived from the SHA-256 of the serialized response message
+func ETagResponseOption(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", weakETag(data))
+	return nil
+}
+
+// ETagMiddleware adds conditional GET support to issue and project endpoints. Responses
+// get an ETag (the one set by ETagResponseOption, or a hash of the body) and requests whose
+// If-None-Match matches it get 304 Not Modified. The ETag is always computed from the current
+// response, so changes made over gRPC, through other gateway replicas or by background jobs
+// are never reported as unmodified; a 304 saves the transfer, not the backend call.
+func ETagMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !etagEligible(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		serveConditionalGet(w, r, next)
+	})
+}
+
+// serveConditionalGet runs the request and replaces the response with 304 if its ETag
+// matches If-None-Match
+func serveConditionalGet(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	buffered := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+	next.ServeHTTP(buffered, r)
+
+	if buffered.status != http.StatusOK {
+		buffered.writeTo(w)
+		return
+	}
+
+	etag := buffered.header.Get("ETag")
+	if etag == "" {
+		etag = weakETag(buffered.body.Bytes())
+		buffered.header.Set("ETag", etag)
+	}
+
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" && etagMatches(ifNoneMatch, etag) {
+		writeNotModified(w, etag)
+		return
+	}
+	buffered.writeTo(w)
+}
+
+// etagEligible reports whether GET responses for the path carry ETags
+func etagEligible(path string) bool {
+	for _, resource := range etagResources {
+		if path == resource {
+			return true
+		}
+		if child, ok := strings.CutPrefix(path, resource+"/"); ok && child != "" && !strings.Contains(child, "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// etagMatches applies the weak comparison used for If-None-Match to a list of ETags
+func etagMatches(ifNoneMatch, etag string) bool {
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == want {
+			return true
+		}
+	}
+	return false
+}
+
+func weakETag(data []byte) string {
+	return fmt.Sprintf(`W/"%x"`, sha256.Sum256(data))
+}
+
+func writeNotModified(w http.ResponseWriter, etag string) {
+	w.Header().Set("ETag", etag)
+	w.WriteHeader(http.StatusNotModified)
+}
+
+// bufferedResponse holds a response until the middleware decides whether to send it or a 304
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for name, values := range b.header {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(b.status)
+	if _, err := w.Write(b.body.Bytes()); err != nil {
+		logger.ZapLogger.Warn("Failed to write response", zap.Error(err))
+	}
+}
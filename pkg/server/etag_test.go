@@ -0,0 +1,193 @@
+package server_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const etagProjectPath = "/v1/projects/" + exportProjectA
+
+// projectBackend serves a single project over GET and updates its name over PUT
+type projectBackend struct {
+	mu    sync.Mutex
+	name  string
+	gets  int
+	watch int
+}
+
+func (b *projectBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if strings.HasSuffix(r.URL.Path, "/watch") {
+		b.watch++
+		_, _ = io.WriteString(w, "stream")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		b.gets++
+		project := &projectPbv1.Project{ProjectId: exportProjectA, Name: b.name}
+		if err := server.ETagResponseOption(r.Context(), w, project); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"projectId":%q,"name":%q}`, exportProjectA, b.name)
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		b.name = string(body)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (b *projectBackend) getCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.gets
+}
+
+func etagRequest(t *testing.T, handler http.Handler, method, path, ifNoneMatch, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestETagMiddleware_ConditionalGet(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	backend := &projectBackend{name: "Alpha"}
+	handler := server.ETagMiddleware(backend)
+
+	first := etagRequest(t, handler, http.MethodGet, etagProjectPath, "", "")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Contains(t, first.Body.String(), "Alpha")
+
+	// The ETag is the weak SHA-256 of the serialized response message
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(&projectPbv1.Project{ProjectId: exportProjectA, Name: "Alpha"})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`W/"%x"`, sha256.Sum256(data)), etag)
+
+	// A repeated GET with the ETag is checked against the current response and gets 304
+	second := etagRequest(t, handler, http.MethodGet, etagProjectPath, etag, "")
+	assert.Equal(t, http.StatusNotModified, second.Code)
+	assert.Empty(t, second.Body.String())
+	assert.Equal(t, etag, second.Header().Get("ETag"))
+	assert.Equal(t, 2, backend.getCount())
+
+	// A different ETag gets the full response
+	stale := etagRequest(t, handler, http.MethodGet, etagProjectPath, `W/"something-else"`, "")
+	assert.Equal(t, http.StatusOK, stale.Code)
+	assert.Equal(t, etag, stale.Header().Get("ETag"))
+}
+
+func TestETagMiddleware_UpdateChangesETag(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	backend := &projectBackend{name: "Alpha"}
+	handler := server.ETagMiddleware(backend)
+
+	etag := etagRequest(t, handler, http.MethodGet, etagProjectPath, "", "").Header().Get("ETag")
+
+	// The project is changed behind the middleware's back, as by a gRPC call or another replica
+	backend.mu.Lock()
+	backend.name = "Beta"
+	backend.mu.Unlock()
+
+	// The old ETag no longer matches once the project has changed
+	after := etagRequest(t, handler, http.MethodGet, etagProjectPath, etag, "")
+	require.Equal(t, http.StatusOK, after.Code)
+	assert.Contains(t, after.Body.String(), "Beta")
+	assert.NotEqual(t, etag, after.Header().Get("ETag"))
+
+	// Any of several ETags may match
+	rec := etagRequest(t, handler, http.MethodGet, etagProjectPath, "W/\"other\", "+after.Header().Get("ETag"), "")
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+}
+
+func TestETagMiddleware_GRPCUpdateIsNotReportedUnmodified(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, projectRepo.CreateProject(ctx, &projectPbv1.Project{ProjectId: exportProjectA, Name: "Alpha"}))
+	projectService, err := projectsvc.NewProjectService(projectRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = projectService.Close() })
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	projectPbv1.RegisterProjectServiceServer(grpcServer, projectService)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	mux := server.NewGatewayMux()
+	require.NoError(t, projectPbv1.RegisterProjectServiceHandler(ctx, mux, conn))
+	handler := server.ETagMiddleware(mux)
+
+	first := etagRequest(t, handler, http.MethodGet, etagProjectPath, "", "")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, http.StatusNotModified, etagRequest(t, handler, http.MethodGet, etagProjectPath, etag, "").Code)
+
+	// Updated over gRPC, the gateway never sees the write
+	_, err = projectPbv1.NewProjectServiceClient(conn).UpdateProject(ctx, &projectPbv1.UpdateProjectRequest{ProjectId: exportProjectA, Name: "Beta"})
+	require.NoError(t, err)
+
+	after := etagRequest(t, handler, http.MethodGet, etagProjectPath, etag, "")
+	require.Equal(t, http.StatusOK, after.Code)
+	assert.Contains(t, after.Body.String(), "Beta")
+	assert.NotEqual(t, etag, after.Header().Get("ETag"))
+}
+
+func TestETagMiddleware_SkipsOtherPaths(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	backend := &projectBackend{name: "Alpha"}
+	handler := server.ETagMiddleware(backend)
+
+	// The watch stream must not be buffered
+	rec := etagRequest(t, handler, http.MethodGet, "/api/v1/issues/"+exportProjectA+"/watch", "*", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "stream", rec.Body.String())
+	assert.Empty(t, rec.Header().Get("ETag"))
+
+	// User reads record activity, so they always reach the backend
+	etagRequest(t, handler, http.MethodGet, "/v1/users/"+exportProjectA, "", "")
+	rec = etagRequest(t, handler, http.MethodGet, "/v1/users/"+exportProjectA, "*", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 2, backend.getCount())
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportPageSize is the number of issues fetched from the repository per page while exporting
+const exportPageSize = 100
+
+var (
+	issueCSVHeader   = []string{"issue_id", "summary", "description", "status", "resolution", "type", "priority", "project_id", "assignee_id", "create_date", "modify_date"}
+	projectCSVHeader = []string{"project_id", "name", "description", "issue_count"}
+)
+
+// ExportHandler serves CSV exports of issues and projects
+type ExportHandler struct {
+	issuesRepo  issuessvc.IssuesRepository
+	projectRepo projectsvc.ProjectRepository
+}
+
+// NewExportHandler creates a new export handler backed by the given repositories
+func NewExportHandler(issuesRepo issuessvc.IssuesRepository, projectRepo projectsvc.ProjectRepository) *ExportHandler {
+	return &ExportHandler{
+		issuesRepo:  issuesRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// ServeIssues streams all issues as CSV, optionally scoped by the project_id query parameter
+func (h *ExportHandler) ServeIssues(w http.ResponseWriter, r *http.Request) {
+	projectID := r.URL.Query().Get("project_id")
+
+	var writer *csv.Writer
+	pageToken := ""
+	for {
+		issues, nextPageToken, err := h.issuesRepo.ListIssues(r.Context(), pageToken, exportPageSize)
+		if err != nil {
+			logger.ZapLogger.Error("Failed to list issues for export", zap.Error(err))
+			if writer == nil {
+				http.Error(w, "failed to export issues", http.StatusInternalServerError)
+			}
+			return
+		}
+
+		// Only write headers once the first page has been fetched successfully
+		if writer == nil {
+			writer = startCSVDownload(w, "issues.csv", issueCSVHeader)
+		}
+
+		for _, issue := range issues {
+			if projectID != "" && issue.ProjectId != projectID {
+				continue
+			}
+			if err := writer.Write(issueToCSVRecord(issue)); err != nil {
+				logger.ZapLogger.Error("Failed to write issue CSV record", zap.Error(err))
+				return
+			}
+		}
+		flushCSV(w, writer)
+
+		if nextPageToken == "" || len(issues) == 0 {
+			return
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// ServeProjects streams all projects as CSV
+func (h *ExportHandler) ServeProjects(w http.ResponseWriter, r *http.Request) {
+	projects, err := h.projectRepo.ListProjects(r.Context())
+	if err != nil {
+		logger.ZapLogger.Error("Failed to list projects for export", zap.Error(err))
+		http.Error(w, "failed to export projects", http.StatusInternalServerError)
+		return
+	}
+
+	writer := startCSVDownload(w, "projects.csv", projectCSVHeader)
+	for _, project := range projects {
+		record := []string{
+			project.ProjectId,
+			project.Name,
+			project.Description,
+			strconv.Itoa(int(project.IssueCount)),
+		}
+		if err := writer.Write(record); err != nil {
+			logger.ZapLogger.Error("Failed to write project CSV record", zap.Error(err))
+			return
+		}
+	}
+	flushCSV(w, writer)
+}
+
+// startCSVDownload sets the download headers and writes the CSV header row
+func startCSVDownload(w http.ResponseWriter, filename string, header []string) *csv.Writer {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		logger.ZapLogger.Error("Failed to write CSV header", zap.Error(err))
+	}
+	return writer
+}
+
+// flushCSV flushes buffered CSV records and pushes them to the client
+func flushCSV(w http.ResponseWriter, writer *csv.Writer) {
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		logger.ZapLogger.Error("Failed to flush CSV export", zap.Error(err))
+		return
+	}
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func issueToCSVRecord(issue *issuesPbv1.Issue) []string {
+	return []string{
+		issue.IssueId,
+		issue.Summary,
+		issue.Description,
+		issue.Status.String(),
+		issue.Resolution.String(),
+		issue.Type.String(),
+		issue.Priority.String(),
+		issue.ProjectId,
+		issue.AssigneeId,
+		formatCSVTimestamp(issue.CreateDate),
+		formatCSVTimestamp(issue.ModifyDate),
+	}
+}
+
+func formatCSVTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
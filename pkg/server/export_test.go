@@ -0,0 +1,96 @@
+package server_test
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const (
+	exportProjectA = "928f705f-0efa-4c96-b2f6-ceb36281e1f1"
+	exportProjectB = "0cfc6cee-67a0-4b2a-8a99-4afa10d4a143"
+)
+
+func newExportHandler(t *testing.T) *server.ExportHandler {
+	t.Helper()
+	logger.ZapLogger, _ = zap.NewDevelopment()
+
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+
+	require.NoError(t, projectRepo.CreateProject(context.Background(), &projectPbv1.Project{
+		ProjectId:   exportProjectA,
+		Name:        "Alpha",
+		Description: "First, with a comma",
+		IssueCount:  2,
+	}))
+
+	issues := []*issuesPbv1.Issue{
+		{IssueId: "c72d237e-2658-4252-be58-760c7867d783", Summary: "Crash on save", Status: issuesPbv1.Status_NEW, Type: issuesPbv1.Type_BUG, Priority: issuesPbv1.Priority_CRITICAL, ProjectId: exportProjectA},
+		{IssueId: "d72d237e-2658-4252-be58-760c7867d783", Summary: "Dark mode", Status: issuesPbv1.Status_ASSIGNED, Type: issuesPbv1.Type_FEATURE, Priority: issuesPbv1.Priority_MINOR, ProjectId: exportProjectB},
+	}
+	for _, issue := range issues {
+		require.NoError(t, issuesRepo.CreateIssue(context.Background(), issue))
+	}
+
+	return server.NewExportHandler(issuesRepo, projectRepo)
+}
+
+func TestExportHandler_ServeIssues(t *testing.T) {
+	handler := newExportHandler(t)
+
+	testCases := []struct {
+		name         string
+		url          string
+		expectedRows int
+	}{
+		{name: "All issues", url: "/export/issues.csv", expectedRows: 2},
+		{name: "Scoped to project", url: "/export/issues.csv?project_id=" + exportProjectB, expectedRows: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeIssues(rr, httptest.NewRequest(http.MethodGet, tc.url, nil))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Contains(t, rr.Header().Get("Content-Disposition"), `filename="issues.csv"`)
+
+			records, err := csv.NewReader(rr.Body).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, tc.expectedRows+1)
+			assert.Equal(t, "issue_id", records[0][0])
+			assert.Contains(t, []string{"NEW", "ASSIGNED"}, records[1][3])
+		})
+	}
+}
+
+func TestExportHandler_ServeProjects(t *testing.T) {
+	handler := newExportHandler(t)
+
+	rr := httptest.NewRecorder()
+	handler.ServeProjects(rr, httptest.NewRequest(http.MethodGet, "/export/projects.csv", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Header().Get("Content-Disposition"), `filename="projects.csv"`)
+
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{exportProjectA, "Alpha", "First, with a comma", "2"}, records[1])
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+// validationErrorPattern matches the "invalid Message.Field: reason" errors generated by protoc-gen-validate
+var validationErrorPattern = regexp.MustCompile(`invalid (\w+)\.(\w+): (.*)`)
+
+// ErrorResponse is the envelope of every error returned by the HTTP gateway
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail describes an error returned by the HTTP gateway
+type ErrorDetail struct {
+	// Status is the gRPC status code name, such as NOT_FOUND
+	Status     string `json:"status"`
+	HTTPStatus int    `json:"http_status"`
+	Message    string `json:"message"`
+	// TraceID matches the trace_id of the request in the logs
+	TraceID         string           `json:"trace_id,omitempty"`
+	FieldViolations []FieldViolation `json:"field_violations,omitempty"`
+}
+
+// FieldViolation is a request field that failed validation
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+	// Rule names the validation rule the field failed, such as UUID or LENGTH_BETWEEN
+	Rule string `json:"rule,omitempty"`
+}
+
+// NewGatewayMux creates the grpc-gateway mux with the service's error format, enum names
+// in JSON responses and ETag support
+func NewGatewayMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithErrorHandler(GatewayErrorHandler),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					EmitUnpopulated: true,
+					UseEnumNumbers:  false,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		}),
+		runtime.WithForwardResponseOption(ETagResponseOption),
+		runtime.WithMetadata(RequestIDMetadata),
+	)
+}
+
+// GatewayErrorHandler writes gRPC errors from the gateway as an ErrorResponse. Validation
+// errors are already in the language of the request's Accept-Language, which the gateway
+// forwards to the services.
+func GatewayErrorHandler(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	// Routing errors carry their own HTTP status, such as 405 Method Not Allowed
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		err = customStatus.Err
+	}
+
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if customStatus != nil {
+		httpStatus = customStatus.HTTPStatus
+	}
+
+	violations := badRequestViolations(st)
+	if violations == nil {
+		violations = fieldViolations(st.Code(), st.Message())
+	}
+	writeErrorResponseWithViolations(ctx, w, httpStatus, st.Code(), st.Message(), violations)
+}
+
+// writeErrorResponse writes an ErrorResponse; handlers outside the gateway use it too
+// so every error the HTTP server returns has the same shape
+func writeErrorResponse(ctx context.Context, w http.ResponseWriter, httpStatus int, c codes.Code, message string) {
+	writeErrorResponseWithViolations(ctx, w, httpStatus, c, message, fieldViolations(c, message))
+}
+
+func writeErrorResponseWithViolations(ctx context.Context, w http.ResponseWriter, httpStatus int, c codes.Code, message string, violations []FieldViolation) {
+	response := ErrorResponse{
+		Error: ErrorDetail{
+			Status:          code.Code_name[int32(c)],
+			HTTPStatus:      httpStatus,
+			Message:         message,
+			TraceID:         logger.TraceIDFromContext(ctx),
+			FieldViolations: violations,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.ZapLogger.Error("Failed to encode error response", zap.Error(err))
+	}
+}
+
+// badRequestViolations returns the field violations in the BadRequest details of a status,
+// or nil without any
+func badRequestViolations(st *status.Status) []FieldViolation {
+	var violations []FieldViolation
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range badRequest.GetFieldViolations() {
+			violations = append(violations, FieldViolation{Field: v.GetField(), Description: v.GetDescription(), Rule: v.GetReason()})
+		}
+	}
+	return violations
+}
+
+// fieldViolations extracts the field named by a protoc-gen-validate error message
+func fieldViolations(c codes.Code, message string) []FieldViolation {
+	if c != codes.InvalidArgument {
+		return nil
+	}
+	match := validationErrorPattern.FindStringSubmatch(message)
+	if match == nil {
+		return nil
+	}
+	return []FieldViolation{{Field: snakeCase(match[2]), Description: match[3]}}
+}
+
+// snakeCase converts a generated Go field name such as ProjectId to its proto name project_id
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
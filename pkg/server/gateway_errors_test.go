@@ -0,0 +1,193 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const gatewayIssueID = "c72d237e-2658-4252-be58-760c7867d783"
+
+// newGateway serves the project and issues services over an in-memory listener and
+// returns the HTTP gateway in front of them
+func newGateway(t *testing.T, projectRepo projectsvc.ProjectRepository) http.Handler {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	projectService, err := projectsvc.NewProjectService(projectRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = projectService.Close() })
+
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, issuesRepo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+		IssueId:  gatewayIssueID,
+		Summary:  "Login fails",
+		Type:     issuesPbv1.Type_BUG,
+		Priority: issuesPbv1.Priority_MAJOR,
+		Status:   issuesPbv1.Status_NEW,
+	}))
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	projectPbv1.RegisterProjectServiceServer(grpcServer, projectService)
+	issuesPbv1.RegisterIssuesServiceServer(grpcServer, issuessvc.NewIssuesService(issuesRepo, nil, nil))
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	mux := server.NewGatewayMux()
+	require.NoError(t, projectPbv1.RegisterProjectServiceHandler(context.Background(), mux, conn))
+	require.NoError(t, issuesPbv1.RegisterIssuesServiceHandler(context.Background(), mux, conn))
+	return server.LoggingMiddleware(mux)
+}
+
+func gatewayRequest(t *testing.T, handler http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return rec
+}
+
+func TestGatewayErrorHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	projectRepo := mocks.NewMockProjectRepository(ctrl)
+	projectRepo.EXPECT().ReadProject(gomock.Any(), "missing").Return(nil, errors.New("project not found")).AnyTimes()
+	projectRepo.EXPECT().ListProjectsPage(gomock.Any(), "", 10).Return(nil, "", errors.New("connection reset")).AnyTimes()
+	handler := newGateway(t, projectRepo)
+
+	testCases := []struct {
+		name               string
+		method             string
+		path               string
+		body               string
+		expectedHTTPStatus int
+		expectedStatus     string
+		expectedViolations []server.FieldViolation
+	}{
+		{
+			name:               "not found",
+			method:             http.MethodGet,
+			path:               "/v1/projects/missing",
+			expectedHTTPStatus: http.StatusNotFound,
+			expectedStatus:     "NOT_FOUND",
+		},
+		{
+			name:               "validation error",
+			method:             http.MethodPost,
+			path:               "/v1/projects/p1/templates",
+			body:               `{"name":"Bug report","default_type":"QUESTION"}`,
+			expectedHTTPStatus: http.StatusBadRequest,
+			expectedStatus:     "INVALID_ARGUMENT",
+			expectedViolations: []server.FieldViolation{{
+				Field:       "default_type",
+				Description: `value must be in list [ COSMETIC BUG FEATURE PERFORMANCE]`,
+				Rule:        "IN",
+			}},
+		},
+		{
+			name:               "internal error",
+			method:             http.MethodGet,
+			path:               "/v1/projects",
+			expectedHTTPStatus: http.StatusInternalServerError,
+			expectedStatus:     "INTERNAL",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := gatewayRequest(t, handler, tc.method, tc.path, tc.body)
+
+			assert.Equal(t, tc.expectedHTTPStatus, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var response server.ErrorResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+			assert.Equal(t, tc.expectedStatus, response.Error.Status)
+			assert.Equal(t, tc.expectedHTTPStatus, response.Error.HTTPStatus)
+			assert.NotEmpty(t, response.Error.Message)
+			assert.NotEmpty(t, response.Error.TraceID)
+			assert.Equal(t, tc.expectedViolations, response.Error.FieldViolations)
+		})
+	}
+}
+
+func TestGatewayErrorHandler_LocalizesValidationErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := newGateway(t, mocks.NewMockProjectRepository(ctrl))
+
+	testCases := []struct {
+		acceptLanguage      string
+		expectedMessage     string
+		expectedDescription string
+	}{
+		{
+			acceptLanguage:      "tr-TR,tr;q=0.9,en;q=0.8",
+			expectedMessage:     "geçersiz istek: geçersiz GetIssueRequest.IssueId: değer geçerli bir UUID olmalıdır",
+			expectedDescription: "değer geçerli bir UUID olmalıdır",
+		},
+		{
+			acceptLanguage:      "fr-FR",
+			expectedMessage:     "invalid request: invalid GetIssueRequest.IssueId: value must be a valid UUID | caused by: invalid uuid format",
+			expectedDescription: "value must be a valid UUID",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.acceptLanguage, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/issues/not-a-uuid", nil)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var response server.ErrorResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+			assert.Equal(t, tc.expectedMessage, response.Error.Message)
+			assert.Equal(t, []server.FieldViolation{{Field: "issue_id", Description: tc.expectedDescription, Rule: "UUID"}},
+				response.Error.FieldViolations)
+		})
+	}
+}
+
+func TestGatewayMux_RendersEnumNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := newGateway(t, mocks.NewMockProjectRepository(ctrl))
+
+	rec := gatewayRequest(t, handler, http.MethodGet, "/api/v1/issues/"+gatewayIssueID, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Issue map[string]any `json:"issue"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, "BUG", response.Issue["type"])
+	assert.Equal(t, "MAJOR", response.Issue["priority"])
+	assert.Equal(t, "NEW", response.Issue["status"])
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+)
+
+// defaultGRPCWebPort is used when GRPC_WEB_PORT is not set
+const defaultGRPCWebPort = "8082"
+
+// grpcWebAllowedHeaders are the request headers browsers may send on gRPC-Web calls; CORS
+// pre-flight requests listing any other header are refused
+var grpcWebAllowedHeaders = []string{
+	"content-type",
+	"x-grpc-web",
+	"x-user-agent",
+	"grpc-timeout",
+	"grpc-accept-encoding",
+	"authorization",
+}
+
+// GRPCWebPortFromEnv reads the port the gRPC-Web proxy for browser clients listens on from
+// GRPC_WEB_PORT, in the ":port" form
+func GRPCWebPortFromEnv() string {
+	port := os.Getenv("GRPC_WEB_PORT")
+	if port == "" {
+		port = defaultGRPCWebPort
+	}
+	if !strings.Contains(port, ":") {
+		port = ":" + port
+	}
+	return port
+}
+
+// SetGRPCWebPort sets the port Start serves gRPC-Web on
+func (s *GRPCServer) SetGRPCWebPort(port string) {
+	s.grpcWebPort = port
+}
+
+// startGRPCWebProxy serves the gRPC services to browser clients over gRPC-Web on grpcWebPort
+func (s *GRPCServer) startGRPCWebProxy(grpcWebPort string) error {
+	listener, err := net.Listen("tcp", grpcWebPort)
+	if err != nil {
+		return err
+	}
+
+	log.Println("gRPC-Web proxy started on " + grpcWebPort)
+	return s.ServeGRPCWeb(listener)
+}
+
+// ServeGRPCWeb serves gRPC-Web calls, in both the binary and the base64 text format, on the
+// listener until the server is stopped. Calls are accepted from any origin.
+func (s *GRPCServer) ServeGRPCWeb(listener net.Listener) error {
+	wrapped := grpcweb.WrapServer(s.server,
+		grpcweb.WithOriginFunc(func(origin string) bool { return true }),
+		grpcweb.WithAllowedRequestHeaders(grpcWebAllowedHeaders),
+	)
+
+	server := &http.Server{
+		Handler:     LoggingMiddleware(wrapped),
+		ReadTimeout: 30 * time.Second,
+		// Server-streaming calls such as StreamProjectUpdates stay open, so writes aren't timed out
+		IdleTimeout: 120 * time.Second,
+	}
+	s.grpcWebMu.Lock()
+	s.grpcWebServer = server
+	s.grpcWebMu.Unlock()
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// stopGRPCWeb closes the gRPC-Web listener and any open gRPC-Web calls
+func (s *GRPCServer) stopGRPCWeb() {
+	s.grpcWebMu.Lock()
+	server := s.grpcWebServer
+	s.grpcWebMu.Unlock()
+	if server != nil {
+		_ = server.Close()
+	}
+}
@@ -0,0 +1,172 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+)
+
+// serveGRPCWeb serves the test services over gRPC-Web on a free local port and returns its base URL
+func serveGRPCWeb(t *testing.T) string {
+	t.Helper()
+	grpcServer, _ := newServingGRPCServer(t)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	done := make(chan error, 1)
+	go func() { done <- grpcServer.ServeGRPCWeb(lis) }()
+	t.Cleanup(func() {
+		require.NoError(t, grpcServer.Stop())
+		assert.NoError(t, <-done)
+	})
+
+	return "http://" + lis.Addr().String()
+}
+
+// grpcWebFrame frames a message the way gRPC-Web does: a flag byte, a 4-byte length and the message
+func grpcWebFrame(flag byte, message []byte) []byte {
+	frame := make([]byte, 5, 5+len(message))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+	return append(frame, message...)
+}
+
+// decodeGRPCWebText decodes a gRPC-Web text response, which is base64 encoded a flush at a time,
+// so padding can appear before the end of the body
+func decodeGRPCWebText(body []byte) ([]byte, error) {
+	var decoded []byte
+	for len(body) > 0 {
+		end := bytes.IndexByte(body, '=')
+		if end < 0 {
+			end = len(body)
+		}
+		for end < len(body) && body[end] == '=' {
+			end++
+		}
+		chunk, err := base64.StdEncoding.DecodeString(string(body[:end]))
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, chunk...)
+		body = body[end:]
+	}
+	return decoded, nil
+}
+
+// readGRPCWebResponse splits a gRPC-Web response body into its message and its trailers
+func readGRPCWebResponse(t *testing.T, body []byte) ([]byte, string) {
+	t.Helper()
+	var message []byte
+	var trailers string
+	for len(body) > 0 {
+		require.GreaterOrEqual(t, len(body), 5)
+		size := binary.BigEndian.Uint32(body[1:5])
+		payload := body[5 : 5+size]
+		if body[0]&0x80 != 0 {
+			trailers = string(payload)
+		} else {
+			message = payload
+		}
+		body = body[5+size:]
+	}
+	return message, trailers
+}
+
+func TestGRPCServer_GRPCWeb(t *testing.T) {
+	baseURL := serveGRPCWeb(t)
+	url := baseURL + issuesPbv1.IssuesService_GetIssue_FullMethodName
+
+	request, err := proto.Marshal(&issuesPbv1.GetIssueRequest{IssueId: shutdownIssueID})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		contentType string
+		encode      func([]byte) []byte
+		decode      func([]byte) ([]byte, error)
+	}{
+		{
+			name:        "binary",
+			contentType: "application/grpc-web+proto",
+			encode:      func(b []byte) []byte { return b },
+			decode:      func(b []byte) ([]byte, error) { return b, nil },
+		},
+		{
+			name:        "text",
+			contentType: "application/grpc-web-text",
+			encode:      func(b []byte) []byte { return []byte(base64.StdEncoding.EncodeToString(b)) },
+			decode:      decodeGRPCWebText,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(tc.encode(grpcWebFrame(0, request))))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tc.contentType)
+			req.Header.Set("X-Grpc-Web", "1")
+			req.Header.Set("Origin", "http://localhost:3000")
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.True(t, strings.HasPrefix(resp.Header.Get("Content-Type"), tc.contentType))
+			assert.Equal(t, "http://localhost:3000", resp.Header.Get("Access-Control-Allow-Origin"))
+
+			encoded, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			body, err := tc.decode(encoded)
+			require.NoError(t, err)
+			message, trailers := readGRPCWebResponse(t, body)
+			assert.Contains(t, trailers, "grpc-status: 0")
+
+			var got issuesPbv1.GetIssueResponse
+			require.NoError(t, proto.Unmarshal(message, &got))
+			assert.Equal(t, shutdownIssueID, got.Issue.IssueId)
+			assert.Equal(t, "Login fails", got.Issue.Summary)
+		})
+	}
+}
+
+func TestGRPCServer_GRPCWebPreflight(t *testing.T) {
+	baseURL := serveGRPCWeb(t)
+
+	req, err := http.NewRequest(http.MethodOptions, baseURL+issuesPbv1.IssuesService_GetIssue_FullMethodName, nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "http://localhost:3000")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web,x-user-agent")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Less(t, resp.StatusCode, 300)
+	assert.Equal(t, "http://localhost:3000", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, strings.ToLower(resp.Header.Get("Access-Control-Allow-Headers")), "x-grpc-web")
+}
+
+func TestGRPCWebPortFromEnv(t *testing.T) {
+	t.Setenv("GRPC_WEB_PORT", "")
+	assert.Equal(t, ":8082", server.GRPCWebPortFromEnv())
+
+	t.Setenv("GRPC_WEB_PORT", "9090")
+	assert.Equal(t, ":9090", server.GRPCWebPortFromEnv())
+
+	t.Setenv("GRPC_WEB_PORT", "127.0.0.1:9090")
+	assert.Equal(t, "127.0.0.1:9090", server.GRPCWebPortFromEnv())
+}
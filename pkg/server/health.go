@@ -0,0 +1,215 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
+)
+
+const (
+	// defaultHealthCheckInterval is used when HEALTH_CHECK_INTERVAL_SECONDS is not set
+	defaultHealthCheckInterval = 10 * time.Second
+	// healthStaleIntervals is the number of check intervals without a result after which
+	// the cached status is reported as unhealthy
+	healthStaleIntervals = 3
+)
+
+// Build metadata reported by the health endpoint, set at build time with
+// -ldflags "-X github.com/yasindce1998/issue-tracker/pkg/server.Version=... -X ...GitCommit=..."
+var (
+	Version   = "dev"
+	GitCommit = "unknown"
+)
+
+// errHealthStatusStale is reported for both dependencies when the latest check result is too old
+var errHealthStatusStale = errors.New("health status is stale, the background health checker is not running")
+
+// HealthCheckIntervalFromEnv reads how often the database and cache are checked from
+// HEALTH_CHECK_INTERVAL_SECONDS
+func HealthCheckIntervalFromEnv() time.Duration {
+	if v, err := strconv.Atoi(os.Getenv("HEALTH_CHECK_INTERVAL_SECONDS")); err == nil && v > 0 {
+		return time.Duration(v) * time.Second
+	}
+	return defaultHealthCheckInterval
+}
+
+// healthResult is the outcome of one round of dependency checks
+type healthResult struct {
+	dbErr     error
+	cacheErr  error
+	checkedAt time.Time
+}
+
+// HealthChecker checks the database and cache in the background and serves the latest
+// result on the health endpoint, so health probes don't put load on either dependency.
+// If no check has completed for several intervals, for example because the checker was
+// stopped, the endpoint reports the service as unhealthy.
+type HealthChecker struct {
+	interval   time.Duration
+	checkDB    func() error
+	checkCache func() error
+	// startedAt is when the process started, used to report uptime
+	startedAt time.Time
+	// cacheTTLs are the effective cache TTLs in seconds, reported so operators can check their configuration
+	cacheTTLs map[string]int64
+
+	mu     sync.RWMutex
+	latest healthResult
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewHealthChecker creates a health checker that runs checkDB and checkCache every interval
+func NewHealthChecker(interval time.Duration, checkDB, checkCache func() error) *HealthChecker {
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+	return &HealthChecker{
+		interval:   interval,
+		checkDB:    checkDB,
+		checkCache: checkCache,
+		startedAt:  time.Now(),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// SetCacheTTLs sets the cache TTLs the health endpoint reports, so operators can confirm
+// their configuration took effect
+func (c *HealthChecker) SetCacheTTLs(cfg cache.CacheConfig) {
+	c.cacheTTLs = cfg.TTLSeconds()
+}
+
+// Start runs the first check and then keeps checking in the background until Stop is called
+func (c *HealthChecker) Start() {
+	c.startOnce.Do(func() {
+		c.check()
+		go c.run()
+	})
+}
+
+// Stop ends background checking and waits for an in-flight check to finish
+func (c *HealthChecker) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+	// If the checker never started, this keeps it from starting and releases the wait below
+	c.startOnce.Do(func() { close(c.done) })
+	<-c.done
+}
+
+func (c *HealthChecker) run() {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.check()
+		}
+	}
+}
+
+// check runs the dependency checks and records the result
+func (c *HealthChecker) check() {
+	result := healthResult{
+		dbErr:     c.checkDB(),
+		cacheErr:  c.checkCache(),
+		checkedAt: time.Now(),
+	}
+
+	c.mu.Lock()
+	c.latest = result
+	c.mu.Unlock()
+}
+
+// status returns the latest result, or errors for both dependencies if it is out of date
+func (c *HealthChecker) status() healthResult {
+	c.mu.RLock()
+	result := c.latest
+	c.mu.RUnlock()
+
+	if time.Since(result.checkedAt) > healthStaleIntervals*c.interval {
+		return healthResult{dbErr: errHealthStatusStale, cacheErr: errHealthStatusStale, checkedAt: result.checkedAt}
+	}
+	return result
+}
+
+// ServeHTTP handles health check requests with the latest background check result
+func (c *HealthChecker) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	result := c.status()
+
+	status := "ok"
+	dbStatus := "ok"
+	cacheStatus := "ok"
+	httpStatus := http.StatusOK
+
+	// Report database health
+	if result.dbErr != nil {
+		dbStatus = "error: " + result.dbErr.Error()
+		status = "error"
+		httpStatus = http.StatusServiceUnavailable
+	}
+
+	// Report cache health; running without a cache is not an error
+	cacheEnabled := !errors.Is(result.cacheErr, cache.ErrCacheDisabled)
+	if !cacheEnabled {
+		cacheStatus = "disabled"
+	} else if result.cacheErr != nil {
+		cacheStatus = "error: " + result.cacheErr.Error()
+		status = "error" // Update overall status
+		httpStatus = http.StatusServiceUnavailable
+	}
+
+	response := HealthResponse{
+		Status:              status,
+		DbStatus:            dbStatus,
+		DbType:              os.Getenv("DB_TYPE"),
+		CacheStatus:         cacheStatus,
+		CacheType:           os.Getenv("CACHE_TYPE"),
+		CacheEnabled:        cacheEnabled,
+		AppName:             "Issue Tracker",
+		CommunicationMethod: getCommMethod(),
+		CheckedAt:           result.checkedAt,
+		Version:             Version,
+		GitCommit:           GitCommit,
+		Uptime:              time.Since(c.startedAt).Round(time.Second).String(),
+		GoVersion:           runtime.Version(),
+		CircuitBreakers:     resilience.Snapshot(),
+		CacheTTLSeconds:     c.cacheTTLs,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus) // Set appropriate HTTP status code
+
+	// Check for encoding errors
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.ZapLogger.Error("Failed to encode health check response", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Log health check results
+	logger.ZapLogger.Debug("Health check performed",
+		zap.String("status", status),
+		zap.String("db_status", dbStatus),
+		zap.String("cache_status", cacheStatus))
+}
@@ -0,0 +1,195 @@
+package server_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+)
+
+func healthRequest(t *testing.T, checker *server.HealthChecker) (int, server.HealthResponse) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var response server.HealthResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	return rec.Code, response
+}
+
+func TestHealthChecker_ServesCachedStatus(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	var dbChecks atomic.Int32
+	checker := server.NewHealthChecker(time.Hour,
+		func() error { dbChecks.Add(1); return nil },
+		func() error { return nil })
+	checker.Start()
+	defer checker.Stop()
+
+	for i := 0; i < 5; i++ {
+		code, response := healthRequest(t, checker)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ok", response.Status)
+	}
+	// Only the check made on Start reached the database
+	assert.Equal(t, int32(1), dbChecks.Load())
+}
+
+func TestHealthChecker_ReportsFailedCheck(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	checker := server.NewHealthChecker(time.Hour,
+		func() error { return nil },
+		func() error { return errors.New("connection refused") })
+	checker.Start()
+	defer checker.Stop()
+
+	code, response := healthRequest(t, checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "error", response.Status)
+	assert.Equal(t, "ok", response.DbStatus)
+	assert.Equal(t, "error: connection refused", response.CacheStatus)
+}
+
+func TestHealthChecker_DistinguishesDisabledCache(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	testCases := []struct {
+		name           string
+		cacheErr       error
+		expectedCode   int
+		expectedStatus string
+		expectedCache  string
+		expectedOn     bool
+	}{
+		{name: "Healthy", expectedCode: http.StatusOK, expectedStatus: "ok", expectedCache: "ok", expectedOn: true},
+		{name: "Disabled", cacheErr: cache.ErrCacheDisabled, expectedCode: http.StatusOK, expectedStatus: "ok", expectedCache: "disabled", expectedOn: false},
+		{
+			name:           "Expected but not initialized",
+			cacheErr:       fmt.Errorf("%w: CACHE_TYPE is redis but no cache was created", cache.ErrCacheNotInitialized),
+			expectedCode:   http.StatusServiceUnavailable,
+			expectedStatus: "error",
+			expectedCache:  "error: cache not initialized: CACHE_TYPE is redis but no cache was created",
+			expectedOn:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := server.NewHealthChecker(time.Hour, func() error { return nil }, func() error { return tc.cacheErr })
+			checker.Start()
+			defer checker.Stop()
+
+			code, response := healthRequest(t, checker)
+			assert.Equal(t, tc.expectedCode, code)
+			assert.Equal(t, tc.expectedStatus, response.Status)
+			assert.Equal(t, tc.expectedCache, response.CacheStatus)
+			assert.Equal(t, tc.expectedOn, response.CacheEnabled)
+		})
+	}
+}
+
+func TestHealthChecker_RechecksPeriodically(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	var failing atomic.Bool
+	checker := server.NewHealthChecker(10*time.Millisecond,
+		func() error {
+			if failing.Load() {
+				return errors.New("ping failed")
+			}
+			return nil
+		},
+		func() error { return nil })
+	checker.Start()
+	defer checker.Stop()
+
+	code, _ := healthRequest(t, checker)
+	require.Equal(t, http.StatusOK, code)
+
+	failing.Store(true)
+	assert.Eventually(t, func() bool {
+		code, _ := healthRequest(t, checker)
+		return code == http.StatusServiceUnavailable
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestHealthChecker_StaleAfterStop(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	checker := server.NewHealthChecker(10*time.Millisecond, func() error { return nil }, func() error { return nil })
+	checker.Start()
+	checker.Stop()
+
+	assert.Eventually(t, func() bool {
+		code, response := healthRequest(t, checker)
+		return code == http.StatusServiceUnavailable && response.Status == "error"
+	}, time.Second, 10*time.Millisecond)
+
+	// A checker that never started has no status to report
+	code, _ := healthRequest(t, server.NewHealthChecker(time.Hour, func() error { return nil }, func() error { return nil }))
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestHealthChecker_ReportsBuildInfo(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	version, commit := server.Version, server.GitCommit
+	server.Version, server.GitCommit = "v1.2.3", "abc1234"
+	defer func() { server.Version, server.GitCommit = version, commit }()
+
+	checker := server.NewHealthChecker(time.Hour, func() error { return nil }, func() error { return nil })
+	checker.Start()
+	defer checker.Stop()
+
+	_, response := healthRequest(t, checker)
+	assert.Equal(t, "v1.2.3", response.Version)
+	assert.Equal(t, "abc1234", response.GitCommit)
+	assert.Equal(t, runtime.Version(), response.GoVersion)
+	uptime, err := time.ParseDuration(response.Uptime)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, uptime, time.Duration(0))
+}
+
+func TestHealthChecker_ReportsCacheTTLs(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	checker := server.NewHealthChecker(time.Hour, func() error { return nil }, func() error { return nil })
+	checker.SetCacheTTLs(cache.CacheConfig{
+		IssueTTL:       30 * time.Second,
+		IssueListTTL:   time.Minute,
+		UserTTL:        2 * time.Hour,
+		ProjectTTL:     time.Hour,
+		ProjectListTTL: 10 * time.Minute,
+
+		IssueResponseTTL: 30 * time.Second,
+	})
+	checker.Start()
+	defer checker.Stop()
+
+	_, response := healthRequest(t, checker)
+	assert.Equal(t, map[string]int64{
+		"issue":          30,
+		"issue_list":     60,
+		"user":           7200,
+		"user_list":      0,
+		"project":        3600,
+		"project_list":   600,
+		"issue_response": 30,
+	}, response.CacheTTLSeconds)
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+const (
+	// requestIDHeader carries the trace ID of an HTTP request back to the client
+	requestIDHeader = "X-Request-ID"
+	// requestIDMetadataKey carries the trace ID of an HTTP request to the gRPC handler
+	requestIDMetadataKey = "x-request-id"
+)
+
+// RequestIDMetadata forwards the trace ID LoggingMiddleware gave an HTTP request to the gRPC
+// handler as x-request-id metadata, so the HTTP and gRPC log lines of the request share it
+func RequestIDMetadata(ctx context.Context, _ *http.Request) metadata.MD {
+	traceID := logger.TraceIDFromContext(ctx)
+	if traceID == "" {
+		return nil
+	}
+	return metadata.Pairs(requestIDMetadataKey, traceID)
+}
+
+// requestIDFromMetadata returns the x-request-id of an incoming gRPC call, or "" without one.
+// The gateway adds its value after any sent by the client as a Grpc-Metadata- header, so the
+// last value wins.
+func requestIDFromMetadata(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, requestIDMetadataKey); len(values) > 0 {
+		return values[len(values)-1]
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// traceIDsByMessage returns the trace_id of each JSON log line, keyed by its message
+func traceIDsByMessage(t *testing.T, logs string) map[string]string {
+	t.Helper()
+	traceIDs := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	for scanner.Scan() {
+		var line struct {
+			Msg     string `json:"msg"`
+			TraceID string `json:"trace_id"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		traceIDs[line.Msg] = line.TraceID
+	}
+	return traceIDs
+}
+
+func TestRequestID_CorrelatesGatewayAndGRPCLogs(t *testing.T) {
+	buf := newBufferLogger(zapcore.InfoLevel)
+
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, issuesRepo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: gatewayIssueID, Summary: "Login fails"}))
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(server.LoggingInterceptor))
+	issuesPbv1.RegisterIssuesServiceServer(grpcServer, issuessvc.NewIssuesService(issuesRepo, nil, nil))
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	mux := server.NewGatewayMux()
+	require.NoError(t, issuesPbv1.RegisterIssuesServiceHandler(context.Background(), mux, conn))
+	httpServer := httptest.NewServer(server.LoggingMiddleware(mux))
+	t.Cleanup(httpServer.Close)
+
+	resp, err := http.Get(httpServer.URL + "/api/v1/issues/" + gatewayIssueID)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	requestID := resp.Header.Get("X-Request-ID")
+	require.NotEmpty(t, requestID)
+
+	traceIDs := traceIDsByMessage(t, buf.String())
+	assert.Equal(t, requestID, traceIDs["HTTP request received"])
+	assert.Equal(t, requestID, traceIDs["gRPC method called"])
+	assert.Equal(t, requestID, traceIDs["gRPC method completed"])
+	assert.Equal(t, requestID, traceIDs["HTTP request completed"])
+}
+
+func TestLoggingInterceptor_UsesRequestIDMetadata(t *testing.T) {
+	newBufferLogger(zapcore.InfoLevel)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "req-123"))
+
+	var traceID string
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		traceID = logger.TraceIDFromContext(ctx)
+		return "response", nil
+	}
+	_, err := server.LoggingInterceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "req-123", traceID)
+
+	// Without the metadata a new trace ID is generated
+	_, err = server.LoggingInterceptor(context.Background(), "request", &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+	require.NoError(t, err)
+	assert.NotEmpty(t, traceID)
+	assert.NotEqual(t, "req-123", traceID)
+}
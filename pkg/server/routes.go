@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+)
+
+const (
+	// routesPath serves the index of the gateway's routes
+	routesPath = "/v1/routes"
+	// openAPIPathPrefix serves the OpenAPI document of each service, such as /v1/openapi/issues.v1.IssuesService.json
+	openAPIPathPrefix = "/v1/openapi/"
+)
+
+// GatewayService is a gRPC service whose REST API the HTTP gateway serves
+type GatewayService struct {
+	// Desc is the generated description of the service, such as issuesPbv1.IssuesService_ServiceDesc
+	Desc *grpc.ServiceDesc
+	// Register registers the service's handlers on the gateway mux
+	Register func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
+	// OpenAPIDocument is the service's generated OpenAPI document; it is optional
+	OpenAPIDocument []byte
+}
+
+// GatewayServices returns the services the HTTP gateway serves
+func GatewayServices() []GatewayService {
+	return []GatewayService{
+		{Desc: &userPbv1.UserService_ServiceDesc, Register: userPbv1.RegisterUserServiceHandlerFromEndpoint, OpenAPIDocument: userPbv1.OpenAPIDocument},
+		{Desc: &issuesPbv1.IssuesService_ServiceDesc, Register: issuesPbv1.RegisterIssuesServiceHandlerFromEndpoint, OpenAPIDocument: issuesPbv1.OpenAPIDocument},
+		{Desc: &projectPbv1.ProjectService_ServiceDesc, Register: projectPbv1.RegisterProjectServiceHandlerFromEndpoint, OpenAPIDocument: projectPbv1.OpenAPIDocument},
+		{Desc: &teamPbv1.TeamService_ServiceDesc, Register: teamPbv1.RegisterTeamServiceHandlerFromEndpoint, OpenAPIDocument: teamPbv1.OpenAPIDocument},
+	}
+}
+
+// Route is an HTTP route of the gateway
+type Route struct {
+	Method string `json:"method"`
+	// Path is the path template, such as /api/v1/issues/{issue_id}
+	Path string `json:"path"`
+	// GRPCMethod is the full name of the gRPC method the route calls
+	GRPCMethod string `json:"grpc_method"`
+	// AuthRequired is false for every route, as the gateway doesn't authenticate API calls yet
+	AuthRequired bool `json:"auth_required"`
+}
+
+// RoutesResponse is the response of GET /v1/routes
+type RoutesResponse struct {
+	Routes []Route `json:"routes"`
+	// OpenAPI lists the paths the services' OpenAPI documents are served at
+	OpenAPI []string `json:"openapi"`
+}
+
+// RouteIndex registers services on the gateway and records the routes they serve, so the index
+// stays complete as services are added without being maintained by hand
+type RouteIndex struct {
+	routes []Route
+	// documents holds the OpenAPI documents by the path they are served at
+	documents map[string][]byte
+}
+
+// NewRouteIndex creates an empty route index
+func NewRouteIndex() *RouteIndex {
+	return &RouteIndex{documents: make(map[string][]byte)}
+}
+
+// Register registers a service's handlers on mux and records the routes of its methods, read
+// from their google.api.http annotations, which are what the generated handlers are built from
+func (ix *RouteIndex) Register(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption, service GatewayService) error {
+	routes, err := serviceRoutes(service.Desc.ServiceName)
+	if err != nil {
+		return err
+	}
+	if err := service.Register(ctx, mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register %s handler: %w", service.Desc.ServiceName, err)
+	}
+
+	ix.routes = append(ix.routes, routes...)
+	if len(service.OpenAPIDocument) > 0 {
+		ix.documents[openAPIPathPrefix+service.Desc.ServiceName+".json"] = service.OpenAPIDocument
+	}
+	return nil
+}
+
+// Routes returns the recorded routes in the order they were registered
+func (ix *RouteIndex) Routes() []Route {
+	return append([]Route(nil), ix.routes...)
+}
+
+// ServeRoutes lists the recorded routes and where the OpenAPI documents are served
+func (ix *RouteIndex) ServeRoutes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorResponse(r.Context(), w, http.StatusMethodNotAllowed, codes.Unimplemented, "method not allowed")
+		return
+	}
+
+	response := RoutesResponse{Routes: ix.Routes(), OpenAPI: make([]string, 0, len(ix.documents))}
+	for path := range ix.documents {
+		response.OpenAPI = append(response.OpenAPI, path)
+	}
+	sort.Strings(response.OpenAPI)
+	writeJSON(w, response)
+}
+
+// ServeOpenAPI serves the OpenAPI document of a registered service
+func (ix *RouteIndex) ServeOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorResponse(r.Context(), w, http.StatusMethodNotAllowed, codes.Unimplemented, "method not allowed")
+		return
+	}
+
+	document, ok := ix.documents[r.URL.Path]
+	if !ok {
+		writeErrorResponse(r.Context(), w, http.StatusNotFound, codes.NotFound, "no OpenAPI document at "+r.URL.Path)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(document)
+}
+
+// serviceRoutes reads the routes of a service's methods from its registered descriptor
+func serviceRoutes(serviceName string) ([]Route, error) {
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the descriptor of %s: %w", serviceName, err)
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", serviceName)
+	}
+
+	var routes []Route
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		rule, _ := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if rule == nil {
+			// Methods without an annotation are only served over gRPC
+			continue
+		}
+
+		grpcMethod := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+		for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			httpMethod, path := httpRulePattern(binding)
+			if path == "" {
+				continue
+			}
+			routes = append(routes, Route{Method: httpMethod, Path: path, GRPCMethod: grpcMethod})
+		}
+	}
+	return routes, nil
+}
+
+// httpRulePattern returns the HTTP method and path template of a binding
+func httpRulePattern(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	default:
+		return "", ""
+	}
+}
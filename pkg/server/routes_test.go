@@ -0,0 +1,100 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+)
+
+// newRouteIndex registers every gateway service on a new mux. The gateway connects lazily, so
+// nothing needs to listen on the endpoint.
+func newRouteIndex(t *testing.T) *server.RouteIndex {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	index := server.NewRouteIndex()
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	for _, service := range server.GatewayServices() {
+		require.NoError(t, index.Register(ctx, server.NewGatewayMux(), "localhost:0", opts, service))
+	}
+	return index
+}
+
+func TestRouteIndex_RecordsAnnotatedMethods(t *testing.T) {
+	index := newRouteIndex(t)
+
+	// Every route calls a method of a registered service
+	methods := make(map[string]bool)
+	for _, service := range server.GatewayServices() {
+		for _, method := range service.Desc.Methods {
+			methods["/"+service.Desc.ServiceName+"/"+method.MethodName] = true
+		}
+		for _, stream := range service.Desc.Streams {
+			methods["/"+service.Desc.ServiceName+"/"+stream.StreamName] = true
+		}
+	}
+	routes := index.Routes()
+	require.NotEmpty(t, routes)
+	for _, route := range routes {
+		assert.True(t, methods[route.GRPCMethod], "%s %s calls unknown method %s", route.Method, route.Path, route.GRPCMethod)
+	}
+
+	assert.Contains(t, routes, server.Route{Method: http.MethodPost, Path: "/api/v1/issues:validate", GRPCMethod: "/issues.v1.IssuesService/ValidateIssue"})
+	assert.Contains(t, routes, server.Route{Method: http.MethodGet, Path: "/api/v1/issues/{issue_id}", GRPCMethod: "/issues.v1.IssuesService/GetIssue"})
+	assert.Contains(t, routes, server.Route{Method: http.MethodDelete, Path: "/v1/users/{user_id}", GRPCMethod: "/user.v1.UserService/DeleteUser"})
+}
+
+func TestRouteIndex_ServeRoutes(t *testing.T) {
+	index := newRouteIndex(t)
+
+	rec := httptest.NewRecorder()
+	index.ServeRoutes(rec, httptest.NewRequest(http.MethodGet, "/v1/routes", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var response server.RoutesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, index.Routes(), response.Routes)
+	assert.Equal(t, []string{
+		"/v1/openapi/issues.v1.IssuesService.json",
+		"/v1/openapi/project.v1.ProjectService.json",
+		"/v1/openapi/team.v1.TeamService.json",
+		"/v1/openapi/user.v1.UserService.json",
+	}, response.OpenAPI)
+
+	rec = httptest.NewRecorder()
+	index.ServeRoutes(rec, httptest.NewRequest(http.MethodPost, "/v1/routes", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestRouteIndex_ServeOpenAPI(t *testing.T) {
+	index := newRouteIndex(t)
+
+	rec := httptest.NewRecorder()
+	index.ServeOpenAPI(rec, httptest.NewRequest(http.MethodGet, "/v1/openapi/issues.v1.IssuesService.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var document struct {
+		Swagger string                     `json:"swagger"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &document))
+	assert.Equal(t, "2.0", document.Swagger)
+	assert.Contains(t, document.Paths, "/api/v1/issues:validate")
+
+	rec = httptest.NewRecorder()
+	index.ServeOpenAPI(rec, httptest.NewRequest(http.MethodGet, "/v1/openapi/unknown.json", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
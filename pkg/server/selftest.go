@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/selftest"
+)
+
+// RunSelfTest serves the application on its configured ports, runs the self-test sequence
+// against it over gRPC, so calls pass through the same interceptors, validation, caches and
+// notifications as client calls, and shuts it down again. It returns an error if any step
+// failed; the latency of each step is logged either way.
+func (app *Application) RunSelfTest() error {
+	grpcPort, httpPort := app.listenPorts()
+	logger.ZapLogger.Info("Running self-test",
+		zap.String("grpc_port", grpcPort),
+		zap.String("http_port", httpPort))
+
+	go func() {
+		if err := app.GRPCServer.Start(grpcPort, httpPort); err != nil {
+			logger.ZapLogger.Error("Server error during self-test", zap.Error(err))
+		}
+	}()
+
+	target := grpcPort
+	if strings.HasPrefix(target, ":") {
+		target = "localhost" + target
+	}
+	// Calls wait for the server to start listening, within the timeout of each step
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to the gRPC server for the self-test: %w", err)
+	}
+
+	report := selftest.Run(context.Background(), selftest.Clients{
+		Users:    userPbv1.NewUserServiceClient(conn),
+		Projects: projectPbv1.NewProjectServiceClient(conn),
+		Issues:   issuesPbv1.NewIssuesServiceClient(conn),
+	})
+	for _, step := range report.Steps {
+		if step.Err != nil {
+			logger.ZapLogger.Error("Self-test step failed",
+				zap.String("step", step.Name),
+				zap.Duration("duration", step.Duration),
+				zap.Error(step.Err))
+			continue
+		}
+		logger.ZapLogger.Info("Self-test step passed",
+			zap.String("step", step.Name),
+			zap.Duration("duration", step.Duration))
+	}
+
+	if err := conn.Close(); err != nil {
+		logger.ZapLogger.Warn("Failed to close self-test connection", zap.Error(err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := app.Shutdown(ctx); err != nil {
+		logger.ZapLogger.Error("Error during shutdown after self-test", zap.Error(err))
+	}
+
+	if err := report.Err(); err != nil {
+		return err
+	}
+	logger.ZapLogger.Info("Self-test passed", zap.Int("steps", len(report.Steps)))
+	return nil
+}
@@ -3,7 +3,6 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net"
@@ -11,6 +10,7 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,15 +18,21 @@ import (
 	"github.com/yasindce1998/issue-tracker/database"
 	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/pkg/config"
+	"github.com/yasindce1998/issue-tracker/pkg/grpcpool"
+	"github.com/yasindce1998/issue-tracker/pkg/jobs"
 	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
 	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
 	"github.com/yasindce1998/issue-tracker/pkg/seed"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
 	"github.com/google/uuid"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -39,7 +45,17 @@ type GRPCServer struct {
 	userService    userPbv1.UserServiceServer
 	issuesService  issuesPbv1.IssuesServiceServer
 	projectService projectPbv1.ProjectServiceServer
+	teamService    teamPbv1.TeamServiceServer
+	exportHandler  *ExportHandler
+	cacheAdmin     *CacheAdminHandler
+	healthChecker  *HealthChecker
 	httpPort       string
+	// grpcWebPort is where browser clients reach the services over gRPC-Web; empty disables it
+	grpcWebPort   string
+	grpcWebMu     sync.Mutex
+	grpcWebServer *http.Server
+	// stopTimeout is how long Stop waits for open calls and streams before closing them
+	stopTimeout time.Duration
 }
 
 // Application represents the main application structure
@@ -47,6 +63,16 @@ type Application struct {
 	GRPCServer *GRPCServer
 	GRPCPort   string
 	HTTPPort   string
+	// clientPool holds the connections used by the project and user service clients
+	clientPool *grpcpool.ConnectionPool
+	// digestScheduler publishes the daily project digests; nil when digests are disabled
+	digestScheduler *issuessvc.DigestScheduler
+	// reminderJob publishes reminders of assigned issues that are due soon
+	reminderJob *jobs.ReminderJob
+	// shutdownTracing flushes the spans not yet exported and stops the tracer provider
+	shutdownTracing func(context.Context) error
+	// shutdownTimeout bounds Shutdown when the application stops on a signal or a server error
+	shutdownTimeout time.Duration
 }
 
 // HealthResponse is the response structure for health checks
@@ -58,11 +84,28 @@ type HealthResponse struct {
 	CacheType           string `json:"cache_type"`
 	AppName             string `json:"app_name"`
 	CommunicationMethod string `json:"communication_method"`
+	// CacheEnabled is false when the service runs without a cache, which isn't unhealthy and is
+	// reported with a CacheStatus of "disabled"
+	CacheEnabled bool `json:"cache_enabled"`
+	// CheckedAt is when the reported database and cache statuses were checked
+	CheckedAt time.Time `json:"checked_at"`
+	// Version and GitCommit identify the running build; see Version
+	Version   string `json:"version"`
+	GitCommit string `json:"git_commit"`
+	// Uptime is how long the process has been running, e.g. "26h3m12s"
+	Uptime    string `json:"uptime"`
+	GoVersion string `json:"go_version"`
+
+	CircuitBreakers map[string]resilience.Stats `json:"circuit_breakers,omitempty"`
+	// CacheTTLSeconds is how long each kind of entity stays cached, with 0 for those that aren't cached
+	CacheTTLSeconds map[string]int64 `json:"cache_ttl_seconds,omitempty"`
 }
 
 // NewApplication creates and initializes a new application instance
 func NewApplication() (*Application, error) {
 	app := &Application{}
+	// Uptime on the health endpoint counts from here
+	startedAt := time.Now()
 
 	// Load environment variables
 	if err := config.LoadEnv(); err != nil {
@@ -76,18 +119,29 @@ func NewApplication() (*Application, error) {
 
 	logger.ZapLogger.Info("Starting Issue Tracker Service")
 
-	// Get required environment variables
-	app.GRPCPort = os.Getenv("GRPC_PORT")
-	app.HTTPPort = os.Getenv("HTTP_PORT")
-	if app.GRPCPort == "" || app.HTTPPort == "" {
-		log.Fatal("GRPC_PORT or HTTP_PORT is not set in environment variables")
+	// Check every required setting before anything is initialized
+	cfg := config.FromEnv()
+	if err := config.Validate(cfg); err != nil {
+		return nil, err
+	}
+	app.GRPCPort = cfg.GRPCPort
+	app.HTTPPort = cfg.HTTPPort
+	app.shutdownTimeout = ShutdownTimeoutFromEnv()
+
+	// Install the tracer provider before the clients and server it instruments are created
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv())
+	if err != nil {
+		return nil, err
 	}
+	app.shutdownTracing = shutdownTracing
 
 	// Create gRPC clients
-	projectClient, userClient, err := createClients()
+	projectClient, userClient, clientPool, err := createClients()
 	if err != nil {
 		logger.ZapLogger.Fatal("Failed to create gRPC clients", zap.Error(err))
 	}
+	app.clientPool = clientPool
+	projectClient, userClient = withResilience(projectClient, userClient)
 
 	// Initialize repositories using the database package
 	repos, err := database.InitializeDatabase()
@@ -95,15 +149,43 @@ func NewApplication() (*Application, error) {
 		logger.ZapLogger.Fatal("Failed to initialize database", zap.Error(err))
 	}
 
+	// Without gRPC clients, validate issue references against the in-memory repositories directly
+	if memdbIssuesRepo, ok := repos.IssuesRepo.(*issuessvc.MemDBIssuesRepository); ok {
+		if projectClient == nil || userClient == nil {
+			memdbIssuesRepo.SetClients(issuessvc.NewLocalValidator(repos.ProjectRepo, repos.UserRepo))
+		}
+		// Moving issues updates the project repository alongside the issue
+		memdbIssuesRepo.SetProjectRepository(repos.ProjectRepo)
+	}
+
 	// Initialize cache
 	cacheInstance := cache.NewCache()
 	logger.ZapLogger.Info("Cache initialized",
 		zap.String("type", os.Getenv("CACHE_TYPE")))
 
+	// The repositories stop calling the cache while it keeps failing, and cache operations get
+	// their own spans when tracing is on; the admin handler keeps the underlying instance, whose
+	// statistics it reports
+	breakerCfg := cache.BreakerConfigFromEnv()
+	cacheBreaker := resilience.NewCircuitBreaker("cache", breakerCfg.FailureThreshold, breakerCfg.CoolDown)
+	resilience.Register(cacheBreaker)
+	var repoCache cache.Cache = cache.NewBreakerCache(cacheInstance, cacheBreaker)
+	if tracing.Enabled() {
+		repoCache = cache.NewTracedCache(repoCache)
+	}
+
 	// Wrap repositories with cache
-	cachedUserRepo := usersvc.NewCachedUserRepository(repos.UserRepo, cacheInstance)
-	cachedIssuesRepo := issuessvc.NewCachedIssuesRepository(repos.IssuesRepo, cacheInstance)
-	cachedProjectRepo := projectsvc.NewCachedProjectRepository(repos.ProjectRepo, cacheInstance)
+	cacheConfig := cfg.CacheTTLs
+	logger.ZapLogger.Info("Cache TTLs configured", zap.Any("ttl_seconds", cacheConfig.TTLSeconds()))
+	// Each repository enters degraded mode on its own once its cache calls keep failing, and
+	// leaves it when the background health check reaches the cache again
+	degradedCfg := cache.DegradedConfigFromEnv()
+	userCache := cache.NewDegradedCache("users", repoCache, degradedCfg)
+	issuesCache := cache.NewDegradedCache("issues", repoCache, degradedCfg)
+	projectCache := cache.NewDegradedCache("projects", repoCache, degradedCfg)
+	cachedUserRepo := usersvc.NewCachedUserRepository(repos.UserRepo, userCache, cacheConfig)
+	cachedIssuesRepo := issuessvc.NewCachedIssuesRepository(repos.IssuesRepo, issuesCache, cacheConfig)
+	cachedProjectRepo := projectsvc.NewCachedProjectRepository(repos.ProjectRepo, projectCache, cacheConfig)
 
 	// Initialize services first - they need to exist before seeding relationships
 	userService := usersvc.NewUserService(cachedUserRepo)
@@ -112,6 +194,31 @@ func NewApplication() (*Application, error) {
 	if err != nil {
 		logger.ZapLogger.Fatal("Failed to initialize project service", zap.Error(err))
 	}
+	// Issue moves are published on the project service's broker so its stream subscribers see them
+	issuesService.SetMessageBroker(projectService.MessageBroker())
+	// GetIssue responses with details are shared through the cache, so repeated gets of an
+	// unchanged issue don't call the project and user services
+	issuesService.SetResponseCache(issuesCache, cacheConfig.IssueResponseTTL)
+	// Users assigned to open issues are only deleted once the issues are reassigned
+	userService.SetAssignedIssues(cachedIssuesRepo)
+	// Issues created for a team without an assignee are assigned to its members in turn
+	teamService := teamsvc.NewTeamService(repos.TeamRepo, cachedProjectRepo, cachedUserRepo)
+	issuesService.SetTeams(repos.TeamRepo)
+
+	if digestConfig := issuessvc.DigestConfigFromEnv(); digestConfig.Enabled {
+		app.digestScheduler = issuessvc.NewDigestScheduler(issuesService, cachedProjectRepo, projectService.MessageBroker(), digestConfig)
+		app.digestScheduler.Start()
+		logger.ZapLogger.Info("Daily project digests enabled", zap.Int("hour_utc", digestConfig.Hour))
+	}
+
+	reminderConfig := jobs.ReminderConfigFromEnv()
+	app.reminderJob = jobs.NewReminderJob(cachedIssuesRepo, projectService.MessageBroker(), reminderConfig)
+	if err := app.reminderJob.Start(); err != nil {
+		return nil, err
+	}
+	logger.ZapLogger.Info("Issue reminders scheduled",
+		zap.String("schedule", reminderConfig.Schedule),
+		zap.Duration("lookahead", reminderConfig.Lookahead))
 
 	// Handle data seeding
 	// Note: We only seed data if using memDB, skip for postgres
@@ -126,7 +233,18 @@ func NewApplication() (*Application, error) {
 	)
 
 	// Configure gRPC Server
-	app.GRPCServer = NewGRPCServer(userService, issuesService, projectService)
+	app.GRPCServer = NewGRPCServer(userService, issuesService, projectService, teamService)
+	app.GRPCServer.SetStopTimeout(GRPCStopTimeoutFromEnv())
+	app.GRPCServer.SetGRPCWebPort(GRPCWebPortFromEnv())
+	app.GRPCServer.exportHandler = NewExportHandler(cachedIssuesRepo, cachedProjectRepo)
+	app.GRPCServer.cacheAdmin = NewCacheAdminHandler(cacheInstance)
+
+	// Check dependencies in the background so health probes only read the latest result
+	app.GRPCServer.healthChecker = NewHealthChecker(HealthCheckIntervalFromEnv(), database.HealthCheck,
+		cache.RecoverOnHealthy(cache.HealthCheck, userCache, issuesCache, projectCache))
+	app.GRPCServer.healthChecker.startedAt = startedAt
+	app.GRPCServer.healthChecker.SetCacheTTLs(cacheConfig)
+	app.GRPCServer.healthChecker.Start()
 
 	return app, nil
 }
@@ -136,17 +254,21 @@ func NewGRPCServer(
 	userService userPbv1.UserServiceServer,
 	issuesService issuesPbv1.IssuesServiceServer,
 	projectService projectPbv1.ProjectServiceServer,
+	teamService teamPbv1.TeamServiceServer,
 ) *GRPCServer {
 	// Add server interceptors for logging
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(LoggingInterceptor),
+		grpc.UnaryInterceptor(NewLoggingInterceptor(logger.PayloadConfigFromEnv())),
 	}
+	// Trace calls when OTEL_ENABLED is set
+	opts = append(opts, tracing.ServerOptions()...)
 	server := grpc.NewServer(opts...)
 
 	// Register services
 	userPbv1.RegisterUserServiceServer(server, userService)
 	issuesPbv1.RegisterIssuesServiceServer(server, issuesService)
 	projectPbv1.RegisterProjectServiceServer(server, projectService)
+	teamPbv1.RegisterTeamServiceServer(server, teamService)
 
 	// Enable reflection for tools like grpcurl
 	reflection.Register(server)
@@ -156,6 +278,8 @@ func NewGRPCServer(
 		userService:    userService,
 		issuesService:  issuesService,
 		projectService: projectService,
+		teamService:    teamService,
+		stopTimeout:    defaultGRPCStopTimeout,
 	}
 }
 
@@ -165,89 +289,129 @@ type contextKey string
 
 // LoggingInterceptor is a gRPC interceptor that logs method calls with trace IDs and timing information.
 // It adds a trace ID to the context and tracks cache statistics for each request.
+// Request payloads are logged with the default redaction and size cap.
 func LoggingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	start := time.Now()
-
-	// Generate or extract trace ID
-	traceID := uuid.New().String()
-	ctx = context.WithValue(ctx, contextKey("trace_id"), traceID)
+	return NewLoggingInterceptor(logger.DefaultPayloadConfig())(ctx, req, info, handler)
+}
 
-	// Add cache stats tracking
-	ctx = logger.WithCacheStats(ctx)
+// NewLoggingInterceptor creates a logging interceptor that logs request and response
+// payloads according to the given payload configuration
+func NewLoggingInterceptor(payloads logger.PayloadConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
 
-	// Log method entry
-	logger.ZapLogger.Info("gRPC method called",
-		zap.String("trace_id", traceID),
-		zap.String("method", info.FullMethod),
-		zap.Any("request", req),
-	)
+		// Reuse the request ID the HTTP gateway forwards, so both log lines of a request match
+		traceID := requestIDFromMetadata(ctx)
+		if traceID == "" {
+			traceID = uuid.New().String()
+		}
+		ctx = logger.WithTraceID(ctx, traceID)
+		// Record the log trace ID on the call's span so its traces and log lines can be matched
+		trace.SpanFromContext(ctx).SetAttributes(tracing.LogTraceIDKey.String(traceID))
 
-	// Call the handler
-	resp, err := handler(ctx, req)
+		// Add cache stats tracking
+		ctx = logger.WithCacheStats(ctx)
 
-	// Log method exit
-	duration := time.Since(start)
-	if err != nil {
-		logger.ZapLogger.Error("gRPC method failed",
+		// Log method entry
+		fields := []zap.Field{
 			zap.String("trace_id", traceID),
 			zap.String("method", info.FullMethod),
-			zap.Duration("duration", duration),
-			zap.Error(err),
-		)
-	} else {
-		logger.ZapLogger.Info("gRPC method completed",
-			zap.String("trace_id", traceID),
-			zap.String("method", info.FullMethod),
-			zap.Duration("duration", duration),
-		)
-	}
+		}
+		if payloads.Enabled {
+			fields = append(fields, zap.String("request", payloads.FormatPayload(req)))
+		}
+		logger.ZapLogger.Info("gRPC method called", fields...)
+
+		// Call the handler
+		resp, err := handler(ctx, req)
 
-	return resp, err
+		// Log method exit
+		duration := time.Since(start)
+		if err != nil {
+			logger.ZapLogger.Error("gRPC method failed",
+				zap.String("trace_id", traceID),
+				zap.String("method", info.FullMethod),
+				zap.Duration("duration", duration),
+				zap.Error(err),
+			)
+		} else {
+			logger.ZapLogger.Info("gRPC method completed",
+				zap.String("trace_id", traceID),
+				zap.String("method", info.FullMethod),
+				zap.Duration("duration", duration),
+			)
+			if payloads.LogResponses && resp != nil {
+				logger.ZapLogger.Debug("gRPC method response",
+					zap.String("trace_id", traceID),
+					zap.String("method", info.FullMethod),
+					zap.String("response", payloads.FormatPayload(resp)),
+				)
+			}
+		}
+
+		return resp, err
+	}
 }
 
 func (s *GRPCServer) startHTTPGateway(grpcPort string, httpPort string) error {
 	ctx := context.Background()
 	// Use a WithLogEntry wrapper for the mux
-	mux := runtime.NewServeMux()
+	mux := NewGatewayMux()
 
 	// Register health check endpoint
-	healthHandler := http.HandlerFunc(HealthHandler)
+	healthHandler := s.healthChecker
+
+	// Wrap the mux with conditional GET support and logging middleware
+	wrappedHandler := LoggingMiddleware(ETagMiddleware(mux))
+
+	// Admin endpoints are only reachable with an admin API key
+	adminMux := http.NewServeMux()
+	adminMux.HandleFunc("/admin/loglevel", ServeLogLevel)
+	if s.cacheAdmin != nil {
+		adminMux.HandleFunc("/admin/cache/keys", s.cacheAdmin.ServeKeys)
+		adminMux.HandleFunc("/admin/cache/flush", s.cacheAdmin.ServeFlush)
+		adminMux.HandleFunc("/admin/cache/stats", s.cacheAdmin.ServeStats)
+	}
+	adminHandler := LoggingMiddleware(RequireAdmin(AdminKeysFromEnv())(adminMux))
 
-	// Wrap the mux with logging middleware
-	wrappedHandler := LoggingMiddleware(mux)
+	// The route index is filled in as the services are registered below
+	routeIndex := NewRouteIndex()
 
 	// Create a handler that routes to health check or gRPC-gateway
 	combinedHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/health" {
+		switch {
+		case r.URL.Path == "/health":
 			healthHandler.ServeHTTP(w, r)
-			return
+		case r.URL.Path == "/export/issues.csv" && s.exportHandler != nil:
+			LoggingMiddleware(http.HandlerFunc(s.exportHandler.ServeIssues)).ServeHTTP(w, r)
+		case r.URL.Path == "/export/projects.csv" && s.exportHandler != nil:
+			LoggingMiddleware(http.HandlerFunc(s.exportHandler.ServeProjects)).ServeHTTP(w, r)
+		case strings.HasPrefix(r.URL.Path, "/admin/"):
+			adminHandler.ServeHTTP(w, r)
+		case r.URL.Path == routesPath:
+			LoggingMiddleware(http.HandlerFunc(routeIndex.ServeRoutes)).ServeHTTP(w, r)
+		case strings.HasPrefix(r.URL.Path, openAPIPathPrefix):
+			LoggingMiddleware(http.HandlerFunc(routeIndex.ServeOpenAPI)).ServeHTTP(w, r)
+		default:
+			wrappedHandler.ServeHTTP(w, r)
 		}
-		wrappedHandler.ServeHTTP(w, r)
 	})
 
-	// Configure gRPC dial options
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-
-	// Register UserService HTTP gateway
-	if err := userPbv1.RegisterUserServiceHandlerFromEndpoint(ctx, mux, grpcPort, opts); err != nil {
-		return fmt.Errorf("failed to register UserService handler: %w", err)
-	}
-
-	// Register IssuesService HTTP gateway
-	if err := issuesPbv1.RegisterIssuesServiceHandlerFromEndpoint(ctx, mux, grpcPort, opts); err != nil {
-		return fmt.Errorf("failed to register IssuesService handler: %w", err)
-	}
+	// Configure gRPC dial options, compressing the calls unless GRPC_COMPRESSION is false
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, CompressionDialOptions()...)
 
-	// Register ProjectService HTTP gateway
-	if err := projectPbv1.RegisterProjectServiceHandlerFromEndpoint(ctx, mux, grpcPort, opts); err != nil {
-		return fmt.Errorf("failed to register ProjectService handler: %w", err)
+	// Register the services' HTTP gateways, recording their routes
+	for _, service := range GatewayServices() {
+		if err := routeIndex.Register(ctx, mux, grpcPort, opts, service); err != nil {
+			return err
+		}
 	}
 
 	// Create a server with proper timeouts
 	httpAddr := httpPort
 	server := &http.Server{
 		Addr:         httpAddr,
-		Handler:      combinedHandler,
+		Handler:      MaxBytesMiddleware(MaxRequestBodyBytesFromEnv())(GzipMiddleware(CompressionMinBytesFromEnv())(combinedHandler)),
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  120 * time.Second,
@@ -268,9 +432,10 @@ func LoggingMiddleware(next http.Handler) http.Handler {
 			Status:         http.StatusOK,
 		}
 
-		// Generate trace ID
+		// Generate trace ID, returned to the client as X-Request-ID and forwarded to the gRPC handler
 		traceID := uuid.New().String()
-		ctx := context.WithValue(r.Context(), contextKey("trace_id"), traceID)
+		ctx := logger.WithTraceID(r.Context(), traceID)
+		w.Header().Set(requestIDHeader, traceID)
 
 		// Add cache stats tracking
 		ctx = logger.WithCacheStats(ctx)
@@ -309,8 +474,15 @@ func (r *statusRecorder) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
-// Start runs the application with graceful shutdown handling
-func (app *Application) Start() error {
+// Flush passes flushes through so streamed responses, such as gRPC-Web calls, aren't buffered
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// listenPorts returns the gRPC and HTTP ports in the ":port" form the servers listen on
+func (app *Application) listenPorts() (string, string) {
 	// Ensure ports have colons for proper listening format
 	grpcPort := app.GRPCPort
 	httpPort := app.HTTPPort
@@ -322,6 +494,12 @@ func (app *Application) Start() error {
 	if !strings.HasPrefix(httpPort, ":") && !strings.Contains(httpPort, ":") {
 		httpPort = ":" + httpPort
 	}
+	return grpcPort, httpPort
+}
+
+// Start runs the application with graceful shutdown handling
+func (app *Application) Start() error {
+	grpcPort, httpPort := app.listenPorts()
 	logger.ZapLogger.Info("Starting application",
 		zap.String("grpc_port", grpcPort),
 		zap.String("http_port", httpPort))
@@ -348,7 +526,7 @@ func (app *Application) Start() error {
 		logger.ZapLogger.Info("Received shutdown signal", zap.String("signal", sig.String()))
 
 		// Create context with timeout for graceful shutdown
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), app.shutdownTimeout)
 		defer cancel()
 
 		// Call shutdown
@@ -358,7 +536,7 @@ func (app *Application) Start() error {
 		logger.ZapLogger.Error("Server error, initiating shutdown", zap.Error(err))
 
 		// Create context with timeout for graceful shutdown
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), app.shutdownTimeout)
 		defer cancel()
 
 		// Call shutdown but return the original server error
@@ -383,19 +561,50 @@ func (app *Application) Shutdown(ctx context.Context) error {
 	done := make(chan struct{})
 
 	go func() {
-		// Close project service messaging resources
+		// Stop generating digests and reminders before the broker they are published on is closed
+		if app.digestScheduler != nil {
+			app.digestScheduler.Stop()
+		}
+		if app.reminderJob != nil {
+			app.reminderJob.Stop()
+		}
+		if issuesService, ok := app.GRPCServer.issuesService.(*issuessvc.IssuesServiceServer); ok {
+			if err := issuesService.Close(); err != nil {
+				logger.ZapLogger.Error("Error closing issues service", zap.Error(err))
+				shutdownErr = err
+			}
+		}
+		// Project update streams never end on their own, so they are told the server is shutting
+		// down and drained, within the gRPC stop timeout, before the broker they read from is
+		// closed and GracefulStop waits for the calls still open
 		if projectService, ok := app.GRPCServer.projectService.(*projectsvc.ProjectService); ok {
+			drainCtx, cancel := context.WithTimeout(ctx, app.GRPCServer.stopTimeout)
+			if err := projectService.DrainStreams(drainCtx); err != nil {
+				logger.ZapLogger.Warn("Closing project update streams that did not drain", zap.Error(err))
+			}
+			cancel()
 			if err := projectService.Close(); err != nil {
 				logger.ZapLogger.Error("Error closing project service", zap.Error(err))
 				shutdownErr = err
 			}
 		}
+		if app.GRPCServer.healthChecker != nil {
+			app.GRPCServer.healthChecker.Stop()
+		}
 		// Close gRPC server
 		if err := app.GRPCServer.Stop(); err != nil {
 			logger.ZapLogger.Error("Error shutting down gRPC server", zap.Error(err))
 			shutdownErr = err
 		}
 
+		// Close the outbound client connections
+		if app.clientPool != nil {
+			if err := app.clientPool.Close(); err != nil {
+				logger.ZapLogger.Error("Error closing gRPC client connections", zap.Error(err))
+				shutdownErr = err
+			}
+		}
+
 		// Close cache connections
 		if os.Getenv("CACHE_TYPE") == "redis" {
 			// Since we don't have direct access to the cache instance here,
@@ -406,14 +615,22 @@ func (app *Application) Shutdown(ctx context.Context) error {
 			}
 		}
 
-		// Close database connections if PostgreSQL
-		if os.Getenv("DB_TYPE") == "postgres" {
+		// Close database connections if PostgreSQL or SQLite
+		if dbType := os.Getenv("DB_TYPE"); dbType == database.PostgresDB || dbType == database.SQLiteDB {
 			if err := database.CloseConnections(); err != nil {
 				logger.ZapLogger.Error("Error closing database connections", zap.Error(err))
 				shutdownErr = err
 			}
 		}
 
+		// Export the spans of the calls above before the process exits
+		if app.shutdownTracing != nil {
+			if err := app.shutdownTracing(ctx); err != nil {
+				logger.ZapLogger.Error("Error shutting down tracing", zap.Error(err))
+				shutdownErr = err
+			}
+		}
+
 		close(done)
 	}()
 
@@ -443,83 +660,98 @@ func (s *GRPCServer) Start(grpcPort string, httpPort string) error {
 		}
 	}()
 
+	// Serve browser clients over gRPC-Web
+	if s.grpcWebPort != "" {
+		go func() {
+			if err := s.startGRPCWebProxy(s.grpcWebPort); err != nil {
+				log.Fatalf("Failed to start gRPC-Web proxy: %v", err)
+			}
+		}()
+	}
+
 	log.Println("gRPC server started on " + grpcPort)
+	return s.Serve(listener)
+}
+
+// Serve serves gRPC calls on the listener until the server is stopped
+func (s *GRPCServer) Serve(listener net.Listener) error {
 	return s.server.Serve(listener)
 }
 
-// Stop gracefully stops the gRPC server
+// SetStopTimeout sets how long Stop waits for open calls and streams to finish
+func (s *GRPCServer) SetStopTimeout(timeout time.Duration) {
+	s.stopTimeout = timeout
+}
+
+// Stop gracefully stops the gRPC server. Calls and streams still open after the stop
+// timeout are closed, so a client that never ends its stream can't hold up shutdown.
 func (s *GRPCServer) Stop() error {
-	s.server.GracefulStop()
+	// gRPC-Web calls are served through the gRPC server, so closing them lets it stop
+	s.stopGRPCWeb()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(s.stopTimeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+	case <-timer.C:
+		logger.ZapLogger.Warn("gRPC server did not stop gracefully in time, closing open calls and streams",
+			zap.Duration("timeout", s.stopTimeout))
+		s.server.Stop()
+		<-stopped
+	}
 	return nil
 }
 
 // createClients sets up the gRPC clients for Project and User services.
-func createClients() (projectPbv1.ProjectServiceClient, userPbv1.UserServiceClient, error) {
-	// For in-memory mode, we might not need actual clients initially
+func createClients() (projectPbv1.ProjectServiceClient, userPbv1.UserServiceClient, *grpcpool.ConnectionPool, error) {
+	// For in-memory mode, issue references are validated locally instead
 	if os.Getenv("DB_TYPE") == "memdb" && os.Getenv("USE_LOCAL_CLIENTS") == "true" {
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 	grpcHost := os.Getenv("GRPC_HOST")
 	grpcPort := os.Getenv("GRPC_PORT")
 
 	addr := fmt.Sprintf("%s:%s", grpcHost, grpcPort)
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, tracing.DialOptions()...)
+	opts = append(opts, CompressionDialOptions()...)
+	pool, err := grpcpool.New(addr, grpcpool.PoolSizeFromEnv(), opts...)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create gRPC connection: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create gRPC connection pool: %w", err)
 	}
 
-	projectClient := projectPbv1.NewProjectServiceClient(conn)
-	userClient := userPbv1.NewUserServiceClient(conn)
+	// Both clients spread their calls over the pooled connections
+	projectClient := projectPbv1.NewProjectServiceClient(pool)
+	userClient := userPbv1.NewUserServiceClient(pool)
 
-	return projectClient, userClient, nil
+	return projectClient, userClient, pool, nil
 }
 
-// HealthHandler handles health check requests
-func HealthHandler(w http.ResponseWriter, _ *http.Request) {
-	status := "ok"
-	dbStatus := "ok"
-	cacheStatus := "ok"
-	httpStatus := http.StatusOK
-
-	// Check database health
-	if err := database.HealthCheck(); err != nil {
-		dbStatus = "error: " + err.Error()
-		status = "error"
-		httpStatus = http.StatusServiceUnavailable
+// withResilience guards the outbound project and user clients with timeouts and circuit breakers
+func withResilience(
+	projectClient projectPbv1.ProjectServiceClient,
+	userClient userPbv1.UserServiceClient,
+) (projectPbv1.ProjectServiceClient, userPbv1.UserServiceClient) {
+	cfg := issuessvc.ResilienceConfigFromEnv()
+
+	if projectClient != nil {
+		breaker := resilience.NewCircuitBreaker("project_service", cfg.FailureThreshold, cfg.CoolDown)
+		resilience.Register(breaker)
+		projectClient = issuessvc.NewResilientProjectClient(projectClient, breaker, cfg.CallTimeout)
 	}
 
-	// Check cache health
-	if err := cache.HealthCheck(); err != nil {
-		cacheStatus = "error: " + err.Error()
-		status = "error" // Update overall status
-		httpStatus = http.StatusServiceUnavailable
-	}
-
-	response := HealthResponse{
-		Status:              status,
-		DbStatus:            dbStatus,
-		DbType:              os.Getenv("DB_TYPE"),
-		CacheStatus:         cacheStatus,
-		CacheType:           os.Getenv("CACHE_TYPE"),
-		AppName:             "Issue Tracker",
-		CommunicationMethod: getCommMethod(),
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(httpStatus) // Set appropriate HTTP status code
-
-	// Check for encoding errors
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		logger.ZapLogger.Error("Failed to encode health check response", zap.Error(err))
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-		return
+	if userClient != nil {
+		breaker := resilience.NewCircuitBreaker("user_service", cfg.FailureThreshold, cfg.CoolDown)
+		resilience.Register(breaker)
+		userClient = issuessvc.NewResilientUserClient(userClient, breaker, cfg.CallTimeout)
 	}
 
-	// Log health check results
-	logger.ZapLogger.Debug("Health check performed",
-		zap.String("status", status),
-		zap.String("db_status", dbStatus),
-		zap.String("cache_status", cacheStatus))
+	return projectClient, userClient
 }
 
 func getCommMethod() string {
@@ -1,18 +1,23 @@
 package server_test
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 
 	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
 	"github.com/yasindce1998/issue-tracker/pkg/server"
 )
 
@@ -118,3 +123,66 @@ func TestLoggingInterceptor(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 }
+
+// newBufferLogger points the global logger at a buffer so tests can inspect the output
+func newBufferLogger(level zapcore.Level) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(buf), level)
+	logger.ZapLogger = zap.New(core)
+	return buf
+}
+
+func TestLoggingInterceptor_RedactsPayloads(t *testing.T) {
+	buf := newBufferLogger(zapcore.DebugLevel)
+
+	cfg := logger.DefaultPayloadConfig()
+	cfg.LogResponses = true
+	interceptor := server.NewLoggingInterceptor(cfg)
+
+	req := &userPbv1.CreateUserRequest{FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return &userPbv1.CreateUserResponse{User: &userPbv1.User{UserId: "u1", EmailAddress: "jane@example.com"}}, nil
+	}
+
+	_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/CreateUser"}, handler)
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.NotContains(t, out, "jane@example.com")
+	assert.Contains(t, out, "Jane")
+	assert.Contains(t, out, "gRPC method response")
+}
+
+func TestLoggingInterceptor_CapsPayloadSize(t *testing.T) {
+	buf := newBufferLogger(zapcore.InfoLevel)
+	interceptor := server.NewLoggingInterceptor(logger.DefaultPayloadConfig())
+
+	req := &issuesPbv1.CreateIssueRequest{Summary: strings.Repeat("x", 1<<20)}
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/issues.v1.IssuesService/CreateIssue"}, handler)
+	require.NoError(t, err)
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		assert.Less(t, len(line), 8*1024, "log line should be capped well below the payload size")
+	}
+	assert.Contains(t, buf.String(), "truncated")
+}
+
+func TestLoggingInterceptor_PayloadsDisabled(t *testing.T) {
+	buf := newBufferLogger(zapcore.DebugLevel)
+	interceptor := server.NewLoggingInterceptor(logger.PayloadConfig{})
+
+	req := &userPbv1.CreateUserRequest{FirstName: "Jane"}
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return &userPbv1.CreateUserResponse{}, nil
+	}
+
+	_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/CreateUser"}, handler)
+	require.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "Jane")
+	assert.NotContains(t, buf.String(), "gRPC method response")
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// defaultShutdownTimeout is used when SHUTDOWN_TIMEOUT_SECONDS is not set
+	defaultShutdownTimeout = 30 * time.Second
+	// defaultGRPCStopTimeout is used when SHUTDOWN_GRPC_STOP_TIMEOUT_SECONDS is not set
+	defaultGRPCStopTimeout = 10 * time.Second
+)
+
+// ShutdownTimeoutFromEnv reads how long the whole shutdown may take from SHUTDOWN_TIMEOUT_SECONDS
+func ShutdownTimeoutFromEnv() time.Duration {
+	if v, err := strconv.Atoi(os.Getenv("SHUTDOWN_TIMEOUT_SECONDS")); err == nil && v > 0 {
+		return time.Duration(v) * time.Second
+	}
+	return defaultShutdownTimeout
+}
+
+// GRPCStopTimeoutFromEnv reads how long the gRPC server waits for open calls and streams to
+// finish before it closes them from SHUTDOWN_GRPC_STOP_TIMEOUT_SECONDS
+func GRPCStopTimeoutFromEnv() time.Duration {
+	if v, err := strconv.Atoi(os.Getenv("SHUTDOWN_GRPC_STOP_TIMEOUT_SECONDS")); err == nil && v > 0 {
+		return time.Duration(v) * time.Second
+	}
+	return defaultGRPCStopTimeout
+}
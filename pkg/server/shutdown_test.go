@@ -0,0 +1,200 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+const shutdownIssueID = "c72d237e-2658-4252-be58-760c7867d783"
+
+// newServingGRPCServer serves the services over in-memory repositories on an in-memory
+// listener and returns a client connection to it
+func newServingGRPCServer(t *testing.T) (*server.GRPCServer, *grpc.ClientConn) {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	userRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	teamRepo, err := teamsvc.NewMemDBTeamRepository()
+	require.NoError(t, err)
+	require.NoError(t, issuesRepo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+		IssueId:  shutdownIssueID,
+		Summary:  "Login fails",
+		Type:     issuesPbv1.Type_BUG,
+		Priority: issuesPbv1.Priority_MAJOR,
+		Status:   issuesPbv1.Status_NEW,
+	}))
+
+	projectService, err := projectsvc.NewProjectService(projectRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = projectService.Close() })
+
+	grpcServer := server.NewGRPCServer(usersvc.NewUserService(userRepo), issuessvc.NewIssuesService(issuesRepo, nil, nil), projectService,
+		teamsvc.NewTeamService(teamRepo, projectRepo, userRepo))
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return grpcServer, conn
+}
+
+func TestGRPCServer_StopForcesOpenStreamsClosed(t *testing.T) {
+	grpcServer, conn := newServingGRPCServer(t)
+	client := issuesPbv1.NewIssuesServiceClient(conn)
+	ctx := context.Background()
+
+	// A watch stream only ends when its client hangs up or the issue is deleted
+	stream, err := client.WatchIssue(ctx, &issuesPbv1.WatchIssueRequest{IssueId: shutdownIssueID})
+	require.NoError(t, err)
+	recvErr := make(chan error, 1)
+	events := make(chan struct{}, 100)
+	go func() {
+		for {
+			if _, err := stream.Recv(); err != nil {
+				recvErr <- err
+				return
+			}
+			events <- struct{}{}
+		}
+	}()
+
+	// Receiving an event shows the stream is open on the server
+	require.Eventually(t, func() bool {
+		resp, err := client.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: shutdownIssueID})
+		require.NoError(t, err)
+		_, err = client.UpdateIssue(ctx, &issuesPbv1.UpdateIssueRequest{
+			IssueId:  shutdownIssueID,
+			Summary:  "Login fails on Safari",
+			Type:     issuesPbv1.Type_BUG,
+			Priority: issuesPbv1.Priority_MAJOR,
+			Status:   issuesPbv1.Status_NEW,
+			Version:  resp.Issue.Version,
+		})
+		require.NoError(t, err)
+		return len(events) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	grpcServer.SetStopTimeout(100 * time.Millisecond)
+	start := time.Now()
+	require.NoError(t, grpcServer.Stop())
+	elapsed := time.Since(start)
+
+	assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond, "the stream was given the stop timeout to finish")
+	assert.Less(t, elapsed, 5*time.Second, "the stream was closed once the stop timeout expired")
+
+	// The client learns the stream is gone rather than waiting on it forever
+	select {
+	case err := <-recvErr:
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	case <-time.After(time.Second):
+		t.Fatal("the watch stream was not closed")
+	}
+}
+
+func TestGRPCServer_StopWithoutOpenStreams(t *testing.T) {
+	grpcServer, conn := newServingGRPCServer(t)
+	client := issuesPbv1.NewIssuesServiceClient(conn)
+
+	_, err := client.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: shutdownIssueID})
+	require.NoError(t, err)
+
+	grpcServer.SetStopTimeout(time.Minute)
+	start := time.Now()
+	require.NoError(t, grpcServer.Stop())
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestApplication_ShutdownDrainsProjectStreams(t *testing.T) {
+	t.Setenv("DB_TYPE", "")
+	t.Setenv("CACHE_TYPE", "")
+	grpcServer, conn := newServingGRPCServer(t)
+	client := projectPbv1.NewProjectServiceClient(conn)
+	ctx := context.Background()
+
+	created, err := client.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Drained"})
+	require.NoError(t, err)
+	projectID := created.Project.ProjectId
+
+	// Open two streams, each subscribed once it has received an update for the project
+	streams := make([]projectPbv1.ProjectService_StreamProjectUpdatesClient, 2)
+	for i := range streams {
+		stream, err := client.StreamProjectUpdates(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&projectPbv1.ProjectUpdateRequest{ProjectId: projectID, Action: "subscribe"}))
+		require.NoError(t, stream.Send(&projectPbv1.ProjectUpdateRequest{ProjectId: projectID, Action: "update"}))
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, projectPbv1.UpdateType_PROJECT_UPDATED, update.UpdateType)
+		streams[i] = stream
+	}
+	// Earlier streams also receive the updates requested on later ones
+	update, err := streams[0].Recv()
+	require.NoError(t, err)
+	require.Equal(t, projectPbv1.UpdateType_PROJECT_UPDATED, update.UpdateType)
+
+	// Without the drain the streams would hold GracefulStop for the whole stop timeout
+	grpcServer.SetStopTimeout(10 * time.Second)
+	app := &server.Application{GRPCServer: grpcServer}
+	shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	start := time.Now()
+	require.NoError(t, app.Shutdown(shutdownCtx))
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	for _, stream := range streams {
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, projectPbv1.UpdateType_SERVER_SHUTDOWN, update.UpdateType)
+		assert.Equal(t, projectID, update.ProjectId)
+
+		_, err = stream.Recv()
+		assert.ErrorIs(t, err, io.EOF, "the stream ends cleanly after the shutdown message")
+	}
+}
+
+func TestShutdownTimeoutsFromEnv(t *testing.T) {
+	t.Setenv("SHUTDOWN_TIMEOUT_SECONDS", "")
+	t.Setenv("SHUTDOWN_GRPC_STOP_TIMEOUT_SECONDS", "")
+	assert.Equal(t, 30*time.Second, server.ShutdownTimeoutFromEnv())
+	assert.Equal(t, 10*time.Second, server.GRPCStopTimeoutFromEnv())
+
+	t.Setenv("SHUTDOWN_TIMEOUT_SECONDS", "60")
+	t.Setenv("SHUTDOWN_GRPC_STOP_TIMEOUT_SECONDS", "20")
+	assert.Equal(t, time.Minute, server.ShutdownTimeoutFromEnv())
+	assert.Equal(t, 20*time.Second, server.GRPCStopTimeoutFromEnv())
+
+	t.Setenv("SHUTDOWN_TIMEOUT_SECONDS", "0")
+	t.Setenv("SHUTDOWN_GRPC_STOP_TIMEOUT_SECONDS", "soon")
+	assert.Equal(t, 30*time.Second, server.ShutdownTimeoutFromEnv())
+	assert.Equal(t, 10*time.Second, server.GRPCStopTimeoutFromEnv())
+}
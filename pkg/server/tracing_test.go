@@ -0,0 +1,150 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/server"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
+)
+
+const tracedIssueID = "5b0f3b1c-7f0e-4a53-9d7e-2f1c6a8e4d10"
+
+// spanAttribute returns the value of a span attribute and whether the span has it
+func spanAttribute(span tracetest.SpanStub, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+// spanNamed returns the only span with the given name
+func spanNamed(t *testing.T, spans tracetest.SpanStubs, name string) tracetest.SpanStub {
+	t.Helper()
+	var found []tracetest.SpanStub
+	for _, span := range spans {
+		if span.Name == name {
+			found = append(found, span)
+		}
+	}
+	require.Len(t, found, 1, "spans named %q", name)
+	return found[0]
+}
+
+// exportedSpans waits for the server span of a call, which ends after the client has its
+// response, and returns every span exported so far
+func exportedSpans(t *testing.T, exporter *tracetest.InMemoryExporter) tracetest.SpanStubs {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		for _, span := range exporter.GetSpans() {
+			if span.Name == "issues.v1.IssuesService/GetIssue" {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 5*time.Millisecond)
+	return exporter.GetSpans()
+}
+
+func TestTracing_GetIssueSpanHierarchy(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracing.Install(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	issuesRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, issuesRepo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+		IssueId:  tracedIssueID,
+		Summary:  "Login fails",
+		Type:     issuesPbv1.Type_BUG,
+		Priority: issuesPbv1.Priority_MAJOR,
+		Status:   issuesPbv1.Status_NEW,
+	}))
+	cachedRepo := issuessvc.NewCachedIssuesRepository(issuesRepo,
+		cache.NewTracedCache(cache.NewMemoryCache(10)), cache.CacheConfigFromEnv())
+
+	// Serve with the options NewGRPCServer uses
+	lis := bufconn.Listen(1 << 20)
+	opts := append([]grpc.ServerOption{grpc.UnaryInterceptor(server.NewLoggingInterceptor(logger.DefaultPayloadConfig()))},
+		tracing.ServerOptions()...)
+	grpcServer := grpc.NewServer(opts...)
+	issuesPbv1.RegisterIssuesServiceServer(grpcServer, issuessvc.NewIssuesService(cachedRepo, nil, nil))
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := issuesPbv1.NewIssuesServiceClient(conn)
+
+	// First call misses the cache and reads the repository
+	_, err = client.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: tracedIssueID})
+	require.NoError(t, err)
+
+	spans := exportedSpans(t, exporter)
+	serverSpan := spanNamed(t, spans, "issues.v1.IssuesService/GetIssue")
+	cachedSpan := spanNamed(t, spans, "CachedIssuesRepository.ReadIssue")
+	getSpan := spanNamed(t, spans, "cache.Get")
+	repoSpan := spanNamed(t, spans, "IssuesRepository.ReadIssue")
+	setSpan := spanNamed(t, spans, "cache.Set")
+
+	assert.Equal(t, serverSpan.SpanContext.SpanID(), cachedSpan.Parent.SpanID())
+	for _, child := range []tracetest.SpanStub{getSpan, repoSpan, setSpan} {
+		assert.Equal(t, cachedSpan.SpanContext.SpanID(), child.Parent.SpanID(), "parent of %s", child.Name)
+		assert.Equal(t, serverSpan.SpanContext.TraceID(), child.SpanContext.TraceID(), "trace of %s", child.Name)
+	}
+
+	hit, ok := spanAttribute(cachedSpan, "cache.hit")
+	require.True(t, ok)
+	assert.False(t, hit.AsBool())
+	hit, ok = spanAttribute(getSpan, "cache.hit")
+	require.True(t, ok)
+	assert.False(t, hit.AsBool())
+
+	// The server span and the spans under it carry the trace ID of the call's log lines
+	logTraceID, ok := spanAttribute(serverSpan, tracing.LogTraceIDKey)
+	require.True(t, ok)
+	assert.NotEmpty(t, logTraceID.AsString())
+	childTraceID, ok := spanAttribute(cachedSpan, tracing.LogTraceIDKey)
+	require.True(t, ok)
+	assert.Equal(t, logTraceID.AsString(), childTraceID.AsString())
+
+	// Second call is served from the cache without reaching the repository
+	exporter.Reset()
+	_, err = client.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: tracedIssueID})
+	require.NoError(t, err)
+
+	spans = exportedSpans(t, exporter)
+	cachedSpan = spanNamed(t, spans, "CachedIssuesRepository.ReadIssue")
+	hit, ok = spanAttribute(cachedSpan, "cache.hit")
+	require.True(t, ok)
+	assert.True(t, hit.AsBool())
+	for _, span := range spans {
+		assert.NotEqual(t, "IssuesRepository.ReadIssue", span.Name)
+		assert.NotEqual(t, "cache.Set", span.Name)
+	}
+}
@@ -0,0 +1,177 @@
+package issuessvc
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+const (
+	// defaultDigestHour is used when DIGEST_HOUR is not set
+	defaultDigestHour = 8
+	// digestProjectPageSize is the number of projects loaded at a time while generating digests
+	digestProjectPageSize = 100
+)
+
+// DigestConfig controls the daily project digests
+type DigestConfig struct {
+	Enabled bool
+	// Hour is the hour of the day, in UTC, at which digests are generated
+	Hour int
+}
+
+// DigestConfigFromEnv reads the digest settings from DIGEST_ENABLED and DIGEST_HOUR,
+// falling back to the defaults for anything unset or invalid
+func DigestConfigFromEnv() DigestConfig {
+	cfg := DigestConfig{Hour: defaultDigestHour}
+
+	if v, err := strconv.ParseBool(os.Getenv("DIGEST_ENABLED")); err == nil {
+		cfg.Enabled = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("DIGEST_HOUR")); err == nil && v >= 0 && v < 24 {
+		cfg.Hour = v
+	}
+
+	return cfg
+}
+
+// ProjectPager pages through the projects digests are generated for
+type ProjectPager interface {
+	ListProjectsPage(ctx context.Context, pageToken string, pageSize int) ([]*projectPbv1.Project, string, error)
+}
+
+// DigestScheduler generates a digest for every project once a day and publishes it through
+// the message broker. Each run covers the 24 hours up to its scheduled time, so consecutive
+// runs cover consecutive windows.
+type DigestScheduler struct {
+	service  *IssuesServiceServer
+	projects ProjectPager
+	broker   broker.MessageBroker
+	hour     int
+	pageSize int
+	now      func() time.Time
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	// ctx is cancelled by Stop, which also aborts a run in progress
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewDigestScheduler creates a scheduler that publishes digests of the paged projects on mb
+func NewDigestScheduler(service *IssuesServiceServer, projects ProjectPager, mb broker.MessageBroker, cfg DigestConfig) *DigestScheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &DigestScheduler{
+		service:  service,
+		projects: projects,
+		broker:   mb,
+		hour:     cfg.Hour,
+		pageSize: digestProjectPageSize,
+		now:      time.Now,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// WithClock overrides the time source, which is useful in tests
+func (d *DigestScheduler) WithClock(now func() time.Time) *DigestScheduler {
+	d.now = now
+	return d
+}
+
+// Start generates digests in the background every day at the configured hour until Stop is called
+func (d *DigestScheduler) Start() {
+	d.startOnce.Do(func() {
+		go d.run()
+	})
+}
+
+// Stop ends scheduling, cancels a run in progress and waits for it to return
+func (d *DigestScheduler) Stop() {
+	d.stopOnce.Do(d.cancel)
+	// If the scheduler never started, this keeps it from starting and releases the wait below
+	d.startOnce.Do(func() { close(d.done) })
+	<-d.done
+}
+
+func (d *DigestScheduler) run() {
+	defer close(d.done)
+
+	for {
+		next := nextDigestRun(d.now(), d.hour)
+		timer := time.NewTimer(next.Sub(d.now()))
+		select {
+		case <-d.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := d.GenerateAll(d.ctx, next); err != nil {
+			logger.ZapLogger.Error("Failed to generate project digests", zap.Error(err))
+		}
+	}
+}
+
+// GenerateAll publishes a digest covering the 24 hours before end for every project. A project
+// whose digest fails is logged and skipped; paging errors and cancellation end the run.
+func (d *DigestScheduler) GenerateAll(ctx context.Context, end time.Time) error {
+	start := end.Add(-digestWindow)
+	published := 0
+
+	pageToken := ""
+	for {
+		projects, nextPageToken, err := d.projects.ListProjectsPage(ctx, pageToken, d.pageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, project := range projects {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			digest, err := d.service.projectDigest(ctx, project.ProjectId, start, end)
+			if err == nil {
+				err = d.broker.PublishDigest(ctx, digest)
+			}
+			if err != nil {
+				logger.ZapLogger.Error("Failed to publish project digest",
+					zap.String("project_id", project.ProjectId),
+					zap.Error(err))
+				continue
+			}
+			published++
+		}
+
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	logger.ZapLogger.Info("Published project digests",
+		zap.Int("count", published),
+		zap.Time("window_end", end))
+	return nil
+}
+
+// nextDigestRun returns the first time after now at the given hour of the day in UTC
+func nextDigestRun(now time.Time, hour int) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
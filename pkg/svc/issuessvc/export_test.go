@@ -0,0 +1,21 @@
+package issuessvc
+
+// ValidateIssueUpdate exposes validateIssueUpdate to the issuessvc_test package
+var ValidateIssueUpdate = validateIssueUpdate
+
+// NextDigestRun exposes nextDigestRun to the issuessvc_test package
+var NextDigestRun = nextDigestRun
+
+// SanitizeDescription exposes sanitizeDescription to the issuessvc_test package
+var SanitizeDescription = sanitizeDescription
+
+// SetDigestPageSize overrides the number of projects a digest scheduler loads at a time
+func (d *DigestScheduler) SetDigestPageSize(pageSize int) {
+	d.pageSize = pageSize
+}
+
+// SetProjectDetailsCacheSize overrides the most projects whose details GetIssue caches; it must
+// be called after SetMessageBroker
+func (s *IssuesServiceServer) SetProjectDetailsCacheSize(size int) {
+	s.projectFetcher.cache.maxEntries = size
+}
@@ -0,0 +1,169 @@
+package issuessvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/brianvoe/gofakeit/v7"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// seedIssueHistory is how far back seeded issues are created
+const seedIssueHistory = 90 * 24 * time.Hour
+
+// weighted is a value picked with the given weight out of the total weight of its list
+type weighted[T any] struct {
+	value  T
+	weight int
+}
+
+// Seeded issues follow these distributions; the weights of each list add up to 100
+var (
+	seedStatuses = []weighted[issuesPbv1.Status]{
+		{issuesPbv1.Status_NEW, 30},
+		{issuesPbv1.Status_ASSIGNED, 25},
+		{issuesPbv1.Status_IN_PROGRESS, 20},
+		{issuesPbv1.Status_RESOLVED, 15},
+		{issuesPbv1.Status_CLOSED, 10},
+	}
+
+	seedResolutions = []weighted[issuesPbv1.Resolution]{
+		{issuesPbv1.Resolution_FIXED, 70},
+		{issuesPbv1.Resolution_WONTFIX, 10},
+		{issuesPbv1.Resolution_INVALID, 10},
+		{issuesPbv1.Resolution_WORKSFORME, 10},
+	}
+
+	seedPriorities = []weighted[issuesPbv1.Priority]{
+		{issuesPbv1.Priority_MINOR, 40},
+		{issuesPbv1.Priority_IMPORTANT, 30},
+		{issuesPbv1.Priority_MAJOR, 20},
+		{issuesPbv1.Priority_CRITICAL, 10},
+	}
+
+	seedTypes = []weighted[issuesPbv1.Type]{
+		{issuesPbv1.Type_BUG, 45},
+		{issuesPbv1.Type_FEATURE, 35},
+		{issuesPbv1.Type_COSMETIC, 10},
+		{issuesPbv1.Type_PERFORMANCE, 10},
+	}
+
+	seedSummaries = map[issuesPbv1.Type][]string{
+		issuesPbv1.Type_BUG:         {"Fix %s crash in %s", "Fix %s error in %s", "%s fails intermittently in %s"},
+		issuesPbv1.Type_FEATURE:     {"Add %s support to %s", "Allow %s export in %s", "Add %s dashboard to %s"},
+		issuesPbv1.Type_COSMETIC:    {"Align %s layout in %s", "Improve %s styling in %s"},
+		issuesPbv1.Type_PERFORMANCE: {"Speed up %s loading in %s", "Reduce %s memory use in %s"},
+	}
+)
+
+// pickWeighted returns a value of the list with a probability proportional to its weight
+func pickWeighted[T any](choices []weighted[T]) T {
+	total := 0
+	for _, c := range choices {
+		total += c.weight
+	}
+
+	n := gofakeit.IntN(total)
+	for _, c := range choices {
+		if n < c.weight {
+			return c.value
+		}
+		n -= c.weight
+	}
+	return choices[len(choices)-1].value
+}
+
+// randomTimeBetween returns a time between from and to, to the second
+func randomTimeBetween(from, to time.Time) time.Time {
+	seconds := int(to.Sub(from) / time.Second)
+	if seconds <= 0 {
+		return from
+	}
+	return from.Add(time.Duration(gofakeit.IntN(seconds+1)) * time.Second)
+}
+
+// truncateText shortens s to at most maxLen bytes without leaving trailing whitespace
+func truncateText(s string, maxLen int) string {
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+	return strings.TrimSpace(s)
+}
+
+// GenerateRandomIssue creates a random issue for the project. Statuses, types and priorities
+// follow realistic distributions, create dates are spread over the 90 days before now and
+// resolved and closed issues carry a resolution. Issues have no status history, so the dates
+// are consistent with the issue having moved NEW, ASSIGNED, IN_PROGRESS, RESOLVED, CLOSED.
+// All randomness comes from gofakeit, so seeding it makes the issues reproducible.
+func GenerateRandomIssue(project *projectPbv1.Project, assigneeIDs []string, now time.Time) *issuesPbv1.Issue {
+	issueType := pickWeighted(seedTypes)
+	templates := seedSummaries[issueType]
+	summary := fmt.Sprintf(templates[gofakeit.IntN(len(templates))], gofakeit.HackerNoun(), project.Name)
+
+	createDate := now.Add(-time.Duration(gofakeit.IntN(int(seedIssueHistory/time.Second))) * time.Second)
+	limits := FieldLimitsFromEnv()
+	issue := &issuesPbv1.Issue{
+		IssueId:     gofakeit.UUID(),
+		Summary:     truncateText(summary, limits.SummaryMaxLength),
+		Description: truncateText(gofakeit.Paragraph(1, gofakeit.Number(1, 8), gofakeit.Number(4, 14), " "), limits.DescriptionMaxLength),
+		Status:      pickWeighted(seedStatuses),
+		Type:        issueType,
+		Priority:    pickWeighted(seedPriorities),
+		ProjectId:   project.ProjectId,
+		CreateDate:  timestamppb.New(createDate),
+		ModifyDate:  timestamppb.New(createDate),
+	}
+
+	if issue.Status == issuesPbv1.Status_NEW {
+		return issue
+	}
+	if len(assigneeIDs) > 0 {
+		issue.AssigneeId = assigneeIDs[gofakeit.IntN(len(assigneeIDs))]
+	}
+
+	lastChange := randomTimeBetween(createDate, now)
+	if issue.Status == issuesPbv1.Status_RESOLVED || issue.Status == issuesPbv1.Status_CLOSED {
+		issue.Resolution = pickWeighted(seedResolutions)
+		issue.ResolutionComment = truncateText(gofakeit.Sentence(gofakeit.Number(4, 12)), 500)
+		issue.ResolveDate = timestamppb.New(lastChange)
+		if issue.Status == issuesPbv1.Status_CLOSED {
+			lastChange = randomTimeBetween(lastChange, now)
+		}
+	}
+	issue.ModifyDate = timestamppb.New(lastChange)
+
+	return issue
+}
+
+// SeedIssues generates random issues for the project and adds them to the repository,
+// assigning those past NEW to one of the given users
+func SeedIssues(ctx context.Context, repo IssuesRepository, project *projectPbv1.Project, assigneeIDs []string, count int, now time.Time) error {
+	logger.ZapLogger.Debug("Seeding issue data",
+		zap.String("project_id", project.ProjectId),
+		zap.Int("count", count))
+
+	for i := 0; i < count; i++ {
+		issue := GenerateRandomIssue(project, assigneeIDs, now)
+
+		if err := repo.CreateIssue(ctx, issue); err != nil {
+			logger.ZapLogger.Error("Failed to seed issue",
+				zap.String("project_id", project.ProjectId),
+				zap.String("summary", issue.Summary),
+				zap.Error(err))
+			continue
+		}
+
+		logger.ZapLogger.Debug("Created seed issue",
+			zap.String("id", issue.IssueId),
+			zap.String("status", issue.Status.String()),
+			zap.String("summary", issue.Summary))
+	}
+
+	return nil
+}
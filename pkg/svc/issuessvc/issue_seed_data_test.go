@@ -0,0 +1,97 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+func TestGenerateRandomIssue_Distribution(t *testing.T) {
+	require.NoError(t, gofakeit.Seed(42))
+	t.Cleanup(func() { _ = gofakeit.Seed(0) })
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	project := &projectPbv1.Project{ProjectId: validProjectID, Name: "Web Application - Seeder"}
+	assignees := []string{validUserID, targetProjectID}
+
+	const count = 2000
+	statuses := map[issuesPbv1.Status]int{}
+	for i := 0; i < count; i++ {
+		issue := issuessvc.GenerateRandomIssue(project, assignees, now)
+		statuses[issue.Status]++
+
+		created := issue.CreateDate.AsTime()
+		assert.False(t, created.After(now))
+		assert.False(t, created.Before(now.AddDate(0, 0, -90)))
+		assert.False(t, issue.ModifyDate.AsTime().Before(created))
+		assert.LessOrEqual(t, len(issue.Summary), 100)
+		assert.NotEmpty(t, issue.Description)
+		assert.LessOrEqual(t, len(issue.Description), 500)
+		assert.Equal(t, validProjectID, issue.ProjectId)
+
+		switch issue.Status {
+		case issuesPbv1.Status_NEW:
+			assert.Empty(t, issue.AssigneeId)
+			assert.Equal(t, created, issue.ModifyDate.AsTime())
+		case issuesPbv1.Status_RESOLVED, issuesPbv1.Status_CLOSED:
+			assert.NotEqual(t, issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED, issue.Resolution)
+			require.NotNil(t, issue.ResolveDate)
+			assert.False(t, issue.ResolveDate.AsTime().Before(created))
+			assert.False(t, issue.ModifyDate.AsTime().Before(issue.ResolveDate.AsTime()))
+			assert.Contains(t, assignees, issue.AssigneeId)
+		default:
+			assert.Nil(t, issue.ResolveDate)
+			assert.Contains(t, assignees, issue.AssigneeId)
+		}
+	}
+
+	expected := map[issuesPbv1.Status]float64{
+		issuesPbv1.Status_NEW:         0.30,
+		issuesPbv1.Status_ASSIGNED:    0.25,
+		issuesPbv1.Status_IN_PROGRESS: 0.20,
+		issuesPbv1.Status_RESOLVED:    0.15,
+		issuesPbv1.Status_CLOSED:      0.10,
+	}
+	assert.Len(t, statuses, len(expected))
+	for status, share := range expected {
+		assert.InDelta(t, share, float64(statuses[status])/count, 0.04, status.String())
+	}
+}
+
+func TestSeedIssues_DeterministicWithSeed(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	t.Cleanup(func() { _ = gofakeit.Seed(0) })
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	project := &projectPbv1.Project{ProjectId: validProjectID, Name: "API Service - Seeder"}
+
+	seedRun := func() []*issuesPbv1.Issue {
+		require.NoError(t, gofakeit.Seed(7))
+		repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+		require.NoError(t, err)
+		require.NoError(t, issuessvc.SeedIssues(context.Background(), repo, project, []string{validUserID}, 20, now))
+
+		issues, _, err := repo.ListIssues(context.Background(), "", 50)
+		require.NoError(t, err)
+		require.Len(t, issues, 20)
+		return issues
+	}
+
+	first, second := seedRun(), seedRun()
+	for i := range first {
+		assert.Equal(t, first[i].IssueId, second[i].IssueId)
+		assert.Equal(t, first[i].Summary, second[i].Summary)
+		assert.Equal(t, first[i].Status, second[i].Status)
+		assert.Equal(t, first[i].CreateDate.AsTime(), second[i].CreateDate.AsTime())
+	}
+}
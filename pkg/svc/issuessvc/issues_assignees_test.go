@@ -0,0 +1,122 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+const (
+	otherUserID   = "b38f705f-0efa-4c96-b2f6-ceb36281e1f3"
+	missingUserID = "c48f705f-0efa-4c96-b2f6-ceb36281e1f4"
+)
+
+func TestIssuesRepositories_CountAndReassignOpenIssues(t *testing.T) {
+	for name, repos := range newNumberingRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			statuses := []issuesPbv1.Status{
+				issuesPbv1.Status_ASSIGNED,
+				issuesPbv1.Status_IN_PROGRESS,
+				issuesPbv1.Status_RESOLVED,
+				issuesPbv1.Status_CLOSED,
+			}
+			for i, st := range statuses {
+				issue := &issuesPbv1.Issue{IssueId: numberedIssueID(i), Summary: testSummary, ProjectId: validProjectID, Status: st, AssigneeId: validUserID}
+				require.NoError(t, repos.issues.CreateIssue(ctx, issue))
+			}
+			otherIssue := &issuesPbv1.Issue{IssueId: numberedIssueID(len(statuses)), Summary: testSummary, ProjectId: validProjectID, Status: issuesPbv1.Status_ASSIGNED, AssigneeId: otherUserID}
+			require.NoError(t, repos.issues.CreateIssue(ctx, otherIssue))
+
+			count, err := repos.issues.CountOpenIssuesByAssignee(ctx, validUserID)
+			require.NoError(t, err)
+			assert.Equal(t, 3, count, "the closed issue doesn't count")
+
+			reassigned, err := repos.issues.ReassignOpenIssues(ctx, validUserID, otherUserID)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{numberedIssueID(0), numberedIssueID(1), numberedIssueID(2)}, reassigned)
+
+			count, err = repos.issues.CountOpenIssuesByAssignee(ctx, validUserID)
+			require.NoError(t, err)
+			assert.Zero(t, count)
+			count, err = repos.issues.CountOpenIssuesByAssignee(ctx, otherUserID)
+			require.NoError(t, err)
+			assert.Equal(t, 4, count)
+
+			moved, err := repos.issues.ReadIssue(ctx, numberedIssueID(0))
+			require.NoError(t, err)
+			assert.Equal(t, otherUserID, moved.AssigneeId)
+			assert.Equal(t, issuesPbv1.Status_ASSIGNED, moved.Status)
+			assert.Equal(t, int64(2), moved.Version, "reassigning is a change clients holding the issue must see")
+
+			closed, err := repos.issues.ReadIssue(ctx, numberedIssueID(3))
+			require.NoError(t, err)
+			assert.Equal(t, validUserID, closed.AssigneeId, "closed issues keep their assignee")
+		})
+	}
+}
+
+func TestIssuesService_AssignIssueChecksAssigneeIsActive(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	userRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	require.NoError(t, userRepo.CreateUser(ctx, &userPbv1.User{UserId: validUserID, EmailAddress: "active@example.com", Active: true}))
+	require.NoError(t, userRepo.CreateUser(ctx, &userPbv1.User{UserId: otherUserID, EmailAddress: "deactivated@example.com", Active: true}))
+	_, err = userRepo.SetUserActive(ctx, otherUserID, false)
+	require.NoError(t, err)
+
+	repos := newNumberingRepositories(t)["memdb"]
+	issuesRepo := repos.issues.(*issuessvc.MemDBIssuesRepository)
+	issuesRepo.SetClients(issuessvc.NewLocalValidator(repos.projects, userRepo))
+	service := issuessvc.NewIssuesService(issuesRepo, nil, nil)
+
+	created, err := service.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+		Summary:   testSummary,
+		Type:      issuesPbv1.Type_BUG,
+		Priority:  issuesPbv1.Priority_MINOR,
+		ProjectId: validProjectID,
+	})
+	require.NoError(t, err)
+	issueID := created.Issue.IssueId
+
+	// A deactivated user exists but can't take new issues
+	_, err = service.AssignIssue(ctx, &issuesPbv1.AssignIssueRequest{IssueId: issueID, AssigneeId: otherUserID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, err, consts.ErrUserDeactivated.Error())
+
+	_, err = service.AssignIssue(ctx, &issuesPbv1.AssignIssueRequest{IssueId: issueID, AssigneeId: missingUserID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := service.AssignIssue(ctx, &issuesPbv1.AssignIssueRequest{IssueId: issueID, AssigneeId: validUserID})
+	require.NoError(t, err)
+	assert.Equal(t, validUserID, resp.Issue.AssigneeId)
+}
+
+func TestPostgresIssuesRepository_ValidateUserExistsReportsDeactivatedUsers(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+	users := usersvc.NewPostgresUserRepository(db)
+	repo := issuessvc.NewPostgresIssuesRepository(db)
+
+	require.NoError(t, users.CreateUser(ctx, &userPbv1.User{UserId: validUserID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com", Active: true}))
+	assert.NoError(t, repo.ValidateUserExists(ctx, validUserID))
+	assert.ErrorIs(t, repo.ValidateUserExists(ctx, missingUserID), consts.ErrUserNotFound)
+
+	_, err := users.SetUserActive(ctx, validUserID, false)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.ValidateUserExists(ctx, validUserID), consts.ErrUserDeactivated)
+}
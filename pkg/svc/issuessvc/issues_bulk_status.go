@@ -0,0 +1,150 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// BulkStatusUpdate moves every issue of a project in one status, optionally narrowed by a filter,
+// to another status
+type BulkStatusUpdate struct {
+	ProjectID  string
+	FromStatus issuesPbv1.Status
+	ToStatus   issuesPbv1.Status
+	// Resolution and ResolutionComment are only written when Resolution is specified
+	Resolution        issuesPbv1.Resolution
+	ResolutionComment string
+	Filter            *issuesPbv1.IssueFilter
+}
+
+// matches reports whether an issue is one the update moves. Issues without an assignee are
+// left out of moves to ASSIGNED and IN_PROGRESS, as those statuses need one; issues still blocked
+// by open issues are left out of moves to RESOLVED and CLOSED by the repositories.
+func (u BulkStatusUpdate) matches(issue *issuesPbv1.Issue) bool {
+	if issue.ProjectId != u.ProjectID || issue.Status != u.FromStatus {
+		return false
+	}
+	if u.needsAssignee() && issue.AssigneeId == "" {
+		return false
+	}
+
+	filter := u.Filter
+	if filter == nil {
+		return true
+	}
+	if len(filter.Types) > 0 && !slices.Contains(filter.Types, issue.Type) {
+		return false
+	}
+	if len(filter.Priorities) > 0 && !slices.Contains(filter.Priorities, issue.Priority) {
+		return false
+	}
+	return filter.AssigneeId == "" || filter.AssigneeId == issue.AssigneeId
+}
+
+func (u BulkStatusUpdate) needsAssignee() bool {
+	return u.ToStatus == issuesPbv1.Status_ASSIGNED || u.ToStatus == issuesPbv1.Status_IN_PROGRESS
+}
+
+func (u BulkStatusUpdate) resolves() bool {
+	return u.ToStatus == issuesPbv1.Status_RESOLVED || u.ToStatus == issuesPbv1.Status_CLOSED
+}
+
+// BulkUpdateIssueStatus moves all issues of a project in from_status to to_status, for example to
+// close every resolved issue at the end of a sprint. Issues blocked by open issues are not resolved
+// or closed, and issues without an assignee are not moved to ASSIGNED or IN_PROGRESS.
+func (s *IssuesServiceServer) BulkUpdateIssueStatus(ctx context.Context, req *issuesPbv1.BulkUpdateIssueStatusRequest) (*issuesPbv1.BulkUpdateIssueStatusResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if req.FromStatus == req.ToStatus {
+		return nil, status.Error(codes.InvalidArgument, "from_status and to_status must differ")
+	}
+
+	if err := s.repository.IsValidStatusTransition(ctx, req.FromStatus, req.ToStatus); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot move issues from %s to %s: %v", req.FromStatus, req.ToStatus, err)
+	}
+
+	update := BulkStatusUpdate{
+		ProjectID:         req.ProjectId,
+		FromStatus:        req.FromStatus,
+		ToStatus:          req.ToStatus,
+		Resolution:        req.Resolution,
+		ResolutionComment: req.ResolutionComment,
+		Filter:            req.Filter,
+	}
+	// Resolved issues already have a resolution, which closing them keeps unless a new one is given
+	if update.resolves() && req.FromStatus != issuesPbv1.Status_RESOLVED &&
+		req.Resolution == issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "resolution is required when status is Resolved or Closed")
+	}
+	if !update.resolves() && req.Resolution != issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "resolution can only be set when status is Resolved or Closed")
+	}
+
+	// The rules only look at the current status of the issue, which every matching issue shares.
+	// A duplicate reference differs per issue, so issues can't be resolved as duplicates in bulk.
+	if err := s.resolutions.Validate(ctx, ResolutionChange{
+		Issue:      &issuesPbv1.Issue{ProjectId: req.ProjectId, Status: req.FromStatus},
+		NewStatus:  req.ToStatus,
+		Resolution: req.Resolution,
+		Comment:    req.ResolutionComment,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.ValidateProjectExists(ctx, req.ProjectId); err != nil {
+		if isDependencyUnavailable(err) {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
+	}
+
+	issueIDs, err := s.repository.BulkUpdateStatus(ctx, update)
+	if err != nil {
+		if errors.Is(err, consts.ErrIssueVersionConflict) {
+			return nil, status.Error(codes.Aborted, "issues were modified by another request; retry the update")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update issues: %v", err)
+	}
+
+	s.notifyBulkUpdateWatchers(ctx, issueIDs)
+
+	return &issuesPbv1.BulkUpdateIssueStatusResponse{
+		UpdatedCount: int32(len(issueIDs)),
+		Message:      fmt.Sprintf("%d issues moved from %s to %s", len(issueIDs), req.FromStatus, req.ToStatus),
+	}, nil
+}
+
+// notifyBulkUpdateWatchers sends the updated issues to their watchers, loading only the issues
+// somebody is watching. The issues are already saved, so failing to load them is only logged.
+func (s *IssuesServiceServer) notifyBulkUpdateWatchers(ctx context.Context, issueIDs []string) {
+	var watched []string
+	for _, issueID := range issueIDs {
+		if _, ok := s.issueWatchers.Load(issueID); ok {
+			watched = append(watched, issueID)
+		}
+	}
+	if len(watched) == 0 {
+		return
+	}
+
+	issues, err := s.repository.BatchGetIssues(ctx, watched)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load bulk updated issues for their watchers", zap.Error(err))
+		return
+	}
+	for _, issue := range issues {
+		s.notifyIssueWatchers(IssueEventUpdated, issue)
+	}
+}
@@ -0,0 +1,266 @@
+package issuessvc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const bulkLinkID = "d72d237e-2658-4252-be58-760c7867d790"
+
+// createBulkIssues creates the issues the bulk status tests move:
+//
+//	0: RESOLVED bug, assigned to validUserID
+//	1: RESOLVED feature, assigned to otherUserID
+//	2: RESOLVED bug, blocked by issue 4
+//	3: RESOLVED bug in targetProjectID
+//	4: IN_PROGRESS bug, assigned to validUserID
+//	5: NEW bug without an assignee
+func createBulkIssues(t *testing.T, repo issuessvc.IssuesRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	issues := []*issuesPbv1.Issue{
+		{Status: issuesPbv1.Status_RESOLVED, Type: issuesPbv1.Type_BUG, AssigneeId: validUserID},
+		{Status: issuesPbv1.Status_RESOLVED, Type: issuesPbv1.Type_FEATURE, AssigneeId: otherUserID},
+		{Status: issuesPbv1.Status_RESOLVED, Type: issuesPbv1.Type_BUG, AssigneeId: validUserID},
+		{Status: issuesPbv1.Status_RESOLVED, Type: issuesPbv1.Type_BUG, AssigneeId: validUserID, ProjectId: targetProjectID},
+		{Status: issuesPbv1.Status_IN_PROGRESS, Type: issuesPbv1.Type_BUG, AssigneeId: validUserID},
+		{Status: issuesPbv1.Status_NEW, Type: issuesPbv1.Type_BUG},
+	}
+	resolvedAt := timestamppb.New(time.Now().Add(-24 * time.Hour))
+	for i, issue := range issues {
+		issue.IssueId = numberedIssueID(i)
+		issue.Summary = testSummary
+		issue.Priority = issuesPbv1.Priority_MAJOR
+		if issue.ProjectId == "" {
+			issue.ProjectId = validProjectID
+		}
+		if issue.Status == issuesPbv1.Status_RESOLVED {
+			issue.Resolution = issuesPbv1.Resolution_FIXED
+			issue.ResolveDate = resolvedAt
+		}
+		require.NoError(t, repo.CreateIssue(ctx, issue))
+	}
+
+	require.NoError(t, repo.CreateIssueLink(ctx, &issuesPbv1.IssueLink{
+		LinkId:        bulkLinkID,
+		SourceIssueId: numberedIssueID(4),
+		TargetIssueId: numberedIssueID(2),
+		Type:          issuesPbv1.LinkType_BLOCKS,
+	}))
+}
+
+func TestIssuesRepositories_BulkUpdateStatus(t *testing.T) {
+	for name, repos := range newNumberingRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			createBulkIssues(t, repos.issues)
+
+			// Only bugs are closed; the blocked bug and the bug of the other project stay resolved
+			updated, err := repos.issues.BulkUpdateStatus(ctx, issuessvc.BulkStatusUpdate{
+				ProjectID:  validProjectID,
+				FromStatus: issuesPbv1.Status_RESOLVED,
+				ToStatus:   issuesPbv1.Status_CLOSED,
+				Filter:     &issuesPbv1.IssueFilter{Types: []issuesPbv1.Type{issuesPbv1.Type_BUG}},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []string{numberedIssueID(0)}, updated)
+
+			closed, err := repos.issues.ReadIssue(ctx, numberedIssueID(0))
+			require.NoError(t, err)
+			assert.Equal(t, issuesPbv1.Status_CLOSED, closed.Status)
+			assert.Equal(t, issuesPbv1.Resolution_FIXED, closed.Resolution, "closing keeps the resolution")
+			assert.Equal(t, int64(2), closed.Version)
+			require.NotNil(t, closed.ResolveDate)
+			assert.WithinDuration(t, time.Now().Add(-24*time.Hour), closed.ResolveDate.AsTime(), time.Minute, "closing keeps the date the issue was resolved on")
+
+			for _, i := range []int{1, 2, 3} {
+				issue, err := repos.issues.ReadIssue(ctx, numberedIssueID(i))
+				require.NoError(t, err)
+				assert.Equal(t, issuesPbv1.Status_RESOLVED, issue.Status, "issue %d", i)
+			}
+
+			// Resolving the blocker lets the blocked issue be closed
+			updated, err = repos.issues.BulkUpdateStatus(ctx, issuessvc.BulkStatusUpdate{
+				ProjectID:         validProjectID,
+				FromStatus:        issuesPbv1.Status_IN_PROGRESS,
+				ToStatus:          issuesPbv1.Status_RESOLVED,
+				Resolution:        issuesPbv1.Resolution_WONTFIX,
+				ResolutionComment: "Out of scope",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []string{numberedIssueID(4)}, updated)
+
+			resolved, err := repos.issues.ReadIssue(ctx, numberedIssueID(4))
+			require.NoError(t, err)
+			assert.Equal(t, issuesPbv1.Resolution_WONTFIX, resolved.Resolution)
+			assert.Equal(t, "Out of scope", resolved.ResolutionComment)
+			assert.NotNil(t, resolved.ResolveDate)
+
+			updated, err = repos.issues.BulkUpdateStatus(ctx, issuessvc.BulkStatusUpdate{
+				ProjectID:  validProjectID,
+				FromStatus: issuesPbv1.Status_RESOLVED,
+				ToStatus:   issuesPbv1.Status_CLOSED,
+				Filter:     &issuesPbv1.IssueFilter{AssigneeId: validUserID},
+			})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{numberedIssueID(2), numberedIssueID(4)}, updated)
+
+			// Issues without an assignee can't be moved to ASSIGNED
+			updated, err = repos.issues.BulkUpdateStatus(ctx, issuessvc.BulkStatusUpdate{
+				ProjectID:  validProjectID,
+				FromStatus: issuesPbv1.Status_NEW,
+				ToStatus:   issuesPbv1.Status_ASSIGNED,
+			})
+			require.NoError(t, err)
+			assert.Empty(t, updated)
+		})
+	}
+}
+
+func TestPostgresIssuesRepository_BulkUpdateStatusRechecksMatches(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+	require.NoError(t, projectsvc.NewPostgresProjectRepository(db).CreateProject(ctx, &projectPbv1.Project{ProjectId: validProjectID, Name: "Project"}))
+	repo := issuessvc.NewPostgresIssuesRepository(db)
+	createBulkIssues(t, repo)
+
+	// Resolve the blocker of issue 2, then reopen it after the issues to close are selected
+	blocker, err := repo.ReadIssue(ctx, numberedIssueID(4))
+	require.NoError(t, err)
+	blocker.Status, blocker.Resolution = issuesPbv1.Status_RESOLVED, issuesPbv1.Resolution_FIXED
+	require.NoError(t, repo.UpdateIssue(ctx, blocker))
+	var reopened bool
+	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:reopen_blocker", func(tx *gorm.DB) {
+		if reopened || !strings.Contains(tx.Statement.SQL.String(), "NOT EXISTS") {
+			return
+		}
+		reopened = true
+		require.NoError(t, tx.Session(&gorm.Session{NewDB: true}).
+			Exec("UPDATE issues SET status = ? WHERE issue_id = ?", issuesPbv1.Status_IN_PROGRESS.String(), numberedIssueID(4)).Error)
+	}))
+
+	updated, err := repo.BulkUpdateStatus(ctx, issuessvc.BulkStatusUpdate{
+		ProjectID:  validProjectID,
+		FromStatus: issuesPbv1.Status_RESOLVED,
+		ToStatus:   issuesPbv1.Status_CLOSED,
+		Filter:     &issuesPbv1.IssueFilter{Types: []issuesPbv1.Type{issuesPbv1.Type_BUG}},
+	})
+	require.True(t, reopened)
+	assert.ErrorIs(t, err, consts.ErrIssueVersionConflict)
+	assert.Empty(t, updated)
+
+	// Neither the reopened blocker nor the issue it blocks again is closed, and the whole update is undone
+	for _, i := range []int{0, 2} {
+		issue, err := repo.ReadIssue(ctx, numberedIssueID(i))
+		require.NoError(t, err)
+		assert.Equal(t, issuesPbv1.Status_RESOLVED, issue.Status, "issue %d", i)
+	}
+}
+
+func TestIssuesService_BulkUpdateIssueStatus(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	repos := newNumberingRepositories(t)["sqlite"]
+	issuesRepo := issuessvc.NewCachedIssuesRepository(repos.issues, cache.NewMemoryCache(100), cache.CacheConfigFromEnv())
+	createBulkIssues(t, issuesRepo)
+	service := issuessvc.NewIssuesService(issuesRepo, nil, nil)
+
+	// Cache the issue, which the bulk update must invalidate
+	_, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: numberedIssueID(1)})
+	require.NoError(t, err)
+
+	resp, err := service.BulkUpdateIssueStatus(ctx, &issuesPbv1.BulkUpdateIssueStatusRequest{
+		ProjectId:  validProjectID,
+		FromStatus: issuesPbv1.Status_RESOLVED,
+		ToStatus:   issuesPbv1.Status_CLOSED,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.UpdatedCount, "the blocked issue stays resolved")
+
+	got, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: numberedIssueID(1)})
+	require.NoError(t, err)
+	assert.Equal(t, issuesPbv1.Status_CLOSED, got.Issue.Status)
+}
+
+func TestIssuesService_BulkUpdateIssueStatusErrors(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repos := newNumberingRepositories(t)["sqlite"]
+	createBulkIssues(t, repos.issues)
+	service := issuessvc.NewIssuesService(repos.issues, nil, nil)
+
+	testCases := []struct {
+		name string
+		req  *issuesPbv1.BulkUpdateIssueStatusRequest
+		code codes.Code
+	}{
+		{
+			name: "Same status",
+			req:  &issuesPbv1.BulkUpdateIssueStatusRequest{ProjectId: validProjectID, FromStatus: issuesPbv1.Status_NEW, ToStatus: issuesPbv1.Status_NEW},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Invalid transition",
+			req:  &issuesPbv1.BulkUpdateIssueStatusRequest{ProjectId: validProjectID, FromStatus: issuesPbv1.Status_CLOSED, ToStatus: issuesPbv1.Status_NEW},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Missing resolution",
+			req:  &issuesPbv1.BulkUpdateIssueStatusRequest{ProjectId: validProjectID, FromStatus: issuesPbv1.Status_IN_PROGRESS, ToStatus: issuesPbv1.Status_RESOLVED},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Resolution without resolving",
+			req:  &issuesPbv1.BulkUpdateIssueStatusRequest{ProjectId: validProjectID, FromStatus: issuesPbv1.Status_NEW, ToStatus: issuesPbv1.Status_ASSIGNED, Resolution: issuesPbv1.Resolution_FIXED},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Duplicates need a reference per issue",
+			req:  &issuesPbv1.BulkUpdateIssueStatusRequest{ProjectId: validProjectID, FromStatus: issuesPbv1.Status_IN_PROGRESS, ToStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_DUPLICATE},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Invalid filter",
+			req: &issuesPbv1.BulkUpdateIssueStatusRequest{ProjectId: validProjectID, FromStatus: issuesPbv1.Status_RESOLVED, ToStatus: issuesPbv1.Status_CLOSED,
+				Filter: &issuesPbv1.IssueFilter{AssigneeId: "not-a-uuid"}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Unknown project",
+			req:  &issuesPbv1.BulkUpdateIssueStatusRequest{ProjectId: missingUserID, FromStatus: issuesPbv1.Status_RESOLVED, ToStatus: issuesPbv1.Status_CLOSED},
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.BulkUpdateIssueStatus(context.Background(), tc.req)
+			assert.Equal(t, tc.code, status.Code(err))
+		})
+	}
+
+	// None of the refused requests changed an issue
+	issue, err := repos.issues.ReadIssue(context.Background(), numberedIssueID(0))
+	require.NoError(t, err)
+	assert.Equal(t, issuesPbv1.Status_RESOLVED, issue.Status)
+}
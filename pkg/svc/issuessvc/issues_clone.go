@@ -0,0 +1,101 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+const (
+	// defaultClonePrefix is prepended to the summary of cloned issues unless the request sets its own prefix
+	defaultClonePrefix = "[CLONE]"
+)
+
+// CloneIssue copies an issue into the same or another project as a new, unassigned issue.
+// The clone records the source in cloned_from, and watchers of the source get a cloned event.
+func (s *IssuesServiceServer) CloneIssue(ctx context.Context, req *issuesPbv1.CloneIssueRequest) (*issuesPbv1.CloneIssueResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	source, err := s.repository.ReadIssue(ctx, req.SourceIssueId)
+	if err != nil {
+		if errors.Is(err, consts.ErrIssueNotFound) || status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "source issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
+	}
+
+	projectID := source.ProjectId
+	if req.TargetProjectId != "" && req.TargetProjectId != source.ProjectId {
+		if err := s.repository.ValidateProjectExists(ctx, req.TargetProjectId); err != nil {
+			if isDependencyUnavailable(err) {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.NotFound, "target project not found: %v", err)
+		}
+		projectID = req.TargetProjectId
+	}
+	if err := s.checkProjectIssueLimit(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	prefix := defaultClonePrefix
+	if req.SummaryPrefix != nil {
+		prefix = req.GetSummaryPrefix()
+	}
+
+	now := timestamppb.Now()
+	clone := &issuesPbv1.Issue{
+		IssueId:     uuid.NewString(),
+		Summary:     cloneSummary(prefix, source.Summary),
+		Description: source.Description,
+		Type:        source.Type,
+		Priority:    source.Priority,
+		Status:      issuesPbv1.Status_NEW,
+		ProjectId:   projectID,
+		ClonedFrom:  source.IssueId,
+		CreateDate:  now,
+		ModifyDate:  now,
+	}
+
+	if err := s.repository.CreateIssue(ctx, clone); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create issue: %v", err)
+	}
+
+	if err := s.notifyProjectService(ctx, clone.ProjectId, clone.IssueId); err != nil {
+		logger.ZapLogger.Error("Failed to notify ProjectService about cloned issue",
+			zap.String("issueId", clone.IssueId),
+			zap.String("projectId", clone.ProjectId),
+			zap.Error(err))
+	}
+	s.notifyWatchersOf(source.IssueId, IssueEventCloned, clone)
+
+	return &issuesPbv1.CloneIssueResponse{
+		Message:     fmt.Sprintf("Issue %s cloned to %s in project %s", source.IssueId, clone.IssueId, clone.ProjectId),
+		ClonedIssue: clone,
+	}, nil
+}
+
+// cloneSummary prefixes the summary, cutting it off so it still fits the summary length limit
+func cloneSummary(prefix, summary string) string {
+	if prefix != "" {
+		summary = prefix + " " + summary
+	}
+	if maxLength := SummaryMaxLengthFromEnv(); utf8.RuneCountInString(summary) > maxLength {
+		summary = string([]rune(summary)[:maxLength])
+	}
+	return summary
+}
@@ -0,0 +1,184 @@
+package issuessvc_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// newCloneFixture returns a service whose repository holds an assigned, in-progress issue
+// with validIssueID and records the issue passed to CreateIssue
+func newCloneFixture(t *testing.T, created **issuesPbv1.Issue) (*issuessvc.IssuesServiceServer, *mocks.MockIssuesRepository) {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issueID string) (*issuesPbv1.Issue, error) {
+		if issueID != validIssueID {
+			return nil, consts.ErrIssueNotFound
+		}
+		return &issuesPbv1.Issue{
+			IssueId:     validIssueID,
+			Summary:     testSummary,
+			Description: testDescription,
+			Status:      issuesPbv1.Status_IN_PROGRESS,
+			Type:        issuesPbv1.Type_BUG,
+			Priority:    issuesPbv1.Priority_CRITICAL,
+			ProjectId:   validProjectID,
+			AssigneeId:  validUserID,
+			Version:     3,
+		}, nil
+	}).AnyTimes()
+	mockRepo.EXPECT().CreateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
+		*created = issue
+		return nil
+	}).AnyTimes()
+	mockRepo.EXPECT().GetProjectMaxIssues(gomock.Any(), gomock.Any()).Return(int32(0), nil).AnyTimes()
+	mockProjectService.EXPECT().UpdateProjectWithIssue(gomock.Any(), gomock.Any()).Return(
+		&projectPbv1.UpdateProjectWithIssueResponse{}, nil).AnyTimes()
+
+	return issuessvc.NewIssuesService(mockRepo, mockProjectService, mocks.NewMockUserServiceClient(ctrl)), mockRepo
+}
+
+func TestIssuesServiceServer_CloneIssueIntoSameProject(t *testing.T) {
+	var created *issuesPbv1.Issue
+	service, _ := newCloneFixture(t, &created)
+
+	resp, err := service.CloneIssue(context.Background(), &issuesPbv1.CloneIssueRequest{SourceIssueId: validIssueID})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Same(t, created, resp.ClonedIssue)
+
+	assert.NotEqual(t, validIssueID, created.IssueId)
+	assert.Equal(t, "[CLONE] "+testSummary, created.Summary)
+	assert.Equal(t, testDescription, created.Description)
+	assert.Equal(t, issuesPbv1.Type_BUG, created.Type)
+	assert.Equal(t, issuesPbv1.Priority_CRITICAL, created.Priority)
+	assert.Equal(t, validProjectID, created.ProjectId)
+	assert.Equal(t, issuesPbv1.Status_NEW, created.Status)
+	assert.Empty(t, created.AssigneeId)
+	assert.Equal(t, validIssueID, created.ClonedFrom)
+	assert.Zero(t, created.Version)
+}
+
+func TestIssuesServiceServer_CloneIssueIntoOtherProject(t *testing.T) {
+	var created *issuesPbv1.Issue
+	service, mockRepo := newCloneFixture(t, &created)
+	mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), targetProjectID).Return(nil)
+
+	_, err := service.CloneIssue(context.Background(), &issuesPbv1.CloneIssueRequest{
+		SourceIssueId:   validIssueID,
+		TargetProjectId: targetProjectID,
+		SummaryPrefix:   proto.String(""),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, targetProjectID, created.ProjectId)
+	assert.Equal(t, testSummary, created.Summary)
+}
+
+func TestIssuesServiceServer_CloneIssueErrors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		req          *issuesPbv1.CloneIssueRequest
+		setupMocks   func(mockRepo *mocks.MockIssuesRepository)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "invalid source ID",
+			req:          &issuesPbv1.CloneIssueRequest{SourceIssueId: "not-a-uuid"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "source issue missing",
+			req:          &issuesPbv1.CloneIssueRequest{SourceIssueId: targetProjectID},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "target project missing",
+			req:  &issuesPbv1.CloneIssueRequest{SourceIssueId: validIssueID, TargetProjectId: targetProjectID},
+			setupMocks: func(mockRepo *mocks.MockIssuesRepository) {
+				mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), targetProjectID).Return(errors.New("project not found"))
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var created *issuesPbv1.Issue
+			service, mockRepo := newCloneFixture(t, &created)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockRepo)
+			}
+
+			_, err := service.CloneIssue(context.Background(), tc.req)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+			assert.Nil(t, created)
+		})
+	}
+}
+
+func TestIssuesServiceServer_CloneIssueTruncatesSummary(t *testing.T) {
+	var created *issuesPbv1.Issue
+	service, _ := newCloneFixture(t, &created)
+
+	_, err := service.CloneIssue(context.Background(), &issuesPbv1.CloneIssueRequest{
+		SourceIssueId: validIssueID,
+		SummaryPrefix: proto.String(strings.Repeat("x", 20)),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.LessOrEqual(t, len([]rune(created.Summary)), 100)
+	assert.True(t, strings.HasPrefix(created.Summary, strings.Repeat("x", 20)+" "))
+}
+
+func TestIssuesServiceServer_CloneIssueNotifiesSourceWatchers(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+	svc := issuessvc.NewIssuesService(mockRepo, mockProjectService, mocks.NewMockUserServiceClient(ctrl))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, _ := startWatch(t, svc, mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+		IssueId:   validIssueID,
+		Summary:   testSummary,
+		Status:    issuesPbv1.Status_NEW,
+		ProjectId: validProjectID,
+	}, nil)
+	mockRepo.EXPECT().GetProjectMaxIssues(gomock.Any(), validProjectID).Return(int32(0), nil)
+	mockRepo.EXPECT().CreateIssue(gomock.Any(), gomock.Any()).Return(nil)
+	mockProjectService.EXPECT().UpdateProjectWithIssue(gomock.Any(), gomock.Any()).Return(
+		&projectPbv1.UpdateProjectWithIssueResponse{}, nil)
+
+	resp, err := svc.CloneIssue(context.Background(), &issuesPbv1.CloneIssueRequest{SourceIssueId: validIssueID})
+	require.NoError(t, err)
+
+	event := receiveEvent(t, stream)
+	assert.Equal(t, issuessvc.IssueEventCloned, event.EventType)
+	assert.Equal(t, resp.ClonedIssue.IssueId, event.Issue.IssueId)
+	assert.Equal(t, validIssueID, event.Issue.ClonedFrom)
+}
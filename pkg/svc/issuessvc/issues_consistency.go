@@ -0,0 +1,82 @@
+package issuessvc
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// ProjectConsistencyHeader is the response header naming the mode that handled a CreateIssue:
+// ProjectConsistencyStrict or ProjectConsistencyLenient
+const ProjectConsistencyHeader = "x-project-consistency"
+
+// Modes reported in ProjectConsistencyHeader
+const (
+	// ProjectConsistencyStrict removes the created issue when its project can't be notified
+	ProjectConsistencyStrict = "strict"
+	// ProjectConsistencyLenient keeps the created issue when its project can't be notified
+	ProjectConsistencyLenient = "lenient"
+)
+
+// rollbackTimeout bounds the removal of an issue whose project couldn't be notified
+const rollbackTimeout = 5 * time.Second
+
+// StrictProjectConsistencyFromEnv reads STRICT_PROJECT_CONSISTENCY, which is off when unset or invalid
+func StrictProjectConsistencyFromEnv() bool {
+	strict, err := strconv.ParseBool(os.Getenv("STRICT_PROJECT_CONSISTENCY"))
+	return err == nil && strict
+}
+
+// notifyCreatedIssue tells the project service about a created issue. By default a failure is
+// only logged, so the issue is created even though its project's issue count drifts. With
+// STRICT_PROJECT_CONSISTENCY the issue is deleted again and the call fails with Unavailable;
+// if the delete fails too, a reconciliation record is logged so the issue can be repaired.
+func (s *IssuesServiceServer) notifyCreatedIssue(ctx context.Context, issue *issuesPbv1.Issue) error {
+	strict := StrictProjectConsistencyFromEnv()
+	mode := ProjectConsistencyLenient
+	if strict {
+		mode = ProjectConsistencyStrict
+	}
+	// Calls made outside of a gRPC server, such as in tests, have no headers to set
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ProjectConsistencyHeader, mode))
+
+	projectErr := s.notifyProjectService(ctx, issue.ProjectId, issue.IssueId)
+	if projectErr == nil {
+		return nil
+	}
+	if !strict {
+		logger.FromContext(ctx).Error("Failed to notify ProjectService about new issue",
+			zap.String("issueId", issue.IssueId),
+			zap.String("projectId", issue.ProjectId),
+			zap.Error(projectErr))
+		return nil
+	}
+
+	// The request's deadline may have passed while notifying, so the rollback gets its own
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+	if err := s.repository.DeleteIssue(rollbackCtx, issue.IssueId); err != nil {
+		logger.FromContext(ctx).Error("Reconciliation needed: issue kept although its project wasn't notified",
+			zap.String("reconciliation", "issue_not_counted_by_project"),
+			zap.String("issueId", issue.IssueId),
+			zap.String("projectId", issue.ProjectId),
+			zap.NamedError("notifyError", projectErr),
+			zap.Error(err))
+		return status.Errorf(codes.Unavailable, "failed to notify the project service, and issue %s could not be removed again: %v", issue.IssueId, projectErr)
+	}
+	logger.FromContext(ctx).Warn("Removed new issue because its project couldn't be notified",
+		zap.String("issueId", issue.IssueId),
+		zap.String("projectId", issue.ProjectId),
+		zap.Error(projectErr))
+	return status.Errorf(codes.Unavailable, "failed to notify the project service, so the issue was not created: %v", projectErr)
+}
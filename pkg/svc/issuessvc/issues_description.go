@@ -0,0 +1,79 @@
+package issuessvc
+
+import (
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// Elements removed together with their content; an unclosed one runs to the end of the text
+	unsafeElementPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?is)<script\b[^>]*>.*?(?:</script\s*>|$)`),
+		regexp.MustCompile(`(?is)<style\b[^>]*>.*?(?:</style\s*>|$)`),
+		regexp.MustCompile(`(?is)<iframe\b[^>]*>.*?(?:</iframe\s*>|$)`),
+		regexp.MustCompile(`(?is)<object\b[^>]*>.*?(?:</object\s*>|$)`),
+		regexp.MustCompile(`(?is)<embed\b[^>]*>.*?(?:</embed\s*>|$)`),
+	}
+
+	htmlCommentPattern = regexp.MustCompile(`(?s)<!--.*?(?:-->|$)`)
+
+	// htmlTagPattern matches opening and closing tags. The name must be followed by whitespace,
+	// a slash or the end of the tag, which leaves markdown autolinks like <https://…> and
+	// <user@example.com> and comparisons like a<b alone.
+	htmlTagPattern = regexp.MustCompile(`</?([a-zA-Z][a-zA-Z0-9]*)(?:\s[^<>]*)?/?>`)
+
+	// allowedHTMLTags are kept, without their attributes, since markdown renders them as written
+	allowedHTMLTags = map[string]bool{
+		"b": true, "strong": true, "i": true, "em": true, "u": true, "s": true, "del": true,
+		"code": true, "pre": true, "kbd": true, "sub": true, "sup": true, "br": true, "hr": true,
+		"p": true, "blockquote": true, "ul": true, "ol": true, "li": true,
+		"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+		"details": true, "summary": true,
+	}
+)
+
+// sanitizeDescription normalizes line endings to \n and removes HTML outside the allowed tags,
+// dropping scripts, styles and embedded content entirely. Markdown is left as it is.
+func sanitizeDescription(description string) string {
+	description = strings.ReplaceAll(description, "\r\n", "\n")
+	description = strings.ReplaceAll(description, "\r", "\n")
+
+	for _, pattern := range unsafeElementPatterns {
+		description = pattern.ReplaceAllString(description, "")
+	}
+	description = htmlCommentPattern.ReplaceAllString(description, "")
+
+	return htmlTagPattern.ReplaceAllStringFunc(description, func(tag string) string {
+		name := strings.ToLower(htmlTagPattern.FindStringSubmatch(tag)[1])
+		if !allowedHTMLTags[name] {
+			return ""
+		}
+		if strings.HasPrefix(tag, "</") {
+			return "</" + name + ">"
+		}
+		return "<" + name + ">"
+	})
+}
+
+// normalizeDescription sanitizes a description and checks the result against the configured
+// maximum length, so every backend stores the same text and none truncates it. A description
+// left empty by sanitization is rejected, as an empty one would be.
+func normalizeDescription(description string) (string, error) {
+	if description == "" {
+		return "", nil
+	}
+
+	normalized := sanitizeDescription(description)
+	if strings.TrimSpace(normalized) == "" {
+		return "", status.Error(codes.InvalidArgument, "description is empty once disallowed HTML is removed")
+	}
+
+	if err := checkLength("description", normalized, DescriptionMaxLengthFromEnv()); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,159 @@
+package issuessvc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+func TestSanitizeDescription(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "plain markdown", input: "## Steps\n\n1. Open `settings`\n2. Click **Save**", expected: "## Steps\n\n1. Open `settings`\n2. Click **Save**"},
+		{name: "line endings", input: "first\r\nsecond\rthird\n", expected: "first\nsecond\nthird\n"},
+		{name: "script with content", input: "before<script>alert('x')</script>after", expected: "beforeafter"},
+		{name: "script with attributes and upper case", input: "a<SCRIPT type=\"text/javascript\">\nsteal()\n</SCRIPT >b", expected: "ab"},
+		{name: "unclosed script", input: "text<script>alert(1)", expected: "text"},
+		{name: "style and iframe", input: "<style>p{}</style>x<iframe src=\"https://evil.test\"></iframe>", expected: "x"},
+		{name: "comment", input: "a<!-- hidden -->b", expected: "ab"},
+		{name: "disallowed tags keep their text", input: "<div class=\"x\"><a href=\"javascript:alert(1)\">link</a></div>", expected: "link"},
+		{name: "allowed tags lose their attributes", input: "<b onclick=\"alert(1)\">bold</b><br/>", expected: "<b>bold</b><br>"},
+		{name: "image", input: "see <img src=x onerror=alert(1)>", expected: "see "},
+		{name: "autolinks", input: "<https://example.com/a?b=c> and <dev@example.com>", expected: "<https://example.com/a?b=c> and <dev@example.com>"},
+		{name: "comparisons", input: "when a < b and c > d, x<y or x << 2", expected: "when a < b and c > d, x<y or x << 2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, issuessvc.SanitizeDescription(tc.input))
+		})
+	}
+}
+
+func TestDescriptionMaxLengthFromEnv(t *testing.T) {
+	t.Setenv("DESCRIPTION_MAX_LENGTH", "")
+	assert.Equal(t, 500, issuessvc.DescriptionMaxLengthFromEnv())
+
+	t.Setenv("DESCRIPTION_MAX_LENGTH", "2000")
+	assert.Equal(t, 2000, issuessvc.DescriptionMaxLengthFromEnv())
+
+	t.Setenv("DESCRIPTION_MAX_LENGTH", "-1")
+	assert.Equal(t, 500, issuessvc.DescriptionMaxLengthFromEnv())
+}
+
+// newDescriptionServices returns services over both issue repositories, each able to create
+// issues in validProjectID
+func newDescriptionServices(t *testing.T) map[string]*issuessvc.IssuesServiceServer {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	ctrl := gomock.NewController(t)
+	projectService := mocks.NewMockProjectServiceClient(ctrl)
+	projectService.EXPECT().UpdateProjectWithIssue(gomock.Any(), gomock.Any()).Return(
+		&projectPbv1.UpdateProjectWithIssueResponse{}, nil).AnyTimes()
+	project := &projectPbv1.Project{ProjectId: validProjectID, Name: "Project"}
+
+	memProjects, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, memProjects.CreateProject(context.Background(), project))
+	memRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	memRepo.SetClients(issuessvc.NewLocalValidator(memProjects, nil))
+
+	db := newSQLiteDB(t)
+	require.NoError(t, projectsvc.NewPostgresProjectRepository(db).CreateProject(context.Background(), project))
+
+	return map[string]*issuessvc.IssuesServiceServer{
+		"memdb":  issuessvc.NewIssuesService(memRepo, projectService, nil),
+		"sqlite": issuessvc.NewIssuesService(issuessvc.NewPostgresIssuesRepository(db), projectService, nil),
+	}
+}
+
+func TestIssuesServiceServer_DescriptionLengthBoundary(t *testing.T) {
+	t.Setenv("DESCRIPTION_MAX_LENGTH", "600")
+	// Multi-byte characters count once, as in a Postgres text column
+	atLimit := strings.Repeat("é", 600)
+	// Line endings are normalized before the length is checked
+	crlfAtLimit := strings.Repeat("a\r\n", 300)
+
+	for name, service := range newDescriptionServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			created, err := service.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+				Summary: testSummary, Description: proto.String(atLimit), Type: issuesPbv1.Type_BUG,
+				Priority: issuesPbv1.Priority_MINOR, ProjectId: validProjectID,
+			})
+			require.NoError(t, err)
+			stored, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: created.Issue.IssueId})
+			require.NoError(t, err)
+			assert.Equal(t, atLimit, stored.Issue.Description)
+
+			_, err = service.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+				Summary: testSummary, Description: proto.String(atLimit + "é"), Type: issuesPbv1.Type_BUG,
+				Priority: issuesPbv1.Priority_MINOR, ProjectId: validProjectID,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			update := &issuesPbv1.UpdateIssueRequest{
+				IssueId: created.Issue.IssueId, Summary: testSummary, Description: proto.String(crlfAtLimit),
+				Status: issuesPbv1.Status_NEW, Type: issuesPbv1.Type_BUG, Priority: issuesPbv1.Priority_MINOR,
+				Version: stored.Issue.Version,
+			}
+			updated, err := service.UpdateIssue(ctx, update)
+			require.NoError(t, err)
+			stored, err = service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: created.Issue.IssueId})
+			require.NoError(t, err)
+			assert.Equal(t, strings.Repeat("a\n", 300), stored.Issue.Description)
+
+			update.Description = proto.String(crlfAtLimit + "a")
+			update.Version = updated.Issue.Version
+			_, err = service.UpdateIssue(ctx, update)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestIssuesServiceServer_SanitizesDescriptions(t *testing.T) {
+	for name, service := range newDescriptionServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			created, err := service.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+				Summary: testSummary, Description: proto.String("Crash on **save**<script>document.cookie</script>"),
+				Type: issuesPbv1.Type_BUG, Priority: issuesPbv1.Priority_MINOR, ProjectId: validProjectID,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "Crash on **save**", created.Issue.Description)
+
+			stored, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: created.Issue.IssueId})
+			require.NoError(t, err)
+			assert.Equal(t, "Crash on **save**", stored.Issue.Description)
+
+			// Nothing is left of a description that is only a script
+			_, err = service.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+				Summary: testSummary, Description: proto.String("<script>alert(1)</script>"),
+				Type: issuesPbv1.Type_BUG, Priority: issuesPbv1.Priority_MINOR, ProjectId: validProjectID,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package issuessvc_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/memory"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+func TestIssuesServiceServer_GetIssueDetails(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	project := &projectPbv1.Project{ProjectId: validProjectID, Name: "Tracker"}
+	user := &userPbv1.User{UserId: validUserID, FirstName: "Ada", EmailAddress: "ada@example.com"}
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	testCases := []struct {
+		name            string
+		assigneeID      string
+		projectErr      error
+		userErr         error
+		wantProject     bool
+		wantUser        bool
+		wantDetailsErrs []string
+	}{
+		{
+			name:        "Both lookups succeed",
+			assigneeID:  validUserID,
+			wantProject: true,
+			wantUser:    true,
+		},
+		{
+			name:            "User service down",
+			assigneeID:      validUserID,
+			userErr:         unavailable,
+			wantProject:     true,
+			wantDetailsErrs: []string{"assignee lookup failed: rpc error: code = Unavailable desc = connection refused"},
+		},
+		{
+			name:       "Both services down",
+			assigneeID: validUserID,
+			projectErr: unavailable,
+			userErr:    unavailable,
+			wantDetailsErrs: []string{
+				"project lookup failed: rpc error: code = Unavailable desc = connection refused",
+				"assignee lookup failed: rpc error: code = Unavailable desc = connection refused",
+			},
+		},
+		{
+			name:        "Unassigned issue skips the user lookup",
+			wantProject: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mocks.NewMockIssuesRepository(ctrl)
+			mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+			mockUserService := mocks.NewMockUserServiceClient(ctrl)
+
+			mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+				IssueId:    validIssueID,
+				Summary:    testSummary,
+				ProjectId:  validProjectID,
+				AssigneeId: tc.assigneeID,
+			}, nil)
+			if tc.projectErr != nil {
+				mockProjectService.EXPECT().GetProject(gomock.Any(), gomock.Any()).Return(nil, tc.projectErr)
+			} else {
+				mockProjectService.EXPECT().GetProject(gomock.Any(), gomock.Any()).Return(&projectPbv1.GetProjectResponse{Project: project}, nil)
+			}
+			// Without an assignee BatchGetUsers isn't expected, so calling it fails the test
+			if tc.assigneeID != "" {
+				batchGet := mockUserService.EXPECT().BatchGetUsers(gomock.Any(), &userPbv1.BatchGetUsersRequest{UserIds: []string{tc.assigneeID}})
+				if tc.userErr != nil {
+					batchGet.Return(nil, tc.userErr)
+				} else {
+					batchGet.Return(&userPbv1.BatchGetUsersResponse{Users: []*userPbv1.User{user}}, nil)
+				}
+			}
+
+			service := issuessvc.NewIssuesService(mockRepo, mockProjectService, mockUserService)
+			resp, err := service.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: validIssueID, IncludeDetails: true})
+			require.NoError(t, err, "failed lookups don't fail the call")
+			assert.Equal(t, validIssueID, resp.Issue.IssueId)
+			assert.Equal(t, tc.wantDetailsErrs, resp.DetailsErrors)
+
+			if tc.wantProject {
+				require.NotNil(t, resp.ProjectInfo)
+				assert.Equal(t, "Tracker", resp.ProjectInfo.Name)
+			} else {
+				assert.Nil(t, resp.ProjectInfo)
+			}
+			if tc.wantUser {
+				require.NotNil(t, resp.UserInfo)
+				assert.Equal(t, validUserID, resp.UserInfo.UserId)
+			} else {
+				assert.Nil(t, resp.UserInfo)
+			}
+		})
+	}
+}
+
+func TestIssuesServiceServer_GetIssueDetailsDropsChangedProject(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+	mb := memory.NewInMemoryBroker()
+	t.Cleanup(func() { _ = mb.Close() })
+
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+		IssueId: validIssueID, Summary: testSummary, ProjectId: validProjectID,
+	}, nil).AnyTimes()
+	var lookups atomic.Int32
+	mockProjectService.EXPECT().GetProject(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *projectPbv1.GetProjectRequest, ...grpc.CallOption) (*projectPbv1.GetProjectResponse, error) {
+			name := "Tracker"
+			if lookups.Add(1) > 1 {
+				name = "Tracker 2"
+			}
+			return &projectPbv1.GetProjectResponse{Project: &projectPbv1.Project{ProjectId: validProjectID, Name: name}}, nil
+		}).AnyTimes()
+
+	service := issuessvc.NewIssuesService(mockRepo, mockProjectService, nil)
+	service.SetMessageBroker(mb)
+	projectName := func() string {
+		resp, err := service.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: validIssueID, IncludeDetails: true})
+		require.NoError(t, err)
+		require.NotNil(t, resp.ProjectInfo)
+		return resp.ProjectInfo.Name
+	}
+
+	// The project is looked up once and then cached
+	assert.Equal(t, "Tracker", projectName())
+	assert.Equal(t, "Tracker", projectName())
+	assert.Equal(t, int32(1), lookups.Load())
+
+	// Other updates of the project don't drop it
+	require.NoError(t, mb.PublishUpdate(context.Background(), validProjectID, &projectPbv1.ProjectUpdateResponse{
+		ProjectId: validProjectID, UpdateType: projectPbv1.UpdateType_ISSUE_ADDED,
+	}))
+	assert.Equal(t, "Tracker", projectName())
+
+	// The project service publishes PROJECT_CHANGED when the project is renamed
+	require.NoError(t, mb.PublishUpdate(context.Background(), validProjectID, &projectPbv1.ProjectUpdateResponse{
+		ProjectId: validProjectID, UpdateType: projectPbv1.UpdateType_PROJECT_CHANGED,
+	}))
+	assert.Eventually(t, func() bool { return projectName() == "Tracker 2" }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(2), lookups.Load())
+}
+
+// contextBroker is an in-memory broker that, like the Kafka broker, ends a subscription once the
+// context it was made with is cancelled. It records the subscriptions made on it.
+type contextBroker struct {
+	broker.MessageBroker
+
+	mu   sync.Mutex
+	subs []*broker.Subscription
+}
+
+func newContextBroker(t *testing.T) *contextBroker {
+	b := &contextBroker{MessageBroker: memory.NewInMemoryBroker()}
+	t.Cleanup(func() { _ = b.Close() })
+	return b
+}
+
+func (b *contextBroker) Subscribe(ctx context.Context, projectID string, updateTypes ...projectPbv1.UpdateType) (*broker.Subscription, error) {
+	sub, err := b.MessageBroker.Subscribe(ctx, projectID, updateTypes...)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	b.subs = append(b.subs, sub)
+	b.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		_ = b.Unsubscribe(context.Background(), sub)
+	}()
+	return sub, nil
+}
+
+// subscriptions returns how many subscriptions were made for projectID and how many of them are still open
+func (b *contextBroker) subscriptions(projectID string) (made, open int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, sub := range b.subs {
+		if sub.ProjectID != projectID {
+			continue
+		}
+		made++
+		select {
+		case _, ok := <-sub.Updates:
+			if ok {
+				open++
+			}
+		default:
+			open++
+		}
+	}
+	return made, open
+}
+
+func TestIssuesServiceServer_GetIssueDetailsOutlivesRequestContext(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+	mb := newContextBroker(t)
+
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issueID string) (*issuesPbv1.Issue, error) {
+		return &issuesPbv1.Issue{IssueId: issueID, Summary: testSummary, ProjectId: issueID}, nil
+	}).AnyTimes()
+	lookups := make(map[string]int)
+	var lookupsMu sync.Mutex
+	mockProjectService.EXPECT().GetProject(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *projectPbv1.GetProjectRequest, _ ...grpc.CallOption) (*projectPbv1.GetProjectResponse, error) {
+			lookupsMu.Lock()
+			lookups[req.ProjectId]++
+			lookupsMu.Unlock()
+			return &projectPbv1.GetProjectResponse{Project: &projectPbv1.Project{ProjectId: req.ProjectId, Name: "Tracker"}}, nil
+		}).AnyTimes()
+	lookupsOf := func(projectID string) int {
+		lookupsMu.Lock()
+		defer lookupsMu.Unlock()
+		return lookups[projectID]
+	}
+
+	service := issuessvc.NewIssuesService(mockRepo, mockProjectService, nil)
+	service.SetMessageBroker(mb)
+	service.SetProjectDetailsCacheSize(1)
+	getIssue := func(projectID string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		resp, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: projectID, IncludeDetails: true})
+		require.NoError(t, err)
+		require.NotNil(t, resp.ProjectInfo)
+	}
+
+	// The subscription isn't ended by the end of the GetIssue call that made it, so the project stays cached
+	getIssue(validProjectID)
+	assert.Never(t, func() bool {
+		getIssue(validProjectID)
+		return lookupsOf(validProjectID) > 1
+	}, 100*time.Millisecond, 10*time.Millisecond)
+	made, open := mb.subscriptions(validProjectID)
+	assert.Equal(t, 1, made)
+	assert.Equal(t, 1, open)
+
+	// Caching another project drops the least recently used one and ends its subscription
+	getIssue(validIssueID)
+	assert.Eventually(t, func() bool {
+		_, open := mb.subscriptions(validProjectID)
+		return open == 0
+	}, time.Second, 10*time.Millisecond)
+	getIssue(validProjectID)
+	assert.Equal(t, 2, lookupsOf(validProjectID))
+
+	// Closing the service ends the subscriptions that are left
+	require.NoError(t, service.Close())
+	assert.Eventually(t, func() bool {
+		_, openProject := mb.subscriptions(validProjectID)
+		_, openIssue := mb.subscriptions(validIssueID)
+		return openProject == 0 && openIssue == 0
+	}, time.Second, 10*time.Millisecond)
+}
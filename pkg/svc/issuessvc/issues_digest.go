@@ -0,0 +1,140 @@
+package issuessvc
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+const (
+	// digestWindow is the period a digest covers when no start is given
+	digestWindow = 24 * time.Hour
+	// defaultDigestOverdueDays is used when DIGEST_OVERDUE_DAYS is not set
+	defaultDigestOverdueDays = 7
+)
+
+// ProjectActivityWindow selects the issue activity counted in a project digest
+type ProjectActivityWindow struct {
+	ProjectID string
+	// From and To bound the window; From is inclusive and To is exclusive
+	From time.Time
+	To   time.Time
+	// OverdueBefore is the create date before which an issue that is still open counts as overdue
+	OverdueBefore time.Time
+}
+
+// ProjectActivity counts a project's issue activity within a window. Issues have no status
+// history, so resolutions are dated by the resolve date and closings by the last modification.
+type ProjectActivity struct {
+	Created  int32
+	Resolved int32
+	Closed   int32
+	Overdue  int32
+}
+
+// DigestOverdueDaysFromEnv reads the age in days after which an open issue counts as overdue
+// from DIGEST_OVERDUE_DAYS
+func DigestOverdueDaysFromEnv() int {
+	if v, err := strconv.Atoi(os.Getenv("DIGEST_OVERDUE_DAYS")); err == nil && v > 0 {
+		return v
+	}
+	return defaultDigestOverdueDays
+}
+
+// SetClock overrides the time source used to end digest windows, which is useful in tests
+func (s *IssuesServiceServer) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// GenerateProjectDigest summarizes the issues of a project created, resolved and closed since
+// the given time, along with the issues that are still overdue
+func (s *IssuesServiceServer) GenerateProjectDigest(ctx context.Context, req *issuesPbv1.GenerateProjectDigestRequest) (*issuesPbv1.GenerateProjectDigestResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	to := s.now()
+	from := to.Add(-digestWindow)
+	if req.Since != nil {
+		from = req.Since.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "since must be in the past")
+	}
+
+	if err := s.repository.ValidateProjectExists(ctx, req.ProjectId); err != nil {
+		if isDependencyUnavailable(err) {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
+	}
+
+	digest, err := s.projectDigest(ctx, req.ProjectId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &issuesPbv1.GenerateProjectDigestResponse{Digest: digest}, nil
+}
+
+// projectDigest counts the activity of a project between from (inclusive) and to (exclusive)
+func (s *IssuesServiceServer) projectDigest(ctx context.Context, projectID string, from, to time.Time) (*issuesPbv1.ProjectDigest, error) {
+	overdueDays := DigestOverdueDaysFromEnv()
+	activity, err := s.repository.ProjectActivity(ctx, ProjectActivityWindow{
+		ProjectID:     projectID,
+		From:          from,
+		To:            to,
+		OverdueBefore: to.AddDate(0, 0, -overdueDays),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count project activity: %v", err)
+	}
+
+	return &issuesPbv1.ProjectDigest{
+		ProjectId:     projectID,
+		WindowStart:   timestamppb.New(from),
+		WindowEnd:     timestamppb.New(to),
+		CreatedCount:  activity.Created,
+		ResolvedCount: activity.Resolved,
+		ClosedCount:   activity.Closed,
+		OverdueCount:  activity.Overdue,
+		OverdueDays:   int32(overdueDays),
+	}, nil
+}
+
+// count adds an issue's activity within the window; issues from other projects are ignored
+func (w ProjectActivityWindow) count(activity *ProjectActivity, issue *issuesPbv1.Issue) {
+	if issue.ProjectId != w.ProjectID {
+		return
+	}
+
+	if w.contains(issue.CreateDate) {
+		activity.Created++
+	}
+	if w.contains(issue.ResolveDate) {
+		activity.Resolved++
+	}
+	if issue.Status == issuesPbv1.Status_CLOSED && w.contains(issue.ModifyDate) {
+		activity.Closed++
+	}
+	if issue.Status != issuesPbv1.Status_RESOLVED && issue.Status != issuesPbv1.Status_CLOSED &&
+		issue.CreateDate != nil && issue.CreateDate.AsTime().Before(w.OverdueBefore) {
+		activity.Overdue++
+	}
+}
+
+// contains reports whether a timestamp falls within the window
+func (w ProjectActivityWindow) contains(ts *timestamppb.Timestamp) bool {
+	if ts == nil {
+		return false
+	}
+	t := ts.AsTime()
+	return !t.Before(w.From) && t.Before(w.To)
+}
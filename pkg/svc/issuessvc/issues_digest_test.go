@@ -0,0 +1,249 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/models"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const thirdProjectID = "3c5e7a9b-1d2f-4b6a-8c0e-2f4a6b8d0e13"
+
+// digestEnd is the end of the digest window the fixture issues are placed around
+var digestEnd = time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)
+
+// digestIssues returns issues around the 24 hours before digestEnd
+func digestIssues() []*issuesPbv1.Issue {
+	at := func(d time.Duration) *timestamppb.Timestamp { return timestamppb.New(digestEnd.Add(d)) }
+	return []*issuesPbv1.Issue{
+		// Created exactly at the start of the window
+		{IssueId: "00000000-0000-4000-8000-000000000001", ProjectId: validProjectID, Status: issuesPbv1.Status_NEW, CreateDate: at(-24 * time.Hour), ModifyDate: at(-24 * time.Hour)},
+		// Created exactly at the end of the window, so it belongs to the next one
+		{IssueId: "00000000-0000-4000-8000-000000000002", ProjectId: validProjectID, Status: issuesPbv1.Status_NEW, CreateDate: at(0), ModifyDate: at(0)},
+		// Resolved in the window
+		{IssueId: "00000000-0000-4000-8000-000000000003", ProjectId: validProjectID, Status: issuesPbv1.Status_RESOLVED, CreateDate: at(-48 * time.Hour), ResolveDate: at(-time.Hour), ModifyDate: at(-time.Hour)},
+		// Resolved before the window and closed in it
+		{IssueId: "00000000-0000-4000-8000-000000000004", ProjectId: validProjectID, Status: issuesPbv1.Status_CLOSED, CreateDate: at(-48 * time.Hour), ResolveDate: at(-30 * time.Hour), ModifyDate: at(-2 * time.Hour)},
+		// Open for ten days
+		{IssueId: "00000000-0000-4000-8000-000000000005", ProjectId: validProjectID, Status: issuesPbv1.Status_IN_PROGRESS, CreateDate: at(-240 * time.Hour), ModifyDate: at(-240 * time.Hour)},
+		// Another project's issue
+		{IssueId: "00000000-0000-4000-8000-000000000006", ProjectId: targetProjectID, Status: issuesPbv1.Status_NEW, CreateDate: at(-time.Hour), ModifyDate: at(-time.Hour)},
+	}
+}
+
+// digestWindow returns the window of the digest ending at end
+func digestWindow(end time.Time) issuessvc.ProjectActivityWindow {
+	return issuessvc.ProjectActivityWindow{
+		ProjectID:     validProjectID,
+		From:          end.Add(-24 * time.Hour),
+		To:            end,
+		OverdueBefore: end.AddDate(0, 0, -7),
+	}
+}
+
+// createSQLiteDigestIssues stores the digest issues with their dates, which CreateIssue leaves to the database
+func createSQLiteDigestIssues(t *testing.T, db *gorm.DB, repo issuessvc.IssuesRepository) {
+	t.Helper()
+	for _, issue := range digestIssues() {
+		require.NoError(t, repo.CreateIssue(context.Background(), issue))
+		dates := map[string]any{
+			"create_date": issue.CreateDate.AsTime(),
+			"modify_date": issue.ModifyDate.AsTime(),
+		}
+		if issue.ResolveDate != nil {
+			dates["resolve_date"] = issue.ResolveDate.AsTime()
+		}
+		require.NoError(t, db.Model(&models.Issues{}).Where("issue_id = ?", issue.IssueId).UpdateColumns(dates).Error)
+	}
+}
+
+func TestProjectActivityRepositories(t *testing.T) {
+	repos := map[string]func(t *testing.T) issuessvc.IssuesRepository{
+		"memdb": func(t *testing.T) issuessvc.IssuesRepository {
+			repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+			require.NoError(t, err)
+			for _, issue := range digestIssues() {
+				require.NoError(t, repo.CreateIssue(context.Background(), issue))
+			}
+			return repo
+		},
+		"sqlite": func(t *testing.T) issuessvc.IssuesRepository {
+			db := newSQLiteDB(t)
+			repo := issuessvc.NewPostgresIssuesRepository(db)
+			createSQLiteDigestIssues(t, db, repo)
+			return repo
+		},
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+
+			activity, err := repo.ProjectActivity(context.Background(), digestWindow(digestEnd))
+			require.NoError(t, err)
+			assert.Equal(t, &issuessvc.ProjectActivity{Created: 1, Resolved: 1, Closed: 1, Overdue: 1}, activity)
+
+			// The next day's window picks up the issue created at the boundary and nothing else
+			activity, err = repo.ProjectActivity(context.Background(), digestWindow(digestEnd.Add(24*time.Hour)))
+			require.NoError(t, err)
+			assert.Equal(t, &issuessvc.ProjectActivity{Created: 1, Overdue: 1}, activity)
+		})
+	}
+}
+
+// newDigestFixture returns a service with a frozen clock at digestEnd, over the digest issues
+// and a project repository holding three projects
+func newDigestFixture(t *testing.T) (*issuessvc.IssuesServiceServer, *projectsvc.MemDBProjectRepository) {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	projects, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	for _, projectID := range []string{validProjectID, targetProjectID, thirdProjectID} {
+		require.NoError(t, projects.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: projectID, Name: "Project " + projectID[:4]}))
+	}
+
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	repo.SetClients(issuessvc.NewLocalValidator(projects, nil))
+	for _, issue := range digestIssues() {
+		require.NoError(t, repo.CreateIssue(context.Background(), issue))
+	}
+
+	service := issuessvc.NewIssuesService(repo, nil, nil)
+	service.SetClock(func() time.Time { return digestEnd })
+	return service, projects
+}
+
+func TestIssuesServiceServer_GenerateProjectDigest(t *testing.T) {
+	service, _ := newDigestFixture(t)
+
+	resp, err := service.GenerateProjectDigest(context.Background(), &issuesPbv1.GenerateProjectDigestRequest{ProjectId: validProjectID})
+	require.NoError(t, err)
+	digest := resp.Digest
+	assert.Equal(t, validProjectID, digest.ProjectId)
+	assert.Equal(t, digestEnd.Add(-24*time.Hour), digest.WindowStart.AsTime())
+	assert.Equal(t, digestEnd, digest.WindowEnd.AsTime())
+	assert.Equal(t, int32(1), digest.CreatedCount)
+	assert.Equal(t, int32(1), digest.ResolvedCount)
+	assert.Equal(t, int32(1), digest.ClosedCount)
+	assert.Equal(t, int32(1), digest.OverdueCount)
+	assert.Equal(t, int32(7), digest.OverdueDays)
+
+	// A longer window and a shorter overdue threshold
+	t.Setenv("DIGEST_OVERDUE_DAYS", "1")
+	resp, err = service.GenerateProjectDigest(context.Background(), &issuesPbv1.GenerateProjectDigestRequest{
+		ProjectId: validProjectID,
+		Since:     timestamppb.New(digestEnd.Add(-72 * time.Hour)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Digest.CreatedCount)
+	assert.Equal(t, int32(2), resp.Digest.ResolvedCount)
+	// The issue opened exactly a day ago is not yet overdue
+	assert.Equal(t, int32(1), resp.Digest.OverdueCount)
+	assert.Equal(t, int32(1), resp.Digest.OverdueDays)
+}
+
+func TestIssuesServiceServer_GenerateProjectDigestErrors(t *testing.T) {
+	service, _ := newDigestFixture(t)
+
+	testCases := []struct {
+		name         string
+		req          *issuesPbv1.GenerateProjectDigestRequest
+		expectedCode codes.Code
+	}{
+		{name: "invalid project ID", req: &issuesPbv1.GenerateProjectDigestRequest{ProjectId: "not-a-uuid"}, expectedCode: codes.InvalidArgument},
+		{name: "since in the future", req: &issuesPbv1.GenerateProjectDigestRequest{ProjectId: validProjectID, Since: timestamppb.New(digestEnd)}, expectedCode: codes.InvalidArgument},
+		{name: "unknown project", req: &issuesPbv1.GenerateProjectDigestRequest{ProjectId: validIssueID}, expectedCode: codes.NotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.GenerateProjectDigest(context.Background(), tc.req)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
+
+// digestRecorder is a broker that records the digests published on it
+type digestRecorder struct {
+	broker.MessageBroker
+	digests []*issuesPbv1.ProjectDigest
+}
+
+func (r *digestRecorder) PublishDigest(_ context.Context, digest *issuesPbv1.ProjectDigest) error {
+	r.digests = append(r.digests, digest)
+	return nil
+}
+
+func TestDigestScheduler_ConsecutiveRunsCountBoundaryIssueOnce(t *testing.T) {
+	service, projects := newDigestFixture(t)
+	recorder := &digestRecorder{}
+	scheduler := issuessvc.NewDigestScheduler(service, projects, recorder, issuessvc.DigestConfig{Enabled: true, Hour: 8})
+	// Page through the three projects two at a time
+	scheduler.SetDigestPageSize(2)
+
+	require.NoError(t, scheduler.GenerateAll(context.Background(), digestEnd))
+	require.NoError(t, scheduler.GenerateAll(context.Background(), digestEnd.Add(24*time.Hour)))
+
+	require.Len(t, recorder.digests, 6)
+	created := map[string]int32{}
+	for _, digest := range recorder.digests {
+		created[digest.ProjectId] += digest.CreatedCount
+	}
+	// The issue created exactly 24 hours before the first run and the one created at it
+	assert.Equal(t, map[string]int32{validProjectID: 2, targetProjectID: 1, thirdProjectID: 0}, created)
+
+	assert.Equal(t, digestEnd, recorder.digests[0].WindowEnd.AsTime())
+	assert.Equal(t, digestEnd, recorder.digests[3].WindowStart.AsTime())
+}
+
+func TestDigestScheduler_GenerateAllStopsWhenCancelled(t *testing.T) {
+	service, projects := newDigestFixture(t)
+	recorder := &digestRecorder{}
+	scheduler := issuessvc.NewDigestScheduler(service, projects, recorder, issuessvc.DigestConfig{Enabled: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, scheduler.GenerateAll(ctx, digestEnd), context.Canceled)
+	assert.Empty(t, recorder.digests)
+
+	// Stopping a scheduler waiting for its next run returns right away
+	scheduler.Start()
+	scheduler.Stop()
+}
+
+func TestNextDigestRun(t *testing.T) {
+	testCases := []struct {
+		name     string
+		now      time.Time
+		expected time.Time
+	}{
+		{name: "later today", now: time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC), expected: time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)},
+		{name: "exactly at the hour", now: time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC), expected: time.Date(2024, 3, 11, 8, 0, 0, 0, time.UTC)},
+		{name: "tomorrow", now: time.Date(2024, 3, 10, 21, 0, 0, 0, time.UTC), expected: time.Date(2024, 3, 11, 8, 0, 0, 0, time.UTC)},
+		{name: "other time zone", now: time.Date(2024, 3, 10, 9, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)), expected: time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, issuessvc.NextDigestRun(tc.now, 8))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+func TestIssuesServiceServer_DueDate(t *testing.T) {
+	for name, service := range newMemberServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			due := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+			created, err := service.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+				Summary:   testSummary,
+				Type:      issuesPbv1.Type_BUG,
+				Priority:  issuesPbv1.Priority_MINOR,
+				ProjectId: validProjectID,
+				DueDate:   timestamppb.New(due),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, due, created.Issue.DueDate.AsTime())
+
+			update := &issuesPbv1.UpdateIssueRequest{
+				IssueId:  created.Issue.IssueId,
+				Summary:  created.Issue.Summary,
+				Type:     created.Issue.Type,
+				Priority: created.Issue.Priority,
+				Status:   created.Issue.Status,
+				Version:  created.Issue.Version,
+			}
+			updated, err := service.UpdateIssue(ctx, update)
+			require.NoError(t, err)
+			assert.Equal(t, due, updated.Issue.DueDate.AsTime(), "an unset due date keeps the current one")
+
+			later := due.Add(48 * time.Hour)
+			update.DueDate = timestamppb.New(later)
+			update.Version = updated.Issue.Version
+			updated, err = service.UpdateIssue(ctx, update)
+			require.NoError(t, err)
+			assert.Equal(t, later, updated.Issue.DueDate.AsTime())
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package issuessvc
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Used when SUMMARY_MAX_LENGTH or DESCRIPTION_MAX_LENGTH is not set
+const (
+	defaultSummaryMaxLength     = 100
+	defaultDescriptionMaxLength = 500
+)
+
+// FieldLimits holds the longest issue summary and description, in characters, the service
+// accepts. The limits are checked before issues reach a repository, and the database columns
+// don't limit either field, so a deployment can raise them without a migration.
+type FieldLimits struct {
+	SummaryMaxLength     int
+	DescriptionMaxLength int
+}
+
+// FieldLimitsFromEnv reads the field limits from SUMMARY_MAX_LENGTH and DESCRIPTION_MAX_LENGTH,
+// keeping the defaults of 100 and 500 for unset or non-positive values
+func FieldLimitsFromEnv() FieldLimits {
+	return FieldLimits{
+		SummaryMaxLength:     SummaryMaxLengthFromEnv(),
+		DescriptionMaxLength: DescriptionMaxLengthFromEnv(),
+	}
+}
+
+// SummaryMaxLengthFromEnv reads the maximum length of an issue summary, in characters,
+// from SUMMARY_MAX_LENGTH
+func SummaryMaxLengthFromEnv() int {
+	if v, err := strconv.Atoi(os.Getenv("SUMMARY_MAX_LENGTH")); err == nil && v > 0 {
+		return v
+	}
+	return defaultSummaryMaxLength
+}
+
+// DescriptionMaxLengthFromEnv reads the maximum length of an issue description, in characters,
+// from DESCRIPTION_MAX_LENGTH
+func DescriptionMaxLengthFromEnv() int {
+	if v, err := strconv.Atoi(os.Getenv("DESCRIPTION_MAX_LENGTH")); err == nil && v > 0 {
+		return v
+	}
+	return defaultDescriptionMaxLength
+}
+
+// checkSummary checks a summary against the configured maximum length
+func checkSummary(summary string) error {
+	return checkLength("summary", summary, SummaryMaxLengthFromEnv())
+}
+
+// checkLength returns an InvalidArgument error naming field when value is longer than
+// maxLength characters. Like request validation errors, it carries the field as a BadRequest.
+func checkLength(field, value string, maxLength int) error {
+	length := utf8.RuneCountInString(value)
+	if length <= maxLength {
+		return nil
+	}
+
+	message := fmt.Sprintf("%s is %d characters long; the maximum is %d", field, length, maxLength)
+	st := status.New(codes.InvalidArgument, message)
+	if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: message, Reason: "MAX_LENGTH"},
+	}}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
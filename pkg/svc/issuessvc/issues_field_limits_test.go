@@ -0,0 +1,72 @@
+package issuessvc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+func TestFieldLimitsFromEnv(t *testing.T) {
+	t.Setenv("SUMMARY_MAX_LENGTH", "")
+	t.Setenv("DESCRIPTION_MAX_LENGTH", "")
+	assert.Equal(t, issuessvc.FieldLimits{SummaryMaxLength: 100, DescriptionMaxLength: 500}, issuessvc.FieldLimitsFromEnv())
+
+	t.Setenv("SUMMARY_MAX_LENGTH", "250")
+	t.Setenv("DESCRIPTION_MAX_LENGTH", "5000")
+	assert.Equal(t, issuessvc.FieldLimits{SummaryMaxLength: 250, DescriptionMaxLength: 5000}, issuessvc.FieldLimitsFromEnv())
+
+	t.Setenv("SUMMARY_MAX_LENGTH", "0")
+	assert.Equal(t, 100, issuessvc.SummaryMaxLengthFromEnv())
+}
+
+func TestIssuesServiceServer_SummaryLengthLimit(t *testing.T) {
+	longSummary := strings.Repeat("é", 250)
+
+	for name, service := range newDescriptionServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			create := &issuesPbv1.CreateIssueRequest{
+				Summary: longSummary, Type: issuesPbv1.Type_BUG, Priority: issuesPbv1.Priority_MINOR, ProjectId: validProjectID,
+			}
+
+			t.Setenv("SUMMARY_MAX_LENGTH", "")
+			_, err := service.CreateIssue(ctx, create)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			st := status.Convert(err)
+			assert.Equal(t, "summary is 250 characters long; the maximum is 100", st.Message())
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Len(t, badRequest.FieldViolations, 1)
+			assert.Equal(t, "summary", badRequest.FieldViolations[0].Field)
+			assert.Equal(t, "MAX_LENGTH", badRequest.FieldViolations[0].Reason)
+
+			resp, err := service.ValidateIssue(ctx, &issuesPbv1.ValidateIssueRequest{Payload: &issuesPbv1.ValidateIssueRequest_Create{Create: create}})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"summary": ""}, problemFields(resp))
+
+			// Raising the limit needs no migration: the summary column isn't limited
+			t.Setenv("SUMMARY_MAX_LENGTH", "250")
+			created, err := service.CreateIssue(ctx, create)
+			require.NoError(t, err)
+			stored, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: created.Issue.IssueId})
+			require.NoError(t, err)
+			assert.Equal(t, longSummary, stored.Issue.Summary)
+
+			_, err = service.UpdateIssue(ctx, &issuesPbv1.UpdateIssueRequest{
+				IssueId: created.Issue.IssueId, Summary: longSummary + "é", Status: issuesPbv1.Status_NEW,
+				Type: issuesPbv1.Type_BUG, Priority: issuesPbv1.Priority_MINOR, Version: stored.Issue.Version,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
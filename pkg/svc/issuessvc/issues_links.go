@@ -0,0 +1,186 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// LinkIssues creates a typed link between two issues. BLOCKED_BY links are stored as the
+// equivalent BLOCKS link, so each relation is stored once whichever side it was created from.
+func (s *IssuesServiceServer) LinkIssues(ctx context.Context, req *issuesPbv1.LinkIssuesRequest) (*issuesPbv1.LinkIssuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if req.SourceIssueId == req.TargetIssueId {
+		return nil, status.Error(codes.InvalidArgument, "an issue cannot be linked to itself")
+	}
+
+	for _, issueID := range []string{req.SourceIssueId, req.TargetIssueId} {
+		if _, err := s.repository.ReadIssue(ctx, issueID); err != nil {
+			return nil, linkedIssueError(issueID, err)
+		}
+	}
+
+	link := normalizeIssueLink(&issuesPbv1.IssueLink{
+		LinkId:        uuid.NewString(),
+		SourceIssueId: req.SourceIssueId,
+		TargetIssueId: req.TargetIssueId,
+		Type:          req.Type,
+		CreateDate:    timestamppb.Now(),
+	})
+
+	existing, err := s.repository.ListIssueLinks(ctx, link.SourceIssueId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issue links: %v", err)
+	}
+	for _, other := range existing {
+		if sameIssueLink(other, link) {
+			return nil, status.Errorf(codes.AlreadyExists, "issues are already linked by link %s", other.LinkId)
+		}
+	}
+
+	if err := s.repository.CreateIssueLink(ctx, link); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create issue link: %v", err)
+	}
+
+	rendered := renderIssueLink(link, req.SourceIssueId)
+	return &issuesPbv1.LinkIssuesResponse{
+		Message: fmt.Sprintf("Issue %s %s issue %s", rendered.SourceIssueId, rendered.Type, rendered.TargetIssueId),
+		Link:    rendered,
+	}, nil
+}
+
+// UnlinkIssues removes a link between two issues
+func (s *IssuesServiceServer) UnlinkIssues(ctx context.Context, req *issuesPbv1.UnlinkIssuesRequest) (*issuesPbv1.UnlinkIssuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	link, err := s.repository.DeleteIssueLink(ctx, req.LinkId)
+	if err != nil {
+		if errors.Is(err, consts.ErrIssueLinkNotFound) {
+			return nil, status.Error(codes.NotFound, "issue link not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete issue link: %v", err)
+	}
+
+	return &issuesPbv1.UnlinkIssuesResponse{
+		Message: fmt.Sprintf("Link %s removed", link.LinkId),
+		Link:    link,
+	}, nil
+}
+
+// ListIssueLinks returns the links from and to an issue, each rendered from the issue's side
+func (s *IssuesServiceServer) ListIssueLinks(ctx context.Context, req *issuesPbv1.ListIssueLinksRequest) (*issuesPbv1.ListIssueLinksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	if _, err := s.repository.ReadIssue(ctx, req.IssueId); err != nil {
+		return nil, linkedIssueError(req.IssueId, err)
+	}
+
+	links, err := s.repository.ListIssueLinks(ctx, req.IssueId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issue links: %v", err)
+	}
+
+	rendered := make([]*issuesPbv1.IssueLink, len(links))
+	for i, link := range links {
+		rendered[i] = renderIssueLink(link, req.IssueId)
+	}
+	return &issuesPbv1.ListIssueLinksResponse{Links: rendered}, nil
+}
+
+// checkOpenBlockers rejects resolving or closing an issue while an issue that blocks it is still open
+func (s *IssuesServiceServer) checkOpenBlockers(ctx context.Context, issueID string) error {
+	links, err := s.repository.ListIssueLinks(ctx, issueID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list issue links: %v", err)
+	}
+
+	var blockers []string
+	for _, link := range links {
+		if link.Type != issuesPbv1.LinkType_BLOCKS || link.TargetIssueId != issueID {
+			continue
+		}
+		blocker, err := s.repository.ReadIssue(ctx, link.SourceIssueId)
+		if err != nil {
+			if errors.Is(err, consts.ErrIssueNotFound) || status.Code(err) == codes.NotFound {
+				// A deleted issue no longer blocks anything
+				continue
+			}
+			return status.Errorf(codes.Internal, "failed to look up blocking issue: %v", err)
+		}
+		if blocker.Status != issuesPbv1.Status_RESOLVED && blocker.Status != issuesPbv1.Status_CLOSED {
+			blockers = append(blockers, blocker.IssueId)
+		}
+	}
+
+	if len(blockers) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "issue is blocked by open issues: %s", strings.Join(blockers, ", "))
+	}
+	return nil
+}
+
+// normalizeIssueLink stores BLOCKED_BY links as the inverse BLOCKS link
+func normalizeIssueLink(link *issuesPbv1.IssueLink) *issuesPbv1.IssueLink {
+	if link.Type == issuesPbv1.LinkType_BLOCKED_BY {
+		link.SourceIssueId, link.TargetIssueId = link.TargetIssueId, link.SourceIssueId
+		link.Type = issuesPbv1.LinkType_BLOCKS
+	}
+	return link
+}
+
+// sameIssueLink reports whether two stored links express the same relation.
+// RELATES_TO is symmetric, so it matches in either direction.
+func sameIssueLink(a, b *issuesPbv1.IssueLink) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	if a.SourceIssueId == b.SourceIssueId && a.TargetIssueId == b.TargetIssueId {
+		return true
+	}
+	return a.Type == issuesPbv1.LinkType_RELATES_TO &&
+		a.SourceIssueId == b.TargetIssueId && a.TargetIssueId == b.SourceIssueId
+}
+
+// renderIssueLink returns a stored link as seen from the given issue, which becomes the
+// source of the link. DUPLICATES has no inverse type, so links duplicating the issue are
+// returned as stored.
+func renderIssueLink(link *issuesPbv1.IssueLink, issueID string) *issuesPbv1.IssueLink {
+	rendered := proto.Clone(link).(*issuesPbv1.IssueLink)
+	if link.SourceIssueId == issueID {
+		return rendered
+	}
+
+	switch link.Type {
+	case issuesPbv1.LinkType_BLOCKS:
+		rendered.Type = issuesPbv1.LinkType_BLOCKED_BY
+	case issuesPbv1.LinkType_RELATES_TO:
+	default:
+		return rendered
+	}
+	rendered.SourceIssueId, rendered.TargetIssueId = link.TargetIssueId, link.SourceIssueId
+	return rendered
+}
+
+// linkedIssueError converts a failed lookup of a linked issue into a gRPC status error
+func linkedIssueError(issueID string, err error) error {
+	if errors.Is(err, consts.ErrIssueNotFound) || status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.NotFound, "issue %s not found", issueID)
+	}
+	return status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
+}
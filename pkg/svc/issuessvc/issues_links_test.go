@@ -0,0 +1,273 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+const (
+	blockerIssueID = "6d1f3a8e-2b4c-4e9a-b7d5-8c0e2f4a6b13"
+	linkID         = "9a7c5e3b-1d2f-4a6b-8c0e-4f2a6d8b0c35"
+)
+
+// newLinkFixture returns a service over a MemDB repository holding an in-progress issue with
+// validIssueID and a new issue with blockerIssueID
+func newLinkFixture(t *testing.T) (*issuessvc.IssuesServiceServer, *issuessvc.MemDBIssuesRepository) {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+		IssueId:     validIssueID,
+		Summary:     testSummary,
+		Description: testDescription,
+		Status:      issuesPbv1.Status_IN_PROGRESS,
+		Type:        issuesPbv1.Type_BUG,
+		Priority:    issuesPbv1.Priority_MAJOR,
+		ProjectId:   validProjectID,
+		AssigneeId:  validUserID,
+	}))
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+		IssueId:   blockerIssueID,
+		Summary:   bugSummary,
+		Status:    issuesPbv1.Status_NEW,
+		ProjectId: validProjectID,
+	}))
+
+	return issuessvc.NewIssuesService(repo, nil, nil), repo
+}
+
+func TestIssueLinkRepositories(t *testing.T) {
+	repos := map[string]func(t *testing.T) issuessvc.IssuesRepository{
+		"memdb": func(t *testing.T) issuessvc.IssuesRepository {
+			repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+			require.NoError(t, err)
+			return repo
+		},
+		"sqlite": func(t *testing.T) issuessvc.IssuesRepository {
+			return issuessvc.NewPostgresIssuesRepository(newSQLiteDB(t))
+		},
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			link := &issuesPbv1.IssueLink{
+				LinkId:        linkID,
+				SourceIssueId: blockerIssueID,
+				TargetIssueId: validIssueID,
+				Type:          issuesPbv1.LinkType_BLOCKS,
+			}
+			require.NoError(t, repo.CreateIssueLink(context.Background(), link))
+
+			// The link is listed for both of its issues
+			for _, issueID := range []string{blockerIssueID, validIssueID} {
+				links, err := repo.ListIssueLinks(context.Background(), issueID)
+				require.NoError(t, err)
+				require.Len(t, links, 1)
+				assert.Equal(t, linkID, links[0].LinkId)
+				assert.Equal(t, blockerIssueID, links[0].SourceIssueId)
+				assert.Equal(t, issuesPbv1.LinkType_BLOCKS, links[0].Type)
+			}
+
+			deleted, err := repo.DeleteIssueLink(context.Background(), linkID)
+			require.NoError(t, err)
+			assert.Equal(t, validIssueID, deleted.TargetIssueId)
+
+			links, err := repo.ListIssueLinks(context.Background(), validIssueID)
+			require.NoError(t, err)
+			assert.Empty(t, links)
+
+			_, err = repo.DeleteIssueLink(context.Background(), linkID)
+			assert.ErrorIs(t, err, consts.ErrIssueLinkNotFound)
+		})
+	}
+}
+
+func TestMemDBIssuesRepository_DeleteIssueRemovesLinks(t *testing.T) {
+	_, repo := newLinkFixture(t)
+	require.NoError(t, repo.CreateIssueLink(context.Background(), &issuesPbv1.IssueLink{
+		LinkId:        linkID,
+		SourceIssueId: blockerIssueID,
+		TargetIssueId: validIssueID,
+		Type:          issuesPbv1.LinkType_BLOCKS,
+	}))
+
+	require.NoError(t, repo.DeleteIssue(context.Background(), blockerIssueID))
+
+	links, err := repo.ListIssueLinks(context.Background(), validIssueID)
+	require.NoError(t, err)
+	assert.Empty(t, links)
+}
+
+func TestIssuesServiceServer_ListIssueLinksRendersBothDirections(t *testing.T) {
+	service, _ := newLinkFixture(t)
+	ctx := context.Background()
+
+	// Created from the blocked issue's side, stored once as blockerIssueID BLOCKS validIssueID
+	created, err := service.LinkIssues(ctx, &issuesPbv1.LinkIssuesRequest{
+		SourceIssueId: validIssueID,
+		TargetIssueId: blockerIssueID,
+		Type:          issuesPbv1.LinkType_BLOCKED_BY,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, validIssueID, created.Link.SourceIssueId)
+	assert.Equal(t, issuesPbv1.LinkType_BLOCKED_BY, created.Link.Type)
+
+	testCases := []struct {
+		issueID        string
+		expectedTarget string
+		expectedType   issuesPbv1.LinkType
+	}{
+		{issueID: blockerIssueID, expectedTarget: validIssueID, expectedType: issuesPbv1.LinkType_BLOCKS},
+		{issueID: validIssueID, expectedTarget: blockerIssueID, expectedType: issuesPbv1.LinkType_BLOCKED_BY},
+	}
+
+	for _, tc := range testCases {
+		resp, err := service.ListIssueLinks(ctx, &issuesPbv1.ListIssueLinksRequest{IssueId: tc.issueID})
+		require.NoError(t, err)
+		require.Len(t, resp.Links, 1)
+		assert.Equal(t, created.Link.LinkId, resp.Links[0].LinkId)
+		assert.Equal(t, tc.issueID, resp.Links[0].SourceIssueId)
+		assert.Equal(t, tc.expectedTarget, resp.Links[0].TargetIssueId)
+		assert.Equal(t, tc.expectedType, resp.Links[0].Type)
+	}
+}
+
+func TestIssuesServiceServer_LinkIssuesValidation(t *testing.T) {
+	service, _ := newLinkFixture(t)
+	ctx := context.Background()
+
+	_, err := service.LinkIssues(ctx, &issuesPbv1.LinkIssuesRequest{
+		SourceIssueId: blockerIssueID,
+		TargetIssueId: validIssueID,
+		Type:          issuesPbv1.LinkType_BLOCKS,
+	})
+	require.NoError(t, err)
+	_, err = service.LinkIssues(ctx, &issuesPbv1.LinkIssuesRequest{
+		SourceIssueId: blockerIssueID,
+		TargetIssueId: validIssueID,
+		Type:          issuesPbv1.LinkType_RELATES_TO,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		req          *issuesPbv1.LinkIssuesRequest
+		expectedCode codes.Code
+	}{
+		{
+			name:         "self link",
+			req:          &issuesPbv1.LinkIssuesRequest{SourceIssueId: validIssueID, TargetIssueId: validIssueID, Type: issuesPbv1.LinkType_RELATES_TO},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "unspecified type",
+			req:          &issuesPbv1.LinkIssuesRequest{SourceIssueId: validIssueID, TargetIssueId: blockerIssueID},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "missing target issue",
+			req:          &issuesPbv1.LinkIssuesRequest{SourceIssueId: validIssueID, TargetIssueId: linkID, Type: issuesPbv1.LinkType_RELATES_TO},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "identical link",
+			req:          &issuesPbv1.LinkIssuesRequest{SourceIssueId: blockerIssueID, TargetIssueId: validIssueID, Type: issuesPbv1.LinkType_BLOCKS},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "inverse of an existing link",
+			req:          &issuesPbv1.LinkIssuesRequest{SourceIssueId: validIssueID, TargetIssueId: blockerIssueID, Type: issuesPbv1.LinkType_BLOCKED_BY},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "relates to in the other direction",
+			req:          &issuesPbv1.LinkIssuesRequest{SourceIssueId: validIssueID, TargetIssueId: blockerIssueID, Type: issuesPbv1.LinkType_RELATES_TO},
+			expectedCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.LinkIssues(ctx, tc.req)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestIssuesServiceServer_UnlinkIssues(t *testing.T) {
+	service, _ := newLinkFixture(t)
+	ctx := context.Background()
+
+	created, err := service.LinkIssues(ctx, &issuesPbv1.LinkIssuesRequest{
+		SourceIssueId: validIssueID,
+		TargetIssueId: blockerIssueID,
+		Type:          issuesPbv1.LinkType_DUPLICATES,
+	})
+	require.NoError(t, err)
+
+	_, err = service.UnlinkIssues(ctx, &issuesPbv1.UnlinkIssuesRequest{LinkId: created.Link.LinkId})
+	require.NoError(t, err)
+
+	resp, err := service.ListIssueLinks(ctx, &issuesPbv1.ListIssueLinksRequest{IssueId: validIssueID})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Links)
+
+	_, err = service.UnlinkIssues(ctx, &issuesPbv1.UnlinkIssuesRequest{LinkId: created.Link.LinkId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestIssuesServiceServer_UpdateIssueRejectsResolvingBlockedIssue(t *testing.T) {
+	service, repo := newLinkFixture(t)
+	ctx := context.Background()
+
+	_, err := service.LinkIssues(ctx, &issuesPbv1.LinkIssuesRequest{
+		SourceIssueId: blockerIssueID,
+		TargetIssueId: validIssueID,
+		Type:          issuesPbv1.LinkType_BLOCKS,
+	})
+	require.NoError(t, err)
+
+	resolve := func() error {
+		issue, err := repo.ReadIssue(ctx, validIssueID)
+		require.NoError(t, err)
+		_, err = service.UpdateIssue(ctx, &issuesPbv1.UpdateIssueRequest{
+			IssueId:     validIssueID,
+			Summary:     issue.Summary,
+			Description: proto.String(issue.Description),
+			Status:      issuesPbv1.Status_RESOLVED,
+			Resolution:  issuesPbv1.Resolution_FIXED,
+			Type:        issue.Type,
+			Priority:    issue.Priority,
+			Version:     issue.Version,
+		})
+		return err
+	}
+
+	err = resolve()
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), blockerIssueID)
+
+	// Once the blocker is resolved, the issue can be resolved too
+	blocker, err := repo.ReadIssue(ctx, blockerIssueID)
+	require.NoError(t, err)
+	blocker.Status = issuesPbv1.Status_RESOLVED
+	require.NoError(t, repo.UpdateIssue(ctx, blocker))
+
+	require.NoError(t, resolve())
+}
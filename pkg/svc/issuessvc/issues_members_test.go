@@ -0,0 +1,110 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+const viewerUserID = "d58f705f-0efa-4c96-b2f6-ceb36281e1f5"
+
+// newMemberServices creates an issues service per backend whose project validProjectID has
+// validUserID as a developer and viewerUserID as a viewer. otherUserID exists but isn't a member.
+func newMemberServices(t *testing.T) map[string]*issuessvc.IssuesServiceServer {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	memUsers, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	memProjects, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	memIssues, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	memIssues.SetClients(issuessvc.NewLocalValidator(memProjects, memUsers))
+
+	db := newSQLiteDB(t)
+
+	backends := map[string]struct {
+		users    usersvc.UserRepository
+		projects projectsvc.ProjectRepository
+		issues   issuessvc.IssuesRepository
+	}{
+		"memdb":  {memUsers, memProjects, memIssues},
+		"sqlite": {usersvc.NewPostgresUserRepository(db), projectsvc.NewPostgresProjectRepository(db), issuessvc.NewPostgresIssuesRepository(db)},
+	}
+
+	services := make(map[string]*issuessvc.IssuesServiceServer)
+	for name, backend := range backends {
+		require.NoError(t, backend.projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: validProjectID, Name: "Members"}))
+		for i, userID := range []string{validUserID, viewerUserID, otherUserID} {
+			require.NoError(t, backend.users.CreateUser(ctx, &userPbv1.User{UserId: userID, EmailAddress: numberedIssueID(i) + "@example.com", Active: true}))
+		}
+		require.NoError(t, backend.projects.SetProjectMember(ctx, &projectPbv1.ProjectMember{ProjectId: validProjectID, UserId: validUserID, Role: projectsvc.RoleDeveloper}))
+		require.NoError(t, backend.projects.SetProjectMember(ctx, &projectPbv1.ProjectMember{ProjectId: validProjectID, UserId: viewerUserID, Role: projectsvc.RoleViewer}))
+
+		services[name] = issuessvc.NewIssuesService(backend.issues, nil, nil)
+	}
+	return services
+}
+
+func TestIssuesService_ViewersCannotBeAssigned(t *testing.T) {
+	for name, service := range newMemberServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			newRequest := func(assigneeID string) *issuesPbv1.CreateIssueRequest {
+				return &issuesPbv1.CreateIssueRequest{
+					Summary:    testSummary,
+					Type:       issuesPbv1.Type_BUG,
+					Priority:   issuesPbv1.Priority_MINOR,
+					ProjectId:  validProjectID,
+					AssigneeId: &assigneeID,
+				}
+			}
+
+			_, err := service.CreateIssue(ctx, newRequest(viewerUserID))
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.Equal(t, "viewer users cannot be assigned to issues", status.Convert(err).Message())
+
+			created, err := service.CreateIssue(ctx, newRequest(validUserID))
+			require.NoError(t, err, "developers can be assigned")
+			assert.Equal(t, validUserID, created.Issue.AssigneeId)
+
+			_, err = service.CreateIssue(ctx, newRequest(otherUserID))
+			require.NoError(t, err, "users who aren't members aren't restricted")
+
+			// Changing the assignee to a viewer is refused the same way
+			viewer := viewerUserID
+			_, err = service.UpdateIssue(ctx, &issuesPbv1.UpdateIssueRequest{
+				IssueId:    created.Issue.IssueId,
+				Summary:    created.Issue.Summary,
+				Type:       created.Issue.Type,
+				Priority:   created.Issue.Priority,
+				Status:     created.Issue.Status,
+				AssigneeId: &viewer,
+				Version:    created.Issue.Version,
+			})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+			_, err = service.AssignIssue(ctx, &issuesPbv1.AssignIssueRequest{IssueId: created.Issue.IssueId, AssigneeId: viewerUserID})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+			got, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: created.Issue.IssueId})
+			require.NoError(t, err)
+			assert.Equal(t, validUserID, got.Issue.AssigneeId)
+		})
+	}
+}
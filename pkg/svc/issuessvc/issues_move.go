@@ -0,0 +1,108 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// ErrMoveNotConfigured is returned by the MemDB repository's MoveIssue when
+// SetProjectRepository hasn't provided the project repository to keep in sync
+var ErrMoveNotConfigured = status.Error(codes.FailedPrecondition,
+	"moving issues is not configured for the in-memory issues repository")
+
+// IssueMove is the result of moving an issue between projects
+type IssueMove struct {
+	Issue       *issuesPbv1.Issue
+	FromProject *projectPbv1.Project
+	ToProject   *projectPbv1.Project
+}
+
+// SetMessageBroker configures the broker used to notify project subscribers when issues
+// move between projects. It should be the broker the project service publishes on, as the
+// project details GetIssue attaches are cached until it reports that the project changed.
+// Close ends the subscriptions the cache makes on it.
+func (s *IssuesServiceServer) SetMessageBroker(mb broker.MessageBroker) {
+	s.messageBroker = mb
+	if s.projectFetcher.cache != nil {
+		s.projectFetcher.cache.close()
+	}
+	s.projectFetcher.cache = newProjectDetailsCache(mb)
+	s.projectFetcher.cache.onChange = func(projectID string) {
+		s.responses.dropProject(context.Background(), projectID)
+	}
+}
+
+// MoveIssue moves an issue from one project to another, keeping both projects' issue counts in sync
+func (s *IssuesServiceServer) MoveIssue(ctx context.Context, req *issuesPbv1.MoveIssueRequest) (*issuesPbv1.MoveIssueResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if req.FromProjectId == req.ToProjectId {
+		return nil, status.Error(codes.InvalidArgument, "issue is already in the target project")
+	}
+
+	move, err := s.repository.MoveIssue(ctx, req.IssueId, req.FromProjectId, req.ToProjectId)
+	if err != nil {
+		return nil, moveIssueError(err)
+	}
+	s.notifyIssueWatchers(IssueEventUpdated, move.Issue)
+
+	message := fmt.Sprintf("Issue %s moved from project %s to project %s", req.IssueId, req.FromProjectId, req.ToProjectId)
+	s.publishProjectUpdate(move.FromProject, message)
+	s.publishProjectUpdate(move.ToProject, message)
+
+	return &issuesPbv1.MoveIssueResponse{
+		Message:               message,
+		Issue:                 move.Issue,
+		FromProjectIssueCount: move.FromProject.IssueCount,
+		ToProjectIssueCount:   move.ToProject.IssueCount,
+	}, nil
+}
+
+// moveIssueError converts a failed repository move into a gRPC status error
+func moveIssueError(err error) error {
+	switch {
+	case errors.Is(err, consts.ErrIssueNotFound):
+		return status.Error(codes.NotFound, "issue not found")
+	case errors.Is(err, consts.ErrProjectNotFound):
+		return status.Error(codes.NotFound, "target project not found")
+	case errors.Is(err, consts.ErrIssueNotInProject):
+		return status.Error(codes.FailedPrecondition, "issue does not belong to the source project")
+	case errors.Is(err, ErrMoveNotConfigured):
+		return err
+	default:
+		return status.Errorf(codes.Internal, "failed to move issue: %v", err)
+	}
+}
+
+// publishProjectUpdate notifies a project's subscribers of its new issue count. Publishing is
+// best effort: the move has already been committed.
+func (s *IssuesServiceServer) publishProjectUpdate(project *projectPbv1.Project, message string) {
+	if s.messageBroker == nil {
+		return
+	}
+
+	update := &projectPbv1.ProjectUpdateResponse{
+		ProjectId:  project.ProjectId,
+		IssueCount: project.IssueCount,
+		Message:    message,
+		UpdateType: projectPbv1.UpdateType_ISSUE_MOVED,
+	}
+	if err := s.messageBroker.PublishUpdate(context.Background(), project.ProjectId, update); err != nil {
+		logger.ZapLogger.Error("Failed to publish project update",
+			zap.String("project_id", project.ProjectId),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,155 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/memory"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const targetProjectID = "3e9a1c4b-7d2f-4b8e-a6c5-1f0d9e8b7a62"
+
+// newMoveFixture creates an issue in validProjectID and an empty targetProjectID
+func newMoveFixture(t *testing.T) (*issuessvc.MemDBIssuesRepository, *projectsvc.MemDBProjectRepository) {
+	t.Helper()
+
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, projectRepo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: validProjectID, Name: "Source"}))
+	require.NoError(t, projectRepo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: targetProjectID, Name: "Target"}))
+
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	repo.SetProjectRepository(projectRepo)
+
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, ProjectId: validProjectID}))
+	require.NoError(t, projectRepo.AddIssueToProject(context.Background(), validProjectID, validIssueID))
+
+	return repo, projectRepo
+}
+
+func issueCount(t *testing.T, projectRepo *projectsvc.MemDBProjectRepository, projectID string) int32 {
+	t.Helper()
+	project, err := projectRepo.ReadProject(context.Background(), projectID)
+	require.NoError(t, err)
+	return project.IssueCount
+}
+
+func TestMemDBIssuesRepository_MoveIssue(t *testing.T) {
+	repo, _ := newMoveFixture(t)
+
+	move, err := repo.MoveIssue(context.Background(), validIssueID, validProjectID, targetProjectID)
+	require.NoError(t, err)
+	assert.Equal(t, targetProjectID, move.Issue.ProjectId)
+	assert.Equal(t, int64(2), move.Issue.Version)
+	assert.Equal(t, int32(0), move.FromProject.IssueCount)
+	assert.Equal(t, int32(1), move.ToProject.IssueCount)
+
+	stored, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+	assert.Equal(t, targetProjectID, stored.ProjectId)
+}
+
+func TestMemDBIssuesRepository_MoveIssueFailureLeavesStateIntact(t *testing.T) {
+	testCases := []struct {
+		name          string
+		fromProjectID string
+		toProjectID   string
+		expectedError error
+	}{
+		{
+			name:          "issue not in source project",
+			fromProjectID: targetProjectID,
+			toProjectID:   validProjectID,
+			expectedError: consts.ErrIssueNotInProject,
+		},
+		{
+			name:          "target project missing",
+			fromProjectID: validProjectID,
+			toProjectID:   "9b1f0e2d-4c3a-4e5b-8f6a-7d9c0b1a2e3f",
+			expectedError: consts.ErrProjectNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, projectRepo := newMoveFixture(t)
+
+			_, err := repo.MoveIssue(context.Background(), validIssueID, tc.fromProjectID, tc.toProjectID)
+			assert.ErrorIs(t, err, tc.expectedError)
+
+			stored, err := repo.ReadIssue(context.Background(), validIssueID)
+			require.NoError(t, err)
+			assert.Equal(t, validProjectID, stored.ProjectId)
+			assert.Equal(t, int64(1), stored.Version)
+			assert.Equal(t, int32(1), issueCount(t, projectRepo, validProjectID))
+			assert.Equal(t, int32(0), issueCount(t, projectRepo, targetProjectID))
+		})
+	}
+}
+
+func TestMemDBIssuesRepository_MoveIssueWithoutProjectRepository(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	_, err = repo.MoveIssue(context.Background(), validIssueID, validProjectID, targetProjectID)
+	assert.ErrorIs(t, err, issuessvc.ErrMoveNotConfigured)
+}
+
+func TestIssuesService_MoveIssue(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, _ := newMoveFixture(t)
+
+	mb := memory.NewInMemoryBroker()
+	defer mb.Close()
+	fromSub, err := mb.Subscribe(context.Background(), validProjectID)
+	require.NoError(t, err)
+	toSub, err := mb.Subscribe(context.Background(), targetProjectID)
+	require.NoError(t, err)
+
+	service := issuessvc.NewIssuesService(repo, nil, nil)
+	service.SetMessageBroker(mb)
+
+	resp, err := service.MoveIssue(context.Background(), &issuesPbv1.MoveIssueRequest{
+		IssueId:       validIssueID,
+		FromProjectId: validProjectID,
+		ToProjectId:   targetProjectID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, targetProjectID, resp.Issue.ProjectId)
+	assert.Equal(t, int32(0), resp.FromProjectIssueCount)
+	assert.Equal(t, int32(1), resp.ToProjectIssueCount)
+
+	for _, sub := range []struct {
+		updates    <-chan *projectPbv1.ProjectUpdateResponse
+		issueCount int32
+	}{{fromSub.Updates, 0}, {toSub.Updates, 1}} {
+		select {
+		case update := <-sub.updates:
+			assert.Equal(t, sub.issueCount, update.IssueCount)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for project update")
+		}
+	}
+
+	// Moving it again from the old project is rejected
+	_, err = service.MoveIssue(context.Background(), &issuesPbv1.MoveIssueRequest{
+		IssueId:       validIssueID,
+		FromProjectId: validProjectID,
+		ToProjectId:   targetProjectID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
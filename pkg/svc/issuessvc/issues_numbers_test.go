@@ -0,0 +1,168 @@
+package issuessvc_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+// numberedIssueID returns the ID of the i-th issue created in the numbering tests
+func numberedIssueID(i int) string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", i)
+}
+
+// numberingRepositories pairs an issue repository with the project repository it moves issues in
+type numberingRepositories struct {
+	issues   issuessvc.IssuesRepository
+	projects projectsvc.ProjectRepository
+}
+
+// newNumberingRepositories returns both issue repositories, each with validProjectID and
+// targetProjectID stored in its project repository
+func newNumberingRepositories(t *testing.T) map[string]numberingRepositories {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	memProjects, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	memRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	memRepo.SetProjectRepository(memProjects)
+
+	db := newSQLiteDB(t)
+	sqliteProjects := projectsvc.NewPostgresProjectRepository(db)
+
+	for _, projects := range []projectsvc.ProjectRepository{memProjects, sqliteProjects} {
+		for _, projectID := range []string{validProjectID, targetProjectID} {
+			require.NoError(t, projects.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: projectID, Name: "Project " + projectID[:4]}))
+		}
+	}
+
+	return map[string]numberingRepositories{
+		"memdb":  {issues: memRepo, projects: memProjects},
+		"sqlite": {issues: issuessvc.NewPostgresIssuesRepository(db), projects: sqliteProjects},
+	}
+}
+
+func TestIssuesRepositories_NumberIssuesPerProject(t *testing.T) {
+	for name, repos := range newNumberingRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := repos.issues
+			projectIDs := []string{validProjectID, validProjectID, targetProjectID, validProjectID}
+			for i, projectID := range projectIDs {
+				issue := &issuesPbv1.Issue{IssueId: numberedIssueID(i), Summary: testSummary, ProjectId: projectID}
+				require.NoError(t, repo.CreateIssue(ctx, issue))
+				require.NoError(t, repos.projects.AddIssueToProject(ctx, projectID, issue.IssueId))
+				// The number is reported back on the created issue
+				assert.NotZero(t, issue.IssueNumber)
+			}
+
+			expected := map[string]int32{numberedIssueID(0): 1, numberedIssueID(1): 2, numberedIssueID(2): 1, numberedIssueID(3): 3}
+			for issueID, number := range expected {
+				stored, err := repo.ReadIssue(ctx, issueID)
+				require.NoError(t, err)
+				assert.Equal(t, number, stored.IssueNumber, issueID)
+			}
+
+			byNumber, err := repo.ReadIssueByNumber(ctx, validProjectID, 2)
+			require.NoError(t, err)
+			assert.Equal(t, numberedIssueID(1), byNumber.IssueId)
+
+			_, err = repo.ReadIssueByNumber(ctx, targetProjectID, 2)
+			assert.ErrorIs(t, err, consts.ErrIssueNotFound)
+
+			// Updates keep the number, even when the caller leaves it out
+			byNumber.IssueNumber = 0
+			byNumber.Summary = bugSummary
+			require.NoError(t, repo.UpdateIssue(ctx, byNumber))
+			stored, err := repo.ReadIssue(ctx, numberedIssueID(1))
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), stored.IssueNumber)
+
+			// The numbers of deleted issues aren't reused
+			require.NoError(t, repo.DeleteIssue(ctx, numberedIssueID(3)))
+			next := &issuesPbv1.Issue{IssueId: numberedIssueID(4), Summary: testSummary, ProjectId: validProjectID}
+			require.NoError(t, repo.CreateIssue(ctx, next))
+			assert.Equal(t, int32(4), next.IssueNumber)
+
+			// A moved issue takes the next number of its new project
+			move, err := repo.MoveIssue(ctx, numberedIssueID(0), validProjectID, targetProjectID)
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), move.Issue.IssueNumber)
+			moved, err := repo.ReadIssueByNumber(ctx, targetProjectID, 2)
+			require.NoError(t, err)
+			assert.Equal(t, numberedIssueID(0), moved.IssueId)
+		})
+	}
+}
+
+func TestMemDBIssuesRepository_NumbersConcurrentCreates(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	const creators = 20
+	numbers := make([]int, creators)
+	var wg sync.WaitGroup
+	for i := 0; i < creators; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			issue := &issuesPbv1.Issue{IssueId: numberedIssueID(i), Summary: testSummary, ProjectId: validProjectID}
+			assert.NoError(t, repo.CreateIssue(context.Background(), issue))
+			numbers[i] = int(issue.IssueNumber)
+		}()
+	}
+	wg.Wait()
+
+	sort.Ints(numbers)
+	for i, number := range numbers {
+		assert.Equal(t, i+1, number)
+	}
+}
+
+func TestIssuesServiceServer_GetIssueByProjectAndNumber(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, ProjectId: validProjectID}))
+	service := issuessvc.NewIssuesService(repo, nil, nil)
+
+	resp, err := service.GetIssueByProjectAndNumber(context.Background(), &issuesPbv1.GetIssueByProjectAndNumberRequest{ProjectId: validProjectID, IssueNumber: 1})
+	require.NoError(t, err)
+	assert.Equal(t, validIssueID, resp.Issue.IssueId)
+	assert.Equal(t, int32(1), resp.Issue.IssueNumber)
+
+	testCases := []struct {
+		name         string
+		req          *issuesPbv1.GetIssueByProjectAndNumberRequest
+		expectedCode codes.Code
+	}{
+		{name: "unknown number", req: &issuesPbv1.GetIssueByProjectAndNumberRequest{ProjectId: validProjectID, IssueNumber: 2}, expectedCode: codes.NotFound},
+		{name: "other project", req: &issuesPbv1.GetIssueByProjectAndNumberRequest{ProjectId: targetProjectID, IssueNumber: 1}, expectedCode: codes.NotFound},
+		{name: "zero number", req: &issuesPbv1.GetIssueByProjectAndNumberRequest{ProjectId: validProjectID}, expectedCode: codes.InvalidArgument},
+		{name: "invalid project ID", req: &issuesPbv1.GetIssueByProjectAndNumberRequest{ProjectId: "not-a-uuid", IssueNumber: 1}, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.GetIssueByProjectAndNumber(context.Background(), tc.req)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
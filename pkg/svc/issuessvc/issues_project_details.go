@@ -0,0 +1,187 @@
+package issuessvc
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// projectDetailsCacheSize is the most projects a projectDetailsCache keeps, each with its own
+// subscription and goroutine; the least recently used project is dropped to make room
+const projectDetailsCacheSize = 256
+
+// projectDetailsCache keeps the projects GetIssue attaches as details, so the issues of a
+// project don't each call the project service. Each cached project has a subscription to its
+// PROJECT_CHANGED updates on the broker the project service publishes on; an update drops the
+// project, so a rename shows up on the next lookup. The subscriptions belong to the cache
+// rather than to the request that first looked the project up, and end when it is closed.
+type projectDetailsCache struct {
+	broker broker.MessageBroker
+	// onChange, if set, is called with the ID of each project a PROJECT_CHANGED update drops
+	onChange func(projectID string)
+	// maxEntries is the most projects kept; see projectDetailsCacheSize
+	maxEntries int
+
+	// ctx is the context of the subscriptions, cancelled by close
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	entries map[string]*projectDetailsEntry
+	// lastUse counts lookups, to find the least recently used entry
+	lastUse uint64
+	closed  bool
+}
+
+// projectDetailsEntry is a project being looked up or cached
+type projectDetailsEntry struct {
+	// sub is nil while the subscription is being made
+	sub *broker.Subscription
+	// project is nil until the lookup that created the entry succeeds
+	project *projectPbv1.Project
+	// used is the lookup count of the entry's last use
+	used uint64
+}
+
+func newProjectDetailsCache(mb broker.MessageBroker) *projectDetailsCache {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &projectDetailsCache{
+		broker:     mb,
+		maxEntries: projectDetailsCacheSize,
+		ctx:        ctx,
+		cancel:     cancel,
+		entries:    make(map[string]*projectDetailsEntry),
+	}
+}
+
+// get returns the cached project, or looks it up with fetch and caches it. The subscription
+// starts before the lookup, so a change made while the project is being fetched drops the
+// entry and the possibly stale result isn't kept. While another lookup of the project is
+// subscribing, or if subscribing fails, the project is looked up without being cached.
+func (c *projectDetailsCache) get(ctx context.Context, projectID string, fetch func(context.Context, string) (*projectPbv1.Project, error)) (*projectPbv1.Project, error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return fetch(ctx, projectID)
+	}
+	c.lastUse++
+	if entry, ok := c.entries[projectID]; ok {
+		entry.used = c.lastUse
+		project := entry.project
+		c.mu.Unlock()
+		if project != nil {
+			return proto.Clone(project).(*projectPbv1.Project), nil
+		}
+		return fetch(ctx, projectID)
+	}
+	entry := &projectDetailsEntry{used: c.lastUse}
+	c.entries[projectID] = entry
+	evicted := c.evictLocked()
+	c.mu.Unlock()
+	c.unsubscribe(evicted)
+
+	sub, err := c.broker.Subscribe(c.ctx, projectID, projectPbv1.UpdateType_PROJECT_CHANGED)
+	c.mu.Lock()
+	if err != nil || c.entries[projectID] != entry {
+		// The cache was closed, or the entry evicted, while subscribing
+		if c.entries[projectID] == entry {
+			delete(c.entries, projectID)
+		}
+		c.mu.Unlock()
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to watch project for changes; its details aren't cached",
+				zap.String("projectId", projectID), zap.Error(err))
+		} else {
+			c.unsubscribe([]*broker.Subscription{sub})
+		}
+		return fetch(ctx, projectID)
+	}
+	entry.sub = sub
+	c.mu.Unlock()
+	go c.watch(projectID, entry)
+
+	project, err := fetch(ctx, projectID)
+	if err != nil || project == nil {
+		return project, err
+	}
+	c.mu.Lock()
+	if c.entries[projectID] == entry {
+		entry.project = proto.Clone(project).(*projectPbv1.Project)
+	}
+	c.mu.Unlock()
+	return project, nil
+}
+
+// evictLocked removes the least recently used entries until there are no more than
+// maxEntries, returning the subscriptions to end. c.mu must be held.
+func (c *projectDetailsCache) evictLocked() []*broker.Subscription {
+	var subs []*broker.Subscription
+	for len(c.entries) > c.maxEntries {
+		var oldestID string
+		var oldest *projectDetailsEntry
+		for projectID, entry := range c.entries {
+			if oldest == nil || entry.used < oldest.used {
+				oldestID, oldest = projectID, entry
+			}
+		}
+		delete(c.entries, oldestID)
+		if oldest.sub != nil {
+			subs = append(subs, oldest.sub)
+		}
+	}
+	return subs
+}
+
+// watch drops entry once its project changes or its subscription ends. Only a PROJECT_CHANGED
+// update is reported to onChange; a subscription that ends because the entry was evicted, the
+// cache closed or the broker shut down just stops the project being cached.
+func (c *projectDetailsCache) watch(projectID string, entry *projectDetailsEntry) {
+	_, changed := <-entry.sub.Updates
+
+	c.mu.Lock()
+	if c.entries[projectID] == entry {
+		delete(c.entries, projectID)
+	}
+	c.mu.Unlock()
+	if changed && c.onChange != nil {
+		c.onChange(projectID)
+	}
+	c.unsubscribe([]*broker.Subscription{entry.sub})
+}
+
+// unsubscribe ends subs, which is a no-op for those that have already ended
+func (c *projectDetailsCache) unsubscribe(subs []*broker.Subscription) {
+	for _, sub := range subs {
+		if err := c.broker.Unsubscribe(context.Background(), sub); err != nil {
+			logger.ZapLogger.Warn("Failed to stop watching project for changes",
+				zap.String("projectId", sub.ProjectID), zap.Error(err))
+		}
+	}
+}
+
+// close ends the subscriptions of the cached projects; later lookups aren't cached
+func (c *projectDetailsCache) close() {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	c.closed = true
+	subs := make([]*broker.Subscription, 0, len(c.entries))
+	for projectID, entry := range c.entries {
+		delete(c.entries, projectID)
+		if entry.sub != nil {
+			subs = append(subs, entry.sub)
+		}
+	}
+	c.mu.Unlock()
+
+	c.cancel()
+	c.unsubscribe(subs)
+}
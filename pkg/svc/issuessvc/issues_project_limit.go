@@ -0,0 +1,37 @@
+package issuessvc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkProjectIssueLimit returns ResourceExhausted when the project already has as many issues as
+// its max_issues allows. A limit of 0 means unlimited, and the issues aren't counted. The count
+// is read before the issue is saved, so concurrent creates can overshoot the limit by the
+// number of calls racing.
+func (s *IssuesServiceServer) checkProjectIssueLimit(ctx context.Context, projectID string) error {
+	maxIssues, err := s.repository.GetProjectMaxIssues(ctx, projectID)
+	if err != nil {
+		if isDependencyUnavailable(err) {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to read the project's issue limit: %v", err)
+	}
+	if maxIssues <= 0 {
+		return nil
+	}
+
+	count, err := s.repository.GetProjectIssueCount(ctx, projectID)
+	if err != nil {
+		if isDependencyUnavailable(err) {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to count the project's issues: %v", err)
+	}
+	if count >= maxIssues {
+		return status.Error(codes.ResourceExhausted, "project issue limit reached")
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+// limitBackend is an issues service with the repository holding its project, so the project's
+// issue limit can be changed
+type limitBackend struct {
+	service  *issuessvc.IssuesServiceServer
+	projects projectsvc.ProjectRepository
+}
+
+// newLimitBackends returns an issues service per storage backend whose project has maxIssues
+// as its limit. The project service client of each adds new issues to the project in the
+// backend's project repository, like the project service does.
+func newLimitBackends(t *testing.T, maxIssues int32) map[string]limitBackend {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+	project := &projectPbv1.Project{ProjectId: validProjectID, Name: "Limited", MaxIssues: maxIssues}
+
+	memProjects, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, memProjects.CreateProject(ctx, project))
+	memIssues, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	memIssues.SetClients(issuessvc.NewLocalValidator(memProjects, nil))
+
+	db := newSQLiteDB(t)
+	sqlProjects := projectsvc.NewPostgresProjectRepository(db)
+	require.NoError(t, sqlProjects.CreateProject(ctx, project))
+
+	return map[string]limitBackend{
+		"memdb":  {issuessvc.NewIssuesService(memIssues, newAddingProjectService(t, memProjects), nil), memProjects},
+		"sqlite": {issuessvc.NewIssuesService(issuessvc.NewPostgresIssuesRepository(db), newAddingProjectService(t, sqlProjects), nil), sqlProjects},
+	}
+}
+
+// newAddingProjectService returns a project service client that adds new issues to their project in projects
+func newAddingProjectService(t *testing.T, projects projectsvc.ProjectRepository) *mocks.MockProjectServiceClient {
+	projectService := mocks.NewMockProjectServiceClient(gomock.NewController(t))
+	projectService.EXPECT().UpdateProjectWithIssue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *projectPbv1.UpdateProjectWithIssueRequest, _ ...grpc.CallOption) (*projectPbv1.UpdateProjectWithIssueResponse, error) {
+			return &projectPbv1.UpdateProjectWithIssueResponse{}, projects.AddIssueToProject(ctx, req.ProjectId, req.IssueId)
+		}).AnyTimes()
+	return projectService
+}
+
+func newLimitRequest() *issuesPbv1.CreateIssueRequest {
+	return &issuesPbv1.CreateIssueRequest{
+		Summary: testSummary, Type: issuesPbv1.Type_BUG, Priority: issuesPbv1.Priority_MINOR, ProjectId: validProjectID,
+	}
+}
+
+func TestIssuesServiceServer_ProjectIssueLimitReached(t *testing.T) {
+	for name, backend := range newLimitBackends(t, 2) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			first, err := backend.service.CreateIssue(ctx, newLimitRequest())
+			require.NoError(t, err)
+			_, err = backend.service.CreateIssue(ctx, newLimitRequest())
+			require.NoError(t, err, "the issue that reaches the limit is created")
+
+			_, err = backend.service.CreateIssue(ctx, newLimitRequest())
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			assert.Equal(t, "project issue limit reached", status.Convert(err).Message())
+
+			_, err = backend.service.CloneIssue(ctx, &issuesPbv1.CloneIssueRequest{SourceIssueId: first.Issue.IssueId})
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err), "clones count against the limit too")
+
+			resp, err := backend.service.ValidateIssue(ctx, &issuesPbv1.ValidateIssueRequest{Payload: &issuesPbv1.ValidateIssueRequest_Create{Create: newLimitRequest()}})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"project_id": ""}, problemFields(resp))
+
+			list, err := backend.service.ListIssues(ctx, &issuesPbv1.ListIssuesRequest{PageSize: 10})
+			require.NoError(t, err)
+			assert.Len(t, list.Issues, 2)
+		})
+	}
+}
+
+func TestIssuesServiceServer_ProjectIssueLimitLoweredBelowCount(t *testing.T) {
+	for name, backend := range newLimitBackends(t, 0) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for i := 0; i < 3; i++ {
+				_, err := backend.service.CreateIssue(ctx, newLimitRequest())
+				require.NoError(t, err)
+			}
+
+			project, err := backend.projects.ReadProject(ctx, validProjectID)
+			require.NoError(t, err)
+			project.MaxIssues = 1
+			require.NoError(t, backend.projects.UpdateProject(ctx, project))
+
+			_, err = backend.service.CreateIssue(ctx, newLimitRequest())
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+	}
+}
+
+func TestIssuesServiceServer_ProjectIssueLimitUnlimited(t *testing.T) {
+	for name, backend := range newLimitBackends(t, 0) {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 5; i++ {
+				_, err := backend.service.CreateIssue(context.Background(), newLimitRequest())
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,14 +2,17 @@ package issuessvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/logger"
 	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -18,40 +21,43 @@ type CachedIssuesRepository struct {
 	repository IssuesRepository
 	cache      cache.Cache
 	ttl        time.Duration
+	listTTL    time.Duration
 }
 
-// NewCachedIssuesRepository creates a new cached issues repository
-func NewCachedIssuesRepository(repository IssuesRepository, cache cache.Cache) *CachedIssuesRepository {
-	// Default TTL: 1 hour
-	ttl := 3600 * time.Second
-
-	// Get TTL from environment variable if available
-	if ttlStr := os.Getenv("CACHE_TTL"); ttlStr != "" {
-		if ttlVal, err := strconv.Atoi(ttlStr); err == nil {
-			ttl = time.Duration(ttlVal) * time.Second
-		}
-	}
-
+// NewCachedIssuesRepository creates a new cached issues repository whose entries expire after the TTLs in cfg
+func NewCachedIssuesRepository(repository IssuesRepository, cache cache.Cache, cfg cache.CacheConfig) *CachedIssuesRepository {
 	return &CachedIssuesRepository{
 		repository: repository,
 		cache:      cache,
-		ttl:        ttl,
+		ttl:        cfg.IssueTTL,
+		listTTL:    cfg.IssueListTTL,
 	}
 }
 
+// DegradedMode reports whether the repository has stopped using its cache because the cache
+// kept failing; see cache.DegradedCache
+func (r *CachedIssuesRepository) DegradedMode() bool {
+	return cache.IsDegraded(r.cache)
+}
+
 // CreateIssue adds a new issue to the repository with caching
-func (r *CachedIssuesRepository) CreateIssue(issue *issuesPbv1.Issue) error {
+func (r *CachedIssuesRepository) CreateIssue(ctx context.Context, issue *issuesPbv1.Issue) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.CreateIssue")
+	defer span.End()
+
 	// Write to repository first
-	if err := r.repository.CreateIssue(issue); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.CreateIssue")
+	err := r.repository.CreateIssue(repoCtx, issue)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Then update cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("issue:%s", issue.IssueId)
-	if err := r.cache.Set(ctx, cacheKey, issue, r.ttl); err != nil {
+	if err := r.set(ctx, cacheKey, issue, r.ttl); err != nil {
 		// Log error but don't fail the request
-		logger.ZapLogger.Error("Failed to cache issue",
+		logger.FromContext(ctx).Error("Failed to cache issue",
 			zap.String("issue_id", issue.IssueId),
 			zap.Error(err))
 	}
@@ -63,8 +69,14 @@ func (r *CachedIssuesRepository) CreateIssue(issue *issuesPbv1.Issue) error {
 }
 
 // ReadIssue retrieves an issue by ID with caching
-func (r *CachedIssuesRepository) ReadIssue(issueID string) (*issuesPbv1.Issue, error) {
-	ctx := context.Background()
+func (r *CachedIssuesRepository) ReadIssue(ctx context.Context, issueID string) (*issuesPbv1.Issue, error) {
+	if r.ttl <= 0 {
+		return r.repository.ReadIssue(ctx, issueID)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.ReadIssue", attribute.String("issue.id", issueID))
+	defer span.End()
+
 	cacheKey := fmt.Sprintf("issue:%s", issueID)
 
 	// Try to get from cache first
@@ -72,13 +84,17 @@ func (r *CachedIssuesRepository) ReadIssue(issueID string) (*issuesPbv1.Issue, e
 	err := r.cache.Get(ctx, cacheKey, issue)
 	if err == nil {
 		// Cache hit
-		logger.ZapLogger.Debug("Issue cache hit", zap.String("issue_id", issueID))
+		logger.FromContext(ctx).Debug("Issue cache hit", zap.String("issue_id", issueID))
 		logger.LogCacheAccess(ctx, "Issue", issueID, logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
 		return issue, nil
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 
 	// Cache miss, get from repository
-	issue, err = r.repository.ReadIssue(issueID)
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.ReadIssue")
+	issue, err = r.repository.ReadIssue(repoCtx, issueID)
+	tracing.EndSpan(repoSpan, err)
 	if err != nil {
 		return nil, err
 	}
@@ -86,9 +102,9 @@ func (r *CachedIssuesRepository) ReadIssue(issueID string) (*issuesPbv1.Issue, e
 	logger.LogCacheAccess(ctx, "Issue", issueID, logger.FromDatabase)
 
 	// Store in cache for future requests
-	if err := r.cache.Set(ctx, cacheKey, issue, r.ttl); err != nil {
+	if err := r.set(ctx, cacheKey, issue, r.ttl); err != nil {
 		// Log error but don't fail the request
-		logger.ZapLogger.Error("Failed to cache issue",
+		logger.FromContext(ctx).Error("Failed to cache issue",
 			zap.String("issue_id", issueID),
 			zap.Error(err))
 	}
@@ -96,22 +112,49 @@ func (r *CachedIssuesRepository) ReadIssue(issueID string) (*issuesPbv1.Issue, e
 	return issue, nil
 }
 
+// ReadIssueByNumber retrieves an issue by its project and issue number. It isn't cached,
+// since an issue's number changes when it moves to another project.
+func (r *CachedIssuesRepository) ReadIssueByNumber(ctx context.Context, projectID string, issueNumber int32) (*issuesPbv1.Issue, error) {
+	return r.repository.ReadIssueByNumber(ctx, projectID, issueNumber)
+}
+
 // UpdateIssue updates an existing issue and refreshes cache
-func (r *CachedIssuesRepository) UpdateIssue(issue *issuesPbv1.Issue) error {
+func (r *CachedIssuesRepository) UpdateIssue(ctx context.Context, issue *issuesPbv1.Issue) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.UpdateIssue", attribute.String("issue.id", issue.IssueId))
+	defer span.End()
+
+	cacheKey := fmt.Sprintf("issue:%s", issue.IssueId)
+
 	// Write to repository first
-	if err := r.repository.UpdateIssue(issue); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.UpdateIssue")
+	err := r.repository.UpdateIssue(repoCtx, issue)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		if errors.Is(err, consts.ErrIssueVersionConflict) {
+			// The cached copy is stale; drop it so the caller's retry reads the current version
+			if delErr := r.cache.Delete(ctx, cacheKey); delErr != nil {
+				logger.FromContext(ctx).Error("Failed to remove stale issue from cache",
+					zap.String("issue_id", issue.IssueId),
+					zap.Error(delErr))
+			}
+		}
 		return err
 	}
 
 	// Update cache
-	ctx := context.Background()
-	cacheKey := fmt.Sprintf("issue:%s", issue.IssueId)
-	if err := r.cache.Set(ctx, cacheKey, issue, r.ttl); err != nil {
-		logger.ZapLogger.Error("Failed to update issue in cache",
+	if err := r.set(ctx, cacheKey, issue, r.ttl); err != nil {
+		logger.FromContext(ctx).Error("Failed to update issue in cache",
 			zap.String("issue_id", issue.IssueId),
 			zap.Error(err))
 	}
 
+	// A status change moves the project's progress
+	if err := r.cache.Delete(ctx, projectsvc.ProjectProgressCacheKey(issue.ProjectId)); err != nil {
+		logger.FromContext(ctx).Error("Failed to invalidate project progress cache",
+			zap.String("project_id", issue.ProjectId),
+			zap.Error(err))
+	}
+
 	// Also invalidate the issues list cache since an issue was updated
 	r.invalidateIssueListCache(ctx)
 
@@ -119,17 +162,22 @@ func (r *CachedIssuesRepository) UpdateIssue(issue *issuesPbv1.Issue) error {
 }
 
 // DeleteIssue removes an issue and clears it from cache
-func (r *CachedIssuesRepository) DeleteIssue(issueID string) error {
+func (r *CachedIssuesRepository) DeleteIssue(ctx context.Context, issueID string) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.DeleteIssue", attribute.String("issue.id", issueID))
+	defer span.End()
+
 	// Delete from repository first
-	if err := r.repository.DeleteIssue(issueID); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.DeleteIssue")
+	err := r.repository.DeleteIssue(repoCtx, issueID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Remove from cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("issue:%s", issueID)
 	if err := r.cache.Delete(ctx, cacheKey); err != nil {
-		logger.ZapLogger.Error("Failed to remove issue from cache",
+		logger.FromContext(ctx).Error("Failed to remove issue from cache",
 			zap.String("issue_id", issueID),
 			zap.Error(err))
 	}
@@ -141,8 +189,14 @@ func (r *CachedIssuesRepository) DeleteIssue(issueID string) error {
 }
 
 // ListIssues retrieves a paginated list of issues with caching
-func (r *CachedIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
-	ctx := context.Background()
+func (r *CachedIssuesRepository) ListIssues(ctx context.Context, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
+	if r.listTTL <= 0 {
+		return r.repository.ListIssues(ctx, pageToken, pageSize)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.ListIssues")
+	defer span.End()
+
 	cacheKey := fmt.Sprintf("issues:list:%s:%d", pageToken, pageSize)
 
 	// Try to get from cache first
@@ -155,15 +209,19 @@ func (r *CachedIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*
 	err := r.cache.Get(ctx, cacheKey, &cachedList)
 	if err == nil {
 		// Cache hit
-		logger.ZapLogger.Debug("Issues list cache hit",
+		logger.FromContext(ctx).Debug("Issues list cache hit",
 			zap.String("page_token", pageToken),
 			zap.Int("page_size", pageSize))
 		logger.LogCacheAccess(ctx, "IssuesList", fmt.Sprintf("page:%s:size:%d", pageToken, pageSize), logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
 		return cachedList.Issues, cachedList.NextToken, nil
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 
 	// Cache miss, get from repository
-	issues, nextToken, err := r.repository.ListIssues(pageToken, pageSize)
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.ListIssues")
+	issues, nextToken, err := r.repository.ListIssues(repoCtx, pageToken, pageSize)
+	tracing.EndSpan(repoSpan, err)
 	if err != nil {
 		return nil, "", err
 	}
@@ -176,8 +234,8 @@ func (r *CachedIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*
 		NextToken: nextToken,
 	}
 
-	if err := r.cache.Set(ctx, cacheKey, toCache, r.ttl); err != nil {
-		logger.ZapLogger.Error("Failed to cache issues list",
+	if err := r.set(ctx, cacheKey, toCache, r.listTTL); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache issues list",
 			zap.String("page_token", pageToken),
 			zap.Int("page_size", pageSize),
 			zap.Error(err))
@@ -186,6 +244,92 @@ func (r *CachedIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*
 	return issues, nextToken, nil
 }
 
+// BatchGetIssues retrieves issues by ID, reading the cached ones from the cache and the rest
+// from the repository in one call, then caching each of those individually
+func (r *CachedIssuesRepository) BatchGetIssues(ctx context.Context, issueIDs []string) ([]*issuesPbv1.Issue, error) {
+	if r.ttl <= 0 {
+		return r.repository.BatchGetIssues(ctx, issueIDs)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.BatchGetIssues", attribute.Int("issue.count", len(issueIDs)))
+	defer span.End()
+
+	found := make(map[string]*issuesPbv1.Issue, len(issueIDs))
+	var misses []string
+	for _, issueID := range issueIDs {
+		var issue = new(issuesPbv1.Issue)
+		if err := r.cache.Get(ctx, fmt.Sprintf("issue:%s", issueID), issue); err == nil {
+			logger.LogCacheAccess(ctx, "Issue", issueID, logger.FromCache)
+			found[issueID] = issue
+			continue
+		}
+		misses = append(misses, issueID)
+	}
+
+	span.SetAttributes(attribute.Int("cache.misses", len(misses)))
+
+	if len(misses) > 0 {
+		repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.BatchGetIssues")
+		issues, err := r.repository.BatchGetIssues(repoCtx, misses)
+		tracing.EndSpan(repoSpan, err)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			logger.LogCacheAccess(ctx, "Issue", issue.IssueId, logger.FromDatabase)
+			found[issue.IssueId] = issue
+
+			if err := r.set(ctx, fmt.Sprintf("issue:%s", issue.IssueId), issue, r.ttl); err != nil {
+				logger.FromContext(ctx).Error("Failed to cache issue",
+					zap.String("issue_id", issue.IssueId),
+					zap.Error(err))
+			}
+		}
+	}
+
+	// Keep the order of the requested IDs
+	issues := make([]*issuesPbv1.Issue, 0, len(found))
+	for _, issueID := range issueIDs {
+		if issue, ok := found[issueID]; ok {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// MoveIssue moves an issue between projects and invalidates the cached issue and both projects
+func (r *CachedIssuesRepository) MoveIssue(ctx context.Context, issueID, fromProjectID, toProjectID string) (*IssueMove, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.MoveIssue", attribute.String("issue.id", issueID))
+	defer span.End()
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.MoveIssue")
+	move, err := r.repository.MoveIssue(repoCtx, issueID, fromProjectID, toProjectID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{
+		fmt.Sprintf("issue:%s", issueID),
+		fmt.Sprintf("project:%s", fromProjectID),
+		fmt.Sprintf("project:%s", toProjectID),
+		projectsvc.ProjectProgressCacheKey(fromProjectID),
+		projectsvc.ProjectProgressCacheKey(toProjectID),
+		"projects:all",
+	}
+	for _, key := range keys {
+		if err := r.cache.Delete(ctx, key); err != nil {
+			logger.FromContext(ctx).Error("Failed to invalidate cache after moving issue",
+				zap.String("key", key),
+				zap.Error(err))
+		}
+	}
+
+	r.invalidateIssueListCache(ctx)
+
+	return move, nil
+}
+
 // ValidateProjectExists checks if a project exists
 func (r *CachedIssuesRepository) ValidateProjectExists(ctx context.Context, projectID string) error {
 	return r.repository.ValidateProjectExists(ctx, projectID)
@@ -196,9 +340,192 @@ func (r *CachedIssuesRepository) ValidateUserExists(ctx context.Context, userID
 	return r.repository.ValidateUserExists(ctx, userID)
 }
 
+// GetProjectMemberRole retrieves the role of a user in a project
+func (r *CachedIssuesRepository) GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error) {
+	return r.repository.GetProjectMemberRole(ctx, projectID, userID)
+}
+
+// GetProjectIssueCount returns the number of issues of a project; counts are not cached, so
+// the issue limit is checked against the current count
+func (r *CachedIssuesRepository) GetProjectIssueCount(ctx context.Context, projectID string) (int32, error) {
+	return r.repository.GetProjectIssueCount(ctx, projectID)
+}
+
+// GetProjectMaxIssues returns the most issues a project may have
+func (r *CachedIssuesRepository) GetProjectMaxIssues(ctx context.Context, projectID string) (int32, error) {
+	return r.repository.GetProjectMaxIssues(ctx, projectID)
+}
+
 // IsValidStatusTransition checks if a status transition is valid
-func (r *CachedIssuesRepository) IsValidStatusTransition(currentStatus, newStatus issuesPbv1.Status) error {
-	return r.repository.IsValidStatusTransition(currentStatus, newStatus)
+func (r *CachedIssuesRepository) IsValidStatusTransition(ctx context.Context, currentStatus, newStatus issuesPbv1.Status) error {
+	return r.repository.IsValidStatusTransition(ctx, currentStatus, newStatus)
+}
+
+// CreateIssueLink adds an issue link; links are not cached
+func (r *CachedIssuesRepository) CreateIssueLink(ctx context.Context, link *issuesPbv1.IssueLink) error {
+	return r.repository.CreateIssueLink(ctx, link)
+}
+
+// DeleteIssueLink removes an issue link
+func (r *CachedIssuesRepository) DeleteIssueLink(ctx context.Context, linkID string) (*issuesPbv1.IssueLink, error) {
+	return r.repository.DeleteIssueLink(ctx, linkID)
+}
+
+// ListIssueLinks retrieves the links from and to an issue
+func (r *CachedIssuesRepository) ListIssueLinks(ctx context.Context, issueID string) ([]*issuesPbv1.IssueLink, error) {
+	return r.repository.ListIssueLinks(ctx, issueID)
+}
+
+// CountOpenIssuesByAssignee counts the open issues of a user; the count is not cached
+func (r *CachedIssuesRepository) CountOpenIssuesByAssignee(ctx context.Context, assigneeID string) (int, error) {
+	return r.repository.CountOpenIssuesByAssignee(ctx, assigneeID)
+}
+
+// ListIssuesByProject lists a project's issues directly from the repository; the pages are not
+// cached, as issues created, moved or deleted would leave them stale
+func (r *CachedIssuesRepository) ListIssuesByProject(ctx context.Context, projectID, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
+	return r.repository.ListIssuesByProject(ctx, projectID, pageToken, pageSize)
+}
+
+// FindIssuesDueSoon returns the assigned open issues due soon; the result is not cached
+func (r *CachedIssuesRepository) FindIssuesDueSoon(ctx context.Context, within time.Duration) ([]*issuesPbv1.Issue, error) {
+	return r.repository.FindIssuesDueSoon(ctx, within)
+}
+
+// ReassignOpenIssues reassigns the open issues of a user and removes them from the cache
+func (r *CachedIssuesRepository) ReassignOpenIssues(ctx context.Context, fromAssigneeID, toAssigneeID string) ([]string, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.ReassignOpenIssues")
+	defer span.End()
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.ReassignOpenIssues")
+	issueIDs, err := r.repository.ReassignOpenIssues(repoCtx, fromAssigneeID, toAssigneeID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issueID := range issueIDs {
+		cacheKey := fmt.Sprintf("issue:%s", issueID)
+		if err := r.cache.Delete(ctx, cacheKey); err != nil {
+			logger.FromContext(ctx).Error("Failed to remove reassigned issue from cache",
+				zap.String("issue_id", issueID),
+				zap.Error(err))
+		}
+	}
+	r.invalidateIssueListCache(ctx)
+
+	return issueIDs, nil
+}
+
+// BulkUpdateStatus moves the matching issues to the new status and removes them from the cache
+func (r *CachedIssuesRepository) BulkUpdateStatus(ctx context.Context, update BulkStatusUpdate) ([]string, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.BulkUpdateStatus", attribute.String("project.id", update.ProjectID))
+	defer span.End()
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.BulkUpdateStatus")
+	issueIDs, err := r.repository.BulkUpdateStatus(repoCtx, update)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("issues.updated", len(issueIDs)))
+
+	keys := []string{projectsvc.ProjectProgressCacheKey(update.ProjectID)}
+	for _, issueID := range issueIDs {
+		keys = append(keys, fmt.Sprintf("issue:%s", issueID))
+	}
+	for _, key := range keys {
+		if err := r.cache.Delete(ctx, key); err != nil {
+			logger.FromContext(ctx).Error("Failed to invalidate cache after bulk status update",
+				zap.String("key", key),
+				zap.Error(err))
+		}
+	}
+	r.invalidateIssueListCache(ctx)
+
+	return issueIDs, nil
+}
+
+// resolutionMetricsTTL is short so metrics stay fresh without recomputing them on every request
+const resolutionMetricsTTL = 5 * time.Minute
+
+// ResolutionMetrics computes resolution time metrics, caching the result for a few minutes
+func (r *CachedIssuesRepository) ResolutionMetrics(ctx context.Context, filter ResolutionMetricsFilter) (*ResolutionMetrics, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.ResolutionMetrics")
+	defer span.End()
+
+	cacheKey := fmt.Sprintf("issues:metrics:resolution:%s:%s:%d:%d:%g",
+		filter.ProjectID, filter.Type, filter.From.Unix(), filter.To.Unix(), filter.SLOHours)
+
+	var metrics = new(ResolutionMetrics)
+	if err := r.cache.Get(ctx, cacheKey, metrics); err == nil {
+		logger.FromContext(ctx).Debug("Resolution metrics cache hit", zap.String("key", cacheKey))
+		logger.LogCacheAccess(ctx, "ResolutionMetrics", cacheKey, logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
+		return metrics, nil
+	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.ResolutionMetrics")
+	metrics, err := r.repository.ResolutionMetrics(repoCtx, filter)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogCacheAccess(ctx, "ResolutionMetrics", cacheKey, logger.FromDatabase)
+
+	if err := r.set(ctx, cacheKey, metrics, resolutionMetricsTTL); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache resolution metrics",
+			zap.String("key", cacheKey),
+			zap.Error(err))
+	}
+
+	return metrics, nil
+}
+
+// ProjectActivity counts a project's issue activity directly from the repository, so
+// digests always reflect the latest changes
+func (r *CachedIssuesRepository) ProjectActivity(ctx context.Context, window ProjectActivityWindow) (*ProjectActivity, error) {
+	return r.repository.ProjectActivity(ctx, window)
+}
+
+// projectProgressTTL keeps progress fresh for dashboards that poll it while issues change
+// status without going through the project repository
+const projectProgressTTL = 60 * time.Second
+
+// ProjectProgress counts the issues of a project by status, caching the result briefly
+func (r *CachedIssuesRepository) ProjectProgress(ctx context.Context, projectID string) (*ProjectProgress, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedIssuesRepository.ProjectProgress")
+	defer span.End()
+
+	cacheKey := projectsvc.ProjectProgressCacheKey(projectID)
+
+	var progress = new(ProjectProgress)
+	if err := r.cache.Get(ctx, cacheKey, progress); err == nil {
+		logger.FromContext(ctx).Debug("Project progress cache hit", zap.String("project_id", projectID))
+		logger.LogCacheAccess(ctx, "ProjectProgress", projectID, logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
+		return progress, nil
+	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "IssuesRepository.ProjectProgress")
+	progress, err := r.repository.ProjectProgress(repoCtx, projectID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogCacheAccess(ctx, "ProjectProgress", projectID, logger.FromDatabase)
+
+	if err := r.set(ctx, cacheKey, progress, projectProgressTTL); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache project progress",
+			zap.String("project_id", projectID),
+			zap.Error(err))
+	}
+
+	return progress, nil
 }
 
 // invalidateIssueListCache removes all cached issue list results to ensure consistency
@@ -221,7 +548,7 @@ func (r *CachedIssuesRepository) invalidateIssueListCache(ctx context.Context) {
 		// Here we're just deleting known keys directly
 		if err := r.cache.Delete(ctx, prefix); err != nil {
 			lastError = err
-			logger.ZapLogger.Debug("Failed to invalidate cache key",
+			logger.FromContext(ctx).Debug("Failed to invalidate cache key",
 				zap.String("key", prefix),
 				zap.Error(err))
 		} else {
@@ -231,11 +558,20 @@ func (r *CachedIssuesRepository) invalidateIssueListCache(ctx context.Context) {
 
 	// Log the overall outcome
 	if lastError != nil {
-		logger.ZapLogger.Error("Failed to invalidate some issue list caches",
+		logger.FromContext(ctx).Error("Failed to invalidate some issue list caches",
 			zap.Int("successful_invalidations", invalidatedCount),
 			zap.Error(lastError))
 	} else if invalidatedCount > 0 {
-		logger.ZapLogger.Debug("Successfully invalidated issue list caches",
+		logger.FromContext(ctx).Debug("Successfully invalidated issue list caches",
 			zap.Int("count", invalidatedCount))
 	}
 }
+
+// set caches value under key with ttl moved by up to 10% either way, so entries cached together
+// expire at different times. Nothing is cached when ttl is zero, which turns caching off.
+func (r *CachedIssuesRepository) set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	return r.cache.Set(ctx, key, value, cache.Jitter(ttl))
+}
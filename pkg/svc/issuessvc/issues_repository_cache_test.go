@@ -0,0 +1,205 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// ttlRecordingCache remembers the expiration each key was last stored with
+type ttlRecordingCache struct {
+	*cache.MemoryCache
+	ttls map[string]time.Duration
+}
+
+func (c *ttlRecordingCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.ttls[key] = expiration
+	return c.MemoryCache.Set(ctx, key, value, expiration)
+}
+
+// requestKey marks the context of the request a cache call is made for
+type requestKey struct{}
+
+// ctxRecordingCache records the request each cache call was made for
+type ctxRecordingCache struct {
+	*cache.MemoryCache
+	requests []any
+}
+
+func (c *ctxRecordingCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Set(ctx, key, value, expiration)
+}
+
+func (c *ctxRecordingCache) Get(ctx context.Context, key string, dest interface{}) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Get(ctx, key, dest)
+}
+
+func (c *ctxRecordingCache) Delete(ctx context.Context, keys ...string) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Delete(ctx, keys...)
+}
+
+func TestCachedIssuesRepository_AppliesIssueAndListTTLs(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	recorder := &ttlRecordingCache{MemoryCache: cache.NewMemoryCache(10), ttls: map[string]time.Duration{}}
+	cached := issuessvc.NewCachedIssuesRepository(repo, recorder, cache.CacheConfig{
+		IssueTTL:     30 * time.Second,
+		IssueListTTL: 10 * time.Minute,
+		UserTTL:      time.Hour,
+		ProjectTTL:   time.Hour,
+	})
+
+	require.NoError(t, cached.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary}))
+	_, _, err = cached.ListIssues(context.Background(), "", 10)
+	require.NoError(t, err)
+
+	// TTLs are moved by up to 10% either way
+	assert.InDelta(t, float64(30*time.Second), float64(recorder.ttls["issue:"+validIssueID]), float64(3*time.Second))
+	assert.InDelta(t, float64(10*time.Minute), float64(recorder.ttls["issues:list::10"]), float64(time.Minute))
+}
+
+func TestCachedIssuesRepository_ZeroTTLDisablesCaching(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	recorder := &ttlRecordingCache{MemoryCache: cache.NewMemoryCache(10), ttls: map[string]time.Duration{}}
+	cached := issuessvc.NewCachedIssuesRepository(repo, recorder, cache.CacheConfig{IssueTTL: time.Minute})
+	ctx := context.Background()
+
+	require.NoError(t, cached.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary}))
+	_, _, err = cached.ListIssues(ctx, "", 10)
+	require.NoError(t, err)
+	assert.NotContains(t, recorder.ttls, "issues:list::10")
+
+	// Lists are read from the repository every time, so they include issues added behind the cache
+	require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: validProjectID, Summary: bugSummary}))
+	issues, _, err := cached.ListIssues(ctx, "", 10)
+	require.NoError(t, err)
+	assert.Len(t, issues, 2)
+
+	// Single issues are still cached
+	assert.Contains(t, recorder.ttls, "issue:"+validIssueID)
+}
+
+// batchCountingRepository records the IDs passed to BatchGetIssues
+type batchCountingRepository struct {
+	issuessvc.IssuesRepository
+	requested [][]string
+}
+
+func (r *batchCountingRepository) BatchGetIssues(ctx context.Context, issueIDs []string) ([]*issuesPbv1.Issue, error) {
+	r.requested = append(r.requested, issueIDs)
+	return r.IssuesRepository.BatchGetIssues(ctx, issueIDs)
+}
+
+func TestCachedIssuesRepository_BatchGetIssuesOnlyFetchesMisses(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	memRepo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, memRepo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary}))
+	require.NoError(t, memRepo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validProjectID, Summary: bugSummary}))
+
+	repo := &batchCountingRepository{IssuesRepository: memRepo}
+	c := cache.NewMemoryCache(10)
+	cached := issuessvc.NewCachedIssuesRepository(repo, c, cache.CacheConfig{IssueTTL: time.Minute, IssueListTTL: time.Minute})
+
+	// Cache one of the issues
+	_, err = cached.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+
+	issues, err := cached.BatchGetIssues(context.Background(), []string{validProjectID, validIssueID, validUserID})
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	assert.Equal(t, validProjectID, issues[0].IssueId)
+	assert.Equal(t, validIssueID, issues[1].IssueId)
+	assert.Equal(t, [][]string{{validProjectID, validUserID}}, repo.requested)
+
+	// The fetched issue is now cached on its own
+	exists, err := c.Exists(context.Background(), "issue:"+validProjectID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	_, err = cached.BatchGetIssues(context.Background(), []string{validProjectID, validIssueID})
+	require.NoError(t, err)
+	assert.Len(t, repo.requested, 1)
+}
+
+func TestCachedIssuesRepository_PassesRequestContextToCache(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	recorder := &ctxRecordingCache{MemoryCache: cache.NewMemoryCache(10)}
+	cached := issuessvc.NewCachedIssuesRepository(repo, recorder, cache.CacheConfig{IssueTTL: time.Minute, IssueListTTL: time.Minute})
+	ctx := context.WithValue(context.Background(), requestKey{}, "request-1")
+
+	issue := &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary}
+	require.NoError(t, cached.CreateIssue(ctx, issue))
+	_, err = cached.ReadIssue(ctx, validIssueID)
+	require.NoError(t, err)
+	_, _, err = cached.ListIssues(ctx, "", 10)
+	require.NoError(t, err)
+	issue.Summary = bugSummary
+	require.NoError(t, cached.UpdateIssue(ctx, issue))
+	require.NoError(t, cached.DeleteIssue(ctx, validIssueID))
+
+	require.NotEmpty(t, recorder.requests)
+	for _, request := range recorder.requests {
+		assert.Equal(t, "request-1", request)
+	}
+}
+
+func TestCachedIssuesRepository_CachedIssueMatchesStoredIssue(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	created := time.Date(2024, 3, 10, 8, 30, 15, 123456789, time.UTC)
+	require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{
+		IssueId:     validIssueID,
+		Summary:     testSummary,
+		Status:      issuesPbv1.Status_RESOLVED,
+		Resolution:  issuesPbv1.Resolution_WONTFIX,
+		Type:        issuesPbv1.Type_FEATURE,
+		Priority:    issuesPbv1.Priority_CRITICAL,
+		ProjectId:   validProjectID,
+		CreateDate:  timestamppb.New(created),
+		ModifyDate:  timestamppb.New(created.Add(time.Hour)),
+		ResolveDate: timestamppb.New(created.Add(2 * time.Hour)),
+		DueDate:     timestamppb.New(created.Add(72 * time.Hour)),
+	}))
+
+	c := cache.NewMemoryCache(10)
+	cached := issuessvc.NewCachedIssuesRepository(repo, c, cache.CacheConfig{IssueTTL: time.Minute})
+
+	stored, err := repo.ReadIssue(ctx, validIssueID)
+	require.NoError(t, err)
+	miss, err := cached.ReadIssue(ctx, validIssueID)
+	require.NoError(t, err)
+	exists, err := c.Exists(ctx, "issue:"+validIssueID)
+	require.NoError(t, err)
+	require.True(t, exists)
+	hit, err := cached.ReadIssue(ctx, validIssueID)
+	require.NoError(t, err)
+
+	assert.True(t, proto.Equal(stored, miss), "issue read through the cache on a miss: %v", miss)
+	assert.True(t, proto.Equal(stored, hit), "issue read from the cache: %v", hit)
+	assert.Equal(t, created, hit.CreateDate.AsTime(), "timestamps keep their nanoseconds")
+}
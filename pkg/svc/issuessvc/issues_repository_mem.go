@@ -4,30 +4,60 @@ package issuessvc
 import (
 	"context"
 	"errors"
+	"sort"
+	"sync"
+	"time"
 
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
-	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
-	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 	"github.com/hashicorp/go-memdb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // IssuesRepository defines repository methods required for issue operations
 type IssuesRepository interface {
-	CreateIssue(issue *issuesPbv1.Issue) error
-	ReadIssue(issueID string) (*issuesPbv1.Issue, error)
-	UpdateIssue(issue *issuesPbv1.Issue) error
-	DeleteIssue(issueID string) error
-	ListIssues(pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error)
+	CreateIssue(ctx context.Context, issue *issuesPbv1.Issue) error
+	ReadIssue(ctx context.Context, issueID string) (*issuesPbv1.Issue, error)
+	ReadIssueByNumber(ctx context.Context, projectID string, issueNumber int32) (*issuesPbv1.Issue, error)
+	UpdateIssue(ctx context.Context, issue *issuesPbv1.Issue) error
+	DeleteIssue(ctx context.Context, issueID string) error
+	ListIssues(ctx context.Context, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error)
+	ListIssuesByProject(ctx context.Context, projectID, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error)
+	BatchGetIssues(ctx context.Context, issueIDs []string) ([]*issuesPbv1.Issue, error)
 	ValidateProjectExists(ctx context.Context, projectID string) error
 	ValidateUserExists(ctx context.Context, userID string) error
-	IsValidStatusTransition(currentStatus, newStatus issuesPbv1.Status) error
+	GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error)
+	GetProjectIssueCount(ctx context.Context, projectID string) (int32, error)
+	GetProjectMaxIssues(ctx context.Context, projectID string) (int32, error)
+	IsValidStatusTransition(ctx context.Context, currentStatus, newStatus issuesPbv1.Status) error
+	ResolutionMetrics(ctx context.Context, filter ResolutionMetricsFilter) (*ResolutionMetrics, error)
+	ProjectActivity(ctx context.Context, window ProjectActivityWindow) (*ProjectActivity, error)
+	ProjectProgress(ctx context.Context, projectID string) (*ProjectProgress, error)
+	CountOpenIssuesByAssignee(ctx context.Context, assigneeID string) (int, error)
+	FindIssuesDueSoon(ctx context.Context, within time.Duration) ([]*issuesPbv1.Issue, error)
+	ReassignOpenIssues(ctx context.Context, fromAssigneeID, toAssigneeID string) ([]string, error)
+	BulkUpdateStatus(ctx context.Context, update BulkStatusUpdate) ([]string, error)
+	MoveIssue(ctx context.Context, issueID, fromProjectID, toProjectID string) (*IssueMove, error)
+	CreateIssueLink(ctx context.Context, link *issuesPbv1.IssueLink) error
+	DeleteIssueLink(ctx context.Context, linkID string) (*issuesPbv1.IssueLink, error)
+	ListIssueLinks(ctx context.Context, issueID string) ([]*issuesPbv1.IssueLink, error)
 }
 
 // MemDBIssuesRepository is an in-memory implementation of IssuesStore
 type MemDBIssuesRepository struct {
-	db            *memdb.MemDB
-	projectClient projectPbv1.ProjectServiceClient
-	userClient    userPbv1.UserServiceClient
+	db        *memdb.MemDB
+	validator ReferenceValidator
+	projects  projectsvc.ProjectRepository
+
+	// numbersMu serializes handing out issue numbers; issueNumbers holds the last number
+	// given to an issue of each project, so numbers of deleted issues aren't reused
+	numbersMu    sync.Mutex
+	issueNumbers map[string]int32
 }
 
 // CreateIssuesMemDBSchema defines the schema for the in-memory database
@@ -42,17 +72,60 @@ func CreateIssuesMemDBSchema() *memdb.DBSchema {
 						Unique:  true,
 						Indexer: &memdb.StringFieldIndex{Field: "IssueId"},
 					},
+					"project_id": {
+						Name:         "project_id",
+						AllowMissing: true,
+						Indexer:      &memdb.StringFieldIndex{Field: "ProjectId"},
+					},
+					"assignee": {
+						Name:         "assignee",
+						AllowMissing: true,
+						Indexer:      &memdb.StringFieldIndex{Field: "AssigneeId"},
+					},
+					"project_number": {
+						Name:         "project_number",
+						AllowMissing: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "ProjectId"},
+								&memdb.IntFieldIndex{Field: "IssueNumber"},
+							},
+						},
+					},
+				},
+			},
+			"issue_link": {
+				Name: "issue_link",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "LinkId"},
+					},
+					"source": {
+						Name:    "source",
+						Indexer: &memdb.StringFieldIndex{Field: "SourceIssueId"},
+					},
+					"target": {
+						Name:    "target",
+						Indexer: &memdb.StringFieldIndex{Field: "TargetIssueId"},
+					},
 				},
 			},
 		},
 	}
 }
 
-// SetClients configures the repository with project and user service clients
-// after initialization for cross-service validation
-func (r *MemDBIssuesRepository) SetClients(projectClient projectPbv1.ProjectServiceClient, userClient userPbv1.UserServiceClient) {
-	r.projectClient = projectClient
-	r.userClient = userClient
+// SetClients configures the repository after initialization with the validator used for
+// cross-service validation, either a ClientValidator or a LocalValidator
+func (r *MemDBIssuesRepository) SetClients(validator ReferenceValidator) {
+	r.validator = validator
+}
+
+// SetProjectRepository configures the repository with the project repository whose
+// issue counts MoveIssue keeps in sync
+func (r *MemDBIssuesRepository) SetProjectRepository(projects projectsvc.ProjectRepository) {
+	r.projects = projects
 }
 
 // NewMemDBIssuesRepositoryWithoutClients creates a new repository without clients
@@ -63,19 +136,38 @@ func NewMemDBIssuesRepositoryWithoutClients() (*MemDBIssuesRepository, error) {
 	}
 
 	return &MemDBIssuesRepository{
-		db: db,
+		db:           db,
+		issueNumbers: make(map[string]int32),
 	}, nil
 }
 
-// CreateIssue adds a new issue to the repository
-func (r *MemDBIssuesRepository) CreateIssue(issue *issuesPbv1.Issue) error {
+// CreateIssue adds a new issue to the repository, numbering it after the last issue of its project
+func (r *MemDBIssuesRepository) CreateIssue(_ context.Context, issue *issuesPbv1.Issue) error {
+	if issue.Version == 0 {
+		issue.Version = 1
+	}
+
+	r.numbersMu.Lock()
+	defer r.numbersMu.Unlock()
+
+	// Store a copy so later changes by the caller can't bypass the version check in UpdateIssue
+	stored := proto.Clone(issue).(*issuesPbv1.Issue)
+	stored.IssueNumber = r.issueNumbers[issue.ProjectId] + 1
+
 	txn := r.db.Txn(true)
-	defer txn.Commit()
-	return txn.Insert("issue", issue)
+	if err := txn.Insert("issue", stored); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+
+	r.issueNumbers[issue.ProjectId] = stored.IssueNumber
+	issue.IssueNumber = stored.IssueNumber
+	return nil
 }
 
 // ReadIssue retrieves an issue by its ID
-func (r *MemDBIssuesRepository) ReadIssue(issueID string) (*issuesPbv1.Issue, error) {
+func (r *MemDBIssuesRepository) ReadIssue(_ context.Context, issueID string) (*issuesPbv1.Issue, error) {
 	txn := r.db.Txn(false)
 	defer txn.Abort()
 
@@ -84,36 +176,91 @@ func (r *MemDBIssuesRepository) ReadIssue(issueID string) (*issuesPbv1.Issue, er
 		return nil, err
 	}
 	if raw == nil {
-		return nil, errors.New("issue not found")
+		return nil, consts.ErrIssueNotFound
+	}
+	return proto.Clone(raw.(*issuesPbv1.Issue)).(*issuesPbv1.Issue), nil
+}
+
+// ReadIssueByNumber retrieves an issue by its project and issue number
+func (r *MemDBIssuesRepository) ReadIssueByNumber(_ context.Context, projectID string, issueNumber int32) (*issuesPbv1.Issue, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("issue", "project_number", projectID, issueNumber)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, consts.ErrIssueNotFound
 	}
-	return raw.(*issuesPbv1.Issue), nil
+	return proto.Clone(raw.(*issuesPbv1.Issue)).(*issuesPbv1.Issue), nil
 }
 
-// UpdateIssue updates an existing issue in the repository
-func (r *MemDBIssuesRepository) UpdateIssue(issue *issuesPbv1.Issue) error {
+// UpdateIssue updates an existing issue in the repository. The write is rejected with
+// consts.ErrIssueVersionConflict unless issue.Version matches the stored version;
+// on success issue.Version is incremented.
+func (r *MemDBIssuesRepository) UpdateIssue(_ context.Context, issue *issuesPbv1.Issue) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
-	return txn.Insert("issue", issue)
+
+	raw, err := txn.First("issue", "id", issue.IssueId)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if raw == nil {
+		txn.Abort()
+		return consts.ErrIssueNotFound
+	}
+	if raw.(*issuesPbv1.Issue).Version != issue.Version {
+		txn.Abort()
+		return consts.ErrIssueVersionConflict
+	}
+
+	updated := proto.Clone(issue).(*issuesPbv1.Issue)
+	updated.IssueNumber = raw.(*issuesPbv1.Issue).IssueNumber
+	updated.Version++
+	if err := txn.Insert("issue", updated); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+
+	issue.Version = updated.Version
+	return nil
 }
 
 // DeleteIssue removes an issue from the repository
-func (r *MemDBIssuesRepository) DeleteIssue(issueID string) error {
+func (r *MemDBIssuesRepository) DeleteIssue(_ context.Context, issueID string) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
 
 	raw, err := txn.First("issue", "id", issueID)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if raw == nil {
-		return errors.New("issue not found")
+		txn.Abort()
+		return consts.ErrIssueNotFound
+	}
+
+	if err := txn.Delete("issue", raw); err != nil {
+		txn.Abort()
+		return err
 	}
 
-	return txn.Delete("issue", raw)
+	// Delete the links from and to the issue
+	for _, index := range []string{"source", "target"} {
+		if _, err := txn.DeleteAll("issue_link", index, issueID); err != nil {
+			txn.Abort()
+			return err
+		}
+	}
+	txn.Commit()
+	return nil
 }
 
 // ListIssues retrieves a paginated list of issues
-func (r *MemDBIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
+func (r *MemDBIssuesRepository) ListIssues(_ context.Context, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
 	txn := r.db.Txn(false)
 	defer txn.Abort()
 
@@ -127,32 +274,459 @@ func (r *MemDBIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*i
 		issues = append(issues, obj.(*issuesPbv1.Issue))
 	}
 
-	issuesPage, nextPageToken := paginateIssues(issues, pageSize, pageToken)
+	issuesPage, nextPageToken, err := paginateIssues(issues, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Return copies so callers can't modify the stored issues
+	for i, issue := range issuesPage {
+		issuesPage[i] = proto.Clone(issue).(*issuesPbv1.Issue)
+	}
 	return issuesPage, nextPageToken, nil
 }
 
-// ValidateProjectExists checks if a project with the given ID exists
-func (r *MemDBIssuesRepository) ValidateProjectExists(ctx context.Context, projectID string) error {
-	// Use the ProjectServiceClient to validate if the project ID exists
-	_, err := r.projectClient.GetProject(ctx, &projectPbv1.GetProjectRequest{ProjectId: projectID})
+// ListIssuesByProject retrieves a paginated list of a project's issues from the project_id
+// index, without reading the issues of other projects
+func (r *MemDBIssuesRepository) ListIssuesByProject(_ context.Context, projectID, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	// Entries of a non-unique index are ordered by the issue ID within each project
+	it, err := txn.Get("issue", "project_id", projectID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var issues []*issuesPbv1.Issue
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		issues = append(issues, obj.(*issuesPbv1.Issue))
+	}
+
+	issuesPage, nextPageToken, err := paginateIssues(issues, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Return copies so callers can't modify the stored issues
+	for i, issue := range issuesPage {
+		issuesPage[i] = proto.Clone(issue).(*issuesPbv1.Issue)
+	}
+	return issuesPage, nextPageToken, nil
+}
+
+// BatchGetIssues retrieves the issues with the given IDs from a single read transaction, in the
+// order of the IDs. IDs without an issue are skipped.
+func (r *MemDBIssuesRepository) BatchGetIssues(_ context.Context, issueIDs []string) ([]*issuesPbv1.Issue, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	issues := make([]*issuesPbv1.Issue, 0, len(issueIDs))
+	for _, issueID := range issueIDs {
+		raw, err := txn.First("issue", "id", issueID)
+		if err != nil {
+			return nil, err
+		}
+		if raw != nil {
+			issues = append(issues, proto.Clone(raw.(*issuesPbv1.Issue)).(*issuesPbv1.Issue))
+		}
+	}
+	return issues, nil
+}
+
+// ResolutionMetrics computes resolution time metrics from the resolved issues matching the filter
+func (r *MemDBIssuesRepository) ResolutionMetrics(_ context.Context, filter ResolutionMetricsFilter) (*ResolutionMetrics, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("issue", "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var hours []float64
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		issue := obj.(*issuesPbv1.Issue)
+		if !filter.matches(issue) {
+			continue
+		}
+		hours = append(hours, issue.ResolveDate.AsTime().Sub(issue.CreateDate.AsTime()).Hours())
+	}
+
+	return computeResolutionMetrics(hours, filter.SLOHours), nil
+}
+
+// ProjectActivity counts the activity of a project's issues within the window
+func (r *MemDBIssuesRepository) ProjectActivity(_ context.Context, window ProjectActivityWindow) (*ProjectActivity, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("issue", "project_id", window.ProjectID)
 	if err != nil {
-		return errors.New("project ID does not exist or could not be validated")
+		return nil, err
 	}
+
+	activity := &ProjectActivity{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		window.count(activity, obj.(*issuesPbv1.Issue))
+	}
+	return activity, nil
+}
+
+// ProjectProgress counts the issues of a project by status
+func (r *MemDBIssuesRepository) ProjectProgress(_ context.Context, projectID string) (*ProjectProgress, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("issue", "project_id", projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	byStatus := make(map[string]int32)
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		byStatus[obj.(*issuesPbv1.Issue).Status.String()]++
+	}
+	return newProjectProgress(byStatus), nil
+}
+
+// CountOpenIssuesByAssignee counts the issues assigned to a user that aren't closed
+func (r *MemDBIssuesRepository) CountOpenIssuesByAssignee(_ context.Context, assigneeID string) (int, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("issue", "assignee", assigneeID)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if obj.(*issuesPbv1.Issue).Status != issuesPbv1.Status_CLOSED {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// FindIssuesDueSoon returns the assigned issues that are neither resolved nor closed and are
+// due within the given time from now, soonest first
+func (r *MemDBIssuesRepository) FindIssuesDueSoon(_ context.Context, within time.Duration) ([]*issuesPbv1.Issue, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("issue", "id")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	until := now.Add(within)
+	var issues []*issuesPbv1.Issue
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		issue := obj.(*issuesPbv1.Issue)
+		if issue.AssigneeId == "" || issue.DueDate == nil ||
+			issue.Status == issuesPbv1.Status_RESOLVED || issue.Status == issuesPbv1.Status_CLOSED {
+			continue
+		}
+		if due := issue.DueDate.AsTime(); !due.Before(now) && !due.After(until) {
+			issues = append(issues, issue)
+		}
+	}
+	// Issues are read in ID order, so issues due at the same time stay in ID order
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].DueDate.AsTime().Before(issues[j].DueDate.AsTime())
+	})
+	return issues, nil
+}
+
+// ReassignOpenIssues assigns the issues of fromAssigneeID that aren't closed to toAssigneeID
+// within a single write transaction and returns their IDs
+func (r *MemDBIssuesRepository) ReassignOpenIssues(_ context.Context, fromAssigneeID, toAssigneeID string) ([]string, error) {
+	txn := r.db.Txn(true)
+
+	it, err := txn.Get("issue", "assignee", fromAssigneeID)
+	if err != nil {
+		txn.Abort()
+		return nil, err
+	}
+
+	// Collect the issues first, since the iterator must not see the writes
+	var open []*issuesPbv1.Issue
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if issue := obj.(*issuesPbv1.Issue); issue.Status != issuesPbv1.Status_CLOSED {
+			open = append(open, issue)
+		}
+	}
+
+	issueIDs := make([]string, 0, len(open))
+	for _, issue := range open {
+		updated := proto.Clone(issue).(*issuesPbv1.Issue)
+		updated.AssigneeId = toAssigneeID
+		updated.ModifyDate = timestamppb.Now()
+		updated.Version++
+		if err := txn.Insert("issue", updated); err != nil {
+			txn.Abort()
+			return nil, err
+		}
+		issueIDs = append(issueIDs, updated.IssueId)
+	}
+	txn.Commit()
+	return issueIDs, nil
+}
+
+// BulkUpdateStatus moves the matching issues to the new status in one write transaction and
+// returns their IDs
+func (r *MemDBIssuesRepository) BulkUpdateStatus(_ context.Context, update BulkStatusUpdate) ([]string, error) {
+	txn := r.db.Txn(true)
+
+	it, err := txn.Get("issue", "project_id", update.ProjectID)
+	if err != nil {
+		txn.Abort()
+		return nil, err
+	}
+
+	// Collect the issues first, since the iterator must not see the writes
+	var matching []*issuesPbv1.Issue
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if issue := obj.(*issuesPbv1.Issue); update.matches(issue) {
+			matching = append(matching, issue)
+		}
+	}
+
+	now := timestamppb.Now()
+	issueIDs := make([]string, 0, len(matching))
+	for _, issue := range matching {
+		if update.resolves() {
+			blocked, err := hasOpenBlockers(txn, issue.IssueId)
+			if err != nil {
+				txn.Abort()
+				return nil, err
+			}
+			if blocked {
+				continue
+			}
+		}
+
+		updated := proto.Clone(issue).(*issuesPbv1.Issue)
+		updated.Status = update.ToStatus
+		if update.resolves() && updated.ResolveDate == nil {
+			updated.ResolveDate = now
+		}
+		if update.Resolution != issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
+			updated.Resolution = update.Resolution
+			updated.ResolutionComment = update.ResolutionComment
+			updated.DuplicateOf = ""
+		}
+		updated.ModifyDate = now
+		updated.Version++
+		if err := txn.Insert("issue", updated); err != nil {
+			txn.Abort()
+			return nil, err
+		}
+		issueIDs = append(issueIDs, updated.IssueId)
+	}
+	txn.Commit()
+	return issueIDs, nil
+}
+
+// hasOpenBlockers reports whether an issue is the target of a BLOCKS link from an issue that
+// isn't resolved or closed. Links from deleted issues are removed with them.
+func hasOpenBlockers(txn *memdb.Txn, issueID string) (bool, error) {
+	it, err := txn.Get("issue_link", "target", issueID)
+	if err != nil {
+		return false, err
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		link := obj.(*issuesPbv1.IssueLink)
+		if link.Type != issuesPbv1.LinkType_BLOCKS {
+			continue
+		}
+		raw, err := txn.First("issue", "id", link.SourceIssueId)
+		if err != nil {
+			return false, err
+		}
+		if raw == nil {
+			continue
+		}
+		if blocker := raw.(*issuesPbv1.Issue); blocker.Status != issuesPbv1.Status_RESOLVED && blocker.Status != issuesPbv1.Status_CLOSED {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MoveIssue moves an issue from one project to another within a single write transaction.
+// The project repository lives in its own MemDB, so if adding the issue to the target project
+// fails, the removal from the source project is undone before the issue write is aborted.
+func (r *MemDBIssuesRepository) MoveIssue(ctx context.Context, issueID, fromProjectID, toProjectID string) (*IssueMove, error) {
+	if r.projects == nil {
+		return nil, ErrMoveNotConfigured
+	}
+
+	r.numbersMu.Lock()
+	defer r.numbersMu.Unlock()
+
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("issue", "id", issueID)
+	if err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	if raw == nil {
+		txn.Abort()
+		return nil, consts.ErrIssueNotFound
+	}
+	if raw.(*issuesPbv1.Issue).ProjectId != fromProjectID {
+		txn.Abort()
+		return nil, consts.ErrIssueNotInProject
+	}
+	if _, err := r.projects.ReadProject(ctx, toProjectID); err != nil {
+		txn.Abort()
+		return nil, consts.ErrProjectNotFound
+	}
+
+	moved := proto.Clone(raw.(*issuesPbv1.Issue)).(*issuesPbv1.Issue)
+	moved.ProjectId = toProjectID
+	// The issue takes the next number of the project it moves to
+	moved.IssueNumber = r.issueNumbers[toProjectID] + 1
+	moved.ModifyDate = timestamppb.Now()
+	moved.Version++
+	if err := txn.Insert("issue", moved); err != nil {
+		txn.Abort()
+		return nil, err
+	}
+
+	if err := r.projects.RemoveIssueFromProject(ctx, fromProjectID, issueID); err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	if err := r.projects.AddIssueToProject(ctx, toProjectID, issueID); err != nil {
+		if undoErr := r.projects.AddIssueToProject(ctx, fromProjectID, issueID); undoErr != nil {
+			logger.ZapLogger.Error("Failed to restore issue to its project after a failed move",
+				zap.String("issue_id", issueID),
+				zap.String("project_id", fromProjectID),
+				zap.Error(undoErr))
+		}
+		txn.Abort()
+		return nil, err
+	}
+	txn.Commit()
+	r.issueNumbers[toProjectID] = moved.IssueNumber
+
+	fromProject, err := r.projects.ReadProject(ctx, fromProjectID)
+	if err != nil {
+		return nil, err
+	}
+	toProject, err := r.projects.ReadProject(ctx, toProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return &IssueMove{Issue: proto.Clone(moved).(*issuesPbv1.Issue), FromProject: fromProject, ToProject: toProject}, nil
+}
+
+// CreateIssueLink adds a new issue link to the repository
+func (r *MemDBIssuesRepository) CreateIssueLink(_ context.Context, link *issuesPbv1.IssueLink) error {
+	txn := r.db.Txn(true)
+	if err := txn.Insert("issue_link", proto.Clone(link)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
 	return nil
 }
 
+// DeleteIssueLink removes an issue link from the repository and returns it
+func (r *MemDBIssuesRepository) DeleteIssueLink(_ context.Context, linkID string) (*issuesPbv1.IssueLink, error) {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("issue_link", "id", linkID)
+	if err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	if raw == nil {
+		txn.Abort()
+		return nil, consts.ErrIssueLinkNotFound
+	}
+
+	if err := txn.Delete("issue_link", raw); err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	txn.Commit()
+	return raw.(*issuesPbv1.IssueLink), nil
+}
+
+// ListIssueLinks retrieves the links from and to an issue, as stored
+func (r *MemDBIssuesRepository) ListIssueLinks(_ context.Context, issueID string) ([]*issuesPbv1.IssueLink, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	var links []*issuesPbv1.IssueLink
+	for _, index := range []string{"source", "target"} {
+		it, err := txn.Get("issue_link", index, issueID)
+		if err != nil {
+			return nil, err
+		}
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			links = append(links, proto.Clone(obj.(*issuesPbv1.IssueLink)).(*issuesPbv1.IssueLink))
+		}
+	}
+	return links, nil
+}
+
+// ValidateProjectExists checks if a project with the given ID exists
+func (r *MemDBIssuesRepository) ValidateProjectExists(ctx context.Context, projectID string) error {
+	if r.validator == nil {
+		return ErrValidationNotConfigured
+	}
+	return r.validator.ValidateProjectExists(ctx, projectID)
+}
+
 // ValidateUserExists checks if a user with the given ID exists
 func (r *MemDBIssuesRepository) ValidateUserExists(ctx context.Context, userID string) error {
-	// Use the UserServiceClient to validate if the user ID exists
-	_, err := r.userClient.GetUser(ctx, &userPbv1.GetUserRequest{UserId: userID})
+	if r.validator == nil {
+		return ErrValidationNotConfigured
+	}
+	return r.validator.ValidateUserExists(ctx, userID)
+}
+
+// GetProjectMemberRole retrieves the role of a user in a project
+func (r *MemDBIssuesRepository) GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error) {
+	if r.validator == nil {
+		return projectsvc.RoleUnspecified, ErrValidationNotConfigured
+	}
+	return r.validator.GetProjectMemberRole(ctx, projectID, userID)
+}
+
+// GetProjectIssueCount returns the issue count the project service keeps for the project
+func (r *MemDBIssuesRepository) GetProjectIssueCount(ctx context.Context, projectID string) (int32, error) {
+	if r.validator == nil {
+		return 0, ErrValidationNotConfigured
+	}
+	project, err := r.validator.ReadProject(ctx, projectID)
 	if err != nil {
-		return errors.New("user ID does not exist or could not be validated")
+		return 0, err
 	}
-	return nil
+	return project.IssueCount, nil
+}
+
+// GetProjectMaxIssues returns the most issues the project may have, 0 meaning unlimited
+func (r *MemDBIssuesRepository) GetProjectMaxIssues(ctx context.Context, projectID string) (int32, error) {
+	if r.validator == nil {
+		return 0, ErrValidationNotConfigured
+	}
+	project, err := r.validator.ReadProject(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	return project.MaxIssues, nil
 }
 
 // IsValidStatusTransition validates whether a status transition is allowed
-func (r *MemDBIssuesRepository) IsValidStatusTransition(currentStatus, newStatus issuesPbv1.Status) error {
+func (r *MemDBIssuesRepository) IsValidStatusTransition(_ context.Context, currentStatus, newStatus issuesPbv1.Status) error {
 	validTransitions := map[issuesPbv1.Status][]issuesPbv1.Status{
 		issuesPbv1.Status_NEW:         {issuesPbv1.Status_ASSIGNED},
 		issuesPbv1.Status_ASSIGNED:    {issuesPbv1.Status_IN_PROGRESS, issuesPbv1.Status_RESOLVED},
@@ -179,16 +753,16 @@ func (r *MemDBIssuesRepository) IsValidStatusTransition(currentStatus, newStatus
 	return errors.New("invalid status transition")
 }
 
-// Pagination Helper
-func paginateIssues(issues []*issuesPbv1.Issue, pageSize int, pageToken string) ([]*issuesPbv1.Issue, string) {
+// paginateIssues returns the page of issues, which are ordered by ID, that follows pageToken
+func paginateIssues(issues []*issuesPbv1.Issue, pageSize int, pageToken string) ([]*issuesPbv1.Issue, string, error) {
 	startIndex := 0
 	if pageToken != "" {
-		for i, issue := range issues {
-			if issue.IssueId == pageToken {
-				startIndex = i + 1
-				break
-			}
+		token, err := pagination.Decode(pageToken, pagination.KindIssue, pagination.SortByID)
+		if err != nil {
+			return nil, "", err
 		}
+		// The page continues after the token's issue even if that issue was deleted since
+		startIndex = sort.Search(len(issues), func(i int) bool { return issues[i].IssueId > token.ID })
 	}
 
 	endIndex := startIndex + pageSize
@@ -198,8 +772,8 @@ func paginateIssues(issues []*issuesPbv1.Issue, pageSize int, pageToken string)
 
 	var nextPageToken string
 	if endIndex < len(issues) {
-		nextPageToken = issues[endIndex-1].IssueId
+		nextPageToken = pagination.Token{Kind: pagination.KindIssue, Sort: pagination.SortByID, ID: issues[endIndex-1].IssueId}.Encode()
 	}
 
-	return issues[startIndex:endIndex], nextPageToken
+	return issues[startIndex:endIndex], nextPageToken, nil
 }
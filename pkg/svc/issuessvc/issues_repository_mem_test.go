@@ -0,0 +1,209 @@
+package issuessvc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+func TestMemDBIssuesRepository_UpdateIssueRejectsStaleVersion(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	issue := &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, Status: issuesPbv1.Status_NEW}
+	require.NoError(t, repo.CreateIssue(context.Background(), issue))
+	assert.Equal(t, int64(1), issue.Version)
+
+	// Two clients read the same version
+	first, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+	second, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+
+	first.Summary = "First edit"
+	require.NoError(t, repo.UpdateIssue(context.Background(), first))
+	assert.Equal(t, int64(2), first.Version)
+
+	// The second write was based on version 1 and must not overwrite the first
+	second.Summary = "Second edit"
+	assert.ErrorIs(t, repo.UpdateIssue(context.Background(), second), consts.ErrIssueVersionConflict)
+
+	stored, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+	assert.Equal(t, "First edit", stored.Summary)
+	assert.Equal(t, int64(2), stored.Version)
+}
+
+func TestMemDBIssuesRepository_ReadIssueReturnsCopy(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary}))
+
+	issue, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+	issue.Summary = "Changed without saving"
+
+	stored, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+	assert.Equal(t, testSummary, stored.Summary)
+}
+
+func TestMemDBIssuesRepository_UpdateIssueNotFound(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	err = repo.UpdateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Version: 1})
+	assert.ErrorIs(t, err, consts.ErrIssueNotFound)
+}
+
+func TestMemDBIssuesRepository_ListIssuesReturnsCopies(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary}))
+
+	issues, _, err := repo.ListIssues(context.Background(), "", 10)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	issues[0].Summary = "Changed without saving"
+	issues[0].Status = issuesPbv1.Status_CLOSED
+
+	stored, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+	assert.Equal(t, testSummary, stored.Summary)
+	assert.Equal(t, issuesPbv1.Status_STATUS_UNSPECIFIED, stored.Status)
+}
+
+func TestMemDBIssuesRepository_ListIssuesPaginates(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	ctx := context.Background()
+	ids := []string{
+		"a0000000-0000-4000-8000-000000000000",
+		"b0000000-0000-4000-8000-000000000000",
+		"c0000000-0000-4000-8000-000000000000",
+	}
+	for _, id := range ids {
+		require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{IssueId: id, Summary: testSummary}))
+	}
+
+	issues, next, err := repo.ListIssues(ctx, "", 2)
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	require.NotEmpty(t, next)
+
+	// The page continues after the last issue of the previous page even once it is deleted
+	require.NoError(t, repo.DeleteIssue(ctx, ids[1]))
+	issues, next, err = repo.ListIssues(ctx, next, 2)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, ids[2], issues[0].IssueId)
+	assert.Empty(t, next)
+
+	// Issue IDs were page tokens before tokens were typed; they fail instead of starting over
+	_, _, err = repo.ListIssues(ctx, ids[0], 2)
+	assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
+
+func TestMemDBIssuesRepository_ValidateWithoutClients(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, repo.ValidateProjectExists(context.Background(), validProjectID), issuessvc.ErrValidationNotConfigured)
+	assert.ErrorIs(t, repo.ValidateUserExists(context.Background(), validUserID), issuessvc.ErrValidationNotConfigured)
+
+	// Creating an issue reports the configuration problem instead of panicking or blaming the project
+	logger.ZapLogger = zap.NewNop()
+	service := issuessvc.NewIssuesService(repo, nil, nil)
+	_, err = service.CreateIssue(context.Background(), &issuesPbv1.CreateIssueRequest{
+		Summary:   testSummary,
+		Type:      issuesPbv1.Type_BUG,
+		Priority:  issuesPbv1.Priority_MINOR,
+		ProjectId: validProjectID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestMemDBIssuesRepository_ValidateWithLocalValidator(t *testing.T) {
+	projectRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	userRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	require.NoError(t, projectRepo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: validProjectID, Name: "Project"}))
+	require.NoError(t, userRepo.CreateUser(context.Background(), &userPbv1.User{UserId: validUserID, EmailAddress: "user@example.com", Active: true}))
+
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	repo.SetClients(issuessvc.NewLocalValidator(projectRepo, userRepo))
+
+	ctx := context.Background()
+	assert.NoError(t, repo.ValidateProjectExists(ctx, validProjectID))
+	assert.NoError(t, repo.ValidateUserExists(ctx, validUserID))
+	assert.Error(t, repo.ValidateProjectExists(ctx, "missing-project"))
+	assert.Error(t, repo.ValidateUserExists(ctx, "missing-user"))
+
+	_, err = userRepo.SetUserActive(ctx, validUserID, false)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.ValidateUserExists(ctx, validUserID), consts.ErrUserDeactivated)
+}
+
+// BenchmarkMemDBIssuesRepository_ListIssuesByProject compares listing a project's issues from
+// the project_id index with reading every issue and keeping the project's, with 10,000 issues
+// spread over 100 projects
+func BenchmarkMemDBIssuesRepository_ListIssuesByProject(b *testing.B) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(b, err)
+	ctx := context.Background()
+
+	const issueCount, projectCount = 10000, 100
+	projectIDs := make([]string, projectCount)
+	for i := range projectIDs {
+		projectIDs[i] = fmt.Sprintf("00000000-0000-4000-8000-%012d", i)
+	}
+	for i := 0; i < issueCount; i++ {
+		require.NoError(b, repo.CreateIssue(ctx, &issuesPbv1.Issue{
+			IssueId:   fmt.Sprintf("10000000-0000-4000-8000-%012d", i),
+			Summary:   testSummary,
+			ProjectId: projectIDs[i%projectCount],
+		}))
+	}
+	projectID := projectIDs[projectCount/2]
+
+	b.Run("Scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			all, _, err := repo.ListIssues(ctx, "", issueCount)
+			require.NoError(b, err)
+			var issues []*issuesPbv1.Issue
+			for _, issue := range all {
+				if issue.ProjectId == projectID {
+					issues = append(issues, issue)
+				}
+			}
+			require.Len(b, issues, issueCount/projectCount)
+		}
+	})
+
+	b.Run("Index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			issues, _, err := repo.ListIssuesByProject(ctx, projectID, "", issueCount)
+			require.NoError(b, err)
+			require.Len(b, issues, issueCount/projectCount)
+		}
+	})
+}
@@ -3,14 +3,22 @@ package issuessvc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/models"
+	"github.com/yasindce1998/issue-tracker/pkg/auth"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// PostgresIssuesRepository implements IssuesRepository using GORM for PostgreSQL
+// PostgresIssuesRepository implements IssuesRepository using GORM for PostgreSQL.
+// It also backs the SQLite database type.
 type PostgresIssuesRepository struct {
 	db *gorm.DB
 }
@@ -20,78 +28,126 @@ func NewPostgresIssuesRepository(db *gorm.DB) *PostgresIssuesRepository {
 	return &PostgresIssuesRepository{db: db}
 }
 
-// CreateIssue adds a new issue to the database
-func (r *PostgresIssuesRepository) CreateIssue(issue *issuesPbv1.Issue) error {
+// CreateIssue adds a new issue to the database, numbering it after the last issue of its project
+func (r *PostgresIssuesRepository) CreateIssue(ctx context.Context, issue *issuesPbv1.Issue) error {
 	// Convert protobuf issue to model
 	dbIssue := &models.Issues{
-		IssueID:     issue.IssueId,
-		Summary:     issue.Summary,
-		Description: issue.Description,
-		Status:      issue.Status.String(),
-		Resolution:  issue.Resolution.String(),
-		Type:        issue.Type.String(),
-		Priority:    issue.Priority.String(),
-		ProjectID:   issue.ProjectId,
-		AssigneeID:  &issue.AssigneeId,
+		IssueID:           issue.IssueId,
+		Summary:           issue.Summary,
+		Description:       issue.Description,
+		Status:            issue.Status.String(),
+		Resolution:        issue.Resolution.String(),
+		Type:              issue.Type.String(),
+		Priority:          issue.Priority.String(),
+		ProjectID:         issue.ProjectId,
+		AssigneeID:        optionalID(issue.AssigneeId),
+		DuplicateOf:       optionalID(issue.DuplicateOf),
+		ClonedFrom:        optionalID(issue.ClonedFrom),
+		TeamID:            optionalID(issue.TeamId),
+		ResolutionComment: issue.ResolutionComment,
+		ReopenCount:       issue.ReopenCount,
+		LastReopenReason:  issue.LastReopenReason,
+		LastReopenedBy:    optionalID(issue.LastReopenedBy),
+		ResolveDate:       optionalTime(issue.ResolveDate),
+		DueDate:           optionalTime(issue.DueDate),
+		Version:           issue.Version,
 	}
+	// Keep the dates the caller set; unset dates are filled in by GORM
+	if issue.CreateDate != nil {
+		dbIssue.CreateDate = issue.CreateDate.AsTime()
+	}
+	if issue.ModifyDate != nil {
+		dbIssue.ModifyDate = issue.ModifyDate.AsTime()
+	}
+	if dbIssue.Version == 0 {
+		dbIssue.Version = 1
+	}
+	if actorID := auth.ActorIDFromContext(ctx); actorID != "" {
+		dbIssue.CreatedByUserID = &actorID
+		dbIssue.LastModifiedByUserID = &actorID
+	}
+
+	// Number and save the issue in one transaction so concurrent creates can't take the same number
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		number, err := nextIssueNumber(tx, issue.ProjectId)
+		if err != nil {
+			return err
+		}
+		dbIssue.IssueNumber = number
+		return tx.Create(dbIssue).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	issue.IssueNumber = dbIssue.IssueNumber
+	// Report the stored version back, as the in-memory repository does, so cached copies of the
+	// new issue can be updated without a version conflict
+	issue.Version = dbIssue.Version
+	return nil
+}
+
+// recordActor adds the user making the call, if any, to the changes of an issue update as its
+// last modifier; writes made without a user, such as those of background jobs, keep the recorded one
+func recordActor(ctx context.Context, changes map[string]interface{}) {
+	if actorID := auth.ActorIDFromContext(ctx); actorID != "" {
+		changes["last_modified_by_user_id"] = actorID
+	}
+}
 
-	// Save to database
-	return r.db.Create(dbIssue).Error
+// nextIssueNumber returns the number for the next issue of a project. The project row is locked
+// for the rest of the transaction, which serializes numbering within the project, and deleted
+// issues are counted so their numbers aren't handed out again.
+func nextIssueNumber(tx *gorm.DB, projectID string) (int32, error) {
+	var project models.Project
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("project_id").Where("project_id = ?", projectID).Limit(1).
+		Find(&project).Error; err != nil {
+		return 0, err
+	}
+
+	var last int32
+	if err := tx.Unscoped().Model(&models.Issues{}).
+		Where("project_id = ?", projectID).
+		Select("COALESCE(MAX(issue_number), 0)").
+		Scan(&last).Error; err != nil {
+		return 0, err
+	}
+	return last + 1, nil
 }
 
 // ReadIssue retrieves an issue by its ID
-func (r *PostgresIssuesRepository) ReadIssue(issueID string) (*issuesPbv1.Issue, error) {
+func (r *PostgresIssuesRepository) ReadIssue(ctx context.Context, issueID string) (*issuesPbv1.Issue, error) {
 	var dbIssue models.Issues
-	if err := r.db.First(&dbIssue, "issue_id = ?", issueID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&dbIssue, "issue_id = ?", issueID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, consts.ErrIssueNotFound
 		}
 		return nil, err
 	}
 
-	// Convert model to protobuf issue
-	var assigneeID string
-	if dbIssue.AssigneeID != nil {
-		assigneeID = *dbIssue.AssigneeID
-	}
-
-	// Parse enum values
-	var status issuesPbv1.Status
-	var resolution issuesPbv1.Resolution
-	var issueType issuesPbv1.Type
-	var priority issuesPbv1.Priority
-
-	// Convert string status to enum (this would need proper validation)
-	statusValue := issuesPbv1.Status_value[dbIssue.Status]
-	status = issuesPbv1.Status(statusValue)
-
-	resolutionValue := issuesPbv1.Resolution_value[dbIssue.Resolution]
-	resolution = issuesPbv1.Resolution(resolutionValue)
-
-	typeValue := issuesPbv1.Type_value[dbIssue.Type]
-	issueType = issuesPbv1.Type(typeValue)
+	return convertIssueToPb(dbIssue), nil
+}
 
-	priorityValue := issuesPbv1.Priority_value[dbIssue.Priority]
-	priority = issuesPbv1.Priority(priorityValue)
+// ReadIssueByNumber retrieves an issue by its project and issue number
+func (r *PostgresIssuesRepository) ReadIssueByNumber(ctx context.Context, projectID string, issueNumber int32) (*issuesPbv1.Issue, error) {
+	var dbIssue models.Issues
+	if err := r.db.WithContext(ctx).First(&dbIssue, "project_id = ? AND issue_number = ?", projectID, issueNumber).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, consts.ErrIssueNotFound
+		}
+		return nil, err
+	}
 
-	return &issuesPbv1.Issue{
-		IssueId:     dbIssue.IssueID,
-		Summary:     dbIssue.Summary,
-		Description: dbIssue.Description,
-		Status:      status,
-		Resolution:  resolution,
-		Type:        issueType,
-		Priority:    priority,
-		ProjectId:   dbIssue.ProjectID,
-		AssigneeId:  assigneeID,
-	}, nil
+	return convertIssueToPb(dbIssue), nil
 }
 
-// UpdateIssue updates an existing issue
-func (r *PostgresIssuesRepository) UpdateIssue(issue *issuesPbv1.Issue) error {
+// UpdateIssue updates an existing issue. The write is rejected with consts.ErrIssueVersionConflict
+// unless issue.Version matches the stored version; on success issue.Version is incremented.
+func (r *PostgresIssuesRepository) UpdateIssue(ctx context.Context, issue *issuesPbv1.Issue) error {
 	// Check if the issue exists first
 	var existingIssue models.Issues
-	if err := r.db.First(&existingIssue, "issue_id = ?", issue.IssueId).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&existingIssue, "issue_id = ?", issue.IssueId).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return consts.ErrIssueNotFound
 		}
@@ -100,22 +156,43 @@ func (r *PostgresIssuesRepository) UpdateIssue(issue *issuesPbv1.Issue) error {
 
 	// Update the issue
 	updates := map[string]interface{}{
-		"summary":     issue.Summary,
-		"description": issue.Description,
-		"status":      issue.Status.String(),
-		"resolution":  issue.Resolution.String(),
-		"type":        issue.Type.String(),
-		"priority":    issue.Priority.String(),
-		"project_id":  issue.ProjectId,
-		"assignee_id": &issue.AssigneeId,
+		"summary":            issue.Summary,
+		"description":        issue.Description,
+		"status":             issue.Status.String(),
+		"resolution":         issue.Resolution.String(),
+		"type":               issue.Type.String(),
+		"priority":           issue.Priority.String(),
+		"project_id":         issue.ProjectId,
+		"assignee_id":        optionalID(issue.AssigneeId),
+		"duplicate_of":       optionalID(issue.DuplicateOf),
+		"resolution_comment": issue.ResolutionComment,
+		"reopen_count":       issue.ReopenCount,
+		"last_reopen_reason": issue.LastReopenReason,
+		"last_reopened_by":   optionalID(issue.LastReopenedBy),
+		"resolve_date":       optionalTime(issue.ResolveDate),
+		"due_date":           optionalTime(issue.DueDate),
+		"version":            issue.Version + 1,
+	}
+	recordActor(ctx, updates)
+
+	// Only write if nobody else has updated the issue since it was read
+	result := r.db.WithContext(ctx).Model(&models.Issues{}).
+		Where("issue_id = ? AND version = ?", issue.IssueId, issue.Version).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return consts.ErrIssueVersionConflict
 	}
 
-	return r.db.Model(&models.Issues{}).Where("issue_id = ?", issue.IssueId).Updates(updates).Error
+	issue.Version++
+	return nil
 }
 
 // DeleteIssue removes an issue from the database
-func (r *PostgresIssuesRepository) DeleteIssue(issueID string) error {
-	result := r.db.Delete(&models.Issues{}, "issue_id = ?", issueID)
+func (r *PostgresIssuesRepository) DeleteIssue(ctx context.Context, issueID string) error {
+	result := r.db.WithContext(ctx).Delete(&models.Issues{}, "issue_id = ?", issueID)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -128,54 +205,117 @@ func (r *PostgresIssuesRepository) DeleteIssue(issueID string) error {
 	return nil
 }
 
-// ListIssues retrieves a paginated list of issues
-func (r *PostgresIssuesRepository) ListIssues(pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
+// ListIssues retrieves a paginated list of issues in creation order. The page token holds the
+// creation date and ID of the last issue of the page, so issues created between pages don't
+// shift the pages that follow.
+func (r *PostgresIssuesRepository) ListIssues(ctx context.Context, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
+	return listIssuePage(r.db.WithContext(ctx), pageToken, pageSize)
+}
+
+// ListIssuesByProject retrieves a paginated list of a project's issues in creation order, with
+// page tokens like those of ListIssues
+func (r *PostgresIssuesRepository) ListIssuesByProject(ctx context.Context, projectID, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
+	return listIssuePage(r.db.WithContext(ctx).Where("project_id = ?", projectID), pageToken, pageSize)
+}
+
+// listIssuePage reads the page of the issues query selects that follows pageToken
+func listIssuePage(query *gorm.DB, pageToken string, pageSize int) ([]*issuesPbv1.Issue, string, error) {
 	var dbIssues []models.Issues
-	query := r.db.Limit(pageSize)
+	// Fetch one extra row to tell whether another page follows
+	query = query.Limit(pageSize + 1)
 
-	// If we have a page token, use it as an offset
 	if pageToken != "" {
-		query = query.Where("issue_id > ?", pageToken)
+		token, err := pagination.Decode(pageToken, pagination.KindIssue, pagination.SortByCreation)
+		if err != nil {
+			return nil, "", err
+		}
+		query = query.Where("(create_date, issue_id) > (?, ?)", token.KeyTime(), token.ID)
 	}
 
-	if err := query.Order("issue_id").Find(&dbIssues).Error; err != nil {
+	if err := query.Order("create_date, issue_id").Find(&dbIssues).Error; err != nil {
 		return nil, "", err
 	}
 
+	var nextPageToken string
+	if len(dbIssues) > pageSize {
+		dbIssues = dbIssues[:pageSize]
+		last := dbIssues[pageSize-1]
+		nextPageToken = pagination.Token{
+			Kind: pagination.KindIssue, Sort: pagination.SortByCreation,
+			Key: pagination.TimeKey(last.CreateDate), ID: last.IssueID,
+		}.Encode()
+	}
+
 	// Convert DB models to protobuf issues
 	issues := make([]*issuesPbv1.Issue, len(dbIssues))
 	for i, dbIssue := range dbIssues {
-		var assigneeID string
-		if dbIssue.AssigneeID != nil {
-			assigneeID = *dbIssue.AssigneeID
+		issues[i] = convertIssueToPb(dbIssue)
+	}
+
+	return issues, nextPageToken, nil
+}
+
+// MoveIssue moves an issue from one project to another in a single database transaction,
+// updating the issue counts of both projects
+func (r *PostgresIssuesRepository) MoveIssue(ctx context.Context, issueID, fromProjectID, toProjectID string) (*IssueMove, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the issue row so concurrent moves of the same issue are serialized
+		var dbIssue models.Issues
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&dbIssue, "issue_id = ?", issueID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return consts.ErrIssueNotFound
+			}
+			return err
+		}
+		if dbIssue.ProjectID != fromProjectID {
+			return consts.ErrIssueNotInProject
+		}
+		// The issue takes the next number of the project it moves to
+		number, err := nextIssueNumber(tx, toProjectID)
+		if err != nil {
+			return err
 		}
 
-		// Parse enum values
-		statusValue := issuesPbv1.Status_value[dbIssue.Status]
-		resolutionValue := issuesPbv1.Resolution_value[dbIssue.Resolution]
-		typeValue := issuesPbv1.Type_value[dbIssue.Type]
-		priorityValue := issuesPbv1.Priority_value[dbIssue.Priority]
+		// The project repository runs inside this transaction, so its writes commit or roll back with the move
+		projects := projectsvc.NewPostgresProjectRepository(tx)
+		if _, err := projects.ReadProject(ctx, toProjectID); err != nil {
+			return err
+		}
+		if err := projects.RemoveIssueFromProject(ctx, fromProjectID, issueID); err != nil {
+			return err
+		}
+		if err := projects.AddIssueToProject(ctx, toProjectID, issueID); err != nil {
+			return err
+		}
 
-		issues[i] = &issuesPbv1.Issue{
-			IssueId:     dbIssue.IssueID,
-			Summary:     dbIssue.Summary,
-			Description: dbIssue.Description,
-			Status:      issuesPbv1.Status(statusValue),
-			Resolution:  issuesPbv1.Resolution(resolutionValue),
-			Type:        issuesPbv1.Type(typeValue),
-			Priority:    issuesPbv1.Priority(priorityValue),
-			ProjectId:   dbIssue.ProjectID,
-			AssigneeId:  assigneeID,
+		changes := map[string]any{
+			"project_id":   toProjectID,
+			"issue_number": number,
+			"version":      gorm.Expr("version + ?", 1),
 		}
+		recordActor(ctx, changes)
+		return tx.Model(&models.Issues{}).
+			Where("issue_id = ?", issueID).
+			UpdateColumns(changes).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate the next page token
-	var nextPageToken string
-	if len(issues) == pageSize {
-		nextPageToken = issues[len(issues)-1].IssueId
+	issue, err := r.ReadIssue(ctx, issueID)
+	if err != nil {
+		return nil, err
 	}
-
-	return issues, nextPageToken, nil
+	projects := projectsvc.NewPostgresProjectRepository(r.db)
+	fromProject, err := projects.ReadProject(ctx, fromProjectID)
+	if err != nil {
+		return nil, err
+	}
+	toProject, err := projects.ReadProject(ctx, toProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return &IssueMove{Issue: issue, FromProject: fromProject, ToProject: toProject}, nil
 }
 
 // ValidateProjectExists checks if a project with the given ID exists
@@ -192,22 +332,52 @@ func (r *PostgresIssuesRepository) ValidateProjectExists(_ context.Context, proj
 	return nil
 }
 
-// ValidateUserExists checks if a user with the given ID exists
+// ValidateUserExists checks if an active user with the given ID exists
 func (r *PostgresIssuesRepository) ValidateUserExists(_ context.Context, userID string) error {
-	var count int64
-	if err := r.db.Model(&models.User{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+	var active []bool
+	if err := r.db.Model(&models.User{}).Where("user_id = ?", userID).Pluck("active", &active).Error; err != nil {
 		return err
 	}
 
-	if count == 0 {
+	if len(active) == 0 {
 		return consts.ErrUserNotFound
 	}
+	if !active[0] {
+		return fmt.Errorf("%w: %s", consts.ErrUserDeactivated, userID)
+	}
 
 	return nil
 }
 
+// GetProjectIssueCount counts the issues associated with a project in project_issues, which its
+// issue count is kept from, as the MemDB repository reads the count the project service keeps
+func (r *PostgresIssuesRepository) GetProjectIssueCount(ctx context.Context, projectID string) (int32, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.ProjectIssue{}).Where("project_id = ?", projectID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int32(count), nil
+}
+
+// GetProjectMaxIssues returns the most issues the project may have, 0 meaning unlimited
+func (r *PostgresIssuesRepository) GetProjectMaxIssues(ctx context.Context, projectID string) (int32, error) {
+	var maxIssues []int32
+	if err := r.db.WithContext(ctx).Model(&models.Project{}).Where("project_id = ?", projectID).Pluck("max_issues", &maxIssues).Error; err != nil {
+		return 0, err
+	}
+	if len(maxIssues) == 0 {
+		return 0, consts.ErrProjectNotFound
+	}
+	return maxIssues[0], nil
+}
+
+// GetProjectMemberRole retrieves the role of a user in a project
+func (r *PostgresIssuesRepository) GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error) {
+	return projectsvc.NewPostgresProjectRepository(r.db).GetProjectMemberRole(ctx, projectID, userID)
+}
+
 // IsValidStatusTransition validates whether a status transition is allowed
-func (r *PostgresIssuesRepository) IsValidStatusTransition(currentStatus, newStatus issuesPbv1.Status) error {
+func (r *PostgresIssuesRepository) IsValidStatusTransition(_ context.Context, currentStatus, newStatus issuesPbv1.Status) error {
 	// Define valid transitions - same as in MemDB implementation
 	validTransitions := map[issuesPbv1.Status][]issuesPbv1.Status{
 		issuesPbv1.Status_NEW:         {issuesPbv1.Status_ASSIGNED},
@@ -238,3 +408,426 @@ func (r *PostgresIssuesRepository) IsValidStatusTransition(currentStatus, newSta
 	// If we get here, the transition is not allowed
 	return errors.New("invalid status transition")
 }
+
+// ResolutionMetrics computes resolution time percentiles in the database with PERCENTILE_CONT.
+// SQLite has no percentile functions, so there the resolution times are summarized in Go.
+func (r *PostgresIssuesRepository) ResolutionMetrics(ctx context.Context, filter ResolutionMetricsFilter) (*ResolutionMetrics, error) {
+	if r.db.WithContext(ctx).Dialector.Name() == "sqlite" {
+		return r.resolutionMetricsInProcess(filter)
+	}
+
+	resolved := resolvedIssuesQuery(r.db, filter).
+		Select("EXTRACT(EPOCH FROM (resolve_date - create_date)) / 3600 AS hours")
+
+	var row struct {
+		TotalResolved  int32
+		P50Hours       float64
+		P90Hours       float64
+		P99Hours       float64
+		SLOBreachCount int32 `gorm:"column:slo_breach_count"`
+	}
+	err := r.db.WithContext(ctx).Table("(?) AS resolved", resolved).
+		Select(`COUNT(*) AS total_resolved,
+			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY hours), 0) AS p50_hours,
+			COALESCE(PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY hours), 0) AS p90_hours,
+			COALESCE(PERCENTILE_CONT(0.99) WITHIN GROUP (ORDER BY hours), 0) AS p99_hours,
+			COUNT(*) FILTER (WHERE hours > ?) AS slo_breach_count`, filter.SLOHours).
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResolutionMetrics{
+		P50Hours:       row.P50Hours,
+		P90Hours:       row.P90Hours,
+		P99Hours:       row.P99Hours,
+		TotalResolved:  row.TotalResolved,
+		SLOBreachCount: row.SLOBreachCount,
+	}, nil
+}
+
+// ProjectActivity counts the activity of a project's issues within the window in a single query
+func (r *PostgresIssuesRepository) ProjectActivity(ctx context.Context, window ProjectActivityWindow) (*ProjectActivity, error) {
+	var row struct {
+		Created  int32
+		Resolved int32
+		Closed   int32
+		Overdue  int32
+	}
+	err := r.db.WithContext(ctx).Model(&models.Issues{}).
+		Where("project_id = ?", window.ProjectID).
+		Select(`COALESCE(SUM(CASE WHEN create_date >= ? AND create_date < ? THEN 1 ELSE 0 END), 0) AS created,
+			COALESCE(SUM(CASE WHEN resolve_date >= ? AND resolve_date < ? THEN 1 ELSE 0 END), 0) AS resolved,
+			COALESCE(SUM(CASE WHEN status = ? AND modify_date >= ? AND modify_date < ? THEN 1 ELSE 0 END), 0) AS closed,
+			COALESCE(SUM(CASE WHEN status NOT IN ? AND create_date < ? THEN 1 ELSE 0 END), 0) AS overdue`,
+			window.From, window.To,
+			window.From, window.To,
+			issuesPbv1.Status_CLOSED.String(), window.From, window.To,
+			[]string{issuesPbv1.Status_RESOLVED.String(), issuesPbv1.Status_CLOSED.String()}, window.OverdueBefore).
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectActivity{
+		Created:  row.Created,
+		Resolved: row.Resolved,
+		Closed:   row.Closed,
+		Overdue:  row.Overdue,
+	}, nil
+}
+
+// ProjectProgress counts the issues of a project by status in a single grouped query
+func (r *PostgresIssuesRepository) ProjectProgress(ctx context.Context, projectID string) (*ProjectProgress, error) {
+	var rows []struct {
+		Status string
+		Count  int32
+	}
+	err := r.db.WithContext(ctx).Model(&models.Issues{}).
+		Where("project_id = ?", projectID).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byStatus := make(map[string]int32, len(rows))
+	for _, row := range rows {
+		byStatus[row.Status] = row.Count
+	}
+	return newProjectProgress(byStatus), nil
+}
+
+// CountOpenIssuesByAssignee counts the issues assigned to a user that aren't closed
+func (r *PostgresIssuesRepository) CountOpenIssuesByAssignee(ctx context.Context, assigneeID string) (int, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Issues{}).
+		Where("assignee_id = ? AND status <> ?", assigneeID, issuesPbv1.Status_CLOSED.String()).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FindIssuesDueSoon returns the assigned issues that are neither resolved nor closed and are
+// due within the given time from now, soonest first
+func (r *PostgresIssuesRepository) FindIssuesDueSoon(ctx context.Context, within time.Duration) ([]*issuesPbv1.Issue, error) {
+	now := time.Now()
+	var dbIssues []models.Issues
+	err := r.db.WithContext(ctx).
+		Where("assignee_id IS NOT NULL AND status NOT IN ? AND due_date >= ? AND due_date <= ?",
+			[]string{issuesPbv1.Status_RESOLVED.String(), issuesPbv1.Status_CLOSED.String()}, now, now.Add(within)).
+		Order("due_date, issue_id").
+		Find(&dbIssues).Error
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]*issuesPbv1.Issue, len(dbIssues))
+	for i, dbIssue := range dbIssues {
+		issues[i] = convertIssueToPb(dbIssue)
+	}
+	return issues, nil
+}
+
+// ReassignOpenIssues assigns the issues of fromAssigneeID that aren't closed to toAssigneeID
+// within a single transaction and returns their IDs
+func (r *PostgresIssuesRepository) ReassignOpenIssues(ctx context.Context, fromAssigneeID, toAssigneeID string) ([]string, error) {
+	var issueIDs []string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Issues{}).
+			Where("assignee_id = ? AND status <> ?", fromAssigneeID, issuesPbv1.Status_CLOSED.String()).
+			Pluck("issue_id", &issueIDs).Error
+		if err != nil || len(issueIDs) == 0 {
+			return err
+		}
+
+		changes := map[string]interface{}{
+			"assignee_id": toAssigneeID,
+			"version":     gorm.Expr("version + 1"),
+		}
+		recordActor(ctx, changes)
+		return tx.Model(&models.Issues{}).
+			Where("issue_id IN ?", issueIDs).
+			Updates(changes).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issueIDs, nil
+}
+
+// BulkUpdateStatus moves the matching issues to the new status in one transaction and returns their IDs.
+// The matching issues are locked until the transaction ends, and the update checks the rules
+// again, so an issue whose status or blockers change in between is never moved.
+func (r *PostgresIssuesRepository) BulkUpdateStatus(ctx context.Context, update BulkStatusUpdate) ([]string, error) {
+	var issueIDs []string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := bulkStatusQuery(tx, update).Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("issue_id", &issueIDs).Error
+		if err != nil || len(issueIDs) == 0 {
+			return err
+		}
+
+		now := time.Now()
+		changes := map[string]interface{}{
+			"status":      update.ToStatus.String(),
+			"version":     gorm.Expr("version + 1"),
+			"modify_date": now,
+		}
+		if update.resolves() {
+			changes["resolve_date"] = gorm.Expr("COALESCE(resolve_date, ?)", now)
+		}
+		if update.Resolution != issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
+			changes["resolution"] = update.Resolution.String()
+			changes["resolution_comment"] = update.ResolutionComment
+			changes["duplicate_of"] = nil
+		}
+		recordActor(ctx, changes)
+
+		result := bulkStatusQuery(tx, update).Where("issue_id IN ?", issueIDs).Updates(changes)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(issueIDs)) {
+			// A blocker was reopened since the issues were selected; nothing is moved
+			return fmt.Errorf("%w: %d of %d issues no longer match", consts.ErrIssueVersionConflict,
+				int64(len(issueIDs))-result.RowsAffected, len(issueIDs))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issueIDs, nil
+}
+
+// bulkStatusQuery selects the issues a bulk status update moves, with the same rules as
+// BulkStatusUpdate.matches and, for moves to RESOLVED or CLOSED, without issues blocked by open issues
+func bulkStatusQuery(db *gorm.DB, update BulkStatusUpdate) *gorm.DB {
+	query := db.Model(&models.Issues{}).
+		Where("project_id = ? AND status = ?", update.ProjectID, update.FromStatus.String())
+	if update.needsAssignee() {
+		query = query.Where("assignee_id IS NOT NULL")
+	}
+	if update.resolves() {
+		query = query.Where(`NOT EXISTS (
+			SELECT 1 FROM issue_links
+			JOIN issues AS blockers ON blockers.issue_id = issue_links.source_issue_id AND blockers.deleted_at IS NULL
+			WHERE issue_links.target_issue_id = issues.issue_id AND issue_links.type = ? AND blockers.status NOT IN ?)`,
+			issuesPbv1.LinkType_BLOCKS.String(),
+			[]string{issuesPbv1.Status_RESOLVED.String(), issuesPbv1.Status_CLOSED.String()})
+	}
+
+	if filter := update.Filter; filter != nil {
+		if len(filter.Types) > 0 {
+			types := make([]string, len(filter.Types))
+			for i, t := range filter.Types {
+				types[i] = t.String()
+			}
+			query = query.Where("type IN ?", types)
+		}
+		if len(filter.Priorities) > 0 {
+			priorities := make([]string, len(filter.Priorities))
+			for i, p := range filter.Priorities {
+				priorities[i] = p.String()
+			}
+			query = query.Where("priority IN ?", priorities)
+		}
+		if filter.AssigneeId != "" {
+			query = query.Where("assignee_id = ?", filter.AssigneeId)
+		}
+	}
+	return query
+}
+
+// resolutionMetricsInProcess loads the create and resolve dates of matching issues and
+// summarizes them with the same calculation as the in-memory repository
+func (r *PostgresIssuesRepository) resolutionMetricsInProcess(filter ResolutionMetricsFilter) (*ResolutionMetrics, error) {
+	var rows []struct {
+		CreateDate  time.Time
+		ResolveDate time.Time
+	}
+	if err := resolvedIssuesQuery(r.db, filter).Select("create_date, resolve_date").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	hours := make([]float64, len(rows))
+	for i, row := range rows {
+		hours[i] = row.ResolveDate.Sub(row.CreateDate).Hours()
+	}
+	return computeResolutionMetrics(hours, filter.SLOHours), nil
+}
+
+// BatchGetIssues retrieves the issues with the given IDs in one query, in the order of the IDs.
+// IDs without an issue are skipped.
+func (r *PostgresIssuesRepository) BatchGetIssues(ctx context.Context, issueIDs []string) ([]*issuesPbv1.Issue, error) {
+	if len(issueIDs) == 0 {
+		return nil, nil
+	}
+
+	// IN rather than = ANY so the query also runs on SQLite
+	var dbIssues []models.Issues
+	if err := r.db.WithContext(ctx).Where("issue_id IN ?", issueIDs).Find(&dbIssues).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*issuesPbv1.Issue, len(dbIssues))
+	for _, dbIssue := range dbIssues {
+		byID[dbIssue.IssueID] = convertIssueToPb(dbIssue)
+	}
+
+	issues := make([]*issuesPbv1.Issue, 0, len(dbIssues))
+	for _, issueID := range issueIDs {
+		if issue, ok := byID[issueID]; ok {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// CreateIssueLink adds a new issue link to the database
+func (r *PostgresIssuesRepository) CreateIssueLink(ctx context.Context, link *issuesPbv1.IssueLink) error {
+	dbLink := &models.IssueLink{
+		LinkID:        link.LinkId,
+		SourceIssueID: link.SourceIssueId,
+		TargetIssueID: link.TargetIssueId,
+		Type:          link.Type.String(),
+	}
+	if link.CreateDate != nil {
+		dbLink.CreateDate = link.CreateDate.AsTime()
+	}
+
+	return r.db.WithContext(ctx).Create(dbLink).Error
+}
+
+// DeleteIssueLink removes an issue link from the database and returns it
+func (r *PostgresIssuesRepository) DeleteIssueLink(ctx context.Context, linkID string) (*issuesPbv1.IssueLink, error) {
+	var dbLink models.IssueLink
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&dbLink, "link_id = ?", linkID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return consts.ErrIssueLinkNotFound
+			}
+			return err
+		}
+		return tx.Delete(&dbLink).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertIssueLinkToPb(dbLink), nil
+}
+
+// ListIssueLinks retrieves the links from and to an issue, as stored
+func (r *PostgresIssuesRepository) ListIssueLinks(ctx context.Context, issueID string) ([]*issuesPbv1.IssueLink, error) {
+	var dbLinks []models.IssueLink
+	if err := r.db.WithContext(ctx).Where("source_issue_id = ? OR target_issue_id = ?", issueID, issueID).
+		Order("create_date").Find(&dbLinks).Error; err != nil {
+		return nil, err
+	}
+
+	links := make([]*issuesPbv1.IssueLink, len(dbLinks))
+	for i, dbLink := range dbLinks {
+		links[i] = convertIssueLinkToPb(dbLink)
+	}
+
+	return links, nil
+}
+
+// convertIssueToPb converts an issue model to its protobuf representation
+func convertIssueToPb(dbIssue models.Issues) *issuesPbv1.Issue {
+	var assigneeID string
+	if dbIssue.AssigneeID != nil {
+		assigneeID = *dbIssue.AssigneeID
+	}
+
+	return &issuesPbv1.Issue{
+		IssueId:           dbIssue.IssueID,
+		Summary:           dbIssue.Summary,
+		Description:       dbIssue.Description,
+		Status:            issuesPbv1.Status(issuesPbv1.Status_value[dbIssue.Status]),
+		Resolution:        issuesPbv1.Resolution(issuesPbv1.Resolution_value[dbIssue.Resolution]),
+		Type:              issuesPbv1.Type(issuesPbv1.Type_value[dbIssue.Type]),
+		Priority:          issuesPbv1.Priority(issuesPbv1.Priority_value[dbIssue.Priority]),
+		ProjectId:         dbIssue.ProjectID,
+		AssigneeId:        assigneeID,
+		DuplicateOf:       derefID(dbIssue.DuplicateOf),
+		ClonedFrom:        derefID(dbIssue.ClonedFrom),
+		TeamId:            derefID(dbIssue.TeamID),
+		ResolutionComment: dbIssue.ResolutionComment,
+		ReopenCount:       dbIssue.ReopenCount,
+		LastReopenReason:  dbIssue.LastReopenReason,
+		LastReopenedBy:    derefID(dbIssue.LastReopenedBy),
+		CreateDate:        timestamppb.New(dbIssue.CreateDate),
+		ModifyDate:        timestamppb.New(dbIssue.ModifyDate),
+		ResolveDate:       optionalTimestamp(dbIssue.ResolveDate),
+		DueDate:           optionalTimestamp(dbIssue.DueDate),
+		Version:           dbIssue.Version,
+		IssueNumber:       dbIssue.IssueNumber,
+	}
+}
+
+// convertIssueLinkToPb converts an issue link model to its protobuf representation
+func convertIssueLinkToPb(dbLink models.IssueLink) *issuesPbv1.IssueLink {
+	return &issuesPbv1.IssueLink{
+		LinkId:        dbLink.LinkID,
+		SourceIssueId: dbLink.SourceIssueID,
+		TargetIssueId: dbLink.TargetIssueID,
+		Type:          issuesPbv1.LinkType(issuesPbv1.LinkType_value[dbLink.Type]),
+		CreateDate:    timestamppb.New(dbLink.CreateDate),
+	}
+}
+
+// resolvedIssuesQuery selects the resolved issues matching the filter
+func resolvedIssuesQuery(db *gorm.DB, filter ResolutionMetricsFilter) *gorm.DB {
+	resolved := db.Model(&models.Issues{}).Where("resolve_date IS NOT NULL")
+	if filter.ProjectID != "" {
+		resolved = resolved.Where("project_id = ?", filter.ProjectID)
+	}
+	if filter.Type != issuesPbv1.Type_TYPE_UNSPECIFIED {
+		resolved = resolved.Where("type = ?", filter.Type.String())
+	}
+	if !filter.From.IsZero() {
+		resolved = resolved.Where("resolve_date >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		resolved = resolved.Where("resolve_date < ?", filter.To)
+	}
+	return resolved
+}
+
+// optionalID maps an empty ID to NULL for nullable uuid columns
+func optionalID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// derefID maps a NULL uuid column to an empty ID
+func derefID(id *string) string {
+	if id == nil {
+		return ""
+	}
+	return *id
+}
+
+// optionalTime maps an unset timestamp to NULL for nullable time columns
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// optionalTimestamp maps a NULL time column to an unset timestamp
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,250 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/database"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/models"
+	"github.com/yasindce1998/issue-tracker/pkg/auth"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+// newSQLiteDB opens a private in-memory SQLite database with the application schema
+func newSQLiteDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := database.OpenSQLite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return db
+}
+
+func TestPostgresIssuesRepository_CRUD(t *testing.T) {
+	repo := issuessvc.NewPostgresIssuesRepository(newSQLiteDB(t))
+
+	issue := &issuesPbv1.Issue{
+		IssueId:    validIssueID,
+		Summary:    testSummary,
+		Status:     issuesPbv1.Status_NEW,
+		Type:       issuesPbv1.Type_BUG,
+		Priority:   issuesPbv1.Priority_MAJOR,
+		ProjectId:  validProjectID,
+		ClonedFrom: targetProjectID,
+	}
+	require.NoError(t, repo.CreateIssue(context.Background(), issue))
+	// The created issue carries its stored version, so it can be updated as it is
+	assert.Equal(t, int64(1), issue.Version)
+
+	read, err := repo.ReadIssue(context.Background(), validIssueID)
+	require.NoError(t, err)
+	assert.Equal(t, testSummary, read.Summary)
+	assert.Equal(t, issuesPbv1.Priority_MAJOR, read.Priority)
+	assert.Equal(t, targetProjectID, read.ClonedFrom)
+	assert.Equal(t, int64(1), read.Version)
+
+	read.Summary = "Updated summary"
+	require.NoError(t, repo.UpdateIssue(context.Background(), read))
+	assert.Equal(t, int64(2), read.Version)
+
+	// Writing with the version that was just replaced is a conflict
+	issue.Summary = "Stale summary"
+	assert.ErrorIs(t, repo.UpdateIssue(context.Background(), issue), consts.ErrIssueVersionConflict)
+
+	issues, next, err := repo.ListIssues(context.Background(), "", 10)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "Updated summary", issues[0].Summary)
+	assert.Equal(t, targetProjectID, issues[0].ClonedFrom)
+	assert.Empty(t, next)
+
+	require.NoError(t, repo.DeleteIssue(context.Background(), validIssueID))
+	_, err = repo.ReadIssue(context.Background(), validIssueID)
+	assert.ErrorIs(t, err, consts.ErrIssueNotFound)
+	assert.ErrorIs(t, repo.DeleteIssue(context.Background(), validIssueID), consts.ErrIssueNotFound)
+}
+
+func TestPostgresIssuesRepository_RecordsActor(t *testing.T) {
+	db := newSQLiteDB(t)
+	repo := issuessvc.NewPostgresIssuesRepository(db)
+	stored := func() models.Issues {
+		t.Helper()
+		var row models.Issues
+		require.NoError(t, db.First(&row, "issue_id = ?", validIssueID).Error)
+		return row
+	}
+
+	issue := &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, Status: issuesPbv1.Status_NEW, ProjectId: validProjectID}
+	require.NoError(t, repo.CreateIssue(auth.WithActorID(context.Background(), validUserID), issue))
+	row := stored()
+	require.NotNil(t, row.CreatedByUserID)
+	require.NotNil(t, row.LastModifiedByUserID)
+	assert.Equal(t, validUserID, *row.CreatedByUserID)
+	assert.Equal(t, validUserID, *row.LastModifiedByUserID)
+
+	issue.Summary = "Updated summary"
+	require.NoError(t, repo.UpdateIssue(auth.WithActorID(context.Background(), otherUserID), issue))
+	row = stored()
+	assert.Equal(t, validUserID, *row.CreatedByUserID)
+	assert.Equal(t, otherUserID, *row.LastModifiedByUserID)
+
+	// A write made without an actor keeps the recorded one
+	issue.Summary = "Summary updated by a job"
+	issue.AssigneeId = otherUserID
+	require.NoError(t, repo.UpdateIssue(context.Background(), issue))
+	assert.Equal(t, otherUserID, *stored().LastModifiedByUserID)
+
+	// Writes that change several issues record the actor as well
+	_, err := repo.ReassignOpenIssues(auth.WithActorID(context.Background(), validUserID), otherUserID, validUserID)
+	require.NoError(t, err)
+	row = stored()
+	assert.Equal(t, validUserID, *row.AssigneeID)
+	assert.Equal(t, validUserID, *row.LastModifiedByUserID)
+}
+
+func TestPostgresIssuesRepository_BatchGetIssues(t *testing.T) {
+	repo := issuessvc.NewPostgresIssuesRepository(newSQLiteDB(t))
+	for _, issueID := range []string{validIssueID, targetProjectID} {
+		require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+			IssueId:   issueID,
+			Summary:   testSummary,
+			Status:    issuesPbv1.Status_NEW,
+			Type:      issuesPbv1.Type_BUG,
+			Priority:  issuesPbv1.Priority_MAJOR,
+			ProjectId: validProjectID,
+		}))
+	}
+
+	issues, err := repo.BatchGetIssues(context.Background(), []string{targetProjectID, validUserID, validIssueID})
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	assert.Equal(t, targetProjectID, issues[0].IssueId)
+	assert.Equal(t, validIssueID, issues[1].IssueId)
+	assert.Equal(t, issuesPbv1.Priority_MAJOR, issues[1].Priority)
+}
+
+func TestPostgresIssuesRepository_ListIssuesInCreationOrder(t *testing.T) {
+	repo := issuessvc.NewPostgresIssuesRepository(newSQLiteDB(t))
+	ctx := context.Background()
+	created := time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC)
+	create := func(issueID string, createDate time.Time) {
+		t.Helper()
+		require.NoError(t, repo.CreateIssue(ctx, &issuesPbv1.Issue{
+			IssueId:    issueID,
+			Summary:    testSummary,
+			ProjectId:  validProjectID,
+			CreateDate: timestamppb.New(createDate),
+		}))
+	}
+	listIDs := func(token string) ([]string, string) {
+		t.Helper()
+		issues, next, err := repo.ListIssues(ctx, token, 2)
+		require.NoError(t, err)
+		ids := make([]string, len(issues))
+		for i, issue := range issues {
+			ids[i] = issue.IssueId
+		}
+		return ids, next
+	}
+
+	// The IDs sort in a different order than the issues were created in, and the last two
+	// issues were created at the same time
+	ids := []string{
+		"e0000000-0000-4000-8000-000000000000",
+		"a0000000-0000-4000-8000-000000000000",
+		"c0000000-0000-4000-8000-000000000000",
+		"d0000000-0000-4000-8000-000000000000",
+	}
+	create(ids[0], created)
+	create(ids[1], created.Add(time.Minute))
+	create(ids[3], created.Add(2*time.Minute))
+	create(ids[2], created.Add(2*time.Minute))
+
+	page, next := listIDs("")
+	assert.Equal(t, ids[:2], page)
+	require.NotEmpty(t, next)
+
+	// An issue whose ID sorts before the rest doesn't shift the pages that follow
+	create("b0000000-0000-4000-8000-000000000000", created.Add(3*time.Minute))
+
+	page, next = listIDs(next)
+	assert.Equal(t, ids[2:], page, "issues created at the same time are listed by ID")
+	page, next = listIDs(next)
+	assert.Equal(t, []string{"b0000000-0000-4000-8000-000000000000"}, page)
+	assert.Empty(t, next)
+
+	_, _, err := repo.ListIssues(ctx, ids[0], 2)
+	assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
+
+func TestPostgresIssuesRepository_MoveIssue(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	db := newSQLiteDB(t)
+	repo := issuessvc.NewPostgresIssuesRepository(db)
+	projects := projectsvc.NewPostgresProjectRepository(db)
+
+	require.NoError(t, projects.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: validProjectID, Name: "Source"}))
+	require.NoError(t, projects.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: targetProjectID, Name: "Target"}))
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, ProjectId: validProjectID}))
+	require.NoError(t, projects.AddIssueToProject(context.Background(), validProjectID, validIssueID))
+
+	_, err := repo.MoveIssue(context.Background(), validIssueID, targetProjectID, validProjectID)
+	assert.ErrorIs(t, err, consts.ErrIssueNotInProject)
+
+	move, err := repo.MoveIssue(context.Background(), validIssueID, validProjectID, targetProjectID)
+	require.NoError(t, err)
+	assert.Equal(t, targetProjectID, move.Issue.ProjectId)
+	assert.Equal(t, int64(2), move.Issue.Version)
+	assert.Equal(t, int32(0), move.FromProject.IssueCount)
+	assert.Equal(t, int32(1), move.ToProject.IssueCount)
+}
+
+func TestPostgresIssuesRepository_ResolutionMetrics(t *testing.T) {
+	repo := issuessvc.NewPostgresIssuesRepository(newSQLiteDB(t))
+
+	now := time.Now()
+	resolutionHours := map[string]int{
+		"10000000-0000-4000-8000-000000000000": 10,
+		"20000000-0000-4000-8000-000000000000": 20,
+		"30000000-0000-4000-8000-000000000000": 30,
+		"40000000-0000-4000-8000-000000000000": 100,
+	}
+	for issueID, hours := range resolutionHours {
+		require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{
+			IssueId:     issueID,
+			Summary:     testSummary,
+			Type:        issuesPbv1.Type_BUG,
+			ProjectId:   validProjectID,
+			ResolveDate: timestamppb.New(now.Add(time.Duration(hours) * time.Hour)),
+		}))
+	}
+	// Unresolved issues are not counted
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, ProjectId: validProjectID}))
+
+	metrics, err := repo.ResolutionMetrics(context.Background(), issuessvc.ResolutionMetricsFilter{ProjectID: validProjectID, SLOHours: 48})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), metrics.TotalResolved)
+	assert.Equal(t, int32(1), metrics.SLOBreachCount)
+	assert.InDelta(t, 25, metrics.P50Hours, 0.1)
+
+	metrics, err = repo.ResolutionMetrics(context.Background(), issuessvc.ResolutionMetricsFilter{ProjectID: targetProjectID, SLOHours: 48})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), metrics.TotalResolved)
+}
@@ -0,0 +1,86 @@
+package issuessvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// issueResponseCache keeps the GetIssue responses that carry project and assignee details, so
+// repeated gets of an unchanged issue don't call the project and user services each time. It
+// lives in the shared cache, so instances behind a Redis cache reuse each other's responses.
+//
+// Entries are keyed by the issue's project, so they can be dropped when the project changes.
+// A response is only served while the issue it was built from still equals the stored issue,
+// so updating, moving or deleting an issue makes its response unreachable right away; the
+// details of a renamed user are refreshed once the entry expires.
+type issueResponseCache struct {
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// SetResponseCache caches GetIssue responses with details in c for ttl; a ttl of zero or less
+// turns the cache off. The entries of a project are dropped when the broker set with
+// SetMessageBroker reports that the project changed.
+func (s *IssuesServiceServer) SetResponseCache(c cache.Cache, ttl time.Duration) {
+	if ttl <= 0 {
+		s.responses = nil
+		return
+	}
+	s.responses = &issueResponseCache{cache: c, ttl: ttl}
+}
+
+// issueResponseKey returns the key of the response GetIssue builds for issue
+func issueResponseKey(issue *issuesPbv1.Issue, includeDetails bool) string {
+	return fmt.Sprintf("%s%s:%t", issueResponseProjectPrefix(issue.ProjectId), issue.IssueId, includeDetails)
+}
+
+// issueResponseProjectPrefix returns the prefix of the keys of a project's issues
+func issueResponseProjectPrefix(projectID string) string {
+	return fmt.Sprintf("issue_response:%s:", projectID)
+}
+
+// get returns the cached response for issue, or nil if none was cached for its current state.
+// Hits and misses are logged as cache accesses of the IssueResponse entity.
+func (c *issueResponseCache) get(ctx context.Context, issue *issuesPbv1.Issue) *issuesPbv1.GetIssueResponse {
+	if c == nil {
+		return nil
+	}
+	resp := new(issuesPbv1.GetIssueResponse)
+	if err := c.cache.Get(ctx, issueResponseKey(issue, true), resp); err != nil || !proto.Equal(resp.Issue, issue) {
+		logger.LogCacheAccess(ctx, "IssueResponse", issue.IssueId, logger.FromDatabase)
+		return nil
+	}
+	logger.LogCacheAccess(ctx, "IssueResponse", issue.IssueId, logger.FromCache)
+	return resp
+}
+
+// set caches resp. Responses whose details couldn't all be looked up aren't cached, so the
+// lookups are retried on the next get.
+func (c *issueResponseCache) set(ctx context.Context, resp *issuesPbv1.GetIssueResponse) {
+	if c == nil || len(resp.DetailsErrors) > 0 {
+		return
+	}
+	if err := c.cache.Set(ctx, issueResponseKey(resp.Issue, true), resp, cache.Jitter(c.ttl)); err != nil {
+		logger.FromContext(ctx).Warn("Failed to cache issue response",
+			zap.String("issue_id", resp.Issue.IssueId), zap.Error(err))
+	}
+}
+
+// dropProject deletes the cached responses of a project's issues
+func (c *issueResponseCache) dropProject(ctx context.Context, projectID string) {
+	if c == nil {
+		return
+	}
+	if _, err := cache.Flush(ctx, c.cache, issueResponseProjectPrefix(projectID)); err != nil {
+		logger.ZapLogger.Warn("Failed to drop the cached issue responses of a changed project",
+			zap.String("project_id", projectID), zap.Error(err))
+	}
+}
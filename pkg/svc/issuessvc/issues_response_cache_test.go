@@ -0,0 +1,173 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/memory"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// responseCacheFixture is a service that caches its responses in responses and whose
+// repository returns issue
+type responseCacheFixture struct {
+	service        *issuessvc.IssuesServiceServer
+	projectService *mocks.MockProjectServiceClient
+	userService    *mocks.MockUserServiceClient
+	responses      cache.Cache
+	issue          *issuesPbv1.Issue
+}
+
+func newResponseCacheFixture(t *testing.T, ttl time.Duration) *responseCacheFixture {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+	ctrl := gomock.NewController(t)
+	f := &responseCacheFixture{
+		responses:      cache.NewMemoryCache(100),
+		projectService: mocks.NewMockProjectServiceClient(ctrl),
+		userService:    mocks.NewMockUserServiceClient(ctrl),
+		issue: &issuesPbv1.Issue{
+			IssueId: validIssueID, Summary: testSummary, ProjectId: validProjectID, AssigneeId: validUserID, Version: 1,
+		},
+	}
+	repo := mocks.NewMockIssuesRepository(ctrl)
+	repo.EXPECT().ReadIssue(gomock.Any(), validIssueID).DoAndReturn(func(context.Context, string) (*issuesPbv1.Issue, error) {
+		return proto.Clone(f.issue).(*issuesPbv1.Issue), nil
+	}).AnyTimes()
+
+	f.service = issuessvc.NewIssuesService(repo, f.projectService, f.userService)
+	f.service.SetResponseCache(f.responses, ttl)
+	return f
+}
+
+// expectLookups expects the project and the assignee to be looked up times times
+func (f *responseCacheFixture) expectLookups(times int) {
+	f.projectService.EXPECT().GetProject(gomock.Any(), gomock.Any()).Return(
+		&projectPbv1.GetProjectResponse{Project: &projectPbv1.Project{ProjectId: validProjectID, Name: "Tracker"}}, nil).Times(times)
+	f.userService.EXPECT().BatchGetUsers(gomock.Any(), gomock.Any()).Return(
+		&userPbv1.BatchGetUsersResponse{Users: []*userPbv1.User{{UserId: validUserID, FirstName: "Ada"}}}, nil).Times(times)
+}
+
+func (f *responseCacheFixture) getWithDetails(t *testing.T) *issuesPbv1.GetIssueResponse {
+	t.Helper()
+	resp, err := f.service.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: validIssueID, IncludeDetails: true})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestIssuesServiceServer_GetIssueResponseCache(t *testing.T) {
+	f := newResponseCacheFixture(t, time.Minute)
+	f.expectLookups(1)
+
+	// The project and user services are called once across repeated gets
+	for i := 0; i < 3; i++ {
+		resp := f.getWithDetails(t)
+		require.NotNil(t, resp.ProjectInfo)
+		assert.Equal(t, "Tracker", resp.ProjectInfo.Name)
+		require.NotNil(t, resp.UserInfo)
+		assert.Equal(t, validUserID, resp.UserInfo.UserId)
+		assert.Equal(t, testSummary, resp.Issue.Summary)
+	}
+
+	// Gets without details don't use or fill the cache
+	resp, err := f.service.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: validIssueID})
+	require.NoError(t, err)
+	assert.Nil(t, resp.ProjectInfo)
+
+	// A changed issue is looked up again, so the response never holds an outdated issue
+	f.issue.Summary = "Renamed"
+	f.issue.Version = 2
+	f.expectLookups(1)
+	assert.Equal(t, "Renamed", f.getWithDetails(t).Issue.Summary)
+	assert.Equal(t, "Renamed", f.getWithDetails(t).Issue.Summary)
+}
+
+func TestIssuesServiceServer_GetIssueResponseCacheExpires(t *testing.T) {
+	f := newResponseCacheFixture(t, 50*time.Millisecond)
+	f.expectLookups(2)
+
+	f.getWithDetails(t)
+	f.getWithDetails(t)
+	time.Sleep(100 * time.Millisecond)
+	f.getWithDetails(t)
+}
+
+func TestIssuesServiceServer_GetIssueResponseCacheOff(t *testing.T) {
+	f := newResponseCacheFixture(t, 0)
+	f.expectLookups(2)
+
+	f.getWithDetails(t)
+	f.getWithDetails(t)
+}
+
+func TestIssuesServiceServer_GetIssueResponseCacheSkipsFailedLookups(t *testing.T) {
+	f := newResponseCacheFixture(t, time.Minute)
+	f.projectService.EXPECT().GetProject(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	f.userService.EXPECT().BatchGetUsers(gomock.Any(), gomock.Any()).Return(
+		&userPbv1.BatchGetUsersResponse{Users: []*userPbv1.User{{UserId: validUserID}}}, nil)
+
+	assert.Len(t, f.getWithDetails(t).DetailsErrors, 1)
+
+	// The partial response wasn't cached, so the lookups are retried
+	f.expectLookups(1)
+	resp := f.getWithDetails(t)
+	assert.Empty(t, resp.DetailsErrors)
+	assert.NotNil(t, resp.ProjectInfo)
+}
+
+func TestIssuesServiceServer_GetIssueResponseCacheDropsChangedProject(t *testing.T) {
+	f := newResponseCacheFixture(t, time.Minute)
+	mb := memory.NewInMemoryBroker()
+	t.Cleanup(func() { _ = mb.Close() })
+	f.service.SetMessageBroker(mb)
+	f.expectLookups(1)
+
+	f.getWithDetails(t)
+	f.getWithDetails(t)
+
+	// The project service publishes PROJECT_CHANGED when the project is renamed
+	require.NoError(t, mb.PublishUpdate(context.Background(), validProjectID, &projectPbv1.ProjectUpdateResponse{
+		ProjectId: validProjectID, UpdateType: projectPbv1.UpdateType_PROJECT_CHANGED,
+	}))
+	assert.Eventually(t, func() bool {
+		keys, err := f.responses.Keys(context.Background(), "issue_response:")
+		return err == nil && len(keys) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	f.expectLookups(1)
+	f.getWithDetails(t)
+	f.getWithDetails(t)
+}
+
+func TestIssuesServiceServer_GetIssueResponseCacheKeptWhenSubscriptionsEnd(t *testing.T) {
+	f := newResponseCacheFixture(t, time.Minute)
+	mb := memory.NewInMemoryBroker()
+	t.Cleanup(func() { _ = mb.Close() })
+	f.service.SetMessageBroker(mb)
+	f.expectLookups(1)
+
+	f.getWithDetails(t)
+
+	// Ending the project details subscriptions isn't a project change
+	require.NoError(t, f.service.Close())
+	assert.Never(t, func() bool {
+		keys, err := f.responses.Keys(context.Background(), "issue_response:")
+		return err != nil || len(keys) == 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
+	f.getWithDetails(t)
+}
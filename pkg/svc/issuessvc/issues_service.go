@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/auth"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -15,14 +19,14 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 )
 
-const (
-	defaultPageSize = 10
-	maxPageSize     = 100
-)
+// detailsLookupTimeout bounds the project and assignee lookups of GetIssue with IncludeDetails
+const detailsLookupTimeout = 2 * time.Second
 
 // IssuesServiceServer is the main service structure for the Issues API
 type IssuesServiceServer struct {
@@ -32,15 +36,43 @@ type IssuesServiceServer struct {
 	userService    userPbv1.UserServiceClient
 	projectFetcher *ProjectServiceClientFetcher
 	userFetcher    *UserServiceClientFetcher
+	resolutions    *ResolutionValidator
+	messageBroker  broker.MessageBroker
+	pageSizes      pagination.Config
+	// responses caches GetIssue responses with details; see SetResponseCache
+	responses *issueResponseCache
+	// now ends digest windows; see SetClock
+	now func() time.Time
+	// teams are read for issues created for a team; see SetTeams
+	teams TeamReader
+	// teamTurns maps a team ID to the *atomic.Uint64 counting the issues assigned to its members
+	teamTurns sync.Map
+
+	// issueWatchers maps an issue ID to the []chan *issuesPbv1.IssueEvent of its WatchIssue streams
+	issueWatchers   sync.Map
+	issueWatchersMu sync.Mutex
 }
 
 // ProjectServiceClientFetcher fetches project-related data
 type ProjectServiceClientFetcher struct {
 	client projectPbv1.ProjectServiceClient
+	// cache is set once the service has a message broker to learn of project changes from
+	cache *projectDetailsCache
 }
 
 // GetProjectDetails fetches project details using the project service
 func (p *ProjectServiceClientFetcher) GetProjectDetails(ctx context.Context, projectID string) (*projectPbv1.Project, error) {
+	if p.cache != nil {
+		return p.cache.get(ctx, projectID, p.fetchProject)
+	}
+	return p.fetchProject(ctx, projectID)
+}
+
+// fetchProject calls the project service's GetProject
+func (p *ProjectServiceClientFetcher) fetchProject(ctx context.Context, projectID string) (*projectPbv1.Project, error) {
+	if p.client == nil {
+		return nil, errors.New("project service client not configured")
+	}
 	resp, err := p.client.GetProject(ctx, &projectPbv1.GetProjectRequest{ProjectId: projectID})
 	if err != nil {
 		return nil, err
@@ -55,11 +87,32 @@ type UserServiceClientFetcher struct {
 
 // GetUserDetails fetches user details using the user service
 func (u *UserServiceClientFetcher) GetUserDetails(ctx context.Context, userID string) (*userPbv1.User, error) {
-	resp, err := u.client.GetUser(ctx, &userPbv1.GetUserRequest{UserId: userID})
+	users, err := u.GetUsersDetails(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	user, ok := users[userID]
+	if !ok {
+		return nil, consts.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// GetUsersDetails fetches the details of several users with one BatchGetUsers call, keyed by
+// user ID. Users that don't exist are left out.
+func (u *UserServiceClientFetcher) GetUsersDetails(ctx context.Context, userIDs []string) (map[string]*userPbv1.User, error) {
+	if u.client == nil {
+		return nil, errors.New("user service client not configured")
+	}
+	resp, err := u.client.BatchGetUsers(ctx, &userPbv1.BatchGetUsersRequest{UserIds: userIDs})
 	if err != nil {
 		return nil, err
 	}
-	return resp.User, nil
+	users := make(map[string]*userPbv1.User, len(resp.Users))
+	for _, user := range resp.Users {
+		users[user.UserId] = user
+	}
+	return users, nil
 }
 
 // NewIssuesService creates a new instance of the issues service
@@ -70,31 +123,79 @@ func NewIssuesService(repository IssuesRepository, projectServiceClient projectP
 		userService:    userServiceClient,
 		projectFetcher: &ProjectServiceClientFetcher{client: projectServiceClient},
 		userFetcher:    &UserServiceClientFetcher{client: userServiceClient},
+		resolutions:    NewResolutionValidator(DefaultResolutionRules(repository.ReadIssue)...),
+		pageSizes:      pagination.ConfigFromEnv(),
+		now:            time.Now,
+	}
+}
+
+// Close ends the broker subscriptions the service keeps to learn of project changes. It should
+// be called before the broker is closed, and is safe to call more than once.
+func (s *IssuesServiceServer) Close() error {
+	if s.projectFetcher.cache != nil {
+		s.projectFetcher.cache.close()
 	}
+	return nil
 }
 
 // CreateIssue handles issue creation.
 func (s *IssuesServiceServer) CreateIssue(ctx context.Context, req *issuesPbv1.CreateIssueRequest) (*issuesPbv1.CreateIssueResponse, error) {
-	// Validate request
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	// Fill unset fields from the issue template, if one was given, so the result is validated
+	req, err := s.applyIssueTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the request, reporting every failed field so forms can point at all of them
+	if err := req.ValidateAll(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if err := checkSummary(req.Summary); err != nil {
+		return nil, err
+	}
+	description, err := normalizeDescription(req.GetDescription())
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate project existence
 	if err := s.repository.ValidateProjectExists(ctx, req.ProjectId); err != nil {
+		if isDependencyUnavailable(err) {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.InvalidArgument, "invalid project: %v", err)
 	}
+	if err := s.checkProjectIssueLimit(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
 
 	// Validate assignee if provided
-	if req.AssigneeId != nil && *req.AssigneeId != "" {
-		if err := s.repository.ValidateUserExists(ctx, *req.AssigneeId); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid user: %v", err)
+	assigneeID := req.GetAssigneeId()
+	if assigneeID != "" {
+		if err := s.repository.ValidateUserExists(ctx, assigneeID); err != nil {
+			return nil, assigneeError(err, "invalid user")
+		}
+		if err := s.checkAssigneeRole(ctx, req.ProjectId, assigneeID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Without an assignee, an issue created for a team goes to the member whose turn it is
+	if req.TeamId != nil {
+		team, err := s.readIssueTeam(ctx, *req.TeamId, req.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+		if assigneeID == "" {
+			if assigneeID, err = s.nextTeamAssignee(ctx, team); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	// Determine issue status
 	issueStatus := issuesPbv1.Status_NEW
-	if req.AssigneeId != nil && *req.AssigneeId != "" {
+	if assigneeID != "" {
 		issueStatus = issuesPbv1.Status_ASSIGNED
 	}
 
@@ -102,33 +203,27 @@ func (s *IssuesServiceServer) CreateIssue(ctx context.Context, req *issuesPbv1.C
 	issue := &issuesPbv1.Issue{
 		IssueId:     uuid.NewString(),
 		Summary:     req.Summary,
-		Description: req.GetDescription(),
+		Description: description,
 		Type:        req.Type,
 		Priority:    req.Priority,
 		Status:      issueStatus,
 		ProjectId:   req.ProjectId,
+		AssigneeId:  assigneeID,
+		TeamId:      req.GetTeamId(),
+		DueDate:     req.DueDate,
 		CreateDate:  timestamppb.Now(),
 		ModifyDate:  timestamppb.Now(),
 	}
 
-	// Assign assignee if provided
-	if req.AssigneeId != nil {
-		issue.AssigneeId = *req.AssigneeId
-	}
-
 	// Save issue
-	if err := s.repository.CreateIssue(issue); err != nil {
+	if err := s.repository.CreateIssue(ctx, issue); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create issue: %v", err)
 	}
 
-	// Notify the ProjectService about the new issue, but don't fail if this fails
-	projectErr := s.notifyProjectService(ctx, issue.ProjectId, issue.IssueId)
-	if projectErr != nil {
-		// Log the error but continue with issue creation
-		logger.ZapLogger.Error("Failed to notify ProjectService about new issue",
-			zap.String("issueId", issue.IssueId),
-			zap.String("projectId", issue.ProjectId),
-			zap.Error(projectErr))
+	// Notify the ProjectService about the new issue; whether a failure fails the call depends on
+	// STRICT_PROJECT_CONSISTENCY
+	if err := s.notifyCreatedIssue(ctx, issue); err != nil {
+		return nil, err
 	}
 
 	// Return response
@@ -138,10 +233,10 @@ func (s *IssuesServiceServer) CreateIssue(ctx context.Context, req *issuesPbv1.C
 // GetIssue retrieves an issue by its ID.
 func (s *IssuesServiceServer) GetIssue(ctx context.Context, req *issuesPbv1.GetIssueRequest) (*issuesPbv1.GetIssueResponse, error) {
 	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
 
-	issue, err := s.repository.ReadIssue(req.IssueId)
+	issue, err := s.repository.ReadIssue(ctx, req.IssueId)
 	if err != nil {
 		if errors.Is(err, consts.ErrNotFound) { // Ensure proper comparison
 			return nil, status.Error(codes.NotFound, "issue not found")
@@ -153,29 +248,128 @@ func (s *IssuesServiceServer) GetIssue(ctx context.Context, req *issuesPbv1.GetI
 
 	// Optionally fetch and attach detailed project/user info
 	if req.IncludeDetails {
-		project, _ := s.projectFetcher.GetProjectDetails(ctx, issue.ProjectId)
-		user, _ := s.userFetcher.GetUserDetails(ctx, issue.AssigneeId)
+		if cached := s.responses.get(ctx, issue); cached != nil {
+			return cached, nil
+		}
+		s.attachIssueDetails(ctx, resp)
+		s.responses.set(ctx, resp)
+	}
+
+	return resp, nil
+}
+
+// attachIssueDetails looks up the issue's project and assignee concurrently and attaches them to
+// resp. Failed lookups are reported in DetailsErrors instead of failing the call; issues without
+// an assignee skip the user lookup.
+func (s *IssuesServiceServer) attachIssueDetails(ctx context.Context, resp *issuesPbv1.GetIssueResponse) {
+	ctx, cancel := context.WithTimeout(ctx, detailsLookupTimeout)
+	defer cancel()
+
+	issue := resp.Issue
+	var project *projectPbv1.Project
+	var user *userPbv1.User
+	var projectErr, userErr error
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		project, projectErr = s.projectFetcher.GetProjectDetails(ctx, issue.ProjectId)
+	}()
+	if issue.AssigneeId != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			user, userErr = s.userFetcher.GetUserDetails(ctx, issue.AssigneeId)
+		}()
+	}
+	wg.Wait()
+
+	if projectErr != nil {
+		logger.FromContext(ctx).Warn("Failed to get issue project details",
+			zap.String("issueId", issue.IssueId),
+			zap.String("projectId", issue.ProjectId),
+			zap.Error(projectErr))
+		resp.DetailsErrors = append(resp.DetailsErrors, fmt.Sprintf("project lookup failed: %v", projectErr))
+	} else if project != nil {
+		resp.ProjectInfo = convertProjectToProjectInfo(project)
+	}
+	if userErr != nil {
+		logger.FromContext(ctx).Warn("Failed to get issue assignee details",
+			zap.String("issueId", issue.IssueId),
+			zap.String("assigneeId", issue.AssigneeId),
+			zap.Error(userErr))
+		resp.DetailsErrors = append(resp.DetailsErrors, fmt.Sprintf("assignee lookup failed: %v", userErr))
+	} else if user != nil {
+		resp.UserInfo = convertUserToUserInfo(user)
+	}
+}
+
+// GetIssueByProjectAndNumber retrieves an issue by its project and its number within the project
+func (s *IssuesServiceServer) GetIssueByProjectAndNumber(ctx context.Context, req *issuesPbv1.GetIssueByProjectAndNumberRequest) (*issuesPbv1.GetIssueByProjectAndNumberResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
 
-		if project != nil {
-			resp.ProjectInfo = convertProjectToProjectInfo(project)
+	issue, err := s.repository.ReadIssueByNumber(ctx, req.ProjectId, req.IssueNumber)
+	if err != nil {
+		if errors.Is(err, consts.ErrIssueNotFound) {
+			return nil, status.Error(codes.NotFound, "issue not found")
 		}
-		if user != nil {
-			resp.UserInfo = convertUserToUserInfo(user)
+		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+	}
+
+	return &issuesPbv1.GetIssueByProjectAndNumberResponse{Issue: issue}, nil
+}
+
+// BatchGetIssues retrieves several issues in one call. Repeated IDs are only looked up once,
+// and IDs without an issue are reported in NotFoundIds.
+func (s *IssuesServiceServer) BatchGetIssues(ctx context.Context, req *issuesPbv1.BatchGetIssuesRequest) (*issuesPbv1.BatchGetIssuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	seen := make(map[string]bool, len(req.IssueIds))
+	issueIDs := make([]string, 0, len(req.IssueIds))
+	for _, issueID := range req.IssueIds {
+		if !seen[issueID] {
+			seen[issueID] = true
+			issueIDs = append(issueIDs, issueID)
 		}
 	}
 
+	issues, err := s.repository.BatchGetIssues(ctx, issueIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get issues: %v", err)
+	}
+
+	found := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		found[issue.IssueId] = true
+	}
+	resp := &issuesPbv1.BatchGetIssuesResponse{Issues: issues}
+	for _, issueID := range issueIDs {
+		if !found[issueID] {
+			resp.NotFoundIds = append(resp.NotFoundIds, issueID)
+		}
+	}
 	return resp, nil
 }
 
 // UpdateIssue modifies an existing issue.
-//
-//nolint:gocyclo,funlen
 func (s *IssuesServiceServer) UpdateIssue(ctx context.Context, req *issuesPbv1.UpdateIssueRequest) (*issuesPbv1.UpdateIssueResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	if err := req.ValidateAll(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if err := checkSummary(req.Summary); err != nil {
+		return nil, err
+	}
+	description, err := normalizeDescription(req.GetDescription())
+	if err != nil {
+		return nil, err
 	}
 
-	issue, err := s.repository.ReadIssue(req.IssueId)
+	issue, err := s.repository.ReadIssue(ctx, req.IssueId)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return nil, status.Error(codes.NotFound, "issue not found")
@@ -183,106 +377,397 @@ func (s *IssuesServiceServer) UpdateIssue(ctx context.Context, req *issuesPbv1.U
 		return nil, status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
 	}
 
+	// Fail fast if the client edited a stale copy; the repository re-checks this atomically
+	if req.Version != issue.Version {
+		return nil, status.Errorf(codes.Aborted, "issue has been modified since version %d (current version %d); reload it and retry", req.Version, issue.Version)
+	}
+
+	newStatus, autoAdjustStatus, err := validateIssueUpdate(issue, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate assignee ID if it's being changed
+	if req.AssigneeId != nil && *req.AssigneeId != "" && *req.AssigneeId != issue.AssigneeId {
+		if err := s.repository.ValidateUserExists(ctx, *req.AssigneeId); err != nil {
+			return nil, assigneeError(err, "invalid assignee")
+		}
+		if err := s.checkAssigneeRole(ctx, issue.ProjectId, *req.AssigneeId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply the status change (transition check skipped if auto-adjusted)
+	change := statusChange{
+		newStatus:           newStatus,
+		resolution:          req.Resolution,
+		comment:             req.GetResolutionComment(),
+		duplicateOf:         req.GetDuplicateOf(),
+		assigneeID:          updatedAssigneeID(issue, req),
+		skipTransitionCheck: autoAdjustStatus,
+	}
+	if err := s.applyStatusChange(ctx, issue, change); err != nil {
+		return nil, err
+	}
+
+	// Update issue fields
+	issue.Summary = req.Summary
+	issue.Description = description
+	issue.Type = req.Type
+	issue.Priority = req.Priority
+	if req.DueDate != nil {
+		issue.DueDate = req.DueDate
+	}
+	issue.ModifyDate = timestamppb.Now()
+
+	// Update assignee (if provided) or remove it (if explicitly set to empty)
+	if req.AssigneeId != nil {
+		issue.AssigneeId = *req.AssigneeId
+	} else if newStatus == issuesPbv1.Status_NEW {
+		// Ensure NEW status has no assignee
+		issue.AssigneeId = ""
+	}
+
+	if err := s.repository.UpdateIssue(ctx, issue); err != nil {
+		return nil, updateIssueError(err)
+	}
+	s.notifyIssueWatchers(IssueEventUpdated, issue)
+
+	// Create response with additional information
+	responseMsg := fmt.Sprintf("Issue with id %s has been updated", issue.IssueId)
+	if autoAdjustStatus {
+		responseMsg += fmt.Sprintf(" (status automatically adjusted from %s to %s based on assignee)", req.Status, newStatus)
+	}
+
+	return &issuesPbv1.UpdateIssueResponse{
+		Issue:   issue,
+		Message: responseMsg,
+	}, nil
+}
+
+// validateIssueUpdate checks an update against the current issue and works out the status the
+// issue will end up in. Adding an assignee to an unassigned issue forces the status to ASSIGNED,
+// in which case autoAdjusted is true and the workflow transition check should be skipped.
+// It has no side effects; checks that need the repository are left to the caller.
+func validateIssueUpdate(current *issuesPbv1.Issue, req *issuesPbv1.UpdateIssueRequest) (adjustedStatus issuesPbv1.Status, autoAdjusted bool, err error) {
 	// Basic field validations
 	if req.Summary == "" || (req.Description != nil && *req.Description == "") ||
 		req.Type == issuesPbv1.Type_TYPE_UNSPECIFIED ||
 		req.Priority == issuesPbv1.Priority_PRIORITY_UNSPECIFIED ||
 		req.Status == issuesPbv1.Status_STATUS_UNSPECIFIED {
-		return nil, status.Error(codes.InvalidArgument, "summary, description, type, priority, and status are required for update")
+		return req.Status, false, status.Error(codes.InvalidArgument, "summary, description, type, priority, and status are required for update")
 	}
 
-	// Determine if assignee is being updated
 	assigneeUpdated := req.AssigneeId != nil
 	hasAssignee := assigneeUpdated && *req.AssigneeId != ""
 
-	// Validate assignee ID if it's being updated
-	if hasAssignee && *req.AssigneeId != issue.AssigneeId {
-		if err := s.repository.ValidateUserExists(ctx, *req.AssigneeId); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid assignee: %v", err)
+	// Removing the assignee rules out the statuses that require one
+	if assigneeUpdated && !hasAssignee && current.AssigneeId != "" &&
+		(req.Status == issuesPbv1.Status_ASSIGNED || req.Status == issuesPbv1.Status_IN_PROGRESS) {
+		return req.Status, false, status.Error(codes.InvalidArgument, "cannot set status to ASSIGNED or IN_PROGRESS when removing an assignee")
+	}
+
+	adjustedStatus = req.Status
+	// If assignee is being added (and there wasn't one before), enforce status ASSIGNED
+	if hasAssignee && current.AssigneeId == "" {
+		adjustedStatus = issuesPbv1.Status_ASSIGNED
+		autoAdjusted = true
+	}
+
+	err = checkStatusRequirements(adjustedStatus, req.Resolution, updatedAssigneeID(current, req))
+	return adjustedStatus, autoAdjusted, err
+}
+
+// updatedAssigneeID returns the assignee the issue will have once the update is saved
+func updatedAssigneeID(current *issuesPbv1.Issue, req *issuesPbv1.UpdateIssueRequest) string {
+	if req.AssigneeId != nil {
+		return *req.AssigneeId
+	}
+	return current.AssigneeId
+}
+
+// checkAssigneeRole refuses assignees whose role in the project is viewer. Users who aren't
+// members of the project aren't restricted.
+func (s *IssuesServiceServer) checkAssigneeRole(ctx context.Context, projectID, assigneeID string) error {
+	role, err := s.repository.GetProjectMemberRole(ctx, projectID, assigneeID)
+	if errors.Is(err, consts.ErrProjectMemberNotFound) {
+		return nil
+	}
+	if err != nil {
+		if isDependencyUnavailable(err) {
+			return err
 		}
+		return status.Errorf(codes.Internal, "failed to check the assignee's project role: %v", err)
+	}
+	if role == projectsvc.RoleViewer {
+		return status.Error(codes.PermissionDenied, "viewer users cannot be assigned to issues")
 	}
+	return nil
+}
 
-	// Enforce status based on assignee
-	autoAdjustStatus := false
-	requestedStatus := req.Status
+// AssignIssue sets or clears the assignee of an issue without requiring a full update.
+// Assigning a NEW issue moves it to ASSIGNED; unassigning an ASSIGNED or IN_PROGRESS issue moves it back to NEW.
+func (s *IssuesServiceServer) AssignIssue(ctx context.Context, req *issuesPbv1.AssignIssueRequest) (*issuesPbv1.AssignIssueResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
 
-	// If assignee is being removed, enforce status NEW
-	if assigneeUpdated && !hasAssignee && issue.AssigneeId != "" {
-		// Validate the status isn't ASSIGNED or IN_PROGRESS
-		if req.Status == issuesPbv1.Status_ASSIGNED || req.Status == issuesPbv1.Status_IN_PROGRESS {
-			return nil, status.Error(codes.InvalidArgument, "cannot set status to ASSIGNED or IN_PROGRESS when removing an assignee")
+	issue, err := s.repository.ReadIssue(ctx, req.IssueId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound || errors.Is(err, consts.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "issue not found")
 		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
 	}
 
-	// If assignee is being added (and there wasn't one before), enforce status ASSIGNED
-	if hasAssignee && issue.AssigneeId == "" {
-		req.Status = issuesPbv1.Status_ASSIGNED
-		autoAdjustStatus = true
+	previousStatus := issue.Status
+	if req.AssigneeId != "" {
+		if req.AssigneeId != issue.AssigneeId {
+			if err := s.repository.ValidateUserExists(ctx, req.AssigneeId); err != nil {
+				return nil, assigneeError(err, "invalid assignee")
+			}
+			if err := s.checkAssigneeRole(ctx, issue.ProjectId, req.AssigneeId); err != nil {
+				return nil, err
+			}
+		}
+		if issue.Status == issuesPbv1.Status_NEW {
+			issue.Status = issuesPbv1.Status_ASSIGNED
+		}
+	} else if issue.Status == issuesPbv1.Status_ASSIGNED || issue.Status == issuesPbv1.Status_IN_PROGRESS {
+		// These statuses require an assignee, so the issue goes back to the queue
+		issue.Status = issuesPbv1.Status_NEW
 	}
 
-	// Validate resolution if status is Resolved or Closed
-	if (req.Status == issuesPbv1.Status_RESOLVED || req.Status == issuesPbv1.Status_CLOSED) &&
-		req.Resolution == issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
-		return nil, status.Error(codes.InvalidArgument, "resolution is required when status is Resolved or Closed")
+	issue.AssigneeId = req.AssigneeId
+	issue.ModifyDate = timestamppb.Now()
+
+	if err := s.repository.UpdateIssue(ctx, issue); err != nil {
+		return nil, updateIssueError(err)
 	}
+	s.notifyIssueWatchers(IssueEventUpdated, issue)
 
-	// Validate assignee if status is Assigned or In Progress
-	if (req.Status == issuesPbv1.Status_ASSIGNED || req.Status == issuesPbv1.Status_IN_PROGRESS) &&
-		(assigneeUpdated && !hasAssignee || (!assigneeUpdated && issue.AssigneeId == "")) {
-		return nil, status.Error(codes.InvalidArgument, "assignee is required when status is Assigned or In Progress")
+	responseMsg := fmt.Sprintf("Issue with id %s has been assigned to %s", issue.IssueId, issue.AssigneeId)
+	if issue.AssigneeId == "" {
+		responseMsg = fmt.Sprintf("Issue with id %s has been unassigned", issue.IssueId)
+	}
+	if issue.Status != previousStatus {
+		responseMsg += fmt.Sprintf(" (status automatically adjusted from %s to %s based on assignee)", previousStatus, issue.Status)
 	}
 
-	// Validate status transition (skip if auto-adjusted)
-	if !autoAdjustStatus {
-		if err := s.repository.IsValidStatusTransition(issue.Status, req.Status); err != nil {
-			return nil, err
+	return &issuesPbv1.AssignIssueResponse{
+		Issue:   issue,
+		Message: responseMsg,
+	}, nil
+}
+
+// ChangeIssueStatus moves an issue through its workflow without requiring the other issue fields.
+// The resolution may be omitted when the issue already has one.
+func (s *IssuesServiceServer) ChangeIssueStatus(ctx context.Context, req *issuesPbv1.ChangeIssueStatusRequest) (*issuesPbv1.ChangeIssueStatusResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	issue, err := s.repository.ReadIssue(ctx, req.IssueId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound || errors.Is(err, consts.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "issue not found")
 		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
 	}
 
-	// Update issue fields
-	issue.Summary = req.Summary
-	issue.Description = req.GetDescription()
-	issue.Type = req.Type
-	issue.Priority = req.Priority
-	issue.Status = req.Status
+	// Anything omitted carries over from the issue, e.g. when closing a resolved issue
+	change := statusChange{
+		newStatus:   req.NewStatus,
+		resolution:  req.Resolution,
+		comment:     req.ResolutionComment,
+		duplicateOf: req.DuplicateOf,
+		assigneeID:  issue.AssigneeId,
+	}
+	if change.resolution == issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
+		change.resolution = issue.Resolution
+		if change.duplicateOf == "" {
+			change.duplicateOf = issue.DuplicateOf
+		}
+	}
+	if change.comment == "" {
+		change.comment = issue.ResolutionComment
+	}
+
+	previousStatus := issue.Status
+	if err := s.applyStatusChange(ctx, issue, change); err != nil {
+		return nil, err
+	}
 	issue.ModifyDate = timestamppb.Now()
 
-	// Update assignee (if provided) or remove it (if explicitly set to empty)
-	if assigneeUpdated {
-		issue.AssigneeId = *req.AssigneeId
-	} else if req.Status == issuesPbv1.Status_NEW {
-		// Ensure NEW status has no assignee
-		issue.AssigneeId = ""
+	if err := s.repository.UpdateIssue(ctx, issue); err != nil {
+		return nil, updateIssueError(err)
 	}
+	s.notifyIssueWatchers(IssueEventUpdated, issue)
+
+	return &issuesPbv1.ChangeIssueStatusResponse{
+		Issue:   issue,
+		Message: fmt.Sprintf("Issue with id %s moved from %s to %s", issue.IssueId, previousStatus, issue.Status),
+	}, nil
+}
 
-	// Only update resolution if specified
-	if req.Resolution != issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
-		issue.Resolution = req.Resolution
+// ReopenIssue moves a RESOLVED or CLOSED issue back to NEW, or to ASSIGNED if an assignee is given or
+// its assignee still exists. The workflow has no transitions out of CLOSED, so reopening is always
+// this explicit action, and the issue records who reopened it and why.
+func (s *IssuesServiceServer) ReopenIssue(ctx context.Context, req *issuesPbv1.ReopenIssueRequest) (*issuesPbv1.ReopenIssueResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
 
-	if err := s.repository.UpdateIssue(issue); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update issue: %v", err)
+	issue, err := s.repository.ReadIssue(ctx, req.IssueId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound || errors.Is(err, consts.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "issue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
 	}
 
-	// Create response with additional information
-	responseMsg := fmt.Sprintf("Issue with id %s has been updated", issue.IssueId)
-	if autoAdjustStatus {
-		responseMsg += fmt.Sprintf(" (status automatically adjusted from %s to %s based on assignee)", requestedStatus, req.Status)
+	if issue.Status != issuesPbv1.Status_RESOLVED && issue.Status != issuesPbv1.Status_CLOSED {
+		return nil, status.Errorf(codes.FailedPrecondition, "only RESOLVED or CLOSED issues can be reopened, issue is %s", issue.Status)
 	}
 
-	return &issuesPbv1.UpdateIssueResponse{
+	previousStatus := issue.Status
+	issue.Status = issuesPbv1.Status_NEW
+	if req.AssigneeId != nil {
+		if err := s.repository.ValidateUserExists(ctx, req.GetAssigneeId()); err != nil {
+			return nil, assigneeError(err, "invalid assignee")
+		}
+		if err := s.checkAssigneeRole(ctx, issue.ProjectId, req.GetAssigneeId()); err != nil {
+			return nil, err
+		}
+		issue.AssigneeId = req.GetAssigneeId()
+		issue.Status = issuesPbv1.Status_ASSIGNED
+	} else if issue.AssigneeId != "" {
+		if err := s.repository.ValidateUserExists(ctx, issue.AssigneeId); err != nil {
+			if isDependencyUnavailable(err) {
+				return nil, err
+			}
+			// The assignee has been deleted or deactivated since the issue was closed, so it goes back to the queue
+			logger.FromContext(ctx).Warn("Assignee of reopened issue no longer exists or is deactivated, clearing it",
+				zap.String("issue_id", issue.IssueId),
+				zap.String("assignee_id", issue.AssigneeId),
+				zap.Error(err))
+			issue.AssigneeId = ""
+		} else {
+			issue.Status = issuesPbv1.Status_ASSIGNED
+		}
+	}
+
+	issue.Resolution = issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED
+	issue.ResolutionComment = ""
+	issue.DuplicateOf = ""
+	issue.ResolveDate = nil
+	issue.ReopenCount++
+	issue.LastReopenReason = req.Reason
+	issue.LastReopenedBy = auth.ActorIDFromContext(ctx)
+	issue.ModifyDate = timestamppb.Now()
+
+	if err := s.repository.UpdateIssue(ctx, issue); err != nil {
+		return nil, updateIssueError(err)
+	}
+	s.notifyIssueWatchers(IssueEventReopened, issue)
+
+	return &issuesPbv1.ReopenIssueResponse{
 		Issue:   issue,
-		Message: responseMsg,
+		Message: fmt.Sprintf("Issue with id %s reopened from %s to %s", issue.IssueId, previousStatus, issue.Status),
 	}, nil
 }
 
+// statusChange is a requested status change along with the data the status rules need
+type statusChange struct {
+	newStatus   issuesPbv1.Status
+	resolution  issuesPbv1.Resolution
+	comment     string
+	duplicateOf string
+	// assigneeID is the assignee the issue will have once the change is saved
+	assigneeID          string
+	skipTransitionCheck bool
+}
+
+// applyStatusChange validates a status change against the workflow, open blockers and resolution
+// rules and applies it to the issue. The resolution details are only applied when a resolution is specified.
+func (s *IssuesServiceServer) applyStatusChange(ctx context.Context, issue *issuesPbv1.Issue, change statusChange) error {
+	if err := s.checkStatusChange(ctx, issue, change); err != nil {
+		return err
+	}
+
+	issue.Status = change.newStatus
+	// Closing a resolved issue keeps the date it was resolved on
+	if (change.newStatus == issuesPbv1.Status_RESOLVED || change.newStatus == issuesPbv1.Status_CLOSED) &&
+		issue.ResolveDate == nil {
+		issue.ResolveDate = timestamppb.Now()
+	}
+	if change.resolution != issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
+		issue.Resolution = change.resolution
+		issue.ResolutionComment = change.comment
+		issue.DuplicateOf = change.duplicateOf
+	}
+	return nil
+}
+
+// checkStatusChange validates a status change against the workflow, open blockers and resolution
+// rules without changing the issue
+func (s *IssuesServiceServer) checkStatusChange(ctx context.Context, issue *issuesPbv1.Issue, change statusChange) error {
+	if err := checkStatusRequirements(change.newStatus, change.resolution, change.assigneeID); err != nil {
+		return err
+	}
+
+	if !change.skipTransitionCheck {
+		if err := s.repository.IsValidStatusTransition(ctx, issue.Status, change.newStatus); err != nil {
+			return err
+		}
+	}
+
+	if change.newStatus != issue.Status &&
+		(change.newStatus == issuesPbv1.Status_RESOLVED || change.newStatus == issuesPbv1.Status_CLOSED) {
+		if err := s.checkOpenBlockers(ctx, issue.IssueId); err != nil {
+			return err
+		}
+	}
+
+	return s.resolutions.Validate(ctx, ResolutionChange{
+		Issue:       issue,
+		NewStatus:   change.newStatus,
+		Resolution:  change.resolution,
+		Comment:     change.comment,
+		DuplicateOf: change.duplicateOf,
+	})
+}
+
+// updateIssueError converts a failed repository write into a gRPC status error
+func updateIssueError(err error) error {
+	if errors.Is(err, consts.ErrIssueVersionConflict) {
+		return status.Error(codes.Aborted, "issue was modified by another request; reload it and retry")
+	}
+	return status.Errorf(codes.Internal, "failed to update issue: %v", err)
+}
+
+// checkStatusRequirements enforces the fields a status needs: a resolution for RESOLVED and CLOSED,
+// and an assignee for ASSIGNED and IN_PROGRESS
+func checkStatusRequirements(newStatus issuesPbv1.Status, resolution issuesPbv1.Resolution, assigneeID string) error {
+	if (newStatus == issuesPbv1.Status_RESOLVED || newStatus == issuesPbv1.Status_CLOSED) &&
+		resolution == issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "resolution is required when status is Resolved or Closed")
+	}
+
+	if (newStatus == issuesPbv1.Status_ASSIGNED || newStatus == issuesPbv1.Status_IN_PROGRESS) &&
+		assigneeID == "" {
+		return status.Error(codes.InvalidArgument, "assignee is required when status is Assigned or In Progress")
+	}
+	return nil
+}
+
 // DeleteIssue removes an issue by its ID.
-func (s *IssuesServiceServer) DeleteIssue(_ context.Context, req *issuesPbv1.DeleteIssueRequest) (*issuesPbv1.DeleteIssueResponse, error) {
+func (s *IssuesServiceServer) DeleteIssue(ctx context.Context, req *issuesPbv1.DeleteIssueRequest) (*issuesPbv1.DeleteIssueResponse, error) {
 	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
 
-	issue, err := s.repository.ReadIssue(req.IssueId)
+	issue, err := s.repository.ReadIssue(ctx, req.IssueId)
 	if err != nil {
 		if errors.Is(err, status.Error(codes.NotFound, "issue not found")) {
 			return nil, status.Error(codes.NotFound, "issue not found")
@@ -290,28 +775,27 @@ func (s *IssuesServiceServer) DeleteIssue(_ context.Context, req *issuesPbv1.Del
 		return nil, status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
 	}
 
-	if err := s.repository.DeleteIssue(req.IssueId); err != nil {
+	if err := s.repository.DeleteIssue(ctx, req.IssueId); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete issue: %v", err)
 	}
+	s.notifyIssueWatchers(IssueEventDeleted, issue)
 
 	return &issuesPbv1.DeleteIssueResponse{Issue: issue}, nil
 }
 
 // ListIssues retrieves paginated issues.
-func (s *IssuesServiceServer) ListIssues(_ context.Context, req *issuesPbv1.ListIssuesRequest) (*issuesPbv1.ListIssuesResponse, error) {
+func (s *IssuesServiceServer) ListIssues(ctx context.Context, req *issuesPbv1.ListIssuesRequest) (*issuesPbv1.ListIssuesResponse, error) {
 	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
-	}
-
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 {
-		pageSize = defaultPageSize
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
-	if pageSize > maxPageSize {
-		pageSize = maxPageSize
+	if err := s.pageSizes.CheckPageSize(req.PageSize); err != nil {
+		return nil, err
 	}
 
-	issues, nextPageToken, err := s.repository.ListIssues(req.PageToken, pageSize)
+	issues, nextPageToken, err := s.repository.ListIssues(ctx, req.PageToken, s.pageSizes.PageSize(req.PageSize))
+	if errors.Is(err, pagination.ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: invalid page token")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list issues: %v", err)
 	}
@@ -324,6 +808,11 @@ func (s *IssuesServiceServer) ListIssues(_ context.Context, req *issuesPbv1.List
 
 // notifyProjectService notify the issue creation for the project
 func (s *IssuesServiceServer) notifyProjectService(ctx context.Context, projectID, issueID string) error {
+	// In-memory storage with USE_LOCAL_CLIENTS runs without a project service client
+	if s.projectService == nil {
+		return nil
+	}
+
 	// Add context timeout to prevent long-running requests
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
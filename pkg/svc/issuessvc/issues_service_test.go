@@ -7,14 +7,19 @@ import (
 	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/mocks"
+	"github.com/yasindce1998/issue-tracker/pkg/auth"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -60,7 +65,8 @@ func TestIssuesServiceServer_CreateIssue(t *testing.T) {
 			},
 			setupMock: func() {
 				mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), validProjectID).Return(nil)
-				mockRepo.EXPECT().CreateIssue(gomock.Any()).DoAndReturn(func(issue *issuesPbv1.Issue) error {
+				mockRepo.EXPECT().GetProjectMaxIssues(gomock.Any(), validProjectID).Return(int32(0), nil)
+				mockRepo.EXPECT().CreateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
 					// Instead of checking UUID directly, just ensure it's not empty
 					assert.NotEmpty(t, issue.IssueId)
 					assert.Equal(t, issuesPbv1.Status_NEW, issue.Status)
@@ -92,8 +98,10 @@ func TestIssuesServiceServer_CreateIssue(t *testing.T) {
 			},
 			setupMock: func() {
 				mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), validProjectID).Return(nil)
+				mockRepo.EXPECT().GetProjectMaxIssues(gomock.Any(), validProjectID).Return(int32(0), nil)
 				mockRepo.EXPECT().ValidateUserExists(gomock.Any(), validUserID).Return(nil)
-				mockRepo.EXPECT().CreateIssue(gomock.Any()).DoAndReturn(func(issue *issuesPbv1.Issue) error {
+				mockRepo.EXPECT().GetProjectMemberRole(gomock.Any(), gomock.Any(), validUserID).Return(projectsvc.RoleUnspecified, consts.ErrProjectMemberNotFound)
+				mockRepo.EXPECT().CreateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
 					assert.NotEmpty(t, issue.IssueId)
 					assert.Equal(t, issuesPbv1.Status_ASSIGNED, issue.Status)
 					assert.Equal(t, validUserID, issue.AssigneeId)
@@ -161,45 +169,12 @@ func TestIssuesServiceServer_CreateIssue(t *testing.T) {
 			},
 			setupMock: func() {
 				mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), validProjectID).Return(nil)
-				mockRepo.EXPECT().CreateIssue(gomock.Any()).Return(consts.ErrDatabaseError)
+				mockRepo.EXPECT().GetProjectMaxIssues(gomock.Any(), validProjectID).Return(int32(0), nil)
+				mockRepo.EXPECT().CreateIssue(gomock.Any(), gomock.Any()).Return(consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
 			expectedError: status.Errorf(codes.Internal, "failed to create issue: %v", consts.ErrDatabaseError),
 		},
-		{
-			name: "Failed To Notify Project Service But Creation Succeeds",
-			req: &issuesPbv1.CreateIssueRequest{
-				Summary:     testSummary,
-				Description: proto.String(testDescription),
-				Type:        issuesPbv1.Type_BUG,
-				Priority:    issuesPbv1.Priority_MINOR,
-				ProjectId:   validProjectID,
-			},
-			setupMock: func() {
-				mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), validProjectID).Return(nil)
-				mockRepo.EXPECT().CreateIssue(gomock.Any()).DoAndReturn(func(issue *issuesPbv1.Issue) error {
-					// Manually set the ID for consistent testing of the response
-					issue.IssueId = validIssueID
-					return nil
-				})
-				// This simulates the Docker networking error you're experiencing
-				mockProjectService.EXPECT().UpdateProjectWithIssue(gomock.Any(), gomock.Any()).Return(
-					nil, status.Error(codes.Unavailable, "name resolver error: produced zero addresses"))
-			},
-			// The operation should succeed even though notification fails
-			expectedResp: &issuesPbv1.CreateIssueResponse{
-				Issue: &issuesPbv1.Issue{
-					IssueId:     validIssueID,
-					Summary:     testSummary,
-					Description: testDescription,
-					Type:        issuesPbv1.Type_BUG,
-					Priority:    issuesPbv1.Priority_MINOR,
-					Status:      issuesPbv1.Status_NEW,
-					ProjectId:   validProjectID,
-				},
-			},
-			expectedError: nil, // No error should be returned despite notification failure
-		},
 	}
 
 	for _, tc := range testCases {
@@ -256,6 +231,95 @@ func TestIssuesServiceServer_CreateIssue(t *testing.T) {
 	}
 }
 
+// headerStream captures the headers a handler sets, standing in for the gRPC server's stream
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestIssuesServiceServer_CreateIssueProjectNotificationFails(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	notifyErr := status.Error(codes.Unavailable, "name resolver error: produced zero addresses")
+
+	testCases := []struct {
+		name          string
+		strict        string
+		rollbackErr   error
+		expectedMode  string
+		expectedError string
+	}{
+		{
+			name:         "Failed To Notify Project Service But Creation Succeeds",
+			strict:       "",
+			expectedMode: issuessvc.ProjectConsistencyLenient,
+		},
+		{
+			name:          "Failed To Notify Project Service In Strict Mode Removes The Issue",
+			strict:        "true",
+			expectedMode:  issuessvc.ProjectConsistencyStrict,
+			expectedError: "rpc error: code = Unavailable desc = failed to notify the project service, so the issue was not created: " + notifyErr.Error(),
+		},
+		{
+			name:          "Failed To Remove The Issue In Strict Mode",
+			strict:        "true",
+			rollbackErr:   consts.ErrDatabaseError,
+			expectedMode:  issuessvc.ProjectConsistencyStrict,
+			expectedError: "rpc error: code = Unavailable desc = failed to notify the project service, and issue " + validIssueID + " could not be removed again: " + notifyErr.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("STRICT_PROJECT_CONSISTENCY", tc.strict)
+			ctrl := gomock.NewController(t)
+			mockRepo := mocks.NewMockIssuesRepository(ctrl)
+			mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+			issuesService := issuessvc.NewIssuesService(mockRepo, mockProjectService, mocks.NewMockUserServiceClient(ctrl))
+
+			mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), validProjectID).Return(nil)
+			mockRepo.EXPECT().GetProjectMaxIssues(gomock.Any(), validProjectID).Return(int32(0), nil)
+			mockRepo.EXPECT().CreateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
+				// Manually set the ID for consistent testing of the response
+				issue.IssueId = validIssueID
+				return nil
+			})
+			// This simulates the project service being unreachable over the Docker network
+			mockProjectService.EXPECT().UpdateProjectWithIssue(gomock.Any(), gomock.Any()).Return(nil, notifyErr)
+			if tc.strict == "true" {
+				mockRepo.EXPECT().DeleteIssue(gomock.Any(), validIssueID).Return(tc.rollbackErr)
+			}
+
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			resp, err := issuesService.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+				Summary:     testSummary,
+				Description: proto.String(testDescription),
+				Type:        issuesPbv1.Type_BUG,
+				Priority:    issuesPbv1.Priority_MINOR,
+				ProjectId:   validProjectID,
+			})
+			assert.Equal(t, []string{tc.expectedMode}, stream.header.Get(issuessvc.ProjectConsistencyHeader))
+
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, codes.Unavailable, status.Code(err))
+				assert.Equal(t, tc.expectedError, err.Error())
+				assert.Nil(t, resp)
+				return
+			}
+			// The operation succeeds even though notification fails
+			require.NoError(t, err)
+			assert.Equal(t, validIssueID, resp.Issue.IssueId)
+			assert.Equal(t, issuesPbv1.Status_NEW, resp.Issue.Status)
+		})
+	}
+}
+
 func TestIssuesServiceServer_GetIssue(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -284,7 +348,7 @@ func TestIssuesServiceServer_GetIssue(t *testing.T) {
 				IssueId: validIssueID,
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ReadIssue(gomock.Any()).Return(&issuesPbv1.Issue{
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), gomock.Any()).Return(&issuesPbv1.Issue{
 					IssueId:     validIssueID,
 					Summary:     testSummary,
 					Description: testDescription,
@@ -324,7 +388,7 @@ func TestIssuesServiceServer_GetIssue(t *testing.T) {
 				IssueId: validIssueID,
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ReadIssue(gomock.Any()).Return(nil, consts.ErrNotFound)
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), gomock.Any()).Return(nil, consts.ErrNotFound)
 			},
 			expectedResp:  nil,
 			expectedError: status.Errorf(codes.NotFound, "issue not found"),
@@ -335,7 +399,7 @@ func TestIssuesServiceServer_GetIssue(t *testing.T) {
 				IssueId: validIssueID,
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ReadIssue(gomock.Any()).Return(nil, consts.ErrDatabaseError)
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), gomock.Any()).Return(nil, consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
 			expectedError: status.Errorf(codes.Internal, "failed to get issue: database error"),
@@ -367,6 +431,32 @@ func TestIssuesServiceServer_GetIssue(t *testing.T) {
 	}
 }
 
+func TestIssuesServiceServer_BatchGetIssues(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary}))
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validProjectID, Summary: bugSummary}))
+	issuesService := issuessvc.NewIssuesService(repo, nil, nil)
+
+	resp, err := issuesService.BatchGetIssues(context.Background(), &issuesPbv1.BatchGetIssuesRequest{
+		IssueIds: []string{validProjectID, validUserID, validIssueID, validProjectID},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Issues, 2)
+	assert.Equal(t, validProjectID, resp.Issues[0].IssueId)
+	assert.Equal(t, validIssueID, resp.Issues[1].IssueId)
+	assert.Equal(t, []string{validUserID}, resp.NotFoundIds)
+
+	tooMany := make([]string, 51)
+	for i := range tooMany {
+		tooMany[i] = validIssueID
+	}
+	for _, ids := range [][]string{nil, tooMany, {invalidProjectID}} {
+		_, err := issuesService.BatchGetIssues(context.Background(), &issuesPbv1.BatchGetIssuesRequest{IssueIds: ids})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
 func TestIssuesServiceServer_UpdateIssue(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -397,7 +487,7 @@ func TestIssuesServiceServer_UpdateIssue(t *testing.T) {
 			expectedResp:  nil,
 			expectedError: codes.InvalidArgument,
 			expectedMsg: "invalid request: invalid UpdateIssueRequest.Summary: " +
-				"value length must be between 1 and 100 runes, inclusive",
+				"value length must be at least 1 runes",
 		},
 		{
 			name: "status transition is invalid",
@@ -409,14 +499,16 @@ func TestIssuesServiceServer_UpdateIssue(t *testing.T) {
 				Priority:    issuesPbv1.Priority_CRITICAL,
 				Status:      issuesPbv1.Status_CLOSED,
 				Resolution:  issuesPbv1.Resolution_FIXED, // Required for closed/resolved statuses.
+				Version:     1,
 			},
 			setupMock: func(mockRepo *mocks.MockIssuesRepository) {
-				mockRepo.EXPECT().ReadIssue(validIssueID).Return(&issuesPbv1.Issue{
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
 					IssueId: validIssueID,
 					Status:  issuesPbv1.Status_NEW, // Current status is NEW.
+					Version: 1,
 				}, nil)
 
-				mockRepo.EXPECT().IsValidStatusTransition(
+				mockRepo.EXPECT().IsValidStatusTransition(gomock.Any(), 
 					issuesPbv1.Status_NEW,
 					issuesPbv1.Status_CLOSED,
 				).Return(status.Error(codes.InvalidArgument, "invalid status transition"))
@@ -435,18 +527,21 @@ func TestIssuesServiceServer_UpdateIssue(t *testing.T) {
 				Priority:    issuesPbv1.Priority_MINOR,
 				Status:      issuesPbv1.Status_NEW,     // No assignee initially.
 				AssigneeId:  proto.String(validUserID), // New assignee.
+				Version:     1,
 			},
 			setupMock: func(mockRepo *mocks.MockIssuesRepository) {
-				mockRepo.EXPECT().ReadIssue(validIssueID).Return(&issuesPbv1.Issue{
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
 					IssueId:    validIssueID,
 					Status:     issuesPbv1.Status_NEW,
 					AssigneeId: "", // No assignee.
+					Version:    1,
 				}, nil)
 
 				mockRepo.EXPECT().ValidateUserExists(gomock.Any(), validUserID).Return(nil)
+				mockRepo.EXPECT().GetProjectMemberRole(gomock.Any(), gomock.Any(), validUserID).Return(projectsvc.RoleUnspecified, consts.ErrProjectMemberNotFound)
 				// No IsValidStatusTransition validation because auto-adjustment to ASSIGNED happens.
 
-				mockRepo.EXPECT().UpdateIssue(gomock.Any()).DoAndReturn(func(issue *issuesPbv1.Issue) error {
+				mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
 					// Verify that the issue has been properly updated
 					assert.Equal(t, "Feature Request", issue.Summary)
 					assert.Equal(t, testDescription, issue.Description)
@@ -471,6 +566,53 @@ func TestIssuesServiceServer_UpdateIssue(t *testing.T) {
 			expectedError: codes.OK,
 			expectedMsg:   "Issue with id",
 		},
+		{
+			name: "stale version",
+			req: &issuesPbv1.UpdateIssueRequest{
+				IssueId:     validIssueID,
+				Summary:     testSummary,
+				Description: proto.String(testDescription),
+				Type:        issuesPbv1.Type_BUG,
+				Priority:    issuesPbv1.Priority_MAJOR,
+				Status:      issuesPbv1.Status_NEW,
+				Version:     1,
+			},
+			setupMock: func(mockRepo *mocks.MockIssuesRepository) {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+					IssueId: validIssueID,
+					Status:  issuesPbv1.Status_NEW,
+					Version: 2, // Someone else updated the issue.
+				}, nil)
+			},
+			expectedResp:  nil,
+			expectedError: codes.Aborted,
+			expectedMsg:   "modified since version 1",
+		},
+		{
+			name: "concurrent update wins the race",
+			req: &issuesPbv1.UpdateIssueRequest{
+				IssueId:     validIssueID,
+				Summary:     testSummary,
+				Description: proto.String(testDescription),
+				Type:        issuesPbv1.Type_BUG,
+				Priority:    issuesPbv1.Priority_MAJOR,
+				Status:      issuesPbv1.Status_NEW,
+				Version:     1,
+			},
+			setupMock: func(mockRepo *mocks.MockIssuesRepository) {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+					IssueId: validIssueID,
+					Status:  issuesPbv1.Status_NEW,
+					Version: 1,
+				}, nil)
+				mockRepo.EXPECT().IsValidStatusTransition(gomock.Any(), issuesPbv1.Status_NEW, issuesPbv1.Status_NEW).Return(nil)
+				// Another update landed between the read and the write.
+				mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).Return(consts.ErrIssueVersionConflict)
+			},
+			expectedResp:  nil,
+			expectedError: codes.Aborted,
+			expectedMsg:   "modified by another request",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -498,6 +640,408 @@ func TestIssuesServiceServer_UpdateIssue(t *testing.T) {
 	}
 }
 
+func TestIssuesServiceServer_AssignIssue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+	mockUserService := mocks.NewMockUserServiceClient(ctrl)
+
+	issuesService := issuessvc.NewIssuesService(mockRepo, mockProjectService, mockUserService)
+
+	testCases := []struct {
+		name           string
+		req            *issuesPbv1.AssignIssueRequest
+		setupMock      func()
+		expectedStatus issuesPbv1.Status
+		expectedError  codes.Code
+		expectedMsg    string
+	}{
+		{
+			name: "assigning a new issue moves it to ASSIGNED",
+			req:  &issuesPbv1.AssignIssueRequest{IssueId: validIssueID, AssigneeId: validUserID},
+			setupMock: func() {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+					IssueId: validIssueID,
+					Status:  issuesPbv1.Status_NEW,
+				}, nil)
+				mockRepo.EXPECT().ValidateUserExists(gomock.Any(), validUserID).Return(nil)
+				mockRepo.EXPECT().GetProjectMemberRole(gomock.Any(), gomock.Any(), validUserID).Return(projectsvc.RoleUnspecified, consts.ErrProjectMemberNotFound)
+				mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
+					assert.Equal(t, validUserID, issue.AssigneeId)
+					assert.Equal(t, issuesPbv1.Status_ASSIGNED, issue.Status)
+					return nil
+				})
+			},
+			expectedStatus: issuesPbv1.Status_ASSIGNED,
+			expectedError:  codes.OK,
+			expectedMsg:    "status automatically adjusted from NEW to ASSIGNED",
+		},
+		{
+			name: "reassigning an in-progress issue keeps its status",
+			req:  &issuesPbv1.AssignIssueRequest{IssueId: validIssueID, AssigneeId: validUserID},
+			setupMock: func() {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+					IssueId:    validIssueID,
+					Status:     issuesPbv1.Status_IN_PROGRESS,
+					AssigneeId: "b28f705f-0efa-4c96-b2f6-ceb36281e1f3",
+				}, nil)
+				mockRepo.EXPECT().ValidateUserExists(gomock.Any(), validUserID).Return(nil)
+				mockRepo.EXPECT().GetProjectMemberRole(gomock.Any(), gomock.Any(), validUserID).Return(projectsvc.RoleUnspecified, consts.ErrProjectMemberNotFound)
+				mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus: issuesPbv1.Status_IN_PROGRESS,
+			expectedError:  codes.OK,
+			expectedMsg:    "has been assigned to " + validUserID,
+		},
+		{
+			name: "unassigning moves the issue back to NEW",
+			req:  &issuesPbv1.AssignIssueRequest{IssueId: validIssueID},
+			setupMock: func() {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+					IssueId:    validIssueID,
+					Status:     issuesPbv1.Status_ASSIGNED,
+					AssigneeId: validUserID,
+				}, nil)
+				mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
+					assert.Empty(t, issue.AssigneeId)
+					assert.Equal(t, issuesPbv1.Status_NEW, issue.Status)
+					return nil
+				})
+			},
+			expectedStatus: issuesPbv1.Status_NEW,
+			expectedError:  codes.OK,
+			expectedMsg:    "has been unassigned",
+		},
+		{
+			name: "unknown assignee",
+			req:  &issuesPbv1.AssignIssueRequest{IssueId: validIssueID, AssigneeId: validUserID},
+			setupMock: func() {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
+					IssueId: validIssueID,
+					Status:  issuesPbv1.Status_NEW,
+				}, nil)
+				mockRepo.EXPECT().ValidateUserExists(gomock.Any(), validUserID).Return(consts.ErrUserNotFound)
+			},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "invalid assignee",
+		},
+		{
+			name: "issue not found",
+			req:  &issuesPbv1.AssignIssueRequest{IssueId: validIssueID, AssigneeId: validUserID},
+			setupMock: func() {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(nil, status.Error(codes.NotFound, "issue not found"))
+			},
+			expectedError: codes.NotFound,
+			expectedMsg:   "issue not found",
+		},
+		{
+			name:          "invalid assignee id",
+			req:           &issuesPbv1.AssignIssueRequest{IssueId: validIssueID, AssigneeId: invalidUserID},
+			setupMock:     func() {},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "invalid request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			resp, err := issuesService.AssignIssue(context.Background(), tc.req)
+
+			if tc.expectedError == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, resp.Issue.Status)
+				assert.Equal(t, tc.req.AssigneeId, resp.Issue.AssigneeId)
+				assert.Contains(t, resp.Message, tc.expectedMsg)
+			} else {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.expectedError, st.Code())
+				assert.Contains(t, st.Message(), tc.expectedMsg)
+			}
+		})
+	}
+}
+
+func TestIssuesServiceServer_ChangeIssueStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+	mockUserService := mocks.NewMockUserServiceClient(ctrl)
+
+	issuesService := issuessvc.NewIssuesService(mockRepo, mockProjectService, mockUserService)
+
+	testCases := []struct {
+		name               string
+		req                *issuesPbv1.ChangeIssueStatusRequest
+		current            *issuesPbv1.Issue
+		transitionErr      error
+		expectTransition   bool
+		expectUpdate       bool
+		expectedStatus     issuesPbv1.Status
+		expectedResolution issuesPbv1.Resolution
+		expectedError      codes.Code
+		expectedMsg        string
+	}{
+		{
+			name:             "assigned issue moves to in progress",
+			req:              &issuesPbv1.ChangeIssueStatusRequest{IssueId: validIssueID, NewStatus: issuesPbv1.Status_IN_PROGRESS},
+			current:          &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_ASSIGNED, AssigneeId: validUserID},
+			expectTransition: true,
+			expectUpdate:     true,
+			expectedStatus:   issuesPbv1.Status_IN_PROGRESS,
+			expectedError:    codes.OK,
+			expectedMsg:      "moved from ASSIGNED to IN_PROGRESS",
+		},
+		{
+			name:               "resolving with a resolution",
+			req:                &issuesPbv1.ChangeIssueStatusRequest{IssueId: validIssueID, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_FIXED},
+			current:            &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_IN_PROGRESS, AssigneeId: validUserID},
+			expectTransition:   true,
+			expectUpdate:       true,
+			expectedStatus:     issuesPbv1.Status_RESOLVED,
+			expectedResolution: issuesPbv1.Resolution_FIXED,
+			expectedError:      codes.OK,
+		},
+		{
+			name:               "closing a resolved issue reuses its resolution",
+			req:                &issuesPbv1.ChangeIssueStatusRequest{IssueId: validIssueID, NewStatus: issuesPbv1.Status_CLOSED},
+			current:            &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_WONTFIX, ResolutionComment: "Out of scope"},
+			expectTransition:   true,
+			expectUpdate:       true,
+			expectedStatus:     issuesPbv1.Status_CLOSED,
+			expectedResolution: issuesPbv1.Resolution_WONTFIX,
+			expectedError:      codes.OK,
+		},
+		{
+			name:          "resolving without a resolution",
+			req:           &issuesPbv1.ChangeIssueStatusRequest{IssueId: validIssueID, NewStatus: issuesPbv1.Status_RESOLVED},
+			current:       &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_IN_PROGRESS, AssigneeId: validUserID},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "resolution is required",
+		},
+		{
+			name:          "in progress without an assignee",
+			req:           &issuesPbv1.ChangeIssueStatusRequest{IssueId: validIssueID, NewStatus: issuesPbv1.Status_IN_PROGRESS},
+			current:       &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_ASSIGNED},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "assignee is required",
+		},
+		{
+			name:             "invalid transition",
+			req:              &issuesPbv1.ChangeIssueStatusRequest{IssueId: validIssueID, NewStatus: issuesPbv1.Status_IN_PROGRESS},
+			current:          &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_CLOSED, AssigneeId: validUserID},
+			transitionErr:    status.Error(codes.InvalidArgument, "invalid status transition"),
+			expectTransition: true,
+			expectedError:    codes.InvalidArgument,
+			expectedMsg:      "invalid status transition",
+		},
+		{
+			name:          "unspecified status",
+			req:           &issuesPbv1.ChangeIssueStatusRequest{IssueId: validIssueID},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "invalid request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.current != nil {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(tc.current, nil)
+			}
+			if tc.expectTransition {
+				mockRepo.EXPECT().IsValidStatusTransition(gomock.Any(), tc.current.Status, tc.req.NewStatus).Return(tc.transitionErr)
+			}
+			if tc.expectUpdate && (tc.req.NewStatus == issuesPbv1.Status_RESOLVED || tc.req.NewStatus == issuesPbv1.Status_CLOSED) {
+				// Resolving and closing check for open blockers
+				mockRepo.EXPECT().ListIssueLinks(gomock.Any(), validIssueID).Return(nil, nil)
+			}
+			if tc.expectUpdate {
+				mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			resp, err := issuesService.ChangeIssueStatus(context.Background(), tc.req)
+
+			if tc.expectedError == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, resp.Issue.Status)
+				assert.Equal(t, tc.expectedResolution, resp.Issue.Resolution)
+				assert.Contains(t, resp.Message, tc.expectedMsg)
+			} else {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.expectedError, st.Code())
+				assert.Contains(t, st.Message(), tc.expectedMsg)
+			}
+		})
+	}
+}
+
+func TestIssuesServiceServer_ReopenIssue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+	mockUserService := mocks.NewMockUserServiceClient(ctrl)
+
+	issuesService := issuessvc.NewIssuesService(mockRepo, mockProjectService, mockUserService)
+
+	testCases := []struct {
+		name             string
+		req              *issuesPbv1.ReopenIssueRequest
+		current          *issuesPbv1.Issue
+		expectUserCheck  bool
+		userErr          error
+		expectRoleCheck  bool
+		role             projectsvc.Role
+		expectUpdate     bool
+		expectedStatus   issuesPbv1.Status
+		expectedAssignee string
+		expectedError    codes.Code
+		expectedMsg      string
+	}{
+		{
+			name: "closed issue keeps its assignee",
+			req:  &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression in 2.1"},
+			current: &issuesPbv1.Issue{
+				IssueId: validIssueID, Status: issuesPbv1.Status_CLOSED, AssigneeId: validUserID,
+				Resolution: issuesPbv1.Resolution_FIXED, ResolutionComment: "Patched",
+			},
+			expectUserCheck:  true,
+			expectUpdate:     true,
+			expectedStatus:   issuesPbv1.Status_ASSIGNED,
+			expectedAssignee: validUserID,
+			expectedError:    codes.OK,
+			expectedMsg:      "reopened from CLOSED to ASSIGNED",
+		},
+		{
+			name:           "resolved issue without an assignee",
+			req:            &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Still happening"},
+			current:        &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_WORKSFORME},
+			expectUpdate:   true,
+			expectedStatus: issuesPbv1.Status_NEW,
+			expectedError:  codes.OK,
+			expectedMsg:    "reopened from RESOLVED to NEW",
+		},
+		{
+			name: "assignee deleted since the issue was closed",
+			req:  &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression in 2.1"},
+			current: &issuesPbv1.Issue{
+				IssueId: validIssueID, Status: issuesPbv1.Status_CLOSED, AssigneeId: validUserID,
+				Resolution: issuesPbv1.Resolution_DUPLICATE, DuplicateOf: validProjectID,
+			},
+			expectUserCheck: true,
+			userErr:         status.Error(codes.NotFound, "user not found"),
+			expectUpdate:    true,
+			expectedStatus:  issuesPbv1.Status_NEW,
+			expectedError:   codes.OK,
+			expectedMsg:     "reopened from CLOSED to NEW",
+		},
+		{
+			name: "closed issue given a new assignee",
+			req:  &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression in 2.1", AssigneeId: proto.String(otherUserID)},
+			current: &issuesPbv1.Issue{
+				IssueId: validIssueID, ProjectId: validProjectID, Status: issuesPbv1.Status_CLOSED, AssigneeId: validUserID,
+				Resolution: issuesPbv1.Resolution_FIXED,
+			},
+			expectUserCheck:  true,
+			expectRoleCheck:  true,
+			role:             projectsvc.RoleDeveloper,
+			expectUpdate:     true,
+			expectedStatus:   issuesPbv1.Status_ASSIGNED,
+			expectedAssignee: otherUserID,
+			expectedError:    codes.OK,
+			expectedMsg:      "reopened from CLOSED to ASSIGNED",
+		},
+		{
+			name:            "new assignee that doesn't exist",
+			req:             &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression in 2.1", AssigneeId: proto.String(otherUserID)},
+			current:         &issuesPbv1.Issue{IssueId: validIssueID, ProjectId: validProjectID, Status: issuesPbv1.Status_CLOSED},
+			expectUserCheck: true,
+			userErr:         status.Error(codes.NotFound, "user not found"),
+			expectedError:   codes.InvalidArgument,
+			expectedMsg:     "invalid assignee",
+		},
+		{
+			name:            "new assignee who is a viewer of the project",
+			req:             &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression in 2.1", AssigneeId: proto.String(otherUserID)},
+			current:         &issuesPbv1.Issue{IssueId: validIssueID, ProjectId: validProjectID, Status: issuesPbv1.Status_RESOLVED},
+			expectUserCheck: true,
+			expectRoleCheck: true,
+			role:            projectsvc.RoleViewer,
+			expectedError:   codes.PermissionDenied,
+			expectedMsg:     "viewer users cannot be assigned",
+		},
+		{
+			name:            "user service unavailable",
+			req:             &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression in 2.1"},
+			current:         &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_CLOSED, AssigneeId: validUserID},
+			expectUserCheck: true,
+			userErr:         status.Error(codes.Unavailable, "user service unavailable"),
+			expectedError:   codes.Unavailable,
+		},
+		{
+			name:          "issue that is still open",
+			req:           &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression in 2.1"},
+			current:       &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_IN_PROGRESS, AssigneeId: validUserID},
+			expectedError: codes.FailedPrecondition,
+			expectedMsg:   "only RESOLVED or CLOSED issues can be reopened",
+		},
+		{
+			name:          "missing reason",
+			req:           &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "invalid request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.current != nil {
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(tc.current, nil)
+			}
+			checkedUser := validUserID
+			if tc.req.AssigneeId != nil {
+				checkedUser = tc.req.GetAssigneeId()
+			}
+			if tc.expectUserCheck {
+				mockRepo.EXPECT().ValidateUserExists(gomock.Any(), checkedUser).Return(tc.userErr)
+			}
+			if tc.expectRoleCheck {
+				mockRepo.EXPECT().GetProjectMemberRole(gomock.Any(), validProjectID, checkedUser).Return(tc.role, nil)
+			}
+			if tc.expectUpdate {
+				mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			ctx := auth.WithActorID(context.Background(), validUserID)
+			resp, err := issuesService.ReopenIssue(ctx, tc.req)
+
+			if tc.expectedError == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, resp.Issue.Status)
+				assert.Equal(t, tc.expectedAssignee, resp.Issue.AssigneeId)
+				assert.Equal(t, issuesPbv1.Resolution_RESOLUTION_UNSPECIFIED, resp.Issue.Resolution)
+				assert.Empty(t, resp.Issue.ResolutionComment)
+				assert.Empty(t, resp.Issue.DuplicateOf)
+				assert.Nil(t, resp.Issue.ResolveDate)
+				assert.Equal(t, int32(1), resp.Issue.ReopenCount)
+				assert.Equal(t, tc.req.Reason, resp.Issue.LastReopenReason)
+				assert.Equal(t, validUserID, resp.Issue.LastReopenedBy, "the issue records who reopened it")
+				assert.Contains(t, resp.Message, tc.expectedMsg)
+			} else {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.expectedError, st.Code())
+				assert.Contains(t, st.Message(), tc.expectedMsg)
+			}
+		})
+	}
+}
+
 func TestIssuesServiceServer_DeleteIssue(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -529,7 +1073,7 @@ func TestIssuesServiceServer_DeleteIssue(t *testing.T) {
 			},
 			setupMock: func() {
 				// Mock repository read and delete operations
-				mockRepo.EXPECT().ReadIssue(validIssueID).Return(&issuesPbv1.Issue{
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
 					IssueId:     validIssueID,
 					Summary:     testSummary,
 					Description: testDescription,
@@ -538,7 +1082,7 @@ func TestIssuesServiceServer_DeleteIssue(t *testing.T) {
 					Status:      issuesPbv1.Status_NEW,
 					ProjectId:   validProjectID,
 				}, nil)
-				mockRepo.EXPECT().DeleteIssue(validIssueID).Return(nil)
+				mockRepo.EXPECT().DeleteIssue(gomock.Any(), validIssueID).Return(nil)
 			},
 			expectedResp:  &issuesPbv1.DeleteIssueResponse{}, // Empty response for successful deletion
 			expectedError: nil,
@@ -561,7 +1105,7 @@ func TestIssuesServiceServer_DeleteIssue(t *testing.T) {
 			},
 			setupMock: func() {
 				// Mock repository response for issue not found
-				mockRepo.EXPECT().ReadIssue(validIssueID).Return(nil, consts.ErrNotFound)
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(nil, consts.ErrNotFound)
 			},
 			expectedResp:  nil,
 			expectedError: status.Errorf(codes.Internal, "failed to retrieve issue: not found"),
@@ -573,7 +1117,7 @@ func TestIssuesServiceServer_DeleteIssue(t *testing.T) {
 			},
 			setupMock: func() {
 				// Mock repository read and delete operations
-				mockRepo.EXPECT().ReadIssue(validIssueID).Return(&issuesPbv1.Issue{
+				mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{
 					IssueId:     validIssueID,
 					Summary:     testSummary,
 					Description: testDescription,
@@ -582,7 +1126,7 @@ func TestIssuesServiceServer_DeleteIssue(t *testing.T) {
 					Status:      issuesPbv1.Status_NEW,
 					ProjectId:   validProjectID,
 				}, nil)
-				mockRepo.EXPECT().DeleteIssue(validIssueID).Return(consts.ErrDatabaseError)
+				mockRepo.EXPECT().DeleteIssue(gomock.Any(), validIssueID).Return(consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
 			expectedError: status.Errorf(codes.Internal, "failed to delete issue: %v", consts.ErrDatabaseError),
@@ -618,6 +1162,10 @@ func TestIssuesServiceServer_ListIssues(t *testing.T) {
 	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
 	mockUserService := mocks.NewMockUserServiceClient(ctrl)
 
+	// Configure the page sizes the constants below expect
+	t.Setenv("DEFAULT_PAGE_SIZE", "20")
+	t.Setenv("MAX_PAGE_SIZE", "50")
+
 	// Initialize service
 	issuesService := issuessvc.NewIssuesService(mockRepo, mockProjectService, mockUserService)
 
@@ -666,7 +1214,7 @@ func TestIssuesServiceServer_ListIssues(t *testing.T) {
 			},
 			setupMock: func() {
 				mockRepo.EXPECT().
-					ListIssues(testPageToken, 10).
+					ListIssues(gomock.Any(), testPageToken, 10).
 					Return(testIssues, testNextPageToken, nil)
 			},
 			expectedResp: &issuesPbv1.ListIssuesResponse{
@@ -683,7 +1231,24 @@ func TestIssuesServiceServer_ListIssues(t *testing.T) {
 			},
 			setupMock: func() {
 				mockRepo.EXPECT().
-					ListIssues(testPageToken, defaultPageSize).
+					ListIssues(gomock.Any(), testPageToken, defaultPageSize).
+					Return(testIssues, testNextPageToken, nil)
+			},
+			expectedResp: &issuesPbv1.ListIssuesResponse{
+				Issues:        testIssues,
+				NextPageToken: testNextPageToken,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Page Size At Maximum",
+			req: &issuesPbv1.ListIssuesRequest{
+				PageToken: testPageToken,
+				PageSize:  maxPageSize,
+			},
+			setupMock: func() {
+				mockRepo.EXPECT().
+					ListIssues(gomock.Any(), testPageToken, maxPageSize).
 					Return(testIssues, testNextPageToken, nil)
 			},
 			expectedResp: &issuesPbv1.ListIssuesResponse{
@@ -693,15 +1258,43 @@ func TestIssuesServiceServer_ListIssues(t *testing.T) {
 			expectedError: nil,
 		},
 		{
-			name: "Invalid Request with Validation Error",
+			name: "Page Size Above Maximum",
 			req: &issuesPbv1.ListIssuesRequest{
-				PageToken: "", // Assume an empty PageToken is invalid
+				PageToken: testPageToken,
+				PageSize:  maxPageSize + 1,
 			},
 			setupMock: func() {
-				// No mock setup required, validation fails before reaching repository
+				// No mock setup required, the page size is rejected rather than capped
+			},
+			expectedResp:  nil,
+			expectedError: status.Errorf(codes.InvalidArgument, "invalid request: page_size must be between 0 and 50"),
+		},
+		{
+			name: "Unset Page Size",
+			req:  &issuesPbv1.ListIssuesRequest{},
+			setupMock: func() {
+				mockRepo.EXPECT().
+					ListIssues(gomock.Any(), "", defaultPageSize).
+					Return(testIssues, "", nil)
+			},
+			expectedResp: &issuesPbv1.ListIssuesResponse{
+				Issues: testIssues,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Page Token Not Issued By The Listing",
+			req: &issuesPbv1.ListIssuesRequest{
+				PageToken: validIssueID,
+				PageSize:  10,
+			},
+			setupMock: func() {
+				mockRepo.EXPECT().
+					ListIssues(gomock.Any(), validIssueID, 10).
+					Return(nil, "", pagination.ErrInvalidPageToken)
 			},
 			expectedResp:  nil,
-			expectedError: status.Errorf(codes.InvalidArgument, "invalid request: invalid ListIssuesRequest.PageSize: value must be inside range [1, 1000]"),
+			expectedError: status.Errorf(codes.InvalidArgument, "invalid request: invalid page token"),
 		},
 		{
 			name: "Repository Error",
@@ -711,7 +1304,7 @@ func TestIssuesServiceServer_ListIssues(t *testing.T) {
 			},
 			setupMock: func() {
 				mockRepo.EXPECT().
-					ListIssues(testPageToken, 10).
+					ListIssues(gomock.Any(), testPageToken, 10).
 					Return(nil, "", consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
@@ -0,0 +1,76 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+)
+
+// TeamReader reads the teams CreateIssue assigns issues to in turn
+type TeamReader interface {
+	ReadTeam(ctx context.Context, teamID string) (*teamPbv1.Team, error)
+}
+
+// SetTeams configures where the teams named by CreateIssue requests are read from. Without it,
+// requests with a team_id are rejected.
+func (s *IssuesServiceServer) SetTeams(teams TeamReader) {
+	s.teams = teams
+}
+
+// readIssueTeam reads the team an issue is created for, which must belong to the issue's project
+func (s *IssuesServiceServer) readIssueTeam(ctx context.Context, teamID, projectID string) (*teamPbv1.Team, error) {
+	if s.teams == nil {
+		return nil, status.Error(codes.FailedPrecondition, "teams are not configured")
+	}
+	team, err := s.teams.ReadTeam(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, consts.ErrTeamNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid team: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get team: %v", err)
+	}
+	if team.ProjectId != projectID {
+		return nil, status.Error(codes.InvalidArgument, "invalid team: the team belongs to another project")
+	}
+	return team, nil
+}
+
+// nextTeamAssignee picks the member of the team whose turn it is to be assigned an issue. Each
+// team has its own counter, so concurrent issues of a team go to different members. Members
+// who can't be assigned the project's issues, because they no longer exist, are deactivated or
+// are viewers of the project, are skipped and the turn passes to the next one.
+func (s *IssuesServiceServer) nextTeamAssignee(ctx context.Context, team *teamPbv1.Team) (string, error) {
+	members := uint64(len(team.MemberIds))
+	if members == 0 {
+		return "", status.Errorf(codes.FailedPrecondition, "team %s has no members", team.TeamId)
+	}
+
+	value, _ := s.teamTurns.LoadOrStore(team.TeamId, new(atomic.Uint64))
+	turns := value.(*atomic.Uint64)
+	turn := turns.Add(1) - 1
+	for skipped := uint64(0); skipped < members; skipped++ {
+		memberID := team.MemberIds[(turn+skipped)%members]
+		if err := s.repository.ValidateUserExists(ctx, memberID); err != nil {
+			if isDependencyUnavailable(err) {
+				return "", err
+			}
+			continue
+		}
+		if err := s.checkAssigneeRole(ctx, team.ProjectId, memberID); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				continue
+			}
+			return "", err
+		}
+		// The skipped members' turns are used up, so the next issue goes to the member after this one
+		turns.Add(skipped)
+		return memberID, nil
+	}
+	return "", status.Errorf(codes.FailedPrecondition, "no member of team %s can be assigned issues", team.TeamId)
+}
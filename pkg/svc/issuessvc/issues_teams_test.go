@@ -0,0 +1,199 @@
+package issuessvc_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+const (
+	teamID            = "e68f705f-0efa-4c96-b2f6-ceb36281e1f6"
+	otherTeamID       = "f78f705f-0efa-4c96-b2f6-ceb36281e1f7"
+	teamProjectID     = "0a8f705f-0efa-4c96-b2f6-ceb36281e1f8"
+	deactivatedUserID = "1b8f705f-0efa-4c96-b2f6-ceb36281e1f9"
+)
+
+// newTeamServices creates an issues service per backend with a team of validProjectID whose
+// members are, in order, validUserID, viewerUserID, otherUserID, missingUserID and
+// deactivatedUserID, and a team of another project. Only validUserID and otherUserID can be
+// assigned the project's issues.
+func newTeamServices(t *testing.T) map[string]*issuessvc.IssuesServiceServer {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	memUsers, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	memProjects, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	memIssues, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	memIssues.SetClients(issuessvc.NewLocalValidator(memProjects, memUsers))
+	memTeams, err := teamsvc.NewMemDBTeamRepository()
+	require.NoError(t, err)
+
+	db := newSQLiteDB(t)
+
+	backends := map[string]struct {
+		users    usersvc.UserRepository
+		projects projectsvc.ProjectRepository
+		issues   issuessvc.IssuesRepository
+		teams    teamsvc.TeamRepository
+	}{
+		"memdb": {memUsers, memProjects, memIssues, memTeams},
+		"sqlite": {usersvc.NewPostgresUserRepository(db), projectsvc.NewPostgresProjectRepository(db),
+			issuessvc.NewPostgresIssuesRepository(db), teamsvc.NewPostgresTeamRepository(db)},
+	}
+
+	services := make(map[string]*issuessvc.IssuesServiceServer)
+	for name, backend := range backends {
+		require.NoError(t, backend.projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: validProjectID, Name: "Teams"}))
+		require.NoError(t, backend.projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: teamProjectID, Name: "Other teams"}))
+		for i, userID := range []string{validUserID, viewerUserID, otherUserID, deactivatedUserID} {
+			require.NoError(t, backend.users.CreateUser(ctx, &userPbv1.User{UserId: userID, EmailAddress: numberedIssueID(i) + "@example.com", Active: true}))
+		}
+		_, err := backend.users.SetUserActive(ctx, deactivatedUserID, false)
+		require.NoError(t, err)
+		require.NoError(t, backend.projects.SetProjectMember(ctx, &projectPbv1.ProjectMember{ProjectId: validProjectID, UserId: viewerUserID, Role: projectsvc.RoleViewer}))
+
+		require.NoError(t, backend.teams.CreateTeam(ctx, &teamPbv1.Team{
+			TeamId:    teamID,
+			Name:      "Triage",
+			ProjectId: validProjectID,
+			MemberIds: []string{validUserID, viewerUserID, otherUserID, missingUserID, deactivatedUserID},
+		}))
+		require.NoError(t, backend.teams.CreateTeam(ctx, &teamPbv1.Team{
+			TeamId:    otherTeamID,
+			Name:      "Elsewhere",
+			ProjectId: teamProjectID,
+			MemberIds: []string{validUserID},
+		}))
+
+		services[name] = issuessvc.NewIssuesService(backend.issues, nil, nil)
+		services[name].SetTeams(backend.teams)
+	}
+	return services
+}
+
+// newTeamIssueRequest creates a request for an issue of validProjectID created for a team
+func newTeamIssueRequest(teamID string) *issuesPbv1.CreateIssueRequest {
+	return &issuesPbv1.CreateIssueRequest{
+		Summary:   testSummary,
+		Type:      issuesPbv1.Type_BUG,
+		Priority:  issuesPbv1.Priority_MINOR,
+		ProjectId: validProjectID,
+		TeamId:    &teamID,
+	}
+}
+
+func TestIssuesService_CreateIssueAssignsTeamMembersInTurn(t *testing.T) {
+	for name, service := range newTeamServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// The viewer, the missing user and the deactivated user are skipped
+			var assignees []string
+			for i := 0; i < 4; i++ {
+				resp, err := service.CreateIssue(ctx, newTeamIssueRequest(teamID))
+				require.NoError(t, err)
+				assert.Equal(t, issuesPbv1.Status_ASSIGNED, resp.Issue.Status)
+				assert.Equal(t, teamID, resp.Issue.TeamId)
+				assignees = append(assignees, resp.Issue.AssigneeId)
+
+				stored, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: resp.Issue.IssueId})
+				require.NoError(t, err)
+				assert.Equal(t, teamID, stored.Issue.TeamId)
+			}
+			assert.Equal(t, []string{validUserID, otherUserID, validUserID, otherUserID}, assignees)
+
+			// An assignee given with the team is kept, and doesn't take a turn
+			req := newTeamIssueRequest(teamID)
+			assigneeID := otherUserID
+			req.AssigneeId = &assigneeID
+			resp, err := service.CreateIssue(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, otherUserID, resp.Issue.AssigneeId)
+			assert.Equal(t, teamID, resp.Issue.TeamId)
+
+			resp, err = service.CreateIssue(ctx, newTeamIssueRequest(teamID))
+			require.NoError(t, err)
+			assert.Equal(t, validUserID, resp.Issue.AssigneeId)
+		})
+	}
+}
+
+func TestIssuesService_CreateIssueRejectsUnusableTeams(t *testing.T) {
+	for name, service := range newTeamServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			_, err := service.CreateIssue(ctx, newTeamIssueRequest(otherTeamID))
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "the team belongs to another project")
+
+			_, err = service.CreateIssue(ctx, newTeamIssueRequest(missingUserID))
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "the team doesn't exist")
+		})
+	}
+
+	// Without members who can be assigned the project's issues, no issue is created
+	services := newTeamServices(t)
+	teams, err := teamsvc.NewMemDBTeamRepository()
+	require.NoError(t, err)
+	require.NoError(t, teams.CreateTeam(context.Background(), &teamPbv1.Team{
+		TeamId:    teamID,
+		ProjectId: validProjectID,
+		MemberIds: []string{viewerUserID, missingUserID},
+	}))
+	services["memdb"].SetTeams(teams)
+	_, err = services["memdb"].CreateIssue(context.Background(), newTeamIssueRequest(teamID))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Without teams configured, requests with a team are rejected
+	unconfigured := newTeamServices(t)["memdb"]
+	unconfigured.SetTeams(nil)
+	_, err = unconfigured.CreateIssue(context.Background(), newTeamIssueRequest(teamID))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestIssuesService_ConcurrentTeamIssuesAreSpreadEvenly(t *testing.T) {
+	service := newTeamServices(t)["memdb"]
+	ctx := context.Background()
+
+	const issues = 20
+	var mu sync.Mutex
+	counts := make(map[string]int)
+	var wg sync.WaitGroup
+	for i := 0; i < issues; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := service.CreateIssue(ctx, newTeamIssueRequest(teamID))
+			if !assert.NoError(t, err) {
+				return
+			}
+			mu.Lock()
+			counts[resp.Issue.AssigneeId]++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, issues, counts[validUserID]+counts[otherUserID])
+	assert.InDelta(t, issues/2, counts[validUserID], 3)
+}
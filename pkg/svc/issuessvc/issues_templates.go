@@ -0,0 +1,68 @@
+package issuessvc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// ErrTemplatesNotConfigured is returned by CreateIssue when a template is requested
+// but the service has no project service client to fetch it from
+var ErrTemplatesNotConfigured = status.Error(codes.FailedPrecondition,
+	"issue templates are not configured: no project service client")
+
+// applyIssueTemplate returns a copy of req with the unset fields filled from the template
+// named by req.TemplateId. Fields set in the request always win over the template.
+func (s *IssuesServiceServer) applyIssueTemplate(ctx context.Context, req *issuesPbv1.CreateIssueRequest) (*issuesPbv1.CreateIssueRequest, error) {
+	if req.TemplateId == "" {
+		return req, nil
+	}
+	if s.projectService == nil {
+		return nil, ErrTemplatesNotConfigured
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.projectService.GetIssueTemplate(ctx, &projectPbv1.GetIssueTemplateRequest{TemplateId: req.TemplateId})
+	if err != nil {
+		if isDependencyUnavailable(err) {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template: %v", err)
+	}
+	template := resp.GetTemplate()
+
+	filled := proto.Clone(req).(*issuesPbv1.CreateIssueRequest)
+
+	switch {
+	case filled.ProjectId == "":
+		filled.ProjectId = template.ProjectId
+	case filled.ProjectId != template.ProjectId:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"template %s belongs to project %s, not %s", template.TemplateId, template.ProjectId, filled.ProjectId)
+	}
+
+	// The template stores enum names because the project API can't reference the issue enums
+	if filled.Type == issuesPbv1.Type_TYPE_UNSPECIFIED {
+		filled.Type = issuesPbv1.Type(issuesPbv1.Type_value[template.DefaultType])
+	}
+	if filled.Priority == issuesPbv1.Priority_PRIORITY_UNSPECIFIED {
+		filled.Priority = issuesPbv1.Priority(issuesPbv1.Priority_value[template.DefaultPriority])
+	}
+	if filled.Description == nil && template.DescriptionTemplate != "" {
+		filled.Description = proto.String(template.DescriptionTemplate)
+	}
+	if template.SummaryPrefix != "" && !strings.HasPrefix(filled.Summary, template.SummaryPrefix) {
+		filled.Summary = template.SummaryPrefix + filled.Summary
+	}
+
+	return filled, nil
+}
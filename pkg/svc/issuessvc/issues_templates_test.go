@@ -0,0 +1,150 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+const validTemplateID = "5b8e2f4a-9c1d-4e3b-a7f6-0d2c4e6a8b1f"
+
+var bugTemplate = &projectPbv1.IssueTemplate{
+	TemplateId:          validTemplateID,
+	ProjectId:           validProjectID,
+	Name:                "Bug report",
+	DefaultType:         "BUG",
+	DefaultPriority:     "MAJOR",
+	SummaryPrefix:       "[Bug] ",
+	DescriptionTemplate: "Steps to reproduce:",
+}
+
+// newTemplateFixture returns a service whose project client serves bugTemplate and which
+// records the issue passed to the repository
+func newTemplateFixture(t *testing.T, created **issuesPbv1.Issue) *issuessvc.IssuesServiceServer {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	mockProjectService := mocks.NewMockProjectServiceClient(ctrl)
+
+	mockProjectService.EXPECT().GetIssueTemplate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *projectPbv1.GetIssueTemplateRequest, _ ...grpc.CallOption) (*projectPbv1.GetIssueTemplateResponse, error) {
+			if req.TemplateId != validTemplateID {
+				return nil, status.Error(codes.NotFound, "issue template not found")
+			}
+			return &projectPbv1.GetIssueTemplateResponse{Template: bugTemplate}, nil
+		})
+	mockRepo.EXPECT().ValidateProjectExists(gomock.Any(), validProjectID).Return(nil).AnyTimes()
+	mockRepo.EXPECT().GetProjectMaxIssues(gomock.Any(), validProjectID).Return(int32(0), nil).AnyTimes()
+	mockRepo.EXPECT().CreateIssue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, issue *issuesPbv1.Issue) error {
+		*created = issue
+		return nil
+	}).AnyTimes()
+	mockProjectService.EXPECT().UpdateProjectWithIssue(gomock.Any(), gomock.Any()).Return(
+		&projectPbv1.UpdateProjectWithIssueResponse{}, nil).AnyTimes()
+
+	return issuessvc.NewIssuesService(mockRepo, mockProjectService, mocks.NewMockUserServiceClient(ctrl))
+}
+
+func TestIssuesServiceServer_CreateIssueAppliesTemplateDefaults(t *testing.T) {
+	var created *issuesPbv1.Issue
+	service := newTemplateFixture(t, &created)
+
+	_, err := service.CreateIssue(context.Background(), &issuesPbv1.CreateIssueRequest{
+		Summary:    "Login fails",
+		TemplateId: validTemplateID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+
+	assert.Equal(t, "[Bug] Login fails", created.Summary)
+	assert.Equal(t, "Steps to reproduce:", created.Description)
+	assert.Equal(t, issuesPbv1.Type_BUG, created.Type)
+	assert.Equal(t, issuesPbv1.Priority_MAJOR, created.Priority)
+	assert.Equal(t, validProjectID, created.ProjectId)
+}
+
+func TestIssuesServiceServer_CreateIssueFieldsOverrideTemplate(t *testing.T) {
+	var created *issuesPbv1.Issue
+	service := newTemplateFixture(t, &created)
+
+	req := &issuesPbv1.CreateIssueRequest{
+		Summary:     "[Bug] Login fails",
+		Description: proto.String(testDescription),
+		Type:        issuesPbv1.Type_PERFORMANCE,
+		Priority:    issuesPbv1.Priority_CRITICAL,
+		ProjectId:   validProjectID,
+		TemplateId:  validTemplateID,
+	}
+	_, err := service.CreateIssue(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, created)
+
+	// The prefix isn't repeated when the summary already starts with it
+	assert.Equal(t, "[Bug] Login fails", created.Summary)
+	assert.Equal(t, testDescription, created.Description)
+	assert.Equal(t, issuesPbv1.Type_PERFORMANCE, created.Type)
+	assert.Equal(t, issuesPbv1.Priority_CRITICAL, created.Priority)
+
+	// The caller's request is left untouched
+	assert.Equal(t, validTemplateID, req.TemplateId)
+	assert.Equal(t, issuesPbv1.Type_PERFORMANCE, req.Type)
+}
+
+func TestIssuesServiceServer_CreateIssueTemplateErrors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		req          *issuesPbv1.CreateIssueRequest
+		expectedCode codes.Code
+	}{
+		{
+			name:         "unknown template",
+			req:          &issuesPbv1.CreateIssueRequest{Summary: testSummary, TemplateId: validIssueID},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "template from another project",
+			req:          &issuesPbv1.CreateIssueRequest{Summary: testSummary, ProjectId: targetProjectID, TemplateId: validTemplateID},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var created *issuesPbv1.Issue
+			service := newTemplateFixture(t, &created)
+
+			_, err := service.CreateIssue(context.Background(), tc.req)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+			assert.Nil(t, created)
+		})
+	}
+}
+
+func TestIssuesServiceServer_CreateIssueTemplateWithoutProjectClient(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	service := issuessvc.NewIssuesService(repo, nil, nil)
+	_, err = service.CreateIssue(context.Background(), &issuesPbv1.CreateIssueRequest{
+		Summary:    testSummary,
+		TemplateId: validTemplateID,
+	})
+	assert.ErrorIs(t, err, issuessvc.ErrTemplatesNotConfigured)
+}
@@ -0,0 +1,174 @@
+package issuessvc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// updateRequest builds an otherwise valid update with the given status and assignee
+func updateRequest(newStatus issuesPbv1.Status, assigneeID *string) *issuesPbv1.UpdateIssueRequest {
+	return &issuesPbv1.UpdateIssueRequest{
+		IssueId:     validIssueID,
+		Summary:     testSummary,
+		Description: proto.String(testDescription),
+		Type:        issuesPbv1.Type_BUG,
+		Priority:    issuesPbv1.Priority_MAJOR,
+		Status:      newStatus,
+		AssigneeId:  assigneeID,
+	}
+}
+
+func TestValidateIssueUpdate(t *testing.T) {
+	unassigned := &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_NEW}
+	assigned := &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_ASSIGNED, AssigneeId: validUserID}
+
+	resolved := updateRequest(issuesPbv1.Status_RESOLVED, nil)
+	resolved.Resolution = issuesPbv1.Resolution_FIXED
+
+	missingSummary := updateRequest(issuesPbv1.Status_NEW, nil)
+	missingSummary.Summary = ""
+
+	emptyDescription := updateRequest(issuesPbv1.Status_NEW, nil)
+	emptyDescription.Description = proto.String("")
+
+	testCases := []struct {
+		name                 string
+		current              *issuesPbv1.Issue
+		req                  *issuesPbv1.UpdateIssueRequest
+		expectedStatus       issuesPbv1.Status
+		expectedAutoAdjusted bool
+		expectedError        codes.Code
+		expectedMsg          string
+	}{
+		{
+			name:           "status unchanged without assignee change",
+			current:        unassigned,
+			req:            updateRequest(issuesPbv1.Status_NEW, nil),
+			expectedStatus: issuesPbv1.Status_NEW,
+			expectedError:  codes.OK,
+		},
+		{
+			name:           "keeping the existing assignee",
+			current:        assigned,
+			req:            updateRequest(issuesPbv1.Status_IN_PROGRESS, nil),
+			expectedStatus: issuesPbv1.Status_IN_PROGRESS,
+			expectedError:  codes.OK,
+		},
+		{
+			name:                 "adding an assignee forces ASSIGNED",
+			current:              unassigned,
+			req:                  updateRequest(issuesPbv1.Status_NEW, proto.String(validUserID)),
+			expectedStatus:       issuesPbv1.Status_ASSIGNED,
+			expectedAutoAdjusted: true,
+			expectedError:        codes.OK,
+		},
+		{
+			name:                 "adding an assignee overrides IN_PROGRESS",
+			current:              unassigned,
+			req:                  updateRequest(issuesPbv1.Status_IN_PROGRESS, proto.String(validUserID)),
+			expectedStatus:       issuesPbv1.Status_ASSIGNED,
+			expectedAutoAdjusted: true,
+			expectedError:        codes.OK,
+		},
+		{
+			name:           "replacing the assignee keeps the requested status",
+			current:        assigned,
+			req:            updateRequest(issuesPbv1.Status_IN_PROGRESS, proto.String(validProjectID)),
+			expectedStatus: issuesPbv1.Status_IN_PROGRESS,
+			expectedError:  codes.OK,
+		},
+		{
+			name:           "removing the assignee with NEW",
+			current:        assigned,
+			req:            updateRequest(issuesPbv1.Status_NEW, proto.String("")),
+			expectedStatus: issuesPbv1.Status_NEW,
+			expectedError:  codes.OK,
+		},
+		{
+			name:          "removing the assignee with ASSIGNED",
+			current:       assigned,
+			req:           updateRequest(issuesPbv1.Status_ASSIGNED, proto.String("")),
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "when removing an assignee",
+		},
+		{
+			name:          "removing the assignee with IN_PROGRESS",
+			current:       assigned,
+			req:           updateRequest(issuesPbv1.Status_IN_PROGRESS, proto.String("")),
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "when removing an assignee",
+		},
+		{
+			name:          "clearing an already empty assignee with ASSIGNED",
+			current:       unassigned,
+			req:           updateRequest(issuesPbv1.Status_ASSIGNED, proto.String("")),
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "assignee is required",
+		},
+		{
+			name:          "IN_PROGRESS without any assignee",
+			current:       unassigned,
+			req:           updateRequest(issuesPbv1.Status_IN_PROGRESS, nil),
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "assignee is required",
+		},
+		{
+			name:          "resolving without a resolution",
+			current:       assigned,
+			req:           updateRequest(issuesPbv1.Status_RESOLVED, nil),
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "resolution is required",
+		},
+		{
+			name:           "resolving with a resolution",
+			current:        assigned,
+			req:            resolved,
+			expectedStatus: issuesPbv1.Status_RESOLVED,
+			expectedError:  codes.OK,
+		},
+		{
+			name:          "missing summary",
+			current:       unassigned,
+			req:           missingSummary,
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "are required for update",
+		},
+		{
+			name:          "empty description",
+			current:       unassigned,
+			req:           emptyDescription,
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "are required for update",
+		},
+		{
+			name:          "unspecified status",
+			current:       unassigned,
+			req:           updateRequest(issuesPbv1.Status_STATUS_UNSPECIFIED, nil),
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "are required for update",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			adjustedStatus, autoAdjusted, err := issuessvc.ValidateIssueUpdate(tc.current, tc.req)
+
+			if tc.expectedError == codes.OK {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, adjustedStatus)
+				assert.Equal(t, tc.expectedAutoAdjusted, autoAdjusted)
+			} else {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.expectedError, st.Code())
+				assert.Contains(t, st.Message(), tc.expectedMsg)
+			}
+		})
+	}
+}
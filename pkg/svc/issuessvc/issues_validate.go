@@ -0,0 +1,195 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// ValidateIssue runs the checks of CreateIssue or UpdateIssue on a payload and reports every
+// problem found instead of failing on the first one. Nothing is saved, so forms can show the
+// problems before the user submits. The transition of an update is checked from current_status
+// when it is set. Lookups that fail for reasons other than the payload fail the call.
+func (s *IssuesServiceServer) ValidateIssue(ctx context.Context, req *issuesPbv1.ValidateIssueRequest) (*issuesPbv1.ValidateIssueResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	var problems validationProblems
+	var err error
+	switch payload := req.Payload.(type) {
+	case *issuesPbv1.ValidateIssueRequest_Create:
+		err = s.validateCreate(ctx, payload.Create, &problems)
+	case *issuesPbv1.ValidateIssueRequest_Update:
+		err = s.validateUpdate(ctx, payload.Update, req.CurrentStatus, &problems)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &issuesPbv1.ValidateIssueResponse{
+		Valid:    len(problems.list) == 0,
+		Problems: problems.list,
+	}, nil
+}
+
+// validateCreate collects the problems CreateIssue would fail with
+func (s *IssuesServiceServer) validateCreate(ctx context.Context, req *issuesPbv1.CreateIssueRequest, problems *validationProblems) error {
+	req, err := s.applyIssueTemplate(ctx, req)
+	if err != nil {
+		return problems.add(err, "template_id")
+	}
+
+	problems.addFieldRules(ctx, req.ValidateAll())
+	if !problems.has("summary") {
+		if err := problems.add(checkSummary(req.Summary), "summary"); err != nil {
+			return err
+		}
+	}
+	if !problems.has("description") {
+		_, err := normalizeDescription(req.GetDescription())
+		if err := problems.add(err, "description"); err != nil {
+			return err
+		}
+	}
+
+	if !problems.has("project_id") {
+		err := s.repository.ValidateProjectExists(ctx, req.ProjectId)
+		if err != nil && !isDependencyUnavailable(err) {
+			err = status.Errorf(codes.InvalidArgument, "invalid project: %v", err)
+		}
+		if err == nil {
+			err = s.checkProjectIssueLimit(ctx, req.ProjectId)
+		}
+		if err := problems.add(err, "project_id"); err != nil {
+			return err
+		}
+	}
+
+	if req.AssigneeId != nil && *req.AssigneeId != "" && !problems.has("assignee_id") {
+		if err := s.repository.ValidateUserExists(ctx, *req.AssigneeId); err != nil {
+			return problems.add(assigneeError(err, "invalid user"), "assignee_id")
+		}
+		if err := problems.add(s.checkAssigneeRole(ctx, req.ProjectId, *req.AssigneeId), "assignee_id"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateUpdate collects the problems UpdateIssue would fail with. The stale version check is
+// left out, as the issue may well change before the update is sent.
+func (s *IssuesServiceServer) validateUpdate(ctx context.Context, req *issuesPbv1.UpdateIssueRequest, currentStatus issuesPbv1.Status, problems *validationProblems) error {
+	problems.addFieldRules(ctx, req.ValidateAll())
+	if !problems.has("summary") {
+		if err := problems.add(checkSummary(req.Summary), "summary"); err != nil {
+			return err
+		}
+	}
+	if !problems.has("description") {
+		_, err := normalizeDescription(req.GetDescription())
+		if err := problems.add(err, "description"); err != nil {
+			return err
+		}
+	}
+	if problems.has("issue_id") {
+		return nil
+	}
+
+	stored, err := s.repository.ReadIssue(ctx, req.IssueId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound || errors.Is(err, consts.ErrIssueNotFound) {
+			return problems.add(status.Error(codes.NotFound, "issue not found"), "issue_id")
+		}
+		return status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
+	}
+	// Repositories may share the issues they return, so the status is set on a copy
+	issue := proto.Clone(stored).(*issuesPbv1.Issue)
+	if currentStatus != issuesPbv1.Status_STATUS_UNSPECIFIED {
+		issue.Status = currentStatus
+	}
+
+	newStatus, autoAdjustStatus, err := validateIssueUpdate(issue, req)
+	if err == nil {
+		err = s.checkStatusChange(ctx, issue, statusChange{
+			newStatus:           newStatus,
+			resolution:          req.Resolution,
+			comment:             req.GetResolutionComment(),
+			duplicateOf:         req.GetDuplicateOf(),
+			assigneeID:          updatedAssigneeID(issue, req),
+			skipTransitionCheck: autoAdjustStatus,
+		})
+		if err := problems.add(err, "status"); err != nil {
+			return err
+		}
+	} else if err := problems.add(err, ""); err != nil {
+		return err
+	}
+
+	if req.AssigneeId != nil && *req.AssigneeId != "" && *req.AssigneeId != issue.AssigneeId && !problems.has("assignee_id") {
+		if err := s.repository.ValidateUserExists(ctx, *req.AssigneeId); err != nil {
+			return problems.add(assigneeError(err, "invalid assignee"), "assignee_id")
+		}
+		if err := problems.add(s.checkAssigneeRole(ctx, issue.ProjectId, *req.AssigneeId), "assignee_id"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validationProblems collects the problems found with a payload
+type validationProblems struct {
+	list []*issuesPbv1.ValidationProblem
+}
+
+// addFieldRules adds a problem for every field rule err reports; err comes from a ValidateAll method
+func (p *validationProblems) addFieldRules(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	for _, violation := range i18n.FieldViolations(ctx, err) {
+		p.list = append(p.list, &issuesPbv1.ValidationProblem{
+			Field:   violation.GetField(),
+			Message: violation.GetDescription(),
+			Rule:    violation.GetReason(),
+		})
+	}
+}
+
+// add records the error of a failed check as a problem with field. Errors that don't mean the
+// payload is invalid, such as an unavailable dependency, a failed lookup or missing configuration,
+// are returned instead.
+func (p *validationProblems) add(err error, field string) error {
+	if err == nil {
+		return nil
+	}
+	if isDependencyUnavailable(err) || errors.Is(err, ErrTemplatesNotConfigured) {
+		return err
+	}
+	st := status.Convert(err)
+	switch st.Code() {
+	// The workflow checks of the repositories return plain errors, which have the Unknown code
+	case codes.Unknown, codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied, codes.NotFound, codes.ResourceExhausted:
+		p.list = append(p.list, &issuesPbv1.ValidationProblem{Field: field, Message: st.Message()})
+		return nil
+	default:
+		return err
+	}
+}
+
+// has reports whether field already has a problem, in which case checks that rely on it are skipped
+func (p *validationProblems) has(field string) bool {
+	for _, problem := range p.list {
+		if problem.Field == field {
+			return true
+		}
+	}
+	return false
+}
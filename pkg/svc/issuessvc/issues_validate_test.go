@@ -0,0 +1,138 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// problemFields returns the fields of the problems of a validation response with their rules
+func problemFields(resp *issuesPbv1.ValidateIssueResponse) map[string]string {
+	fields := make(map[string]string, len(resp.Problems))
+	for _, problem := range resp.Problems {
+		fields[problem.Field] = problem.Rule
+	}
+	return fields
+}
+
+func TestIssuesServiceServer_ValidateIssueCreate(t *testing.T) {
+	for name, service := range newMemberServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			assignee := validUserID
+			create := &issuesPbv1.CreateIssueRequest{
+				Summary:    testSummary,
+				Type:       issuesPbv1.Type_BUG,
+				Priority:   issuesPbv1.Priority_MINOR,
+				ProjectId:  validProjectID,
+				AssigneeId: &assignee,
+			}
+
+			resp, err := service.ValidateIssue(ctx, &issuesPbv1.ValidateIssueRequest{Payload: &issuesPbv1.ValidateIssueRequest_Create{Create: create}})
+			require.NoError(t, err)
+			assert.True(t, resp.Valid)
+			assert.Empty(t, resp.Problems)
+
+			missingUserID := validIssueID
+			empty := ""
+			resp, err = service.ValidateIssue(ctx, &issuesPbv1.ValidateIssueRequest{Payload: &issuesPbv1.ValidateIssueRequest_Create{Create: &issuesPbv1.CreateIssueRequest{
+				Summary:     "",
+				Description: &empty,
+				Type:        issuesPbv1.Type_BUG,
+				Priority:    issuesPbv1.Priority_MINOR,
+				ProjectId:   targetProjectID,
+				AssigneeId:  &missingUserID,
+			}}})
+			require.NoError(t, err)
+			assert.False(t, resp.Valid)
+			assert.Equal(t, map[string]string{
+				"summary":     "MIN_LENGTH",
+				"description": "MIN_LENGTH",
+				"project_id":  "",
+				"assignee_id": "",
+			}, problemFields(resp), "every problem is reported")
+
+			// Nothing was created
+			list, err := service.ListIssues(ctx, &issuesPbv1.ListIssuesRequest{PageSize: 10})
+			require.NoError(t, err)
+			assert.Empty(t, list.Issues)
+		})
+	}
+}
+
+func TestIssuesServiceServer_ValidateIssueUpdate(t *testing.T) {
+	for name, service := range newMemberServices(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			assignee := validUserID
+			created, err := service.CreateIssue(ctx, &issuesPbv1.CreateIssueRequest{
+				Summary:    testSummary,
+				Type:       issuesPbv1.Type_BUG,
+				Priority:   issuesPbv1.Priority_MINOR,
+				ProjectId:  validProjectID,
+				AssigneeId: &assignee,
+			})
+			require.NoError(t, err)
+			issue := created.Issue
+
+			newUpdate := func(newStatus issuesPbv1.Status) *issuesPbv1.UpdateIssueRequest {
+				return &issuesPbv1.UpdateIssueRequest{
+					IssueId:  issue.IssueId,
+					Summary:  issue.Summary,
+					Type:     issue.Type,
+					Priority: issue.Priority,
+					Status:   newStatus,
+					Version:  issue.Version,
+				}
+			}
+			validate := func(update *issuesPbv1.UpdateIssueRequest, currentStatus issuesPbv1.Status) *issuesPbv1.ValidateIssueResponse {
+				t.Helper()
+				resp, err := service.ValidateIssue(ctx, &issuesPbv1.ValidateIssueRequest{
+					Payload:       &issuesPbv1.ValidateIssueRequest_Update{Update: update},
+					CurrentStatus: currentStatus,
+				})
+				require.NoError(t, err)
+				return resp
+			}
+
+			assert.True(t, validate(newUpdate(issuesPbv1.Status_IN_PROGRESS), issuesPbv1.Status_STATUS_UNSPECIFIED).Valid)
+
+			// The transition is checked from the supplied status
+			resp := validate(newUpdate(issuesPbv1.Status_IN_PROGRESS), issuesPbv1.Status_CLOSED)
+			assert.Equal(t, map[string]string{"status": ""}, problemFields(resp))
+
+			resolved := newUpdate(issuesPbv1.Status_RESOLVED)
+			resp = validate(resolved, issuesPbv1.Status_STATUS_UNSPECIFIED)
+			require.Len(t, resp.Problems, 1)
+			assert.Equal(t, "resolution is required when status is Resolved or Closed", resp.Problems[0].Message)
+
+			viewer := viewerUserID
+			reassigned := newUpdate(issuesPbv1.Status_ASSIGNED)
+			reassigned.AssigneeId = &viewer
+			assert.Equal(t, map[string]string{"assignee_id": ""}, problemFields(validate(reassigned, issuesPbv1.Status_STATUS_UNSPECIFIED)))
+
+			missing := newUpdate(issuesPbv1.Status_ASSIGNED)
+			missing.IssueId = otherUserID
+			assert.Equal(t, map[string]string{"issue_id": ""}, problemFields(validate(missing, issuesPbv1.Status_STATUS_UNSPECIFIED)))
+
+			// Nothing was changed
+			got, err := service.GetIssue(ctx, &issuesPbv1.GetIssueRequest{IssueId: issue.IssueId})
+			require.NoError(t, err)
+			assert.Equal(t, issuesPbv1.Status_ASSIGNED, got.Issue.Status)
+			assert.Equal(t, issue.Version, got.Issue.Version)
+		})
+	}
+}
+
+func TestIssuesServiceServer_ValidateIssueRequiresPayload(t *testing.T) {
+	for _, service := range newMemberServices(t) {
+		_, err := service.ValidateIssue(context.Background(), &issuesPbv1.ValidateIssueRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
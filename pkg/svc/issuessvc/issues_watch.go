@@ -0,0 +1,133 @@
+package issuessvc
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// Event types pushed to issue watchers
+const (
+	IssueEventUpdated  = "updated"
+	IssueEventReopened = "reopened"
+	IssueEventCloned   = "cloned"
+	IssueEventDeleted  = "deleted"
+)
+
+// watcherBufferSize is the number of events buffered per watcher before new events are dropped
+const watcherBufferSize = 10
+
+// WatchIssue streams change events for a single issue until the client disconnects
+// or the issue is deleted
+func (s *IssuesServiceServer) WatchIssue(req *issuesPbv1.WatchIssueRequest, stream issuesPbv1.IssuesService_WatchIssueServer) error {
+	if err := req.Validate(); err != nil {
+		return i18n.InvalidRequest(stream.Context(), err)
+	}
+
+	// Register before checking the issue exists so no change can slip in between
+	ch := make(chan *issuesPbv1.IssueEvent, watcherBufferSize)
+	s.addIssueWatcher(req.IssueId, ch)
+	defer s.removeIssueWatcher(req.IssueId, ch)
+
+	ctx := stream.Context()
+	if _, err := s.repository.ReadIssue(ctx, req.IssueId); err != nil {
+		if status.Code(err) == codes.NotFound || errors.Is(err, consts.ErrNotFound) {
+			return status.Error(codes.NotFound, "issue not found")
+		}
+		return status.Errorf(codes.Internal, "failed to retrieve issue: %v", err)
+	}
+
+	for {
+		select {
+		case event := <-ch:
+			if err := stream.Send(event); err != nil {
+				logger.ZapLogger.Error("Error sending issue event to stream",
+					zap.String("issue_id", req.IssueId),
+					zap.Error(err))
+				return err
+			}
+			// Nothing more will happen to a deleted issue
+			if event.EventType == IssueEventDeleted {
+				return nil
+			}
+		case <-ctx.Done():
+			// Client disconnected or the stream was cancelled
+			return nil
+		}
+	}
+}
+
+// addIssueWatcher registers a channel for events on the given issue. Watcher lists are
+// replaced rather than mutated so notifyIssueWatchers can read them without locking.
+func (s *IssuesServiceServer) addIssueWatcher(issueID string, ch chan *issuesPbv1.IssueEvent) {
+	s.issueWatchersMu.Lock()
+	defer s.issueWatchersMu.Unlock()
+
+	var channels []chan *issuesPbv1.IssueEvent
+	if existing, ok := s.issueWatchers.Load(issueID); ok {
+		channels = existing.([]chan *issuesPbv1.IssueEvent)
+	}
+	updated := make([]chan *issuesPbv1.IssueEvent, 0, len(channels)+1)
+	updated = append(updated, channels...)
+	s.issueWatchers.Store(issueID, append(updated, ch))
+}
+
+func (s *IssuesServiceServer) removeIssueWatcher(issueID string, ch chan *issuesPbv1.IssueEvent) {
+	s.issueWatchersMu.Lock()
+	defer s.issueWatchersMu.Unlock()
+
+	existing, ok := s.issueWatchers.Load(issueID)
+	if !ok {
+		return
+	}
+	channels := existing.([]chan *issuesPbv1.IssueEvent)
+	updated := make([]chan *issuesPbv1.IssueEvent, 0, len(channels))
+	for _, c := range channels {
+		if c != ch {
+			updated = append(updated, c)
+		}
+	}
+
+	if len(updated) == 0 {
+		s.issueWatchers.Delete(issueID)
+		return
+	}
+	s.issueWatchers.Store(issueID, updated)
+}
+
+// notifyIssueWatchers pushes an event to everyone watching the issue without blocking the caller
+func (s *IssuesServiceServer) notifyIssueWatchers(eventType string, issue *issuesPbv1.Issue) {
+	s.notifyWatchersOf(issue.IssueId, eventType, issue)
+}
+
+// notifyWatchersOf pushes an event carrying issue to the watchers of issueID, which may be
+// another issue, such as the clone of the watched issue
+func (s *IssuesServiceServer) notifyWatchersOf(issueID, eventType string, issue *issuesPbv1.Issue) {
+	existing, ok := s.issueWatchers.Load(issueID)
+	if !ok {
+		return
+	}
+
+	// Each watcher gets its own copy so later changes to the issue don't race with the stream
+	for _, ch := range existing.([]chan *issuesPbv1.IssueEvent) {
+		event := &issuesPbv1.IssueEvent{
+			EventType: eventType,
+			Issue:     proto.Clone(issue).(*issuesPbv1.Issue),
+		}
+		select {
+		case ch <- event:
+		default:
+			logger.ZapLogger.Warn("Issue watcher is not keeping up, dropping event",
+				zap.String("issue_id", issueID),
+				zap.String("event_type", eventType))
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// fakeWatchStream collects the events sent by WatchIssue
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *issuesPbv1.IssueEvent
+}
+
+func (f *fakeWatchStream) Context() context.Context { return f.ctx }
+
+func (f *fakeWatchStream) Send(event *issuesPbv1.IssueEvent) error {
+	f.events <- event
+	return nil
+}
+
+// startWatch runs WatchIssue in the background and returns once the watcher is registered
+func startWatch(t *testing.T, svc *issuessvc.IssuesServiceServer, mockRepo *mocks.MockIssuesRepository, ctx context.Context) (*fakeWatchStream, <-chan error) {
+	t.Helper()
+
+	registered := make(chan struct{})
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).DoAndReturn(func(context.Context, string) (*issuesPbv1.Issue, error) {
+		close(registered)
+		return &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_NEW}, nil
+	})
+
+	stream := &fakeWatchStream{ctx: ctx, events: make(chan *issuesPbv1.IssueEvent, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchIssue(&issuesPbv1.WatchIssueRequest{IssueId: validIssueID}, stream)
+	}()
+
+	select {
+	case <-registered:
+	case <-time.After(time.Second):
+		t.Fatal("watcher was not registered")
+	}
+	return stream, done
+}
+
+func receiveEvent(t *testing.T, stream *fakeWatchStream) *issuesPbv1.IssueEvent {
+	t.Helper()
+	select {
+	case event := <-stream.events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestIssuesServiceServer_WatchIssue(t *testing.T) {
+	logger.ZapLogger, _ = zap.NewDevelopment()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	svc := issuessvc.NewIssuesService(mockRepo, mocks.NewMockProjectServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	stream, done := startWatch(t, svc, mockRepo, context.Background())
+
+	// An update is pushed to the watcher
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_NEW, Version: 1}, nil)
+	mockRepo.EXPECT().IsValidStatusTransition(gomock.Any(), issuesPbv1.Status_NEW, issuesPbv1.Status_NEW).Return(nil)
+	mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).Return(nil)
+	_, err := svc.UpdateIssue(context.Background(), &issuesPbv1.UpdateIssueRequest{
+		IssueId:     validIssueID,
+		Summary:     testSummary,
+		Description: proto.String(testDescription),
+		Type:        issuesPbv1.Type_BUG,
+		Priority:    issuesPbv1.Priority_MAJOR,
+		Status:      issuesPbv1.Status_NEW,
+		Version:     1,
+	})
+	require.NoError(t, err)
+
+	event := receiveEvent(t, stream)
+	assert.Equal(t, issuessvc.IssueEventUpdated, event.EventType)
+	assert.Equal(t, testSummary, event.Issue.Summary)
+
+	// A delete is pushed and ends the stream
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{IssueId: validIssueID}, nil)
+	mockRepo.EXPECT().DeleteIssue(gomock.Any(), validIssueID).Return(nil)
+	_, err = svc.DeleteIssue(context.Background(), &issuesPbv1.DeleteIssueRequest{IssueId: validIssueID})
+	require.NoError(t, err)
+
+	event = receiveEvent(t, stream)
+	assert.Equal(t, issuessvc.IssueEventDeleted, event.EventType)
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not end after the issue was deleted")
+	}
+}
+
+func TestIssuesServiceServer_WatchIssue_ClientDisconnect(t *testing.T) {
+	logger.ZapLogger, _ = zap.NewDevelopment()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	svc := issuessvc.NewIssuesService(mockRepo, mocks.NewMockProjectServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, done := startWatch(t, svc, mockRepo, ctx)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not end after the client disconnected")
+	}
+
+	// Later changes are not delivered to the disconnected watcher
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{IssueId: validIssueID}, nil)
+	mockRepo.EXPECT().DeleteIssue(gomock.Any(), validIssueID).Return(nil)
+	_, err := svc.DeleteIssue(context.Background(), &issuesPbv1.DeleteIssueRequest{IssueId: validIssueID})
+	require.NoError(t, err)
+	assert.Empty(t, stream.events)
+}
+
+func TestIssuesServiceServer_WatchIssue_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	svc := issuessvc.NewIssuesService(mockRepo, mocks.NewMockProjectServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(nil, status.Error(codes.NotFound, "issue not found"))
+
+	stream := &fakeWatchStream{ctx: context.Background(), events: make(chan *issuesPbv1.IssueEvent, 1)}
+	err := svc.WatchIssue(&issuesPbv1.WatchIssueRequest{IssueId: validIssueID}, stream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestIssuesServiceServer_WatchIssue_Reopen(t *testing.T) {
+	logger.ZapLogger, _ = zap.NewDevelopment()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	svc := issuessvc.NewIssuesService(mockRepo, mocks.NewMockProjectServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, _ := startWatch(t, svc, mockRepo, ctx)
+
+	mockRepo.EXPECT().ReadIssue(gomock.Any(), validIssueID).Return(&issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_CLOSED}, nil)
+	mockRepo.EXPECT().UpdateIssue(gomock.Any(), gomock.Any()).Return(nil)
+	_, err := svc.ReopenIssue(context.Background(), &issuesPbv1.ReopenIssueRequest{IssueId: validIssueID, Reason: "Regression"})
+	require.NoError(t, err)
+
+	event := receiveEvent(t, stream)
+	assert.Equal(t, issuessvc.IssueEventReopened, event.EventType)
+	assert.Equal(t, issuesPbv1.Status_NEW, event.Issue.Status)
+}
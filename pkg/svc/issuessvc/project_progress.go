@@ -0,0 +1,64 @@
+package issuessvc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// ProjectProgress counts a project's issues by status. Resolved and closed issues count as done.
+type ProjectProgress struct {
+	TotalIssues    int32
+	ClosedIssues   int32
+	ResolvedIssues int32
+	CompletionPct  float32
+	// IssuesByStatus maps status names to issue counts; statuses without issues are left out
+	IssuesByStatus map[string]int32
+}
+
+// newProjectProgress totals the issue counts of each status and works out the share that is done
+func newProjectProgress(byStatus map[string]int32) *ProjectProgress {
+	progress := &ProjectProgress{IssuesByStatus: byStatus}
+	for _, count := range byStatus {
+		progress.TotalIssues += count
+	}
+	progress.ClosedIssues = byStatus[issuesPbv1.Status_CLOSED.String()]
+	progress.ResolvedIssues = byStatus[issuesPbv1.Status_RESOLVED.String()]
+
+	if progress.TotalIssues > 0 {
+		progress.CompletionPct = float32(progress.ClosedIssues+progress.ResolvedIssues) / float32(progress.TotalIssues) * 100
+	}
+	return progress
+}
+
+// GetProjectProgress reports how many of a project's issues are in each status and the
+// percentage that are resolved or closed
+func (s *IssuesServiceServer) GetProjectProgress(ctx context.Context, req *issuesPbv1.GetProjectProgressRequest) (*issuesPbv1.GetProjectProgressResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	if err := s.repository.ValidateProjectExists(ctx, req.ProjectId); err != nil {
+		if isDependencyUnavailable(err) {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
+	}
+
+	progress, err := s.repository.ProjectProgress(ctx, req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count project issues: %v", err)
+	}
+
+	return &issuesPbv1.GetProjectProgressResponse{
+		TotalIssues:    progress.TotalIssues,
+		ClosedIssues:   progress.ClosedIssues,
+		ResolvedIssues: progress.ResolvedIssues,
+		CompletionPct:  progress.CompletionPct,
+		IssuesByStatus: progress.IssuesByStatus,
+	}, nil
+}
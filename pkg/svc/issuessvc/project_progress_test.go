@@ -0,0 +1,131 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+// progressStatuses are the statuses of the issues created in validProjectID by the progress tests
+var progressStatuses = []issuesPbv1.Status{
+	issuesPbv1.Status_NEW,
+	issuesPbv1.Status_NEW,
+	issuesPbv1.Status_IN_PROGRESS,
+	issuesPbv1.Status_RESOLVED,
+	issuesPbv1.Status_CLOSED,
+	issuesPbv1.Status_CLOSED,
+}
+
+// createProgressIssues creates an issue in each of progressStatuses, plus a closed issue in
+// targetProjectID that must not count towards validProjectID's progress
+func createProgressIssues(t *testing.T, repos numberingRepositories) {
+	t.Helper()
+	ctx := context.Background()
+
+	create := func(i int, projectID string, st issuesPbv1.Status) {
+		issue := &issuesPbv1.Issue{IssueId: numberedIssueID(i), Summary: testSummary, ProjectId: projectID, Status: st}
+		require.NoError(t, repos.issues.CreateIssue(ctx, issue))
+		require.NoError(t, repos.projects.AddIssueToProject(ctx, projectID, issue.IssueId))
+	}
+	for i, st := range progressStatuses {
+		create(i, validProjectID, st)
+	}
+	create(len(progressStatuses), targetProjectID, issuesPbv1.Status_CLOSED)
+}
+
+func TestIssuesRepositories_ProjectProgress(t *testing.T) {
+	for name, repos := range newNumberingRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			createProgressIssues(t, repos)
+
+			progress, err := repos.issues.ProjectProgress(context.Background(), validProjectID)
+			require.NoError(t, err)
+
+			assert.Equal(t, int32(6), progress.TotalIssues)
+			assert.Equal(t, int32(2), progress.ClosedIssues)
+			assert.Equal(t, int32(1), progress.ResolvedIssues)
+			assert.InDelta(t, 50, progress.CompletionPct, 0.001)
+			assert.Equal(t, map[string]int32{"NEW": 2, "IN_PROGRESS": 1, "RESOLVED": 1, "CLOSED": 2}, progress.IssuesByStatus)
+		})
+	}
+}
+
+func TestIssuesRepositories_ProjectProgressWithoutIssues(t *testing.T) {
+	for name, repos := range newNumberingRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			progress, err := repos.issues.ProjectProgress(context.Background(), validProjectID)
+			require.NoError(t, err)
+
+			assert.Zero(t, progress.TotalIssues)
+			assert.Zero(t, progress.CompletionPct)
+			assert.Empty(t, progress.IssuesByStatus)
+		})
+	}
+}
+
+func TestIssuesService_GetProjectProgress(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	// The issues and project repositories share a cache, as they do in the server
+	db := newSQLiteDB(t)
+	sharedCache := cache.NewMemoryCache(100)
+	projects := projectsvc.NewCachedProjectRepository(projectsvc.NewPostgresProjectRepository(db), sharedCache, cache.CacheConfigFromEnv())
+	issuesRepo := issuessvc.NewCachedIssuesRepository(issuessvc.NewPostgresIssuesRepository(db), sharedCache, cache.CacheConfigFromEnv())
+	for _, projectID := range []string{validProjectID, targetProjectID} {
+		require.NoError(t, projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: projectID, Name: "Project " + projectID[:4]}))
+	}
+	createProgressIssues(t, numberingRepositories{issues: issuesRepo, projects: projects})
+
+	service := issuessvc.NewIssuesService(issuesRepo, nil, nil)
+	resp, err := service.GetProjectProgress(ctx, &issuesPbv1.GetProjectProgressRequest{ProjectId: validProjectID})
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), resp.TotalIssues)
+	assert.Equal(t, int32(2), resp.ClosedIssues)
+	assert.Equal(t, int32(1), resp.ResolvedIssues)
+	assert.InDelta(t, 50, resp.CompletionPct, 0.001)
+	assert.Equal(t, int32(2), resp.IssuesByStatus["NEW"])
+
+	// Adding an issue to the project invalidates the cached progress
+	issue := &issuesPbv1.Issue{IssueId: numberedIssueID(100), Summary: testSummary, ProjectId: validProjectID, Status: issuesPbv1.Status_RESOLVED}
+	require.NoError(t, issuesRepo.CreateIssue(ctx, issue))
+	require.NoError(t, projects.AddIssueToProject(ctx, validProjectID, issue.IssueId))
+
+	resp, err = service.GetProjectProgress(ctx, &issuesPbv1.GetProjectProgressRequest{ProjectId: validProjectID})
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), resp.TotalIssues)
+	assert.Equal(t, int32(2), resp.ResolvedIssues)
+	assert.InDelta(t, 400.0/7, resp.CompletionPct, 0.001)
+
+	// So does removing one
+	require.NoError(t, projects.RemoveIssueFromProject(ctx, validProjectID, issue.IssueId))
+	require.NoError(t, issuesRepo.DeleteIssue(ctx, issue.IssueId))
+
+	resp, err = service.GetProjectProgress(ctx, &issuesPbv1.GetProjectProgressRequest{ProjectId: validProjectID})
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), resp.TotalIssues)
+	assert.InDelta(t, 50, resp.CompletionPct, 0.001)
+}
+
+func TestIssuesService_GetProjectProgressErrors(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	service := issuessvc.NewIssuesService(issuessvc.NewPostgresIssuesRepository(newSQLiteDB(t)), nil, nil)
+
+	_, err := service.GetProjectProgress(context.Background(), &issuesPbv1.GetProjectProgressRequest{ProjectId: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.GetProjectProgress(context.Background(), &issuesPbv1.GetProjectProgressRequest{ProjectId: validProjectID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,167 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// ErrValidationNotConfigured is returned by the MemDB repository's cross-service validation
+// when SetClients hasn't provided a way to look up projects and users
+var ErrValidationNotConfigured = status.Error(codes.FailedPrecondition,
+	"cross-service validation is not configured for the in-memory issues repository")
+
+// ReferenceValidator checks that the projects and users an issue refers to exist. Users that
+// exist but are deactivated fail validation with an error wrapping consts.ErrUserDeactivated.
+// GetProjectMemberRole returns consts.ErrProjectMemberNotFound for users who aren't members.
+// ReadProject returns the project with its issue count and limit.
+type ReferenceValidator interface {
+	ValidateProjectExists(ctx context.Context, projectID string) error
+	ValidateUserExists(ctx context.Context, userID string) error
+	GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error)
+	ReadProject(ctx context.Context, projectID string) (*projectPbv1.Project, error)
+}
+
+// ClientValidator validates references by calling the project and user services over gRPC
+type ClientValidator struct {
+	projectClient projectPbv1.ProjectServiceClient
+	userClient    userPbv1.UserServiceClient
+}
+
+// NewClientValidator creates a ClientValidator from the project and user service clients
+func NewClientValidator(projectClient projectPbv1.ProjectServiceClient, userClient userPbv1.UserServiceClient) *ClientValidator {
+	return &ClientValidator{projectClient: projectClient, userClient: userClient}
+}
+
+// ValidateProjectExists checks if a project with the given ID exists
+func (v *ClientValidator) ValidateProjectExists(ctx context.Context, projectID string) error {
+	if v.projectClient == nil {
+		return ErrValidationNotConfigured
+	}
+
+	_, err := v.projectClient.GetProject(ctx, &projectPbv1.GetProjectRequest{ProjectId: projectID})
+	if err != nil {
+		if isDependencyUnavailable(err) {
+			return err
+		}
+		return errors.New("project ID does not exist or could not be validated")
+	}
+	return nil
+}
+
+// ValidateUserExists checks if an active user with the given ID exists
+func (v *ClientValidator) ValidateUserExists(ctx context.Context, userID string) error {
+	if v.userClient == nil {
+		return ErrValidationNotConfigured
+	}
+
+	resp, err := v.userClient.GetUser(ctx, &userPbv1.GetUserRequest{UserId: userID})
+	if err != nil {
+		if isDependencyUnavailable(err) {
+			return err
+		}
+		return errors.New("user ID does not exist or could not be validated")
+	}
+	if !resp.GetUser().GetActive() {
+		return fmt.Errorf("%w: %s", consts.ErrUserDeactivated, userID)
+	}
+	return nil
+}
+
+// GetProjectMemberRole looks the user up in the members the project service lists for the project
+func (v *ClientValidator) GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error) {
+	if v.projectClient == nil {
+		return projectsvc.RoleUnspecified, ErrValidationNotConfigured
+	}
+
+	resp, err := v.projectClient.ListProjectMembers(ctx, &projectPbv1.ListProjectMembersRequest{ProjectId: projectID})
+	if err != nil {
+		return projectsvc.RoleUnspecified, err
+	}
+	for _, member := range resp.GetMembers() {
+		if member.UserId == userID {
+			return member.Role, nil
+		}
+	}
+	return projectsvc.RoleUnspecified, consts.ErrProjectMemberNotFound
+}
+
+// ReadProject retrieves the project from the project service
+func (v *ClientValidator) ReadProject(ctx context.Context, projectID string) (*projectPbv1.Project, error) {
+	if v.projectClient == nil {
+		return nil, ErrValidationNotConfigured
+	}
+
+	resp, err := v.projectClient.GetProject(ctx, &projectPbv1.GetProjectRequest{ProjectId: projectID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetProject(), nil
+}
+
+// LocalValidator validates references against the project and user repositories of the
+// same process, for running fully in memory without gRPC clients
+type LocalValidator struct {
+	projects projectsvc.ProjectRepository
+	users    usersvc.UserRepository
+}
+
+// NewLocalValidator creates a LocalValidator backed by the given repositories
+func NewLocalValidator(projects projectsvc.ProjectRepository, users usersvc.UserRepository) *LocalValidator {
+	return &LocalValidator{projects: projects, users: users}
+}
+
+// ValidateProjectExists checks if a project with the given ID exists
+func (v *LocalValidator) ValidateProjectExists(ctx context.Context, projectID string) error {
+	project, err := v.projects.ReadProject(ctx, projectID)
+	if err != nil || project == nil {
+		return fmt.Errorf("project ID does not exist: %s", projectID)
+	}
+	return nil
+}
+
+// ValidateUserExists checks if an active user with the given ID exists
+func (v *LocalValidator) ValidateUserExists(ctx context.Context, userID string) error {
+	user, err := v.users.GetUserByID(ctx, userID)
+	if errors.Is(err, consts.ErrUserNotFound) || (err == nil && user == nil) {
+		return fmt.Errorf("user ID does not exist: %s", userID)
+	}
+	if err != nil {
+		return fmt.Errorf("user ID could not be validated: %w", err)
+	}
+	if !user.Active {
+		return fmt.Errorf("%w: %s", consts.ErrUserDeactivated, userID)
+	}
+	return nil
+}
+
+// GetProjectMemberRole retrieves the role of a user in a project from the project repository
+func (v *LocalValidator) GetProjectMemberRole(ctx context.Context, projectID, userID string) (projectsvc.Role, error) {
+	return v.projects.GetProjectMemberRole(ctx, projectID, userID)
+}
+
+// ReadProject retrieves the project from the project repository
+func (v *LocalValidator) ReadProject(ctx context.Context, projectID string) (*projectPbv1.Project, error) {
+	return v.projects.ReadProject(ctx, projectID)
+}
+
+// assigneeError converts an error from ValidateUserExists to the status returned for an invalid
+// assignee: deactivated users can't be assigned issues, while unknown IDs are bad arguments
+func assigneeError(err error, message string) error {
+	if isDependencyUnavailable(err) {
+		return err
+	}
+	if errors.Is(err, consts.ErrUserDeactivated) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", message, err)
+	}
+	return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+}
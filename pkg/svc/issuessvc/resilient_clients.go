@@ -0,0 +1,176 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
+)
+
+// Defaults for outbound calls to the project and user services
+const (
+	defaultOutboundCallTimeout     = 2 * time.Second
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerCoolDown         = 30 * time.Second
+)
+
+// ResilienceConfig controls timeouts and circuit breaking for outbound service calls
+type ResilienceConfig struct {
+	CallTimeout      time.Duration
+	FailureThreshold int
+	CoolDown         time.Duration
+}
+
+// ResilienceConfigFromEnv reads the resilience settings from environment variables,
+// falling back to the defaults for anything unset or invalid
+func ResilienceConfigFromEnv() ResilienceConfig {
+	cfg := ResilienceConfig{
+		CallTimeout:      defaultOutboundCallTimeout,
+		FailureThreshold: defaultBreakerFailureThreshold,
+		CoolDown:         defaultBreakerCoolDown,
+	}
+
+	if v, err := strconv.Atoi(os.Getenv("OUTBOUND_CALL_TIMEOUT_MS")); err == nil && v > 0 {
+		cfg.CallTimeout = time.Duration(v) * time.Millisecond
+	}
+	if v, err := strconv.Atoi(os.Getenv("CIRCUIT_BREAKER_FAILURE_THRESHOLD")); err == nil && v > 0 {
+		cfg.FailureThreshold = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("CIRCUIT_BREAKER_COOLDOWN_SECONDS")); err == nil && v > 0 {
+		cfg.CoolDown = time.Duration(v) * time.Second
+	}
+
+	return cfg
+}
+
+// ResilientProjectClient wraps a ProjectServiceClient with a per-call timeout and a circuit breaker.
+// Only the unary calls made by the issues service are guarded; everything else is passed through.
+type ResilientProjectClient struct {
+	projectPbv1.ProjectServiceClient
+	breaker *resilience.CircuitBreaker
+	timeout time.Duration
+}
+
+// NewResilientProjectClient wraps the given project client
+func NewResilientProjectClient(client projectPbv1.ProjectServiceClient, breaker *resilience.CircuitBreaker, timeout time.Duration) *ResilientProjectClient {
+	return &ResilientProjectClient{
+		ProjectServiceClient: client,
+		breaker:              breaker,
+		timeout:              timeout,
+	}
+}
+
+// GetProject fetches a project, failing fast while the project service is unavailable
+func (c *ResilientProjectClient) GetProject(ctx context.Context, in *projectPbv1.GetProjectRequest, opts ...grpc.CallOption) (*projectPbv1.GetProjectResponse, error) {
+	var resp *projectPbv1.GetProjectResponse
+	err := guardedCall(ctx, c.breaker, c.timeout, "project", func(ctx context.Context) error {
+		var err error
+		resp, err = c.ProjectServiceClient.GetProject(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// UpdateProjectWithIssue notifies the project service, failing fast while it is unavailable
+func (c *ResilientProjectClient) UpdateProjectWithIssue(ctx context.Context, in *projectPbv1.UpdateProjectWithIssueRequest, opts ...grpc.CallOption) (*projectPbv1.UpdateProjectWithIssueResponse, error) {
+	var resp *projectPbv1.UpdateProjectWithIssueResponse
+	err := guardedCall(ctx, c.breaker, c.timeout, "project", func(ctx context.Context) error {
+		var err error
+		resp, err = c.ProjectServiceClient.UpdateProjectWithIssue(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// ResilientUserClient wraps a UserServiceClient with a per-call timeout and a circuit breaker
+type ResilientUserClient struct {
+	userPbv1.UserServiceClient
+	breaker *resilience.CircuitBreaker
+	timeout time.Duration
+}
+
+// NewResilientUserClient wraps the given user client
+func NewResilientUserClient(client userPbv1.UserServiceClient, breaker *resilience.CircuitBreaker, timeout time.Duration) *ResilientUserClient {
+	return &ResilientUserClient{
+		UserServiceClient: client,
+		breaker:           breaker,
+		timeout:           timeout,
+	}
+}
+
+// GetUser fetches a user, failing fast while the user service is unavailable
+func (c *ResilientUserClient) GetUser(ctx context.Context, in *userPbv1.GetUserRequest, opts ...grpc.CallOption) (*userPbv1.GetUserResponse, error) {
+	var resp *userPbv1.GetUserResponse
+	err := guardedCall(ctx, c.breaker, c.timeout, "user", func(ctx context.Context) error {
+		var err error
+		resp, err = c.UserServiceClient.GetUser(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// BatchGetUsers fetches several users, failing fast while the user service is unavailable
+func (c *ResilientUserClient) BatchGetUsers(ctx context.Context, in *userPbv1.BatchGetUsersRequest, opts ...grpc.CallOption) (*userPbv1.BatchGetUsersResponse, error) {
+	var resp *userPbv1.BatchGetUsersResponse
+	err := guardedCall(ctx, c.breaker, c.timeout, "user", func(ctx context.Context) error {
+		var err error
+		resp, err = c.UserServiceClient.BatchGetUsers(ctx, in, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// guardedCall runs call under the breaker with its own timeout and records the outcome
+func guardedCall(ctx context.Context, breaker *resilience.CircuitBreaker, timeout time.Duration, service string, call func(context.Context) error) error {
+	if err := breaker.Allow(); err != nil {
+		return status.Errorf(codes.Unavailable, "%s service unavailable, try later", service)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := call(callCtx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = status.Errorf(codes.DeadlineExceeded, "%s service call timed out after %s", service, timeout)
+	}
+	if isDependencyFailure(err) {
+		breaker.Failure()
+	} else {
+		breaker.Success()
+	}
+	return err
+}
+
+// isDependencyFailure reports whether err means the remote service is unhealthy,
+// as opposed to a normal answer such as NotFound or InvalidArgument
+func isDependencyFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Unknown, codes.Internal, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDependencyUnavailable reports whether a validation error came from an unreachable or
+// unconfigured dependency, in which case it should be surfaced as-is rather than as a bad argument
+func isDependencyUnavailable(err error) bool {
+	if errors.Is(err, ErrValidationNotConfigured) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
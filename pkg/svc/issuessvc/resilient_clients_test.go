@@ -0,0 +1,118 @@
+package issuessvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/resilience"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// hangingProjectClient never answers until the caller gives up
+type hangingProjectClient struct {
+	projectPbv1.ProjectServiceClient
+	calls int
+}
+
+func (c *hangingProjectClient) GetProject(ctx context.Context, _ *projectPbv1.GetProjectRequest, _ ...grpc.CallOption) (*projectPbv1.GetProjectResponse, error) {
+	c.calls++
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// failingUserClient fails with Unavailable until told to recover
+type failingUserClient struct {
+	userPbv1.UserServiceClient
+	healthy bool
+	calls   int
+}
+
+func (c *failingUserClient) GetUser(_ context.Context, req *userPbv1.GetUserRequest, _ ...grpc.CallOption) (*userPbv1.GetUserResponse, error) {
+	c.calls++
+	if !c.healthy {
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	return &userPbv1.GetUserResponse{User: &userPbv1.User{UserId: req.UserId}}, nil
+}
+
+func TestResilientProjectClient_TimesOutAndOpens(t *testing.T) {
+	fake := &hangingProjectClient{}
+	breaker := resilience.NewCircuitBreaker("project_test", 2, time.Minute)
+	client := issuessvc.NewResilientProjectClient(fake, breaker, 20*time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		_, err := client.GetProject(context.Background(), &projectPbv1.GetProjectRequest{ProjectId: validProjectID})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.Less(t, time.Since(start), time.Second, "call must not hang past its timeout")
+	}
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+
+	// While open the remote service is not called at all
+	_, err := client.GetProject(context.Background(), &projectPbv1.GetProjectRequest{ProjectId: validProjectID})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Equal(t, "project service unavailable, try later", st.Message())
+	assert.Equal(t, 2, fake.calls)
+}
+
+func TestResilientUserClient_HalfOpenRecovery(t *testing.T) {
+	now := time.Unix(0, 0)
+	fake := &failingUserClient{}
+	breaker := resilience.NewCircuitBreaker("user_test", 3, 5*time.Second).WithClock(func() time.Time { return now })
+	client := issuessvc.NewResilientUserClient(fake, breaker, time.Second)
+	req := &userPbv1.GetUserRequest{UserId: validUserID}
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetUser(context.Background(), req)
+		require.Error(t, err)
+	}
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+
+	_, err := client.GetUser(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 3, fake.calls, "open breaker must short-circuit")
+
+	// Cool-down elapsed but the service is still down: the trial call re-opens the breaker
+	now = now.Add(5 * time.Second)
+	_, err = client.GetUser(context.Background(), req)
+	require.Error(t, err)
+	assert.Equal(t, 4, fake.calls)
+	assert.Equal(t, resilience.StateOpen, breaker.State())
+
+	// Service recovers: the next trial call closes the breaker
+	now = now.Add(5 * time.Second)
+	fake.healthy = true
+	resp, err := client.GetUser(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, validUserID, resp.User.UserId)
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+}
+
+func TestResilientUserClient_NotFoundDoesNotTrip(t *testing.T) {
+	breaker := resilience.NewCircuitBreaker("user_notfound_test", 1, time.Minute)
+	client := issuessvc.NewResilientUserClient(&notFoundUserClient{}, breaker, time.Second)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetUser(context.Background(), &userPbv1.GetUserRequest{UserId: validUserID})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	}
+	assert.Equal(t, resilience.StateClosed, breaker.State())
+}
+
+type notFoundUserClient struct {
+	userPbv1.UserServiceClient
+}
+
+func (c *notFoundUserClient) GetUser(_ context.Context, _ *userPbv1.GetUserRequest, _ ...grpc.CallOption) (*userPbv1.GetUserResponse, error) {
+	return nil, status.Error(codes.NotFound, "user not found")
+}
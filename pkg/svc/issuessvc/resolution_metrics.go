@@ -0,0 +1,135 @@
+package issuessvc
+
+import (
+	"context"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// defaultResolutionSLOHours is used when RESOLUTION_SLO_HOURS is not set
+const defaultResolutionSLOHours = 48
+
+// ResolutionMetricsFilter selects the resolved issues included in resolution metrics.
+// Zero values leave the corresponding dimension unfiltered.
+type ResolutionMetricsFilter struct {
+	ProjectID string
+	Type      issuesPbv1.Type
+	// From and To bound the resolve date; From is inclusive and To is exclusive
+	From time.Time
+	To   time.Time
+	// SLOHours is the resolution time above which an issue counts as an SLO breach
+	SLOHours float64
+}
+
+// ResolutionMetrics summarizes how long resolved issues took to resolve
+type ResolutionMetrics struct {
+	P50Hours       float64
+	P90Hours       float64
+	P99Hours       float64
+	TotalResolved  int32
+	SLOBreachCount int32
+}
+
+// ResolutionSLOHoursFromEnv reads the resolution SLO threshold from RESOLUTION_SLO_HOURS
+func ResolutionSLOHoursFromEnv() float64 {
+	if v, err := strconv.ParseFloat(os.Getenv("RESOLUTION_SLO_HOURS"), 64); err == nil && v > 0 {
+		return v
+	}
+	return defaultResolutionSLOHours
+}
+
+// GetIssueResolutionMetrics reports resolution time percentiles and SLO breaches for resolved issues
+func (s *IssuesServiceServer) GetIssueResolutionMetrics(ctx context.Context, req *issuesPbv1.GetIssueResolutionMetricsRequest) (*issuesPbv1.GetIssueResolutionMetricsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	filter := ResolutionMetricsFilter{
+		ProjectID: req.ProjectId,
+		Type:      req.IssueType,
+		SLOHours:  ResolutionSLOHoursFromEnv(),
+	}
+	if req.FromDate != nil {
+		filter.From = req.FromDate.AsTime()
+	}
+	if req.ToDate != nil {
+		filter.To = req.ToDate.AsTime()
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, status.Error(codes.InvalidArgument, "from_date must be before to_date")
+	}
+
+	metrics, err := s.repository.ResolutionMetrics(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute resolution metrics: %v", err)
+	}
+
+	return &issuesPbv1.GetIssueResolutionMetricsResponse{
+		P50Hours:       metrics.P50Hours,
+		P90Hours:       metrics.P90Hours,
+		P99Hours:       metrics.P99Hours,
+		TotalResolved:  metrics.TotalResolved,
+		SloBreachCount: metrics.SLOBreachCount,
+		SloHours:       filter.SLOHours,
+	}, nil
+}
+
+// matches reports whether a resolved issue falls within the filter
+func (f ResolutionMetricsFilter) matches(issue *issuesPbv1.Issue) bool {
+	if issue.ResolveDate == nil || issue.CreateDate == nil {
+		return false
+	}
+	if f.ProjectID != "" && issue.ProjectId != f.ProjectID {
+		return false
+	}
+	if f.Type != issuesPbv1.Type_TYPE_UNSPECIFIED && issue.Type != f.Type {
+		return false
+	}
+
+	resolved := issue.ResolveDate.AsTime()
+	if !f.From.IsZero() && resolved.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !resolved.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// computeResolutionMetrics summarizes resolution times given in hours
+func computeResolutionMetrics(hours []float64, sloHours float64) *ResolutionMetrics {
+	metrics := &ResolutionMetrics{TotalResolved: int32(len(hours))}
+	if len(hours) == 0 {
+		return metrics
+	}
+
+	sorted := append([]float64(nil), hours...)
+	sort.Float64s(sorted)
+	metrics.P50Hours = percentile(sorted, 0.5)
+	metrics.P90Hours = percentile(sorted, 0.9)
+	metrics.P99Hours = percentile(sorted, 0.99)
+
+	for _, h := range sorted {
+		if h > sloHours {
+			metrics.SLOBreachCount++
+		}
+	}
+	return metrics
+}
+
+// percentile interpolates between the closest ranks of sorted values, matching PERCENTILE_CONT in Postgres
+func percentile(sorted []float64, p float64) float64 {
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
@@ -0,0 +1,174 @@
+package issuessvc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+// resolvedIssue creates an issue that took the given number of hours to resolve
+func resolvedIssue(n int, projectID string, issueType issuesPbv1.Type, resolvedAt time.Time, hours float64) *issuesPbv1.Issue {
+	created := resolvedAt.Add(-time.Duration(hours * float64(time.Hour)))
+	return &issuesPbv1.Issue{
+		IssueId:     fmt.Sprintf("00000000-0000-4000-8000-%012d", n),
+		ProjectId:   projectID,
+		Type:        issueType,
+		Status:      issuesPbv1.Status_RESOLVED,
+		Resolution:  issuesPbv1.Resolution_FIXED,
+		CreateDate:  timestamppb.New(created),
+		ResolveDate: timestamppb.New(resolvedAt),
+	}
+}
+
+func TestMemDBIssuesRepository_ResolutionMetrics(t *testing.T) {
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+
+	resolvedAt := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	issues := []*issuesPbv1.Issue{
+		resolvedIssue(1, validProjectID, issuesPbv1.Type_BUG, resolvedAt, 10),
+		resolvedIssue(2, validProjectID, issuesPbv1.Type_BUG, resolvedAt, 20),
+		resolvedIssue(3, validProjectID, issuesPbv1.Type_BUG, resolvedAt, 30),
+		resolvedIssue(4, validProjectID, issuesPbv1.Type_BUG, resolvedAt, 40),
+		resolvedIssue(5, validProjectID, issuesPbv1.Type_BUG, resolvedAt, 100),
+		// Excluded by the type, project or date filters below
+		resolvedIssue(6, validProjectID, issuesPbv1.Type_FEATURE, resolvedAt, 500),
+		resolvedIssue(7, validUserID, issuesPbv1.Type_BUG, resolvedAt, 500),
+		resolvedIssue(8, validProjectID, issuesPbv1.Type_BUG, resolvedAt.AddDate(0, -1, 0), 500),
+		// Never resolved
+		{IssueId: validIssueID, ProjectId: validProjectID, Type: issuesPbv1.Type_BUG, Status: issuesPbv1.Status_NEW, CreateDate: timestamppb.New(resolvedAt)},
+	}
+	for _, issue := range issues {
+		require.NoError(t, repo.CreateIssue(context.Background(), issue))
+	}
+
+	metrics, err := repo.ResolutionMetrics(context.Background(), issuessvc.ResolutionMetricsFilter{
+		ProjectID: validProjectID,
+		Type:      issuesPbv1.Type_BUG,
+		From:      resolvedAt.AddDate(0, 0, -7),
+		To:        resolvedAt.AddDate(0, 0, 1),
+		SLOHours:  48,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(5), metrics.TotalResolved)
+	assert.InDelta(t, 30, metrics.P50Hours, 0.001)
+	// Interpolated between the two slowest issues, as PERCENTILE_CONT does
+	assert.InDelta(t, 76, metrics.P90Hours, 0.001)
+	assert.InDelta(t, 97.6, metrics.P99Hours, 0.001)
+	assert.Equal(t, int32(1), metrics.SLOBreachCount)
+
+	// No filters includes every resolved issue
+	metrics, err = repo.ResolutionMetrics(context.Background(), issuessvc.ResolutionMetricsFilter{SLOHours: 48})
+	require.NoError(t, err)
+	assert.Equal(t, int32(8), metrics.TotalResolved)
+	assert.Equal(t, int32(4), metrics.SLOBreachCount)
+
+	// Nothing resolved in the window
+	metrics, err = repo.ResolutionMetrics(context.Background(), issuessvc.ResolutionMetricsFilter{From: resolvedAt.AddDate(1, 0, 0), SLOHours: 48})
+	require.NoError(t, err)
+	assert.Equal(t, &issuessvc.ResolutionMetrics{}, metrics)
+}
+
+func TestIssuesServiceServer_GetIssueResolutionMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIssuesRepository(ctrl)
+	issuesService := issuessvc.NewIssuesService(mockRepo, mocks.NewMockProjectServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("passes the filter and SLO threshold to the repository", func(t *testing.T) {
+		t.Setenv("RESOLUTION_SLO_HOURS", "24")
+
+		mockRepo.EXPECT().ResolutionMetrics(gomock.Any(), issuessvc.ResolutionMetricsFilter{
+			ProjectID: validProjectID,
+			Type:      issuesPbv1.Type_BUG,
+			From:      from,
+			To:        to,
+			SLOHours:  24,
+		}).Return(&issuessvc.ResolutionMetrics{P50Hours: 12, P90Hours: 30, P99Hours: 70, TotalResolved: 20, SLOBreachCount: 3}, nil)
+
+		resp, err := issuesService.GetIssueResolutionMetrics(context.Background(), &issuesPbv1.GetIssueResolutionMetricsRequest{
+			ProjectId: validProjectID,
+			IssueType: issuesPbv1.Type_BUG,
+			FromDate:  timestamppb.New(from),
+			ToDate:    timestamppb.New(to),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 12.0, resp.P50Hours)
+		assert.Equal(t, 30.0, resp.P90Hours)
+		assert.Equal(t, 70.0, resp.P99Hours)
+		assert.Equal(t, int32(20), resp.TotalResolved)
+		assert.Equal(t, int32(3), resp.SloBreachCount)
+		assert.Equal(t, 24.0, resp.SloHours)
+	})
+
+	t.Run("defaults to a 48 hour SLO", func(t *testing.T) {
+		t.Setenv("RESOLUTION_SLO_HOURS", "")
+
+		mockRepo.EXPECT().ResolutionMetrics(gomock.Any(), issuessvc.ResolutionMetricsFilter{SLOHours: 48}).Return(&issuessvc.ResolutionMetrics{}, nil)
+
+		resp, err := issuesService.GetIssueResolutionMetrics(context.Background(), &issuesPbv1.GetIssueResolutionMetricsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, 48.0, resp.SloHours)
+	})
+
+	t.Run("rejects an empty date range", func(t *testing.T) {
+		_, err := issuesService.GetIssueResolutionMetrics(context.Background(), &issuesPbv1.GetIssueResolutionMetricsRequest{
+			FromDate: timestamppb.New(to),
+			ToDate:   timestamppb.New(from),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		mockRepo.EXPECT().ResolutionMetrics(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+
+		_, err := issuesService.GetIssueResolutionMetrics(context.Background(), &issuesPbv1.GetIssueResolutionMetricsRequest{})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestIssuesServiceServer_ResolveDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, Status: issuesPbv1.Status_IN_PROGRESS, AssigneeId: validUserID}))
+
+	issuesService := issuessvc.NewIssuesService(repo, mocks.NewMockProjectServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	resp, err := issuesService.ChangeIssueStatus(context.Background(), &issuesPbv1.ChangeIssueStatusRequest{
+		IssueId:    validIssueID,
+		NewStatus:  issuesPbv1.Status_RESOLVED,
+		Resolution: issuesPbv1.Resolution_FIXED,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Issue.ResolveDate)
+	resolveDate := resp.Issue.ResolveDate.AsTime()
+
+	// Closing keeps the original resolve date
+	resp, err = issuesService.ChangeIssueStatus(context.Background(), &issuesPbv1.ChangeIssueStatusRequest{
+		IssueId:   validIssueID,
+		NewStatus: issuesPbv1.Status_CLOSED,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resolveDate, resp.Issue.ResolveDate.AsTime())
+}
@@ -0,0 +1,129 @@
+package issuessvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+)
+
+// ResolutionChange describes an issue being moved to RESOLVED or CLOSED
+type ResolutionChange struct {
+	// Issue is the issue as currently stored, before the change
+	Issue       *issuesPbv1.Issue
+	NewStatus   issuesPbv1.Status
+	Resolution  issuesPbv1.Resolution
+	Comment     string
+	DuplicateOf string
+}
+
+// ResolutionRule is a single named check applied to resolution changes
+type ResolutionRule struct {
+	Name  string
+	Check func(ctx context.Context, change ResolutionChange) error
+}
+
+// ResolutionValidator applies resolution rules when an issue is resolved or closed
+type ResolutionValidator struct {
+	rules []ResolutionRule
+}
+
+// NewResolutionValidator creates a validator that applies the given rules in order
+func NewResolutionValidator(rules ...ResolutionRule) *ResolutionValidator {
+	return &ResolutionValidator{rules: rules}
+}
+
+// DefaultResolutionRules returns the standard rules; lookupIssue is used to check duplicate references
+func DefaultResolutionRules(lookupIssue func(ctx context.Context, issueID string) (*issuesPbv1.Issue, error)) []ResolutionRule {
+	return []ResolutionRule{
+		FixedRequiresWorkRule(),
+		ResolutionCommentRule(),
+		DuplicateReferenceRule(lookupIssue),
+	}
+}
+
+// Validate runs every rule against the change and reports the first violation as InvalidArgument.
+// Changes that don't resolve or close the issue are not checked.
+func (v *ResolutionValidator) Validate(ctx context.Context, change ResolutionChange) error {
+	if change.NewStatus != issuesPbv1.Status_RESOLVED && change.NewStatus != issuesPbv1.Status_CLOSED {
+		return nil
+	}
+
+	for _, rule := range v.rules {
+		err := rule.Check(ctx, change)
+		if err == nil {
+			continue
+		}
+		// Rules report infrastructure failures as gRPC status errors; pass those through
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.InvalidArgument, "resolution rule %q violated: %v", rule.Name, err)
+	}
+	return nil
+}
+
+// FixedRequiresWorkRule rejects resolving a NEW issue as FIXED; work must have started first
+func FixedRequiresWorkRule() ResolutionRule {
+	return ResolutionRule{
+		Name: "fixed_requires_work",
+		Check: func(_ context.Context, change ResolutionChange) error {
+			if change.Resolution == issuesPbv1.Resolution_FIXED && change.Issue.Status == issuesPbv1.Status_NEW {
+				return errors.New("an issue cannot be marked FIXED before work on it has started")
+			}
+			return nil
+		},
+	}
+}
+
+// ResolutionCommentRule requires a comment explaining WONTFIX and INVALID resolutions
+func ResolutionCommentRule() ResolutionRule {
+	return ResolutionRule{
+		Name: "comment_required",
+		Check: func(_ context.Context, change ResolutionChange) error {
+			if change.Resolution != issuesPbv1.Resolution_WONTFIX && change.Resolution != issuesPbv1.Resolution_INVALID {
+				return nil
+			}
+			if strings.TrimSpace(change.Comment) == "" {
+				return fmt.Errorf("a resolution comment is required when resolving as %s", change.Resolution)
+			}
+			return nil
+		},
+	}
+}
+
+// DuplicateReferenceRule requires DUPLICATE resolutions to reference another existing issue,
+// and rejects duplicate references on any other resolution
+func DuplicateReferenceRule(lookupIssue func(ctx context.Context, issueID string) (*issuesPbv1.Issue, error)) ResolutionRule {
+	return ResolutionRule{
+		Name: "duplicate_reference",
+		Check: func(ctx context.Context, change ResolutionChange) error {
+			if change.Resolution != issuesPbv1.Resolution_DUPLICATE {
+				if change.DuplicateOf != "" {
+					return errors.New("duplicate_of can only be set when resolving as DUPLICATE")
+				}
+				return nil
+			}
+
+			if change.DuplicateOf == "" {
+				return errors.New("duplicate_of is required when resolving as DUPLICATE")
+			}
+			if change.DuplicateOf == change.Issue.IssueId {
+				return errors.New("an issue cannot be a duplicate of itself")
+			}
+			if _, err := lookupIssue(ctx, change.DuplicateOf); err != nil {
+				if status.Code(err) == codes.NotFound || errors.Is(err, consts.ErrNotFound) || errors.Is(err, consts.ErrIssueNotFound) {
+					return fmt.Errorf("duplicate issue %s does not exist", change.DuplicateOf)
+				}
+				return status.Errorf(codes.Internal, "failed to look up duplicate issue: %v", err)
+			}
+			return nil
+		},
+	}
+}
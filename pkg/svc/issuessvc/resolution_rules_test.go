@@ -0,0 +1,159 @@
+package issuessvc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	issuesPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/issues/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/issuessvc"
+)
+
+const originalIssueID = "5b6f2a8e-3c1d-4f7a-9e2b-8d4c6a1f0e3b"
+
+func TestResolutionValidator_Validate(t *testing.T) {
+	lookup := func(_ context.Context, issueID string) (*issuesPbv1.Issue, error) {
+		switch issueID {
+		case originalIssueID:
+			return &issuesPbv1.Issue{IssueId: originalIssueID}, nil
+		case validProjectID:
+			return nil, errors.New("connection refused")
+		default:
+			return nil, consts.ErrIssueNotFound
+		}
+	}
+	validator := issuessvc.NewResolutionValidator(issuessvc.DefaultResolutionRules(lookup)...)
+
+	inProgress := &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_IN_PROGRESS}
+
+	testCases := []struct {
+		name          string
+		change        issuessvc.ResolutionChange
+		expectedError codes.Code
+		expectedMsg   string
+	}{
+		{
+			name:          "fixed after work started",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_FIXED},
+			expectedError: codes.OK,
+		},
+		{
+			name:          "fixed straight from new",
+			change:        issuessvc.ResolutionChange{Issue: &issuesPbv1.Issue{IssueId: validIssueID, Status: issuesPbv1.Status_NEW}, NewStatus: issuesPbv1.Status_CLOSED, Resolution: issuesPbv1.Resolution_FIXED},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "fixed_requires_work",
+		},
+		{
+			name:          "wontfix with a comment",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_WONTFIX, Comment: "Out of scope"},
+			expectedError: codes.OK,
+		},
+		{
+			name:          "wontfix without a comment",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_WONTFIX},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "comment_required",
+		},
+		{
+			name:          "invalid with a blank comment",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_CLOSED, Resolution: issuesPbv1.Resolution_INVALID, Comment: "   "},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "comment_required",
+		},
+		{
+			name:          "duplicate of an existing issue",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_DUPLICATE, DuplicateOf: originalIssueID},
+			expectedError: codes.OK,
+		},
+		{
+			name:          "duplicate without a reference",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_DUPLICATE},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "duplicate_of is required",
+		},
+		{
+			name:          "duplicate of itself",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_DUPLICATE, DuplicateOf: validIssueID},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "duplicate of itself",
+		},
+		{
+			name:          "duplicate of a missing issue",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_DUPLICATE, DuplicateOf: validUserID},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "does not exist",
+		},
+		{
+			name:          "duplicate lookup fails",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_DUPLICATE, DuplicateOf: validProjectID},
+			expectedError: codes.Internal,
+			expectedMsg:   "failed to look up duplicate issue",
+		},
+		{
+			name:          "duplicate reference on another resolution",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_RESOLVED, Resolution: issuesPbv1.Resolution_FIXED, DuplicateOf: originalIssueID},
+			expectedError: codes.InvalidArgument,
+			expectedMsg:   "duplicate_reference",
+		},
+		{
+			name:          "rules are skipped for other statuses",
+			change:        issuessvc.ResolutionChange{Issue: inProgress, NewStatus: issuesPbv1.Status_IN_PROGRESS, Resolution: issuesPbv1.Resolution_WONTFIX},
+			expectedError: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validator.Validate(context.Background(), tc.change)
+
+			if tc.expectedError == codes.OK {
+				assert.NoError(t, err)
+			} else {
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.expectedError, st.Code())
+				assert.Contains(t, st.Message(), tc.expectedMsg)
+			}
+		})
+	}
+}
+
+func TestIssuesServiceServer_ChangeIssueStatus_Duplicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo, err := issuessvc.NewMemDBIssuesRepositoryWithoutClients()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: originalIssueID, Summary: testSummary, Status: issuesPbv1.Status_NEW}))
+	require.NoError(t, repo.CreateIssue(context.Background(), &issuesPbv1.Issue{IssueId: validIssueID, Summary: testSummary, Status: issuesPbv1.Status_IN_PROGRESS, AssigneeId: validUserID}))
+
+	issuesService := issuessvc.NewIssuesService(repo, mocks.NewMockProjectServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	_, err = issuesService.ChangeIssueStatus(context.Background(), &issuesPbv1.ChangeIssueStatusRequest{
+		IssueId:    validIssueID,
+		NewStatus:  issuesPbv1.Status_RESOLVED,
+		Resolution: issuesPbv1.Resolution_DUPLICATE,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = issuesService.ChangeIssueStatus(context.Background(), &issuesPbv1.ChangeIssueStatusRequest{
+		IssueId:     validIssueID,
+		NewStatus:   issuesPbv1.Status_RESOLVED,
+		Resolution:  issuesPbv1.Resolution_DUPLICATE,
+		DuplicateOf: originalIssueID,
+	})
+	require.NoError(t, err)
+
+	resp, err := issuesService.GetIssue(context.Background(), &issuesPbv1.GetIssueRequest{IssueId: validIssueID})
+	require.NoError(t, err)
+	assert.Equal(t, issuesPbv1.Status_RESOLVED, resp.Issue.Status)
+	assert.Equal(t, issuesPbv1.Resolution_DUPLICATE, resp.Issue.Resolution)
+	assert.Equal(t, originalIssueID, resp.Issue.DuplicateOf)
+}
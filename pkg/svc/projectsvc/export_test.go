@@ -0,0 +1,20 @@
+package projectsvc
+
+import (
+	"time"
+
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+)
+
+// SetMessageBrokerFactory replaces the broker factory used by NewProjectService and
+// returns a function restoring the original
+func SetMessageBrokerFactory(factory func() (broker.MessageBroker, error)) (restore func()) {
+	original := newMessageBroker
+	newMessageBroker = factory
+	return func() { newMessageBroker = original }
+}
+
+// SetHeartbeatInterval changes how long streams of the service may sit idle before a heartbeat is sent
+func (s *ProjectService) SetHeartbeatInterval(interval time.Duration) {
+	s.heartbeatInterval = interval
+}
@@ -0,0 +1,67 @@
+package projectsvc
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// defaultHeartbeatInterval is used when STREAM_HEARTBEAT_INTERVAL_SECONDS is not set. It is
+// shorter than the idle timeouts of common load balancers, which start at 60 seconds.
+const defaultHeartbeatInterval = 30 * time.Second
+
+// HeartbeatIntervalFromEnv reads how long a project update stream may sit idle before a heartbeat
+// is sent from STREAM_HEARTBEAT_INTERVAL_SECONDS; 0 disables heartbeats
+func HeartbeatIntervalFromEnv() time.Duration {
+	if v, err := strconv.Atoi(os.Getenv("STREAM_HEARTBEAT_INTERVAL_SECONDS")); err == nil && v >= 0 {
+		return time.Duration(v) * time.Second
+	}
+	return defaultHeartbeatInterval
+}
+
+// streamHeartbeat fires once a stream has been idle for the interval. Without an interval it never fires.
+type streamHeartbeat struct {
+	ticker   *time.Ticker
+	interval time.Duration
+}
+
+func newStreamHeartbeat(interval time.Duration) *streamHeartbeat {
+	h := &streamHeartbeat{interval: interval}
+	if interval > 0 {
+		h.ticker = time.NewTicker(interval)
+	}
+	return h
+}
+
+// C returns the channel the heartbeat fires on, or nil, which is never ready, if heartbeats are disabled
+func (h *streamHeartbeat) C() <-chan time.Time {
+	if h.ticker == nil {
+		return nil
+	}
+	return h.ticker.C
+}
+
+// reset restarts the idle interval after a message has been sent
+func (h *streamHeartbeat) reset() {
+	if h.ticker != nil {
+		h.ticker.Reset(h.interval)
+	}
+}
+
+func (h *streamHeartbeat) stop() {
+	if h.ticker != nil {
+		h.ticker.Stop()
+	}
+}
+
+// sendHeartbeat sends a keepalive message so proxies don't close the idle stream
+func sendHeartbeat(stream projectPbv1.ProjectService_StreamProjectUpdatesServer, sub *broker.Subscription) error {
+	heartbeat := &projectPbv1.ProjectUpdateResponse{Heartbeat: true}
+	if sub != nil {
+		heartbeat.ProjectId = sub.ProjectID
+	}
+	return stream.Send(heartbeat)
+}
@@ -0,0 +1,71 @@
+package projectsvc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// Role is a user's role in a project
+type Role = projectPbv1.Role
+
+// Project roles. Viewers can't be assigned the project's issues.
+const (
+	RoleUnspecified = projectPbv1.Role_ROLE_UNSPECIFIED
+	RoleMaintainer  = projectPbv1.Role_MAINTAINER
+	RoleDeveloper   = projectPbv1.Role_DEVELOPER
+	RoleViewer      = projectPbv1.Role_VIEWER
+)
+
+// SetProjectMemberRole adds a user to a project's members or changes the user's role
+func (s *ProjectService) SetProjectMemberRole(ctx context.Context, req *projectPbv1.SetProjectMemberRoleRequest) (*projectPbv1.SetProjectMemberRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	member := &projectPbv1.ProjectMember{
+		ProjectId: req.ProjectId,
+		UserId:    req.UserId,
+		Role:      req.Role,
+	}
+
+	if err := s.repository.SetProjectMember(ctx, member); err != nil {
+		if errors.Is(err, consts.ErrProjectNotFound) {
+			return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set project member role: %v", err)
+	}
+
+	return &projectPbv1.SetProjectMemberRoleResponse{
+		Member: member,
+	}, nil
+}
+
+// ListProjectMembers lists the members of a project with their roles
+func (s *ProjectService) ListProjectMembers(ctx context.Context, req *projectPbv1.ListProjectMembersRequest) (*projectPbv1.ListProjectMembersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	if _, err := s.repository.ReadProject(ctx, req.ProjectId); err != nil {
+		if errors.Is(err, consts.ErrProjectNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
+	members, err := s.repository.ListProjectMembers(ctx, req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list project members: %v", err)
+	}
+
+	return &projectPbv1.ListProjectMembersResponse{
+		Members: members,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package projectsvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const (
+	developerUserID  = "6c9e3a5b-0d2e-4f4c-b8a7-1e3d5f7b9c2a"
+	viewerUserID     = "7d0f4b6c-1e3f-4a5d-89b8-2f4e6a8c0d3b"
+	missingProjectID = "8e1a5c7d-2f4a-4b6e-9ac9-3a5f7b9d1e4c"
+)
+
+func TestProjectService_ProjectMembers(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	service, err := projectsvc.NewProjectService(repo)
+	require.NoError(t, err)
+	defer service.Close()
+
+	ctx := context.Background()
+	project, err := service.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Project"})
+	require.NoError(t, err)
+	projectID := project.Project.ProjectId
+
+	set, err := service.SetProjectMemberRole(ctx, &projectPbv1.SetProjectMemberRoleRequest{ProjectId: projectID, UserId: viewerUserID, Role: projectPbv1.Role_VIEWER})
+	require.NoError(t, err)
+	assert.Equal(t, projectsvc.RoleViewer, set.Member.Role)
+	_, err = service.SetProjectMemberRole(ctx, &projectPbv1.SetProjectMemberRoleRequest{ProjectId: projectID, UserId: developerUserID, Role: projectPbv1.Role_VIEWER})
+	require.NoError(t, err)
+
+	// Setting the role again replaces it
+	_, err = service.SetProjectMemberRole(ctx, &projectPbv1.SetProjectMemberRoleRequest{ProjectId: projectID, UserId: developerUserID, Role: projectPbv1.Role_DEVELOPER})
+	require.NoError(t, err)
+
+	listed, err := service.ListProjectMembers(ctx, &projectPbv1.ListProjectMembersRequest{ProjectId: projectID})
+	require.NoError(t, err)
+	roles := make(map[string]projectsvc.Role)
+	for _, member := range listed.Members {
+		roles[member.UserId] = member.Role
+	}
+	assert.Equal(t, map[string]projectsvc.Role{developerUserID: projectsvc.RoleDeveloper, viewerUserID: projectsvc.RoleViewer}, roles)
+}
+
+func TestProjectService_ProjectMemberErrors(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	service, err := projectsvc.NewProjectService(repo)
+	require.NoError(t, err)
+	defer service.Close()
+
+	ctx := context.Background()
+	project, err := service.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Project"})
+	require.NoError(t, err)
+	projectID := project.Project.ProjectId
+
+	_, err = service.SetProjectMemberRole(ctx, &projectPbv1.SetProjectMemberRoleRequest{ProjectId: projectID, UserId: viewerUserID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a role is required")
+	_, err = service.SetProjectMemberRole(ctx, &projectPbv1.SetProjectMemberRoleRequest{ProjectId: projectID, UserId: "not-a-uuid", Role: projectPbv1.Role_VIEWER})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.SetProjectMemberRole(ctx, &projectPbv1.SetProjectMemberRoleRequest{ProjectId: missingProjectID, UserId: viewerUserID, Role: projectPbv1.Role_VIEWER})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.ListProjectMembers(ctx, &projectPbv1.ListProjectMembersRequest{ProjectId: missingProjectID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
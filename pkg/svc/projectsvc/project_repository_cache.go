@@ -3,13 +3,13 @@ package projectsvc
 import (
 	"context"
 	"fmt"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/yasindce1998/issue-tracker/cache"
 	"github.com/yasindce1998/issue-tracker/logger"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -18,50 +18,99 @@ type CachedProjectRepository struct {
 	repository ProjectRepository
 	cache      cache.Cache
 	ttl        time.Duration
+	listTTL    time.Duration
 }
 
-// NewCachedProjectRepository creates a new cached project repository
-func NewCachedProjectRepository(repository ProjectRepository, cache cache.Cache) *CachedProjectRepository {
-	// Default TTL: 1 hour
-	ttl := 3600 * time.Second
-
-	// Get TTL from environment variable if available
-	if ttlStr := os.Getenv("CACHE_TTL"); ttlStr != "" {
-		if ttlVal, err := strconv.Atoi(ttlStr); err == nil {
-			ttl = time.Duration(ttlVal) * time.Second
-		}
-	}
+// ProjectProgressCacheKey is the cache key of a project's issue progress. The issues
+// repository caches the progress, and adding issues to or removing them from the project
+// invalidates it.
+func ProjectProgressCacheKey(projectID string) string {
+	return fmt.Sprintf("project:progress:%s", projectID)
+}
 
+// NewCachedProjectRepository creates a new cached project repository whose entries expire after the TTLs in cfg
+func NewCachedProjectRepository(repository ProjectRepository, cache cache.Cache, cfg cache.CacheConfig) *CachedProjectRepository {
 	return &CachedProjectRepository{
 		repository: repository,
 		cache:      cache,
-		ttl:        ttl,
+		ttl:        cfg.ProjectTTL,
+		listTTL:    cfg.ProjectListTTL,
 	}
 }
 
+// DegradedMode reports whether the repository has stopped using its cache because the cache
+// kept failing; see cache.DegradedCache
+func (r *CachedProjectRepository) DegradedMode() bool {
+	return cache.IsDegraded(r.cache)
+}
+
 // CreateProject adds a new project to the repository with caching
-func (r *CachedProjectRepository) CreateProject(project *projectPbv1.Project) error {
+func (r *CachedProjectRepository) CreateProject(ctx context.Context, project *projectPbv1.Project) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.CreateProject")
+	defer span.End()
+
 	// Write to repository first
-	if err := r.repository.CreateProject(project); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.CreateProject")
+	err := r.repository.CreateProject(repoCtx, project)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Then update cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("project:%s", project.ProjectId)
-	if err := r.cache.Set(ctx, cacheKey, project, r.ttl); err != nil {
+	if err := r.set(ctx, cacheKey, project, r.ttl); err != nil {
 		// Log error but don't fail the request
-		logger.ZapLogger.Error("Failed to cache project",
+		logger.FromContext(ctx).Error("Failed to cache project",
 			zap.String("project_id", project.ProjectId),
 			zap.Error(err))
 	}
 
+	// Also invalidate projects list cache
+	if err := r.cache.Delete(ctx, "projects:all"); err != nil {
+		logger.FromContext(ctx).Error("Failed to invalidate projects list cache", zap.Error(err))
+	}
+
 	return nil
 }
 
+// FindOrCreateProject finds or creates a project and caches the result
+func (r *CachedProjectRepository) FindOrCreateProject(ctx context.Context, project *projectPbv1.Project) (*projectPbv1.Project, bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.FindOrCreateProject")
+	defer span.End()
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.FindOrCreateProject")
+	result, created, err := r.repository.FindOrCreateProject(repoCtx, project)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cacheKey := fmt.Sprintf("project:%s", result.ProjectId)
+	if err := r.set(ctx, cacheKey, result, r.ttl); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache project",
+			zap.String("project_id", result.ProjectId),
+			zap.Error(err))
+	}
+
+	if created {
+		if err := r.cache.Delete(ctx, "projects:all"); err != nil {
+			logger.FromContext(ctx).Error("Failed to invalidate projects list cache", zap.Error(err))
+		}
+	}
+
+	return result, created, nil
+}
+
 // ReadProject retrieves a project by ID with caching
-func (r *CachedProjectRepository) ReadProject(projectID string) (*projectPbv1.Project, error) {
-	ctx := context.Background()
+func (r *CachedProjectRepository) ReadProject(ctx context.Context, projectID string) (*projectPbv1.Project, error) {
+	if r.ttl <= 0 {
+		return r.repository.ReadProject(ctx, projectID)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.ReadProject")
+	defer span.End()
+
 	cacheKey := fmt.Sprintf("project:%s", projectID)
 
 	// Try to get from cache first
@@ -69,13 +118,17 @@ func (r *CachedProjectRepository) ReadProject(projectID string) (*projectPbv1.Pr
 	err := r.cache.Get(ctx, cacheKey, project)
 	if err == nil {
 		// Cache hit
-		logger.ZapLogger.Debug("Project cache hit", zap.String("project_id", projectID))
+		logger.FromContext(ctx).Debug("Project cache hit", zap.String("project_id", projectID))
 		logger.LogCacheAccess(ctx, "Project", projectID, logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
 		return project, nil
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 
 	// Cache miss, get from repository
-	project, err = r.repository.ReadProject(projectID)
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.ReadProject")
+	project, err = r.repository.ReadProject(repoCtx, projectID)
+	tracing.EndSpan(repoSpan, err)
 	if err != nil {
 		return nil, err
 	}
@@ -83,9 +136,9 @@ func (r *CachedProjectRepository) ReadProject(projectID string) (*projectPbv1.Pr
 	logger.LogCacheAccess(ctx, "Project", projectID, logger.FromDatabase)
 
 	// Store in cache for future requests
-	if err := r.cache.Set(ctx, cacheKey, project, r.ttl); err != nil {
+	if err := r.set(ctx, cacheKey, project, r.ttl); err != nil {
 		// Log error but don't fail the request
-		logger.ZapLogger.Error("Failed to cache project",
+		logger.FromContext(ctx).Error("Failed to cache project",
 			zap.String("project_id", projectID),
 			zap.Error(err))
 	}
@@ -94,46 +147,72 @@ func (r *CachedProjectRepository) ReadProject(projectID string) (*projectPbv1.Pr
 }
 
 // UpdateProject updates an existing project and refreshes cache
-func (r *CachedProjectRepository) UpdateProject(project *projectPbv1.Project) error {
+func (r *CachedProjectRepository) UpdateProject(ctx context.Context, project *projectPbv1.Project) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.UpdateProject")
+	defer span.End()
+
 	// Write to repository first
-	if err := r.repository.UpdateProject(project); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.UpdateProject")
+	err := r.repository.UpdateProject(repoCtx, project)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Update cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("project:%s", project.ProjectId)
-	if err := r.cache.Set(ctx, cacheKey, project, r.ttl); err != nil {
-		logger.ZapLogger.Error("Failed to update project in cache",
+	if err := r.set(ctx, cacheKey, project, r.ttl); err != nil {
+		logger.FromContext(ctx).Error("Failed to update project in cache",
 			zap.String("project_id", project.ProjectId),
 			zap.Error(err))
 	}
 
+	// Also invalidate projects list cache
+	if err := r.cache.Delete(ctx, "projects:all"); err != nil {
+		logger.FromContext(ctx).Error("Failed to invalidate projects list cache", zap.Error(err))
+	}
+
 	return nil
 }
 
 // DeleteProject removes a project and clears it from cache
-func (r *CachedProjectRepository) DeleteProject(projectID string) error {
+func (r *CachedProjectRepository) DeleteProject(ctx context.Context, projectID string) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.DeleteProject")
+	defer span.End()
+
 	// Delete from repository first
-	if err := r.repository.DeleteProject(projectID); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.DeleteProject")
+	err := r.repository.DeleteProject(repoCtx, projectID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Remove from cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("project:%s", projectID)
 	if err := r.cache.Delete(ctx, cacheKey); err != nil {
-		logger.ZapLogger.Error("Failed to remove project from cache",
+		logger.FromContext(ctx).Error("Failed to remove project from cache",
 			zap.String("project_id", projectID),
 			zap.Error(err))
 	}
 
+	// Also invalidate projects list cache
+	if err := r.cache.Delete(ctx, "projects:all"); err != nil {
+		logger.FromContext(ctx).Error("Failed to invalidate projects list cache", zap.Error(err))
+	}
+
 	return nil
 }
 
 // ListProjects retrieves all projects with caching
-func (r *CachedProjectRepository) ListProjects() ([]*projectPbv1.Project, error) {
-	ctx := context.Background()
+func (r *CachedProjectRepository) ListProjects(ctx context.Context) ([]*projectPbv1.Project, error) {
+	if r.listTTL <= 0 {
+		return r.repository.ListProjects(ctx)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.ListProjects")
+	defer span.End()
+
 	cacheKey := "projects:all"
 
 	// Try to get from cache first
@@ -141,13 +220,17 @@ func (r *CachedProjectRepository) ListProjects() ([]*projectPbv1.Project, error)
 	err := r.cache.Get(ctx, cacheKey, &projects)
 	if err == nil {
 		// Cache hit
-		logger.ZapLogger.Debug("Projects list cache hit")
+		logger.FromContext(ctx).Debug("Projects list cache hit")
 		logger.LogCacheAccess(ctx, "ProjectsList", "all", logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
 		return projects, nil
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 
 	// Cache miss, get from repository
-	projects, err = r.repository.ListProjects()
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.ListProjects")
+	projects, err = r.repository.ListProjects(repoCtx)
+	tracing.EndSpan(repoSpan, err)
 	if err != nil {
 		return nil, err
 	}
@@ -155,59 +238,186 @@ func (r *CachedProjectRepository) ListProjects() ([]*projectPbv1.Project, error)
 	logger.LogCacheAccess(ctx, "ProjectsList", "all", logger.FromDatabase)
 
 	// Store in cache for future requests
-	if err := r.cache.Set(ctx, cacheKey, projects, r.ttl); err != nil {
-		logger.ZapLogger.Error("Failed to cache projects list", zap.Error(err))
+	if err := r.set(ctx, cacheKey, projects, r.listTTL); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache projects list", zap.Error(err))
 	}
 
 	return projects, nil
 }
 
+// ListProjectsPage retrieves a page of projects directly from the repository; pages are not cached
+func (r *CachedProjectRepository) ListProjectsPage(ctx context.Context, pageToken string, pageSize int) ([]*projectPbv1.Project, string, error) {
+	return r.repository.ListProjectsPage(ctx, pageToken, pageSize)
+}
+
 // AddIssueToProject associates an issue with a project and updates cache
-func (r *CachedProjectRepository) AddIssueToProject(projectID string, issueID string) error {
+func (r *CachedProjectRepository) AddIssueToProject(ctx context.Context, projectID string, issueID string) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.AddIssueToProject")
+	defer span.End()
+
 	// Update in repository first
-	if err := r.repository.AddIssueToProject(projectID, issueID); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.AddIssueToProject")
+	err := r.repository.AddIssueToProject(repoCtx, projectID, issueID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Invalidate project cache since issue count changed
-	ctx := context.Background()
 	projectCacheKey := fmt.Sprintf("project:%s", projectID)
 	if err := r.cache.Delete(ctx, projectCacheKey); err != nil {
-		logger.ZapLogger.Error("Failed to invalidate project cache after adding issue",
+		logger.FromContext(ctx).Error("Failed to invalidate project cache after adding issue",
 			zap.String("project_id", projectID),
 			zap.String("issue_id", issueID),
 			zap.Error(err))
 	}
 
+	// The project's progress counts the issue
+	if err := r.cache.Delete(ctx, ProjectProgressCacheKey(projectID)); err != nil {
+		logger.FromContext(ctx).Error("Failed to invalidate project progress cache",
+			zap.String("project_id", projectID),
+			zap.Error(err))
+	}
+
 	// Also invalidate projects list cache
 	if err := r.cache.Delete(ctx, "projects:all"); err != nil {
-		logger.ZapLogger.Error("Failed to invalidate projects list cache", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to invalidate projects list cache", zap.Error(err))
 	}
 
 	return nil
 }
 
 // RemoveIssueFromProject removes an association between an issue and a project
-func (r *CachedProjectRepository) RemoveIssueFromProject(projectID string, issueID string) error {
+func (r *CachedProjectRepository) RemoveIssueFromProject(ctx context.Context, projectID string, issueID string) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.RemoveIssueFromProject")
+	defer span.End()
+
 	// Update in repository first
-	if err := r.repository.RemoveIssueFromProject(projectID, issueID); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.RemoveIssueFromProject")
+	err := r.repository.RemoveIssueFromProject(repoCtx, projectID, issueID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Invalidate project cache since issue count changed
-	ctx := context.Background()
 	projectCacheKey := fmt.Sprintf("project:%s", projectID)
 	if err := r.cache.Delete(ctx, projectCacheKey); err != nil {
-		logger.ZapLogger.Error("Failed to invalidate project cache after removing issue",
+		logger.FromContext(ctx).Error("Failed to invalidate project cache after removing issue",
 			zap.String("project_id", projectID),
 			zap.String("issue_id", issueID),
 			zap.Error(err))
 	}
 
+	// The project's progress counts the issue
+	if err := r.cache.Delete(ctx, ProjectProgressCacheKey(projectID)); err != nil {
+		logger.FromContext(ctx).Error("Failed to invalidate project progress cache",
+			zap.String("project_id", projectID),
+			zap.Error(err))
+	}
+
 	// Also invalidate projects list cache
 	if err := r.cache.Delete(ctx, "projects:all"); err != nil {
-		logger.ZapLogger.Error("Failed to invalidate projects list cache", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to invalidate projects list cache", zap.Error(err))
+	}
+
+	return nil
+}
+
+// CreateIssueTemplate adds a new issue template to the repository
+func (r *CachedProjectRepository) CreateIssueTemplate(ctx context.Context, template *projectPbv1.IssueTemplate) error {
+	return r.repository.CreateIssueTemplate(ctx, template)
+}
+
+// ReadIssueTemplate retrieves an issue template by ID with caching
+func (r *CachedProjectRepository) ReadIssueTemplate(ctx context.Context, templateID string) (*projectPbv1.IssueTemplate, error) {
+	if r.ttl <= 0 {
+		return r.repository.ReadIssueTemplate(ctx, templateID)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.ReadIssueTemplate")
+	defer span.End()
+
+	cacheKey := fmt.Sprintf("template:%s", templateID)
+
+	// Try to get from cache first
+	var template = new(projectPbv1.IssueTemplate)
+	err := r.cache.Get(ctx, cacheKey, template)
+	if err == nil {
+		logger.LogCacheAccess(ctx, "IssueTemplate", templateID, logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
+		return template, nil
+	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
+
+	// Cache miss, get from repository
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.ReadIssueTemplate")
+	template, err = r.repository.ReadIssueTemplate(repoCtx, templateID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.LogCacheAccess(ctx, "IssueTemplate", templateID, logger.FromDatabase)
+
+	// Store in cache for future requests
+	if err := r.set(ctx, cacheKey, template, r.ttl); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache issue template",
+			zap.String("template_id", templateID),
+			zap.Error(err))
+	}
+
+	return template, nil
+}
+
+// ListIssueTemplates retrieves the issue templates of a project
+func (r *CachedProjectRepository) ListIssueTemplates(ctx context.Context, projectID string) ([]*projectPbv1.IssueTemplate, error) {
+	return r.repository.ListIssueTemplates(ctx, projectID)
+}
+
+// DeleteIssueTemplate removes an issue template and clears it from cache
+func (r *CachedProjectRepository) DeleteIssueTemplate(ctx context.Context, templateID string) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedProjectRepository.DeleteIssueTemplate")
+	defer span.End()
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "ProjectRepository.DeleteIssueTemplate")
+	err := r.repository.DeleteIssueTemplate(repoCtx, templateID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return err
+	}
+
+	cacheKey := fmt.Sprintf("template:%s", templateID)
+	if err := r.cache.Delete(ctx, cacheKey); err != nil {
+		logger.FromContext(ctx).Error("Failed to remove issue template from cache",
+			zap.String("template_id", templateID),
+			zap.Error(err))
 	}
 
 	return nil
 }
+
+// SetProjectMember adds a member to a project or replaces the member's role. Roles aren't
+// cached, so role changes apply to the next issue assignment.
+func (r *CachedProjectRepository) SetProjectMember(ctx context.Context, member *projectPbv1.ProjectMember) error {
+	return r.repository.SetProjectMember(ctx, member)
+}
+
+// GetProjectMemberRole retrieves the role of a user in a project
+func (r *CachedProjectRepository) GetProjectMemberRole(ctx context.Context, projectID, userID string) (Role, error) {
+	return r.repository.GetProjectMemberRole(ctx, projectID, userID)
+}
+
+// ListProjectMembers retrieves the members of a project
+func (r *CachedProjectRepository) ListProjectMembers(ctx context.Context, projectID string) ([]*projectPbv1.ProjectMember, error) {
+	return r.repository.ListProjectMembers(ctx, projectID)
+}
+
+// set caches value under key with ttl moved by up to 10% either way, so entries cached together
+// expire at different times. Nothing is cached when ttl is zero, which turns caching off.
+func (r *CachedProjectRepository) set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	return r.cache.Set(ctx, key, value, cache.Jitter(ttl))
+}
@@ -0,0 +1,174 @@
+package projectsvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/memory"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+// ttlRecordingCache remembers the expiration each key was last stored with
+type ttlRecordingCache struct {
+	*cache.MemoryCache
+	ttls map[string]time.Duration
+}
+
+func (c *ttlRecordingCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.ttls[key] = expiration
+	return c.MemoryCache.Set(ctx, key, value, expiration)
+}
+
+// requestKey marks the context of the request a cache call is made for
+type requestKey struct{}
+
+// ctxRecordingCache records the request each cache call was made for
+type ctxRecordingCache struct {
+	*cache.MemoryCache
+	requests []any
+}
+
+func (c *ctxRecordingCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Set(ctx, key, value, expiration)
+}
+
+func (c *ctxRecordingCache) Get(ctx context.Context, key string, dest interface{}) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Get(ctx, key, dest)
+}
+
+func (c *ctxRecordingCache) Delete(ctx context.Context, keys ...string) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Delete(ctx, keys...)
+}
+
+func TestCachedProjectRepository_AppliesProjectTTL(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+
+	recorder := &ttlRecordingCache{MemoryCache: cache.NewMemoryCache(10), ttls: map[string]time.Duration{}}
+	cached := projectsvc.NewCachedProjectRepository(repo, recorder, cache.CacheConfig{
+		IssueTTL:       time.Minute,
+		IssueListTTL:   time.Minute,
+		UserTTL:        time.Minute,
+		ProjectTTL:     45 * time.Minute,
+		ProjectListTTL: 5 * time.Minute,
+	})
+
+	require.NoError(t, cached.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: "project-1", Name: "Cached"}))
+	_, err = cached.ListProjects(context.Background())
+	require.NoError(t, err)
+
+	// TTLs are moved by up to 10% either way
+	assert.InDelta(t, float64(45*time.Minute), float64(recorder.ttls["project:project-1"]), float64(270*time.Second))
+	assert.InDelta(t, float64(5*time.Minute), float64(recorder.ttls["projects:all"]), float64(30*time.Second))
+}
+
+func TestCachedProjectRepository_ZeroTTLDisablesCaching(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+
+	recorder := &ttlRecordingCache{MemoryCache: cache.NewMemoryCache(10), ttls: map[string]time.Duration{}}
+	cached := projectsvc.NewCachedProjectRepository(repo, recorder, cache.CacheConfig{})
+	ctx := context.Background()
+
+	project := &projectPbv1.Project{ProjectId: "project-1", Name: "Uncached"}
+	require.NoError(t, cached.CreateProject(ctx, project))
+	_, err = cached.ReadProject(ctx, "project-1")
+	require.NoError(t, err)
+	_, err = cached.ListProjects(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, recorder.ttls)
+
+	// Reads go to the repository, so a change made behind the cache is seen straight away
+	project.Name = "Renamed"
+	require.NoError(t, repo.UpdateProject(ctx, project))
+	got, err := cached.ReadProject(ctx, "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", got.Name)
+}
+
+func TestCachedProjectRepository_PassesRequestContextToCache(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+
+	recorder := &ctxRecordingCache{MemoryCache: cache.NewMemoryCache(10)}
+	cached := projectsvc.NewCachedProjectRepository(repo, recorder, cache.CacheConfig{ProjectTTL: time.Minute})
+	ctx := context.WithValue(context.Background(), requestKey{}, "request-1")
+
+	project := &projectPbv1.Project{ProjectId: sqliteProjectID, Name: "Original"}
+	require.NoError(t, cached.CreateProject(ctx, project))
+	_, err = cached.ReadProject(ctx, sqliteProjectID)
+	require.NoError(t, err)
+	_, err = cached.ListProjects(ctx)
+	require.NoError(t, err)
+	project.Name = "Renamed"
+	require.NoError(t, cached.UpdateProject(ctx, project))
+	require.NoError(t, cached.DeleteProject(ctx, sqliteProjectID))
+
+	require.NotEmpty(t, recorder.requests)
+	for _, request := range recorder.requests {
+		assert.Equal(t, "request-1", request)
+	}
+}
+
+func TestProjectService_RenameRefreshesCachedReads(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	restore := projectsvc.SetMessageBrokerFactory(func() (broker.MessageBroker, error) { return memory.NewInMemoryBroker(), nil })
+	defer restore()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	cached := projectsvc.NewCachedProjectRepository(repo, cache.NewMemoryCache(10), cache.CacheConfig{
+		ProjectTTL:     time.Hour,
+		ProjectListTTL: time.Hour,
+	})
+	service, err := projectsvc.NewProjectService(cached)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+	ctx := context.Background()
+
+	created, err := service.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Tracker", Description: "Issues"})
+	require.NoError(t, err)
+	projectID := created.Project.ProjectId
+	// Both reads are cached before the rename
+	_, err = service.GetProject(ctx, &projectPbv1.GetProjectRequest{ProjectId: projectID})
+	require.NoError(t, err)
+	_, err = service.ListProjects(ctx, &projectPbv1.ListProjectsRequest{})
+	require.NoError(t, err)
+	sub, err := service.MessageBroker().Subscribe(ctx, projectID, projectPbv1.UpdateType_PROJECT_CHANGED)
+	require.NoError(t, err)
+
+	_, err = service.UpdateProject(ctx, &projectPbv1.UpdateProjectRequest{ProjectId: projectID, Name: "Tracker 2", Description: "Issues"})
+	require.NoError(t, err)
+
+	got, err := service.GetProject(ctx, &projectPbv1.GetProjectRequest{ProjectId: projectID})
+	require.NoError(t, err)
+	assert.Equal(t, "Tracker 2", got.Project.Name)
+	list, err := service.ListProjects(ctx, &projectPbv1.ListProjectsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Projects, 1)
+	assert.Equal(t, "Tracker 2", list.Projects[0].Name)
+	update := <-sub.Updates
+	assert.Equal(t, projectPbv1.UpdateType_PROJECT_CHANGED, update.UpdateType)
+
+	_, err = service.DeleteProject(ctx, &projectPbv1.DeleteProjectRequest{ProjectId: projectID})
+	require.NoError(t, err)
+	list, err = service.ListProjects(ctx, &projectPbv1.ListProjectsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Projects)
+	update = <-sub.Updates
+	assert.Equal(t, projectPbv1.UpdateType_PROJECT_CHANGED, update.UpdateType)
+}
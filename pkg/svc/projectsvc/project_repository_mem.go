@@ -3,21 +3,34 @@
 package projectsvc
 
 import (
+	"context"
 	"errors"
 
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 	"github.com/hashicorp/go-memdb"
+	"google.golang.org/protobuf/proto"
 )
 
 // ProjectRepository defines repository methods required for project operations
 type ProjectRepository interface {
-	CreateProject(project *projectPbv1.Project) error
-	ReadProject(projectID string) (*projectPbv1.Project, error)
-	UpdateProject(project *projectPbv1.Project) error
-	DeleteProject(projectID string) error
-	ListProjects() ([]*projectPbv1.Project, error)
-	AddIssueToProject(projectID string, issueID string) error
-	RemoveIssueFromProject(projectID string, issueID string) error
+	CreateProject(ctx context.Context, project *projectPbv1.Project) error
+	FindOrCreateProject(ctx context.Context, project *projectPbv1.Project) (*projectPbv1.Project, bool, error)
+	ReadProject(ctx context.Context, projectID string) (*projectPbv1.Project, error)
+	UpdateProject(ctx context.Context, project *projectPbv1.Project) error
+	DeleteProject(ctx context.Context, projectID string) error
+	ListProjects(ctx context.Context) ([]*projectPbv1.Project, error)
+	ListProjectsPage(ctx context.Context, pageToken string, pageSize int) ([]*projectPbv1.Project, string, error)
+	AddIssueToProject(ctx context.Context, projectID string, issueID string) error
+	RemoveIssueFromProject(ctx context.Context, projectID string, issueID string) error
+	CreateIssueTemplate(ctx context.Context, template *projectPbv1.IssueTemplate) error
+	ReadIssueTemplate(ctx context.Context, templateID string) (*projectPbv1.IssueTemplate, error)
+	ListIssueTemplates(ctx context.Context, projectID string) ([]*projectPbv1.IssueTemplate, error)
+	DeleteIssueTemplate(ctx context.Context, templateID string) error
+	SetProjectMember(ctx context.Context, member *projectPbv1.ProjectMember) error
+	GetProjectMemberRole(ctx context.Context, projectID, userID string) (Role, error)
+	ListProjectMembers(ctx context.Context, projectID string) ([]*projectPbv1.ProjectMember, error)
 }
 
 // MemDBProjectRepository is an in-memory implementation of ProjectRepository
@@ -37,6 +50,11 @@ func CreateProjectMemDBSchema() *memdb.DBSchema {
 						Unique:  true,
 						Indexer: &memdb.StringFieldIndex{Field: "ProjectId"},
 					},
+					"name": {
+						Name:    "name",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "Name"},
+					},
 				},
 			},
 			"project_issue": {
@@ -47,20 +65,60 @@ func CreateProjectMemDBSchema() *memdb.DBSchema {
 						Unique: true,
 						Indexer: &memdb.CompoundIndex{
 							Indexes: []memdb.Indexer{
-								&memdb.StringFieldIndex{Field: "ProjectId"},
-								&memdb.StringFieldIndex{Field: "IssueId"},
+								&memdb.StringFieldIndex{Field: "ProjectID"},
+								&memdb.StringFieldIndex{Field: "IssueID"},
 							},
 						},
 					},
 					"project": {
 						Name:    "project",
 						Unique:  false,
-						Indexer: &memdb.StringFieldIndex{Field: "ProjectId"},
+						Indexer: &memdb.StringFieldIndex{Field: "ProjectID"},
 					},
 					"issue": {
 						Name:    "issue",
 						Unique:  false,
-						Indexer: &memdb.StringFieldIndex{Field: "IssueId"},
+						Indexer: &memdb.StringFieldIndex{Field: "IssueID"},
+					},
+				},
+			},
+			"template": {
+				Name: "template",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "TemplateId"},
+					},
+					"project": {
+						Name:    "project",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "ProjectId"},
+					},
+				},
+			},
+			"project_member": {
+				Name: "project_member",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "ProjectId"},
+								&memdb.StringFieldIndex{Field: "UserId"},
+							},
+						},
+					},
+					"project": {
+						Name:    "project",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "ProjectId"},
+					},
+					"user": {
+						Name:    "user",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "UserId"},
 					},
 				},
 			},
@@ -85,15 +143,63 @@ type ProjectIssueRelation struct {
 	IssueID   string
 }
 
-// CreateProject adds a new project to the repository
-func (r *MemDBProjectRepository) CreateProject(project *projectPbv1.Project) error {
+// CreateProject adds a new project to the repository. Project names are unique.
+func (r *MemDBProjectRepository) CreateProject(_ context.Context, project *projectPbv1.Project) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
-	return txn.Insert("project", project)
+
+	// MemDB doesn't enforce unique indexes, so a duplicate name would replace the index entry
+	if err := checkProjectName(txn, project); err != nil {
+		txn.Abort()
+		return err
+	}
+
+	// Store a copy so later changes by the caller don't modify the stored project
+	if err := txn.Insert("project", proto.Clone(project)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindOrCreateProject returns the project with the same name if one exists, otherwise it
+// inserts the given project. The lookup and insert share one write transaction so concurrent
+// callers can't both create the project. The boolean reports whether it was created.
+func (r *MemDBProjectRepository) FindOrCreateProject(_ context.Context, project *projectPbv1.Project) (*projectPbv1.Project, bool, error) {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("project", "name", project.Name)
+	if err != nil {
+		txn.Abort()
+		return nil, false, err
+	}
+	if raw != nil {
+		txn.Abort()
+		return proto.Clone(raw.(*projectPbv1.Project)).(*projectPbv1.Project), false, nil
+	}
+
+	if err := txn.Insert("project", proto.Clone(project)); err != nil {
+		txn.Abort()
+		return nil, false, err
+	}
+	txn.Commit()
+	return proto.Clone(project).(*projectPbv1.Project), true, nil
+}
+
+// checkProjectName returns ErrProjectNameAlreadyExists if another project has the project's name
+func checkProjectName(txn *memdb.Txn, project *projectPbv1.Project) error {
+	raw, err := txn.First("project", "name", project.Name)
+	if err != nil {
+		return err
+	}
+	if raw != nil && raw.(*projectPbv1.Project).ProjectId != project.ProjectId {
+		return consts.ErrProjectNameAlreadyExists
+	}
+	return nil
 }
 
 // ReadProject retrieves a project by its ID
-func (r *MemDBProjectRepository) ReadProject(projectID string) (*projectPbv1.Project, error) {
+func (r *MemDBProjectRepository) ReadProject(_ context.Context, projectID string) (*projectPbv1.Project, error) {
 	txn := r.db.Txn(false)
 	defer txn.Abort()
 
@@ -102,53 +208,82 @@ func (r *MemDBProjectRepository) ReadProject(projectID string) (*projectPbv1.Pro
 		return nil, err
 	}
 	if raw == nil {
-		return nil, errors.New("project not found")
+		return nil, consts.ErrProjectNotFound
 	}
-	return raw.(*projectPbv1.Project), nil
+	return proto.Clone(raw.(*projectPbv1.Project)).(*projectPbv1.Project), nil
 }
 
 // UpdateProject updates an existing project
-func (r *MemDBProjectRepository) UpdateProject(project *projectPbv1.Project) error {
+func (r *MemDBProjectRepository) UpdateProject(_ context.Context, project *projectPbv1.Project) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
-	return txn.Insert("project", project)
+
+	raw, err := txn.First("project", "id", project.ProjectId)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if raw == nil {
+		txn.Abort()
+		return consts.ErrProjectNotFound
+	}
+	if err := checkProjectName(txn, project); err != nil {
+		txn.Abort()
+		return err
+	}
+
+	if err := txn.Insert("project", proto.Clone(project)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
 }
 
 // DeleteProject removes a project from the repository
-func (r *MemDBProjectRepository) DeleteProject(projectID string) error {
+func (r *MemDBProjectRepository) DeleteProject(_ context.Context, projectID string) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
 
 	// First check if project exists
 	raw, err := txn.First("project", "id", projectID)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if raw == nil {
-		return errors.New("project not found")
+		txn.Abort()
+		return consts.ErrProjectNotFound
 	}
 
 	// Delete the project
 	if err := txn.Delete("project", raw); err != nil {
+		txn.Abort()
 		return err
 	}
 
 	// Delete all project-issue relationships for this project
-	issueIt, err := txn.Get("project_issue", "project", projectID)
-	if err != nil {
+	if _, err := txn.DeleteAll("project_issue", "project", projectID); err != nil {
+		txn.Abort()
 		return err
 	}
-	for relation := issueIt.Next(); relation != nil; relation = issueIt.Next() {
-		if err := txn.Delete("project_issue", relation); err != nil {
-			return err
-		}
+
+	// Delete the project's issue templates
+	if _, err := txn.DeleteAll("template", "project", projectID); err != nil {
+		txn.Abort()
+		return err
+	}
+
+	// Delete the project's members
+	if _, err := txn.DeleteAll("project_member", "project", projectID); err != nil {
+		txn.Abort()
+		return err
 	}
 
+	txn.Commit()
 	return nil
 }
 
 // ListProjects retrieves all projects from the repository
-func (r *MemDBProjectRepository) ListProjects() ([]*projectPbv1.Project, error) {
+func (r *MemDBProjectRepository) ListProjects(_ context.Context) ([]*projectPbv1.Project, error) {
 	txn := r.db.Txn(false)
 	defer txn.Abort()
 
@@ -159,33 +294,72 @@ func (r *MemDBProjectRepository) ListProjects() ([]*projectPbv1.Project, error)
 
 	var projects []*projectPbv1.Project
 	for obj := it.Next(); obj != nil; obj = it.Next() {
-		projects = append(projects, obj.(*projectPbv1.Project))
+		projects = append(projects, proto.Clone(obj.(*projectPbv1.Project)).(*projectPbv1.Project))
 	}
 
 	return projects, nil
 }
 
+// ListProjectsPage retrieves up to pageSize projects ordered by ID, starting after the last
+// project of the page pageToken ends. The returned page token is empty on the last page.
+func (r *MemDBProjectRepository) ListProjectsPage(_ context.Context, pageToken string, pageSize int) ([]*projectPbv1.Project, string, error) {
+	var lastID string
+	if pageToken != "" {
+		token, err := pagination.Decode(pageToken, pagination.KindProject, pagination.SortByID)
+		if err != nil {
+			return nil, "", err
+		}
+		lastID = token.ID
+	}
+
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.LowerBound("project", "id", lastID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var projects []*projectPbv1.Project
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		project := obj.(*projectPbv1.Project)
+		if project.ProjectId == lastID {
+			continue
+		}
+		if len(projects) == pageSize {
+			last := projects[len(projects)-1].ProjectId
+			return projects, pagination.Token{Kind: pagination.KindProject, Sort: pagination.SortByID, ID: last}.Encode(), nil
+		}
+		projects = append(projects, proto.Clone(project).(*projectPbv1.Project))
+	}
+	return projects, "", nil
+}
+
 // AddIssueToProject associates an issue with a project
-func (r *MemDBProjectRepository) AddIssueToProject(projectID string, issueID string) error {
+func (r *MemDBProjectRepository) AddIssueToProject(_ context.Context, projectID string, issueID string) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
 
 	// Check if project exists
 	projectRaw, err := txn.First("project", "id", projectID)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if projectRaw == nil {
-		return errors.New("project not found")
+		txn.Abort()
+		return consts.ErrProjectNotFound
 	}
-	project := projectRaw.(*projectPbv1.Project)
+	// Copy before mutating so an aborted transaction leaves the stored project untouched
+	project := proto.Clone(projectRaw.(*projectPbv1.Project)).(*projectPbv1.Project)
 
 	// Check if relation already exists
 	relationRaw, err := txn.First("project_issue", "id", projectID, issueID)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if relationRaw != nil {
+		txn.Abort()
 		return errors.New("issue already added to project")
 	}
 
@@ -195,40 +369,51 @@ func (r *MemDBProjectRepository) AddIssueToProject(projectID string, issueID str
 		IssueID:   issueID,
 	}
 	if err := txn.Insert("project_issue", relation); err != nil {
+		txn.Abort()
 		return err
 	}
 
 	// Update issue count in project
 	project.IssueCount++
-	return txn.Insert("project", project)
+	if err := txn.Insert("project", project); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
 }
 
 // RemoveIssueFromProject removes an association between an issue and a project
-func (r *MemDBProjectRepository) RemoveIssueFromProject(projectID string, issueID string) error {
+func (r *MemDBProjectRepository) RemoveIssueFromProject(_ context.Context, projectID string, issueID string) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
 
 	// Check if project exists
 	projectRaw, err := txn.First("project", "id", projectID)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if projectRaw == nil {
-		return errors.New("project not found")
+		txn.Abort()
+		return consts.ErrProjectNotFound
 	}
-	project := projectRaw.(*projectPbv1.Project)
+	// Copy before mutating so an aborted transaction leaves the stored project untouched
+	project := proto.Clone(projectRaw.(*projectPbv1.Project)).(*projectPbv1.Project)
 
 	// Check if relation exists
 	relationRaw, err := txn.First("project_issue", "id", projectID, issueID)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if relationRaw == nil {
+		txn.Abort()
 		return errors.New("issue not found in project")
 	}
 
 	// Remove the relation
 	if err := txn.Delete("project_issue", relationRaw); err != nil {
+		txn.Abort()
 		return err
 	}
 
@@ -236,5 +421,144 @@ func (r *MemDBProjectRepository) RemoveIssueFromProject(projectID string, issueI
 	if project.IssueCount > 0 {
 		project.IssueCount--
 	}
-	return txn.Insert("project", project)
+	if err := txn.Insert("project", project); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// CreateIssueTemplate adds a new issue template to the repository
+func (r *MemDBProjectRepository) CreateIssueTemplate(_ context.Context, template *projectPbv1.IssueTemplate) error {
+	txn := r.db.Txn(true)
+
+	// Check if project exists
+	projectRaw, err := txn.First("project", "id", template.ProjectId)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if projectRaw == nil {
+		txn.Abort()
+		return consts.ErrProjectNotFound
+	}
+
+	if err := txn.Insert("template", proto.Clone(template)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// ReadIssueTemplate retrieves an issue template by its ID
+func (r *MemDBProjectRepository) ReadIssueTemplate(_ context.Context, templateID string) (*projectPbv1.IssueTemplate, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("template", "id", templateID)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, consts.ErrTemplateNotFound
+	}
+	return proto.Clone(raw.(*projectPbv1.IssueTemplate)).(*projectPbv1.IssueTemplate), nil
+}
+
+// ListIssueTemplates retrieves the issue templates of a project
+func (r *MemDBProjectRepository) ListIssueTemplates(_ context.Context, projectID string) ([]*projectPbv1.IssueTemplate, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("template", "project", projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*projectPbv1.IssueTemplate
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		templates = append(templates, proto.Clone(obj.(*projectPbv1.IssueTemplate)).(*projectPbv1.IssueTemplate))
+	}
+
+	return templates, nil
+}
+
+// DeleteIssueTemplate removes an issue template from the repository
+func (r *MemDBProjectRepository) DeleteIssueTemplate(_ context.Context, templateID string) error {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("template", "id", templateID)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if raw == nil {
+		txn.Abort()
+		return consts.ErrTemplateNotFound
+	}
+
+	if err := txn.Delete("template", raw); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// SetProjectMember adds a member to a project or replaces the role of an existing member
+func (r *MemDBProjectRepository) SetProjectMember(_ context.Context, member *projectPbv1.ProjectMember) error {
+	txn := r.db.Txn(true)
+
+	// Check if project exists
+	projectRaw, err := txn.First("project", "id", member.ProjectId)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if projectRaw == nil {
+		txn.Abort()
+		return consts.ErrProjectNotFound
+	}
+
+	if err := txn.Insert("project_member", proto.Clone(member)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// GetProjectMemberRole retrieves the role of a user in a project
+func (r *MemDBProjectRepository) GetProjectMemberRole(_ context.Context, projectID, userID string) (Role, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("project_member", "id", projectID, userID)
+	if err != nil {
+		return RoleUnspecified, err
+	}
+	if raw == nil {
+		return RoleUnspecified, consts.ErrProjectMemberNotFound
+	}
+	return raw.(*projectPbv1.ProjectMember).Role, nil
+}
+
+// ListProjectMembers retrieves the members of a project
+func (r *MemDBProjectRepository) ListProjectMembers(_ context.Context, projectID string) ([]*projectPbv1.ProjectMember, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("project_member", "project", projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*projectPbv1.ProjectMember
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		members = append(members, proto.Clone(obj.(*projectPbv1.ProjectMember)).(*projectPbv1.ProjectMember))
+	}
+
+	return members, nil
 }
@@ -0,0 +1,124 @@
+package projectsvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+func TestMemDBProjectRepository_ReturnsCopies(t *testing.T) {
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+
+	project := &projectPbv1.Project{ProjectId: "project-1", Name: "Original"}
+	require.NoError(t, repo.CreateProject(context.Background(), project))
+
+	// Changing the object passed to CreateProject doesn't reach the store
+	project.Name = "Changed after create"
+
+	read, err := repo.ReadProject(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Original", read.Name)
+	read.Name = "Changed after read"
+
+	listed, err := repo.ListProjects(context.Background())
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "Original", listed[0].Name)
+	listed[0].IssueCount = 42
+
+	stored, err := repo.ReadProject(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Original", stored.Name)
+	assert.Equal(t, int32(0), stored.IssueCount)
+}
+
+func TestMemDBProjectRepository_AddIssueToProjectRollsBackOnFailedInsert(t *testing.T) {
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: "project-1", Name: "Project"}))
+
+	// An empty issue ID is rejected by the relation index after the project has been read
+	// inside the transaction, so the issue count must not change
+	require.Error(t, repo.AddIssueToProject(context.Background(), "project-1", ""))
+
+	stored, err := repo.ReadProject(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), stored.IssueCount)
+
+	require.NoError(t, repo.AddIssueToProject(context.Background(), "project-1", "issue-1"))
+	stored, err = repo.ReadProject(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stored.IssueCount)
+}
+
+func TestMemDBProjectRepository_RemoveIssueFromProjectLeavesStateOnError(t *testing.T) {
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: "project-1", Name: "Project"}))
+	require.NoError(t, repo.AddIssueToProject(context.Background(), "project-1", "issue-1"))
+
+	require.Error(t, repo.RemoveIssueFromProject(context.Background(), "project-1", "issue-2"))
+
+	stored, err := repo.ReadProject(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stored.IssueCount)
+
+	// The existing relation is still there to be removed
+	require.NoError(t, repo.RemoveIssueFromProject(context.Background(), "project-1", "issue-1"))
+	stored, err = repo.ReadProject(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), stored.IssueCount)
+}
+
+func TestMemDBProjectRepository_DeleteProjectRemovesRelations(t *testing.T) {
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: "project-1", Name: "Project"}))
+	require.NoError(t, repo.AddIssueToProject(context.Background(), "project-1", "issue-1"))
+
+	require.NoError(t, repo.DeleteProject(context.Background(), "project-1"))
+	require.Error(t, repo.DeleteProject(context.Background(), "project-1"))
+
+	// Re-adding the issue to a recreated project works because the old relation went with the project
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: "project-1", Name: "Project"}))
+	require.NoError(t, repo.AddIssueToProject(context.Background(), "project-1", "issue-1"))
+}
+
+func TestMemDBProjectRepository_IssueTemplates(t *testing.T) {
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: "project-1", Name: "Project"}))
+
+	template := &projectPbv1.IssueTemplate{TemplateId: "template-1", ProjectId: "project-1", Name: "Bug report", DefaultType: "BUG"}
+	require.NoError(t, repo.CreateIssueTemplate(context.Background(), template))
+	assert.ErrorIs(t, repo.CreateIssueTemplate(context.Background(), &projectPbv1.IssueTemplate{TemplateId: "template-2", ProjectId: "missing"}),
+		consts.ErrProjectNotFound)
+
+	read, err := repo.ReadIssueTemplate(context.Background(), "template-1")
+	require.NoError(t, err)
+	assert.Equal(t, "BUG", read.DefaultType)
+
+	listed, err := repo.ListIssueTemplates(context.Background(), "project-1")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "Bug report", listed[0].Name)
+
+	require.NoError(t, repo.DeleteIssueTemplate(context.Background(), "template-1"))
+	_, err = repo.ReadIssueTemplate(context.Background(), "template-1")
+	assert.ErrorIs(t, err, consts.ErrTemplateNotFound)
+	assert.ErrorIs(t, repo.DeleteIssueTemplate(context.Background(), "template-1"), consts.ErrTemplateNotFound)
+
+	// Deleting a project removes its templates
+	require.NoError(t, repo.CreateIssueTemplate(context.Background(), template))
+	require.NoError(t, repo.DeleteProject(context.Background(), "project-1"))
+	listed, err = repo.ListIssueTemplates(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
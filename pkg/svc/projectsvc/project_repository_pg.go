@@ -1,17 +1,22 @@
 package projectsvc
 
 import (
+	"context"
 	"errors"
+	"strings"
 
 	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/models"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// PostgresProjectRepository implements ProjectRepository using GORM for PostgreSQL
+// PostgresProjectRepository implements ProjectRepository using GORM for PostgreSQL.
+// It also backs the SQLite database type.
 type PostgresProjectRepository struct {
 	db *gorm.DB
 }
@@ -22,23 +27,57 @@ func NewPostgresProjectRepository(db *gorm.DB) *PostgresProjectRepository {
 }
 
 // CreateProject adds a new project to the database
-func (r *PostgresProjectRepository) CreateProject(project *projectPbv1.Project) error {
+func (r *PostgresProjectRepository) CreateProject(ctx context.Context, project *projectPbv1.Project) error {
 	// Convert protobuf project to model
 	dbProject := &models.Project{
 		ProjectID:   project.ProjectId,
 		Name:        project.Name,
 		Description: project.Description,
 		IssueCount:  project.IssueCount,
+		MaxIssues:   project.MaxIssues,
 	}
 
 	// Save to database
-	return r.db.Create(dbProject).Error
+	if err := r.db.WithContext(ctx).Create(dbProject).Error; err != nil {
+		if r.isDuplicateName(err) {
+			return consts.ErrProjectNameAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// FindOrCreateProject inserts the project unless one with the same name already exists, in
+// which case the existing project is returned. The boolean reports whether it was created.
+func (r *PostgresProjectRepository) FindOrCreateProject(ctx context.Context, project *projectPbv1.Project) (*projectPbv1.Project, bool, error) {
+	var dbProject models.Project
+
+	// ON CONFLICT makes the insert atomic with respect to the unique name index, which only
+	// covers projects that aren't deleted; no row is returned when the name is already taken
+	result := r.db.WithContext(ctx).Raw(
+		`INSERT INTO projects (project_id, name, description, issue_count, max_issues)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (name) WHERE deleted_at IS NULL DO NOTHING
+		RETURNING *`,
+		project.ProjectId, project.Name, project.Description, project.IssueCount, project.MaxIssues,
+	).Scan(&dbProject)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return convertProjectToPb(dbProject), true, nil
+	}
+
+	if err := r.db.WithContext(ctx).First(&dbProject, "name = ?", project.Name).Error; err != nil {
+		return nil, false, err
+	}
+	return convertProjectToPb(dbProject), false, nil
 }
 
 // ReadProject retrieves a project by its ID
-func (r *PostgresProjectRepository) ReadProject(projectID string) (*projectPbv1.Project, error) {
+func (r *PostgresProjectRepository) ReadProject(ctx context.Context, projectID string) (*projectPbv1.Project, error) {
 	var dbProject models.Project
-	if err := r.db.First(&dbProject, "project_id = ?", projectID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&dbProject, "project_id = ?", projectID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, consts.ErrProjectNotFound
 		}
@@ -46,19 +85,14 @@ func (r *PostgresProjectRepository) ReadProject(projectID string) (*projectPbv1.
 	}
 
 	// Convert model to protobuf project
-	return &projectPbv1.Project{
-		ProjectId:   dbProject.ProjectID,
-		Name:        dbProject.Name,
-		Description: dbProject.Description,
-		IssueCount:  dbProject.IssueCount,
-	}, nil
+	return convertProjectToPb(dbProject), nil
 }
 
 // UpdateProject updates an existing project
-func (r *PostgresProjectRepository) UpdateProject(project *projectPbv1.Project) error {
+func (r *PostgresProjectRepository) UpdateProject(ctx context.Context, project *projectPbv1.Project) error {
 	// Check if the project exists first
 	var existingProject models.Project
-	if err := r.db.First(&existingProject, "project_id = ?", project.ProjectId).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&existingProject, "project_id = ?", project.ProjectId).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return consts.ErrProjectNotFound
 		}
@@ -70,56 +104,108 @@ func (r *PostgresProjectRepository) UpdateProject(project *projectPbv1.Project)
 		"name":        project.Name,
 		"description": project.Description,
 		"issue_count": project.IssueCount,
+		"max_issues":  project.MaxIssues,
 	}
 
-	return r.db.Model(&models.Project{}).Where("project_id = ?", project.ProjectId).Updates(updates).Error
+	if err := r.db.WithContext(ctx).Model(&models.Project{}).Where("project_id = ?", project.ProjectId).Updates(updates).Error; err != nil {
+		if r.isDuplicateName(err) {
+			return consts.ErrProjectNameAlreadyExists
+		}
+		return err
+	}
+	return nil
 }
 
-// DeleteProject removes a project from the database
-func (r *PostgresProjectRepository) DeleteProject(projectID string) error {
-	result := r.db.Delete(&models.Project{}, "project_id = ?", projectID)
-	if result.Error != nil {
-		return result.Error
-	}
+// DeleteProject removes a project and its issue templates, members, teams and issue
+// associations from the database
+func (r *PostgresProjectRepository) DeleteProject(ctx context.Context, projectID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.Project{}, "project_id = ?", projectID)
+		if result.Error != nil {
+			return result.Error
+		}
 
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return consts.ErrProjectNotFound
-	}
+		// Check if any rows were affected
+		if result.RowsAffected == 0 {
+			return consts.ErrProjectNotFound
+		}
 
-	return nil
+		if err := tx.Delete(&models.IssueTemplate{}, "project_id = ?", projectID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.ProjectMember{}, "project_id = ?", projectID).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("team_id IN (?)", tx.Model(&models.Team{}).Select("team_id").Where("project_id = ?", projectID)).
+			Delete(&models.TeamMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Team{}, "project_id = ?", projectID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.ProjectIssue{}, "project_id = ?", projectID).Error
+	})
 }
 
 // ListProjects retrieves all projects
-func (r *PostgresProjectRepository) ListProjects() ([]*projectPbv1.Project, error) {
+func (r *PostgresProjectRepository) ListProjects(ctx context.Context) ([]*projectPbv1.Project, error) {
 	var dbProjects []models.Project
-	if err := r.db.Find(&dbProjects).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&dbProjects).Error; err != nil {
 		return nil, err
 	}
 
 	// Convert DB models to protobuf projects
 	projects := make([]*projectPbv1.Project, len(dbProjects))
 	for i, dbProject := range dbProjects {
-		projects[i] = &projectPbv1.Project{
-			ProjectId:   dbProject.ProjectID,
-			Name:        dbProject.Name,
-			Description: dbProject.Description,
-			IssueCount:  dbProject.IssueCount,
-		}
+		projects[i] = convertProjectToPb(dbProject)
 	}
 
 	return projects, nil
 }
 
-// AddIssueToProject associates an issue with a project
-func (r *PostgresProjectRepository) AddIssueToProject(projectID string, issueID string) error {
+// ListProjectsPage retrieves up to pageSize projects ordered by ID, starting after the last
+// project of the page pageToken ends. The returned page token is empty on the last page.
+func (r *PostgresProjectRepository) ListProjectsPage(ctx context.Context, pageToken string, pageSize int) ([]*projectPbv1.Project, string, error) {
+	// Fetch one extra row to tell whether another page follows
+	query := r.db.WithContext(ctx).Order("project_id").Limit(pageSize + 1)
+	if pageToken != "" {
+		token, err := pagination.Decode(pageToken, pagination.KindProject, pagination.SortByID)
+		if err != nil {
+			return nil, "", err
+		}
+		query = query.Where("project_id > ?", token.ID)
+	}
+
+	var dbProjects []models.Project
+	if err := query.Find(&dbProjects).Error; err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(dbProjects) > pageSize {
+		dbProjects = dbProjects[:pageSize]
+		nextPageToken = pagination.Token{Kind: pagination.KindProject, Sort: pagination.SortByID, ID: dbProjects[pageSize-1].ProjectID}.Encode()
+	}
+
+	projects := make([]*projectPbv1.Project, len(dbProjects))
+	for i, dbProject := range dbProjects {
+		projects[i] = convertProjectToPb(dbProject)
+	}
+
+	return projects, nextPageToken, nil
+}
+
+// AddIssueToProject associates an issue with a project by adding a row to the project_issues
+// join table. It leaves the issue's project_id alone, so an issue may be associated with
+// several projects.
+func (r *PostgresProjectRepository) AddIssueToProject(ctx context.Context, projectID string, issueID string) error {
 	logger.ZapLogger.Debug("AddIssueToProject called",
 		zap.String("project_id", projectID),
 		zap.String("issue_id", issueID))
 
 	// Check if project exists first
 	var project models.Project
-	if err := r.db.First(&project, "project_id = ?", projectID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&project, "project_id = ?", projectID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return consts.ErrProjectNotFound
 		}
@@ -128,72 +214,250 @@ func (r *PostgresProjectRepository) AddIssueToProject(projectID string, issueID
 
 	// Check if the issue exists
 	var issue models.Issues
-	if err := r.db.First(&issue, "issue_id = ?", issueID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&issue, "issue_id = ?", issueID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return consts.ErrIssueNotFound
 		}
 		return err
 	}
 
-	// Check if issue already belongs to this project
-	if issue.ProjectID == projectID {
-		return nil
-	}
-
-	// Use a transaction with pessimistic locking for both operations
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		// Lock the project row for update to prevent concurrent modifications
+	// Use a transaction with pessimistic locking so concurrent changes count the same rows
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the project row for update to prevent concurrent modifications. SQLite has no
+		// row locks and drops the clause; its single writer serializes the update instead.
 		var lockedProject models.Project
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").First(&lockedProject, "project_id = ?", projectID).Error; err != nil {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&lockedProject, "project_id = ?", projectID).Error; err != nil {
 			return err
 		}
 
-		// Update the issue's project_id field
-		if err := tx.Model(&issue).Update("project_id", projectID).Error; err != nil {
-			return err
+		// Adding an issue that already belongs to the project changes nothing
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.ProjectIssue{ProjectID: projectID, IssueID: issueID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
 		}
 
-		// Directly increment issue count with SQL to avoid race conditions
-		if err := tx.Model(&models.Project{}).
-			Where("project_id = ?", projectID).
-			UpdateColumn("issue_count", gorm.Expr("issue_count + ?", 1)).Error; err != nil {
+		count, err := updateIssueCount(tx, projectID)
+		if err != nil {
 			return err
 		}
 
 		logger.ZapLogger.Debug("Project issue count incremented",
 			zap.String("project_id", projectID),
 			zap.String("issue_id", issueID),
-			zap.Int32("new_count", lockedProject.IssueCount+1))
+			zap.Int32("new_count", count))
 
 		return nil
 	})
 }
 
-// RemoveIssueFromProject removes an association between an issue and a project
-func (r *PostgresProjectRepository) RemoveIssueFromProject(projectID string, issueID string) error {
+// RemoveIssueFromProject removes an association between an issue and a project, returning
+// ErrIssueNotFound if the issue isn't associated with it
+func (r *PostgresProjectRepository) RemoveIssueFromProject(ctx context.Context, projectID string, issueID string) error {
 	// Check if project exists
 	var project models.Project
-	if err := r.db.First(&project, "project_id = ?", projectID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&project, "project_id = ?", projectID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return consts.ErrProjectNotFound
 		}
 		return err
 	}
 
-	// Check if issue exists and belongs to project (this would be better with a join table)
-	var issue models.Issues
-	if err := r.db.First(&issue, "issue_id = ? AND project_id = ?", issueID, projectID).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var lockedProject models.Project
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&lockedProject, "project_id = ?", projectID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&models.ProjectIssue{}, "project_id = ? AND issue_id = ?", projectID, issueID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
 			return consts.ErrIssueNotFound
 		}
+
+		_, err := updateIssueCount(tx, projectID)
 		return err
+	})
+}
+
+// updateIssueCount sets the issue count of a project to the number of issues associated with
+// it and returns the new count
+func updateIssueCount(tx *gorm.DB, projectID string) (int32, error) {
+	var count int64
+	if err := tx.Model(&models.ProjectIssue{}).Where("project_id = ?", projectID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Model(&models.Project{}).
+		Where("project_id = ?", projectID).
+		UpdateColumn("issue_count", count).Error; err != nil {
+		return 0, err
 	}
+	return int32(count), nil
+}
 
-	// Decrement issue count
-	if project.IssueCount > 0 {
-		project.IssueCount--
+// CreateIssueTemplate adds a new issue template to the database
+func (r *PostgresProjectRepository) CreateIssueTemplate(ctx context.Context, template *projectPbv1.IssueTemplate) error {
+	// Check if project exists first
+	var project models.Project
+	if err := r.db.WithContext(ctx).First(&project, "project_id = ?", template.ProjectId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return consts.ErrProjectNotFound
+		}
+		return err
+	}
+
+	dbTemplate := &models.IssueTemplate{
+		TemplateID:          template.TemplateId,
+		ProjectID:           template.ProjectId,
+		Name:                template.Name,
+		DefaultType:         template.DefaultType,
+		DefaultPriority:     template.DefaultPriority,
+		SummaryPrefix:       template.SummaryPrefix,
+		DescriptionTemplate: template.DescriptionTemplate,
+	}
+
+	return r.db.WithContext(ctx).Create(dbTemplate).Error
+}
+
+// ReadIssueTemplate retrieves an issue template by its ID
+func (r *PostgresProjectRepository) ReadIssueTemplate(ctx context.Context, templateID string) (*projectPbv1.IssueTemplate, error) {
+	var dbTemplate models.IssueTemplate
+	if err := r.db.WithContext(ctx).First(&dbTemplate, "template_id = ?", templateID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, consts.ErrTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return convertTemplateToPb(dbTemplate), nil
+}
+
+// ListIssueTemplates retrieves the issue templates of a project
+func (r *PostgresProjectRepository) ListIssueTemplates(ctx context.Context, projectID string) ([]*projectPbv1.IssueTemplate, error) {
+	var dbTemplates []models.IssueTemplate
+	if err := r.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&dbTemplates).Error; err != nil {
+		return nil, err
 	}
 
-	// Update project
-	return r.db.Model(&project).Update("issue_count", project.IssueCount).Error
+	templates := make([]*projectPbv1.IssueTemplate, len(dbTemplates))
+	for i, dbTemplate := range dbTemplates {
+		templates[i] = convertTemplateToPb(dbTemplate)
+	}
+
+	return templates, nil
+}
+
+// DeleteIssueTemplate removes an issue template from the database
+func (r *PostgresProjectRepository) DeleteIssueTemplate(ctx context.Context, templateID string) error {
+	result := r.db.WithContext(ctx).Delete(&models.IssueTemplate{}, "template_id = ?", templateID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return consts.ErrTemplateNotFound
+	}
+
+	return nil
+}
+
+// SetProjectMember adds a member to a project or replaces the role of an existing member
+func (r *PostgresProjectRepository) SetProjectMember(ctx context.Context, member *projectPbv1.ProjectMember) error {
+	// Check if project exists first
+	var project models.Project
+	if err := r.db.WithContext(ctx).First(&project, "project_id = ?", member.ProjectId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return consts.ErrProjectNotFound
+		}
+		return err
+	}
+
+	dbMember := &models.ProjectMember{
+		ProjectID: member.ProjectId,
+		UserID:    member.UserId,
+		Role:      member.Role.String(),
+	}
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role"}),
+	}).Create(dbMember).Error
+}
+
+// GetProjectMemberRole retrieves the role of a user in a project
+func (r *PostgresProjectRepository) GetProjectMemberRole(ctx context.Context, projectID, userID string) (Role, error) {
+	var dbMember models.ProjectMember
+	if err := r.db.WithContext(ctx).First(&dbMember, "project_id = ? AND user_id = ?", projectID, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return RoleUnspecified, consts.ErrProjectMemberNotFound
+		}
+		return RoleUnspecified, err
+	}
+
+	return convertMemberToPb(dbMember).Role, nil
+}
+
+// ListProjectMembers retrieves the members of a project
+func (r *PostgresProjectRepository) ListProjectMembers(ctx context.Context, projectID string) ([]*projectPbv1.ProjectMember, error) {
+	var dbMembers []models.ProjectMember
+	if err := r.db.WithContext(ctx).Where("project_id = ?", projectID).Order("user_id").Find(&dbMembers).Error; err != nil {
+		return nil, err
+	}
+
+	members := make([]*projectPbv1.ProjectMember, len(dbMembers))
+	for i, dbMember := range dbMembers {
+		members[i] = convertMemberToPb(dbMember)
+	}
+
+	return members, nil
+}
+
+// isDuplicateName reports whether err is the unique index on project names rejecting a write.
+// The dialect translates its driver's unique violation to gorm.ErrDuplicatedKey; the column is
+// then found in the message, which names it in both Postgres ("idx_projects_name") and SQLite
+// ("UNIQUE constraint failed: projects.name").
+func (r *PostgresProjectRepository) isDuplicateName(err error) bool {
+	translator, ok := r.db.Dialector.(gorm.ErrorTranslator)
+	if !ok || !errors.Is(translator.Translate(err), gorm.ErrDuplicatedKey) {
+		return false
+	}
+	return strings.Contains(err.Error(), "name")
+}
+
+// convertProjectToPb converts a project model to its protobuf representation
+func convertProjectToPb(dbProject models.Project) *projectPbv1.Project {
+	return &projectPbv1.Project{
+		ProjectId:   dbProject.ProjectID,
+		Name:        dbProject.Name,
+		Description: dbProject.Description,
+		IssueCount:  dbProject.IssueCount,
+		MaxIssues:   dbProject.MaxIssues,
+	}
+}
+
+// convertMemberToPb converts a project member model to its protobuf representation
+func convertMemberToPb(dbMember models.ProjectMember) *projectPbv1.ProjectMember {
+	return &projectPbv1.ProjectMember{
+		ProjectId: dbMember.ProjectID,
+		UserId:    dbMember.UserID,
+		Role:      projectPbv1.Role(projectPbv1.Role_value[dbMember.Role]),
+	}
+}
+
+// convertTemplateToPb converts a template model to its protobuf representation
+func convertTemplateToPb(dbTemplate models.IssueTemplate) *projectPbv1.IssueTemplate {
+	return &projectPbv1.IssueTemplate{
+		TemplateId:          dbTemplate.TemplateID,
+		ProjectId:           dbTemplate.ProjectID,
+		Name:                dbTemplate.Name,
+		DefaultType:         dbTemplate.DefaultType,
+		DefaultPriority:     dbTemplate.DefaultPriority,
+		SummaryPrefix:       dbTemplate.SummaryPrefix,
+		DescriptionTemplate: dbTemplate.DescriptionTemplate,
+	}
 }
@@ -0,0 +1,180 @@
+package projectsvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/database"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/models"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const (
+	sqliteProjectID = "928f705f-0efa-4c96-b2f6-ceb36281e1f1"
+	sqliteOtherID   = "3e9a1c4b-7d2f-4b8e-a6c5-1f0d9e8b7a62"
+	sqliteIssueID   = "c72d237e-2658-4252-be58-760c7867d783"
+	sqliteTemplate  = "5b8e2f4a-9c1d-4e3b-a7f6-0d2c4e6a8b1f"
+)
+
+// newSQLiteDB opens a private in-memory SQLite database with the application schema
+func newSQLiteDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := database.OpenSQLite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return db
+}
+
+func TestPostgresProjectRepository_CRUD(t *testing.T) {
+	repo := projectsvc.NewPostgresProjectRepository(newSQLiteDB(t))
+
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: sqliteProjectID, Name: "Original"}))
+
+	read, err := repo.ReadProject(context.Background(), sqliteProjectID)
+	require.NoError(t, err)
+	assert.Equal(t, "Original", read.Name)
+
+	require.NoError(t, repo.UpdateProject(context.Background(), &projectPbv1.Project{ProjectId: sqliteProjectID, Name: "Renamed"}))
+	projects, err := repo.ListProjects(context.Background())
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, "Renamed", projects[0].Name)
+
+	require.NoError(t, repo.DeleteProject(context.Background(), sqliteProjectID))
+	_, err = repo.ReadProject(context.Background(), sqliteProjectID)
+	assert.ErrorIs(t, err, consts.ErrProjectNotFound)
+	assert.ErrorIs(t, repo.DeleteProject(context.Background(), sqliteProjectID), consts.ErrProjectNotFound)
+}
+
+func TestProjectRepositories_ListProjectsPage(t *testing.T) {
+	memRepo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	repos := map[string]projectsvc.ProjectRepository{
+		"memdb":  memRepo,
+		"sqlite": projectsvc.NewPostgresProjectRepository(newSQLiteDB(t)),
+	}
+
+	for name, repo := range repos {
+		t.Run(name, func(t *testing.T) {
+			for _, id := range []string{sqliteProjectID, sqliteOtherID, sqliteTemplate} {
+				require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: id, Name: "Project " + id[:4]}))
+			}
+
+			var ids []string
+			pageToken := ""
+			for pages := 0; ; pages++ {
+				require.Less(t, pages, 3, "paging must end")
+				projects, next, err := repo.ListProjectsPage(context.Background(), pageToken, 2)
+				require.NoError(t, err)
+				for _, project := range projects {
+					ids = append(ids, project.ProjectId)
+				}
+				if next == "" {
+					break
+				}
+				pageToken = next
+			}
+
+			// Pages are ordered by ID
+			assert.Equal(t, []string{sqliteOtherID, sqliteTemplate, sqliteProjectID}, ids)
+		})
+	}
+}
+
+func TestPostgresProjectRepository_AddAndRemoveIssue(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	db := newSQLiteDB(t)
+	repo := projectsvc.NewPostgresProjectRepository(db)
+
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: sqliteProjectID, Name: "Source"}))
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: sqliteOtherID, Name: "Target"}))
+	require.NoError(t, db.Create(&models.Issues{
+		IssueID:   sqliteIssueID,
+		Summary:   "Issue",
+		Status:    "NEW",
+		Type:      "BUG",
+		Priority:  "MINOR",
+		ProjectID: sqliteProjectID,
+	}).Error)
+
+	issueCount := func(projectID string) int32 {
+		t.Helper()
+		project, err := repo.ReadProject(context.Background(), projectID)
+		require.NoError(t, err)
+		return project.IssueCount
+	}
+
+	require.NoError(t, repo.AddIssueToProject(context.Background(), sqliteProjectID, sqliteIssueID))
+	require.NoError(t, repo.AddIssueToProject(context.Background(), sqliteProjectID, sqliteIssueID), "adding an issue twice changes nothing")
+	assert.Equal(t, int32(1), issueCount(sqliteProjectID))
+
+	// An issue can belong to several projects, and adding it takes the row lock, which SQLite ignores
+	require.NoError(t, repo.AddIssueToProject(context.Background(), sqliteOtherID, sqliteIssueID))
+	assert.Equal(t, int32(1), issueCount(sqliteOtherID))
+	assert.Equal(t, int32(1), issueCount(sqliteProjectID))
+
+	var issue models.Issues
+	require.NoError(t, db.First(&issue, "issue_id = ?", sqliteIssueID).Error)
+	assert.Equal(t, sqliteProjectID, issue.ProjectID, "the issue's own project is left alone")
+
+	// Removing detaches the issue from that project only
+	require.NoError(t, repo.RemoveIssueFromProject(context.Background(), sqliteOtherID, sqliteIssueID))
+	assert.ErrorIs(t, repo.RemoveIssueFromProject(context.Background(), sqliteOtherID, sqliteIssueID), consts.ErrIssueNotFound)
+	assert.Equal(t, int32(0), issueCount(sqliteOtherID))
+	assert.Equal(t, int32(1), issueCount(sqliteProjectID))
+
+	assert.ErrorIs(t, repo.AddIssueToProject(context.Background(), sqliteOtherID, sqliteTemplate), consts.ErrIssueNotFound)
+	assert.ErrorIs(t, repo.AddIssueToProject(context.Background(), sqliteTemplate, sqliteIssueID), consts.ErrProjectNotFound)
+
+	// Deleting a project deletes its associations
+	require.NoError(t, repo.DeleteProject(context.Background(), sqliteProjectID))
+	var associations int64
+	require.NoError(t, db.Model(&models.ProjectIssue{}).Count(&associations).Error)
+	assert.Zero(t, associations)
+}
+
+func TestPostgresProjectRepository_IssueTemplates(t *testing.T) {
+	repo := projectsvc.NewPostgresProjectRepository(newSQLiteDB(t))
+	require.NoError(t, repo.CreateProject(context.Background(), &projectPbv1.Project{ProjectId: sqliteProjectID, Name: "Project"}))
+
+	require.NoError(t, repo.CreateIssueTemplate(context.Background(), &projectPbv1.IssueTemplate{
+		TemplateId:          sqliteTemplate,
+		ProjectId:           sqliteProjectID,
+		Name:                "Bug report",
+		DefaultType:         "BUG",
+		DefaultPriority:     "MAJOR",
+		SummaryPrefix:       "[Bug] ",
+		DescriptionTemplate: "Steps to reproduce:",
+	}))
+	assert.ErrorIs(t, repo.CreateIssueTemplate(context.Background(), &projectPbv1.IssueTemplate{TemplateId: sqliteIssueID, ProjectId: sqliteOtherID, Name: "Orphan"}),
+		consts.ErrProjectNotFound)
+
+	read, err := repo.ReadIssueTemplate(context.Background(), sqliteTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, "MAJOR", read.DefaultPriority)
+	assert.Equal(t, "[Bug] ", read.SummaryPrefix)
+	assert.Equal(t, "Steps to reproduce:", read.DescriptionTemplate)
+
+	listed, err := repo.ListIssueTemplates(context.Background(), sqliteProjectID)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, sqliteTemplate, listed[0].TemplateId)
+
+	require.NoError(t, repo.DeleteIssueTemplate(context.Background(), sqliteTemplate))
+	_, err = repo.ReadIssueTemplate(context.Background(), sqliteTemplate)
+	assert.ErrorIs(t, err, consts.ErrTemplateNotFound)
+	assert.ErrorIs(t, repo.DeleteIssueTemplate(context.Background(), sqliteTemplate), consts.ErrTemplateNotFound)
+}
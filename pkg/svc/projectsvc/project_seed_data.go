@@ -2,13 +2,12 @@ package projectsvc
 
 import (
 	"context"
-	"crypto/rand"
+	"errors"
 	"log"
-	"math/big"
 
 	"github.com/brianvoe/gofakeit/v7"
-	"github.com/google/uuid"
 
+	"github.com/yasindce1998/issue-tracker/consts"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 )
 
@@ -31,20 +30,21 @@ var (
 // GenerateRandomProjects creates a specified number of random projects
 func GenerateRandomProjects(count int) []*projectPbv1.Project {
 	projects := make([]*projectPbv1.Project, count)
+	names := make(map[string]bool, count)
 
 	for i := 0; i < count; i++ {
-		// Use crypto/rand to securely select a random item
-		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(projectTypes))))
-		if err != nil {
-			log.Printf("Error generating random number: %v, using default", err)
-			n = big.NewInt(0)
+		// Create a descriptive name based on the type; project names are unique
+		var name string
+		for name == "" || names[name] {
+			// gofakeit draws from a crypto seeded source unless seeding was made deterministic
+			projectType := projectTypes[gofakeit.IntN(len(projectTypes))]
+			name = projectType + " - " + gofakeit.ProductName()
 		}
-		projectType := projectTypes[n.Int64()]
+		names[name] = true
 
-		// Create a project with a descriptive name based on the type
 		project := &projectPbv1.Project{
-			ProjectId:   uuid.New().String(),
-			Name:        projectType + " - " + gofakeit.ProductName(),
+			ProjectId:   gofakeit.UUID(),
+			Name:        name,
 			Description: gofakeit.Paragraph(2, 4, 10, "\n"),
 			IssueCount:  int32(15) * int32(gofakeit.Float32Range(0, 1)),
 		}
@@ -56,12 +56,16 @@ func GenerateRandomProjects(count int) []*projectPbv1.Project {
 }
 
 // SeedProjects inserts a set of random projects into the repository for testing
-func SeedProjects(repository ProjectRepository, count int) error {
+func SeedProjects(ctx context.Context, repository ProjectRepository, count int) error {
 	log.Printf("Seeding %d projects into the database...", count)
 	projects := GenerateRandomProjects(count)
 
 	for _, project := range projects {
-		err := repository.CreateProject(project)
+		err := repository.CreateProject(ctx, project)
+		if errors.Is(err, consts.ErrProjectNameAlreadyExists) {
+			log.Printf("Skipping project %q: a project with that name already exists", project.Name)
+			continue
+		}
 		if err != nil {
 			return err
 		}
@@ -69,7 +73,7 @@ func SeedProjects(repository ProjectRepository, count int) error {
 
 		// Optionally seed some issues for this project too
 		if project.IssueCount > 0 {
-			err = seedProjectIssues(repository, project.ProjectId, int(project.IssueCount))
+			err = seedProjectIssues(ctx, repository, project.ProjectId, int(project.IssueCount))
 			if err != nil {
 				log.Printf("Warning: failed to seed all issues for project %s: %v", project.ProjectId, err)
 			}
@@ -81,10 +85,10 @@ func SeedProjects(repository ProjectRepository, count int) error {
 }
 
 // seedProjectIssues creates random issue relations for a project
-func seedProjectIssues(repository ProjectRepository, projectID string, count int) error {
+func seedProjectIssues(ctx context.Context, repository ProjectRepository, projectID string, count int) error {
 	for i := 0; i < count; i++ {
-		issueID := uuid.New().String()
-		err := repository.AddIssueToProject(projectID, issueID)
+		issueID := gofakeit.UUID()
+		err := repository.AddIssueToProject(ctx, projectID, issueID)
 		if err != nil {
 			return err
 		}
@@ -100,14 +104,14 @@ func InitializeProjectSeedData(ctx context.Context, projectService *ProjectServi
 	}
 
 	// Check if we already have projects
-	resp, err := projectService.ListProjects(ctx, nil)
+	resp, err := projectService.ListProjects(ctx, &projectPbv1.ListProjectsRequest{PageSize: 1})
 	if err == nil && len(resp.Projects) > 0 {
 		log.Printf("Found %d existing projects, skipping seed data", len(resp.Projects))
 		return
 	}
 
 	// Seed projects
-	err = SeedProjects(projectService.repository, count)
+	err = SeedProjects(ctx, projectService.repository, count)
 	if err != nil {
 		log.Printf("Error seeding projects: %v", err)
 	}
@@ -7,10 +7,15 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
 
+	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
 	"github.com/yasindce1998/issue-tracker/pkg/messaging"
 	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/memory"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -20,36 +25,60 @@ import (
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 )
 
-// Constants for communication methods
-const (
-	commMethodKafka = "kafka"
-)
-
 // ProjectService implements the ProjectServiceServer interface
 type ProjectService struct {
 	projectPbv1.UnimplementedProjectServiceServer
 	repository    ProjectRepository
 	messageBroker broker.MessageBroker
-	subscribers   map[string][]chan *projectPbv1.ProjectUpdateResponse
-	subscribersMu sync.RWMutex
+	// shutdownCh is closed by DrainStreams or Close to tell open streams the server is going away
+	shutdownCh   chan struct{}
+	shutdownOnce sync.Once
+	// streamsMu guards activeStreams and streamsIdle, which is closed whenever no stream is open
+	streamsMu     sync.Mutex
+	activeStreams int
+	streamsIdle   chan struct{}
+	// heartbeatInterval is how long a stream may sit idle before a heartbeat is sent; 0 disables heartbeats
+	heartbeatInterval time.Duration
+	pageSizes         pagination.Config
 }
 
-// NewProjectService creates a new ProjectService with dependency injection
+// newMessageBroker creates the broker for project updates; tests replace it to simulate failures
+var newMessageBroker = messaging.NewMessageBroker
+
+// NewProjectService creates a new ProjectService with dependency injection.
+// Project CRUD doesn't need messaging, so if the configured broker can't be created
+// the service falls back to an in-memory broker rather than failing to start.
 func NewProjectService(repository ProjectRepository) (*ProjectService, error) {
 	// Get the message broker from the factory
-	mb, err := messaging.NewMessageBroker()
+	mb, err := newMessageBroker()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create message broker: %w", err)
+		logger.ZapLogger.Warn("Failed to create message broker, falling back to in-memory broker; "+
+			"project updates will only reach subscribers of this instance",
+			zap.String("method", getCommMethod()),
+			zap.Error(err))
+		mb = memory.NewInMemoryBrokerWithConfig(broker.DeliveryConfigFromEnv())
 	}
 	return &ProjectService{
-		repository:    repository,
-		messageBroker: mb,
-		subscribers:   make(map[string][]chan *projectPbv1.ProjectUpdateResponse),
+		repository:        repository,
+		messageBroker:     mb,
+		pageSizes:         pagination.ConfigFromEnv(),
+		shutdownCh:        make(chan struct{}),
+		streamsIdle:       closedChannel(),
+		heartbeatInterval: HeartbeatIntervalFromEnv(),
 	}, nil
 }
 
+// MessageBroker returns the broker project updates are published on
+func (s *ProjectService) MessageBroker() broker.MessageBroker {
+	return s.messageBroker
+}
+
 // CreateProject creates a new project
-func (s *ProjectService) CreateProject(_ context.Context, req *projectPbv1.CreateProjectRequest) (*projectPbv1.CreateProjectResponse, error) {
+func (s *ProjectService) CreateProject(ctx context.Context, req *projectPbv1.CreateProjectRequest) (*projectPbv1.CreateProjectResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
 	// Generate a new UUID for the project
 	projectID := uuid.New().String()
 
@@ -59,11 +88,15 @@ func (s *ProjectService) CreateProject(_ context.Context, req *projectPbv1.Creat
 		Name:        req.Name,
 		Description: req.Description,
 		IssueCount:  0,
+		MaxIssues:   req.MaxIssues,
 	}
 
 	// Store the project in the repository
-	err := s.repository.CreateProject(project)
+	err := s.repository.CreateProject(ctx, project)
 	if err != nil {
+		if errors.Is(err, consts.ErrProjectNameAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "project name already exists")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create project: %v", err)
 	}
 
@@ -72,10 +105,30 @@ func (s *ProjectService) CreateProject(_ context.Context, req *projectPbv1.Creat
 	}, nil
 }
 
+// FindOrCreateProject returns the project with the given name, creating it if it doesn't exist.
+// It is idempotent, so clients can safely retry a create.
+func (s *ProjectService) FindOrCreateProject(ctx context.Context, req *projectPbv1.FindOrCreateProjectRequest) (*projectPbv1.FindOrCreateProjectResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	project, created, err := s.repository.FindOrCreateProject(ctx, &projectPbv1.Project{
+		ProjectId:   uuid.New().String(),
+		Name:        req.Name,
+		Description: req.Description,
+		MaxIssues:   req.MaxIssues,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find or create project: %v", err)
+	}
+
+	return &projectPbv1.FindOrCreateProjectResponse{Project: project, Created: created}, nil
+}
+
 // GetProject retrieves a project by ID
-func (s *ProjectService) GetProject(_ context.Context, req *projectPbv1.GetProjectRequest) (*projectPbv1.GetProjectResponse, error) {
+func (s *ProjectService) GetProject(ctx context.Context, req *projectPbv1.GetProjectRequest) (*projectPbv1.GetProjectResponse, error) {
 	// Retrieve the project from the repository
-	project, err := s.repository.ReadProject(req.ProjectId)
+	project, err := s.repository.ReadProject(ctx, req.ProjectId)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
 	}
@@ -85,10 +138,36 @@ func (s *ProjectService) GetProject(_ context.Context, req *projectPbv1.GetProje
 	}, nil
 }
 
+// GetProjectUpdateSnapshot returns the project's current issue count as a ProjectUpdateResponse,
+// so polling clients read the same payload StreamProjectUpdates sends
+func (s *ProjectService) GetProjectUpdateSnapshot(ctx context.Context, req *projectPbv1.GetProjectUpdateSnapshotRequest) (*projectPbv1.ProjectUpdateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	project, err := s.repository.ReadProject(ctx, req.ProjectId)
+	if err != nil {
+		if errors.Is(err, consts.ErrProjectNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
+	return &projectPbv1.ProjectUpdateResponse{
+		ProjectId:  project.ProjectId,
+		IssueCount: project.IssueCount,
+		Message:    fmt.Sprintf("Project %s has %d issues", project.ProjectId, project.IssueCount),
+	}, nil
+}
+
 // UpdateProject updates an existing project
-func (s *ProjectService) UpdateProject(_ context.Context, req *projectPbv1.UpdateProjectRequest) (*projectPbv1.UpdateProjectResponse, error) {
+func (s *ProjectService) UpdateProject(ctx context.Context, req *projectPbv1.UpdateProjectRequest) (*projectPbv1.UpdateProjectResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
 	// First check if the project exists
-	existingProject, err := s.repository.ReadProject(req.ProjectId)
+	existingProject, err := s.repository.ReadProject(ctx, req.ProjectId)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
 	}
@@ -96,52 +175,80 @@ func (s *ProjectService) UpdateProject(_ context.Context, req *projectPbv1.Updat
 	// Update the project fields
 	existingProject.Name = req.Name
 	existingProject.Description = req.Description
+	existingProject.MaxIssues = req.MaxIssues
 
 	// Save the updated project
-	err = s.repository.UpdateProject(existingProject)
+	err = s.repository.UpdateProject(ctx, existingProject)
 	if err != nil {
+		if errors.Is(err, consts.ErrProjectNameAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "project name already exists")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update project: %v", err)
 	}
 
+	// Services holding the project's details, such as the issues service, drop them on this update
+	s.notifySubscribers(req.ProjectId, &projectPbv1.ProjectUpdateResponse{
+		ProjectId:  req.ProjectId,
+		IssueCount: existingProject.IssueCount,
+		Message:    fmt.Sprintf("Project %s updated", req.ProjectId),
+		UpdateType: projectPbv1.UpdateType_PROJECT_CHANGED,
+	})
+
 	return &projectPbv1.UpdateProjectResponse{
 		Project: existingProject,
 	}, nil
 }
 
 // DeleteProject deletes a project by ID
-func (s *ProjectService) DeleteProject(_ context.Context, req *projectPbv1.DeleteProjectRequest) (*emptypb.Empty, error) {
+func (s *ProjectService) DeleteProject(ctx context.Context, req *projectPbv1.DeleteProjectRequest) (*emptypb.Empty, error) {
 	// Delete the project
-	err := s.repository.DeleteProject(req.ProjectId)
+	err := s.repository.DeleteProject(ctx, req.ProjectId)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "failed to delete project: %v", err)
 	}
 
+	s.notifySubscribers(req.ProjectId, &projectPbv1.ProjectUpdateResponse{
+		ProjectId:  req.ProjectId,
+		Message:    fmt.Sprintf("Project %s deleted", req.ProjectId),
+		UpdateType: projectPbv1.UpdateType_PROJECT_CHANGED,
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
-// ListProjects lists all projects
-func (s *ProjectService) ListProjects(_ context.Context, _ *emptypb.Empty) (*projectPbv1.ListProjectsResponse, error) {
-	// Retrieve all projects
-	projects, err := s.repository.ListProjects()
+// ListProjects retrieves a paginated list of projects ordered by ID
+func (s *ProjectService) ListProjects(ctx context.Context, req *projectPbv1.ListProjectsRequest) (*projectPbv1.ListProjectsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if err := s.pageSizes.CheckPageSize(req.PageSize); err != nil {
+		return nil, err
+	}
+
+	projects, nextPageToken, err := s.repository.ListProjectsPage(ctx, req.PageToken, s.pageSizes.PageSize(req.PageSize))
+	if errors.Is(err, pagination.ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: invalid page token")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list projects: %v", err)
 	}
 
 	return &projectPbv1.ListProjectsResponse{
-		Projects: projects,
+		Projects:      projects,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // UpdateProjectWithIssue adds an issue to a project
-func (s *ProjectService) UpdateProjectWithIssue(_ context.Context, req *projectPbv1.UpdateProjectWithIssueRequest) (*projectPbv1.UpdateProjectWithIssueResponse, error) {
+func (s *ProjectService) UpdateProjectWithIssue(ctx context.Context, req *projectPbv1.UpdateProjectWithIssueRequest) (*projectPbv1.UpdateProjectWithIssueResponse, error) {
 	// Add the issue to the project
-	err := s.repository.AddIssueToProject(req.ProjectId, req.IssueId)
+	err := s.repository.AddIssueToProject(ctx, req.ProjectId, req.IssueId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update project with issue: %v", err)
 	}
 
 	// Get the updated project
-	project, err := s.repository.ReadProject(req.ProjectId)
+	project, err := s.repository.ReadProject(ctx, req.ProjectId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get updated project: %v", err)
 	}
@@ -156,6 +263,7 @@ func (s *ProjectService) UpdateProjectWithIssue(_ context.Context, req *projectP
 		ProjectId:  req.ProjectId,
 		IssueCount: project.IssueCount,
 		Message:    fmt.Sprintf("Issue %s added to project %s", req.IssueId, req.ProjectId),
+		UpdateType: projectPbv1.UpdateType_ISSUE_ADDED,
 	})
 
 	return &projectPbv1.UpdateProjectWithIssueResponse{
@@ -167,127 +275,159 @@ func (s *ProjectService) UpdateProjectWithIssue(_ context.Context, req *projectP
 
 // StreamProjectUpdates handles streaming project updates
 func (s *ProjectService) StreamProjectUpdates(stream projectPbv1.ProjectService_StreamProjectUpdatesServer) error {
-	var subscribedProjectID string
-	var updateCh <-chan *projectPbv1.ProjectUpdateResponse
-
 	ctx := stream.Context()
+	s.streamStarted()
+	defer s.streamEnded()
 
-	// Create in-memory channel if not using Kafka
-	var inMemoryCh chan *projectPbv1.ProjectUpdateResponse
-	if os.Getenv("COMMUNICATION_METHOD") != commMethodKafka {
-		inMemoryCh = make(chan *projectPbv1.ProjectUpdateResponse, 10)
-		updateCh = inMemoryCh
-		defer close(inMemoryCh)
-	}
-
-	// Process incoming messages in a separate goroutine
+	// Process incoming messages in a separate goroutine, which hands each new subscription to the sending loop.
+	// The two share no variables: the receiving goroutine alone tracks the subscribed project, and the
+	// sending loop alone owns the current subscription and its updates channel.
+	subscriptions := make(chan *broker.Subscription)
 	errCh := make(chan error, 1)
-	go func() {
-		for {
-			req, err := stream.Recv()
-			if err != nil {
-				if errors.Is(err, io.EOF) {
-					// Client closed the stream gracefully
-					errCh <- nil
-				} else {
-					// Some other error
-					logger.ZapLogger.Warn("Error receiving from stream",
-						zap.Error(err))
-					errCh <- err
-				}
-				return
-			}
+	go s.receiveProjectRequests(ctx, stream, subscriptions, errCh)
 
-			switch req.Action {
-			case "subscribe":
-				// If already subscribed, clean up first
-				if subscribedProjectID != "" {
-					if os.Getenv("COMMUNICATION_METHOD") == commMethodKafka {
-						if updateCh != nil {
-							_ = s.messageBroker.Unsubscribe(ctx, subscribedProjectID, updateCh)
-						}
-					} else {
-						s.removeSubscriber(subscribedProjectID, inMemoryCh)
-					}
-				}
+	// Send updates to the client
+	return s.handleProjectUpdates(ctx, stream, subscriptions, errCh)
+}
 
-				// Subscribe to project updates
-				subscribedProjectID = req.ProjectId
-
-				if os.Getenv("COMMUNICATION_METHOD") == commMethodKafka {
-					// Subscribe via Kafka
-					kCh, err := s.messageBroker.Subscribe(ctx, subscribedProjectID)
-					if err != nil {
-						logger.ZapLogger.Error("Failed to subscribe via Kafka",
-							zap.String("project_id", subscribedProjectID),
-							zap.Error(err))
-						return
-					}
-					updateCh = kCh
-				} else {
-					// Use in-memory subscription
-					s.addSubscriber(subscribedProjectID, inMemoryCh)
-				}
+// receiveProjectRequests handles subscribe and update requests from the client until the stream ends
+func (s *ProjectService) receiveProjectRequests(
+	ctx context.Context,
+	stream projectPbv1.ProjectService_StreamProjectUpdatesServer,
+	subscriptions chan<- *broker.Subscription,
+	errCh chan<- error,
+) {
+	var subscribedProjectID string
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// Client closed the stream gracefully
+				errCh <- nil
+			} else if ctx.Err() != nil {
+				// The stream has already ended, by the client hanging up or the handler returning
+				errCh <- err
+			} else {
+				// Some other error
+				logger.ZapLogger.Warn("Error receiving from stream",
+					zap.Error(err))
+				errCh <- err
+			}
+			return
+		}
 
-				logger.ZapLogger.Info("Client subscribed to project",
-					zap.String("project_id", subscribedProjectID),
-					zap.String("method", getCommMethod()))
+		switch req.Action {
+		case "subscribe":
+			if err := req.Validate(); err != nil {
+				errCh <- i18n.InvalidRequest(ctx, err)
+				return
+			}
 
-			case "update":
-				if req.ProjectId != subscribedProjectID {
-					// Can't update a project you're not subscribed to
-					continue
-				}
+			// Subscribe to project updates, replaying earlier ones if asked; the sending loop ends any previous subscription
+			var sub *broker.Subscription
+			var err error
+			if req.ReplayFrom != nil {
+				sub, err = s.messageBroker.SubscribeFrom(ctx, req.ProjectId, req.ReplayFrom.AsTime(), req.UpdateTypes...)
+			} else {
+				sub, err = s.messageBroker.Subscribe(ctx, req.ProjectId, req.UpdateTypes...)
+			}
+			if err != nil {
+				logger.ZapLogger.Error("Failed to subscribe to project updates",
+					zap.String("project_id", req.ProjectId),
+					zap.String("method", getCommMethod()),
+					zap.Error(err))
+				errCh <- status.Errorf(codes.Unavailable, "failed to subscribe to project updates: %v", err)
+				return
+			}
 
-				// Just notify about the update
-				project, err := s.repository.ReadProject(req.ProjectId)
-				if err == nil {
-					s.notifySubscribers(req.ProjectId, &projectPbv1.ProjectUpdateResponse{
-						ProjectId:  req.ProjectId,
-						IssueCount: project.IssueCount,
-						Message:    fmt.Sprintf("Project %s updated", req.ProjectId),
-					})
-				}
+			select {
+			case subscriptions <- sub:
+			case <-ctx.Done():
+				_ = s.messageBroker.Unsubscribe(context.Background(), sub)
+				return
+			}
+			subscribedProjectID = req.ProjectId
+
+			logger.ZapLogger.Info("Client subscribed to project",
+				zap.String("project_id", subscribedProjectID),
+				zap.Stringers("update_types", req.UpdateTypes),
+				zap.Bool("replay", req.ReplayFrom != nil),
+				zap.String("method", getCommMethod()))
+
+		case "update":
+			if req.ProjectId != subscribedProjectID {
+				// Can't update a project you're not subscribed to
+				continue
 			}
-		}
-	}()
 
-	// Send updates to the client
-	if updateCh != nil {
-		return s.handleProjectUpdates(ctx, stream, updateCh, errCh, subscribedProjectID, inMemoryCh)
+			// Just notify about the update
+			project, err := s.repository.ReadProject(ctx, req.ProjectId)
+			if err == nil {
+				s.notifySubscribers(req.ProjectId, &projectPbv1.ProjectUpdateResponse{
+					ProjectId:  req.ProjectId,
+					IssueCount: project.IssueCount,
+					Message:    fmt.Sprintf("Project %s updated", req.ProjectId),
+					UpdateType: projectPbv1.UpdateType_PROJECT_UPDATED,
+				})
+			}
+		}
 	}
-
-	return nil
 }
 
-// handleProjectUpdates processes updates from the update channel and sends them to the client
+// handleProjectUpdates processes updates from the current subscription and sends them to the client,
+// sending a heartbeat whenever nothing else has been sent for the heartbeat interval
 func (s *ProjectService) handleProjectUpdates(
 	ctx context.Context,
 	stream projectPbv1.ProjectService_StreamProjectUpdatesServer,
-	updateCh <-chan *projectPbv1.ProjectUpdateResponse,
+	subscriptions <-chan *broker.Subscription,
 	errCh <-chan error,
-	subscribedProjectID string,
-	inMemoryCh chan *projectPbv1.ProjectUpdateResponse,
 ) error {
+	var sub *broker.Subscription
+	// updateCh stays nil, and so never ready, until the client subscribes
+	var updateCh <-chan *projectPbv1.ProjectUpdateResponse
+	defer func() {
+		if sub != nil {
+			_ = s.messageBroker.Unsubscribe(context.Background(), sub)
+		}
+	}()
+	heartbeat := newStreamHeartbeat(s.heartbeatInterval)
+	defer heartbeat.stop()
+
 	for {
 		select {
+		case next := <-subscriptions:
+			if sub != nil {
+				_ = s.messageBroker.Unsubscribe(context.Background(), sub)
+			}
+			sub, updateCh = next, next.Updates
 		case update, ok := <-updateCh:
 			if !ok {
-				// Channel closed
+				// The broker ended the subscription
+				if s.shuttingDown() {
+					return sendShutdownMessage(stream, sub)
+				}
+				if errors.Is(sub.Err(), broker.ErrSlowConsumer) {
+					logger.ZapLogger.Warn("Disconnected slow stream consumer",
+						zap.String("project_id", sub.ProjectID),
+						zap.String("subscription_id", sub.ID),
+						zap.Int64("dropped_updates", sub.Dropped()))
+					return status.Errorf(codes.ResourceExhausted,
+						"stream closed after dropping %d updates for project %s: client is not keeping up", sub.Dropped(), sub.ProjectID)
+				}
 				return nil
 			}
-			if err := stream.Send(update); err != nil {
+			if err := sendProjectUpdate(stream, sub, update); err != nil {
 				logger.ZapLogger.Error("Error sending to stream", zap.Error(err))
-
-				// Clean up subscription
-				if os.Getenv("COMMUNICATION_METHOD") == commMethodKafka {
-					_ = s.messageBroker.Unsubscribe(ctx, subscribedProjectID, updateCh)
-				} else {
-					s.removeSubscriber(subscribedProjectID, inMemoryCh)
-				}
-
 				return err
 			}
+			heartbeat.reset()
+		case <-heartbeat.C():
+			if err := sendHeartbeat(stream, sub); err != nil {
+				logger.ZapLogger.Error("Error sending heartbeat to stream", zap.Error(err))
+				return err
+			}
+		case <-s.shutdownCh:
+			return sendShutdownMessage(stream, sub)
 		case err := <-errCh:
 			// Handle errors from the receiving goroutine
 			return err
@@ -298,6 +438,54 @@ func (s *ProjectService) handleProjectUpdates(
 	}
 }
 
+// shuttingDown reports whether DrainStreams or Close has been called
+func (s *ProjectService) shuttingDown() bool {
+	select {
+	case <-s.shutdownCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// sendShutdownMessage sends the final message of a stream ended by server shutdown so the client knows to reconnect
+func sendShutdownMessage(stream projectPbv1.ProjectService_StreamProjectUpdatesServer, sub *broker.Subscription) error {
+	update := &projectPbv1.ProjectUpdateResponse{
+		Message:    "server shutting down",
+		UpdateType: projectPbv1.UpdateType_SERVER_SHUTDOWN,
+	}
+	if sub != nil {
+		update.ProjectId = sub.ProjectID
+	}
+	if err := stream.Send(update); err != nil {
+		logger.ZapLogger.Warn("Error sending shutdown message to stream", zap.Error(err))
+	}
+	return nil
+}
+
+// sendProjectUpdate sends an update to the client, preceded by a marker telling it to
+// re-fetch the project if any updates were dropped since the last one it received
+func sendProjectUpdate(stream projectPbv1.ProjectService_StreamProjectUpdatesServer, sub *broker.Subscription, update *projectPbv1.ProjectUpdateResponse) error {
+	if dropped := sub.TakeDropped(); dropped > 0 {
+		logger.ZapLogger.Warn("Stream subscriber missed updates",
+			zap.String("project_id", sub.ProjectID),
+			zap.String("subscription_id", sub.ID),
+			zap.Int64("dropped_updates", dropped),
+			zap.Int64("total_dropped_updates", sub.Dropped()))
+
+		marker := &projectPbv1.ProjectUpdateResponse{
+			ProjectId:      sub.ProjectID,
+			Message:        fmt.Sprintf("%d updates were dropped; re-fetch project %s to resynchronize", dropped, sub.ProjectID),
+			DroppedUpdates: dropped,
+			UpdateType:     projectPbv1.UpdateType_UPDATES_DROPPED,
+		}
+		if err := stream.Send(marker); err != nil {
+			return err
+		}
+	}
+	return stream.Send(update)
+}
+
 // Helper function to get communication method
 func getCommMethod() string {
 	method := os.Getenv("COMMUNICATION_METHOD")
@@ -307,65 +495,77 @@ func getCommMethod() string {
 	return method
 }
 
-// Helper methods for managing subscribers
-func (s *ProjectService) addSubscriber(projectID string, ch chan *projectPbv1.ProjectUpdateResponse) {
-	s.subscribersMu.Lock()
-	defer s.subscribersMu.Unlock()
-	s.subscribers[projectID] = append(s.subscribers[projectID], ch)
+func (s *ProjectService) notifySubscribers(projectID string, update *projectPbv1.ProjectUpdateResponse) {
+	if err := s.messageBroker.PublishUpdate(context.Background(), projectID, update); err != nil {
+		logger.ZapLogger.Error("Failed to publish project update",
+			zap.String("project_id", projectID),
+			zap.String("method", getCommMethod()),
+			zap.Error(err))
+	}
 }
 
-func (s *ProjectService) removeSubscriber(projectID string, ch chan *projectPbv1.ProjectUpdateResponse) {
-	s.subscribersMu.Lock()
-	defer s.subscribersMu.Unlock()
-	channels := s.subscribers[projectID]
-	for i, c := range channels {
-		if c == ch {
-			// Remove this channel
-			s.subscribers[projectID] = append(channels[:i], channels[i+1:]...)
-			break
-		}
+// streamStarted counts a newly opened stream
+func (s *ProjectService) streamStarted() {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	if s.activeStreams == 0 {
+		s.streamsIdle = make(chan struct{})
 	}
+	s.activeStreams++
 }
 
-func (s *ProjectService) notifySubscribers(projectID string, update *projectPbv1.ProjectUpdateResponse) {
-	// If we're using Kafka, publish to Kafka
-	if os.Getenv("COMMUNICATION_METHOD") == commMethodKafka {
-		err := s.messageBroker.PublishUpdate(context.Background(), projectID, update)
-		if err != nil {
-			logger.ZapLogger.Error("Failed to publish update to Kafka",
-				zap.String("project_id", projectID),
-				zap.Error(err))
-		}
-		return
+// streamEnded counts a stream that has returned, releasing DrainStreams once none is open
+func (s *ProjectService) streamEnded() {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	s.activeStreams--
+	if s.activeStreams == 0 {
+		close(s.streamsIdle)
 	}
+}
 
-	// Otherwise use the existing in-memory notification
-	s.subscribersMu.RLock()
-	defer s.subscribersMu.RUnlock()
+// ActiveStreams returns the number of open StreamProjectUpdates streams
+func (s *ProjectService) ActiveStreams() int {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+	return s.activeStreams
+}
 
-	projectSubs, ok := s.subscribers[projectID]
-	if !ok {
-		logger.ZapLogger.Debug("No subscribers for project", zap.String("project_id", projectID))
-		return
+// DrainStreams tells open StreamProjectUpdates streams that the server is shutting down, so
+// their clients know to reconnect, and waits until they have all returned, ending their
+// subscriptions, or ctx is done. Streams opened afterwards end straight away.
+func (s *ProjectService) DrainStreams(ctx context.Context) error {
+	s.shutdownOnce.Do(func() { close(s.shutdownCh) })
+
+	s.streamsMu.Lock()
+	active, idle := s.activeStreams, s.streamsIdle
+	s.streamsMu.Unlock()
+	logger.ZapLogger.Info("Draining project update streams", zap.Int("active_streams", active))
+
+	select {
+	case <-idle:
+		logger.ZapLogger.Info("Project update streams drained", zap.Int("drained_streams", active))
+		return nil
+	case <-ctx.Done():
+		remaining := s.ActiveStreams()
+		logger.ZapLogger.Warn("Project update streams did not drain in time",
+			zap.Int("active_streams", remaining), zap.Error(ctx.Err()))
+		return fmt.Errorf("draining %d project update streams: %w", remaining, ctx.Err())
 	}
+}
 
-	logger.ZapLogger.Info("Broadcasting update to subscribers",
-		zap.String("project_id", projectID),
-		zap.Int("subscriber_count", len(projectSubs)),
-		zap.Int("issue_count", int(update.IssueCount)))
-
-	for _, ch := range projectSubs {
-		select {
-		case ch <- update:
-			logger.ZapLogger.Debug("Notification sent to subscriber", zap.String("project_id", projectID))
-		default:
-			logger.ZapLogger.Warn("Subscriber channel blocked, notification skipped", zap.String("project_id", projectID))
-		}
-	}
+// closedChannel returns a channel that is already closed
+func closedChannel() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
 }
 
-// Close releases resources used by the project service
+// Close tells open StreamProjectUpdates streams that the server is shutting down and
+// releases resources used by the project service. Unlike DrainStreams it doesn't wait for
+// the streams to end. It is safe to call more than once.
 func (s *ProjectService) Close() error {
+	s.shutdownOnce.Do(func() { close(s.shutdownCh) })
 	if s.messageBroker != nil {
 		return s.messageBroker.Close()
 	}
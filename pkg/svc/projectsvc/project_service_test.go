@@ -5,16 +5,19 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/logger"
 	"github.com/yasindce1998/issue-tracker/mocks"
+	"github.com/yasindce1998/issue-tracker/pkg/messaging/broker"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
 	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestCreateProject(t *testing.T) {
@@ -34,8 +37,8 @@ func TestCreateProject(t *testing.T) {
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
 				// Verify the project has proper fields before saving
-				mockRepo.EXPECT().CreateProject(gomock.Any()).DoAndReturn(
-					func(project *projectPbv1.Project) error {
+				mockRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, project *projectPbv1.Project) error {
 						// Verify project fields
 						if project.Name != "Test Project" ||
 							project.Description != "This is a test project" ||
@@ -54,6 +57,32 @@ func TestCreateProject(t *testing.T) {
 				assert.Equal(t, int32(0), resp.Project.IssueCount)
 			},
 		},
+		{
+			name: "Duplicate name",
+			req: &projectPbv1.CreateProjectRequest{
+				Name:        "Test Project",
+				Description: "Created twice",
+			},
+			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
+				mockRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(consts.ErrProjectNameAlreadyExists)
+			},
+			expectedErr: codes.AlreadyExists,
+			checkResp: func(t *testing.T, resp *projectPbv1.CreateProjectResponse) {
+				assert.Nil(t, resp)
+			},
+		},
+		{
+			name: "Negative issue limit",
+			req: &projectPbv1.CreateProjectRequest{
+				Name:      "Test Project",
+				MaxIssues: -1,
+			},
+			mockSetup:   func(_ *mocks.MockProjectRepository) {},
+			expectedErr: codes.InvalidArgument,
+			checkResp: func(t *testing.T, resp *projectPbv1.CreateProjectResponse) {
+				assert.Nil(t, resp)
+			},
+		},
 		{
 			name: "Repository error",
 			req: &projectPbv1.CreateProjectRequest{
@@ -61,7 +90,7 @@ func TestCreateProject(t *testing.T) {
 				Description: "This project will fail",
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().CreateProject(gomock.Any()).Return(errors.New("database error"))
+				mockRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
 			},
 			expectedErr: codes.Internal,
 			checkResp: func(t *testing.T, resp *projectPbv1.CreateProjectResponse) {
@@ -105,6 +134,47 @@ func TestCreateProject(t *testing.T) {
 	}
 }
 
+func TestFindOrCreateProject(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	service, err := projectsvc.NewProjectService(repo)
+	require.NoError(t, err)
+	defer service.Close()
+	ctx := context.Background()
+
+	first, err := service.FindOrCreateProject(ctx, &projectPbv1.FindOrCreateProjectRequest{Name: "Tracker", Description: "First", MaxIssues: 3})
+	require.NoError(t, err)
+	assert.True(t, first.Created)
+	assert.NotEmpty(t, first.Project.ProjectId)
+	assert.Equal(t, int32(3), first.Project.MaxIssues)
+
+	// Retrying returns the same project instead of creating a second one
+	second, err := service.FindOrCreateProject(ctx, &projectPbv1.FindOrCreateProjectRequest{Name: "Tracker", Description: "Second", MaxIssues: 10})
+	require.NoError(t, err)
+	assert.False(t, second.Created)
+	assert.Equal(t, first.Project.ProjectId, second.Project.ProjectId)
+	assert.Equal(t, "First", second.Project.Description)
+	assert.Equal(t, int32(3), second.Project.MaxIssues)
+
+	// CreateProject refuses the name, and so does renaming another project to it
+	_, err = service.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Tracker"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	other, err := service.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Other"})
+	require.NoError(t, err)
+	_, err = service.UpdateProject(ctx, &projectPbv1.UpdateProjectRequest{ProjectId: other.Project.ProjectId, Name: "Tracker"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	listed, err := service.ListProjects(ctx, &projectPbv1.ListProjectsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.Projects, 2)
+
+	_, err = service.FindOrCreateProject(ctx, &projectPbv1.FindOrCreateProjectRequest{Name: ""})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.FindOrCreateProject(ctx, &projectPbv1.FindOrCreateProjectRequest{Name: "Capped", MaxIssues: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestGetProject(t *testing.T) {
 	// Create test project
 	testProject := &projectPbv1.Project{
@@ -127,7 +197,7 @@ func TestGetProject(t *testing.T) {
 				ProjectId: "test-project-id",
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().ReadProject("test-project-id").Return(testProject, nil)
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "test-project-id").Return(testProject, nil)
 			},
 			expectedErr: codes.OK,
 			checkResp: func(t *testing.T, resp *projectPbv1.GetProjectResponse) {
@@ -141,7 +211,7 @@ func TestGetProject(t *testing.T) {
 				ProjectId: "non-existent-id",
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().ReadProject("non-existent-id").Return(nil, errors.New("project not found"))
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "non-existent-id").Return(nil, errors.New("project not found"))
 			},
 			expectedErr: codes.NotFound,
 			checkResp: func(t *testing.T, resp *projectPbv1.GetProjectResponse) {
@@ -184,6 +254,92 @@ func TestGetProject(t *testing.T) {
 	}
 }
 
+func TestGetProjectUpdateSnapshot(t *testing.T) {
+	testProject := &projectPbv1.Project{
+		ProjectId:  "test-project-id",
+		Name:       "Test Project",
+		IssueCount: 2,
+	}
+
+	testCases := []struct {
+		name        string
+		req         *projectPbv1.GetProjectUpdateSnapshotRequest
+		mockSetup   func(mockRepo *mocks.MockProjectRepository)
+		expectedErr codes.Code
+		checkResp   func(t *testing.T, resp *projectPbv1.ProjectUpdateResponse)
+	}{
+		{
+			name: "Successful snapshot",
+			req: &projectPbv1.GetProjectUpdateSnapshotRequest{
+				ProjectId: "test-project-id",
+			},
+			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "test-project-id").Return(testProject, nil)
+			},
+			expectedErr: codes.OK,
+			checkResp: func(t *testing.T, resp *projectPbv1.ProjectUpdateResponse) {
+				assert.Equal(t, "test-project-id", resp.ProjectId)
+				assert.Equal(t, int32(2), resp.IssueCount)
+				assert.Equal(t, "Project test-project-id has 2 issues", resp.Message)
+				assert.Equal(t, projectPbv1.UpdateType_UPDATE_TYPE_UNSPECIFIED, resp.UpdateType)
+			},
+		},
+		{
+			name: "Project not found",
+			req: &projectPbv1.GetProjectUpdateSnapshotRequest{
+				ProjectId: "non-existent-id",
+			},
+			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "non-existent-id").Return(nil, consts.ErrProjectNotFound)
+			},
+			expectedErr: codes.NotFound,
+			checkResp: func(t *testing.T, resp *projectPbv1.ProjectUpdateResponse) {
+				assert.Nil(t, resp)
+			},
+		},
+		{
+			name: "Repository error",
+			req: &projectPbv1.GetProjectUpdateSnapshotRequest{
+				ProjectId: "test-project-id",
+			},
+			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "test-project-id").Return(nil, errors.New("connection reset"))
+			},
+			expectedErr: codes.Internal,
+			checkResp: func(t *testing.T, resp *projectPbv1.ProjectUpdateResponse) {
+				assert.Nil(t, resp)
+			},
+		},
+		{
+			name: "Invalid project ID",
+			req: &projectPbv1.GetProjectUpdateSnapshotRequest{
+				ProjectId: "not a valid id",
+			},
+			mockSetup:   func(mockRepo *mocks.MockProjectRepository) {},
+			expectedErr: codes.InvalidArgument,
+			checkResp: func(t *testing.T, resp *projectPbv1.ProjectUpdateResponse) {
+				assert.Nil(t, resp)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockProjectRepository(ctrl)
+			tc.mockSetup(mockRepo)
+			service, _ := projectsvc.NewProjectService(mockRepo)
+
+			resp, err := service.GetProjectUpdateSnapshot(context.Background(), tc.req)
+
+			assert.Equal(t, tc.expectedErr, status.Code(err))
+			tc.checkResp(t, resp)
+		})
+	}
+}
+
 func TestUpdateProject(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -198,10 +354,11 @@ func TestUpdateProject(t *testing.T) {
 				ProjectId:   "test-project-id",
 				Name:        "Updated Project",
 				Description: "Updated Description",
+				MaxIssues:   50,
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
 				// Return existing project when ReadProject is called
-				mockRepo.EXPECT().ReadProject("test-project-id").Return(&projectPbv1.Project{
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "test-project-id").Return(&projectPbv1.Project{
 					ProjectId:   "test-project-id",
 					Name:        "Old Project",
 					Description: "Old Description",
@@ -209,12 +366,13 @@ func TestUpdateProject(t *testing.T) {
 				}, nil)
 
 				// Verify the update has correct fields
-				mockRepo.EXPECT().UpdateProject(gomock.Any()).DoAndReturn(
-					func(project *projectPbv1.Project) error {
+				mockRepo.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, project *projectPbv1.Project) error {
 						// Verify project fields were updated correctly
 						if project.ProjectId != "test-project-id" ||
 							project.Name != "Updated Project" ||
-							project.Description != "Updated Description" {
+							project.Description != "Updated Description" ||
+							project.MaxIssues != 50 {
 							return errors.New("project fields weren't updated correctly")
 						}
 						return nil
@@ -226,6 +384,7 @@ func TestUpdateProject(t *testing.T) {
 				assert.Equal(t, "test-project-id", resp.Project.ProjectId)
 				assert.Equal(t, "Updated Project", resp.Project.Name)
 				assert.Equal(t, "Updated Description", resp.Project.Description)
+				assert.Equal(t, int32(50), resp.Project.MaxIssues)
 			},
 		},
 		{
@@ -236,7 +395,7 @@ func TestUpdateProject(t *testing.T) {
 				Description: "Updated Description",
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().ReadProject("non-existent-id").Return(nil, errors.New("project not found"))
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "non-existent-id").Return(nil, errors.New("project not found"))
 			},
 			expectedErr: codes.NotFound,
 			checkResp: func(t *testing.T, resp *projectPbv1.UpdateProjectResponse) {
@@ -252,14 +411,14 @@ func TestUpdateProject(t *testing.T) {
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
 				// Project exists
-				mockRepo.EXPECT().ReadProject("test-project-id").Return(&projectPbv1.Project{
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "test-project-id").Return(&projectPbv1.Project{
 					ProjectId:   "test-project-id",
 					Name:        "Old Project",
 					Description: "Old Description",
 				}, nil)
 
 				// Update fails
-				mockRepo.EXPECT().UpdateProject(gomock.Any()).Return(errors.New("update failed"))
+				mockRepo.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(errors.New("update failed"))
 			},
 			expectedErr: codes.Internal,
 			checkResp: func(t *testing.T, resp *projectPbv1.UpdateProjectResponse) {
@@ -315,7 +474,7 @@ func TestDeleteProject(t *testing.T) {
 				ProjectId: "existing-project-id",
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().DeleteProject("existing-project-id").Return(nil)
+				mockRepo.EXPECT().DeleteProject(gomock.Any(), "existing-project-id").Return(nil)
 			},
 			expectedErr: codes.OK,
 		},
@@ -325,7 +484,7 @@ func TestDeleteProject(t *testing.T) {
 				ProjectId: "non-existent-id",
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().DeleteProject("non-existent-id").Return(errors.New("project not found"))
+				mockRepo.EXPECT().DeleteProject(gomock.Any(), "non-existent-id").Return(errors.New("project not found"))
 			},
 			expectedErr: codes.NotFound,
 		},
@@ -383,6 +542,7 @@ func TestListProjects(t *testing.T) {
 
 	testCases := []struct {
 		name        string
+		req         *projectPbv1.ListProjectsRequest
 		mockSetup   func(mockRepo *mocks.MockProjectRepository)
 		expectedErr codes.Code
 		checkResp   func(t *testing.T, resp *projectPbv1.ListProjectsResponse)
@@ -390,7 +550,7 @@ func TestListProjects(t *testing.T) {
 		{
 			name: "Successful list projects",
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().ListProjects().Return(sampleProjects, nil)
+				mockRepo.EXPECT().ListProjectsPage(gomock.Any(), "", 10).Return(sampleProjects, "", nil)
 			},
 			expectedErr: codes.OK,
 			checkResp: func(t *testing.T, resp *projectPbv1.ListProjectsResponse) {
@@ -405,7 +565,7 @@ func TestListProjects(t *testing.T) {
 		{
 			name: "Empty projects list",
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().ListProjects().Return([]*projectPbv1.Project{}, nil)
+				mockRepo.EXPECT().ListProjectsPage(gomock.Any(), "", 10).Return([]*projectPbv1.Project{}, "", nil)
 			},
 			expectedErr: codes.OK,
 			checkResp: func(t *testing.T, resp *projectPbv1.ListProjectsResponse) {
@@ -416,13 +576,46 @@ func TestListProjects(t *testing.T) {
 		{
 			name: "Repository error",
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
-				mockRepo.EXPECT().ListProjects().Return(nil, errors.New("database error"))
+				mockRepo.EXPECT().ListProjectsPage(gomock.Any(), "", 10).Return(nil, "", errors.New("database error"))
 			},
 			expectedErr: codes.Internal,
 			checkResp: func(t *testing.T, resp *projectPbv1.ListProjectsResponse) {
 				assert.Nil(t, resp)
 			},
 		},
+		{
+			name: "Next page",
+			req:  &projectPbv1.ListProjectsRequest{PageSize: 1, PageToken: "page-1"},
+			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
+				mockRepo.EXPECT().ListProjectsPage(gomock.Any(), "page-1", 1).Return(sampleProjects[1:], "page-2", nil)
+			},
+			expectedErr: codes.OK,
+			checkResp: func(t *testing.T, resp *projectPbv1.ListProjectsResponse) {
+				require.Len(t, resp.Projects, 1)
+				assert.Equal(t, "project-2", resp.Projects[0].ProjectId)
+				assert.Equal(t, "page-2", resp.NextPageToken)
+			},
+		},
+		{
+			name:        "Page size above maximum",
+			req:         &projectPbv1.ListProjectsRequest{PageSize: 101},
+			mockSetup:   func(*mocks.MockProjectRepository) {},
+			expectedErr: codes.InvalidArgument,
+			checkResp: func(t *testing.T, resp *projectPbv1.ListProjectsResponse) {
+				assert.Nil(t, resp)
+			},
+		},
+		{
+			name: "Page token not issued by the listing",
+			req:  &projectPbv1.ListProjectsRequest{PageToken: "project-1"},
+			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
+				mockRepo.EXPECT().ListProjectsPage(gomock.Any(), "project-1", 10).Return(nil, "", pagination.ErrInvalidPageToken)
+			},
+			expectedErr: codes.InvalidArgument,
+			checkResp: func(t *testing.T, resp *projectPbv1.ListProjectsResponse) {
+				assert.Nil(t, resp)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -441,7 +634,11 @@ func TestListProjects(t *testing.T) {
 			service, _ := projectsvc.NewProjectService(mockRepo)
 
 			// Call the method
-			resp, err := service.ListProjects(context.Background(), &emptypb.Empty{})
+			req := tc.req
+			if req == nil {
+				req = &projectPbv1.ListProjectsRequest{}
+			}
+			resp, err := service.ListProjects(context.Background(), req)
 
 			// Check error if expected
 			if tc.expectedErr != codes.OK {
@@ -476,12 +673,12 @@ func TestUpdateProjectWithIssue(t *testing.T) {
 			},
 			mockSetup: func(mockRepo *mocks.MockProjectRepository) {
 				// First check if project exists
-				mockRepo.EXPECT().ReadProject("project-1").Return(&projectPbv1.Project{
+				mockRepo.EXPECT().ReadProject(gomock.Any(), "project-1").Return(&projectPbv1.Project{
 					ProjectId:   "project-1",
 					Name:        "Test Project",
 					Description: "Test Description",
 				}, nil)
-				mockRepo.EXPECT().AddIssueToProject("project-1", "issue-1").Return(nil)
+				mockRepo.EXPECT().AddIssueToProject(gomock.Any(), "project-1", "issue-1").Return(nil)
 			},
 			expectedErr: codes.OK,
 			checkResp: func(t *testing.T, resp *projectPbv1.UpdateProjectWithIssueResponse) {
@@ -523,3 +720,33 @@ func TestUpdateProjectWithIssue(t *testing.T) {
 		})
 	}
 }
+
+func TestNewProjectService_FallsBackWhenBrokerFails(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	restore := projectsvc.SetMessageBrokerFactory(func() (broker.MessageBroker, error) {
+		return nil, errors.New("kafka: connection refused")
+	})
+	defer restore()
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockProjectRepository(ctrl)
+	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), "project-1", "issue-1").Return(nil)
+	mockRepo.EXPECT().ReadProject(gomock.Any(), "project-1").Return(&projectPbv1.Project{ProjectId: "project-1", IssueCount: 1}, nil)
+
+	service, err := projectsvc.NewProjectService(mockRepo)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+
+	// Updates still reach subscribers through the in-memory fallback
+	sub, err := service.MessageBroker().Subscribe(context.Background(), "project-1")
+	assert.NoError(t, err)
+
+	_, err = service.UpdateProjectWithIssue(context.Background(), &projectPbv1.UpdateProjectWithIssueRequest{
+		ProjectId: "project-1",
+		IssueId:   "issue-1",
+	})
+	assert.NoError(t, err)
+
+	update := <-sub.Updates
+	assert.Equal(t, int32(1), update.IssueCount)
+}
@@ -0,0 +1,518 @@
+package projectsvc_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/mocks"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const streamProjectID = "project-1"
+
+// slowStream is a StreamProjectUpdates server stream whose Send blocks until release is closed
+type slowStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	cancel   context.CancelFunc
+	requests chan *projectPbv1.ProjectUpdateRequest
+	release  chan struct{}
+	// ended is closed once Recv has reported the end of the stream
+	ended     chan struct{}
+	endedOnce sync.Once
+
+	mu   sync.Mutex
+	sent []*projectPbv1.ProjectUpdateResponse
+}
+
+func newSlowStream() *slowStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &slowStream{
+		ctx:      ctx,
+		cancel:   cancel,
+		requests: make(chan *projectPbv1.ProjectUpdateRequest, 1),
+		release:  make(chan struct{}),
+		ended:    make(chan struct{}),
+	}
+}
+
+func (s *slowStream) Context() context.Context {
+	return s.ctx
+}
+
+// Recv ends the stream gracefully when requests is closed or the test finishes, so the
+// receiving goroutine doesn't log after the test has returned
+func (s *slowStream) Recv() (*projectPbv1.ProjectUpdateRequest, error) {
+	select {
+	case req, ok := <-s.requests:
+		if !ok {
+			s.endedOnce.Do(func() { close(s.ended) })
+			return nil, io.EOF
+		}
+		return req, nil
+	case <-s.ctx.Done():
+		s.endedOnce.Do(func() { close(s.ended) })
+		return nil, io.EOF
+	}
+}
+
+func (s *slowStream) Send(update *projectPbv1.ProjectUpdateResponse) error {
+	s.mu.Lock()
+	s.sent = append(s.sent, update)
+	s.mu.Unlock()
+
+	<-s.release
+	return nil
+}
+
+func (s *slowStream) messages() []*projectPbv1.ProjectUpdateResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*projectPbv1.ProjectUpdateResponse(nil), s.sent...)
+}
+
+// streamFixture starts StreamProjectUpdates on a slow stream and subscribes it to streamProjectID
+type streamFixture struct {
+	service    *projectsvc.ProjectService
+	stream     *slowStream
+	issueCount atomic.Int32
+	done       chan error
+}
+
+func newStreamFixture(t *testing.T) *streamFixture {
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("COMMUNICATION_METHOD", "")
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockProjectRepository(ctrl)
+
+	f := &streamFixture{stream: newSlowStream(), done: make(chan error, 1)}
+
+	// End the stream and wait for the receiving goroutine, so it can't log after the test returns
+	t.Cleanup(func() {
+		f.stream.cancel()
+		select {
+		case <-f.stream.ended:
+		case <-time.After(time.Second):
+			t.Error("receiving goroutine did not finish")
+		}
+	})
+
+	// Every issue added to the project bumps its issue count, which identifies the update
+	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), streamProjectID, gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().ReadProject(gomock.Any(), streamProjectID).DoAndReturn(func(context.Context, string) (*projectPbv1.Project, error) {
+		return &projectPbv1.Project{ProjectId: streamProjectID, IssueCount: f.issueCount.Add(1)}, nil
+	}).AnyTimes()
+
+	service, err := projectsvc.NewProjectService(mockRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+	f.service = service
+
+	go func() {
+		f.done <- service.StreamProjectUpdates(f.stream)
+	}()
+	f.stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: streamProjectID, Action: "subscribe"}
+
+	// Publish until the first update reaches Send, where the stream now sits blocked
+	require.Eventually(t, func() bool {
+		f.publish(t)
+		return len(f.stream.messages()) > 0
+	}, time.Second, 5*time.Millisecond)
+
+	return f
+}
+
+// publish adds an issue to the project and returns the issue count carried by the resulting update
+func (f *streamFixture) publish(t *testing.T) int32 {
+	t.Helper()
+	resp, err := f.service.UpdateProjectWithIssue(context.Background(), &projectPbv1.UpdateProjectWithIssueRequest{
+		ProjectId: streamProjectID,
+		IssueId:   "issue",
+	})
+	require.NoError(t, err)
+	return resp.IssueCount
+}
+
+func (f *streamFixture) result(t *testing.T) error {
+	t.Helper()
+	select {
+	case err := <-f.done:
+		return err
+	case <-time.After(time.Second):
+		t.Fatal("StreamProjectUpdates did not return")
+		return nil
+	}
+}
+
+func TestStreamProjectUpdates_DropOldest(t *testing.T) {
+	t.Setenv("SUBSCRIBER_BUFFER_SIZE", "3")
+	t.Setenv("SUBSCRIBER_OVERFLOW_POLICY", "drop-oldest")
+	f := newStreamFixture(t)
+
+	// Ten updates arrive while the client is stuck receiving the first
+	var last int32
+	for i := 0; i < 10; i++ {
+		last = f.publish(t)
+	}
+	close(f.stream.release)
+
+	// The stream catches up with a marker followed by the newest buffered updates
+	require.Eventually(t, func() bool {
+		messages := f.stream.messages()
+		return messages[len(messages)-1].IssueCount == last
+	}, time.Second, 5*time.Millisecond)
+
+	messages := f.stream.messages()
+	require.Len(t, messages, 5)
+
+	marker := messages[1]
+	assert.Equal(t, streamProjectID, marker.ProjectId)
+	assert.GreaterOrEqual(t, marker.DroppedUpdates, int64(7))
+	assert.Contains(t, marker.Message, "updates were dropped")
+
+	for i, update := range messages[2:] {
+		assert.Equal(t, last-2+int32(i), update.IssueCount)
+		assert.Zero(t, update.DroppedUpdates)
+	}
+
+	// Keeping up again doesn't produce another marker
+	next := f.publish(t)
+	require.Eventually(t, func() bool { return len(f.stream.messages()) == 6 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, next, f.stream.messages()[5].IssueCount)
+
+	close(f.stream.requests)
+	assert.NoError(t, f.result(t))
+}
+
+func TestStreamProjectUpdates_DisconnectSlowConsumer(t *testing.T) {
+	t.Setenv("SUBSCRIBER_BUFFER_SIZE", "2")
+	t.Setenv("SUBSCRIBER_OVERFLOW_POLICY", "disconnect")
+	t.Setenv("SUBSCRIBER_MAX_CONSECUTIVE_DROPS", "3")
+	f := newStreamFixture(t)
+
+	// Enough updates to fill the buffer and then drop three in a row
+	for i := 0; i < 5; i++ {
+		f.publish(t)
+	}
+	close(f.stream.release)
+
+	err := f.result(t)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// What was buffered before the disconnect is delivered after a marker
+	messages := f.stream.messages()
+	require.Len(t, messages, 4)
+	assert.GreaterOrEqual(t, messages[1].DroppedUpdates, int64(3))
+	assert.NotZero(t, messages[2].IssueCount)
+	assert.NotZero(t, messages[3].IssueCount)
+}
+
+func TestStreamProjectUpdates_Shutdown(t *testing.T) {
+	f := newStreamFixture(t)
+	close(f.stream.release)
+
+	require.NoError(t, f.service.Close())
+	assert.NoError(t, f.result(t))
+
+	messages := f.stream.messages()
+	last := messages[len(messages)-1]
+	assert.Equal(t, "server shutting down", last.Message)
+	assert.Equal(t, streamProjectID, last.ProjectId)
+
+	// Closing again is a no-op
+	assert.NoError(t, f.service.Close())
+}
+
+func TestDrainStreams(t *testing.T) {
+	f := newStreamFixture(t)
+	assert.Equal(t, 1, f.service.ActiveStreams())
+
+	// The stream is stuck sending an update, so it can't end before the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, f.service.DrainStreams(ctx), context.DeadlineExceeded)
+	assert.Equal(t, 1, f.service.ActiveStreams())
+
+	close(f.stream.release)
+	require.NoError(t, f.service.DrainStreams(context.Background()))
+	assert.NoError(t, f.result(t))
+	assert.Zero(t, f.service.ActiveStreams())
+
+	messages := f.stream.messages()
+	last := messages[len(messages)-1]
+	assert.Equal(t, projectPbv1.UpdateType_SERVER_SHUTDOWN, last.UpdateType)
+
+	// Draining without open streams returns straight away
+	require.NoError(t, f.service.DrainStreams(context.Background()))
+}
+
+func TestStreamProjectUpdates_FilterUpdateTypes(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("COMMUNICATION_METHOD", "")
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockProjectRepository(ctrl)
+	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), streamProjectID, gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().ReadProject(gomock.Any(), streamProjectID).Return(&projectPbv1.Project{ProjectId: streamProjectID, IssueCount: 1}, nil).AnyTimes()
+
+	service, err := projectsvc.NewProjectService(mockRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+
+	stream := newSlowStream()
+	close(stream.release)
+	t.Cleanup(stream.cancel)
+	done := make(chan error, 1)
+	go func() {
+		done <- service.StreamProjectUpdates(stream)
+	}()
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{
+		ProjectId:   streamProjectID,
+		Action:      "subscribe",
+		UpdateTypes: []projectPbv1.UpdateType{projectPbv1.UpdateType_PROJECT_UPDATED},
+	}
+
+	// Request project updates until the subscription is in place and the first one arrives
+	require.Eventually(t, func() bool {
+		stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: streamProjectID, Action: "update"}
+		return len(stream.messages()) > 0
+	}, time.Second, 5*time.Millisecond)
+
+	_, err = service.UpdateProjectWithIssue(context.Background(), &projectPbv1.UpdateProjectWithIssueRequest{ProjectId: streamProjectID, IssueId: "issue"})
+	require.NoError(t, err)
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: streamProjectID, Action: "update"}
+
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) > 1
+	}, time.Second, 5*time.Millisecond)
+
+	// The shutdown message is sent whatever the filter
+	require.NoError(t, service.Close())
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("StreamProjectUpdates did not return")
+	}
+
+	messages := stream.messages()
+	require.NotEmpty(t, messages)
+	last := messages[len(messages)-1]
+	assert.Equal(t, projectPbv1.UpdateType_SERVER_SHUTDOWN, last.UpdateType)
+	for _, msg := range messages[:len(messages)-1] {
+		assert.Equal(t, projectPbv1.UpdateType_PROJECT_UPDATED, msg.UpdateType, msg.Message)
+	}
+}
+
+func TestStreamProjectUpdates_InvalidFilter(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("COMMUNICATION_METHOD", "")
+
+	service, err := projectsvc.NewProjectService(mocks.NewMockProjectRepository(gomock.NewController(t)))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+
+	stream := newSlowStream()
+	t.Cleanup(stream.cancel)
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{
+		ProjectId:   streamProjectID,
+		Action:      "subscribe",
+		UpdateTypes: []projectPbv1.UpdateType{projectPbv1.UpdateType_UPDATE_TYPE_UNSPECIFIED},
+	}
+
+	err = service.StreamProjectUpdates(stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStreamProjectUpdates_Heartbeat(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("COMMUNICATION_METHOD", "")
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockProjectRepository(ctrl)
+	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), streamProjectID, gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().ReadProject(gomock.Any(), streamProjectID).Return(&projectPbv1.Project{ProjectId: streamProjectID, IssueCount: 1}, nil).AnyTimes()
+
+	service, err := projectsvc.NewProjectService(mockRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+	service.SetHeartbeatInterval(20 * time.Millisecond)
+
+	stream := newSlowStream()
+	close(stream.release)
+	done := make(chan error, 1)
+	go func() {
+		done <- service.StreamProjectUpdates(stream)
+	}()
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: streamProjectID, Action: "subscribe"}
+
+	// An idle stream keeps getting heartbeats for the project it is subscribed to
+	require.Eventually(t, func() bool {
+		var heartbeats int
+		for _, msg := range stream.messages() {
+			if msg.Heartbeat && msg.ProjectId == streamProjectID {
+				heartbeats++
+			}
+		}
+		return heartbeats >= 2
+	}, time.Second, 5*time.Millisecond)
+
+	_, err = service.UpdateProjectWithIssue(context.Background(), &projectPbv1.UpdateProjectWithIssueRequest{ProjectId: streamProjectID, IssueId: "issue"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		for _, msg := range stream.messages() {
+			if msg.UpdateType == projectPbv1.UpdateType_ISSUE_ADDED {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 5*time.Millisecond)
+
+	stream.cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("StreamProjectUpdates did not return")
+	}
+	<-stream.ended
+
+	// Heartbeats carry no update, so clients can tell them apart from real ones
+	for _, msg := range stream.messages() {
+		if msg.Heartbeat {
+			assert.Equal(t, projectPbv1.UpdateType_UPDATE_TYPE_UNSPECIFIED, msg.UpdateType)
+			assert.Zero(t, msg.IssueCount)
+		} else {
+			assert.Equal(t, projectPbv1.UpdateType_ISSUE_ADDED, msg.UpdateType)
+		}
+	}
+}
+
+func TestHeartbeatIntervalFromEnv(t *testing.T) {
+	t.Setenv("STREAM_HEARTBEAT_INTERVAL_SECONDS", "")
+	assert.Equal(t, 30*time.Second, projectsvc.HeartbeatIntervalFromEnv())
+	t.Setenv("STREAM_HEARTBEAT_INTERVAL_SECONDS", "15")
+	assert.Equal(t, 15*time.Second, projectsvc.HeartbeatIntervalFromEnv())
+	t.Setenv("STREAM_HEARTBEAT_INTERVAL_SECONDS", "0")
+	assert.Zero(t, projectsvc.HeartbeatIntervalFromEnv())
+	t.Setenv("STREAM_HEARTBEAT_INTERVAL_SECONDS", "-1")
+	assert.Equal(t, 30*time.Second, projectsvc.HeartbeatIntervalFromEnv())
+}
+
+// Run with -race: an update requested straight after subscribing must reach the client, and the
+// subscription state the receiving goroutine and the sending loop each keep must not be shared
+func TestStreamProjectUpdates_SubscribeThenUpdate(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("COMMUNICATION_METHOD", "")
+
+	const otherProjectID = "project-2"
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockProjectRepository(ctrl)
+	mockRepo.EXPECT().ReadProject(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, projectID string) (*projectPbv1.Project, error) {
+		return &projectPbv1.Project{ProjectId: projectID, IssueCount: 1}, nil
+	}).AnyTimes()
+
+	service, err := projectsvc.NewProjectService(mockRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+
+	stream := newSlowStream()
+	close(stream.release)
+	t.Cleanup(stream.cancel)
+	done := make(chan error, 1)
+	go func() {
+		done <- service.StreamProjectUpdates(stream)
+	}()
+
+	// No retries: the subscription is in place before the update request is read
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: streamProjectID, Action: "subscribe"}
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: streamProjectID, Action: "update"}
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// Switching projects replaces the subscription; updates to the old project are ignored
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: otherProjectID, Action: "subscribe"}
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: streamProjectID, Action: "update"}
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{ProjectId: otherProjectID, Action: "update"}
+	require.Eventually(t, func() bool {
+		return len(stream.messages()) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	close(stream.requests)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("StreamProjectUpdates did not return")
+	}
+
+	messages := stream.messages()
+	require.Len(t, messages, 2)
+	assert.Equal(t, streamProjectID, messages[0].ProjectId)
+	assert.Equal(t, otherProjectID, messages[1].ProjectId)
+	for _, msg := range messages {
+		assert.Equal(t, projectPbv1.UpdateType_PROJECT_UPDATED, msg.UpdateType)
+	}
+}
+
+func TestStreamProjectUpdates_ReplayFrom(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	t.Setenv("COMMUNICATION_METHOD", "")
+
+	var issueCount atomic.Int32
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockProjectRepository(ctrl)
+	mockRepo.EXPECT().AddIssueToProject(gomock.Any(), streamProjectID, gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().ReadProject(gomock.Any(), streamProjectID).DoAndReturn(func(context.Context, string) (*projectPbv1.Project, error) {
+		return &projectPbv1.Project{ProjectId: streamProjectID, IssueCount: issueCount.Add(1)}, nil
+	}).AnyTimes()
+	service, err := projectsvc.NewProjectService(mockRepo)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = service.Close() })
+	addIssue := func() {
+		_, err := service.UpdateProjectWithIssue(context.Background(), &projectPbv1.UpdateProjectWithIssueRequest{ProjectId: streamProjectID, IssueId: "issue"})
+		require.NoError(t, err)
+	}
+
+	// Three issues are added before anyone subscribes
+	for i := 0; i < 3; i++ {
+		addIssue()
+	}
+
+	stream := newSlowStream()
+	close(stream.release)
+	t.Cleanup(stream.cancel)
+	go func() {
+		_ = service.StreamProjectUpdates(stream)
+	}()
+	stream.requests <- &projectPbv1.ProjectUpdateRequest{
+		ProjectId:  streamProjectID,
+		Action:     "subscribe",
+		ReplayFrom: timestamppb.New(time.Unix(0, 0)),
+	}
+	require.Eventually(t, func() bool { return len(stream.messages()) == 3 }, time.Second, 5*time.Millisecond)
+	addIssue()
+	require.Eventually(t, func() bool { return len(stream.messages()) == 4 }, time.Second, 5*time.Millisecond)
+
+	messages := stream.messages()
+	for i, msg := range messages {
+		assert.Equal(t, int32(i+1), msg.IssueCount)
+		assert.Equal(t, i < 3, msg.Replayed, "update %d", i+1)
+	}
+}
@@ -0,0 +1,94 @@
+package projectsvc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+)
+
+// CreateIssueTemplate creates a template that prefills issues created in a project
+func (s *ProjectService) CreateIssueTemplate(ctx context.Context, req *projectPbv1.CreateIssueTemplateRequest) (*projectPbv1.CreateIssueTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	template := &projectPbv1.IssueTemplate{
+		TemplateId:          uuid.New().String(),
+		ProjectId:           req.ProjectId,
+		Name:                req.Name,
+		DefaultType:         req.DefaultType,
+		DefaultPriority:     req.DefaultPriority,
+		SummaryPrefix:       req.SummaryPrefix,
+		DescriptionTemplate: req.DescriptionTemplate,
+	}
+
+	if err := s.repository.CreateIssueTemplate(ctx, template); err != nil {
+		if errors.Is(err, consts.ErrProjectNotFound) {
+			return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create issue template: %v", err)
+	}
+
+	return &projectPbv1.CreateIssueTemplateResponse{
+		Template: template,
+	}, nil
+}
+
+// GetIssueTemplate retrieves an issue template by ID
+func (s *ProjectService) GetIssueTemplate(ctx context.Context, req *projectPbv1.GetIssueTemplateRequest) (*projectPbv1.GetIssueTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	template, err := s.repository.ReadIssueTemplate(ctx, req.TemplateId)
+	if err != nil {
+		if errors.Is(err, consts.ErrTemplateNotFound) {
+			return nil, status.Error(codes.NotFound, "issue template not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get issue template: %v", err)
+	}
+
+	return &projectPbv1.GetIssueTemplateResponse{
+		Template: template,
+	}, nil
+}
+
+// ListIssueTemplates lists the issue templates of a project
+func (s *ProjectService) ListIssueTemplates(ctx context.Context, req *projectPbv1.ListIssueTemplatesRequest) (*projectPbv1.ListIssueTemplatesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	templates, err := s.repository.ListIssueTemplates(ctx, req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issue templates: %v", err)
+	}
+
+	return &projectPbv1.ListIssueTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+// DeleteIssueTemplate deletes an issue template by ID
+func (s *ProjectService) DeleteIssueTemplate(ctx context.Context, req *projectPbv1.DeleteIssueTemplateRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	if err := s.repository.DeleteIssueTemplate(ctx, req.TemplateId); err != nil {
+		if errors.Is(err, consts.ErrTemplateNotFound) {
+			return nil, status.Error(codes.NotFound, "issue template not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete issue template: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
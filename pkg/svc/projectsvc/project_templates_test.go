@@ -0,0 +1,147 @@
+package projectsvc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+)
+
+const missingTemplateID = "5b8e2f4a-9c1d-4e3b-a7f6-0d2c4e6a8b1f"
+
+// bugReportTemplate is a description template longer than a short note
+const bugReportTemplate = `## Steps to reproduce
+1.
+2.
+3.
+
+## Expected behaviour
+
+## Actual behaviour
+
+## Environment
+- Version:
+- Operating system:
+- Browser:
+
+## Logs or screenshots
+`
+
+func TestProjectService_IssueTemplates(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	service, err := projectsvc.NewProjectService(repo)
+	require.NoError(t, err)
+	defer service.Close()
+
+	ctx := context.Background()
+	project, err := service.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Project"})
+	require.NoError(t, err)
+	projectID := project.Project.ProjectId
+
+	created, err := service.CreateIssueTemplate(ctx, &projectPbv1.CreateIssueTemplateRequest{
+		ProjectId:           projectID,
+		Name:                "Bug report",
+		DefaultType:         "BUG",
+		DefaultPriority:     "MAJOR",
+		SummaryPrefix:       "[Bug] ",
+		DescriptionTemplate: bugReportTemplate,
+	})
+	require.NoError(t, err)
+	templateID := created.Template.TemplateId
+	assert.NotEmpty(t, templateID)
+
+	got, err := service.GetIssueTemplate(ctx, &projectPbv1.GetIssueTemplateRequest{TemplateId: templateID})
+	require.NoError(t, err)
+	assert.Equal(t, "Bug report", got.Template.Name)
+	assert.Equal(t, projectID, got.Template.ProjectId)
+	assert.Equal(t, bugReportTemplate, got.Template.DescriptionTemplate)
+
+	listed, err := service.ListIssueTemplates(ctx, &projectPbv1.ListIssueTemplatesRequest{ProjectId: projectID})
+	require.NoError(t, err)
+	require.Len(t, listed.Templates, 1)
+
+	_, err = service.DeleteIssueTemplate(ctx, &projectPbv1.DeleteIssueTemplateRequest{TemplateId: templateID})
+	require.NoError(t, err)
+	_, err = service.GetIssueTemplate(ctx, &projectPbv1.GetIssueTemplateRequest{TemplateId: templateID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestProjectService_IssueTemplateErrors(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	service, err := projectsvc.NewProjectService(repo)
+	require.NoError(t, err)
+	defer service.Close()
+
+	ctx := context.Background()
+	project, err := service.CreateProject(ctx, &projectPbv1.CreateProjectRequest{Name: "Project"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		call         func() error
+		expectedCode codes.Code
+	}{
+		{
+			name: "unknown default type",
+			call: func() error {
+				_, err := service.CreateIssueTemplate(ctx, &projectPbv1.CreateIssueTemplateRequest{
+					ProjectId: project.Project.ProjectId, Name: "Template", DefaultType: "QUESTION"})
+				return err
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "description template too long",
+			call: func() error {
+				_, err := service.CreateIssueTemplate(ctx, &projectPbv1.CreateIssueTemplateRequest{
+					ProjectId: project.Project.ProjectId, Name: "Template", DescriptionTemplate: strings.Repeat("a", 501)})
+				return err
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "missing project",
+			call: func() error {
+				_, err := service.CreateIssueTemplate(ctx, &projectPbv1.CreateIssueTemplateRequest{
+					ProjectId: "missing-project", Name: "Template"})
+				return err
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "delete missing template",
+			call: func() error {
+				_, err := service.DeleteIssueTemplate(ctx, &projectPbv1.DeleteIssueTemplateRequest{TemplateId: missingTemplateID})
+				return err
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "invalid template id",
+			call: func() error {
+				_, err := service.GetIssueTemplate(ctx, &projectPbv1.GetIssueTemplateRequest{TemplateId: "not-a-uuid"})
+				return err
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedCode, status.Code(tc.call()))
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Package teamsvc provides the service managing teams, the groups of a project's users that
+// issues can be assigned to in turn. It includes implementations for both in-memory and
+// database storage.
+package teamsvc
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+)
+
+// TeamRepository defines repository methods required for team operations. Teams keep their
+// members in the order they were added, which is the order issues are assigned to them in.
+type TeamRepository interface {
+	CreateTeam(ctx context.Context, team *teamPbv1.Team) error
+	ReadTeam(ctx context.Context, teamID string) (*teamPbv1.Team, error)
+	UpdateTeam(ctx context.Context, team *teamPbv1.Team) error
+	DeleteTeam(ctx context.Context, teamID string) error
+	ListTeams(ctx context.Context, projectID string) ([]*teamPbv1.Team, error)
+	AddTeamMember(ctx context.Context, teamID, userID string) (*teamPbv1.Team, error)
+	RemoveTeamMember(ctx context.Context, teamID, userID string) (*teamPbv1.Team, error)
+}
+
+// MemDBTeamRepository is an in-memory implementation of TeamRepository
+type MemDBTeamRepository struct {
+	db *memdb.MemDB
+}
+
+// CreateTeamMemDBSchema defines the schema for the in-memory database
+func CreateTeamMemDBSchema() *memdb.DBSchema {
+	return &memdb.DBSchema{
+		Tables: map[string]*memdb.TableSchema{
+			"team": {
+				Name: "team",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "TeamId"},
+					},
+					"project": {
+						Name:    "project",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "ProjectId"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// NewMemDBTeamRepository initializes the repository
+func NewMemDBTeamRepository() (*MemDBTeamRepository, error) {
+	db, err := memdb.NewMemDB(CreateTeamMemDBSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &MemDBTeamRepository{
+		db: db,
+	}, nil
+}
+
+// CreateTeam adds a new team to the repository
+func (r *MemDBTeamRepository) CreateTeam(_ context.Context, team *teamPbv1.Team) error {
+	txn := r.db.Txn(true)
+	if err := txn.Insert("team", proto.Clone(team)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// ReadTeam retrieves a team by its ID
+func (r *MemDBTeamRepository) ReadTeam(_ context.Context, teamID string) (*teamPbv1.Team, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("team", "id", teamID)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, consts.ErrTeamNotFound
+	}
+	return proto.Clone(raw.(*teamPbv1.Team)).(*teamPbv1.Team), nil
+}
+
+// UpdateTeam replaces the name and members of an existing team; its project doesn't change
+func (r *MemDBTeamRepository) UpdateTeam(_ context.Context, team *teamPbv1.Team) error {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("team", "id", team.TeamId)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if raw == nil {
+		txn.Abort()
+		return consts.ErrTeamNotFound
+	}
+
+	updated := proto.Clone(raw.(*teamPbv1.Team)).(*teamPbv1.Team)
+	updated.Name = team.Name
+	updated.MemberIds = slices.Clone(team.MemberIds)
+	if err := txn.Insert("team", updated); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// DeleteTeam removes a team from the repository
+func (r *MemDBTeamRepository) DeleteTeam(_ context.Context, teamID string) error {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("team", "id", teamID)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if raw == nil {
+		txn.Abort()
+		return consts.ErrTeamNotFound
+	}
+
+	if err := txn.Delete("team", raw); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// ListTeams retrieves the teams of a project ordered by name
+func (r *MemDBTeamRepository) ListTeams(_ context.Context, projectID string) ([]*teamPbv1.Team, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("team", "project", projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []*teamPbv1.Team
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		teams = append(teams, proto.Clone(obj.(*teamPbv1.Team)).(*teamPbv1.Team))
+	}
+	sort.SliceStable(teams, func(i, j int) bool {
+		return teams[i].Name < teams[j].Name
+	})
+
+	return teams, nil
+}
+
+// AddTeamMember appends a user to the members of a team; users who already are members keep
+// their place
+func (r *MemDBTeamRepository) AddTeamMember(_ context.Context, teamID, userID string) (*teamPbv1.Team, error) {
+	return r.updateMembers(teamID, func(memberIDs []string) ([]string, error) {
+		if slices.Contains(memberIDs, userID) {
+			return memberIDs, nil
+		}
+		return append(memberIDs, userID), nil
+	})
+}
+
+// RemoveTeamMember removes a user from the members of a team
+func (r *MemDBTeamRepository) RemoveTeamMember(_ context.Context, teamID, userID string) (*teamPbv1.Team, error) {
+	return r.updateMembers(teamID, func(memberIDs []string) ([]string, error) {
+		i := slices.Index(memberIDs, userID)
+		if i < 0 {
+			return nil, consts.ErrTeamMemberNotFound
+		}
+		return slices.Delete(memberIDs, i, i+1), nil
+	})
+}
+
+// updateMembers replaces the members of a team with the result of update in one transaction
+func (r *MemDBTeamRepository) updateMembers(teamID string, update func(memberIDs []string) ([]string, error)) (*teamPbv1.Team, error) {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("team", "id", teamID)
+	if err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	if raw == nil {
+		txn.Abort()
+		return nil, consts.ErrTeamNotFound
+	}
+
+	team := proto.Clone(raw.(*teamPbv1.Team)).(*teamPbv1.Team)
+	if team.MemberIds, err = update(team.MemberIds); err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	if err := txn.Insert("team", team); err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	txn.Commit()
+	return proto.Clone(team).(*teamPbv1.Team), nil
+}
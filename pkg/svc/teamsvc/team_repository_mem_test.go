@@ -0,0 +1,39 @@
+package teamsvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+)
+
+func TestMemDBTeamRepository_ReturnsCopies(t *testing.T) {
+	repo, err := teamsvc.NewMemDBTeamRepository()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	team := &teamPbv1.Team{TeamId: "team-1", Name: "Triage", ProjectId: "project-1", MemberIds: []string{"user-1"}}
+	require.NoError(t, repo.CreateTeam(ctx, team))
+
+	// Changing the object passed to CreateTeam doesn't reach the store
+	team.MemberIds[0] = "changed-after-create"
+
+	read, err := repo.ReadTeam(ctx, "team-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-1"}, read.MemberIds)
+	read.MemberIds[0] = "changed-after-read"
+
+	added, err := repo.AddTeamMember(ctx, "team-1", "user-2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-1", "user-2"}, added.MemberIds)
+	added.MemberIds[0] = "changed-after-add"
+
+	listed, err := repo.ListTeams(ctx, "project-1")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, []string{"user-1", "user-2"}, listed[0].MemberIds)
+}
@@ -0,0 +1,218 @@
+package teamsvc
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/models"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+)
+
+// PostgresTeamRepository implements TeamRepository using GORM for PostgreSQL.
+// It also backs the SQLite database type.
+type PostgresTeamRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTeamRepository initializes the repository with a GORM DB instance
+func NewPostgresTeamRepository(db *gorm.DB) *PostgresTeamRepository {
+	return &PostgresTeamRepository{db: db}
+}
+
+// CreateTeam adds a new team and its members to the database
+func (r *PostgresTeamRepository) CreateTeam(ctx context.Context, team *teamPbv1.Team) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dbTeam := &models.Team{
+			TeamID:    team.TeamId,
+			ProjectID: team.ProjectId,
+			Name:      team.Name,
+		}
+		if err := tx.Create(dbTeam).Error; err != nil {
+			return err
+		}
+		return createMembers(tx, team.TeamId, team.MemberIds)
+	})
+}
+
+// ReadTeam retrieves a team by its ID
+func (r *PostgresTeamRepository) ReadTeam(ctx context.Context, teamID string) (*teamPbv1.Team, error) {
+	return readTeam(r.db.WithContext(ctx), teamID)
+}
+
+// UpdateTeam replaces the name and members of an existing team; its project doesn't change
+func (r *PostgresTeamRepository) UpdateTeam(ctx context.Context, team *teamPbv1.Team) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockTeam(tx, team.TeamId); err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Team{}).Where("team_id = ?", team.TeamId).
+			UpdateColumn("name", team.Name).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.TeamMember{}, "team_id = ?", team.TeamId).Error; err != nil {
+			return err
+		}
+		return createMembers(tx, team.TeamId, team.MemberIds)
+	})
+}
+
+// DeleteTeam removes a team and its members from the database
+func (r *PostgresTeamRepository) DeleteTeam(ctx context.Context, teamID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.Team{}, "team_id = ?", teamID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return consts.ErrTeamNotFound
+		}
+		return tx.Delete(&models.TeamMember{}, "team_id = ?", teamID).Error
+	})
+}
+
+// ListTeams retrieves the teams of a project ordered by name
+func (r *PostgresTeamRepository) ListTeams(ctx context.Context, projectID string) ([]*teamPbv1.Team, error) {
+	var dbTeams []models.Team
+	if err := r.db.WithContext(ctx).Where("project_id = ?", projectID).Order("name, team_id").Find(&dbTeams).Error; err != nil {
+		return nil, err
+	}
+	if len(dbTeams) == 0 {
+		return nil, nil
+	}
+
+	teamIDs := make([]string, len(dbTeams))
+	for i, dbTeam := range dbTeams {
+		teamIDs[i] = dbTeam.TeamID
+	}
+	var dbMembers []models.TeamMember
+	if err := r.db.WithContext(ctx).Where("team_id IN ?", teamIDs).Order("position").Find(&dbMembers).Error; err != nil {
+		return nil, err
+	}
+	memberIDs := make(map[string][]string, len(dbTeams))
+	for _, dbMember := range dbMembers {
+		memberIDs[dbMember.TeamID] = append(memberIDs[dbMember.TeamID], dbMember.UserID)
+	}
+
+	teams := make([]*teamPbv1.Team, len(dbTeams))
+	for i, dbTeam := range dbTeams {
+		teams[i] = convertTeamToPb(dbTeam, memberIDs[dbTeam.TeamID])
+	}
+	return teams, nil
+}
+
+// AddTeamMember appends a user to the members of a team; users who already are members keep
+// their place
+func (r *PostgresTeamRepository) AddTeamMember(ctx context.Context, teamID, userID string) (*teamPbv1.Team, error) {
+	var team *teamPbv1.Team
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The team row is locked so concurrent additions don't take the same position
+		if err := lockTeam(tx, teamID); err != nil {
+			return err
+		}
+
+		var last struct{ Position *int }
+		if err := tx.Model(&models.TeamMember{}).Select("MAX(position) AS position").
+			Where("team_id = ?", teamID).Scan(&last).Error; err != nil {
+			return err
+		}
+		position := 0
+		if last.Position != nil {
+			position = *last.Position + 1
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.TeamMember{TeamID: teamID, UserID: userID, Position: position}).Error; err != nil {
+			return err
+		}
+
+		var err error
+		team, err = readTeam(tx, teamID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// RemoveTeamMember removes a user from the members of a team
+func (r *PostgresTeamRepository) RemoveTeamMember(ctx context.Context, teamID, userID string) (*teamPbv1.Team, error) {
+	var team *teamPbv1.Team
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockTeam(tx, teamID); err != nil {
+			return err
+		}
+
+		result := tx.Delete(&models.TeamMember{}, "team_id = ? AND user_id = ?", teamID, userID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return consts.ErrTeamMemberNotFound
+		}
+
+		var err error
+		team, err = readTeam(tx, teamID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// lockTeam reads a team's row for update. SQLite has no row locks and drops the clause; its
+// single writer serializes the transaction instead.
+func lockTeam(tx *gorm.DB, teamID string) error {
+	var dbTeam models.Team
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&dbTeam, "team_id = ?", teamID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return consts.ErrTeamNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// readTeam reads a team with its members in order
+func readTeam(db *gorm.DB, teamID string) (*teamPbv1.Team, error) {
+	var dbTeam models.Team
+	if err := db.First(&dbTeam, "team_id = ?", teamID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, consts.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	var memberIDs []string
+	if err := db.Model(&models.TeamMember{}).Where("team_id = ?", teamID).
+		Order("position").Pluck("user_id", &memberIDs).Error; err != nil {
+		return nil, err
+	}
+	return convertTeamToPb(dbTeam, memberIDs), nil
+}
+
+// createMembers adds the members of a team in order
+func createMembers(tx *gorm.DB, teamID string, memberIDs []string) error {
+	if len(memberIDs) == 0 {
+		return nil
+	}
+	dbMembers := make([]models.TeamMember, len(memberIDs))
+	for i, userID := range memberIDs {
+		dbMembers[i] = models.TeamMember{TeamID: teamID, UserID: userID, Position: i}
+	}
+	return tx.Create(&dbMembers).Error
+}
+
+// convertTeamToPb converts a team model and its member IDs to the protobuf representation
+func convertTeamToPb(dbTeam models.Team, memberIDs []string) *teamPbv1.Team {
+	return &teamPbv1.Team{
+		TeamId:    dbTeam.TeamID,
+		ProjectId: dbTeam.ProjectID,
+		Name:      dbTeam.Name,
+		MemberIds: memberIDs,
+	}
+}
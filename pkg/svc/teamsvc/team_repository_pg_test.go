@@ -0,0 +1,64 @@
+package teamsvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/database"
+	"github.com/yasindce1998/issue-tracker/models"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+)
+
+const (
+	sqliteTeamID      = "5b8e2f4a-9c1d-4e3b-a7f6-0d2c4e6a8b1f"
+	sqliteOtherTeamID = "6c9f3a5b-0d2e-4f4c-b8a7-1e3d5f7b9c2a"
+	sqliteProjectID   = "928f705f-0efa-4c96-b2f6-ceb36281e1f1"
+	sqliteUserID      = "a28f705f-0efa-4c96-b2f6-ceb36281e1f2"
+	sqliteOtherUserID = "b38f705f-0efa-4c96-b2f6-ceb36281e1f3"
+	sqliteThirdUserID = "c48f705f-0efa-4c96-b2f6-ceb36281e1f4"
+)
+
+// newSQLiteDB opens a private in-memory SQLite database with the application schema
+func newSQLiteDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := database.OpenSQLite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return db
+}
+
+func TestPostgresTeamRepository_DeletedWithProject(t *testing.T) {
+	db := newSQLiteDB(t)
+	projects := projectsvc.NewPostgresProjectRepository(db)
+	repo := teamsvc.NewPostgresTeamRepository(db)
+	ctx := context.Background()
+
+	const otherProjectID = "3e9a1c4b-7d2f-4b8e-a6c5-1f0d9e8b7a62"
+	require.NoError(t, projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: sqliteProjectID, Name: "Project"}))
+	require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: sqliteTeamID, Name: "Triage", ProjectId: sqliteProjectID, MemberIds: []string{sqliteUserID}}))
+	require.NoError(t, repo.CreateTeam(ctx, &teamPbv1.Team{TeamId: sqliteOtherTeamID, Name: "Kept", ProjectId: otherProjectID, MemberIds: []string{sqliteUserID}}))
+
+	require.NoError(t, projects.DeleteProject(ctx, sqliteProjectID))
+	_, err := repo.ReadTeam(ctx, sqliteTeamID)
+	assert.ErrorIs(t, err, consts.ErrTeamNotFound)
+	var members int64
+	require.NoError(t, db.Model(&models.TeamMember{}).Where("team_id = ?", sqliteTeamID).Count(&members).Error)
+	assert.Zero(t, members)
+
+	kept, err := repo.ReadTeam(ctx, sqliteOtherTeamID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{sqliteUserID}, kept.MemberIds)
+}
@@ -0,0 +1,217 @@
+package teamsvc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+)
+
+// ProjectReader reads the projects teams belong to
+type ProjectReader interface {
+	ReadProject(ctx context.Context, projectID string) (*projectPbv1.Project, error)
+}
+
+// UserReader reads the users that are members of teams
+type UserReader interface {
+	GetUserByID(ctx context.Context, userID string) (*userPbv1.User, error)
+}
+
+// TeamService implements the TeamServiceServer interface
+type TeamService struct {
+	teamPbv1.UnimplementedTeamServiceServer
+	repository TeamRepository
+	projects   ProjectReader
+	users      UserReader
+}
+
+// NewTeamService creates a new TeamService. Teams may only be created in projects projects
+// reads, and only users users reads may be members.
+func NewTeamService(repository TeamRepository, projects ProjectReader, users UserReader) *TeamService {
+	return &TeamService{
+		repository: repository,
+		projects:   projects,
+		users:      users,
+	}
+}
+
+// CreateTeam creates a team in a project
+func (s *TeamService) CreateTeam(ctx context.Context, req *teamPbv1.CreateTeamRequest) (*teamPbv1.CreateTeamResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if err := s.checkProject(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
+	if err := s.checkUsers(ctx, req.MemberIds...); err != nil {
+		return nil, err
+	}
+
+	team := &teamPbv1.Team{
+		TeamId:    uuid.New().String(),
+		Name:      req.Name,
+		ProjectId: req.ProjectId,
+		MemberIds: req.MemberIds,
+	}
+
+	if err := s.repository.CreateTeam(ctx, team); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create team: %v", err)
+	}
+
+	return &teamPbv1.CreateTeamResponse{
+		Team: team,
+	}, nil
+}
+
+// GetTeam retrieves a team by ID
+func (s *TeamService) GetTeam(ctx context.Context, req *teamPbv1.GetTeamRequest) (*teamPbv1.GetTeamResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	team, err := s.repository.ReadTeam(ctx, req.TeamId)
+	if err != nil {
+		return nil, teamError(err, "failed to get team")
+	}
+
+	return &teamPbv1.GetTeamResponse{
+		Team: team,
+	}, nil
+}
+
+// UpdateTeam renames a team and replaces its members
+func (s *TeamService) UpdateTeam(ctx context.Context, req *teamPbv1.UpdateTeamRequest) (*teamPbv1.UpdateTeamResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	team, err := s.repository.ReadTeam(ctx, req.TeamId)
+	if err != nil {
+		return nil, teamError(err, "failed to get team")
+	}
+	if err := s.checkUsers(ctx, req.MemberIds...); err != nil {
+		return nil, err
+	}
+
+	team.Name = req.Name
+	team.MemberIds = req.MemberIds
+	if err := s.repository.UpdateTeam(ctx, team); err != nil {
+		return nil, teamError(err, "failed to update team")
+	}
+
+	return &teamPbv1.UpdateTeamResponse{
+		Team: team,
+	}, nil
+}
+
+// DeleteTeam deletes a team by ID. Issues created for the team keep their assignees.
+func (s *TeamService) DeleteTeam(ctx context.Context, req *teamPbv1.DeleteTeamRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	if err := s.repository.DeleteTeam(ctx, req.TeamId); err != nil {
+		return nil, teamError(err, "failed to delete team")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListTeams lists the teams of a project
+func (s *TeamService) ListTeams(ctx context.Context, req *teamPbv1.ListTeamsRequest) (*teamPbv1.ListTeamsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if err := s.checkProject(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.repository.ListTeams(ctx, req.ProjectId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list teams: %v", err)
+	}
+
+	return &teamPbv1.ListTeamsResponse{
+		Teams: teams,
+	}, nil
+}
+
+// AddTeamMember adds a user to the end of a team's members, after whom the user is assigned issues
+func (s *TeamService) AddTeamMember(ctx context.Context, req *teamPbv1.AddTeamMemberRequest) (*teamPbv1.AddTeamMemberResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+	if err := s.checkUsers(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	team, err := s.repository.AddTeamMember(ctx, req.TeamId, req.UserId)
+	if err != nil {
+		return nil, teamError(err, "failed to add team member")
+	}
+
+	return &teamPbv1.AddTeamMemberResponse{
+		Team: team,
+	}, nil
+}
+
+// RemoveTeamMember removes a user from a team's members
+func (s *TeamService) RemoveTeamMember(ctx context.Context, req *teamPbv1.RemoveTeamMemberRequest) (*teamPbv1.RemoveTeamMemberResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	team, err := s.repository.RemoveTeamMember(ctx, req.TeamId, req.UserId)
+	if err != nil {
+		return nil, teamError(err, "failed to remove team member")
+	}
+
+	return &teamPbv1.RemoveTeamMemberResponse{
+		Team: team,
+	}, nil
+}
+
+// checkProject reports a project that doesn't exist as NotFound
+func (s *TeamService) checkProject(ctx context.Context, projectID string) error {
+	if _, err := s.projects.ReadProject(ctx, projectID); err != nil {
+		if errors.Is(err, consts.ErrProjectNotFound) {
+			return status.Error(codes.NotFound, "project not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+	return nil
+}
+
+// checkUsers reports members that don't exist as InvalidArgument
+func (s *TeamService) checkUsers(ctx context.Context, userIDs ...string) error {
+	for _, userID := range userIDs {
+		if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+			if errors.Is(err, consts.ErrUserNotFound) {
+				return status.Errorf(codes.InvalidArgument, "invalid member %s: %v", userID, err)
+			}
+			return status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+	}
+	return nil
+}
+
+// teamError maps repository errors to gRPC statuses, reporting other errors as Internal with message
+func teamError(err error, message string) error {
+	switch {
+	case errors.Is(err, consts.ErrTeamNotFound):
+		return status.Error(codes.NotFound, "team not found")
+	case errors.Is(err, consts.ErrTeamMemberNotFound):
+		return status.Error(codes.NotFound, "user is not a member of the team")
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+}
@@ -0,0 +1,144 @@
+package teamsvc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+	projectPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/project/v1"
+	teamPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/team/v1"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/projectsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/teamsvc"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+const missingID = "8e1a5c7d-2f4a-4b6e-9ac9-3a5f7b9d1e4c"
+
+// newTeamService creates a team service over in-memory repositories holding the project
+// sqliteProjectID and the users sqliteUserID, sqliteOtherUserID and sqliteThirdUserID
+func newTeamService(t *testing.T) *teamsvc.TeamService {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+	ctx := context.Background()
+
+	projects, err := projectsvc.NewMemDBProjectRepository()
+	require.NoError(t, err)
+	require.NoError(t, projects.CreateProject(ctx, &projectPbv1.Project{ProjectId: sqliteProjectID, Name: "Teams"}))
+	users, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	for _, userID := range []string{sqliteUserID, sqliteOtherUserID, sqliteThirdUserID} {
+		require.NoError(t, users.CreateUser(ctx, &userPbv1.User{UserId: userID, EmailAddress: userID + "@example.com", Active: true}))
+	}
+	teams, err := teamsvc.NewMemDBTeamRepository()
+	require.NoError(t, err)
+
+	return teamsvc.NewTeamService(teams, projects, users)
+}
+
+func TestTeamService_Teams(t *testing.T) {
+	service := newTeamService(t)
+	ctx := context.Background()
+
+	created, err := service.CreateTeam(ctx, &teamPbv1.CreateTeamRequest{
+		Name:      "Triage",
+		ProjectId: sqliteProjectID,
+		MemberIds: []string{sqliteUserID, sqliteOtherUserID},
+	})
+	require.NoError(t, err)
+	teamID := created.Team.TeamId
+	assert.NotEmpty(t, teamID)
+
+	got, err := service.GetTeam(ctx, &teamPbv1.GetTeamRequest{TeamId: teamID})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(created.Team, got.Team))
+
+	updated, err := service.UpdateTeam(ctx, &teamPbv1.UpdateTeamRequest{TeamId: teamID, Name: "Support", MemberIds: []string{sqliteOtherUserID}})
+	require.NoError(t, err)
+	assert.Equal(t, sqliteProjectID, updated.Team.ProjectId)
+
+	added, err := service.AddTeamMember(ctx, &teamPbv1.AddTeamMemberRequest{TeamId: teamID, UserId: sqliteThirdUserID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{sqliteOtherUserID, sqliteThirdUserID}, added.Team.MemberIds)
+	removed, err := service.RemoveTeamMember(ctx, &teamPbv1.RemoveTeamMemberRequest{TeamId: teamID, UserId: sqliteOtherUserID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{sqliteThirdUserID}, removed.Team.MemberIds)
+
+	listed, err := service.ListTeams(ctx, &teamPbv1.ListTeamsRequest{ProjectId: sqliteProjectID})
+	require.NoError(t, err)
+	require.Len(t, listed.Teams, 1)
+	assert.True(t, proto.Equal(&teamPbv1.Team{TeamId: teamID, Name: "Support", ProjectId: sqliteProjectID, MemberIds: []string{sqliteThirdUserID}}, listed.Teams[0]))
+
+	_, err = service.DeleteTeam(ctx, &teamPbv1.DeleteTeamRequest{TeamId: teamID})
+	require.NoError(t, err)
+	_, err = service.GetTeam(ctx, &teamPbv1.GetTeamRequest{TeamId: teamID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTeamService_Errors(t *testing.T) {
+	service := newTeamService(t)
+	ctx := context.Background()
+
+	created, err := service.CreateTeam(ctx, &teamPbv1.CreateTeamRequest{Name: "Triage", ProjectId: sqliteProjectID})
+	require.NoError(t, err)
+	teamID := created.Team.TeamId
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"create without name", func() error {
+			_, err := service.CreateTeam(ctx, &teamPbv1.CreateTeamRequest{ProjectId: sqliteProjectID})
+			return err
+		}, codes.InvalidArgument},
+		{"create with repeated members", func() error {
+			_, err := service.CreateTeam(ctx, &teamPbv1.CreateTeamRequest{Name: "Twice", ProjectId: sqliteProjectID, MemberIds: []string{sqliteUserID, sqliteUserID}})
+			return err
+		}, codes.InvalidArgument},
+		{"create in missing project", func() error {
+			_, err := service.CreateTeam(ctx, &teamPbv1.CreateTeamRequest{Name: "Lost", ProjectId: missingID})
+			return err
+		}, codes.NotFound},
+		{"create with missing member", func() error {
+			_, err := service.CreateTeam(ctx, &teamPbv1.CreateTeamRequest{Name: "Ghosts", ProjectId: sqliteProjectID, MemberIds: []string{missingID}})
+			return err
+		}, codes.InvalidArgument},
+		{"update missing team", func() error {
+			_, err := service.UpdateTeam(ctx, &teamPbv1.UpdateTeamRequest{TeamId: missingID, Name: "Lost"})
+			return err
+		}, codes.NotFound},
+		{"list teams of missing project", func() error {
+			_, err := service.ListTeams(ctx, &teamPbv1.ListTeamsRequest{ProjectId: missingID})
+			return err
+		}, codes.NotFound},
+		{"add missing user", func() error {
+			_, err := service.AddTeamMember(ctx, &teamPbv1.AddTeamMemberRequest{TeamId: teamID, UserId: missingID})
+			return err
+		}, codes.InvalidArgument},
+		{"add to missing team", func() error {
+			_, err := service.AddTeamMember(ctx, &teamPbv1.AddTeamMemberRequest{TeamId: missingID, UserId: sqliteUserID})
+			return err
+		}, codes.NotFound},
+		{"remove user who isn't a member", func() error {
+			_, err := service.RemoveTeamMember(ctx, &teamPbv1.RemoveTeamMemberRequest{TeamId: teamID, UserId: sqliteUserID})
+			return err
+		}, codes.NotFound},
+		{"delete missing team", func() error {
+			_, err := service.DeleteTeam(ctx, &teamPbv1.DeleteTeamRequest{TeamId: missingID})
+			return err
+		}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, status.Code(tt.call()))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package usersvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// fakeAssignedIssues holds the open issue count of each user in memory
+type fakeAssignedIssues struct {
+	open map[string]int
+}
+
+func (f *fakeAssignedIssues) CountOpenIssuesByAssignee(_ context.Context, assigneeID string) (int, error) {
+	return f.open[assigneeID], nil
+}
+
+func (f *fakeAssignedIssues) ReassignOpenIssues(_ context.Context, fromAssigneeID, toAssigneeID string) ([]string, error) {
+	issueIDs := make([]string, f.open[fromAssigneeID])
+	f.open[toAssigneeID] += f.open[fromAssigneeID]
+	delete(f.open, fromAssigneeID)
+	return issueIDs, nil
+}
+
+// newCachedUserService serves users from a cached in-memory repository, as the server does
+func newCachedUserService(t *testing.T) *usersvc.UserService {
+	t.Helper()
+	logger.ZapLogger = zap.NewNop()
+
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	cached := usersvc.NewCachedUserRepository(repo, cache.NewMemoryCache(100), cache.CacheConfig{UserTTL: time.Minute})
+	return usersvc.NewUserService(cached)
+}
+
+func createUser(t *testing.T, service *usersvc.UserService, email string) *userPbv1.User {
+	t.Helper()
+	resp, err := service.CreateUser(context.Background(), &userPbv1.CreateUserRequest{FirstName: "Jane", LastName: "Doe", EmailAddress: email})
+	require.NoError(t, err)
+	return resp.User
+}
+
+func listedUserIDs(t *testing.T, service *usersvc.UserService, includeDeactivated bool) []string {
+	t.Helper()
+	resp, err := service.ListUsers(context.Background(), &userPbv1.ListUsersRequest{PageSize: 10, IncludeDeactivated: includeDeactivated})
+	require.NoError(t, err)
+	ids := make([]string, len(resp.Users))
+	for i, user := range resp.Users {
+		ids[i] = user.UserId
+	}
+	return ids
+}
+
+func TestUserService_DeactivateAndReactivateUser(t *testing.T) {
+	service := newCachedUserService(t)
+	ctx := context.Background()
+
+	user := createUser(t, service, "jane@example.com")
+	assert.True(t, user.Active, "users are created active")
+	// Cache the list, which deactivating the user must invalidate
+	assert.Equal(t, []string{user.UserId}, listedUserIDs(t, service, false))
+
+	deactivated, err := service.DeactivateUser(ctx, &userPbv1.DeactivateUserRequest{UserId: user.UserId})
+	require.NoError(t, err)
+	assert.False(t, deactivated.User.Active)
+
+	// Deactivated users can still be fetched, to display the issues assigned to them
+	got, err := service.GetUser(ctx, &userPbv1.GetUserRequest{UserId: user.UserId})
+	require.NoError(t, err)
+	assert.False(t, got.User.Active)
+	assert.Equal(t, "jane@example.com", got.User.EmailAddress)
+
+	assert.Empty(t, listedUserIDs(t, service, false))
+	assert.Equal(t, []string{user.UserId}, listedUserIDs(t, service, true))
+
+	// Updating the user's name doesn't reactivate them
+	updated, err := service.UpdateUser(ctx, &userPbv1.UpdateUserRequest{UserId: user.UserId, FirstName: "Janet", LastName: "Doe", EmailAddress: "jane@example.com"})
+	require.NoError(t, err)
+	assert.False(t, updated.User.Active)
+
+	reactivated, err := service.ReactivateUser(ctx, &userPbv1.ReactivateUserRequest{UserId: user.UserId})
+	require.NoError(t, err)
+	assert.True(t, reactivated.User.Active)
+	assert.Equal(t, "Janet", reactivated.User.FirstName)
+	assert.Equal(t, []string{user.UserId}, listedUserIDs(t, service, false))
+}
+
+func TestUserService_DeactivateUserErrors(t *testing.T) {
+	service := newCachedUserService(t)
+
+	_, err := service.DeactivateUser(context.Background(), &userPbv1.DeactivateUserRequest{UserId: nonExistUUID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.ReactivateUser(context.Background(), &userPbv1.ReactivateUserRequest{UserId: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_DeleteUserWithOpenIssues(t *testing.T) {
+	service := newCachedUserService(t)
+	ctx := context.Background()
+
+	user := createUser(t, service, "jane@example.com")
+	other := createUser(t, service, "john@example.com")
+	issues := &fakeAssignedIssues{open: map[string]int{user.UserId: 40}}
+	service.SetAssignedIssues(issues)
+
+	_, err := service.DeleteUser(ctx, &userPbv1.DeleteUserRequest{UserId: user.UserId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, err, "40 open issues")
+
+	// Issues can't be handed to the user being deleted, to an unknown user or to a deactivated one
+	_, err = service.DeleteUser(ctx, &userPbv1.DeleteUserRequest{UserId: user.UserId, ReassignTo: user.UserId})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.DeleteUser(ctx, &userPbv1.DeleteUserRequest{UserId: user.UserId, ReassignTo: nonExistUUID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.DeactivateUser(ctx, &userPbv1.DeactivateUserRequest{UserId: other.UserId})
+	require.NoError(t, err)
+	_, err = service.DeleteUser(ctx, &userPbv1.DeleteUserRequest{UserId: user.UserId, ReassignTo: other.UserId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, 40, issues.open[user.UserId], "nothing is reassigned when the request is refused")
+
+	_, err = service.ReactivateUser(ctx, &userPbv1.ReactivateUserRequest{UserId: other.UserId})
+	require.NoError(t, err)
+	_, err = service.DeleteUser(ctx, &userPbv1.DeleteUserRequest{UserId: user.UserId, ReassignTo: other.UserId})
+	require.NoError(t, err)
+	assert.Equal(t, 40, issues.open[other.UserId])
+
+	_, err = service.GetUser(ctx, &userPbv1.GetUserRequest{UserId: user.UserId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Users without open issues are deleted as before
+	_, err = service.DeleteUser(ctx, &userPbv1.DeleteUserRequest{UserId: createUser(t, service, "jim@example.com").UserId})
+	assert.NoError(t, err)
+}
@@ -3,13 +3,13 @@ package usersvc
 import (
 	"context"
 	"fmt"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/yasindce1998/issue-tracker/cache"
 	"github.com/yasindce1998/issue-tracker/logger"
 	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -18,40 +18,43 @@ type CachedUserRepository struct {
 	repository UserRepository
 	cache      cache.Cache
 	ttl        time.Duration
+	listTTL    time.Duration
 }
 
-// NewCachedUserRepository creates a new cached user repository
-func NewCachedUserRepository(repository UserRepository, cache cache.Cache) *CachedUserRepository {
-	// Default TTL: 1 hour
-	ttl := 3600 * time.Second
-
-	// Get TTL from environment variable if available
-	if ttlStr := os.Getenv("CACHE_TTL"); ttlStr != "" {
-		if ttlVal, err := strconv.Atoi(ttlStr); err == nil {
-			ttl = time.Duration(ttlVal) * time.Second
-		}
-	}
-
+// NewCachedUserRepository creates a new cached user repository whose entries expire after the TTLs in cfg
+func NewCachedUserRepository(repository UserRepository, cache cache.Cache, cfg cache.CacheConfig) *CachedUserRepository {
 	return &CachedUserRepository{
 		repository: repository,
 		cache:      cache,
-		ttl:        ttl,
+		ttl:        cfg.UserTTL,
+		listTTL:    cfg.UserListTTL,
 	}
 }
 
+// DegradedMode reports whether the repository has stopped using its cache because the cache
+// kept failing; see cache.DegradedCache
+func (r *CachedUserRepository) DegradedMode() bool {
+	return cache.IsDegraded(r.cache)
+}
+
 // CreateUser adds a new user to the repository with caching
-func (r *CachedUserRepository) CreateUser(user *userPbv1.User) error {
+func (r *CachedUserRepository) CreateUser(ctx context.Context, user *userPbv1.User) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.CreateUser")
+	defer span.End()
+
 	// Write to repository first
-	if err := r.repository.CreateUser(user); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.CreateUser")
+	err := r.repository.CreateUser(repoCtx, user)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Then update cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("user:%s", user.UserId)
-	if err := r.cache.Set(ctx, cacheKey, user, r.ttl); err != nil {
+	if err := r.set(ctx, cacheKey, user, r.ttl); err != nil {
 		// Log error but don't fail the request
-		logger.ZapLogger.Error("Failed to cache user",
+		logger.FromContext(ctx).Error("Failed to cache user",
 			zap.String("user_id", user.UserId),
 			zap.Error(err))
 	}
@@ -63,8 +66,14 @@ func (r *CachedUserRepository) CreateUser(user *userPbv1.User) error {
 }
 
 // GetUserByID retrieves a user by ID with caching
-func (r *CachedUserRepository) GetUserByID(userID string) (*userPbv1.User, error) {
-	ctx := context.Background()
+func (r *CachedUserRepository) GetUserByID(ctx context.Context, userID string) (*userPbv1.User, error) {
+	if r.ttl <= 0 {
+		return r.repository.GetUserByID(ctx, userID)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.GetUserByID")
+	defer span.End()
+
 	cacheKey := fmt.Sprintf("user:%s", userID)
 
 	// Try to get from cache first
@@ -72,13 +81,17 @@ func (r *CachedUserRepository) GetUserByID(userID string) (*userPbv1.User, error
 	err := r.cache.Get(ctx, cacheKey, user)
 	if err == nil {
 		// Cache hit
-		logger.ZapLogger.Debug("User cache hit", zap.String("user_id", userID))
+		logger.FromContext(ctx).Debug("User cache hit", zap.String("user_id", userID))
 		logger.LogCacheAccess(ctx, "User", userID, logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
 		return user, nil
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 
 	// Cache miss, get from repository
-	user, err = r.repository.GetUserByID(userID)
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.GetUserByID")
+	user, err = r.repository.GetUserByID(repoCtx, userID)
+	tracing.EndSpan(repoSpan, err)
 	if err != nil {
 		return nil, err
 	}
@@ -86,9 +99,9 @@ func (r *CachedUserRepository) GetUserByID(userID string) (*userPbv1.User, error
 	logger.LogCacheAccess(ctx, "User", userID, logger.FromDatabase)
 
 	// Store in cache for future requests
-	if err := r.cache.Set(ctx, cacheKey, user, r.ttl); err != nil {
+	if err := r.set(ctx, cacheKey, user, r.ttl); err != nil {
 		// Log error but don't fail the request
-		logger.ZapLogger.Error("Failed to cache user",
+		logger.FromContext(ctx).Error("Failed to cache user",
 			zap.String("user_id", userID),
 			zap.Error(err))
 	}
@@ -96,18 +109,108 @@ func (r *CachedUserRepository) GetUserByID(userID string) (*userPbv1.User, error
 	return user, nil
 }
 
+// BatchGetUsers retrieves users by ID, reading the cached ones from the cache and the rest from
+// the repository in one call, then caching each of those individually
+func (r *CachedUserRepository) BatchGetUsers(ctx context.Context, userIDs []string) ([]*userPbv1.User, error) {
+	if r.ttl <= 0 {
+		return r.repository.BatchGetUsers(ctx, userIDs)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.BatchGetUsers", attribute.Int("user.count", len(userIDs)))
+	defer span.End()
+
+	found := make(map[string]*userPbv1.User, len(userIDs))
+	var misses []string
+	for _, userID := range userIDs {
+		var user = new(userPbv1.User)
+		if err := r.cache.Get(ctx, fmt.Sprintf("user:%s", userID), user); err == nil {
+			logger.LogCacheAccess(ctx, "User", userID, logger.FromCache)
+			found[userID] = user
+			continue
+		}
+		misses = append(misses, userID)
+	}
+
+	span.SetAttributes(attribute.Int("cache.misses", len(misses)))
+
+	if len(misses) > 0 {
+		repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.BatchGetUsers")
+		users, err := r.repository.BatchGetUsers(repoCtx, misses)
+		tracing.EndSpan(repoSpan, err)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			logger.LogCacheAccess(ctx, "User", user.UserId, logger.FromDatabase)
+			found[user.UserId] = user
+
+			if err := r.set(ctx, fmt.Sprintf("user:%s", user.UserId), user, r.ttl); err != nil {
+				logger.FromContext(ctx).Error("Failed to cache user",
+					zap.String("user_id", user.UserId),
+					zap.Error(err))
+			}
+		}
+	}
+
+	// Keep the order of the requested IDs
+	users := make([]*userPbv1.User, 0, len(found))
+	for _, userID := range userIDs {
+		if user, ok := found[userID]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// GetUserByEmail retrieves a user by email address; lookups by email are not cached
+func (r *CachedUserRepository) GetUserByEmail(ctx context.Context, email string) (*userPbv1.User, error) {
+	return r.repository.GetUserByEmail(ctx, email)
+}
+
+// FindOrCreateUser finds or creates a user and caches the result
+func (r *CachedUserRepository) FindOrCreateUser(ctx context.Context, user *userPbv1.User) (*userPbv1.User, bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.FindOrCreateUser")
+	defer span.End()
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.FindOrCreateUser")
+	result, created, err := r.repository.FindOrCreateUser(repoCtx, user)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cacheKey := fmt.Sprintf("user:%s", result.UserId)
+	if err := r.set(ctx, cacheKey, result, r.ttl); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache user",
+			zap.String("user_id", result.UserId),
+			zap.Error(err))
+	}
+
+	// Only a newly created user changes the users list
+	if created {
+		r.invalidateUserListCache(ctx)
+	}
+
+	return result, created, nil
+}
+
 // UpdateUser updates an existing user and refreshes cache
-func (r *CachedUserRepository) UpdateUser(user *userPbv1.User) error {
+func (r *CachedUserRepository) UpdateUser(ctx context.Context, user *userPbv1.User) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.UpdateUser")
+	defer span.End()
+
 	// Write to repository first
-	if err := r.repository.UpdateUser(user); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.UpdateUser")
+	err := r.repository.UpdateUser(repoCtx, user)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Update cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("user:%s", user.UserId)
-	if err := r.cache.Set(ctx, cacheKey, user, r.ttl); err != nil {
-		logger.ZapLogger.Error("Failed to update user in cache",
+	if err := r.set(ctx, cacheKey, user, r.ttl); err != nil {
+		logger.FromContext(ctx).Error("Failed to update user in cache",
 			zap.String("user_id", user.UserId),
 			zap.Error(err))
 	}
@@ -119,17 +222,22 @@ func (r *CachedUserRepository) UpdateUser(user *userPbv1.User) error {
 }
 
 // DeleteUser removes a user and clears it from cache
-func (r *CachedUserRepository) DeleteUser(userID string) error {
+func (r *CachedUserRepository) DeleteUser(ctx context.Context, userID string) error {
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.DeleteUser")
+	defer span.End()
+
 	// Delete from repository first
-	if err := r.repository.DeleteUser(userID); err != nil {
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.DeleteUser")
+	err := r.repository.DeleteUser(repoCtx, userID)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
 		return err
 	}
 
 	// Remove from cache
-	ctx := context.Background()
 	cacheKey := fmt.Sprintf("user:%s", userID)
 	if err := r.cache.Delete(ctx, cacheKey); err != nil {
-		logger.ZapLogger.Error("Failed to remove user from cache",
+		logger.FromContext(ctx).Error("Failed to remove user from cache",
 			zap.String("user_id", userID),
 			zap.Error(err))
 	}
@@ -141,9 +249,15 @@ func (r *CachedUserRepository) DeleteUser(userID string) error {
 }
 
 // ListUsers retrieves a paginated list of users with caching
-func (r *CachedUserRepository) ListUsers(pageToken string, pageSize int) ([]*userPbv1.User, string, error) {
-	ctx := context.Background()
-	cacheKey := fmt.Sprintf("users:list:%s:%d", pageToken, pageSize)
+func (r *CachedUserRepository) ListUsers(ctx context.Context, pageToken string, pageSize int, opts ListUsersOptions) ([]*userPbv1.User, string, error) {
+	if r.listTTL <= 0 {
+		return r.repository.ListUsers(ctx, pageToken, pageSize, opts)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.ListUsers")
+	defer span.End()
+
+	cacheKey := fmt.Sprintf("users:list:%s:%d:%t:%d:%s", pageToken, pageSize, opts.IncludeDeactivated, opts.OrderBy, opts.Filter)
 
 	// Try to get from cache first
 	type cachedUsersList struct {
@@ -155,15 +269,19 @@ func (r *CachedUserRepository) ListUsers(pageToken string, pageSize int) ([]*use
 	err := r.cache.Get(ctx, cacheKey, &cachedList)
 	if err == nil {
 		// Cache hit
-		logger.ZapLogger.Debug("Users list cache hit",
+		logger.FromContext(ctx).Debug("Users list cache hit",
 			zap.String("page_token", pageToken),
 			zap.Int("page_size", pageSize))
 		logger.LogCacheAccess(ctx, "UsersList", fmt.Sprintf("page:%s:size:%d", pageToken, pageSize), logger.FromCache)
+		span.SetAttributes(attribute.Bool("cache.hit", true))
 		return cachedList.Users, cachedList.NextToken, nil
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 
 	// Cache miss, get from repository
-	users, nextToken, err := r.repository.ListUsers(pageToken, pageSize)
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.ListUsers")
+	users, nextToken, err := r.repository.ListUsers(repoCtx, pageToken, pageSize, opts)
+	tracing.EndSpan(repoSpan, err)
 	if err != nil {
 		return nil, "", err
 	}
@@ -176,8 +294,8 @@ func (r *CachedUserRepository) ListUsers(pageToken string, pageSize int) ([]*use
 		NextToken: nextToken,
 	}
 
-	if err := r.cache.Set(ctx, cacheKey, toCache, r.ttl); err != nil {
-		logger.ZapLogger.Error("Failed to cache users list",
+	if err := r.set(ctx, cacheKey, toCache, r.listTTL); err != nil {
+		logger.FromContext(ctx).Error("Failed to cache users list",
 			zap.String("page_token", pageToken),
 			zap.Int("page_size", pageSize),
 			zap.Error(err))
@@ -186,44 +304,64 @@ func (r *CachedUserRepository) ListUsers(pageToken string, pageSize int) ([]*use
 	return users, nextToken, nil
 }
 
+// UpdateLastActive records when the user was last active. It runs on every GetUser, so the
+// cached user is left alone; cached copies show the previous value until they expire.
+func (r *CachedUserRepository) UpdateLastActive(ctx context.Context, userID string, lastActive time.Time) error {
+	return r.repository.UpdateLastActive(ctx, userID, lastActive)
+}
+
+// ListInactiveUsers lists users last active before inactiveSince; the result is not cached
+func (r *CachedUserRepository) ListInactiveUsers(ctx context.Context, inactiveSince time.Time) ([]*userPbv1.User, error) {
+	return r.repository.ListInactiveUsers(ctx, inactiveSince)
+}
+
+// SetUserActive deactivates or reactivates a user and refreshes the cached user
+func (r *CachedUserRepository) SetUserActive(ctx context.Context, userID string, active bool) (*userPbv1.User, error) {
+	ctx, span := tracing.StartSpan(ctx, "CachedUserRepository.SetUserActive")
+	defer span.End()
+
+	repoCtx, repoSpan := tracing.StartSpan(ctx, "UserRepository.SetUserActive")
+	user, err := r.repository.SetUserActive(repoCtx, userID, active)
+	tracing.EndSpan(repoSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("user:%s", userID)
+	if err := r.set(ctx, cacheKey, user, r.ttl); err != nil {
+		logger.FromContext(ctx).Error("Failed to update user in cache",
+			zap.String("user_id", userID),
+			zap.Error(err))
+	}
+
+	// Deactivated users are left out of the users list
+	r.invalidateUserListCache(ctx)
+
+	return user, nil
+}
+
 // invalidateUserListCache removes all cached user list results to ensure consistency
-// after a user is created, updated, or deleted
+// after a user is created, updated, deactivated or deleted
 func (r *CachedUserRepository) invalidateUserListCache(ctx context.Context) {
-	// For Redis specifically, we need to get all matching keys first
-	// This is a more efficient approach that works with both Redis and memory caches
-
-	// Track all key invalidations for logging
-	var invalidatedCount int
-	var lastError error
-
-	// We'll invalidate specific keys we know about rather than using patterns
-	// This is more efficient and works across different cache implementations
-	knownPrefixes := []string{
-		"users:list:", // Basic list cache
-		"users:all",   // Any cache of all users
-		"users:count", // User count cache if implemented
-	}
-
-	for _, prefix := range knownPrefixes {
-		// Different cache implementations might have different ways to delete by pattern
-		// Here we're just deleting known keys directly
-		if err := r.cache.Delete(ctx, prefix); err != nil {
-			lastError = err
-			logger.ZapLogger.Debug("Failed to invalidate cache key",
-				zap.String("key", prefix),
-				zap.Error(err))
-		} else {
-			invalidatedCount++
-		}
+	// Pages are cached under one key per page token, size and filter, so remove them by prefix
+	removed, err := cache.Flush(ctx, r.cache, "users:list:")
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to invalidate user list caches",
+			zap.Int("removed", removed),
+			zap.Error(err))
+		return
 	}
+	if removed > 0 {
+		logger.FromContext(ctx).Debug("Successfully invalidated user list caches",
+			zap.Int("count", removed))
+	}
+}
 
-	// Log the overall outcome
-	if lastError != nil {
-		logger.ZapLogger.Error("Failed to invalidate some user list caches",
-			zap.Int("successful_invalidations", invalidatedCount),
-			zap.Error(lastError))
-	} else if invalidatedCount > 0 {
-		logger.ZapLogger.Debug("Successfully invalidated user list caches",
-			zap.Int("count", invalidatedCount))
+// set caches value under key with ttl moved by up to 10% either way, so entries cached together
+// expire at different times. Nothing is cached when ttl is zero, which turns caching off.
+func (r *CachedUserRepository) set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
 	}
+	return r.cache.Set(ctx, key, value, cache.Jitter(ttl))
 }
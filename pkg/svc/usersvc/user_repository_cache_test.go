@@ -0,0 +1,227 @@
+package usersvc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/yasindce1998/issue-tracker/cache"
+	"github.com/yasindce1998/issue-tracker/logger"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// ttlRecordingCache remembers the expiration each key was last stored with
+type ttlRecordingCache struct {
+	*cache.MemoryCache
+	ttls map[string]time.Duration
+}
+
+func (c *ttlRecordingCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.ttls[key] = expiration
+	return c.MemoryCache.Set(ctx, key, value, expiration)
+}
+
+// requestKey marks the context of the request a cache call is made for
+type requestKey struct{}
+
+// ctxRecordingCache records the request each cache call was made for
+type ctxRecordingCache struct {
+	*cache.MemoryCache
+	requests []any
+}
+
+func (c *ctxRecordingCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Set(ctx, key, value, expiration)
+}
+
+func (c *ctxRecordingCache) Get(ctx context.Context, key string, dest interface{}) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Get(ctx, key, dest)
+}
+
+func (c *ctxRecordingCache) Delete(ctx context.Context, keys ...string) error {
+	c.requests = append(c.requests, ctx.Value(requestKey{}))
+	return c.MemoryCache.Delete(ctx, keys...)
+}
+
+func TestCachedUserRepository_AppliesUserTTL(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	recorder := &ttlRecordingCache{MemoryCache: cache.NewMemoryCache(10), ttls: map[string]time.Duration{}}
+	cached := usersvc.NewCachedUserRepository(repo, recorder, cache.CacheConfig{
+		IssueTTL:     time.Minute,
+		IssueListTTL: time.Minute,
+		UserTTL:      2 * time.Hour,
+		UserListTTL:  5 * time.Minute,
+		ProjectTTL:   time.Minute,
+	})
+
+	require.NoError(t, cached.CreateUser(context.Background(), &userPbv1.User{UserId: validUUID, EmailAddress: "jane@example.com"}))
+	_, _, err = cached.ListUsers(context.Background(), "", 10, usersvc.ListUsersOptions{})
+	require.NoError(t, err)
+
+	// TTLs are moved by up to 10% either way
+	assert.InDelta(t, float64(2*time.Hour), float64(recorder.ttls["user:"+validUUID]), float64(12*time.Minute))
+	assert.InDelta(t, float64(5*time.Minute), float64(recorder.ttls["users:list::10:false:0:"]), float64(30*time.Second))
+}
+
+func TestCachedUserRepository_ZeroTTLDisablesCaching(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	recorder := &ttlRecordingCache{MemoryCache: cache.NewMemoryCache(10), ttls: map[string]time.Duration{}}
+	cached := usersvc.NewCachedUserRepository(repo, recorder, cache.CacheConfig{UserListTTL: time.Minute})
+	ctx := context.Background()
+
+	user := &userPbv1.User{UserId: validUUID, FirstName: "Jane", EmailAddress: "jane@example.com"}
+	require.NoError(t, cached.CreateUser(ctx, user))
+	_, err = cached.GetUserByID(ctx, validUUID)
+	require.NoError(t, err)
+	assert.NotContains(t, recorder.ttls, "user:"+validUUID)
+
+	// Reads go to the repository, so a change made behind the cache is seen straight away
+	user.FirstName = "Janet"
+	require.NoError(t, repo.UpdateUser(ctx, user))
+	got, err := cached.GetUserByID(ctx, validUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Janet", got.FirstName)
+
+	// Lists are still cached
+	_, _, err = cached.ListUsers(ctx, "", 10, usersvc.ListUsersOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, recorder.ttls, "users:list::10:false:0:")
+}
+
+func TestCachedUserRepository_PassesRequestContextToCache(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	recorder := &ctxRecordingCache{MemoryCache: cache.NewMemoryCache(10)}
+	cached := usersvc.NewCachedUserRepository(repo, recorder, cache.CacheConfig{UserTTL: time.Minute})
+	ctx := context.WithValue(context.Background(), requestKey{}, "request-1")
+
+	user := &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}
+	require.NoError(t, cached.CreateUser(ctx, user))
+	_, err = cached.GetUserByID(ctx, validUUID)
+	require.NoError(t, err)
+	_, _, err = cached.ListUsers(ctx, "", 10, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	user.FirstName = "Janet"
+	require.NoError(t, cached.UpdateUser(ctx, user))
+	require.NoError(t, cached.DeleteUser(ctx, validUUID))
+
+	require.NotEmpty(t, recorder.requests)
+	for _, request := range recorder.requests {
+		assert.Equal(t, "request-1", request)
+	}
+}
+
+// batchCountingRepository records the IDs passed to BatchGetUsers
+type batchCountingRepository struct {
+	usersvc.UserRepository
+	requested [][]string
+}
+
+func (r *batchCountingRepository) BatchGetUsers(ctx context.Context, userIDs []string) ([]*userPbv1.User, error) {
+	r.requested = append(r.requested, userIDs)
+	return r.UserRepository.BatchGetUsers(ctx, userIDs)
+}
+
+func TestCachedUserRepository_BatchGetUsersOnlyFetchesMisses(t *testing.T) {
+	logger.ZapLogger = zap.NewNop()
+	memRepo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	require.NoError(t, memRepo.CreateUser(context.Background(), &userPbv1.User{UserId: validUUID, EmailAddress: "jane@example.com"}))
+	require.NoError(t, memRepo.CreateUser(context.Background(), &userPbv1.User{UserId: nonExistUUID, EmailAddress: "john@example.com"}))
+
+	repo := &batchCountingRepository{UserRepository: memRepo}
+	c := cache.NewMemoryCache(10)
+	cached := usersvc.NewCachedUserRepository(repo, c, cache.CacheConfig{UserTTL: time.Minute, UserListTTL: time.Minute})
+
+	// Cache one of the users
+	_, err = cached.GetUserByID(context.Background(), validUUID)
+	require.NoError(t, err)
+
+	missingID := "00000000-0000-4000-8000-000000000000"
+	users, err := cached.BatchGetUsers(context.Background(), []string{nonExistUUID, validUUID, missingID})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, nonExistUUID, users[0].UserId)
+	assert.Equal(t, validUUID, users[1].UserId)
+	assert.Equal(t, [][]string{{nonExistUUID, missingID}}, repo.requested)
+
+	_, err = cached.BatchGetUsers(context.Background(), []string{nonExistUUID, validUUID})
+	require.NoError(t, err)
+	assert.Len(t, repo.requested, 1)
+}
+
+// downCache fails every call it receives, counting them
+type downCache struct {
+	*cache.MemoryCache
+	calls int
+}
+
+var errCacheDown = errors.New("dial tcp: i/o timeout")
+
+func (c *downCache) Set(context.Context, string, interface{}, time.Duration) error {
+	c.calls++
+	return errCacheDown
+}
+
+func (c *downCache) Get(context.Context, string, interface{}) error {
+	c.calls++
+	return errCacheDown
+}
+
+func (c *downCache) Delete(context.Context, ...string) error {
+	c.calls++
+	return errCacheDown
+}
+
+func TestCachedUserRepository_DegradedMode(t *testing.T) {
+	core, logs := observer.New(zapcore.ErrorLevel)
+	logger.ZapLogger = zap.New(core)
+	defer func() { logger.ZapLogger = zap.NewNop() }()
+
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	down := &downCache{MemoryCache: cache.NewMemoryCache(10)}
+	degraded := cache.NewDegradedCache("users", down, cache.DegradedConfig{ErrorThreshold: 3, Window: time.Minute})
+	cached := usersvc.NewCachedUserRepository(repo, degraded, cache.CacheConfig{UserTTL: time.Minute, UserListTTL: time.Minute})
+	ctx := context.Background()
+
+	user := &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}
+	require.NoError(t, cached.CreateUser(ctx, user))
+	for !cached.DegradedMode() {
+		require.Less(t, down.calls, 10, "the repository must degrade")
+		_, err := cached.GetUserByID(ctx, validUUID)
+		require.NoError(t, err)
+	}
+	logged, calls := logs.Len(), down.calls
+
+	// Degraded, the repository keeps serving from the database without calling the cache or logging errors
+	for i := 0; i < 10; i++ {
+		got, err := cached.GetUserByID(ctx, validUUID)
+		require.NoError(t, err)
+		assert.Equal(t, "Jane", got.FirstName)
+		user.FirstName = "Janet"
+		require.NoError(t, cached.UpdateUser(ctx, user))
+		user.FirstName = "Jane"
+		require.NoError(t, cached.UpdateUser(ctx, user))
+	}
+	assert.Equal(t, calls, down.calls)
+	assert.Equal(t, logged, logs.Len())
+}
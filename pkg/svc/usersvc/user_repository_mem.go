@@ -3,20 +3,46 @@
 package usersvc
 
 import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
 	"github.com/hashicorp/go-memdb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // UserRepository defines the interface for database operations
 type UserRepository interface {
-	CreateUser(user *userPbv1.User) error
-	GetUserByID(userID string) (*userPbv1.User, error)
-	UpdateUser(user *userPbv1.User) error
-	DeleteUser(userID string) error
-	ListUsers(pageToken string, pageSize int) ([]*userPbv1.User, string, error)
+	CreateUser(ctx context.Context, user *userPbv1.User) error
+	GetUserByID(ctx context.Context, userID string) (*userPbv1.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*userPbv1.User, error)
+	BatchGetUsers(ctx context.Context, userIDs []string) ([]*userPbv1.User, error)
+	FindOrCreateUser(ctx context.Context, user *userPbv1.User) (*userPbv1.User, bool, error)
+	UpdateUser(ctx context.Context, user *userPbv1.User) error
+	DeleteUser(ctx context.Context, userID string) error
+	ListUsers(ctx context.Context, pageToken string, pageSize int, opts ListUsersOptions) ([]*userPbv1.User, string, error)
+	UpdateLastActive(ctx context.Context, userID string, lastActive time.Time) error
+	ListInactiveUsers(ctx context.Context, inactiveSince time.Time) ([]*userPbv1.User, error)
+	SetUserActive(ctx context.Context, userID string, active bool) (*userPbv1.User, error)
+}
+
+// ListUsersOptions selects and orders the users ListUsers returns
+type ListUsersOptions struct {
+	IncludeDeactivated bool               // deactivated users are left out unless set
+	Filter             string             // case-insensitive substring of the first, last or full name or the email address
+	OrderBy            userPbv1.UserOrder // by user ID unless LAST_NAME
 }
 
+// sortByLastName is the order of page tokens of users listed by last name, then ID
+const sortByLastName = "last_name"
+
 // MemDBUserRepository implements UserRepository using Hashicorp MemDB
 type MemDBUserRepository struct {
 	db *memdb.MemDB
@@ -39,12 +65,52 @@ func CreateMemDBSchema() *memdb.DBSchema {
 						Unique:  true,
 						Indexer: &memdb.StringFieldIndex{Field: "EmailAddress"},
 					},
+					"last_active": {
+						Name:         "last_active", // Orders users by LastActiveAt for inactivity scans
+						AllowMissing: true,
+						Indexer:      lastActiveIndexer{},
+					},
 				},
 			},
 		},
 	}
 }
 
+// lastActiveIndexer indexes users by LastActiveAt. Values are encoded so that
+// byte order matches time order, which lets ListInactiveUsers stop at the cutoff.
+type lastActiveIndexer struct{}
+
+// FromObject implements memdb.SingleIndexer; users that were never active are left out of the index
+func (lastActiveIndexer) FromObject(obj interface{}) (bool, []byte, error) {
+	user, ok := obj.(*userPbv1.User)
+	if !ok {
+		return false, nil, fmt.Errorf("unexpected type %T for last_active index", obj)
+	}
+	if user.LastActiveAt == nil {
+		return false, nil, nil
+	}
+	return true, encodeIndexTime(user.LastActiveAt.AsTime()), nil
+}
+
+// FromArgs implements memdb.Indexer
+func (lastActiveIndexer) FromArgs(args ...interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("last_active index takes one argument, got %d", len(args))
+	}
+	t, ok := args[0].(time.Time)
+	if !ok {
+		return nil, fmt.Errorf("last_active index argument must be a time.Time, got %T", args[0])
+	}
+	return encodeIndexTime(t), nil
+}
+
+// encodeIndexTime encodes t as big-endian nanoseconds with the sign bit flipped so earlier times sort first
+func encodeIndexTime(t time.Time) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(t.UnixNano())^(1<<63))
+	return buf
+}
+
 // NewMemDBUserRepository initializes the repository with a MemDB instance
 func NewMemDBUserRepository() (*MemDBUserRepository, error) {
 	db, err := memdb.NewMemDB(CreateMemDBSchema())
@@ -55,25 +121,31 @@ func NewMemDBUserRepository() (*MemDBUserRepository, error) {
 }
 
 // CreateUser adds a new user to the repository
-func (r *MemDBUserRepository) CreateUser(user *userPbv1.User) error {
+func (r *MemDBUserRepository) CreateUser(_ context.Context, user *userPbv1.User) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
 
 	// Ensure email uniqueness
 	raw, err := txn.First("user", "email", user.EmailAddress)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if raw != nil {
+		txn.Abort()
 		return consts.ErrEmailAlreadyExists
 	}
 
-	// Insert the user into the database
-	return txn.Insert("user", user)
+	// Insert a copy so later changes by the caller don't modify the stored user
+	if err := txn.Insert("user", proto.Clone(user)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
 }
 
 // GetUserByID retrieves a user by their ID
-func (r *MemDBUserRepository) GetUserByID(userID string) (*userPbv1.User, error) {
+func (r *MemDBUserRepository) GetUserByID(_ context.Context, userID string) (*userPbv1.User, error) {
 	txn := r.db.Txn(false)
 	defer txn.Abort()
 
@@ -84,20 +156,80 @@ func (r *MemDBUserRepository) GetUserByID(userID string) (*userPbv1.User, error)
 	if raw == nil {
 		return nil, consts.ErrUserNotFound
 	}
-	return raw.(*userPbv1.User), nil
+	return cloneUser(raw), nil
+}
+
+// BatchGetUsers retrieves the users with the given IDs from a single read transaction, in the
+// order of the IDs. IDs without a user are skipped.
+func (r *MemDBUserRepository) BatchGetUsers(_ context.Context, userIDs []string) ([]*userPbv1.User, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	users := make([]*userPbv1.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		raw, err := txn.First("user", "id", userID)
+		if err != nil {
+			return nil, err
+		}
+		if raw != nil {
+			users = append(users, cloneUser(raw))
+		}
+	}
+	return users, nil
 }
 
-// UpdateUser updates an existing user
-func (r *MemDBUserRepository) UpdateUser(user *userPbv1.User) error {
+// GetUserByEmail retrieves a user by their email address
+func (r *MemDBUserRepository) GetUserByEmail(_ context.Context, email string) (*userPbv1.User, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("user", "email", email)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, consts.ErrUserNotFound
+	}
+	return cloneUser(raw), nil
+}
+
+// FindOrCreateUser returns the user with the same email address if one exists, otherwise
+// it inserts the given user. The lookup and insert share one write transaction so
+// concurrent callers can't both create the user. The boolean reports whether it was created.
+func (r *MemDBUserRepository) FindOrCreateUser(_ context.Context, user *userPbv1.User) (*userPbv1.User, bool, error) {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("user", "email", user.EmailAddress)
+	if err != nil {
+		txn.Abort()
+		return nil, false, err
+	}
+	if raw != nil {
+		txn.Abort()
+		return cloneUser(raw), false, nil
+	}
+
+	if err := txn.Insert("user", proto.Clone(user)); err != nil {
+		txn.Abort()
+		return nil, false, err
+	}
+	txn.Commit()
+	return user, true, nil
+}
+
+// UpdateUser updates the name and email address of an existing user. The fields the
+// update doesn't carry are copied onto user from the stored one.
+func (r *MemDBUserRepository) UpdateUser(_ context.Context, user *userPbv1.User) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
 
 	// Check whether the user exists
 	raw, err := txn.First("user", "id", user.UserId)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if raw == nil {
+		txn.Abort()
 		return consts.ErrUserNotFound
 	}
 
@@ -106,37 +238,54 @@ func (r *MemDBUserRepository) UpdateUser(user *userPbv1.User) error {
 		// Check if email is already in use by another user
 		emailCheck, err := txn.First("user", "email", user.EmailAddress)
 		if err != nil {
+			txn.Abort()
 			return err
 		}
 		if emailCheck != nil {
+			txn.Abort()
 			return consts.ErrEmailAlreadyExists
 		}
 	}
 
+	user.LastActiveAt = existingUser.LastActiveAt
+	user.Active = existingUser.Active
+
 	// Replace the user record in the database
 	if err := txn.Delete("user", existingUser); err != nil {
+		txn.Abort()
 		return err
 	}
-	return txn.Insert("user", user)
+	if err := txn.Insert("user", proto.Clone(user)); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
 }
 
 // DeleteUser removes a user from the repository
-func (r *MemDBUserRepository) DeleteUser(userID string) error {
+func (r *MemDBUserRepository) DeleteUser(_ context.Context, userID string) error {
 	txn := r.db.Txn(true)
-	defer txn.Commit()
 
 	raw, err := txn.First("user", "id", userID)
 	if err != nil {
+		txn.Abort()
 		return err
 	}
 	if raw == nil {
+		txn.Abort()
 		return consts.ErrUserNotFound
 	}
-	return txn.Delete("user", raw)
+	if err := txn.Delete("user", raw); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
 }
 
-// ListUsers retrieves a paginated list of users
-func (r *MemDBUserRepository) ListUsers(pageToken string, pageSize int) ([]*userPbv1.User, string, error) {
+// ListUsers retrieves a paginated list of users, leaving out deactivated users unless includeDeactivated is set
+func (r *MemDBUserRepository) ListUsers(_ context.Context, pageToken string, pageSize int, opts ListUsersOptions) ([]*userPbv1.User, string, error) {
 	txn := r.db.Txn(false)
 	defer txn.Abort()
 
@@ -147,24 +296,71 @@ func (r *MemDBUserRepository) ListUsers(pageToken string, pageSize int) ([]*user
 
 	var users []*userPbv1.User
 	for obj := it.Next(); obj != nil; obj = it.Next() {
-		users = append(users, obj.(*userPbv1.User))
+		user := obj.(*userPbv1.User)
+		if !user.Active && !opts.IncludeDeactivated {
+			continue
+		}
+		if !matchesUserFilter(user, opts.Filter) {
+			continue
+		}
+		users = append(users, user)
+	}
+
+	// The id index already orders users by ID, which breaks ties between equal last names
+	sortOrder := pagination.SortByID
+	if opts.OrderBy == userPbv1.UserOrder_LAST_NAME {
+		sortOrder = sortByLastName
+		sort.SliceStable(users, func(i, j int) bool {
+			return users[i].LastName < users[j].LastName
+		})
 	}
 
-	// Perform pagination using the helper
-	paginatedUsers, nextPageToken := paginateUsers(users, pageSize, pageToken)
+	paginatedUsers, nextPageToken, err := paginateUsers(users, pageSize, pageToken, sortOrder)
+	if err != nil {
+		return nil, "", err
+	}
+	for i, user := range paginatedUsers {
+		paginatedUsers[i] = cloneUser(user)
+	}
 	return paginatedUsers, nextPageToken, nil
 }
 
-// Pagination Helper
-func paginateUsers(users []*userPbv1.User, pageSize int, pageToken string) ([]*userPbv1.User, string) {
+// matchesUserFilter reports whether filter is a case-insensitive substring of the user's first,
+// last or full name or email address. An empty filter matches every user.
+func matchesUserFilter(user *userPbv1.User, filter string) bool {
+	if filter == "" {
+		return true
+	}
+	filter = strings.ToLower(filter)
+	for _, field := range []string{user.FirstName, user.LastName, user.FirstName + " " + user.LastName, user.EmailAddress} {
+		if strings.Contains(strings.ToLower(field), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// cloneUser copies a stored user so callers can't modify the object held by MemDB
+func cloneUser(raw interface{}) *userPbv1.User {
+	return proto.Clone(raw.(*userPbv1.User)).(*userPbv1.User)
+}
+
+// paginateUsers returns the page of users, ordered by ID or by last name, then ID, that follows
+// pageToken
+func paginateUsers(users []*userPbv1.User, pageSize int, pageToken string, sortOrder string) ([]*userPbv1.User, string, error) {
 	startIndex := 0
 	if pageToken != "" {
-		for i, user := range users {
-			if user.UserId == pageToken {
-				startIndex = i + 1
-				break
-			}
+		token, err := pagination.Decode(pageToken, pagination.KindUser, sortOrder)
+		if err != nil {
+			return nil, "", err
 		}
+		// The page continues after the token's user even if that user was deleted since
+		startIndex = sort.Search(len(users), func(i int) bool {
+			if sortOrder == sortByLastName && users[i].LastName != token.Key {
+				return users[i].LastName > token.Key
+			}
+			return users[i].UserId > token.ID
+		})
 	}
 
 	endIndex := startIndex + pageSize
@@ -174,8 +370,83 @@ func paginateUsers(users []*userPbv1.User, pageSize int, pageToken string) ([]*u
 
 	var nextPageToken string
 	if endIndex < len(users) {
-		nextPageToken = users[endIndex-1].UserId
+		last := users[endIndex-1]
+		token := pagination.Token{Kind: pagination.KindUser, Sort: sortOrder, ID: last.UserId}
+		if sortOrder == sortByLastName {
+			token.Key = last.LastName
+		}
+		nextPageToken = token.Encode()
+	}
+
+	return users[startIndex:endIndex], nextPageToken, nil
+}
+
+// UpdateLastActive records when the user was last active
+func (r *MemDBUserRepository) UpdateLastActive(_ context.Context, userID string, lastActive time.Time) error {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("user", "id", userID)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if raw == nil {
+		txn.Abort()
+		return consts.ErrUserNotFound
 	}
 
-	return users[startIndex:endIndex], nextPageToken
+	// Objects stored in MemDB must not be modified in place, so store an updated copy
+	user := cloneUser(raw)
+	user.LastActiveAt = timestamppb.New(lastActive)
+	if err := txn.Insert("user", user); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// ListInactiveUsers returns users last active before inactiveSince, least recently active first
+func (r *MemDBUserRepository) ListInactiveUsers(_ context.Context, inactiveSince time.Time) ([]*userPbv1.User, error) {
+	txn := r.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get("user", "last_active")
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*userPbv1.User
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		user := obj.(*userPbv1.User)
+		if !user.LastActiveAt.AsTime().Before(inactiveSince) {
+			break
+		}
+		users = append(users, cloneUser(user))
+	}
+	return users, nil
+}
+
+// SetUserActive deactivates or reactivates a user and returns the updated user
+func (r *MemDBUserRepository) SetUserActive(_ context.Context, userID string, active bool) (*userPbv1.User, error) {
+	txn := r.db.Txn(true)
+
+	raw, err := txn.First("user", "id", userID)
+	if err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	if raw == nil {
+		txn.Abort()
+		return nil, consts.ErrUserNotFound
+	}
+
+	user := cloneUser(raw)
+	user.Active = active
+	if err := txn.Insert("user", user); err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	txn.Commit()
+	return cloneUser(user), nil
 }
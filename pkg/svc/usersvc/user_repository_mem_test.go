@@ -0,0 +1,247 @@
+package usersvc_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+func TestMemDBUserRepository_UpdateUserRollsBackOnFailedInsert(t *testing.T) {
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	original := &userPbv1.User{
+		UserId:       validUUID,
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		EmailAddress: "jane@example.com",
+	}
+	require.NoError(t, repo.CreateUser(context.Background(), original))
+
+	// UpdateUser deletes the existing record before inserting the replacement.
+	// An empty email is rejected by the email index, so the insert fails after
+	// the delete has already been applied inside the transaction.
+	err = repo.UpdateUser(context.Background(), &userPbv1.User{
+		UserId:    validUUID,
+		FirstName: "Broken",
+		LastName:  "Update",
+	})
+	require.Error(t, err)
+
+	stored, err := repo.GetUserByID(context.Background(), validUUID)
+	require.NoError(t, err, "user must survive a failed update")
+	assert.Equal(t, original.EmailAddress, stored.EmailAddress)
+	assert.Equal(t, original.FirstName, stored.FirstName)
+
+	users, _, err := repo.ListUsers(context.Background(), "", 10, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+}
+
+func TestMemDBUserRepository_CreateUserRollsBackOnFailedInsert(t *testing.T) {
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	// A user without an ID is rejected by the primary index
+	err = repo.CreateUser(context.Background(), &userPbv1.User{FirstName: "No", LastName: "ID", EmailAddress: "noid@example.com"})
+	require.Error(t, err)
+
+	users, _, err := repo.ListUsers(context.Background(), "", 10, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	assert.Empty(t, users)
+}
+
+func TestMemDBUserRepository_FindOrCreateUser(t *testing.T) {
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	first := &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}
+	user, created, err := repo.FindOrCreateUser(context.Background(), first)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, validUUID, user.UserId)
+
+	// Same email again returns the stored user untouched
+	second := &userPbv1.User{UserId: nonExistUUID, FirstName: "Other", LastName: "Name", EmailAddress: "jane@example.com"}
+	user, created, err = repo.FindOrCreateUser(context.Background(), second)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, validUUID, user.UserId)
+	assert.Equal(t, "Jane", user.FirstName)
+
+	byEmail, err := repo.GetUserByEmail(context.Background(), "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, validUUID, byEmail.UserId)
+
+	_, err = repo.GetUserByEmail(context.Background(), "missing@example.com")
+	assert.ErrorIs(t, err, consts.ErrUserNotFound)
+}
+
+func TestMemDBUserRepository_FindOrCreateUserConcurrent(t *testing.T) {
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	const callers = 20
+	var createdCount atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, created, err := repo.FindOrCreateUser(context.Background(), &userPbv1.User{
+				UserId:       uuid.NewString(),
+				FirstName:    "Jane",
+				LastName:     "Doe",
+				EmailAddress: "jane@example.com",
+			})
+			assert.NoError(t, err)
+			if created {
+				createdCount.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), createdCount.Load(), "exactly one caller creates the user")
+	users, _, err := repo.ListUsers(context.Background(), "", callers, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+}
+
+func TestMemDBUserRepository_ListInactiveUsers(t *testing.T) {
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	lastActive := map[string]time.Time{
+		"a@example.com": now.AddDate(0, -6, 0),
+		"b@example.com": now.AddDate(0, 0, -1),
+		"c@example.com": now.AddDate(-2, 0, 0),
+		"d@example.com": now.AddDate(0, -2, 0),
+	}
+	ids := make(map[string]string)
+	for email, at := range lastActive {
+		ids[email] = uuid.NewString()
+		require.NoError(t, repo.CreateUser(context.Background(), &userPbv1.User{
+			UserId:       ids[email],
+			FirstName:    "First",
+			LastName:     "Last",
+			EmailAddress: email,
+			LastActiveAt: timestamppb.New(at),
+		}))
+	}
+
+	// Never active users aren't indexed and so aren't reported
+	require.NoError(t, repo.CreateUser(context.Background(), &userPbv1.User{UserId: uuid.NewString(), FirstName: "New", LastName: "User", EmailAddress: "e@example.com"}))
+
+	users, err := repo.ListInactiveUsers(context.Background(), now.AddDate(0, -1, 0))
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	assert.Equal(t, "c@example.com", users[0].EmailAddress)
+	assert.Equal(t, "a@example.com", users[1].EmailAddress)
+	assert.Equal(t, "d@example.com", users[2].EmailAddress)
+
+	// Activity moves the user out of the inactive list
+	require.NoError(t, repo.UpdateLastActive(context.Background(), ids["c@example.com"], now))
+	users, err = repo.ListInactiveUsers(context.Background(), now.AddDate(0, -1, 0))
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "a@example.com", users[0].EmailAddress)
+
+	stored, err := repo.GetUserByID(context.Background(), ids["c@example.com"])
+	require.NoError(t, err)
+	assert.True(t, now.Equal(stored.LastActiveAt.AsTime()))
+
+	assert.ErrorIs(t, repo.UpdateLastActive(context.Background(), nonExistUUID, now), consts.ErrUserNotFound)
+}
+
+func TestMemDBUserRepository_ReturnsCopies(t *testing.T) {
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+
+	user := &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}
+	require.NoError(t, repo.CreateUser(context.Background(), user))
+	user.FirstName = "Changed after create"
+
+	byID, err := repo.GetUserByID(context.Background(), validUUID)
+	require.NoError(t, err)
+	byID.FirstName = "Changed by ID"
+
+	byEmail, err := repo.GetUserByEmail(context.Background(), "jane@example.com")
+	require.NoError(t, err)
+	byEmail.FirstName = "Changed by email"
+
+	existing, created, err := repo.FindOrCreateUser(context.Background(), &userPbv1.User{UserId: nonExistUUID, FirstName: "Other", LastName: "Doe", EmailAddress: "jane@example.com"})
+	require.NoError(t, err)
+	require.False(t, created)
+	existing.FirstName = "Changed by find or create"
+
+	listed, _, err := repo.ListUsers(context.Background(), "", 10, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	listed[0].FirstName = "Changed by list"
+
+	stored, err := repo.GetUserByID(context.Background(), validUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", stored.FirstName)
+}
+
+func TestMemDBUserRepository_ListUsersByLastNamePaginates(t *testing.T) {
+	repo, err := usersvc.NewMemDBUserRepository()
+	require.NoError(t, err)
+	ctx := context.Background()
+	users := []*userPbv1.User{
+		{UserId: "30000000-0000-4000-8000-000000000000", LastName: "Adams"},
+		{UserId: "10000000-0000-4000-8000-000000000000", LastName: "Baker"},
+		{UserId: "20000000-0000-4000-8000-000000000000", LastName: "Baker"},
+		{UserId: "40000000-0000-4000-8000-000000000000", LastName: "Clark"},
+	}
+	for _, user := range users {
+		user.FirstName = "User"
+		user.EmailAddress = user.UserId + "@example.com"
+		user.Active = true
+		require.NoError(t, repo.CreateUser(ctx, user))
+	}
+	byLastName := usersvc.ListUsersOptions{OrderBy: userPbv1.UserOrder_LAST_NAME}
+
+	var ids []string
+	token := ""
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 2, "paging must end")
+		page, next, err := repo.ListUsers(ctx, token, 2, byLastName)
+		require.NoError(t, err)
+		for _, user := range page {
+			ids = append(ids, user.UserId)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+
+		// The page continues after the last user of the previous page even once it is deleted
+		require.NoError(t, repo.DeleteUser(ctx, page[len(page)-1].UserId))
+	}
+	assert.Equal(t, []string{users[0].UserId, users[1].UserId, users[2].UserId, users[3].UserId}, ids)
+
+	// A token of another order fails instead of starting over
+	_, next, err := repo.ListUsers(ctx, "", 1, usersvc.ListUsersOptions{})
+	require.NoError(t, err)
+	_, _, err = repo.ListUsers(ctx, next, 1, byLastName)
+	assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+
+	// User IDs were page tokens before tokens were typed
+	_, _, err = repo.ListUsers(ctx, users[0].UserId, 2, usersvc.ListUsersOptions{})
+	assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
@@ -1,17 +1,23 @@
 package usersvc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/models"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// PostgresUserRepository implements UserRepository using GORM for PostgreSQL
+// PostgresUserRepository implements UserRepository using GORM for PostgreSQL.
+// It also backs the SQLite database type.
 type PostgresUserRepository struct {
 	db *gorm.DB
 }
@@ -22,21 +28,24 @@ func NewPostgresUserRepository(db *gorm.DB) *PostgresUserRepository {
 }
 
 // CreateUser adds a new user to the database
-func (r *PostgresUserRepository) CreateUser(user *userPbv1.User) error {
+func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *userPbv1.User) error {
 	// Convert protobuf user to model
 	dbUser := &models.User{
 		UserID:       user.UserId,
 		FirstName:    user.FirstName,
 		LastName:     user.LastName,
 		EmailAddress: user.EmailAddress,
+		LastActiveAt: optionalTimestamp(user.LastActiveAt),
+		Active:       user.Active,
+		// Stored in UTC so page tokens compare the same way in every database
+		CreatedAt: time.Now().UTC(),
 	}
 
 	// Try to create the user
-	result := r.db.Create(dbUser)
+	result := r.db.WithContext(ctx).Create(dbUser)
 	if result.Error != nil {
 		// Check for common errors
-		if strings.Contains(result.Error.Error(), "unique constraint") &&
-			strings.Contains(result.Error.Error(), "email_address") {
+		if r.isDuplicateEmail(result.Error) {
 			return consts.ErrEmailAlreadyExists
 		}
 		return fmt.Errorf("%w: %s", consts.ErrDatabaseError, result.Error.Error())
@@ -46,10 +55,10 @@ func (r *PostgresUserRepository) CreateUser(user *userPbv1.User) error {
 }
 
 // GetUserByID retrieves a user by their ID
-func (r *PostgresUserRepository) GetUserByID(userID string) (*userPbv1.User, error) {
+func (r *PostgresUserRepository) GetUserByID(ctx context.Context, userID string) (*userPbv1.User, error) {
 	var dbUser models.User
 
-	if err := r.db.Where("user_id = ?", userID).First(&dbUser).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&dbUser).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, consts.ErrUserNotFound
 		}
@@ -57,16 +66,86 @@ func (r *PostgresUserRepository) GetUserByID(userID string) (*userPbv1.User, err
 	}
 
 	// Convert database model to protobuf
-	return &userPbv1.User{
-		UserId:       dbUser.UserID,
-		FirstName:    dbUser.FirstName,
-		LastName:     dbUser.LastName,
-		EmailAddress: dbUser.EmailAddress,
-	}, nil
+	return userFromModel(dbUser), nil
+}
+
+// BatchGetUsers retrieves the users with the given IDs in one query, in the order of the IDs.
+// IDs without a user are skipped.
+func (r *PostgresUserRepository) BatchGetUsers(ctx context.Context, userIDs []string) ([]*userPbv1.User, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	// IN rather than = ANY so the query also runs on SQLite
+	var dbUsers []models.User
+	if err := r.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&dbUsers).Error; err != nil {
+		return nil, fmt.Errorf("%w: %s", consts.ErrDatabaseError, err.Error())
+	}
+
+	byID := make(map[string]*userPbv1.User, len(dbUsers))
+	for _, dbUser := range dbUsers {
+		byID[dbUser.UserID] = userFromModel(dbUser)
+	}
+	users := make([]*userPbv1.User, 0, len(dbUsers))
+	for _, userID := range userIDs {
+		if user, ok := byID[userID]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// GetUserByEmail retrieves a user by their email address
+func (r *PostgresUserRepository) GetUserByEmail(ctx context.Context, email string) (*userPbv1.User, error) {
+	var dbUser models.User
+
+	if err := r.db.WithContext(ctx).Where("email_address = ?", email).First(&dbUser).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, consts.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("%w: %s", consts.ErrDatabaseError, err.Error())
+	}
+
+	return userFromModel(dbUser), nil
 }
 
-// UpdateUser updates an existing user
-func (r *PostgresUserRepository) UpdateUser(user *userPbv1.User) error {
+// FindOrCreateUser inserts the user unless one with the same email address already exists,
+// in which case the existing user is returned. The boolean reports whether it was created.
+func (r *PostgresUserRepository) FindOrCreateUser(ctx context.Context, user *userPbv1.User) (*userPbv1.User, bool, error) {
+	var dbUser models.User
+
+	// ON CONFLICT makes the insert atomic with respect to the unique email constraint;
+	// no row is returned when the email is already taken. The raw insert skips GORM's
+	// autoCreateTime, so created_at, which users are paged by, is set here as in CreateUser.
+	result := r.db.WithContext(ctx).Raw(
+		`INSERT INTO users (user_id, first_name, last_name, email_address, last_active_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (email_address) DO NOTHING
+		RETURNING *`,
+		user.UserId, user.FirstName, user.LastName, user.EmailAddress, optionalTimestamp(user.LastActiveAt), time.Now().UTC(),
+	).Scan(&dbUser)
+	if result.Error != nil {
+		return nil, false, fmt.Errorf("%w: %s", consts.ErrDatabaseError, result.Error.Error())
+	}
+
+	if result.RowsAffected > 0 {
+		return userFromModel(dbUser), true, nil
+	}
+
+	existing, err := r.GetUserByEmail(ctx, user.EmailAddress)
+	if err != nil {
+		if errors.Is(err, consts.ErrUserNotFound) {
+			// The email belongs to a soft-deleted user, which still holds the unique constraint
+			return nil, false, consts.ErrEmailAlreadyExists
+		}
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+// UpdateUser updates the name and email address of an existing user. The fields the
+// update doesn't carry are copied onto user from the updated row.
+func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *userPbv1.User) error {
 	// Create a map for update values (excluding UserID)
 	updates := map[string]interface{}{
 		"first_name":    user.FirstName,
@@ -75,8 +154,12 @@ func (r *PostgresUserRepository) UpdateUser(user *userPbv1.User) error {
 	}
 
 	// Update user where UserID matches
-	result := r.db.Model(&models.User{}).Where("user_id = ?", user.UserId).Updates(updates)
+	var dbUser models.User
+	result := r.db.WithContext(ctx).Model(&dbUser).Clauses(clause.Returning{}).Where("user_id = ?", user.UserId).Updates(updates)
 	if result.Error != nil {
+		if r.isDuplicateEmail(result.Error) {
+			return consts.ErrEmailAlreadyExists
+		}
 		return fmt.Errorf("%w: %s", consts.ErrDatabaseError, result.Error.Error())
 	}
 
@@ -85,12 +168,15 @@ func (r *PostgresUserRepository) UpdateUser(user *userPbv1.User) error {
 		return consts.ErrUserNotFound
 	}
 
+	updated := userFromModel(dbUser)
+	user.LastActiveAt = updated.LastActiveAt
+	user.Active = updated.Active
 	return nil
 }
 
 // DeleteUser removes a user from the database
-func (r *PostgresUserRepository) DeleteUser(userID string) error {
-	result := r.db.Delete(&models.User{}, "user_id = ?", userID)
+func (r *PostgresUserRepository) DeleteUser(ctx context.Context, userID string) error {
+	result := r.db.WithContext(ctx).Delete(&models.User{}, "user_id = ?", userID)
 	if result.Error != nil {
 		return fmt.Errorf("%w: %s", consts.ErrDatabaseError, result.Error.Error())
 	}
@@ -103,34 +189,141 @@ func (r *PostgresUserRepository) DeleteUser(userID string) error {
 	return nil
 }
 
-// ListUsers retrieves a paginated list of users
-func (r *PostgresUserRepository) ListUsers(pageToken string, pageSize int) ([]*userPbv1.User, string, error) {
+// ListUsers retrieves a paginated list of users in creation order, or by last name, leaving out
+// deactivated users unless includeDeactivated is set. The page token holds the creation time,
+// or last name, and ID of the last user of the page, so users created between pages don't
+// shift the pages that follow.
+func (r *PostgresUserRepository) ListUsers(ctx context.Context, pageToken string, pageSize int, opts ListUsersOptions) ([]*userPbv1.User, string, error) {
 	var dbUsers []models.User
 
-	query := r.db.Model(&models.User{}).Limit(pageSize)
+	// Fetch one extra row to tell whether another page follows
+	query := r.db.WithContext(ctx).Model(&models.User{}).Limit(pageSize + 1)
+	order, sortOrder := "created_at, user_id", pagination.SortByCreation
+	if opts.OrderBy == userPbv1.UserOrder_LAST_NAME {
+		order, sortOrder = "last_name, user_id", sortByLastName
+	}
 	if pageToken != "" {
-		query = query.Where("user_id > ?", pageToken)
+		token, err := pagination.Decode(pageToken, pagination.KindUser, sortOrder)
+		if err != nil {
+			return nil, "", err
+		}
+		if sortOrder == sortByLastName {
+			query = query.Where("(last_name, user_id) > (?, ?)", token.Key, token.ID)
+		} else {
+			query = query.Where("(created_at, user_id) > (?, ?)", token.KeyTime(), token.ID)
+		}
+	}
+	if !opts.IncludeDeactivated {
+		query = query.Where("active = ?", true)
+	}
+	if opts.Filter != "" {
+		pattern := "%" + escapeLike(strings.ToLower(opts.Filter)) + "%"
+		query = query.Where(`(LOWER(first_name) LIKE ? ESCAPE '\' OR LOWER(last_name) LIKE ? ESCAPE '\'
+			OR LOWER(first_name || ' ' || last_name) LIKE ? ESCAPE '\' OR LOWER(email_address) LIKE ? ESCAPE '\')`,
+			pattern, pattern, pattern, pattern)
 	}
 
-	if err := query.Order("user_id").Find(&dbUsers).Error; err != nil {
+	if err := query.Order(order).Find(&dbUsers).Error; err != nil {
 		return nil, "", fmt.Errorf("%w: %s", consts.ErrDatabaseError, err.Error())
 	}
 
+	var nextPageToken string
+	if len(dbUsers) > pageSize {
+		dbUsers = dbUsers[:pageSize]
+		last := dbUsers[pageSize-1]
+		token := pagination.Token{Kind: pagination.KindUser, Sort: sortOrder, Key: pagination.TimeKey(last.CreatedAt), ID: last.UserID}
+		if sortOrder == sortByLastName {
+			token.Key = last.LastName
+		}
+		nextPageToken = token.Encode()
+	}
+
 	// Convert database models to protobuf responses
 	users := make([]*userPbv1.User, len(dbUsers))
 	for i, dbUser := range dbUsers {
-		users[i] = &userPbv1.User{
-			UserId:       dbUser.UserID,
-			FirstName:    dbUser.FirstName,
-			LastName:     dbUser.LastName,
-			EmailAddress: dbUser.EmailAddress,
-		}
+		users[i] = userFromModel(dbUser)
 	}
 
-	var nextPageToken string
-	if len(users) > 0 && len(users) == pageSize {
-		nextPageToken = users[len(users)-1].UserId
+	return users, nextPageToken, nil
+}
+
+// UpdateLastActive records when the user was last active
+func (r *PostgresUserRepository) UpdateLastActive(ctx context.Context, userID string, lastActive time.Time) error {
+	result := r.db.WithContext(ctx).Model(&models.User{}).Where("user_id = ?", userID).Update("last_active_at", lastActive)
+	if result.Error != nil {
+		return fmt.Errorf("%w: %s", consts.ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return consts.ErrUserNotFound
 	}
+	return nil
+}
 
-	return users, nextPageToken, nil
+// ListInactiveUsers returns users last active before inactiveSince, least recently active first
+func (r *PostgresUserRepository) ListInactiveUsers(ctx context.Context, inactiveSince time.Time) ([]*userPbv1.User, error) {
+	var dbUsers []models.User
+
+	if err := r.db.WithContext(ctx).Where("last_active_at < ?", inactiveSince).Order("last_active_at").Find(&dbUsers).Error; err != nil {
+		return nil, fmt.Errorf("%w: %s", consts.ErrDatabaseError, err.Error())
+	}
+
+	users := make([]*userPbv1.User, len(dbUsers))
+	for i, dbUser := range dbUsers {
+		users[i] = userFromModel(dbUser)
+	}
+	return users, nil
+}
+
+// SetUserActive deactivates or reactivates a user and returns the updated user
+func (r *PostgresUserRepository) SetUserActive(ctx context.Context, userID string, active bool) (*userPbv1.User, error) {
+	var dbUser models.User
+	result := r.db.WithContext(ctx).Model(&dbUser).Clauses(clause.Returning{}).Where("user_id = ?", userID).Update("active", active)
+	if result.Error != nil {
+		return nil, fmt.Errorf("%w: %s", consts.ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return nil, consts.ErrUserNotFound
+	}
+	return userFromModel(dbUser), nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// isDuplicateEmail reports whether err is the unique constraint on email_address rejecting an insert.
+// The dialect translates its driver's unique violation to gorm.ErrDuplicatedKey; the column is
+// then found in the message, which names it in both Postgres ("users_email_address_key")
+// and SQLite ("UNIQUE constraint failed: users.email_address").
+func (r *PostgresUserRepository) isDuplicateEmail(err error) bool {
+	translator, ok := r.db.Dialector.(gorm.ErrorTranslator)
+	if !ok || !errors.Is(translator.Translate(err), gorm.ErrDuplicatedKey) {
+		return false
+	}
+	return strings.Contains(err.Error(), "email_address")
+}
+
+// userFromModel converts a database user to its protobuf representation
+func userFromModel(dbUser models.User) *userPbv1.User {
+	user := &userPbv1.User{
+		UserId:       dbUser.UserID,
+		FirstName:    dbUser.FirstName,
+		LastName:     dbUser.LastName,
+		EmailAddress: dbUser.EmailAddress,
+		Active:       dbUser.Active,
+	}
+	if dbUser.LastActiveAt != nil {
+		user.LastActiveAt = timestamppb.New(*dbUser.LastActiveAt)
+	}
+	return user
+}
+
+// optionalTimestamp converts a protobuf timestamp to a nullable SQL value
+func optionalTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
 }
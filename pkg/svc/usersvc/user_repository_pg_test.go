@@ -0,0 +1,207 @@
+package usersvc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/database"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
+	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
+	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
+)
+
+// newSQLiteUserRepository runs the GORM user repository against a private in-memory SQLite database
+func newSQLiteUserRepository(t *testing.T) *usersvc.PostgresUserRepository {
+	t.Helper()
+
+	db, err := database.OpenSQLite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	return usersvc.NewPostgresUserRepository(db)
+}
+
+func TestPostgresUserRepository_CRUD(t *testing.T) {
+	repo := newSQLiteUserRepository(t)
+
+	user := &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}
+	require.NoError(t, repo.CreateUser(context.Background(), user))
+
+	read, err := repo.GetUserByID(context.Background(), validUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", read.EmailAddress)
+
+	byEmail, err := repo.GetUserByEmail(context.Background(), "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, validUUID, byEmail.UserId)
+
+	user.FirstName = "Janet"
+	require.NoError(t, repo.UpdateUser(context.Background(), user))
+	read, err = repo.GetUserByID(context.Background(), validUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Janet", read.FirstName)
+
+	require.NoError(t, repo.DeleteUser(context.Background(), validUUID))
+	_, err = repo.GetUserByID(context.Background(), validUUID)
+	assert.ErrorIs(t, err, consts.ErrUserNotFound)
+	assert.ErrorIs(t, repo.DeleteUser(context.Background(), validUUID), consts.ErrUserNotFound)
+	assert.ErrorIs(t, repo.UpdateUser(context.Background(), user), consts.ErrUserNotFound)
+}
+
+func TestPostgresUserRepository_CreateUserDuplicateEmail(t *testing.T) {
+	repo := newSQLiteUserRepository(t)
+
+	require.NoError(t, repo.CreateUser(context.Background(), &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}))
+
+	err := repo.CreateUser(context.Background(), &userPbv1.User{UserId: nonExistUUID, FirstName: "John", LastName: "Doe", EmailAddress: "jane@example.com"})
+	assert.ErrorIs(t, err, consts.ErrEmailAlreadyExists)
+
+	// A clashing primary key is a database error, not a duplicate email
+	err = repo.CreateUser(context.Background(), &userPbv1.User{UserId: validUUID, FirstName: "John", LastName: "Doe", EmailAddress: "john@example.com"})
+	assert.ErrorIs(t, err, consts.ErrDatabaseError)
+}
+
+func TestPostgresUserRepository_FindOrCreateUser(t *testing.T) {
+	repo := newSQLiteUserRepository(t)
+
+	created, wasCreated, err := repo.FindOrCreateUser(context.Background(), &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"})
+	require.NoError(t, err)
+	assert.True(t, wasCreated)
+	assert.Equal(t, validUUID, created.UserId)
+
+	found, wasCreated, err := repo.FindOrCreateUser(context.Background(), &userPbv1.User{UserId: nonExistUUID, FirstName: "Other", LastName: "Name", EmailAddress: "jane@example.com"})
+	require.NoError(t, err)
+	assert.False(t, wasCreated)
+	assert.Equal(t, validUUID, found.UserId)
+	assert.Equal(t, "Jane", found.FirstName)
+}
+
+func TestPostgresUserRepository_ListUsersPaginates(t *testing.T) {
+	repo := newSQLiteUserRepository(t)
+
+	ids := []string{
+		"10000000-0000-4000-8000-000000000000",
+		"20000000-0000-4000-8000-000000000000",
+		"30000000-0000-4000-8000-000000000000",
+	}
+	for i, id := range ids {
+		require.NoError(t, repo.CreateUser(context.Background(), &userPbv1.User{
+			UserId:       id,
+			FirstName:    "User",
+			LastName:     "Test",
+			EmailAddress: id + "@example.com",
+			LastActiveAt: timestamppb.New(time.Now().Add(-time.Duration(i) * 24 * time.Hour)),
+		}))
+	}
+
+	page, next, err := repo.ListUsers(context.Background(), "", 2, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, ids[1], page[1].UserId)
+	require.NotEmpty(t, next)
+
+	page, next, err = repo.ListUsers(context.Background(), next, 2, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, ids[2], page[0].UserId)
+	assert.Empty(t, next)
+
+	inactive, err := repo.ListInactiveUsers(context.Background(), time.Now().Add(-12*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, inactive, 2)
+	assert.Equal(t, ids[2], inactive[0].UserId)
+
+	require.NoError(t, repo.UpdateLastActive(context.Background(), ids[2], time.Now()))
+	inactive, err = repo.ListInactiveUsers(context.Background(), time.Now().Add(-12*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, inactive, 1)
+	assert.Equal(t, ids[1], inactive[0].UserId)
+}
+
+func TestPostgresUserRepository_ListUsersInCreationOrder(t *testing.T) {
+	repo := newSQLiteUserRepository(t)
+	ctx := context.Background()
+	create := func(userID string) {
+		t.Helper()
+		require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: userID, FirstName: "User", LastName: "Test", EmailAddress: userID + "@example.com"}))
+	}
+	listIDs := func(token string) ([]string, string) {
+		t.Helper()
+		users, next, err := repo.ListUsers(ctx, token, 2, usersvc.ListUsersOptions{IncludeDeactivated: true})
+		require.NoError(t, err)
+		ids := make([]string, len(users))
+		for i, user := range users {
+			ids[i] = user.UserId
+		}
+		return ids, next
+	}
+
+	// The IDs sort in a different order than the users are created in
+	ids := []string{
+		"30000000-0000-4000-8000-000000000000",
+		"10000000-0000-4000-8000-000000000000",
+		"40000000-0000-4000-8000-000000000000",
+	}
+	for _, id := range ids {
+		create(id)
+	}
+
+	page, next := listIDs("")
+	assert.Equal(t, ids[:2], page)
+	require.NotEmpty(t, next)
+
+	// A user whose ID sorts before the rest doesn't shift the pages that follow
+	create("20000000-0000-4000-8000-000000000000")
+
+	page, next = listIDs(next)
+	assert.Equal(t, []string{ids[2], "20000000-0000-4000-8000-000000000000"}, page)
+	assert.Empty(t, next)
+
+	_, _, err := repo.ListUsers(ctx, ids[0], 2, usersvc.ListUsersOptions{})
+	assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
+
+func TestPostgresUserRepository_SetUserActive(t *testing.T) {
+	repo := newSQLiteUserRepository(t)
+	ctx := context.Background()
+
+	lastActive := timestamppb.New(time.Now().Add(-time.Hour).Truncate(time.Second))
+	require.NoError(t, repo.CreateUser(ctx, &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com", LastActiveAt: lastActive, Active: true}))
+
+	deactivated, err := repo.SetUserActive(ctx, validUUID, false)
+	require.NoError(t, err)
+	assert.False(t, deactivated.Active)
+	assert.Equal(t, "jane@example.com", deactivated.EmailAddress)
+
+	users, _, err := repo.ListUsers(ctx, "", 10, usersvc.ListUsersOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, users)
+	users, _, err = repo.ListUsers(ctx, "", 10, usersvc.ListUsersOptions{IncludeDeactivated: true})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.False(t, users[0].Active)
+
+	// An update leaves the fields it doesn't carry as they are, and reports them back
+	update := &userPbv1.User{UserId: validUUID, FirstName: "Janet", LastName: "Doe", EmailAddress: "jane@example.com"}
+	require.NoError(t, repo.UpdateUser(ctx, update))
+	assert.False(t, update.Active)
+	assert.True(t, lastActive.AsTime().Equal(update.LastActiveAt.AsTime()))
+
+	reactivated, err := repo.SetUserActive(ctx, validUUID, true)
+	require.NoError(t, err)
+	assert.True(t, reactivated.Active)
+	assert.Equal(t, "Janet", reactivated.FirstName)
+
+	_, err = repo.SetUserActive(ctx, nonExistUUID, false)
+	assert.ErrorIs(t, err, consts.ErrUserNotFound)
+}
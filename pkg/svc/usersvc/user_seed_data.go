@@ -1,6 +1,7 @@
 package usersvc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/yasindce1998/issue-tracker/logger"
@@ -10,7 +11,7 @@ import (
 )
 
 // SeedUsers generates random user data and adds it to the repository
-func SeedUsers(repo UserRepository, count int) error {
+func SeedUsers(ctx context.Context, repo UserRepository, count int) error {
 	logger.ZapLogger.Info("Seeding user data", zap.Int("count", count))
 
 	for i := 0; i < count; i++ {
@@ -20,10 +21,11 @@ func SeedUsers(repo UserRepository, count int) error {
 			FirstName:    gofakeit.FirstName(),
 			LastName:     gofakeit.LastName(),
 			EmailAddress: gofakeit.Email(),
+			Active:       true,
 		}
 
 		// Add to repository
-		err := repo.CreateUser(user)
+		err := repo.CreateUser(ctx, user)
 		if err != nil {
 			logger.ZapLogger.Error("Failed to seed user",
 				zap.String("email", user.EmailAddress),
@@ -3,29 +3,53 @@ package usersvc
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/yasindce1998/issue-tracker/consts"
+	"github.com/yasindce1998/issue-tracker/logger"
+	"github.com/yasindce1998/issue-tracker/pkg/i18n"
+	"github.com/yasindce1998/issue-tracker/pkg/pagination"
 	userPbv1 "github.com/yasindce1998/issue-tracker/pkg/pb/user/v1"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// maxListUsersFilterLength is the longest ListUsers filter accepted, as in user.proto
+const maxListUsersFilterLength = 100
+
+// AssignedIssues counts and reassigns the open issues of a user, so users aren't deleted while
+// issues are still assigned to them. Open issues are those that aren't closed.
+type AssignedIssues interface {
+	CountOpenIssuesByAssignee(ctx context.Context, assigneeID string) (int, error)
+	ReassignOpenIssues(ctx context.Context, fromAssigneeID, toAssigneeID string) ([]string, error)
+}
+
 // UserService serves as the application/gRPC service interface
 type UserService struct {
 	userPbv1.UnimplementedUserServiceServer
-	repository UserRepository
+	repository     UserRepository
+	assignedIssues AssignedIssues
+	pageSizes      pagination.Config
 }
 
 // NewUserService initializes the service with a repository
 func NewUserService(repository UserRepository) *UserService {
-	return &UserService{repository: repository}
+	return &UserService{repository: repository, pageSizes: pagination.ConfigFromEnv()}
+}
+
+// SetAssignedIssues configures the service with the issues DeleteUser checks and reassigns.
+// Without it, users are deleted regardless of their issues.
+func (s *UserService) SetAssignedIssues(assignedIssues AssignedIssues) {
+	s.assignedIssues = assignedIssues
 }
 
 // CreateUser creates a new user
-func (s *UserService) CreateUser(_ context.Context, req *userPbv1.CreateUserRequest) (*userPbv1.CreateUserResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+func (s *UserService) CreateUser(ctx context.Context, req *userPbv1.CreateUserRequest) (*userPbv1.CreateUserResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
 
 	user := &userPbv1.User{
@@ -33,9 +57,11 @@ func (s *UserService) CreateUser(_ context.Context, req *userPbv1.CreateUserRequ
 		FirstName:    req.FirstName,
 		LastName:     req.LastName,
 		EmailAddress: req.EmailAddress,
+		LastActiveAt: timestamppb.Now(),
+		Active:       true,
 	}
 
-	if err := s.repository.CreateUser(user); err != nil {
+	if err := s.repository.CreateUser(ctx, user); err != nil {
 		if errors.Is(err, consts.ErrEmailAlreadyExists) {
 			return nil, status.Error(codes.AlreadyExists, "email already exists")
 		}
@@ -45,13 +71,38 @@ func (s *UserService) CreateUser(_ context.Context, req *userPbv1.CreateUserRequ
 	return &userPbv1.CreateUserResponse{User: user}, nil
 }
 
+// FindOrCreateUser returns the user with the given email address, creating it if it doesn't exist.
+// It is idempotent, which makes it suitable for identity providers pushing user data.
+func (s *UserService) FindOrCreateUser(ctx context.Context, req *userPbv1.FindOrCreateUserRequest) (*userPbv1.FindOrCreateUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	user, created, err := s.repository.FindOrCreateUser(ctx, &userPbv1.User{
+		UserId:       uuid.NewString(),
+		FirstName:    req.FirstName,
+		LastName:     req.LastName,
+		EmailAddress: req.EmailAddress,
+		LastActiveAt: timestamppb.Now(),
+		Active:       true,
+	})
+	if err != nil {
+		if errors.Is(err, consts.ErrEmailAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "email already exists")
+		}
+		return nil, status.Error(codes.Internal, "failed to find or create user")
+	}
+
+	return &userPbv1.FindOrCreateUserResponse{User: user, Created: created}, nil
+}
+
 // GetUser retrieves a user by ID
-func (s *UserService) GetUser(_ context.Context, req *userPbv1.GetUserRequest) (*userPbv1.GetUserResponse, error) {
+func (s *UserService) GetUser(ctx context.Context, req *userPbv1.GetUserRequest) (*userPbv1.GetUserResponse, error) {
 	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
 
-	user, err := s.repository.GetUserByID(req.UserId)
+	user, err := s.repository.GetUserByID(ctx, req.UserId)
 	if err != nil {
 		if errors.Is(err, consts.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
@@ -59,13 +110,29 @@ func (s *UserService) GetUser(_ context.Context, req *userPbv1.GetUserRequest) (
 		return nil, status.Error(codes.Internal, "failed to retrieve user")
 	}
 
+	s.recordActivity(ctx, user.UserId)
+
 	return &userPbv1.GetUserResponse{User: user}, nil
 }
 
+// recordActivity updates the user's last active time in the background so GetUser doesn't wait on a write.
+// The write keeps the request's values but not its cancellation, since the request finishes first.
+func (s *UserService) recordActivity(ctx context.Context, userID string) {
+	now := time.Now()
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.repository.UpdateLastActive(ctx, userID, now); err != nil {
+			logger.ZapLogger.Warn("Failed to update user last active time",
+				zap.String("user_id", userID),
+				zap.Error(err))
+		}
+	}()
+}
+
 // UpdateUser updates an existing user
-func (s *UserService) UpdateUser(_ context.Context, req *userPbv1.UpdateUserRequest) (*userPbv1.UpdateUserResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+func (s *UserService) UpdateUser(ctx context.Context, req *userPbv1.UpdateUserRequest) (*userPbv1.UpdateUserResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
 
 	user := &userPbv1.User{
@@ -75,7 +142,7 @@ func (s *UserService) UpdateUser(_ context.Context, req *userPbv1.UpdateUserRequ
 		EmailAddress: req.EmailAddress,
 	}
 
-	if err := s.repository.UpdateUser(user); err != nil {
+	if err := s.repository.UpdateUser(ctx, user); err != nil {
 		if errors.Is(err, consts.ErrEmailAlreadyExists) {
 			return nil, status.Error(codes.AlreadyExists, "email already exists")
 		}
@@ -88,13 +155,18 @@ func (s *UserService) UpdateUser(_ context.Context, req *userPbv1.UpdateUserRequ
 	return &userPbv1.UpdateUserResponse{User: user}, nil
 }
 
-// DeleteUser removes a user
-func (s *UserService) DeleteUser(_ context.Context, req *userPbv1.DeleteUserRequest) (*userPbv1.DeleteUserResponse, error) {
+// DeleteUser removes a user. It is refused while the user is assigned to open issues, unless
+// ReassignTo names an active user to move them to first.
+func (s *UserService) DeleteUser(ctx context.Context, req *userPbv1.DeleteUserRequest) (*userPbv1.DeleteUserResponse, error) {
 	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		return nil, i18n.InvalidRequest(ctx, err)
 	}
 
-	err := s.repository.DeleteUser(req.UserId)
+	if err := s.releaseAssignedIssues(ctx, req.UserId, req.ReassignTo); err != nil {
+		return nil, err
+	}
+
+	err := s.repository.DeleteUser(ctx, req.UserId)
 	if err != nil {
 		if errors.Is(err, consts.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
@@ -105,14 +177,113 @@ func (s *UserService) DeleteUser(_ context.Context, req *userPbv1.DeleteUserRequ
 	return &userPbv1.DeleteUserResponse{}, nil
 }
 
-// ListUsers retrieves a paginated list of users
-func (s *UserService) ListUsers(_ context.Context, req *userPbv1.ListUsersRequest) (*userPbv1.ListUsersResponse, error) {
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 {
-		pageSize = 10
+// releaseAssignedIssues moves the open issues of a user about to be deleted to reassignTo, if
+// given, and fails with FailedPrecondition if any are still assigned to the user
+func (s *UserService) releaseAssignedIssues(ctx context.Context, userID, reassignTo string) error {
+	if s.assignedIssues == nil {
+		return nil
 	}
 
-	users, nextPageToken, err := s.repository.ListUsers(req.PageToken, pageSize)
+	if reassignTo != "" {
+		if reassignTo == userID {
+			return status.Error(codes.InvalidArgument, "cannot reassign issues to the user being deleted")
+		}
+		if _, err := s.repository.GetUserByID(ctx, userID); err != nil {
+			if errors.Is(err, consts.ErrUserNotFound) {
+				return status.Error(codes.NotFound, "user not found")
+			}
+			return status.Error(codes.Internal, "failed to retrieve user")
+		}
+
+		target, err := s.repository.GetUserByID(ctx, reassignTo)
+		if err != nil {
+			if errors.Is(err, consts.ErrUserNotFound) {
+				return status.Error(codes.InvalidArgument, "user to reassign issues to not found")
+			}
+			return status.Error(codes.Internal, "failed to retrieve user to reassign issues to")
+		}
+		if !target.Active {
+			return status.Error(codes.FailedPrecondition, "user to reassign issues to is deactivated")
+		}
+
+		reassigned, err := s.assignedIssues.ReassignOpenIssues(ctx, userID, reassignTo)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to reassign issues")
+		}
+		logger.ZapLogger.Info("Reassigned open issues of deleted user",
+			zap.String("user_id", userID),
+			zap.String("reassign_to", reassignTo),
+			zap.Int("count", len(reassigned)))
+	}
+
+	// Issues assigned after the check aren't caught; they keep the ID of the deleted user
+	open, err := s.assignedIssues.CountOpenIssuesByAssignee(ctx, userID)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to count assigned issues")
+	}
+	if open > 0 {
+		return status.Errorf(codes.FailedPrecondition,
+			"user is assigned to %d open issues; reassign them with reassign_to or deactivate the user instead", open)
+	}
+	return nil
+}
+
+// DeactivateUser marks a user as deactivated. Deactivated users can't be assigned to issues and
+// are left out of ListUsers by default, but can still be fetched to display existing issues.
+func (s *UserService) DeactivateUser(ctx context.Context, req *userPbv1.DeactivateUserRequest) (*userPbv1.DeactivateUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	user, err := s.repository.SetUserActive(ctx, req.UserId, false)
+	if err != nil {
+		if errors.Is(err, consts.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to deactivate user")
+	}
+
+	return &userPbv1.DeactivateUserResponse{User: user}, nil
+}
+
+// ReactivateUser makes a deactivated user active again
+func (s *UserService) ReactivateUser(ctx context.Context, req *userPbv1.ReactivateUserRequest) (*userPbv1.ReactivateUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	user, err := s.repository.SetUserActive(ctx, req.UserId, true)
+	if err != nil {
+		if errors.Is(err, consts.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to reactivate user")
+	}
+
+	return &userPbv1.ReactivateUserResponse{User: user}, nil
+}
+
+// ListUsers retrieves a paginated list of users; deactivated users are included only if requested.
+// Like ListIssues, it rejects page sizes above MAX_PAGE_SIZE.
+func (s *UserService) ListUsers(ctx context.Context, req *userPbv1.ListUsersRequest) (*userPbv1.ListUsersResponse, error) {
+	if err := s.pageSizes.CheckPageSize(req.PageSize); err != nil {
+		return nil, err
+	}
+	if len(req.Filter) > maxListUsersFilterLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: filter must be at most %d characters", maxListUsersFilterLength)
+	}
+	if _, ok := userPbv1.UserOrder_name[int32(req.OrderBy)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: unknown order_by %d", req.OrderBy)
+	}
+
+	users, nextPageToken, err := s.repository.ListUsers(ctx, req.PageToken, s.pageSizes.PageSize(req.PageSize), ListUsersOptions{
+		IncludeDeactivated: req.IncludeDeactivated,
+		Filter:             req.Filter,
+		OrderBy:            req.OrderBy,
+	})
+	if errors.Is(err, pagination.ErrInvalidPageToken) {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: invalid page token")
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to list users")
 	}
@@ -122,3 +293,51 @@ func (s *UserService) ListUsers(_ context.Context, req *userPbv1.ListUsersReques
 		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// BatchGetUsers retrieves several users in one call. Repeated IDs are only looked up once,
+// and IDs without a user are reported in NotFoundIds.
+func (s *UserService) BatchGetUsers(ctx context.Context, req *userPbv1.BatchGetUsersRequest) (*userPbv1.BatchGetUsersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	seen := make(map[string]bool, len(req.UserIds))
+	userIDs := make([]string, 0, len(req.UserIds))
+	for _, userID := range req.UserIds {
+		if !seen[userID] {
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	users, err := s.repository.BatchGetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get users")
+	}
+
+	found := make(map[string]bool, len(users))
+	for _, user := range users {
+		found[user.UserId] = true
+	}
+	resp := &userPbv1.BatchGetUsersResponse{Users: users}
+	for _, userID := range userIDs {
+		if !found[userID] {
+			resp.NotFoundIds = append(resp.NotFoundIds, userID)
+		}
+	}
+	return resp, nil
+}
+
+// ListInactiveUsers lists users who haven't been active since the given time, least recently active first
+func (s *UserService) ListInactiveUsers(ctx context.Context, req *userPbv1.ListInactiveUsersRequest) (*userPbv1.ListInactiveUsersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, i18n.InvalidRequest(ctx, err)
+	}
+
+	users, err := s.repository.ListInactiveUsers(ctx, req.InactiveSince.AsTime())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list inactive users")
+	}
+
+	return &userPbv1.ListInactiveUsersResponse{Users: users}, nil
+}
@@ -2,7 +2,9 @@ package usersvc_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/yasindce1998/issue-tracker/consts"
 	"github.com/yasindce1998/issue-tracker/mocks"
@@ -10,9 +12,11 @@ import (
 	"github.com/yasindce1998/issue-tracker/pkg/svc/usersvc"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -47,7 +51,7 @@ func TestUserServiceServer_CreateUser(t *testing.T) {
 				EmailAddress: gofakeit.Email(),
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().CreateUser(gomock.Any()).DoAndReturn(func(user *userPbv1.User) error {
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user *userPbv1.User) error {
 					user.UserId = validUUID
 					return nil
 				})
@@ -68,7 +72,7 @@ func TestUserServiceServer_CreateUser(t *testing.T) {
 				EmailAddress: gofakeit.Email(),
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().CreateUser(gomock.Any()).Return(consts.ErrEmailAlreadyExists)
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(consts.ErrEmailAlreadyExists)
 			},
 			expectedResp:  nil,
 			expectedError: status.Error(codes.AlreadyExists, "email already exists"),
@@ -81,7 +85,7 @@ func TestUserServiceServer_CreateUser(t *testing.T) {
 				EmailAddress: gofakeit.Email(),
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().CreateUser(gomock.Any()).Return(consts.ErrDatabaseError)
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
 			expectedError: status.Error(codes.Internal, "failed to create user"),
@@ -140,12 +144,14 @@ func TestUserServiceServer_GetUser(t *testing.T) {
 				UserId: validUUID,
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().GetUserByID(validUUID).Return(&userPbv1.User{
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), validUUID).Return(&userPbv1.User{
 					UserId:       validUUID,
 					FirstName:    "John",
 					LastName:     "Doe",
 					EmailAddress: "john.doe@example.com",
 				}, nil)
+				// Recorded in the background; covered by TestUserServiceServer_GetUserRecordsActivity
+				mockRepo.EXPECT().UpdateLastActive(gomock.Any(), validUUID, gomock.Any()).Return(nil).AnyTimes()
 			},
 			expectedResp: &userPbv1.GetUserResponse{
 				User: &userPbv1.User{
@@ -163,7 +169,7 @@ func TestUserServiceServer_GetUser(t *testing.T) {
 				UserId: nonExistUUID,
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().GetUserByID(nonExistUUID).Return(nil, consts.ErrUserNotFound)
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), nonExistUUID).Return(nil, consts.ErrUserNotFound)
 			},
 			expectedResp:  nil,
 			expectedError: status.Error(codes.NotFound, "user not found"),
@@ -174,7 +180,7 @@ func TestUserServiceServer_GetUser(t *testing.T) {
 				UserId: validUUID,
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().GetUserByID(validUUID).Return(nil, consts.ErrDatabaseError)
+				mockRepo.EXPECT().GetUserByID(gomock.Any(), validUUID).Return(nil, consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
 			expectedError: status.Error(codes.Internal, "failed to retrieve user"),
@@ -202,6 +208,68 @@ func TestUserServiceServer_GetUser(t *testing.T) {
 	}
 }
 
+func TestUserServiceServer_GetUserRecordsActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	userService := usersvc.NewUserService(mockRepo)
+
+	// The repository write is held up, which must not delay the response
+	release := make(chan struct{})
+	recorded := make(chan time.Time, 1)
+	mockRepo.EXPECT().GetUserByID(gomock.Any(), validUUID).Return(&userPbv1.User{UserId: validUUID}, nil)
+	mockRepo.EXPECT().UpdateLastActive(gomock.Any(), validUUID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, lastActive time.Time) error {
+		<-release
+		recorded <- lastActive
+		return nil
+	})
+
+	before := time.Now()
+	resp, err := userService.GetUser(context.Background(), &userPbv1.GetUserRequest{UserId: validUUID})
+	assert.NoError(t, err)
+	assert.Equal(t, validUUID, resp.User.UserId)
+	close(release)
+
+	select {
+	case lastActive := <-recorded:
+		assert.False(t, lastActive.Before(before))
+	case <-time.After(time.Second):
+		t.Fatal("last active time was not recorded")
+	}
+}
+
+func TestUserServiceServer_ListInactiveUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	userService := usersvc.NewUserService(mockRepo)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	inactive := []*userPbv1.User{{UserId: validUUID, LastActiveAt: timestamppb.New(since.AddDate(0, -3, 0))}}
+
+	t.Run("passes the cutoff to the repository", func(t *testing.T) {
+		mockRepo.EXPECT().ListInactiveUsers(gomock.Any(), since).Return(inactive, nil)
+
+		resp, err := userService.ListInactiveUsers(context.Background(), &userPbv1.ListInactiveUsersRequest{InactiveSince: timestamppb.New(since)})
+		assert.NoError(t, err)
+		assert.Equal(t, inactive, resp.Users)
+	})
+
+	t.Run("requires a cutoff", func(t *testing.T) {
+		_, err := userService.ListInactiveUsers(context.Background(), &userPbv1.ListInactiveUsersRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		mockRepo.EXPECT().ListInactiveUsers(gomock.Any(), since).Return(nil, consts.ErrDatabaseError)
+
+		_, err := userService.ListInactiveUsers(context.Background(), &userPbv1.ListInactiveUsersRequest{InactiveSince: timestamppb.New(since)})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
 func TestUserServiceServer_UpdateUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -225,7 +293,7 @@ func TestUserServiceServer_UpdateUser(t *testing.T) {
 				EmailAddress: "updated.email@example.com",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().UpdateUser(&userPbv1.User{
+				mockRepo.EXPECT().UpdateUser(gomock.Any(), &userPbv1.User{
 					UserId:       validUUID,
 					FirstName:    "UpdatedFirstName",
 					LastName:     "UpdatedLastName",
@@ -251,7 +319,7 @@ func TestUserServiceServer_UpdateUser(t *testing.T) {
 				EmailAddress: "updated.email@example.com",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().UpdateUser(&userPbv1.User{
+				mockRepo.EXPECT().UpdateUser(gomock.Any(), &userPbv1.User{
 					UserId:       nonExistUUID,
 					FirstName:    "UpdatedFirstName",
 					LastName:     "UpdatedLastName",
@@ -270,7 +338,7 @@ func TestUserServiceServer_UpdateUser(t *testing.T) {
 				EmailAddress: "existing.email@example.com",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().UpdateUser(&userPbv1.User{
+				mockRepo.EXPECT().UpdateUser(gomock.Any(), &userPbv1.User{
 					UserId:       validUUID,
 					FirstName:    "UpdatedFirstName",
 					LastName:     "UpdatedLastName",
@@ -289,7 +357,7 @@ func TestUserServiceServer_UpdateUser(t *testing.T) {
 				EmailAddress: "updated.email@example.com",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().UpdateUser(&userPbv1.User{
+				mockRepo.EXPECT().UpdateUser(gomock.Any(), &userPbv1.User{
 					UserId:       validUUID,
 					FirstName:    "UpdatedFirstName",
 					LastName:     "UpdatedLastName",
@@ -343,7 +411,7 @@ func TestUserServiceServer_DeleteUser(t *testing.T) {
 			},
 			setupMock: func() {
 				// Mock the repository to successfully delete the user
-				mockRepo.EXPECT().DeleteUser(validUUID).Return(nil)
+				mockRepo.EXPECT().DeleteUser(gomock.Any(), validUUID).Return(nil)
 			},
 			expectedResp:  &userPbv1.DeleteUserResponse{},
 			expectedError: nil,
@@ -355,7 +423,7 @@ func TestUserServiceServer_DeleteUser(t *testing.T) {
 			},
 			setupMock: func() {
 				// Mock the repository to return ErrUserNotFound
-				mockRepo.EXPECT().DeleteUser(nonExistUUID).Return(consts.ErrUserNotFound)
+				mockRepo.EXPECT().DeleteUser(gomock.Any(), nonExistUUID).Return(consts.ErrUserNotFound)
 			},
 			expectedResp:  nil,
 			expectedError: status.Error(codes.NotFound, "user not found"),
@@ -367,7 +435,7 @@ func TestUserServiceServer_DeleteUser(t *testing.T) {
 			},
 			setupMock: func() {
 				// Mock the repository to return a generic internal error
-				mockRepo.EXPECT().DeleteUser(validUUID).Return(consts.ErrDatabaseError)
+				mockRepo.EXPECT().DeleteUser(gomock.Any(), validUUID).Return(consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
 			expectedError: status.Error(codes.Internal, "failed to delete user"),
@@ -404,6 +472,10 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	// Use the default page sizes of 10 and 100
+	t.Setenv("DEFAULT_PAGE_SIZE", "")
+	t.Setenv("MAX_PAGE_SIZE", "")
+
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	userService := usersvc.NewUserService(mockRepo)
 
@@ -436,7 +508,7 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 				PageToken: "",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ListUsers("", 2).Return(validUsers, "next-token", nil)
+				mockRepo.EXPECT().ListUsers(gomock.Any(), "", 2, usersvc.ListUsersOptions{}).Return(validUsers, "next-token", nil)
 			},
 			expectedResp: &userPbv1.ListUsersResponse{
 				Users:         validUsers,
@@ -451,7 +523,7 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 				PageToken: "",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ListUsers("", 10).Return(validUsers, "next-token", nil)
+				mockRepo.EXPECT().ListUsers(gomock.Any(), "", 10, usersvc.ListUsersOptions{}).Return(validUsers, "next-token", nil)
 			},
 			expectedResp: &userPbv1.ListUsersResponse{
 				Users:         validUsers,
@@ -466,7 +538,7 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 				PageToken: "",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ListUsers("", 10).Return([]*userPbv1.User{}, "", nil)
+				mockRepo.EXPECT().ListUsers(gomock.Any(), "", 10, usersvc.ListUsersOptions{}).Return([]*userPbv1.User{}, "", nil)
 			},
 			expectedResp: &userPbv1.ListUsersResponse{
 				Users:         []*userPbv1.User{}, // Empty list
@@ -481,7 +553,7 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 				PageToken: "user-2",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ListUsers("user-2", 2).Return(validUsers, "next-token-2", nil)
+				mockRepo.EXPECT().ListUsers(gomock.Any(), "user-2", 2, usersvc.ListUsersOptions{}).Return(validUsers, "next-token-2", nil)
 			},
 			expectedResp: &userPbv1.ListUsersResponse{
 				Users:         validUsers,
@@ -489,6 +561,72 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "Filter and Order",
+			req: &userPbv1.ListUsersRequest{
+				PageSize: 10,
+				Filter:   "doe",
+				OrderBy:  userPbv1.UserOrder_LAST_NAME,
+			},
+			setupMock: func() {
+				mockRepo.EXPECT().ListUsers(gomock.Any(), "", 10, usersvc.ListUsersOptions{Filter: "doe", OrderBy: userPbv1.UserOrder_LAST_NAME}).Return(validUsers[:1], "", nil)
+			},
+			expectedResp: &userPbv1.ListUsersResponse{
+				Users: validUsers[:1],
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Page Size At Maximum",
+			req: &userPbv1.ListUsersRequest{
+				PageSize: 100,
+			},
+			setupMock: func() {
+				mockRepo.EXPECT().ListUsers(gomock.Any(), "", 100, usersvc.ListUsersOptions{}).Return(validUsers, "", nil)
+			},
+			expectedResp: &userPbv1.ListUsersResponse{
+				Users: validUsers,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Page Size Above Maximum",
+			req: &userPbv1.ListUsersRequest{
+				PageSize: 101,
+			},
+			setupMock:     func() {},
+			expectedResp:  nil,
+			expectedError: status.Error(codes.InvalidArgument, "invalid request: page_size must be between 0 and 100"),
+		},
+		{
+			name: "Negative Page Size",
+			req: &userPbv1.ListUsersRequest{
+				PageSize: -1,
+			},
+			setupMock:     func() {},
+			expectedResp:  nil,
+			expectedError: status.Error(codes.InvalidArgument, "invalid request: page_size must be between 0 and 100"),
+		},
+		{
+			name: "Filter Too Long",
+			req: &userPbv1.ListUsersRequest{
+				PageSize: 10,
+				Filter:   strings.Repeat("a", 101),
+			},
+			setupMock:     func() {},
+			expectedResp:  nil,
+			expectedError: status.Error(codes.InvalidArgument, "invalid request: filter must be at most 100 characters"),
+		},
+		{
+			name: "Unknown Order",
+			req: &userPbv1.ListUsersRequest{
+				PageSize: 10,
+				OrderBy:  userPbv1.UserOrder(9),
+			},
+			setupMock:     func() {},
+			expectedResp:  nil,
+			expectedError: status.Error(codes.InvalidArgument, "invalid request: unknown order_by 9"),
+		},
 		{
 			name: "Internal Error",
 			req: &userPbv1.ListUsersRequest{
@@ -496,7 +634,7 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 				PageToken: "",
 			},
 			setupMock: func() {
-				mockRepo.EXPECT().ListUsers("", 10).Return(nil, "", consts.ErrDatabaseError)
+				mockRepo.EXPECT().ListUsers(gomock.Any(), "", 10, usersvc.ListUsersOptions{}).Return(nil, "", consts.ErrDatabaseError)
 			},
 			expectedResp:  nil,
 			expectedError: status.Error(codes.Internal, "failed to list users"),
@@ -530,6 +668,35 @@ func TestUserServiceServer_ListUsers(t *testing.T) {
 	}
 }
 
+func TestUserServiceServer_BatchGetUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	userService := usersvc.NewUserService(mockRepo)
+	user := &userPbv1.User{UserId: validUUID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane@example.com"}
+
+	// Repeated IDs are looked up once; missing ones are reported rather than failing the call
+	mockRepo.EXPECT().BatchGetUsers(gomock.Any(), []string{validUUID, nonExistUUID}).Return([]*userPbv1.User{user}, nil)
+	resp, err := userService.BatchGetUsers(context.Background(), &userPbv1.BatchGetUsersRequest{UserIds: []string{validUUID, nonExistUUID, validUUID}})
+	require.NoError(t, err)
+	assert.Equal(t, []*userPbv1.User{user}, resp.Users)
+	assert.Equal(t, []string{nonExistUUID}, resp.NotFoundIds)
+
+	tooMany := make([]string, 101)
+	for i := range tooMany {
+		tooMany[i] = validUUID
+	}
+	_, err = userService.BatchGetUsers(context.Background(), &userPbv1.BatchGetUsersRequest{UserIds: tooMany})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = userService.BatchGetUsers(context.Background(), &userPbv1.BatchGetUsersRequest{UserIds: []string{"not-a-uuid"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockRepo.EXPECT().BatchGetUsers(gomock.Any(), []string{validUUID}).Return(nil, consts.ErrDatabaseError)
+	_, err = userService.BatchGetUsers(context.Background(), &userPbv1.BatchGetUsersRequest{UserIds: []string{validUUID}})
+	assert.EqualError(t, err, status.Error(codes.Internal, "failed to get users").Error())
+}
+
 // Helper function to validate a user response
 func validateUserResponse(t *testing.T, expected *userPbv1.User, actual *userPbv1.User) {
 	if expected != nil {
@@ -550,3 +717,82 @@ func validateError(t *testing.T, expectedErr, actualErr error) {
 		assert.NoError(t, actualErr)
 	}
 }
+
+func TestUserServiceServer_FindOrCreateUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	userService := usersvc.NewUserService(mockRepo)
+
+	existing := &userPbv1.User{
+		UserId:       validUUID,
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		EmailAddress: "jane@example.com",
+	}
+
+	testCases := []struct {
+		name            string
+		req             *userPbv1.FindOrCreateUserRequest
+		setupMock       func()
+		expectedCreated bool
+		expectedUserID  string
+		expectedError   error
+	}{
+		{
+			name: "Creates a new user",
+			req:  &userPbv1.FindOrCreateUserRequest{FirstName: "John", LastName: "Smith", EmailAddress: "john@example.com"},
+			setupMock: func() {
+				mockRepo.EXPECT().FindOrCreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user *userPbv1.User) (*userPbv1.User, bool, error) {
+					assert.NotEmpty(t, user.UserId)
+					assert.Equal(t, "john@example.com", user.EmailAddress)
+					user.UserId = nonExistUUID
+					return user, true, nil
+				})
+			},
+			expectedCreated: true,
+			expectedUserID:  nonExistUUID,
+		},
+		{
+			name: "Returns the existing user",
+			req:  &userPbv1.FindOrCreateUserRequest{FirstName: "Other", LastName: "Name", EmailAddress: existing.EmailAddress},
+			setupMock: func() {
+				mockRepo.EXPECT().FindOrCreateUser(gomock.Any(), gomock.Any()).Return(existing, false, nil)
+			},
+			expectedCreated: false,
+			expectedUserID:  validUUID,
+		},
+		{
+			name:          "Invalid email",
+			req:           &userPbv1.FindOrCreateUserRequest{FirstName: "John", LastName: "Smith", EmailAddress: "not-an-email"},
+			setupMock:     func() {},
+			expectedError: status.Error(codes.InvalidArgument, "invalid request: invalid FindOrCreateUserRequest.EmailAddress: value must be a valid email address | caused by: mail: missing '@' or angle-addr"),
+		},
+		{
+			name: "Repository failure",
+			req:  &userPbv1.FindOrCreateUserRequest{FirstName: "John", LastName: "Smith", EmailAddress: "john@example.com"},
+			setupMock: func() {
+				mockRepo.EXPECT().FindOrCreateUser(gomock.Any(), gomock.Any()).Return(nil, false, consts.ErrDatabaseError)
+			},
+			expectedError: status.Error(codes.Internal, "failed to find or create user"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			resp, err := userService.FindOrCreateUser(context.Background(), tc.req)
+
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, resp)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCreated, resp.Created)
+			assert.Equal(t, tc.expectedUserID, resp.User.UserId)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Package tracing exports OpenTelemetry traces of gRPC calls, repository calls, cache
+// operations and Kafka publishes when OTEL_ENABLED is set
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"github.com/yasindce1998/issue-tracker/logger"
+)
+
+const (
+	// instrumentationName names the tracer the application's own spans come from
+	instrumentationName = "github.com/yasindce1998/issue-tracker"
+
+	defaultEndpoint    = "localhost:4317"
+	defaultServiceName = "issue-tracker"
+	defaultSampleRatio = 1.0
+)
+
+// LogTraceIDKey is the span attribute holding the trace ID written to the request's log lines
+const LogTraceIDKey = attribute.Key("log.trace_id")
+
+// enabled is set once a tracer provider is installed
+var enabled atomic.Bool
+
+// Config controls trace export
+type Config struct {
+	Enabled bool
+	// Endpoint is the OTLP gRPC collector, as host:port or as a URL
+	Endpoint    string
+	ServiceName string
+	// SampleRatio is the fraction of new traces recorded; calls that are part of a sampled
+	// trace are always recorded
+	SampleRatio float64
+}
+
+// ConfigFromEnv reads the tracing settings from OTEL_ENABLED, OTEL_EXPORTER_OTLP_ENDPOINT,
+// OTEL_SERVICE_NAME and OTEL_SAMPLING_RATIO, falling back to the defaults for anything unset
+// or invalid
+func ConfigFromEnv() Config {
+	cfg := Config{
+		Endpoint:    defaultEndpoint,
+		ServiceName: defaultServiceName,
+		SampleRatio: defaultSampleRatio,
+	}
+
+	if v, err := strconv.ParseBool(os.Getenv("OTEL_ENABLED")); err == nil {
+		cfg.Enabled = v
+	}
+	if v := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); v != "" {
+		cfg.Endpoint = v
+	}
+	if v := os.Getenv("OTEL_SERVICE_NAME"); v != "" {
+		cfg.ServiceName = v
+	}
+	if v, err := strconv.ParseFloat(os.Getenv("OTEL_SAMPLING_RATIO"), 64); err == nil && v >= 0 && v <= 1 {
+		cfg.SampleRatio = v
+	}
+
+	return cfg
+}
+
+// Setup installs a tracer provider exporting to the configured collector and returns the
+// function that flushes and stops it. Without Enabled it does nothing, and every span
+// started through this package is a no-op.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint), otlptracegrpc.WithInsecure()}
+	if strings.Contains(cfg.Endpoint, "://") {
+		// A URL carries its own scheme, which decides whether the connection is secure
+		options = []otlptracegrpc.Option{otlptracegrpc.WithEndpointURL(cfg.Endpoint)}
+	}
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName))),
+	)
+	Install(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Install makes provider the source of every span and enables the gRPC instrumentation.
+// Setup calls it; tests can install a provider with an in-memory exporter instead.
+func Install(provider trace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	enabled.Store(true)
+}
+
+// Enabled reports whether a tracer provider has been installed
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// ServerOptions returns the options that trace incoming gRPC calls, or none when tracing is off
+func ServerOptions() []grpc.ServerOption {
+	if !Enabled() {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.StatsHandler(otelgrpc.NewServerHandler())}
+}
+
+// DialOptions returns the options that trace outgoing gRPC calls, or none when tracing is off
+func DialOptions() []grpc.DialOption {
+	if !Enabled() {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithStatsHandler(otelgrpc.NewClientHandler())}
+}
+
+// StartSpan starts a span as a child of the span in ctx. The span carries the log trace ID of
+// the request, if there is one, so traces and log lines can be matched up.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if traceID := logger.TraceIDFromContext(ctx); traceID != "" {
+		attrs = append(attrs, LogTraceIDKey.String(traceID))
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err, if any, as the outcome of the span and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
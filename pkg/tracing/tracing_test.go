@@ -0,0 +1,57 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yasindce1998/issue-tracker/pkg/tracing"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("OTEL_ENABLED", "")
+		t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+		t.Setenv("OTEL_SERVICE_NAME", "")
+		t.Setenv("OTEL_SAMPLING_RATIO", "")
+
+		assert.Equal(t, tracing.Config{
+			Endpoint:    "localhost:4317",
+			ServiceName: "issue-tracker",
+			SampleRatio: 1,
+		}, tracing.ConfigFromEnv())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("OTEL_ENABLED", "true")
+		t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "collector:4317")
+		t.Setenv("OTEL_SERVICE_NAME", "issues")
+		t.Setenv("OTEL_SAMPLING_RATIO", "0.25")
+
+		assert.Equal(t, tracing.Config{
+			Enabled:     true,
+			Endpoint:    "collector:4317",
+			ServiceName: "issues",
+			SampleRatio: 0.25,
+		}, tracing.ConfigFromEnv())
+	})
+
+	t.Run("invalid sampling ratio", func(t *testing.T) {
+		for _, ratio := range []string{"-0.5", "1.5", "half"} {
+			t.Setenv("OTEL_SAMPLING_RATIO", ratio)
+			assert.Equal(t, 1.0, tracing.ConfigFromEnv().SampleRatio, ratio)
+		}
+	})
+}
+
+func TestSetup_Disabled(t *testing.T) {
+	shutdown, err := tracing.Setup(context.Background(), tracing.Config{})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	assert.False(t, tracing.Enabled())
+	assert.Nil(t, tracing.ServerOptions())
+	assert.Nil(t, tracing.DialOptions())
+}